@@ -3,6 +3,7 @@ package movev1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
@@ -546,15 +547,70 @@ func (x *_ExecuteAuthorization_1_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ExecuteAuthorization_2_list)(nil)
+
+type _ExecuteAuthorization_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ExecuteAuthorization_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecuteAuthorization_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ExecuteAuthorization_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecuteAuthorization_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecuteAuthorization_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecuteAuthorization_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecuteAuthorization_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecuteAuthorization_2_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ExecuteAuthorization       protoreflect.MessageDescriptor
-	fd_ExecuteAuthorization_items protoreflect.FieldDescriptor
+	md_ExecuteAuthorization                protoreflect.MessageDescriptor
+	fd_ExecuteAuthorization_items          protoreflect.FieldDescriptor
+	fd_ExecuteAuthorization_spend_limit    protoreflect.FieldDescriptor
+	fd_ExecuteAuthorization_remaining_uses protoreflect.FieldDescriptor
 )
 
 func init() {
 	file_initia_move_v1_authz_proto_init()
 	md_ExecuteAuthorization = File_initia_move_v1_authz_proto.Messages().ByName("ExecuteAuthorization")
 	fd_ExecuteAuthorization_items = md_ExecuteAuthorization.Fields().ByName("items")
+	fd_ExecuteAuthorization_spend_limit = md_ExecuteAuthorization.Fields().ByName("spend_limit")
+	fd_ExecuteAuthorization_remaining_uses = md_ExecuteAuthorization.Fields().ByName("remaining_uses")
 }
 
 var _ protoreflect.Message = (*fastReflection_ExecuteAuthorization)(nil)
@@ -628,6 +684,18 @@ func (x *fastReflection_ExecuteAuthorization) Range(f func(protoreflect.FieldDes
 			return
 		}
 	}
+	if len(x.SpendLimit) != 0 {
+		value := protoreflect.ValueOfList(&_ExecuteAuthorization_2_list{list: &x.SpendLimit})
+		if !f(fd_ExecuteAuthorization_spend_limit, value) {
+			return
+		}
+	}
+	if x.RemainingUses != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.RemainingUses)
+		if !f(fd_ExecuteAuthorization_remaining_uses, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -645,6 +713,10 @@ func (x *fastReflection_ExecuteAuthorization) Has(fd protoreflect.FieldDescripto
 	switch fd.FullName() {
 	case "initia.move.v1.ExecuteAuthorization.items":
 		return len(x.Items) != 0
+	case "initia.move.v1.ExecuteAuthorization.spend_limit":
+		return len(x.SpendLimit) != 0
+	case "initia.move.v1.ExecuteAuthorization.remaining_uses":
+		return x.RemainingUses != uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorization"))
@@ -663,6 +735,10 @@ func (x *fastReflection_ExecuteAuthorization) Clear(fd protoreflect.FieldDescrip
 	switch fd.FullName() {
 	case "initia.move.v1.ExecuteAuthorization.items":
 		x.Items = nil
+	case "initia.move.v1.ExecuteAuthorization.spend_limit":
+		x.SpendLimit = nil
+	case "initia.move.v1.ExecuteAuthorization.remaining_uses":
+		x.RemainingUses = uint64(0)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorization"))
@@ -685,6 +761,15 @@ func (x *fastReflection_ExecuteAuthorization) Get(descriptor protoreflect.FieldD
 		}
 		listValue := &_ExecuteAuthorization_1_list{list: &x.Items}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ExecuteAuthorization.spend_limit":
+		if len(x.SpendLimit) == 0 {
+			return protoreflect.ValueOfList(&_ExecuteAuthorization_2_list{})
+		}
+		listValue := &_ExecuteAuthorization_2_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ExecuteAuthorization.remaining_uses":
+		value := x.RemainingUses
+		return protoreflect.ValueOfUint64(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorization"))
@@ -709,6 +794,12 @@ func (x *fastReflection_ExecuteAuthorization) Set(fd protoreflect.FieldDescripto
 		lv := value.List()
 		clv := lv.(*_ExecuteAuthorization_1_list)
 		x.Items = *clv.list
+	case "initia.move.v1.ExecuteAuthorization.spend_limit":
+		lv := value.List()
+		clv := lv.(*_ExecuteAuthorization_2_list)
+		x.SpendLimit = *clv.list
+	case "initia.move.v1.ExecuteAuthorization.remaining_uses":
+		x.RemainingUses = value.Uint()
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorization"))
@@ -735,6 +826,14 @@ func (x *fastReflection_ExecuteAuthorization) Mutable(fd protoreflect.FieldDescr
 		}
 		value := &_ExecuteAuthorization_1_list{list: &x.Items}
 		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ExecuteAuthorization.spend_limit":
+		if x.SpendLimit == nil {
+			x.SpendLimit = []*v1beta1.Coin{}
+		}
+		value := &_ExecuteAuthorization_2_list{list: &x.SpendLimit}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ExecuteAuthorization.remaining_uses":
+		panic(fmt.Errorf("field remaining_uses of message initia.move.v1.ExecuteAuthorization is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorization"))
@@ -751,6 +850,11 @@ func (x *fastReflection_ExecuteAuthorization) NewField(fd protoreflect.FieldDesc
 	case "initia.move.v1.ExecuteAuthorization.items":
 		list := []*ExecuteAuthorizationItem{}
 		return protoreflect.ValueOfList(&_ExecuteAuthorization_1_list{list: &list})
+	case "initia.move.v1.ExecuteAuthorization.spend_limit":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ExecuteAuthorization_2_list{list: &list})
+	case "initia.move.v1.ExecuteAuthorization.remaining_uses":
+		return protoreflect.ValueOfUint64(uint64(0))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorization"))
@@ -826,6 +930,15 @@ func (x *fastReflection_ExecuteAuthorization) ProtoMethods() *protoiface.Methods
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.SpendLimit) > 0 {
+			for _, e := range x.SpendLimit {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.RemainingUses != 0 {
+			n += 1 + runtime.Sov(uint64(x.RemainingUses))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -855,6 +968,27 @@ func (x *fastReflection_ExecuteAuthorization) ProtoMethods() *protoiface.Methods
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if x.RemainingUses != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.RemainingUses))
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.SpendLimit) > 0 {
+			for iNdEx := len(x.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.SpendLimit[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
 		if len(x.Items) > 0 {
 			for iNdEx := len(x.Items) - 1; iNdEx >= 0; iNdEx-- {
 				encoded, err := options.Marshal(x.Items[iNdEx])
@@ -954,6 +1088,59 @@ func (x *fastReflection_ExecuteAuthorization) ProtoMethods() *protoiface.Methods
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.SpendLimit = append(x.SpendLimit, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.SpendLimit[len(x.SpendLimit)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+				}
+				x.RemainingUses = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.RemainingUses |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -1045,6 +1232,12 @@ type ExecuteAuthorization struct {
 	unknownFields protoimpl.UnknownFields
 
 	Items []*ExecuteAuthorizationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// SpendLimit is the remaining cap of the coins which can flow out of the
+	// granter account during the executions, and empty means no cap.
+	SpendLimit []*v1beta1.Coin `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3" json:"spend_limit,omitempty"`
+	// RemainingUses is the number of executions left before the grant
+	// expires, and zero means no limit.
+	RemainingUses uint64 `protobuf:"varint,3,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
 }
 
 func (x *ExecuteAuthorization) Reset() {
@@ -1074,6 +1267,20 @@ func (x *ExecuteAuthorization) GetItems() []*ExecuteAuthorizationItem {
 	return nil
 }
 
+func (x *ExecuteAuthorization) GetSpendLimit() []*v1beta1.Coin {
+	if x != nil {
+		return x.SpendLimit
+	}
+	return nil
+}
+
+func (x *ExecuteAuthorization) GetRemainingUses() uint64 {
+	if x != nil {
+		return x.RemainingUses
+	}
+	return 0
+}
+
 var File_initia_move_v1_authz_proto protoreflect.FileDescriptor
 
 var file_initia_move_v1_authz_proto_rawDesc = []byte{
@@ -1081,6 +1288,8 @@ var file_initia_move_v1_authz_proto_rawDesc = []byte{
 	0x2f, 0x61, 0x75, 0x74, 0x68, 0x7a, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x69, 0x6e,
 	0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d,
 	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x19, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x5f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x63, 0x6f,
 	0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f,
 	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
@@ -1092,28 +1301,39 @@ var file_initia_move_v1_authz_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x3a, 0x2f, 0xca, 0xb4, 0x2d, 0x0d, 0x41, 0x75, 0x74,
 	0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x19, 0x6d,
 	0x6f, 0x76, 0x65, 0x2f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x41, 0x75, 0x74, 0x68, 0x6f,
-	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x92, 0x01, 0x0a, 0x14, 0x45, 0x78, 0x65,
+	0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xbe, 0x02, 0x0a, 0x14, 0x45, 0x78, 0x65,
 	0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f,
 	0x6e, 0x12, 0x49, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
 	0x32, 0x28, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
 	0x31, 0x2e, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
 	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00,
-	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x3a, 0x2f, 0xca, 0xb4,
-	0x2d, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a,
-	0xe7, 0xb0, 0x2a, 0x19, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65,
-	0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xb3, 0x01,
-	0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x50, 0x72, 0x6f, 0x74, 0x6f,
-	0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
-	0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76,
-	0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d,
-	0x58, 0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e,
-	0x56, 0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65,
-	0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76,
-	0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
-	0xea, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a,
-	0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x82, 0x01, 0x0a,
+	0x0b, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65,
+	0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x46, 0xc8,
+	0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d,
+	0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0x9a,
+	0xe7, 0xb0, 0x2a, 0x0c, 0x6c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x5f, 0x63, 0x6f, 0x69, 0x6e, 0x73,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0a, 0x73, 0x70, 0x65, 0x6e, 0x64, 0x4c, 0x69, 0x6d, 0x69,
+	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x75,
+	0x73, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x55, 0x73, 0x65, 0x73, 0x3a, 0x2f, 0xca, 0xb4, 0x2d, 0x0d, 0x41, 0x75,
+	0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x8a, 0xe7, 0xb0, 0x2a, 0x19,
+	0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68,
+	0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0xb3, 0x01, 0x0a, 0x12, 0x63, 0x6f,
+	0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31,
+	0x42, 0x0a, 0x41, 0x75, 0x74, 0x68, 0x7a, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37,
+	0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70,
+	0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31,
+	0x3b, 0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e,
+	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02,
+	0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2,
+	0x02, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31,
+	0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62,
+	0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1133,14 +1353,16 @@ var file_initia_move_v1_authz_proto_goTypes = []interface{}{
 	(*PublishAuthorization)(nil),     // 0: initia.move.v1.PublishAuthorization
 	(*ExecuteAuthorization)(nil),     // 1: initia.move.v1.ExecuteAuthorization
 	(*ExecuteAuthorizationItem)(nil), // 2: initia.move.v1.ExecuteAuthorizationItem
+	(*v1beta1.Coin)(nil),             // 3: cosmos.base.v1beta1.Coin
 }
 var file_initia_move_v1_authz_proto_depIdxs = []int32{
 	2, // 0: initia.move.v1.ExecuteAuthorization.items:type_name -> initia.move.v1.ExecuteAuthorizationItem
-	1, // [1:1] is the sub-list for method output_type
-	1, // [1:1] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 1: initia.move.v1.ExecuteAuthorization.spend_limit:type_name -> cosmos.base.v1beta1.Coin
+	2, // [2:2] is the sub-list for method output_type
+	2, // [2:2] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_initia_move_v1_authz_proto_init() }
@@ -3,6 +3,7 @@ package movev1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -315,465 +316,1552 @@ func (x *_GenesisState_8_list) IsValid() bool {
 	return x.list != nil
 }
 
-var (
-	md_GenesisState                   protoreflect.MessageDescriptor
-	fd_GenesisState_params            protoreflect.FieldDescriptor
-	fd_GenesisState_execution_counter protoreflect.FieldDescriptor
-	fd_GenesisState_stdlibs           protoreflect.FieldDescriptor
-	fd_GenesisState_modules           protoreflect.FieldDescriptor
-	fd_GenesisState_resources         protoreflect.FieldDescriptor
-	fd_GenesisState_table_infos       protoreflect.FieldDescriptor
-	fd_GenesisState_table_entries     protoreflect.FieldDescriptor
-	fd_GenesisState_dex_pairs         protoreflect.FieldDescriptor
-)
+var _ protoreflect.List = (*_GenesisState_9_list)(nil)
 
-func init() {
-	file_initia_move_v1_genesis_proto_init()
-	md_GenesisState = File_initia_move_v1_genesis_proto.Messages().ByName("GenesisState")
-	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
-	fd_GenesisState_execution_counter = md_GenesisState.Fields().ByName("execution_counter")
-	fd_GenesisState_stdlibs = md_GenesisState.Fields().ByName("stdlibs")
-	fd_GenesisState_modules = md_GenesisState.Fields().ByName("modules")
-	fd_GenesisState_resources = md_GenesisState.Fields().ByName("resources")
-	fd_GenesisState_table_infos = md_GenesisState.Fields().ByName("table_infos")
-	fd_GenesisState_table_entries = md_GenesisState.Fields().ByName("table_entries")
-	fd_GenesisState_dex_pairs = md_GenesisState.Fields().ByName("dex_pairs")
+type _GenesisState_9_list struct {
+	list *[]*ModuleRevenueRecipient
 }
 
-var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
 
-type fastReflection_GenesisState GenesisState
+func (x *_GenesisState_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
 
-func (x *GenesisState) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_GenesisState)(x)
+func (x *_GenesisState_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleRevenueRecipient)
+	(*x.list)[i] = concreteValue
 }
 
-func (x *GenesisState) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_move_v1_genesis_proto_msgTypes[0]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+func (x *_GenesisState_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleRevenueRecipient)
+	*x.list = append(*x.list, concreteValue)
 }
 
-var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
-var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+func (x *_GenesisState_9_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleRevenueRecipient)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
 
-type fastReflection_GenesisState_messageType struct{}
+func (x *_GenesisState_9_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
 
-func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_GenesisState)(nil)
+func (x *_GenesisState_9_list) NewElement() protoreflect.Value {
+	v := new(ModuleRevenueRecipient)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
 }
-func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+
+func (x *_GenesisState_9_list) IsValid() bool {
+	return x.list != nil
 }
-func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+
+var _ protoreflect.List = (*_GenesisState_10_list)(nil)
+
+type _GenesisState_10_list struct {
+	list *[]*ModuleRevenueRatio
 }
 
-// Descriptor returns message descriptor, which contains only the protobuf
-// type information for the message.
-func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
-	return md_GenesisState
+func (x *_GenesisState_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-// Type returns the message type, which encapsulates both Go and protobuf
-// type information. If the Go type information is not needed,
-// it is recommended that the message descriptor be used instead.
-func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
-	return _fastReflection_GenesisState_messageType
+func (x *_GenesisState_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// New returns a newly allocated and mutable empty message.
-func (x *fastReflection_GenesisState) New() protoreflect.Message {
-	return new(fastReflection_GenesisState)
+func (x *_GenesisState_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleRevenueRatio)
+	(*x.list)[i] = concreteValue
 }
 
-// Interface unwraps the message reflection interface and
-// returns the underlying ProtoMessage interface.
-func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
-	return (*GenesisState)(x)
+func (x *_GenesisState_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleRevenueRatio)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Range iterates over every populated field in an undefined order,
-// calling f for each field descriptor and value encountered.
-// Range returns immediately if f returns false.
-// While iterating, mutating operations may only be performed
-// on the current field descriptor.
-func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
-	if x.Params != nil {
-		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-		if !f(fd_GenesisState_params, value) {
-			return
-		}
-	}
-	if x.ExecutionCounter != uint64(0) {
-		value := protoreflect.ValueOfUint64(x.ExecutionCounter)
-		if !f(fd_GenesisState_execution_counter, value) {
-			return
-		}
-	}
-	if len(x.Stdlibs) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Stdlibs})
-		if !f(fd_GenesisState_stdlibs, value) {
-			return
-		}
-	}
-	if len(x.Modules) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.Modules})
-		if !f(fd_GenesisState_modules, value) {
-			return
-		}
-	}
-	if len(x.Resources) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Resources})
-		if !f(fd_GenesisState_resources, value) {
-			return
-		}
-	}
-	if len(x.TableInfos) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.TableInfos})
-		if !f(fd_GenesisState_table_infos, value) {
-			return
-		}
-	}
-	if len(x.TableEntries) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TableEntries})
-		if !f(fd_GenesisState_table_entries, value) {
-			return
-		}
+func (x *_GenesisState_10_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleRevenueRatio)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
-	if len(x.DexPairs) != 0 {
-		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.DexPairs})
-		if !f(fd_GenesisState_dex_pairs, value) {
-			return
-		}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_10_list) NewElement() protoreflect.Value {
+	v := new(ModuleRevenueRatio)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_11_list)(nil)
+
+type _GenesisState_11_list struct {
+	list *[]*ModuleRevenue
+}
+
+func (x *_GenesisState_11_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Has reports whether a field is populated.
-//
-// Some fields have the property of nullability where it is possible to
-// distinguish between the default value of a field and whether the field
-// was explicitly populated with the default value. Singular message fields,
-// member fields of a oneof, and proto2 scalar fields are nullable. Such
-// fields are populated only if explicitly set.
-//
-// In other cases (aside from the nullable cases above),
-// a proto3 scalar field is populated if it contains a non-zero value, and
-// a repeated field is populated if it is non-empty.
-func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
-	switch fd.FullName() {
-	case "initia.move.v1.GenesisState.params":
-		return x.Params != nil
-	case "initia.move.v1.GenesisState.execution_counter":
-		return x.ExecutionCounter != uint64(0)
-	case "initia.move.v1.GenesisState.stdlibs":
-		return len(x.Stdlibs) != 0
-	case "initia.move.v1.GenesisState.modules":
-		return len(x.Modules) != 0
-	case "initia.move.v1.GenesisState.resources":
-		return len(x.Resources) != 0
-	case "initia.move.v1.GenesisState.table_infos":
-		return len(x.TableInfos) != 0
-	case "initia.move.v1.GenesisState.table_entries":
-		return len(x.TableEntries) != 0
-	case "initia.move.v1.GenesisState.dex_pairs":
-		return len(x.DexPairs) != 0
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleRevenue)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleRevenue)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_11_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleRevenue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// Clear clears the field such that a subsequent Has call reports false.
-//
-// Clearing an extension field clears both the extension type and value
-// associated with the given field number.
-//
-// Clear is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
-	switch fd.FullName() {
-	case "initia.move.v1.GenesisState.params":
-		x.Params = nil
-	case "initia.move.v1.GenesisState.execution_counter":
-		x.ExecutionCounter = uint64(0)
-	case "initia.move.v1.GenesisState.stdlibs":
-		x.Stdlibs = nil
-	case "initia.move.v1.GenesisState.modules":
-		x.Modules = nil
-	case "initia.move.v1.GenesisState.resources":
-		x.Resources = nil
-	case "initia.move.v1.GenesisState.table_infos":
-		x.TableInfos = nil
-	case "initia.move.v1.GenesisState.table_entries":
-		x.TableEntries = nil
-	case "initia.move.v1.GenesisState.dex_pairs":
-		x.DexPairs = nil
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_11_list) NewElement() protoreflect.Value {
+	v := new(ModuleRevenue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_12_list)(nil)
+
+type _GenesisState_12_list struct {
+	list *[]*ClaimableRevenue
+}
+
+func (x *_GenesisState_12_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
+	return len(*x.list)
 }
 
-// Get retrieves the value for a field.
-//
-// For unpopulated scalars, it returns the default value, where
-// the default value of a bytes scalar is guaranteed to be a copy.
-// For unpopulated composite types, it returns an empty, read-only view
-// of the value; to obtain a mutable reference, use Mutable.
-func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
-	switch descriptor.FullName() {
-	case "initia.move.v1.GenesisState.params":
-		value := x.Params
-		return protoreflect.ValueOfMessage(value.ProtoReflect())
-	case "initia.move.v1.GenesisState.execution_counter":
-		value := x.ExecutionCounter
-		return protoreflect.ValueOfUint64(value)
-	case "initia.move.v1.GenesisState.stdlibs":
-		if len(x.Stdlibs) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_3_list{})
-		}
-		listValue := &_GenesisState_3_list{list: &x.Stdlibs}
-		return protoreflect.ValueOfList(listValue)
-	case "initia.move.v1.GenesisState.modules":
-		if len(x.Modules) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_4_list{})
-		}
-		listValue := &_GenesisState_4_list{list: &x.Modules}
-		return protoreflect.ValueOfList(listValue)
-	case "initia.move.v1.GenesisState.resources":
-		if len(x.Resources) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_5_list{})
-		}
-		listValue := &_GenesisState_5_list{list: &x.Resources}
-		return protoreflect.ValueOfList(listValue)
-	case "initia.move.v1.GenesisState.table_infos":
-		if len(x.TableInfos) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_6_list{})
-		}
-		listValue := &_GenesisState_6_list{list: &x.TableInfos}
-		return protoreflect.ValueOfList(listValue)
-	case "initia.move.v1.GenesisState.table_entries":
-		if len(x.TableEntries) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_7_list{})
-		}
-		listValue := &_GenesisState_7_list{list: &x.TableEntries}
-		return protoreflect.ValueOfList(listValue)
-	case "initia.move.v1.GenesisState.dex_pairs":
-		if len(x.DexPairs) == 0 {
-			return protoreflect.ValueOfList(&_GenesisState_8_list{})
-		}
-		listValue := &_GenesisState_8_list{list: &x.DexPairs}
-		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", descriptor.FullName()))
-	}
+func (x *_GenesisState_12_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// Set stores the value for a field.
-//
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType.
-// When setting a composite type, it is unspecified whether the stored value
-// aliases the source's memory in any way. If the composite value is an
-// empty, read-only value, then it panics.
-//
-// Set is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
-	switch fd.FullName() {
-	case "initia.move.v1.GenesisState.params":
-		x.Params = value.Message().Interface().(*Params)
-	case "initia.move.v1.GenesisState.execution_counter":
-		x.ExecutionCounter = value.Uint()
-	case "initia.move.v1.GenesisState.stdlibs":
-		lv := value.List()
-		clv := lv.(*_GenesisState_3_list)
-		x.Stdlibs = *clv.list
-	case "initia.move.v1.GenesisState.modules":
-		lv := value.List()
-		clv := lv.(*_GenesisState_4_list)
-		x.Modules = *clv.list
-	case "initia.move.v1.GenesisState.resources":
-		lv := value.List()
-		clv := lv.(*_GenesisState_5_list)
-		x.Resources = *clv.list
-	case "initia.move.v1.GenesisState.table_infos":
-		lv := value.List()
-		clv := lv.(*_GenesisState_6_list)
-		x.TableInfos = *clv.list
-	case "initia.move.v1.GenesisState.table_entries":
-		lv := value.List()
-		clv := lv.(*_GenesisState_7_list)
-		x.TableEntries = *clv.list
-	case "initia.move.v1.GenesisState.dex_pairs":
-		lv := value.List()
-		clv := lv.(*_GenesisState_8_list)
-		x.DexPairs = *clv.list
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_12_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimableRevenue)
+	(*x.list)[i] = concreteValue
 }
 
-// Mutable returns a mutable reference to a composite type.
-//
-// If the field is unpopulated, it may allocate a composite value.
-// For a field belonging to a oneof, it implicitly clears any other field
-// that may be currently set within the same oneof.
-// For extension fields, it implicitly stores the provided ExtensionType
-// if not already stored.
-// It panics if the field does not contain a composite type.
-//
-// Mutable is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "initia.move.v1.GenesisState.params":
-		if x.Params == nil {
-			x.Params = new(Params)
-		}
-		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
-	case "initia.move.v1.GenesisState.stdlibs":
-		if x.Stdlibs == nil {
-			x.Stdlibs = [][]byte{}
-		}
-		value := &_GenesisState_3_list{list: &x.Stdlibs}
-		return protoreflect.ValueOfList(value)
-	case "initia.move.v1.GenesisState.modules":
-		if x.Modules == nil {
-			x.Modules = []*Module{}
-		}
-		value := &_GenesisState_4_list{list: &x.Modules}
-		return protoreflect.ValueOfList(value)
-	case "initia.move.v1.GenesisState.resources":
-		if x.Resources == nil {
-			x.Resources = []*Resource{}
-		}
-		value := &_GenesisState_5_list{list: &x.Resources}
-		return protoreflect.ValueOfList(value)
-	case "initia.move.v1.GenesisState.table_infos":
-		if x.TableInfos == nil {
-			x.TableInfos = []*TableInfo{}
-		}
-		value := &_GenesisState_6_list{list: &x.TableInfos}
-		return protoreflect.ValueOfList(value)
-	case "initia.move.v1.GenesisState.table_entries":
-		if x.TableEntries == nil {
-			x.TableEntries = []*TableEntry{}
-		}
-		value := &_GenesisState_7_list{list: &x.TableEntries}
-		return protoreflect.ValueOfList(value)
-	case "initia.move.v1.GenesisState.dex_pairs":
-		if x.DexPairs == nil {
-			x.DexPairs = []*DexPair{}
-		}
-		value := &_GenesisState_8_list{list: &x.DexPairs}
-		return protoreflect.ValueOfList(value)
-	case "initia.move.v1.GenesisState.execution_counter":
-		panic(fmt.Errorf("field execution_counter of message initia.move.v1.GenesisState is not mutable"))
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
-	}
+func (x *_GenesisState_12_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ClaimableRevenue)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// NewField returns a new value that is assignable to the field
-// for the given descriptor. For scalars, this returns the default value.
-// For lists, maps, and messages, this returns a new, empty, mutable value.
-func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
-	switch fd.FullName() {
-	case "initia.move.v1.GenesisState.params":
-		m := new(Params)
-		return protoreflect.ValueOfMessage(m.ProtoReflect())
-	case "initia.move.v1.GenesisState.execution_counter":
-		return protoreflect.ValueOfUint64(uint64(0))
-	case "initia.move.v1.GenesisState.stdlibs":
-		list := [][]byte{}
-		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
-	case "initia.move.v1.GenesisState.modules":
-		list := []*Module{}
-		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
-	case "initia.move.v1.GenesisState.resources":
-		list := []*Resource{}
-		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
-	case "initia.move.v1.GenesisState.table_infos":
-		list := []*TableInfo{}
-		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
-	case "initia.move.v1.GenesisState.table_entries":
-		list := []*TableEntry{}
-		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
-	case "initia.move.v1.GenesisState.dex_pairs":
-		list := []*DexPair{}
-		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
-	default:
-		if fd.IsExtension() {
-			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
-		}
-		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+func (x *_GenesisState_12_list) AppendMutable() protoreflect.Value {
+	v := new(ClaimableRevenue)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
 	}
+	*x.list = (*x.list)[:n]
 }
 
-// WhichOneof reports which field within the oneof is populated,
-// returning nil if none are populated.
-// It panics if the oneof descriptor does not belong to this message.
-func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
-	switch d.FullName() {
-	default:
-		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.GenesisState", d.FullName()))
+func (x *_GenesisState_12_list) NewElement() protoreflect.Value {
+	v := new(ClaimableRevenue)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_12_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_13_list)(nil)
+
+type _GenesisState_13_list struct {
+	list *[]*CronJob
+}
+
+func (x *_GenesisState_13_list) Len() int {
+	if x.list == nil {
+		return 0
 	}
-	panic("unreachable")
+	return len(*x.list)
 }
 
-// GetUnknown retrieves the entire list of unknown fields.
-// The caller may only mutate the contents of the RawFields
-// if the mutated bytes are stored back into the message with SetUnknown.
-func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
-	return x.unknownFields
+func (x *_GenesisState_13_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
 }
 
-// SetUnknown stores an entire list of unknown fields.
-// The raw fields must be syntactically valid according to the wire format.
-// An implementation may panic if this is not the case.
-// Once stored, the caller must not mutate the content of the RawFields.
-// An empty RawFields may be passed to clear the fields.
-//
-// SetUnknown is a mutating operation and unsafe for concurrent use.
-func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
-	x.unknownFields = fields
+func (x *_GenesisState_13_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CronJob)
+	(*x.list)[i] = concreteValue
 }
 
-// IsValid reports whether the message is valid.
-//
-// An invalid message is an empty, read-only value.
-//
-// An invalid message often corresponds to a nil pointer of the concrete
-// message type, but the details are implementation dependent.
-// Validity is not part of the protobuf data model, and may not
-// be preserved in marshaling or other operations.
-func (x *fastReflection_GenesisState) IsValid() bool {
-	return x != nil
+func (x *_GenesisState_13_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CronJob)
+	*x.list = append(*x.list, concreteValue)
 }
 
-// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
-// This method may return nil.
-//
-// The returned methods type is identical to
+func (x *_GenesisState_13_list) AppendMutable() protoreflect.Value {
+	v := new(CronJob)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_13_list) NewElement() protoreflect.Value {
+	v := new(CronJob)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_13_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_14_list)(nil)
+
+type _GenesisState_14_list struct {
+	list *[]*CronRun
+}
+
+func (x *_GenesisState_14_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_14_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CronRun)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_14_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*CronRun)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_14_list) AppendMutable() protoreflect.Value {
+	v := new(CronRun)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_14_list) NewElement() protoreflect.Value {
+	v := new(CronRun)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_14_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_16_list)(nil)
+
+type _GenesisState_16_list struct {
+	list *[]*PublishNamespace
+}
+
+func (x *_GenesisState_16_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_16_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PublishNamespace)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_16_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PublishNamespace)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_16_list) AppendMutable() protoreflect.Value {
+	v := new(PublishNamespace)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_16_list) NewElement() protoreflect.Value {
+	v := new(PublishNamespace)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_16_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_17_list)(nil)
+
+type _GenesisState_17_list struct {
+	list *[]*PublishUsage
+}
+
+func (x *_GenesisState_17_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_17_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PublishUsage)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_17_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PublishUsage)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_17_list) AppendMutable() protoreflect.Value {
+	v := new(PublishUsage)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_17_list) NewElement() protoreflect.Value {
+	v := new(PublishUsage)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_17_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_18_list)(nil)
+
+type _GenesisState_18_list struct {
+	list *[]*PublishDeposit
+}
+
+func (x *_GenesisState_18_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_18_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PublishDeposit)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_18_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*PublishDeposit)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_18_list) AppendMutable() protoreflect.Value {
+	v := new(PublishDeposit)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_18_list) NewElement() protoreflect.Value {
+	v := new(PublishDeposit)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_18_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_19_list)(nil)
+
+type _GenesisState_19_list struct {
+	list *[]*Authenticator
+}
+
+func (x *_GenesisState_19_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_19_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Authenticator)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_19_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Authenticator)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_19_list) AppendMutable() protoreflect.Value {
+	v := new(Authenticator)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_19_list) NewElement() protoreflect.Value {
+	v := new(Authenticator)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_19_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_20_list)(nil)
+
+type _GenesisState_20_list struct {
+	list *[]*Sponsor
+}
+
+func (x *_GenesisState_20_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_20_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Sponsor)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_20_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*Sponsor)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_20_list) AppendMutable() protoreflect.Value {
+	v := new(Sponsor)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_20_list) NewElement() protoreflect.Value {
+	v := new(Sponsor)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_20_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_21_list)(nil)
+
+type _GenesisState_21_list struct {
+	list *[]*VotingPowerWeightObservation
+}
+
+func (x *_GenesisState_21_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_21_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerWeightObservation)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_21_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*VotingPowerWeightObservation)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_21_list) AppendMutable() protoreflect.Value {
+	v := new(VotingPowerWeightObservation)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_21_list) NewElement() protoreflect.Value {
+	v := new(VotingPowerWeightObservation)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_21_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_GenesisState_22_list)(nil)
+
+type _GenesisState_22_list struct {
+	list *[]*v1beta1.DecCoin
+}
+
+func (x *_GenesisState_22_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_GenesisState_22_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_GenesisState_22_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.DecCoin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_GenesisState_22_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_GenesisState_22_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.DecCoin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_GenesisState_22_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_GenesisState                                  protoreflect.MessageDescriptor
+	fd_GenesisState_params                           protoreflect.FieldDescriptor
+	fd_GenesisState_execution_counter                protoreflect.FieldDescriptor
+	fd_GenesisState_stdlibs                          protoreflect.FieldDescriptor
+	fd_GenesisState_modules                          protoreflect.FieldDescriptor
+	fd_GenesisState_resources                        protoreflect.FieldDescriptor
+	fd_GenesisState_table_infos                      protoreflect.FieldDescriptor
+	fd_GenesisState_table_entries                    protoreflect.FieldDescriptor
+	fd_GenesisState_dex_pairs                        protoreflect.FieldDescriptor
+	fd_GenesisState_revenue_recipients               protoreflect.FieldDescriptor
+	fd_GenesisState_revenue_ratios                   protoreflect.FieldDescriptor
+	fd_GenesisState_module_revenues                  protoreflect.FieldDescriptor
+	fd_GenesisState_claimable_revenues               protoreflect.FieldDescriptor
+	fd_GenesisState_cron_jobs                        protoreflect.FieldDescriptor
+	fd_GenesisState_cron_runs                        protoreflect.FieldDescriptor
+	fd_GenesisState_cron_job_sequence                protoreflect.FieldDescriptor
+	fd_GenesisState_publish_namespaces               protoreflect.FieldDescriptor
+	fd_GenesisState_publish_usages                   protoreflect.FieldDescriptor
+	fd_GenesisState_publish_deposits                 protoreflect.FieldDescriptor
+	fd_GenesisState_authenticators                   protoreflect.FieldDescriptor
+	fd_GenesisState_sponsors                         protoreflect.FieldDescriptor
+	fd_GenesisState_voting_power_weight_observations protoreflect.FieldDescriptor
+	fd_GenesisState_voting_power_weights             protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_genesis_proto_init()
+	md_GenesisState = File_initia_move_v1_genesis_proto.Messages().ByName("GenesisState")
+	fd_GenesisState_params = md_GenesisState.Fields().ByName("params")
+	fd_GenesisState_execution_counter = md_GenesisState.Fields().ByName("execution_counter")
+	fd_GenesisState_stdlibs = md_GenesisState.Fields().ByName("stdlibs")
+	fd_GenesisState_modules = md_GenesisState.Fields().ByName("modules")
+	fd_GenesisState_resources = md_GenesisState.Fields().ByName("resources")
+	fd_GenesisState_table_infos = md_GenesisState.Fields().ByName("table_infos")
+	fd_GenesisState_table_entries = md_GenesisState.Fields().ByName("table_entries")
+	fd_GenesisState_dex_pairs = md_GenesisState.Fields().ByName("dex_pairs")
+	fd_GenesisState_revenue_recipients = md_GenesisState.Fields().ByName("revenue_recipients")
+	fd_GenesisState_revenue_ratios = md_GenesisState.Fields().ByName("revenue_ratios")
+	fd_GenesisState_module_revenues = md_GenesisState.Fields().ByName("module_revenues")
+	fd_GenesisState_claimable_revenues = md_GenesisState.Fields().ByName("claimable_revenues")
+	fd_GenesisState_cron_jobs = md_GenesisState.Fields().ByName("cron_jobs")
+	fd_GenesisState_cron_runs = md_GenesisState.Fields().ByName("cron_runs")
+	fd_GenesisState_cron_job_sequence = md_GenesisState.Fields().ByName("cron_job_sequence")
+	fd_GenesisState_publish_namespaces = md_GenesisState.Fields().ByName("publish_namespaces")
+	fd_GenesisState_publish_usages = md_GenesisState.Fields().ByName("publish_usages")
+	fd_GenesisState_publish_deposits = md_GenesisState.Fields().ByName("publish_deposits")
+	fd_GenesisState_authenticators = md_GenesisState.Fields().ByName("authenticators")
+	fd_GenesisState_sponsors = md_GenesisState.Fields().ByName("sponsors")
+	fd_GenesisState_voting_power_weight_observations = md_GenesisState.Fields().ByName("voting_power_weight_observations")
+	fd_GenesisState_voting_power_weights = md_GenesisState.Fields().ByName("voting_power_weights")
+}
+
+var _ protoreflect.Message = (*fastReflection_GenesisState)(nil)
+
+type fastReflection_GenesisState GenesisState
+
+func (x *GenesisState) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_GenesisState)(x)
+}
+
+func (x *GenesisState) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_genesis_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_GenesisState_messageType fastReflection_GenesisState_messageType
+var _ protoreflect.MessageType = fastReflection_GenesisState_messageType{}
+
+type fastReflection_GenesisState_messageType struct{}
+
+func (x fastReflection_GenesisState_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_GenesisState)(nil)
+}
+func (x fastReflection_GenesisState_messageType) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+func (x fastReflection_GenesisState_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_GenesisState) Descriptor() protoreflect.MessageDescriptor {
+	return md_GenesisState
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_GenesisState) Type() protoreflect.MessageType {
+	return _fastReflection_GenesisState_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_GenesisState) New() protoreflect.Message {
+	return new(fastReflection_GenesisState)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_GenesisState) Interface() protoreflect.ProtoMessage {
+	return (*GenesisState)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_GenesisState) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Params != nil {
+		value := protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+		if !f(fd_GenesisState_params, value) {
+			return
+		}
+	}
+	if x.ExecutionCounter != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.ExecutionCounter)
+		if !f(fd_GenesisState_execution_counter, value) {
+			return
+		}
+	}
+	if len(x.Stdlibs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_3_list{list: &x.Stdlibs})
+		if !f(fd_GenesisState_stdlibs, value) {
+			return
+		}
+	}
+	if len(x.Modules) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_4_list{list: &x.Modules})
+		if !f(fd_GenesisState_modules, value) {
+			return
+		}
+	}
+	if len(x.Resources) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_5_list{list: &x.Resources})
+		if !f(fd_GenesisState_resources, value) {
+			return
+		}
+	}
+	if len(x.TableInfos) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_6_list{list: &x.TableInfos})
+		if !f(fd_GenesisState_table_infos, value) {
+			return
+		}
+	}
+	if len(x.TableEntries) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_7_list{list: &x.TableEntries})
+		if !f(fd_GenesisState_table_entries, value) {
+			return
+		}
+	}
+	if len(x.DexPairs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_8_list{list: &x.DexPairs})
+		if !f(fd_GenesisState_dex_pairs, value) {
+			return
+		}
+	}
+	if len(x.RevenueRecipients) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_9_list{list: &x.RevenueRecipients})
+		if !f(fd_GenesisState_revenue_recipients, value) {
+			return
+		}
+	}
+	if len(x.RevenueRatios) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_10_list{list: &x.RevenueRatios})
+		if !f(fd_GenesisState_revenue_ratios, value) {
+			return
+		}
+	}
+	if len(x.ModuleRevenues) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_11_list{list: &x.ModuleRevenues})
+		if !f(fd_GenesisState_module_revenues, value) {
+			return
+		}
+	}
+	if len(x.ClaimableRevenues) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_12_list{list: &x.ClaimableRevenues})
+		if !f(fd_GenesisState_claimable_revenues, value) {
+			return
+		}
+	}
+	if len(x.CronJobs) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_13_list{list: &x.CronJobs})
+		if !f(fd_GenesisState_cron_jobs, value) {
+			return
+		}
+	}
+	if len(x.CronRuns) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_14_list{list: &x.CronRuns})
+		if !f(fd_GenesisState_cron_runs, value) {
+			return
+		}
+	}
+	if x.CronJobSequence != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CronJobSequence)
+		if !f(fd_GenesisState_cron_job_sequence, value) {
+			return
+		}
+	}
+	if len(x.PublishNamespaces) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_16_list{list: &x.PublishNamespaces})
+		if !f(fd_GenesisState_publish_namespaces, value) {
+			return
+		}
+	}
+	if len(x.PublishUsages) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_17_list{list: &x.PublishUsages})
+		if !f(fd_GenesisState_publish_usages, value) {
+			return
+		}
+	}
+	if len(x.PublishDeposits) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_18_list{list: &x.PublishDeposits})
+		if !f(fd_GenesisState_publish_deposits, value) {
+			return
+		}
+	}
+	if len(x.Authenticators) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_19_list{list: &x.Authenticators})
+		if !f(fd_GenesisState_authenticators, value) {
+			return
+		}
+	}
+	if len(x.Sponsors) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_20_list{list: &x.Sponsors})
+		if !f(fd_GenesisState_sponsors, value) {
+			return
+		}
+	}
+	if len(x.VotingPowerWeightObservations) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_21_list{list: &x.VotingPowerWeightObservations})
+		if !f(fd_GenesisState_voting_power_weight_observations, value) {
+			return
+		}
+	}
+	if len(x.VotingPowerWeights) != 0 {
+		value := protoreflect.ValueOfList(&_GenesisState_22_list{list: &x.VotingPowerWeights})
+		if !f(fd_GenesisState_voting_power_weights, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_GenesisState) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.GenesisState.params":
+		return x.Params != nil
+	case "initia.move.v1.GenesisState.execution_counter":
+		return x.ExecutionCounter != uint64(0)
+	case "initia.move.v1.GenesisState.stdlibs":
+		return len(x.Stdlibs) != 0
+	case "initia.move.v1.GenesisState.modules":
+		return len(x.Modules) != 0
+	case "initia.move.v1.GenesisState.resources":
+		return len(x.Resources) != 0
+	case "initia.move.v1.GenesisState.table_infos":
+		return len(x.TableInfos) != 0
+	case "initia.move.v1.GenesisState.table_entries":
+		return len(x.TableEntries) != 0
+	case "initia.move.v1.GenesisState.dex_pairs":
+		return len(x.DexPairs) != 0
+	case "initia.move.v1.GenesisState.revenue_recipients":
+		return len(x.RevenueRecipients) != 0
+	case "initia.move.v1.GenesisState.revenue_ratios":
+		return len(x.RevenueRatios) != 0
+	case "initia.move.v1.GenesisState.module_revenues":
+		return len(x.ModuleRevenues) != 0
+	case "initia.move.v1.GenesisState.claimable_revenues":
+		return len(x.ClaimableRevenues) != 0
+	case "initia.move.v1.GenesisState.cron_jobs":
+		return len(x.CronJobs) != 0
+	case "initia.move.v1.GenesisState.cron_runs":
+		return len(x.CronRuns) != 0
+	case "initia.move.v1.GenesisState.cron_job_sequence":
+		return x.CronJobSequence != uint64(0)
+	case "initia.move.v1.GenesisState.publish_namespaces":
+		return len(x.PublishNamespaces) != 0
+	case "initia.move.v1.GenesisState.publish_usages":
+		return len(x.PublishUsages) != 0
+	case "initia.move.v1.GenesisState.publish_deposits":
+		return len(x.PublishDeposits) != 0
+	case "initia.move.v1.GenesisState.authenticators":
+		return len(x.Authenticators) != 0
+	case "initia.move.v1.GenesisState.sponsors":
+		return len(x.Sponsors) != 0
+	case "initia.move.v1.GenesisState.voting_power_weight_observations":
+		return len(x.VotingPowerWeightObservations) != 0
+	case "initia.move.v1.GenesisState.voting_power_weights":
+		return len(x.VotingPowerWeights) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.GenesisState.params":
+		x.Params = nil
+	case "initia.move.v1.GenesisState.execution_counter":
+		x.ExecutionCounter = uint64(0)
+	case "initia.move.v1.GenesisState.stdlibs":
+		x.Stdlibs = nil
+	case "initia.move.v1.GenesisState.modules":
+		x.Modules = nil
+	case "initia.move.v1.GenesisState.resources":
+		x.Resources = nil
+	case "initia.move.v1.GenesisState.table_infos":
+		x.TableInfos = nil
+	case "initia.move.v1.GenesisState.table_entries":
+		x.TableEntries = nil
+	case "initia.move.v1.GenesisState.dex_pairs":
+		x.DexPairs = nil
+	case "initia.move.v1.GenesisState.revenue_recipients":
+		x.RevenueRecipients = nil
+	case "initia.move.v1.GenesisState.revenue_ratios":
+		x.RevenueRatios = nil
+	case "initia.move.v1.GenesisState.module_revenues":
+		x.ModuleRevenues = nil
+	case "initia.move.v1.GenesisState.claimable_revenues":
+		x.ClaimableRevenues = nil
+	case "initia.move.v1.GenesisState.cron_jobs":
+		x.CronJobs = nil
+	case "initia.move.v1.GenesisState.cron_runs":
+		x.CronRuns = nil
+	case "initia.move.v1.GenesisState.cron_job_sequence":
+		x.CronJobSequence = uint64(0)
+	case "initia.move.v1.GenesisState.publish_namespaces":
+		x.PublishNamespaces = nil
+	case "initia.move.v1.GenesisState.publish_usages":
+		x.PublishUsages = nil
+	case "initia.move.v1.GenesisState.publish_deposits":
+		x.PublishDeposits = nil
+	case "initia.move.v1.GenesisState.authenticators":
+		x.Authenticators = nil
+	case "initia.move.v1.GenesisState.sponsors":
+		x.Sponsors = nil
+	case "initia.move.v1.GenesisState.voting_power_weight_observations":
+		x.VotingPowerWeightObservations = nil
+	case "initia.move.v1.GenesisState.voting_power_weights":
+		x.VotingPowerWeights = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_GenesisState) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.GenesisState.params":
+		value := x.Params
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.move.v1.GenesisState.execution_counter":
+		value := x.ExecutionCounter
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.GenesisState.stdlibs":
+		if len(x.Stdlibs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_3_list{})
+		}
+		listValue := &_GenesisState_3_list{list: &x.Stdlibs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.modules":
+		if len(x.Modules) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_4_list{})
+		}
+		listValue := &_GenesisState_4_list{list: &x.Modules}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.resources":
+		if len(x.Resources) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_5_list{})
+		}
+		listValue := &_GenesisState_5_list{list: &x.Resources}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.table_infos":
+		if len(x.TableInfos) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_6_list{})
+		}
+		listValue := &_GenesisState_6_list{list: &x.TableInfos}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.table_entries":
+		if len(x.TableEntries) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_7_list{})
+		}
+		listValue := &_GenesisState_7_list{list: &x.TableEntries}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.dex_pairs":
+		if len(x.DexPairs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_8_list{})
+		}
+		listValue := &_GenesisState_8_list{list: &x.DexPairs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.revenue_recipients":
+		if len(x.RevenueRecipients) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_9_list{})
+		}
+		listValue := &_GenesisState_9_list{list: &x.RevenueRecipients}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.revenue_ratios":
+		if len(x.RevenueRatios) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_10_list{})
+		}
+		listValue := &_GenesisState_10_list{list: &x.RevenueRatios}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.module_revenues":
+		if len(x.ModuleRevenues) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_11_list{})
+		}
+		listValue := &_GenesisState_11_list{list: &x.ModuleRevenues}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.claimable_revenues":
+		if len(x.ClaimableRevenues) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_12_list{})
+		}
+		listValue := &_GenesisState_12_list{list: &x.ClaimableRevenues}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.cron_jobs":
+		if len(x.CronJobs) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_13_list{})
+		}
+		listValue := &_GenesisState_13_list{list: &x.CronJobs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.cron_runs":
+		if len(x.CronRuns) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_14_list{})
+		}
+		listValue := &_GenesisState_14_list{list: &x.CronRuns}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.cron_job_sequence":
+		value := x.CronJobSequence
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.GenesisState.publish_namespaces":
+		if len(x.PublishNamespaces) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_16_list{})
+		}
+		listValue := &_GenesisState_16_list{list: &x.PublishNamespaces}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.publish_usages":
+		if len(x.PublishUsages) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_17_list{})
+		}
+		listValue := &_GenesisState_17_list{list: &x.PublishUsages}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.publish_deposits":
+		if len(x.PublishDeposits) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_18_list{})
+		}
+		listValue := &_GenesisState_18_list{list: &x.PublishDeposits}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.authenticators":
+		if len(x.Authenticators) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_19_list{})
+		}
+		listValue := &_GenesisState_19_list{list: &x.Authenticators}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.sponsors":
+		if len(x.Sponsors) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_20_list{})
+		}
+		listValue := &_GenesisState_20_list{list: &x.Sponsors}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.voting_power_weight_observations":
+		if len(x.VotingPowerWeightObservations) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_21_list{})
+		}
+		listValue := &_GenesisState_21_list{list: &x.VotingPowerWeightObservations}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.GenesisState.voting_power_weights":
+		if len(x.VotingPowerWeights) == 0 {
+			return protoreflect.ValueOfList(&_GenesisState_22_list{})
+		}
+		listValue := &_GenesisState_22_list{list: &x.VotingPowerWeights}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.GenesisState.params":
+		x.Params = value.Message().Interface().(*Params)
+	case "initia.move.v1.GenesisState.execution_counter":
+		x.ExecutionCounter = value.Uint()
+	case "initia.move.v1.GenesisState.stdlibs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_3_list)
+		x.Stdlibs = *clv.list
+	case "initia.move.v1.GenesisState.modules":
+		lv := value.List()
+		clv := lv.(*_GenesisState_4_list)
+		x.Modules = *clv.list
+	case "initia.move.v1.GenesisState.resources":
+		lv := value.List()
+		clv := lv.(*_GenesisState_5_list)
+		x.Resources = *clv.list
+	case "initia.move.v1.GenesisState.table_infos":
+		lv := value.List()
+		clv := lv.(*_GenesisState_6_list)
+		x.TableInfos = *clv.list
+	case "initia.move.v1.GenesisState.table_entries":
+		lv := value.List()
+		clv := lv.(*_GenesisState_7_list)
+		x.TableEntries = *clv.list
+	case "initia.move.v1.GenesisState.dex_pairs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_8_list)
+		x.DexPairs = *clv.list
+	case "initia.move.v1.GenesisState.revenue_recipients":
+		lv := value.List()
+		clv := lv.(*_GenesisState_9_list)
+		x.RevenueRecipients = *clv.list
+	case "initia.move.v1.GenesisState.revenue_ratios":
+		lv := value.List()
+		clv := lv.(*_GenesisState_10_list)
+		x.RevenueRatios = *clv.list
+	case "initia.move.v1.GenesisState.module_revenues":
+		lv := value.List()
+		clv := lv.(*_GenesisState_11_list)
+		x.ModuleRevenues = *clv.list
+	case "initia.move.v1.GenesisState.claimable_revenues":
+		lv := value.List()
+		clv := lv.(*_GenesisState_12_list)
+		x.ClaimableRevenues = *clv.list
+	case "initia.move.v1.GenesisState.cron_jobs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_13_list)
+		x.CronJobs = *clv.list
+	case "initia.move.v1.GenesisState.cron_runs":
+		lv := value.List()
+		clv := lv.(*_GenesisState_14_list)
+		x.CronRuns = *clv.list
+	case "initia.move.v1.GenesisState.cron_job_sequence":
+		x.CronJobSequence = value.Uint()
+	case "initia.move.v1.GenesisState.publish_namespaces":
+		lv := value.List()
+		clv := lv.(*_GenesisState_16_list)
+		x.PublishNamespaces = *clv.list
+	case "initia.move.v1.GenesisState.publish_usages":
+		lv := value.List()
+		clv := lv.(*_GenesisState_17_list)
+		x.PublishUsages = *clv.list
+	case "initia.move.v1.GenesisState.publish_deposits":
+		lv := value.List()
+		clv := lv.(*_GenesisState_18_list)
+		x.PublishDeposits = *clv.list
+	case "initia.move.v1.GenesisState.authenticators":
+		lv := value.List()
+		clv := lv.(*_GenesisState_19_list)
+		x.Authenticators = *clv.list
+	case "initia.move.v1.GenesisState.sponsors":
+		lv := value.List()
+		clv := lv.(*_GenesisState_20_list)
+		x.Sponsors = *clv.list
+	case "initia.move.v1.GenesisState.voting_power_weight_observations":
+		lv := value.List()
+		clv := lv.(*_GenesisState_21_list)
+		x.VotingPowerWeightObservations = *clv.list
+	case "initia.move.v1.GenesisState.voting_power_weights":
+		lv := value.List()
+		clv := lv.(*_GenesisState_22_list)
+		x.VotingPowerWeights = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.GenesisState.params":
+		if x.Params == nil {
+			x.Params = new(Params)
+		}
+		return protoreflect.ValueOfMessage(x.Params.ProtoReflect())
+	case "initia.move.v1.GenesisState.stdlibs":
+		if x.Stdlibs == nil {
+			x.Stdlibs = [][]byte{}
+		}
+		value := &_GenesisState_3_list{list: &x.Stdlibs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.modules":
+		if x.Modules == nil {
+			x.Modules = []*Module{}
+		}
+		value := &_GenesisState_4_list{list: &x.Modules}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.resources":
+		if x.Resources == nil {
+			x.Resources = []*Resource{}
+		}
+		value := &_GenesisState_5_list{list: &x.Resources}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.table_infos":
+		if x.TableInfos == nil {
+			x.TableInfos = []*TableInfo{}
+		}
+		value := &_GenesisState_6_list{list: &x.TableInfos}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.table_entries":
+		if x.TableEntries == nil {
+			x.TableEntries = []*TableEntry{}
+		}
+		value := &_GenesisState_7_list{list: &x.TableEntries}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.dex_pairs":
+		if x.DexPairs == nil {
+			x.DexPairs = []*DexPair{}
+		}
+		value := &_GenesisState_8_list{list: &x.DexPairs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.revenue_recipients":
+		if x.RevenueRecipients == nil {
+			x.RevenueRecipients = []*ModuleRevenueRecipient{}
+		}
+		value := &_GenesisState_9_list{list: &x.RevenueRecipients}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.revenue_ratios":
+		if x.RevenueRatios == nil {
+			x.RevenueRatios = []*ModuleRevenueRatio{}
+		}
+		value := &_GenesisState_10_list{list: &x.RevenueRatios}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.module_revenues":
+		if x.ModuleRevenues == nil {
+			x.ModuleRevenues = []*ModuleRevenue{}
+		}
+		value := &_GenesisState_11_list{list: &x.ModuleRevenues}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.claimable_revenues":
+		if x.ClaimableRevenues == nil {
+			x.ClaimableRevenues = []*ClaimableRevenue{}
+		}
+		value := &_GenesisState_12_list{list: &x.ClaimableRevenues}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.cron_jobs":
+		if x.CronJobs == nil {
+			x.CronJobs = []*CronJob{}
+		}
+		value := &_GenesisState_13_list{list: &x.CronJobs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.cron_runs":
+		if x.CronRuns == nil {
+			x.CronRuns = []*CronRun{}
+		}
+		value := &_GenesisState_14_list{list: &x.CronRuns}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.publish_namespaces":
+		if x.PublishNamespaces == nil {
+			x.PublishNamespaces = []*PublishNamespace{}
+		}
+		value := &_GenesisState_16_list{list: &x.PublishNamespaces}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.publish_usages":
+		if x.PublishUsages == nil {
+			x.PublishUsages = []*PublishUsage{}
+		}
+		value := &_GenesisState_17_list{list: &x.PublishUsages}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.publish_deposits":
+		if x.PublishDeposits == nil {
+			x.PublishDeposits = []*PublishDeposit{}
+		}
+		value := &_GenesisState_18_list{list: &x.PublishDeposits}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.authenticators":
+		if x.Authenticators == nil {
+			x.Authenticators = []*Authenticator{}
+		}
+		value := &_GenesisState_19_list{list: &x.Authenticators}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.sponsors":
+		if x.Sponsors == nil {
+			x.Sponsors = []*Sponsor{}
+		}
+		value := &_GenesisState_20_list{list: &x.Sponsors}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.voting_power_weight_observations":
+		if x.VotingPowerWeightObservations == nil {
+			x.VotingPowerWeightObservations = []*VotingPowerWeightObservation{}
+		}
+		value := &_GenesisState_21_list{list: &x.VotingPowerWeightObservations}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.voting_power_weights":
+		if x.VotingPowerWeights == nil {
+			x.VotingPowerWeights = []*v1beta1.DecCoin{}
+		}
+		value := &_GenesisState_22_list{list: &x.VotingPowerWeights}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.GenesisState.execution_counter":
+		panic(fmt.Errorf("field execution_counter of message initia.move.v1.GenesisState is not mutable"))
+	case "initia.move.v1.GenesisState.cron_job_sequence":
+		panic(fmt.Errorf("field cron_job_sequence of message initia.move.v1.GenesisState is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_GenesisState) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.GenesisState.params":
+		m := new(Params)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.move.v1.GenesisState.execution_counter":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.GenesisState.stdlibs":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_GenesisState_3_list{list: &list})
+	case "initia.move.v1.GenesisState.modules":
+		list := []*Module{}
+		return protoreflect.ValueOfList(&_GenesisState_4_list{list: &list})
+	case "initia.move.v1.GenesisState.resources":
+		list := []*Resource{}
+		return protoreflect.ValueOfList(&_GenesisState_5_list{list: &list})
+	case "initia.move.v1.GenesisState.table_infos":
+		list := []*TableInfo{}
+		return protoreflect.ValueOfList(&_GenesisState_6_list{list: &list})
+	case "initia.move.v1.GenesisState.table_entries":
+		list := []*TableEntry{}
+		return protoreflect.ValueOfList(&_GenesisState_7_list{list: &list})
+	case "initia.move.v1.GenesisState.dex_pairs":
+		list := []*DexPair{}
+		return protoreflect.ValueOfList(&_GenesisState_8_list{list: &list})
+	case "initia.move.v1.GenesisState.revenue_recipients":
+		list := []*ModuleRevenueRecipient{}
+		return protoreflect.ValueOfList(&_GenesisState_9_list{list: &list})
+	case "initia.move.v1.GenesisState.revenue_ratios":
+		list := []*ModuleRevenueRatio{}
+		return protoreflect.ValueOfList(&_GenesisState_10_list{list: &list})
+	case "initia.move.v1.GenesisState.module_revenues":
+		list := []*ModuleRevenue{}
+		return protoreflect.ValueOfList(&_GenesisState_11_list{list: &list})
+	case "initia.move.v1.GenesisState.claimable_revenues":
+		list := []*ClaimableRevenue{}
+		return protoreflect.ValueOfList(&_GenesisState_12_list{list: &list})
+	case "initia.move.v1.GenesisState.cron_jobs":
+		list := []*CronJob{}
+		return protoreflect.ValueOfList(&_GenesisState_13_list{list: &list})
+	case "initia.move.v1.GenesisState.cron_runs":
+		list := []*CronRun{}
+		return protoreflect.ValueOfList(&_GenesisState_14_list{list: &list})
+	case "initia.move.v1.GenesisState.cron_job_sequence":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.GenesisState.publish_namespaces":
+		list := []*PublishNamespace{}
+		return protoreflect.ValueOfList(&_GenesisState_16_list{list: &list})
+	case "initia.move.v1.GenesisState.publish_usages":
+		list := []*PublishUsage{}
+		return protoreflect.ValueOfList(&_GenesisState_17_list{list: &list})
+	case "initia.move.v1.GenesisState.publish_deposits":
+		list := []*PublishDeposit{}
+		return protoreflect.ValueOfList(&_GenesisState_18_list{list: &list})
+	case "initia.move.v1.GenesisState.authenticators":
+		list := []*Authenticator{}
+		return protoreflect.ValueOfList(&_GenesisState_19_list{list: &list})
+	case "initia.move.v1.GenesisState.sponsors":
+		list := []*Sponsor{}
+		return protoreflect.ValueOfList(&_GenesisState_20_list{list: &list})
+	case "initia.move.v1.GenesisState.voting_power_weight_observations":
+		list := []*VotingPowerWeightObservation{}
+		return protoreflect.ValueOfList(&_GenesisState_21_list{list: &list})
+	case "initia.move.v1.GenesisState.voting_power_weights":
+		list := []*v1beta1.DecCoin{}
+		return protoreflect.ValueOfList(&_GenesisState_22_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.GenesisState"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.GenesisState does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_GenesisState) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.GenesisState", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_GenesisState) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_GenesisState) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_GenesisState) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
 // "google.golang.org/protobuf/runtime/protoiface".Methods.
 // Consult the protoiface package documentation for details.
 func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
@@ -785,243 +1873,978 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				Size:              0,
 			}
 		}
-		options := runtime.SizeInputToOptions(input)
-		_ = options
-		var n int
-		var l int
-		_ = l
-		if x.Params != nil {
-			l = options.Size(x.Params)
-			n += 1 + l + runtime.Sov(uint64(l))
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Params != nil {
+			l = options.Size(x.Params)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.ExecutionCounter != 0 {
+			n += 1 + runtime.Sov(uint64(x.ExecutionCounter))
+		}
+		if len(x.Stdlibs) > 0 {
+			for _, b := range x.Stdlibs {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Modules) > 0 {
+			for _, e := range x.Modules {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Resources) > 0 {
+			for _, e := range x.Resources {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TableInfos) > 0 {
+			for _, e := range x.TableInfos {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.TableEntries) > 0 {
+			for _, e := range x.TableEntries {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.DexPairs) > 0 {
+			for _, e := range x.DexPairs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RevenueRecipients) > 0 {
+			for _, e := range x.RevenueRecipients {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RevenueRatios) > 0 {
+			for _, e := range x.RevenueRatios {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ModuleRevenues) > 0 {
+			for _, e := range x.ModuleRevenues {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ClaimableRevenues) > 0 {
+			for _, e := range x.ClaimableRevenues {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CronJobs) > 0 {
+			for _, e := range x.CronJobs {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.CronRuns) > 0 {
+			for _, e := range x.CronRuns {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.CronJobSequence != 0 {
+			n += 1 + runtime.Sov(uint64(x.CronJobSequence))
+		}
+		if len(x.PublishNamespaces) > 0 {
+			for _, e := range x.PublishNamespaces {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PublishUsages) > 0 {
+			for _, e := range x.PublishUsages {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.PublishDeposits) > 0 {
+			for _, e := range x.PublishDeposits {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Authenticators) > 0 {
+			for _, e := range x.Authenticators {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Sponsors) > 0 {
+			for _, e := range x.Sponsors {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VotingPowerWeightObservations) > 0 {
+			for _, e := range x.VotingPowerWeightObservations {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.VotingPowerWeights) > 0 {
+			for _, e := range x.VotingPowerWeights {
+				l = options.Size(e)
+				n += 2 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*GenesisState)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.VotingPowerWeights) > 0 {
+			for iNdEx := len(x.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerWeights[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xb2
+			}
+		}
+		if len(x.VotingPowerWeightObservations) > 0 {
+			for iNdEx := len(x.VotingPowerWeightObservations) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.VotingPowerWeightObservations[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xaa
+			}
+		}
+		if len(x.Sponsors) > 0 {
+			for iNdEx := len(x.Sponsors) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Sponsors[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0xa2
+			}
 		}
-		if x.ExecutionCounter != 0 {
-			n += 1 + runtime.Sov(uint64(x.ExecutionCounter))
+		if len(x.Authenticators) > 0 {
+			for iNdEx := len(x.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Authenticators[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x9a
+			}
 		}
-		if len(x.Stdlibs) > 0 {
-			for _, b := range x.Stdlibs {
-				l = len(b)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.PublishDeposits) > 0 {
+			for iNdEx := len(x.PublishDeposits) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PublishDeposits[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x92
 			}
 		}
-		if len(x.Modules) > 0 {
-			for _, e := range x.Modules {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.PublishUsages) > 0 {
+			for iNdEx := len(x.PublishUsages) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PublishUsages[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x8a
 			}
 		}
-		if len(x.Resources) > 0 {
-			for _, e := range x.Resources {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if len(x.PublishNamespaces) > 0 {
+			for iNdEx := len(x.PublishNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.PublishNamespaces[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1
+				i--
+				dAtA[i] = 0x82
 			}
 		}
-		if len(x.TableInfos) > 0 {
-			for _, e := range x.TableInfos {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if x.CronJobSequence != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CronJobSequence))
+			i--
+			dAtA[i] = 0x78
+		}
+		if len(x.CronRuns) > 0 {
+			for iNdEx := len(x.CronRuns) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CronRuns[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x72
+			}
+		}
+		if len(x.CronJobs) > 0 {
+			for iNdEx := len(x.CronJobs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.CronJobs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x6a
+			}
+		}
+		if len(x.ClaimableRevenues) > 0 {
+			for iNdEx := len(x.ClaimableRevenues) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ClaimableRevenues[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x62
+			}
+		}
+		if len(x.ModuleRevenues) > 0 {
+			for iNdEx := len(x.ModuleRevenues) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ModuleRevenues[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.RevenueRatios) > 0 {
+			for iNdEx := len(x.RevenueRatios) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RevenueRatios[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.RevenueRecipients) > 0 {
+			for iNdEx := len(x.RevenueRecipients) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.RevenueRecipients[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.DexPairs) > 0 {
+			for iNdEx := len(x.DexPairs) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.DexPairs[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x42
 			}
 		}
 		if len(x.TableEntries) > 0 {
-			for _, e := range x.TableEntries {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+			for iNdEx := len(x.TableEntries) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TableEntries[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TableInfos) > 0 {
+			for iNdEx := len(x.TableInfos) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TableInfos[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.Resources) > 0 {
+			for iNdEx := len(x.Resources) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Resources[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.Modules) > 0 {
+			for iNdEx := len(x.Modules) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Modules[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.Stdlibs) > 0 {
+			for iNdEx := len(x.Stdlibs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Stdlibs[iNdEx])
+				copy(dAtA[i:], x.Stdlibs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stdlibs[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
 			}
 		}
-		if len(x.DexPairs) > 0 {
-			for _, e := range x.DexPairs {
-				l = options.Size(e)
-				n += 1 + l + runtime.Sov(uint64(l))
+		if x.ExecutionCounter != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionCounter))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.Params != nil {
+			encoded, err := options.Marshal(x.Params)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
 			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0xa
 		}
-		if x.unknownFields != nil {
-			n += len(x.unknownFields)
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
 		}
-		return protoiface.SizeOutput{
+		return protoiface.MarshalOutput{
 			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Size:              n,
-		}
+			Buf:               input.Buf,
+		}, nil
 	}
-
-	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
 		x := input.Message.Interface().(*GenesisState)
 		if x == nil {
-			return protoiface.MarshalOutput{
+			return protoiface.UnmarshalOutput{
 				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Buf:               input.Buf,
+				Flags:             input.Flags,
 			}, nil
 		}
-		options := runtime.MarshalInputToOptions(input)
+		options := runtime.UnmarshalInputToOptions(input)
 		_ = options
-		size := options.Size(x)
-		dAtA := make([]byte, size)
-		i := len(dAtA)
-		_ = i
-		var l int
-		_ = l
-		if x.unknownFields != nil {
-			i -= len(x.unknownFields)
-			copy(dAtA[i:], x.unknownFields)
-		}
-		if len(x.DexPairs) > 0 {
-			for iNdEx := len(x.DexPairs) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.DexPairs[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Params == nil {
+					x.Params = &Params{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionCounter", wireType)
+				}
+				x.ExecutionCounter = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.ExecutionCounter |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stdlibs", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Stdlibs = append(x.Stdlibs, make([]byte, postIndex-iNdEx))
+				copy(x.Stdlibs[len(x.Stdlibs)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Modules = append(x.Modules, &Module{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modules[len(x.Modules)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Resources = append(x.Resources, &Resource{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Resources[len(x.Resources)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TableInfos", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x42
-			}
-		}
-		if len(x.TableEntries) > 0 {
-			for iNdEx := len(x.TableEntries) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TableEntries[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x3a
-			}
-		}
-		if len(x.TableInfos) > 0 {
-			for iNdEx := len(x.TableInfos) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.TableInfos[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x32
-			}
-		}
-		if len(x.Resources) > 0 {
-			for iNdEx := len(x.Resources) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Resources[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TableInfos = append(x.TableInfos, &TableInfo{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TableInfos[len(x.TableInfos)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TableEntries", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TableEntries = append(x.TableEntries, &TableEntry{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TableEntries[len(x.TableEntries)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexPairs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.DexPairs = append(x.DexPairs, &DexPair{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DexPairs[len(x.DexPairs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevenueRecipients", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevenueRecipients = append(x.RevenueRecipients, &ModuleRevenueRecipient{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevenueRecipients[len(x.RevenueRecipients)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RevenueRatios", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RevenueRatios = append(x.RevenueRatios, &ModuleRevenueRatio{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.RevenueRatios[len(x.RevenueRatios)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleRevenues", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleRevenues = append(x.ModuleRevenues, &ModuleRevenue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ModuleRevenues[len(x.ModuleRevenues)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 12:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ClaimableRevenues", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ClaimableRevenues = append(x.ClaimableRevenues, &ClaimableRevenue{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ClaimableRevenues[len(x.ClaimableRevenues)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronJobs", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x2a
-			}
-		}
-		if len(x.Modules) > 0 {
-			for iNdEx := len(x.Modules) - 1; iNdEx >= 0; iNdEx-- {
-				encoded, err := options.Marshal(x.Modules[iNdEx])
-				if err != nil {
-					return protoiface.MarshalOutput{
-						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-						Buf:               input.Buf,
-					}, err
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				i -= len(encoded)
-				copy(dAtA[i:], encoded)
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-				i--
-				dAtA[i] = 0x22
-			}
-		}
-		if len(x.Stdlibs) > 0 {
-			for iNdEx := len(x.Stdlibs) - 1; iNdEx >= 0; iNdEx-- {
-				i -= len(x.Stdlibs[iNdEx])
-				copy(dAtA[i:], x.Stdlibs[iNdEx])
-				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Stdlibs[iNdEx])))
-				i--
-				dAtA[i] = 0x1a
-			}
-		}
-		if x.ExecutionCounter != 0 {
-			i = runtime.EncodeVarint(dAtA, i, uint64(x.ExecutionCounter))
-			i--
-			dAtA[i] = 0x10
-		}
-		if x.Params != nil {
-			encoded, err := options.Marshal(x.Params)
-			if err != nil {
-				return protoiface.MarshalOutput{
-					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-					Buf:               input.Buf,
-				}, err
-			}
-			i -= len(encoded)
-			copy(dAtA[i:], encoded)
-			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
-			i--
-			dAtA[i] = 0xa
-		}
-		if input.Buf != nil {
-			input.Buf = append(input.Buf, dAtA...)
-		} else {
-			input.Buf = dAtA
-		}
-		return protoiface.MarshalOutput{
-			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-			Buf:               input.Buf,
-		}, nil
-	}
-	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
-		x := input.Message.Interface().(*GenesisState)
-		if x == nil {
-			return protoiface.UnmarshalOutput{
-				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
-				Flags:             input.Flags,
-			}, nil
-		}
-		options := runtime.UnmarshalInputToOptions(input)
-		_ = options
-		dAtA := input.Buf
-		l := len(dAtA)
-		iNdEx := 0
-		for iNdEx < l {
-			preIndex := iNdEx
-			var wire uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				wire |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				x.CronJobs = append(x.CronJobs, &CronJob{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CronJobs[len(x.CronJobs)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
-			}
-			fieldNum := int32(wire >> 3)
-			wireType := int(wire & 0x7)
-			if wireType == 4 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
-			}
-			if fieldNum <= 0 {
-				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
-			}
-			switch fieldNum {
-			case 1:
+				iNdEx = postIndex
+			case 14:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronRuns", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1048,18 +2871,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				if x.Params == nil {
-					x.Params = &Params{}
-				}
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Params); err != nil {
+				x.CronRuns = append(x.CronRuns, &CronRun{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.CronRuns[len(x.CronRuns)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 2:
+			case 15:
 				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ExecutionCounter", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronJobSequence", wireType)
 				}
-				x.ExecutionCounter = 0
+				x.CronJobSequence = 0
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1069,16 +2890,16 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					x.ExecutionCounter |= uint64(b&0x7F) << shift
+					x.CronJobSequence |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-			case 3:
+			case 16:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Stdlibs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishNamespaces", wireType)
 				}
-				var byteLen int
+				var msglen int
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -1088,27 +2909,29 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					byteLen |= int(b&0x7F) << shift
+					msglen |= int(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				if byteLen < 0 {
+				if msglen < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
-				postIndex := iNdEx + byteLen
+				postIndex := iNdEx + msglen
 				if postIndex < 0 {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
 				}
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Stdlibs = append(x.Stdlibs, make([]byte, postIndex-iNdEx))
-				copy(x.Stdlibs[len(x.Stdlibs)-1], dAtA[iNdEx:postIndex])
+				x.PublishNamespaces = append(x.PublishNamespaces, &PublishNamespace{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PublishNamespaces[len(x.PublishNamespaces)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
 				iNdEx = postIndex
-			case 4:
+			case 17:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Modules", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishUsages", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1135,14 +2958,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Modules = append(x.Modules, &Module{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Modules[len(x.Modules)-1]); err != nil {
+				x.PublishUsages = append(x.PublishUsages, &PublishUsage{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PublishUsages[len(x.PublishUsages)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 5:
+			case 18:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Resources", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishDeposits", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1169,14 +2992,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.Resources = append(x.Resources, &Resource{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Resources[len(x.Resources)-1]); err != nil {
+				x.PublishDeposits = append(x.PublishDeposits, &PublishDeposit{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.PublishDeposits[len(x.PublishDeposits)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 6:
+			case 19:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TableInfos", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1203,14 +3026,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TableInfos = append(x.TableInfos, &TableInfo{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TableInfos[len(x.TableInfos)-1]); err != nil {
+				x.Authenticators = append(x.Authenticators, &Authenticator{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Authenticators[len(x.Authenticators)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 7:
+			case 20:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TableEntries", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Sponsors", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1237,14 +3060,14 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.TableEntries = append(x.TableEntries, &TableEntry{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TableEntries[len(x.TableEntries)-1]); err != nil {
+				x.Sponsors = append(x.Sponsors, &Sponsor{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Sponsors[len(x.Sponsors)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
-			case 8:
+			case 21:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field DexPairs", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeightObservations", wireType)
 				}
 				var msglen int
 				for shift := uint(0); ; shift += 7 {
@@ -1271,8 +3094,42 @@ func (x *fastReflection_GenesisState) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.DexPairs = append(x.DexPairs, &DexPair{})
-				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.DexPairs[len(x.DexPairs)-1]); err != nil {
+				x.VotingPowerWeightObservations = append(x.VotingPowerWeightObservations, &VotingPowerWeightObservation{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerWeightObservations[len(x.VotingPowerWeightObservations)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 22:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.VotingPowerWeights = append(x.VotingPowerWeights, &v1beta1.DecCoin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.VotingPowerWeights[len(x.VotingPowerWeights)-1]); err != nil {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
 				}
 				iNdEx = postIndex
@@ -1330,14 +3187,28 @@ type GenesisState struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Params           *Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
-	ExecutionCounter uint64        `protobuf:"varint,2,opt,name=execution_counter,json=executionCounter,proto3" json:"execution_counter,omitempty"`
-	Stdlibs          [][]byte      `protobuf:"bytes,3,rep,name=stdlibs,proto3" json:"stdlibs,omitempty"`
-	Modules          []*Module     `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
-	Resources        []*Resource   `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
-	TableInfos       []*TableInfo  `protobuf:"bytes,6,rep,name=table_infos,json=tableInfos,proto3" json:"table_infos,omitempty"`
-	TableEntries     []*TableEntry `protobuf:"bytes,7,rep,name=table_entries,json=tableEntries,proto3" json:"table_entries,omitempty"`
-	DexPairs         []*DexPair    `protobuf:"bytes,8,rep,name=dex_pairs,json=dexPairs,proto3" json:"dex_pairs,omitempty"`
+	Params                        *Params                         `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+	ExecutionCounter              uint64                          `protobuf:"varint,2,opt,name=execution_counter,json=executionCounter,proto3" json:"execution_counter,omitempty"`
+	Stdlibs                       [][]byte                        `protobuf:"bytes,3,rep,name=stdlibs,proto3" json:"stdlibs,omitempty"`
+	Modules                       []*Module                       `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
+	Resources                     []*Resource                     `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	TableInfos                    []*TableInfo                    `protobuf:"bytes,6,rep,name=table_infos,json=tableInfos,proto3" json:"table_infos,omitempty"`
+	TableEntries                  []*TableEntry                   `protobuf:"bytes,7,rep,name=table_entries,json=tableEntries,proto3" json:"table_entries,omitempty"`
+	DexPairs                      []*DexPair                      `protobuf:"bytes,8,rep,name=dex_pairs,json=dexPairs,proto3" json:"dex_pairs,omitempty"`
+	RevenueRecipients             []*ModuleRevenueRecipient       `protobuf:"bytes,9,rep,name=revenue_recipients,json=revenueRecipients,proto3" json:"revenue_recipients,omitempty"`
+	RevenueRatios                 []*ModuleRevenueRatio           `protobuf:"bytes,10,rep,name=revenue_ratios,json=revenueRatios,proto3" json:"revenue_ratios,omitempty"`
+	ModuleRevenues                []*ModuleRevenue                `protobuf:"bytes,11,rep,name=module_revenues,json=moduleRevenues,proto3" json:"module_revenues,omitempty"`
+	ClaimableRevenues             []*ClaimableRevenue             `protobuf:"bytes,12,rep,name=claimable_revenues,json=claimableRevenues,proto3" json:"claimable_revenues,omitempty"`
+	CronJobs                      []*CronJob                      `protobuf:"bytes,13,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs,omitempty"`
+	CronRuns                      []*CronRun                      `protobuf:"bytes,14,rep,name=cron_runs,json=cronRuns,proto3" json:"cron_runs,omitempty"`
+	CronJobSequence               uint64                          `protobuf:"varint,15,opt,name=cron_job_sequence,json=cronJobSequence,proto3" json:"cron_job_sequence,omitempty"`
+	PublishNamespaces             []*PublishNamespace             `protobuf:"bytes,16,rep,name=publish_namespaces,json=publishNamespaces,proto3" json:"publish_namespaces,omitempty"`
+	PublishUsages                 []*PublishUsage                 `protobuf:"bytes,17,rep,name=publish_usages,json=publishUsages,proto3" json:"publish_usages,omitempty"`
+	PublishDeposits               []*PublishDeposit               `protobuf:"bytes,18,rep,name=publish_deposits,json=publishDeposits,proto3" json:"publish_deposits,omitempty"`
+	Authenticators                []*Authenticator                `protobuf:"bytes,19,rep,name=authenticators,proto3" json:"authenticators,omitempty"`
+	Sponsors                      []*Sponsor                      `protobuf:"bytes,20,rep,name=sponsors,proto3" json:"sponsors,omitempty"`
+	VotingPowerWeightObservations []*VotingPowerWeightObservation `protobuf:"bytes,21,rep,name=voting_power_weight_observations,json=votingPowerWeightObservations,proto3" json:"voting_power_weight_observations,omitempty"`
+	VotingPowerWeights            []*v1beta1.DecCoin              `protobuf:"bytes,22,rep,name=voting_power_weights,json=votingPowerWeights,proto3" json:"voting_power_weights,omitempty"`
 }
 
 func (x *GenesisState) Reset() {
@@ -1416,6 +3287,104 @@ func (x *GenesisState) GetDexPairs() []*DexPair {
 	return nil
 }
 
+func (x *GenesisState) GetRevenueRecipients() []*ModuleRevenueRecipient {
+	if x != nil {
+		return x.RevenueRecipients
+	}
+	return nil
+}
+
+func (x *GenesisState) GetRevenueRatios() []*ModuleRevenueRatio {
+	if x != nil {
+		return x.RevenueRatios
+	}
+	return nil
+}
+
+func (x *GenesisState) GetModuleRevenues() []*ModuleRevenue {
+	if x != nil {
+		return x.ModuleRevenues
+	}
+	return nil
+}
+
+func (x *GenesisState) GetClaimableRevenues() []*ClaimableRevenue {
+	if x != nil {
+		return x.ClaimableRevenues
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCronJobs() []*CronJob {
+	if x != nil {
+		return x.CronJobs
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCronRuns() []*CronRun {
+	if x != nil {
+		return x.CronRuns
+	}
+	return nil
+}
+
+func (x *GenesisState) GetCronJobSequence() uint64 {
+	if x != nil {
+		return x.CronJobSequence
+	}
+	return 0
+}
+
+func (x *GenesisState) GetPublishNamespaces() []*PublishNamespace {
+	if x != nil {
+		return x.PublishNamespaces
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPublishUsages() []*PublishUsage {
+	if x != nil {
+		return x.PublishUsages
+	}
+	return nil
+}
+
+func (x *GenesisState) GetPublishDeposits() []*PublishDeposit {
+	if x != nil {
+		return x.PublishDeposits
+	}
+	return nil
+}
+
+func (x *GenesisState) GetAuthenticators() []*Authenticator {
+	if x != nil {
+		return x.Authenticators
+	}
+	return nil
+}
+
+func (x *GenesisState) GetSponsors() []*Sponsor {
+	if x != nil {
+		return x.Sponsors
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVotingPowerWeightObservations() []*VotingPowerWeightObservation {
+	if x != nil {
+		return x.VotingPowerWeightObservations
+	}
+	return nil
+}
+
+func (x *GenesisState) GetVotingPowerWeights() []*v1beta1.DecCoin {
+	if x != nil {
+		return x.VotingPowerWeights
+	}
+	return nil
+}
+
 var File_initia_move_v1_genesis_proto protoreflect.FileDescriptor
 
 var file_initia_move_v1_genesis_proto_rawDesc = []byte{
@@ -1423,10 +3392,12 @@ var file_initia_move_v1_genesis_proto_rawDesc = []byte{
 	0x2f, 0x67, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e,
 	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x11,
 	0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76,
+	0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74,
 	0x6f, 0x1a, 0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67,
 	0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1a, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f,
 	0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x22, 0x90, 0x05, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
+	0x6f, 0x74, 0x6f, 0x22, 0xe9, 0x11, 0x0a, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x53,
 	0x74, 0x61, 0x74, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
 	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x42, 0x09, 0xc8, 0xde,
@@ -1467,19 +3438,120 @@ var file_initia_move_v1_genesis_proto_rawDesc = []byte{
 	0x76, 0x31, 0x2e, 0x44, 0x65, 0x78, 0x50, 0x61, 0x69, 0x72, 0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00,
 	0xea, 0xde, 0x1f, 0x13, 0x64, 0x65, 0x78, 0x5f, 0x70, 0x61, 0x69, 0x72, 0x73, 0x2c, 0x6f, 0x6d,
 	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x64, 0x65,
-	0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x42, 0xb5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47,
-	0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67,
-	0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69,
-	0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b,
-	0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e,
-	0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02,
-	0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0x5c,
-	0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x78, 0x50, 0x61, 0x69, 0x72, 0x73, 0x12, 0x80, 0x01, 0x0a, 0x12, 0x72, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x5f, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x09, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x26, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x42, 0x29, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x1c, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72, 0x65, 0x63,
+	0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74,
+	0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x52,
+	0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x70, 0x0a, 0x0e, 0x72, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x52, 0x61, 0x74, 0x69, 0x6f, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18, 0x72,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x72, 0x65,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x73, 0x12, 0x6e, 0x0a, 0x0f, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x18, 0x0b,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x42, 0x26, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x19, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x7a, 0x0a, 0x12, 0x63,
+	0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x73, 0x18, 0x0c, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62,
+	0x6c, 0x65, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x42, 0x29, 0xc8, 0xde, 0x1f, 0x00, 0xea,
+	0xde, 0x1f, 0x1c, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x72, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8,
+	0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x63, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x73, 0x12, 0x56, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e, 0x5f,
+	0x6a, 0x6f, 0x62, 0x73, 0x18, 0x0d, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x6e,
+	0x4a, 0x6f, 0x62, 0x42, 0x20, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x13, 0x63, 0x72, 0x6f,
+	0x6e, 0x5f, 0x6a, 0x6f, 0x62, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79,
+	0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x63, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x73, 0x12,
+	0x56, 0x0a, 0x09, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x18, 0x0e, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x42, 0x20, 0xc8, 0xde, 0x1f,
+	0x00, 0xea, 0xde, 0x1f, 0x13, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x72, 0x75, 0x6e, 0x73, 0x2c, 0x6f,
+	0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x63,
+	0x72, 0x6f, 0x6e, 0x52, 0x75, 0x6e, 0x73, 0x12, 0x48, 0x0a, 0x11, 0x63, 0x72, 0x6f, 0x6e, 0x5f,
+	0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x18, 0x0f, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72,
+	0x6f, 0x6e, 0x5f, 0x6a, 0x6f, 0x62, 0x5f, 0x73, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63, 0x65, 0x22,
+	0x52, 0x0f, 0x63, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x53, 0x65, 0x71, 0x75, 0x65, 0x6e, 0x63,
+	0x65, 0x12, 0x7a, 0x0a, 0x12, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x18, 0x10, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x42,
+	0x29, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x73, 0x12, 0x6a, 0x0a,
+	0x0e, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18,
+	0x11, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x55, 0x73,
+	0x61, 0x67, 0x65, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x75, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74,
+	0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0d, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x72, 0x0a, 0x10, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x18, 0x12, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x42, 0x27, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1a, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0f, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x73, 0x12, 0x6c, 0x0a,
+	0x0e, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x18,
+	0x13, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63,
+	0x61, 0x74, 0x6f, 0x72, 0x42, 0x25, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x18, 0x61, 0x75,
+	0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x61, 0x75, 0x74,
+	0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x54, 0x0a, 0x08, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x18, 0x14, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x17, 0x2e,
+	0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x42, 0x1f, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x12,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70,
+	0x74, 0x79, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x08, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72,
+	0x73, 0x12, 0xae, 0x01, 0x0a, 0x20, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77,
+	0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x15, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x56, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f,
+	0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x37, 0xc8, 0xde, 0x1f, 0x00,
+	0xea, 0xde, 0x1f, 0x2a, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x6f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x1d, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x12, 0xaa, 0x01, 0x0a, 0x14, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x16, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x44, 0x65, 0x63, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x5a, 0xc8, 0xde, 0x1f, 0x00, 0xea, 0xde, 0x1f, 0x1e, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x2c, 0x6f, 0x6d,
+	0x69, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x79, 0xaa, 0xdf, 0x1f, 0x2b, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73,
+	0x6d, 0x6f, 0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x44, 0x65,
+	0x63, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x12, 0x76, 0x6f, 0x74,
+	0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x73, 0x42,
+	0xb5, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0c, 0x47, 0x65, 0x6e, 0x65, 0x73, 0x69, 0x73, 0x50,
+	0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+	0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62, 0x73, 0x2f, 0x69,
+	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76, 0x65, 0x76, 0x31, 0xa2,
+	0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x4d,
+	0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c,
+	0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x3a, 0x3a, 0x4d,
+	0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1496,26 +3568,52 @@ func file_initia_move_v1_genesis_proto_rawDescGZIP() []byte {
 
 var file_initia_move_v1_genesis_proto_msgTypes = make([]protoimpl.MessageInfo, 1)
 var file_initia_move_v1_genesis_proto_goTypes = []interface{}{
-	(*GenesisState)(nil), // 0: initia.move.v1.GenesisState
-	(*Params)(nil),       // 1: initia.move.v1.Params
-	(*Module)(nil),       // 2: initia.move.v1.Module
-	(*Resource)(nil),     // 3: initia.move.v1.Resource
-	(*TableInfo)(nil),    // 4: initia.move.v1.TableInfo
-	(*TableEntry)(nil),   // 5: initia.move.v1.TableEntry
-	(*DexPair)(nil),      // 6: initia.move.v1.DexPair
+	(*GenesisState)(nil),                 // 0: initia.move.v1.GenesisState
+	(*Params)(nil),                       // 1: initia.move.v1.Params
+	(*Module)(nil),                       // 2: initia.move.v1.Module
+	(*Resource)(nil),                     // 3: initia.move.v1.Resource
+	(*TableInfo)(nil),                    // 4: initia.move.v1.TableInfo
+	(*TableEntry)(nil),                   // 5: initia.move.v1.TableEntry
+	(*DexPair)(nil),                      // 6: initia.move.v1.DexPair
+	(*ModuleRevenueRecipient)(nil),       // 7: initia.move.v1.ModuleRevenueRecipient
+	(*ModuleRevenueRatio)(nil),           // 8: initia.move.v1.ModuleRevenueRatio
+	(*ModuleRevenue)(nil),                // 9: initia.move.v1.ModuleRevenue
+	(*ClaimableRevenue)(nil),             // 10: initia.move.v1.ClaimableRevenue
+	(*CronJob)(nil),                      // 11: initia.move.v1.CronJob
+	(*CronRun)(nil),                      // 12: initia.move.v1.CronRun
+	(*PublishNamespace)(nil),             // 13: initia.move.v1.PublishNamespace
+	(*PublishUsage)(nil),                 // 14: initia.move.v1.PublishUsage
+	(*PublishDeposit)(nil),               // 15: initia.move.v1.PublishDeposit
+	(*Authenticator)(nil),                // 16: initia.move.v1.Authenticator
+	(*Sponsor)(nil),                      // 17: initia.move.v1.Sponsor
+	(*VotingPowerWeightObservation)(nil), // 18: initia.move.v1.VotingPowerWeightObservation
+	(*v1beta1.DecCoin)(nil),              // 19: cosmos.base.v1beta1.DecCoin
 }
 var file_initia_move_v1_genesis_proto_depIdxs = []int32{
-	1, // 0: initia.move.v1.GenesisState.params:type_name -> initia.move.v1.Params
-	2, // 1: initia.move.v1.GenesisState.modules:type_name -> initia.move.v1.Module
-	3, // 2: initia.move.v1.GenesisState.resources:type_name -> initia.move.v1.Resource
-	4, // 3: initia.move.v1.GenesisState.table_infos:type_name -> initia.move.v1.TableInfo
-	5, // 4: initia.move.v1.GenesisState.table_entries:type_name -> initia.move.v1.TableEntry
-	6, // 5: initia.move.v1.GenesisState.dex_pairs:type_name -> initia.move.v1.DexPair
-	6, // [6:6] is the sub-list for method output_type
-	6, // [6:6] is the sub-list for method input_type
-	6, // [6:6] is the sub-list for extension type_name
-	6, // [6:6] is the sub-list for extension extendee
-	0, // [0:6] is the sub-list for field type_name
+	1,  // 0: initia.move.v1.GenesisState.params:type_name -> initia.move.v1.Params
+	2,  // 1: initia.move.v1.GenesisState.modules:type_name -> initia.move.v1.Module
+	3,  // 2: initia.move.v1.GenesisState.resources:type_name -> initia.move.v1.Resource
+	4,  // 3: initia.move.v1.GenesisState.table_infos:type_name -> initia.move.v1.TableInfo
+	5,  // 4: initia.move.v1.GenesisState.table_entries:type_name -> initia.move.v1.TableEntry
+	6,  // 5: initia.move.v1.GenesisState.dex_pairs:type_name -> initia.move.v1.DexPair
+	7,  // 6: initia.move.v1.GenesisState.revenue_recipients:type_name -> initia.move.v1.ModuleRevenueRecipient
+	8,  // 7: initia.move.v1.GenesisState.revenue_ratios:type_name -> initia.move.v1.ModuleRevenueRatio
+	9,  // 8: initia.move.v1.GenesisState.module_revenues:type_name -> initia.move.v1.ModuleRevenue
+	10, // 9: initia.move.v1.GenesisState.claimable_revenues:type_name -> initia.move.v1.ClaimableRevenue
+	11, // 10: initia.move.v1.GenesisState.cron_jobs:type_name -> initia.move.v1.CronJob
+	12, // 11: initia.move.v1.GenesisState.cron_runs:type_name -> initia.move.v1.CronRun
+	13, // 12: initia.move.v1.GenesisState.publish_namespaces:type_name -> initia.move.v1.PublishNamespace
+	14, // 13: initia.move.v1.GenesisState.publish_usages:type_name -> initia.move.v1.PublishUsage
+	15, // 14: initia.move.v1.GenesisState.publish_deposits:type_name -> initia.move.v1.PublishDeposit
+	16, // 15: initia.move.v1.GenesisState.authenticators:type_name -> initia.move.v1.Authenticator
+	17, // 16: initia.move.v1.GenesisState.sponsors:type_name -> initia.move.v1.Sponsor
+	18, // 17: initia.move.v1.GenesisState.voting_power_weight_observations:type_name -> initia.move.v1.VotingPowerWeightObservation
+	19, // 18: initia.move.v1.GenesisState.voting_power_weights:type_name -> cosmos.base.v1beta1.DecCoin
+	19, // [19:19] is the sub-list for method output_type
+	19, // [19:19] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_initia_move_v1_genesis_proto_init() }
@@ -19,22 +19,40 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Query_Module_FullMethodName        = "/initia.move.v1.Query/Module"
-	Query_Modules_FullMethodName       = "/initia.move.v1.Query/Modules"
-	Query_Resource_FullMethodName      = "/initia.move.v1.Query/Resource"
-	Query_Resources_FullMethodName     = "/initia.move.v1.Query/Resources"
-	Query_TableInfo_FullMethodName     = "/initia.move.v1.Query/TableInfo"
-	Query_TableEntry_FullMethodName    = "/initia.move.v1.Query/TableEntry"
-	Query_TableEntries_FullMethodName  = "/initia.move.v1.Query/TableEntries"
-	Query_LegacyView_FullMethodName    = "/initia.move.v1.Query/LegacyView"
-	Query_View_FullMethodName          = "/initia.move.v1.Query/View"
-	Query_ViewBatch_FullMethodName     = "/initia.move.v1.Query/ViewBatch"
-	Query_ViewJSON_FullMethodName      = "/initia.move.v1.Query/ViewJSON"
-	Query_ViewJSONBatch_FullMethodName = "/initia.move.v1.Query/ViewJSONBatch"
-	Query_ScriptABI_FullMethodName     = "/initia.move.v1.Query/ScriptABI"
-	Query_Params_FullMethodName        = "/initia.move.v1.Query/Params"
-	Query_Metadata_FullMethodName      = "/initia.move.v1.Query/Metadata"
-	Query_Denom_FullMethodName         = "/initia.move.v1.Query/Denom"
+	Query_Module_FullMethodName                    = "/initia.move.v1.Query/Module"
+	Query_Modules_FullMethodName                   = "/initia.move.v1.Query/Modules"
+	Query_Resource_FullMethodName                  = "/initia.move.v1.Query/Resource"
+	Query_Resources_FullMethodName                 = "/initia.move.v1.Query/Resources"
+	Query_TableInfo_FullMethodName                 = "/initia.move.v1.Query/TableInfo"
+	Query_TableEntry_FullMethodName                = "/initia.move.v1.Query/TableEntry"
+	Query_TableEntries_FullMethodName              = "/initia.move.v1.Query/TableEntries"
+	Query_TableEntriesByJSON_FullMethodName        = "/initia.move.v1.Query/TableEntriesByJSON"
+	Query_LegacyView_FullMethodName                = "/initia.move.v1.Query/LegacyView"
+	Query_View_FullMethodName                      = "/initia.move.v1.Query/View"
+	Query_ViewBatch_FullMethodName                 = "/initia.move.v1.Query/ViewBatch"
+	Query_ViewJSON_FullMethodName                  = "/initia.move.v1.Query/ViewJSON"
+	Query_ViewJSONBatch_FullMethodName             = "/initia.move.v1.Query/ViewJSONBatch"
+	Query_ScriptABI_FullMethodName                 = "/initia.move.v1.Query/ScriptABI"
+	Query_Profile_FullMethodName                   = "/initia.move.v1.Query/Profile"
+	Query_ModuleRevenue_FullMethodName             = "/initia.move.v1.Query/ModuleRevenue"
+	Query_ClaimableRevenue_FullMethodName          = "/initia.move.v1.Query/ClaimableRevenue"
+	Query_CronJob_FullMethodName                   = "/initia.move.v1.Query/CronJob"
+	Query_CronJobs_FullMethodName                  = "/initia.move.v1.Query/CronJobs"
+	Query_CronRuns_FullMethodName                  = "/initia.move.v1.Query/CronRuns"
+	Query_PublishPolicy_FullMethodName             = "/initia.move.v1.Query/PublishPolicy"
+	Query_PublishDeposit_FullMethodName            = "/initia.move.v1.Query/PublishDeposit"
+	Query_ModuleDependencies_FullMethodName        = "/initia.move.v1.Query/ModuleDependencies"
+	Query_ModuleDependents_FullMethodName          = "/initia.move.v1.Query/ModuleDependents"
+	Query_ModuleFriends_FullMethodName             = "/initia.move.v1.Query/ModuleFriends"
+	Query_FunctionSignatures_FullMethodName        = "/initia.move.v1.Query/FunctionSignatures"
+	Query_CheckUpgradeCompatibility_FullMethodName = "/initia.move.v1.Query/CheckUpgradeCompatibility"
+	Query_ResourceHolders_FullMethodName           = "/initia.move.v1.Query/ResourceHolders"
+	Query_ResourcesByType_FullMethodName           = "/initia.move.v1.Query/ResourcesByType"
+	Query_Authenticator_FullMethodName             = "/initia.move.v1.Query/Authenticator"
+	Query_Sponsor_FullMethodName                   = "/initia.move.v1.Query/Sponsor"
+	Query_Params_FullMethodName                    = "/initia.move.v1.Query/Params"
+	Query_Metadata_FullMethodName                  = "/initia.move.v1.Query/Metadata"
+	Query_Denom_FullMethodName                     = "/initia.move.v1.Query/Denom"
 )
 
 // QueryClient is the client API for Query service.
@@ -55,6 +73,9 @@ type QueryClient interface {
 	TableEntry(ctx context.Context, in *QueryTableEntryRequest, opts ...grpc.CallOption) (*QueryTableEntryResponse, error)
 	// Query table entries with pagination
 	TableEntries(ctx context.Context, in *QueryTableEntriesRequest, opts ...grpc.CallOption) (*QueryTableEntriesResponse, error)
+	// Query table entries with pagination in the key range, where the range
+	// keys are given as json values of the table key type
+	TableEntriesByJSON(ctx context.Context, in *QueryTableEntriesByJSONRequest, opts ...grpc.CallOption) (*QueryTableEntriesByJSONResponse, error)
 	// Deprecated: Do not use.
 	// Deprecated: Use Query/ViewJSON or Query/ViewJSONBatch
 	// LegacyView execute view function and return the view result.
@@ -71,6 +92,54 @@ type QueryClient interface {
 	ViewJSONBatch(ctx context.Context, in *QueryViewJSONBatchRequest, opts ...grpc.CallOption) (*QueryViewJSONBatchResponse, error)
 	// ScriptABI decode script bytes into ABI
 	ScriptABI(ctx context.Context, in *QueryScriptABIRequest, opts ...grpc.CallOption) (*QueryScriptABIResponse, error)
+	// Profile executes an entry function without committing the state changes
+	// and returns the gas usage broken down by module, with an optional trace of
+	// the state accesses made by the vm. The vm reports gas usage per module only,
+	// so no per-function breakdown or instruction-level trace is available. The
+	// query is disabled unless `move.contract-profile-enabled` is set in the app
+	// config.
+	Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error)
+	// ModuleRevenue queries the contract shared revenue information of a module
+	ModuleRevenue(ctx context.Context, in *QueryModuleRevenueRequest, opts ...grpc.CallOption) (*QueryModuleRevenueResponse, error)
+	// ClaimableRevenue queries the contract shared revenue which can be claimed by the recipient
+	ClaimableRevenue(ctx context.Context, in *QueryClaimableRevenueRequest, opts ...grpc.CallOption) (*QueryClaimableRevenueResponse, error)
+	// CronJob queries a cron job
+	CronJob(ctx context.Context, in *QueryCronJobRequest, opts ...grpc.CallOption) (*QueryCronJobResponse, error)
+	// CronJobs queries all cron jobs
+	CronJobs(ctx context.Context, in *QueryCronJobsRequest, opts ...grpc.CallOption) (*QueryCronJobsResponse, error)
+	// CronRuns queries the recent run history of a cron job
+	CronRuns(ctx context.Context, in *QueryCronRunsRequest, opts ...grpc.CallOption) (*QueryCronRunsResponse, error)
+	// PublishPolicy queries the publish policy applied to an address
+	PublishPolicy(ctx context.Context, in *QueryPublishPolicyRequest, opts ...grpc.CallOption) (*QueryPublishPolicyResponse, error)
+	// PublishDeposit queries the publish deposit of a module
+	PublishDeposit(ctx context.Context, in *QueryPublishDepositRequest, opts ...grpc.CallOption) (*QueryPublishDepositResponse, error)
+	// ModuleDependencies queries the modules imported by a module
+	ModuleDependencies(ctx context.Context, in *QueryModuleDependenciesRequest, opts ...grpc.CallOption) (*QueryModuleDependenciesResponse, error)
+	// ModuleDependents queries the published modules which import a module
+	ModuleDependents(ctx context.Context, in *QueryModuleDependentsRequest, opts ...grpc.CallOption) (*QueryModuleDependentsResponse, error)
+	// ModuleFriends queries the friend declarations of the modules of an address
+	ModuleFriends(ctx context.Context, in *QueryModuleFriendsRequest, opts ...grpc.CallOption) (*QueryModuleFriendsResponse, error)
+	// FunctionSignatures queries the entry and view function signatures
+	// of the modules of an address
+	FunctionSignatures(ctx context.Context, in *QueryFunctionSignaturesRequest, opts ...grpc.CallOption) (*QueryFunctionSignaturesResponse, error)
+	// CheckUpgradeCompatibility checks whether a module bundle can be published
+	// without committing the state changes, and reports the changed public
+	// functions and structs and the published dependents which would break.
+	CheckUpgradeCompatibility(ctx context.Context, in *QueryCheckUpgradeCompatibilityRequest, opts ...grpc.CallOption) (*QueryCheckUpgradeCompatibilityResponse, error)
+	// ResourceHolders queries the accounts holding a resource of the struct tag.
+	// The query is served from the node local resource index, so the node
+	// must enable `move.resource-index-enabled`.
+	ResourceHolders(ctx context.Context, in *QueryResourceHoldersRequest, opts ...grpc.CallOption) (*QueryResourceHoldersResponse, error)
+	// ResourcesByType queries the resources of an account whose struct tag
+	// starts with the prefix. The query is served from the node local
+	// resource index, so the node must enable `move.resource-index-enabled`.
+	ResourcesByType(ctx context.Context, in *QueryResourcesByTypeRequest, opts ...grpc.CallOption) (*QueryResourcesByTypeResponse, error)
+	// Authenticator queries the move view function which authenticates
+	// the signatures of an account
+	Authenticator(ctx context.Context, in *QueryAuthenticatorRequest, opts ...grpc.CallOption) (*QueryAuthenticatorResponse, error)
+	// Sponsor queries the move view function which decides whether
+	// the sponsor pays the fee of a tx
+	Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error)
 	// Params queries all parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Metadata converts metadata to denom
@@ -150,6 +219,15 @@ func (c *queryClient) TableEntries(ctx context.Context, in *QueryTableEntriesReq
 	return out, nil
 }
 
+func (c *queryClient) TableEntriesByJSON(ctx context.Context, in *QueryTableEntriesByJSONRequest, opts ...grpc.CallOption) (*QueryTableEntriesByJSONResponse, error) {
+	out := new(QueryTableEntriesByJSONResponse)
+	err := c.cc.Invoke(ctx, Query_TableEntriesByJSON_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // Deprecated: Do not use.
 func (c *queryClient) LegacyView(ctx context.Context, in *QueryLegacyViewRequest, opts ...grpc.CallOption) (*QueryLegacyViewResponse, error) {
 	out := new(QueryLegacyViewResponse)
@@ -205,6 +283,159 @@ func (c *queryClient) ScriptABI(ctx context.Context, in *QueryScriptABIRequest,
 	return out, nil
 }
 
+func (c *queryClient) Profile(ctx context.Context, in *QueryProfileRequest, opts ...grpc.CallOption) (*QueryProfileResponse, error) {
+	out := new(QueryProfileResponse)
+	err := c.cc.Invoke(ctx, Query_Profile_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleRevenue(ctx context.Context, in *QueryModuleRevenueRequest, opts ...grpc.CallOption) (*QueryModuleRevenueResponse, error) {
+	out := new(QueryModuleRevenueResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleRevenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ClaimableRevenue(ctx context.Context, in *QueryClaimableRevenueRequest, opts ...grpc.CallOption) (*QueryClaimableRevenueResponse, error) {
+	out := new(QueryClaimableRevenueResponse)
+	err := c.cc.Invoke(ctx, Query_ClaimableRevenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CronJob(ctx context.Context, in *QueryCronJobRequest, opts ...grpc.CallOption) (*QueryCronJobResponse, error) {
+	out := new(QueryCronJobResponse)
+	err := c.cc.Invoke(ctx, Query_CronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CronJobs(ctx context.Context, in *QueryCronJobsRequest, opts ...grpc.CallOption) (*QueryCronJobsResponse, error) {
+	out := new(QueryCronJobsResponse)
+	err := c.cc.Invoke(ctx, Query_CronJobs_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CronRuns(ctx context.Context, in *QueryCronRunsRequest, opts ...grpc.CallOption) (*QueryCronRunsResponse, error) {
+	out := new(QueryCronRunsResponse)
+	err := c.cc.Invoke(ctx, Query_CronRuns_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PublishPolicy(ctx context.Context, in *QueryPublishPolicyRequest, opts ...grpc.CallOption) (*QueryPublishPolicyResponse, error) {
+	out := new(QueryPublishPolicyResponse)
+	err := c.cc.Invoke(ctx, Query_PublishPolicy_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PublishDeposit(ctx context.Context, in *QueryPublishDepositRequest, opts ...grpc.CallOption) (*QueryPublishDepositResponse, error) {
+	out := new(QueryPublishDepositResponse)
+	err := c.cc.Invoke(ctx, Query_PublishDeposit_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleDependencies(ctx context.Context, in *QueryModuleDependenciesRequest, opts ...grpc.CallOption) (*QueryModuleDependenciesResponse, error) {
+	out := new(QueryModuleDependenciesResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleDependencies_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleDependents(ctx context.Context, in *QueryModuleDependentsRequest, opts ...grpc.CallOption) (*QueryModuleDependentsResponse, error) {
+	out := new(QueryModuleDependentsResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleDependents_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ModuleFriends(ctx context.Context, in *QueryModuleFriendsRequest, opts ...grpc.CallOption) (*QueryModuleFriendsResponse, error) {
+	out := new(QueryModuleFriendsResponse)
+	err := c.cc.Invoke(ctx, Query_ModuleFriends_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FunctionSignatures(ctx context.Context, in *QueryFunctionSignaturesRequest, opts ...grpc.CallOption) (*QueryFunctionSignaturesResponse, error) {
+	out := new(QueryFunctionSignaturesResponse)
+	err := c.cc.Invoke(ctx, Query_FunctionSignatures_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) CheckUpgradeCompatibility(ctx context.Context, in *QueryCheckUpgradeCompatibilityRequest, opts ...grpc.CallOption) (*QueryCheckUpgradeCompatibilityResponse, error) {
+	out := new(QueryCheckUpgradeCompatibilityResponse)
+	err := c.cc.Invoke(ctx, Query_CheckUpgradeCompatibility_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourceHolders(ctx context.Context, in *QueryResourceHoldersRequest, opts ...grpc.CallOption) (*QueryResourceHoldersResponse, error) {
+	out := new(QueryResourceHoldersResponse)
+	err := c.cc.Invoke(ctx, Query_ResourceHolders_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ResourcesByType(ctx context.Context, in *QueryResourcesByTypeRequest, opts ...grpc.CallOption) (*QueryResourcesByTypeResponse, error) {
+	out := new(QueryResourcesByTypeResponse)
+	err := c.cc.Invoke(ctx, Query_ResourcesByType_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Authenticator(ctx context.Context, in *QueryAuthenticatorRequest, opts ...grpc.CallOption) (*QueryAuthenticatorResponse, error) {
+	out := new(QueryAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Query_Authenticator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Sponsor(ctx context.Context, in *QuerySponsorRequest, opts ...grpc.CallOption) (*QuerySponsorResponse, error) {
+	out := new(QuerySponsorResponse)
+	err := c.cc.Invoke(ctx, Query_Sponsor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
 	out := new(QueryParamsResponse)
 	err := c.cc.Invoke(ctx, Query_Params_FullMethodName, in, out, opts...)
@@ -250,6 +481,9 @@ type QueryServer interface {
 	TableEntry(context.Context, *QueryTableEntryRequest) (*QueryTableEntryResponse, error)
 	// Query table entries with pagination
 	TableEntries(context.Context, *QueryTableEntriesRequest) (*QueryTableEntriesResponse, error)
+	// Query table entries with pagination in the key range, where the range
+	// keys are given as json values of the table key type
+	TableEntriesByJSON(context.Context, *QueryTableEntriesByJSONRequest) (*QueryTableEntriesByJSONResponse, error)
 	// Deprecated: Do not use.
 	// Deprecated: Use Query/ViewJSON or Query/ViewJSONBatch
 	// LegacyView execute view function and return the view result.
@@ -266,6 +500,54 @@ type QueryServer interface {
 	ViewJSONBatch(context.Context, *QueryViewJSONBatchRequest) (*QueryViewJSONBatchResponse, error)
 	// ScriptABI decode script bytes into ABI
 	ScriptABI(context.Context, *QueryScriptABIRequest) (*QueryScriptABIResponse, error)
+	// Profile executes an entry function without committing the state changes
+	// and returns the gas usage broken down by module, with an optional trace of
+	// the state accesses made by the vm. The vm reports gas usage per module only,
+	// so no per-function breakdown or instruction-level trace is available. The
+	// query is disabled unless `move.contract-profile-enabled` is set in the app
+	// config.
+	Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error)
+	// ModuleRevenue queries the contract shared revenue information of a module
+	ModuleRevenue(context.Context, *QueryModuleRevenueRequest) (*QueryModuleRevenueResponse, error)
+	// ClaimableRevenue queries the contract shared revenue which can be claimed by the recipient
+	ClaimableRevenue(context.Context, *QueryClaimableRevenueRequest) (*QueryClaimableRevenueResponse, error)
+	// CronJob queries a cron job
+	CronJob(context.Context, *QueryCronJobRequest) (*QueryCronJobResponse, error)
+	// CronJobs queries all cron jobs
+	CronJobs(context.Context, *QueryCronJobsRequest) (*QueryCronJobsResponse, error)
+	// CronRuns queries the recent run history of a cron job
+	CronRuns(context.Context, *QueryCronRunsRequest) (*QueryCronRunsResponse, error)
+	// PublishPolicy queries the publish policy applied to an address
+	PublishPolicy(context.Context, *QueryPublishPolicyRequest) (*QueryPublishPolicyResponse, error)
+	// PublishDeposit queries the publish deposit of a module
+	PublishDeposit(context.Context, *QueryPublishDepositRequest) (*QueryPublishDepositResponse, error)
+	// ModuleDependencies queries the modules imported by a module
+	ModuleDependencies(context.Context, *QueryModuleDependenciesRequest) (*QueryModuleDependenciesResponse, error)
+	// ModuleDependents queries the published modules which import a module
+	ModuleDependents(context.Context, *QueryModuleDependentsRequest) (*QueryModuleDependentsResponse, error)
+	// ModuleFriends queries the friend declarations of the modules of an address
+	ModuleFriends(context.Context, *QueryModuleFriendsRequest) (*QueryModuleFriendsResponse, error)
+	// FunctionSignatures queries the entry and view function signatures
+	// of the modules of an address
+	FunctionSignatures(context.Context, *QueryFunctionSignaturesRequest) (*QueryFunctionSignaturesResponse, error)
+	// CheckUpgradeCompatibility checks whether a module bundle can be published
+	// without committing the state changes, and reports the changed public
+	// functions and structs and the published dependents which would break.
+	CheckUpgradeCompatibility(context.Context, *QueryCheckUpgradeCompatibilityRequest) (*QueryCheckUpgradeCompatibilityResponse, error)
+	// ResourceHolders queries the accounts holding a resource of the struct tag.
+	// The query is served from the node local resource index, so the node
+	// must enable `move.resource-index-enabled`.
+	ResourceHolders(context.Context, *QueryResourceHoldersRequest) (*QueryResourceHoldersResponse, error)
+	// ResourcesByType queries the resources of an account whose struct tag
+	// starts with the prefix. The query is served from the node local
+	// resource index, so the node must enable `move.resource-index-enabled`.
+	ResourcesByType(context.Context, *QueryResourcesByTypeRequest) (*QueryResourcesByTypeResponse, error)
+	// Authenticator queries the move view function which authenticates
+	// the signatures of an account
+	Authenticator(context.Context, *QueryAuthenticatorRequest) (*QueryAuthenticatorResponse, error)
+	// Sponsor queries the move view function which decides whether
+	// the sponsor pays the fee of a tx
+	Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error)
 	// Params queries all parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Metadata converts metadata to denom
@@ -300,6 +582,9 @@ func (UnimplementedQueryServer) TableEntry(context.Context, *QueryTableEntryRequ
 func (UnimplementedQueryServer) TableEntries(context.Context, *QueryTableEntriesRequest) (*QueryTableEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TableEntries not implemented")
 }
+func (UnimplementedQueryServer) TableEntriesByJSON(context.Context, *QueryTableEntriesByJSONRequest) (*QueryTableEntriesByJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TableEntriesByJSON not implemented")
+}
 func (UnimplementedQueryServer) LegacyView(context.Context, *QueryLegacyViewRequest) (*QueryLegacyViewResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LegacyView not implemented")
 }
@@ -318,6 +603,57 @@ func (UnimplementedQueryServer) ViewJSONBatch(context.Context, *QueryViewJSONBat
 func (UnimplementedQueryServer) ScriptABI(context.Context, *QueryScriptABIRequest) (*QueryScriptABIResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ScriptABI not implemented")
 }
+func (UnimplementedQueryServer) Profile(context.Context, *QueryProfileRequest) (*QueryProfileResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Profile not implemented")
+}
+func (UnimplementedQueryServer) ModuleRevenue(context.Context, *QueryModuleRevenueRequest) (*QueryModuleRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleRevenue not implemented")
+}
+func (UnimplementedQueryServer) ClaimableRevenue(context.Context, *QueryClaimableRevenueRequest) (*QueryClaimableRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimableRevenue not implemented")
+}
+func (UnimplementedQueryServer) CronJob(context.Context, *QueryCronJobRequest) (*QueryCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CronJob not implemented")
+}
+func (UnimplementedQueryServer) CronJobs(context.Context, *QueryCronJobsRequest) (*QueryCronJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CronJobs not implemented")
+}
+func (UnimplementedQueryServer) CronRuns(context.Context, *QueryCronRunsRequest) (*QueryCronRunsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CronRuns not implemented")
+}
+func (UnimplementedQueryServer) PublishPolicy(context.Context, *QueryPublishPolicyRequest) (*QueryPublishPolicyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishPolicy not implemented")
+}
+func (UnimplementedQueryServer) PublishDeposit(context.Context, *QueryPublishDepositRequest) (*QueryPublishDepositResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishDeposit not implemented")
+}
+func (UnimplementedQueryServer) ModuleDependencies(context.Context, *QueryModuleDependenciesRequest) (*QueryModuleDependenciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleDependencies not implemented")
+}
+func (UnimplementedQueryServer) ModuleDependents(context.Context, *QueryModuleDependentsRequest) (*QueryModuleDependentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleDependents not implemented")
+}
+func (UnimplementedQueryServer) ModuleFriends(context.Context, *QueryModuleFriendsRequest) (*QueryModuleFriendsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModuleFriends not implemented")
+}
+func (UnimplementedQueryServer) FunctionSignatures(context.Context, *QueryFunctionSignaturesRequest) (*QueryFunctionSignaturesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FunctionSignatures not implemented")
+}
+func (UnimplementedQueryServer) CheckUpgradeCompatibility(context.Context, *QueryCheckUpgradeCompatibilityRequest) (*QueryCheckUpgradeCompatibilityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CheckUpgradeCompatibility not implemented")
+}
+func (UnimplementedQueryServer) ResourceHolders(context.Context, *QueryResourceHoldersRequest) (*QueryResourceHoldersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourceHolders not implemented")
+}
+func (UnimplementedQueryServer) ResourcesByType(context.Context, *QueryResourcesByTypeRequest) (*QueryResourcesByTypeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResourcesByType not implemented")
+}
+func (UnimplementedQueryServer) Authenticator(context.Context, *QueryAuthenticatorRequest) (*QueryAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Authenticator not implemented")
+}
+func (UnimplementedQueryServer) Sponsor(context.Context, *QuerySponsorRequest) (*QuerySponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sponsor not implemented")
+}
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
@@ -466,6 +802,24 @@ func _Query_TableEntries_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TableEntriesByJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTableEntriesByJSONRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TableEntriesByJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_TableEntriesByJSON_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TableEntriesByJSON(ctx, req.(*QueryTableEntriesByJSONRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_LegacyView_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryLegacyViewRequest)
 	if err := dec(in); err != nil {
@@ -574,6 +928,312 @@ func _Query_ScriptABI_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_Profile_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProfileRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Profile(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Profile_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Profile(ctx, req.(*QueryProfileRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleRevenue(ctx, req.(*QueryModuleRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ClaimableRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryClaimableRevenueRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ClaimableRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ClaimableRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ClaimableRevenue(ctx, req.(*QueryClaimableRevenueRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CronJob(ctx, req.(*QueryCronJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CronJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronJobsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CronJobs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CronJobs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CronJobs(ctx, req.(*QueryCronJobsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CronRuns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCronRunsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CronRuns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CronRuns_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CronRuns(ctx, req.(*QueryCronRunsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PublishPolicy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPublishPolicyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PublishPolicy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PublishPolicy_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PublishPolicy(ctx, req.(*QueryPublishPolicyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PublishDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPublishDepositRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PublishDeposit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PublishDeposit_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PublishDeposit(ctx, req.(*QueryPublishDepositRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleDependencies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleDependenciesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleDependencies(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleDependencies_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleDependencies(ctx, req.(*QueryModuleDependenciesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleDependents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleDependentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleDependents(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleDependents_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleDependents(ctx, req.(*QueryModuleDependentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ModuleFriends_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryModuleFriendsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ModuleFriends(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ModuleFriends_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ModuleFriends(ctx, req.(*QueryModuleFriendsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FunctionSignatures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFunctionSignaturesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FunctionSignatures(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_FunctionSignatures_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FunctionSignatures(ctx, req.(*QueryFunctionSignaturesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_CheckUpgradeCompatibility_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryCheckUpgradeCompatibilityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).CheckUpgradeCompatibility(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_CheckUpgradeCompatibility_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).CheckUpgradeCompatibility(ctx, req.(*QueryCheckUpgradeCompatibilityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourceHolders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourceHoldersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourceHolders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourceHolders_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourceHolders(ctx, req.(*QueryResourceHoldersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ResourcesByType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryResourcesByTypeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ResourcesByType(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_ResourcesByType_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ResourcesByType(ctx, req.(*QueryResourcesByTypeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Authenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuthenticatorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Authenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Authenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Authenticator(ctx, req.(*QueryAuthenticatorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Sponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySponsorRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Sponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_Sponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Sponsor(ctx, req.(*QuerySponsorRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryParamsRequest)
 	if err := dec(in); err != nil {
@@ -663,6 +1323,10 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "TableEntries",
 			Handler:    _Query_TableEntries_Handler,
 		},
+		{
+			MethodName: "TableEntriesByJSON",
+			Handler:    _Query_TableEntriesByJSON_Handler,
+		},
 		{
 			MethodName: "LegacyView",
 			Handler:    _Query_LegacyView_Handler,
@@ -687,6 +1351,74 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "ScriptABI",
 			Handler:    _Query_ScriptABI_Handler,
 		},
+		{
+			MethodName: "Profile",
+			Handler:    _Query_Profile_Handler,
+		},
+		{
+			MethodName: "ModuleRevenue",
+			Handler:    _Query_ModuleRevenue_Handler,
+		},
+		{
+			MethodName: "ClaimableRevenue",
+			Handler:    _Query_ClaimableRevenue_Handler,
+		},
+		{
+			MethodName: "CronJob",
+			Handler:    _Query_CronJob_Handler,
+		},
+		{
+			MethodName: "CronJobs",
+			Handler:    _Query_CronJobs_Handler,
+		},
+		{
+			MethodName: "CronRuns",
+			Handler:    _Query_CronRuns_Handler,
+		},
+		{
+			MethodName: "PublishPolicy",
+			Handler:    _Query_PublishPolicy_Handler,
+		},
+		{
+			MethodName: "PublishDeposit",
+			Handler:    _Query_PublishDeposit_Handler,
+		},
+		{
+			MethodName: "ModuleDependencies",
+			Handler:    _Query_ModuleDependencies_Handler,
+		},
+		{
+			MethodName: "ModuleDependents",
+			Handler:    _Query_ModuleDependents_Handler,
+		},
+		{
+			MethodName: "ModuleFriends",
+			Handler:    _Query_ModuleFriends_Handler,
+		},
+		{
+			MethodName: "FunctionSignatures",
+			Handler:    _Query_FunctionSignatures_Handler,
+		},
+		{
+			MethodName: "CheckUpgradeCompatibility",
+			Handler:    _Query_CheckUpgradeCompatibility_Handler,
+		},
+		{
+			MethodName: "ResourceHolders",
+			Handler:    _Query_ResourceHolders_Handler,
+		},
+		{
+			MethodName: "ResourcesByType",
+			Handler:    _Query_ResourcesByType_Handler,
+		},
+		{
+			MethodName: "Authenticator",
+			Handler:    _Query_Authenticator_Handler,
+		},
+		{
+			MethodName: "Sponsor",
+			Handler:    _Query_Sponsor_Handler,
+		},
 		{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
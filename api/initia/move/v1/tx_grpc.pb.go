@@ -19,19 +19,28 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Msg_Publish_FullMethodName        = "/initia.move.v1.Msg/Publish"
-	Msg_Execute_FullMethodName        = "/initia.move.v1.Msg/Execute"
-	Msg_ExecuteJSON_FullMethodName    = "/initia.move.v1.Msg/ExecuteJSON"
-	Msg_Script_FullMethodName         = "/initia.move.v1.Msg/Script"
-	Msg_ScriptJSON_FullMethodName     = "/initia.move.v1.Msg/ScriptJSON"
-	Msg_GovPublish_FullMethodName     = "/initia.move.v1.Msg/GovPublish"
-	Msg_GovExecute_FullMethodName     = "/initia.move.v1.Msg/GovExecute"
-	Msg_GovExecuteJSON_FullMethodName = "/initia.move.v1.Msg/GovExecuteJSON"
-	Msg_GovScript_FullMethodName      = "/initia.move.v1.Msg/GovScript"
-	Msg_GovScriptJSON_FullMethodName  = "/initia.move.v1.Msg/GovScriptJSON"
-	Msg_Whitelist_FullMethodName      = "/initia.move.v1.Msg/Whitelist"
-	Msg_Delist_FullMethodName         = "/initia.move.v1.Msg/Delist"
-	Msg_UpdateParams_FullMethodName   = "/initia.move.v1.Msg/UpdateParams"
+	Msg_Publish_FullMethodName             = "/initia.move.v1.Msg/Publish"
+	Msg_Execute_FullMethodName             = "/initia.move.v1.Msg/Execute"
+	Msg_ExecuteJSON_FullMethodName         = "/initia.move.v1.Msg/ExecuteJSON"
+	Msg_Script_FullMethodName              = "/initia.move.v1.Msg/Script"
+	Msg_ScriptJSON_FullMethodName          = "/initia.move.v1.Msg/ScriptJSON"
+	Msg_GovPublish_FullMethodName          = "/initia.move.v1.Msg/GovPublish"
+	Msg_GovExecute_FullMethodName          = "/initia.move.v1.Msg/GovExecute"
+	Msg_GovExecuteJSON_FullMethodName      = "/initia.move.v1.Msg/GovExecuteJSON"
+	Msg_GovScript_FullMethodName           = "/initia.move.v1.Msg/GovScript"
+	Msg_GovScriptJSON_FullMethodName       = "/initia.move.v1.Msg/GovScriptJSON"
+	Msg_Whitelist_FullMethodName           = "/initia.move.v1.Msg/Whitelist"
+	Msg_Delist_FullMethodName              = "/initia.move.v1.Msg/Delist"
+	Msg_UpdateParams_FullMethodName        = "/initia.move.v1.Msg/UpdateParams"
+	Msg_SetRevenueRecipient_FullMethodName = "/initia.move.v1.Msg/SetRevenueRecipient"
+	Msg_ClaimRevenue_FullMethodName        = "/initia.move.v1.Msg/ClaimRevenue"
+	Msg_UpdateRevenueRatio_FullMethodName  = "/initia.move.v1.Msg/UpdateRevenueRatio"
+	Msg_RegisterCronJob_FullMethodName     = "/initia.move.v1.Msg/RegisterCronJob"
+	Msg_DepositCronJob_FullMethodName      = "/initia.move.v1.Msg/DepositCronJob"
+	Msg_DeregisterCronJob_FullMethodName   = "/initia.move.v1.Msg/DeregisterCronJob"
+	Msg_SetPublishNamespace_FullMethodName = "/initia.move.v1.Msg/SetPublishNamespace"
+	Msg_SetAuthenticator_FullMethodName    = "/initia.move.v1.Msg/SetAuthenticator"
+	Msg_SetSponsor_FullMethodName          = "/initia.move.v1.Msg/SetSponsor"
 )
 
 // MsgClient is the client API for Msg service.
@@ -72,6 +81,29 @@ type MsgClient interface {
 	// UpdateParams defines an operation for updating the x/move module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRevenueRecipient registers the recipient of the contract shared revenue
+	// of a module. Only the module publisher can register the recipient.
+	SetRevenueRecipient(ctx context.Context, in *MsgSetRevenueRecipient, opts ...grpc.CallOption) (*MsgSetRevenueRecipientResponse, error)
+	// ClaimRevenue transfers the accrued contract shared revenue to the recipient
+	ClaimRevenue(ctx context.Context, in *MsgClaimRevenue, opts ...grpc.CallOption) (*MsgClaimRevenueResponse, error)
+	// UpdateRevenueRatio sets or removes the contract shared revenue ratio
+	// override of a module via gov proposal
+	UpdateRevenueRatio(ctx context.Context, in *MsgUpdateRevenueRatio, opts ...grpc.CallOption) (*MsgUpdateRevenueRatioResponse, error)
+	// RegisterCronJob registers a scheduled entry function call with the prepaid fee
+	RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error)
+	// DepositCronJob adds the prepaid fee to a cron job
+	DepositCronJob(ctx context.Context, in *MsgDepositCronJob, opts ...grpc.CallOption) (*MsgDepositCronJobResponse, error)
+	// DeregisterCronJob removes a cron job and refunds the remaining deposit
+	DeregisterCronJob(ctx context.Context, in *MsgDeregisterCronJob, opts ...grpc.CallOption) (*MsgDeregisterCronJobResponse, error)
+	// SetPublishNamespace sets or removes the module name prefixes
+	// which an address can publish via gov proposal
+	SetPublishNamespace(ctx context.Context, in *MsgSetPublishNamespace, opts ...grpc.CallOption) (*MsgSetPublishNamespaceResponse, error)
+	// SetAuthenticator sets or removes the move view function which
+	// authenticates the signatures of the sender
+	SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error)
+	// SetSponsor sets or removes the move view function which decides
+	// whether the sponsor pays the fee of a tx
+	SetSponsor(ctx context.Context, in *MsgSetSponsor, opts ...grpc.CallOption) (*MsgSetSponsorResponse, error)
 }
 
 type msgClient struct {
@@ -199,6 +231,87 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetRevenueRecipient(ctx context.Context, in *MsgSetRevenueRecipient, opts ...grpc.CallOption) (*MsgSetRevenueRecipientResponse, error) {
+	out := new(MsgSetRevenueRecipientResponse)
+	err := c.cc.Invoke(ctx, Msg_SetRevenueRecipient_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ClaimRevenue(ctx context.Context, in *MsgClaimRevenue, opts ...grpc.CallOption) (*MsgClaimRevenueResponse, error) {
+	out := new(MsgClaimRevenueResponse)
+	err := c.cc.Invoke(ctx, Msg_ClaimRevenue_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRevenueRatio(ctx context.Context, in *MsgUpdateRevenueRatio, opts ...grpc.CallOption) (*MsgUpdateRevenueRatioResponse, error) {
+	out := new(MsgUpdateRevenueRatioResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateRevenueRatio_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error) {
+	out := new(MsgRegisterCronJobResponse)
+	err := c.cc.Invoke(ctx, Msg_RegisterCronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DepositCronJob(ctx context.Context, in *MsgDepositCronJob, opts ...grpc.CallOption) (*MsgDepositCronJobResponse, error) {
+	out := new(MsgDepositCronJobResponse)
+	err := c.cc.Invoke(ctx, Msg_DepositCronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) DeregisterCronJob(ctx context.Context, in *MsgDeregisterCronJob, opts ...grpc.CallOption) (*MsgDeregisterCronJobResponse, error) {
+	out := new(MsgDeregisterCronJobResponse)
+	err := c.cc.Invoke(ctx, Msg_DeregisterCronJob_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetPublishNamespace(ctx context.Context, in *MsgSetPublishNamespace, opts ...grpc.CallOption) (*MsgSetPublishNamespaceResponse, error) {
+	out := new(MsgSetPublishNamespaceResponse)
+	err := c.cc.Invoke(ctx, Msg_SetPublishNamespace_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error) {
+	out := new(MsgSetAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, Msg_SetAuthenticator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetSponsor(ctx context.Context, in *MsgSetSponsor, opts ...grpc.CallOption) (*MsgSetSponsorResponse, error) {
+	out := new(MsgSetSponsorResponse)
+	err := c.cc.Invoke(ctx, Msg_SetSponsor_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -237,6 +350,29 @@ type MsgServer interface {
 	// UpdateParams defines an operation for updating the x/move module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetRevenueRecipient registers the recipient of the contract shared revenue
+	// of a module. Only the module publisher can register the recipient.
+	SetRevenueRecipient(context.Context, *MsgSetRevenueRecipient) (*MsgSetRevenueRecipientResponse, error)
+	// ClaimRevenue transfers the accrued contract shared revenue to the recipient
+	ClaimRevenue(context.Context, *MsgClaimRevenue) (*MsgClaimRevenueResponse, error)
+	// UpdateRevenueRatio sets or removes the contract shared revenue ratio
+	// override of a module via gov proposal
+	UpdateRevenueRatio(context.Context, *MsgUpdateRevenueRatio) (*MsgUpdateRevenueRatioResponse, error)
+	// RegisterCronJob registers a scheduled entry function call with the prepaid fee
+	RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error)
+	// DepositCronJob adds the prepaid fee to a cron job
+	DepositCronJob(context.Context, *MsgDepositCronJob) (*MsgDepositCronJobResponse, error)
+	// DeregisterCronJob removes a cron job and refunds the remaining deposit
+	DeregisterCronJob(context.Context, *MsgDeregisterCronJob) (*MsgDeregisterCronJobResponse, error)
+	// SetPublishNamespace sets or removes the module name prefixes
+	// which an address can publish via gov proposal
+	SetPublishNamespace(context.Context, *MsgSetPublishNamespace) (*MsgSetPublishNamespaceResponse, error)
+	// SetAuthenticator sets or removes the move view function which
+	// authenticates the signatures of the sender
+	SetAuthenticator(context.Context, *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error)
+	// SetSponsor sets or removes the move view function which decides
+	// whether the sponsor pays the fee of a tx
+	SetSponsor(context.Context, *MsgSetSponsor) (*MsgSetSponsorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -283,6 +419,33 @@ func (UnimplementedMsgServer) Delist(context.Context, *MsgDelist) (*MsgDelistRes
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) SetRevenueRecipient(context.Context, *MsgSetRevenueRecipient) (*MsgSetRevenueRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRevenueRecipient not implemented")
+}
+func (UnimplementedMsgServer) ClaimRevenue(context.Context, *MsgClaimRevenue) (*MsgClaimRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRevenue not implemented")
+}
+func (UnimplementedMsgServer) UpdateRevenueRatio(context.Context, *MsgUpdateRevenueRatio) (*MsgUpdateRevenueRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRevenueRatio not implemented")
+}
+func (UnimplementedMsgServer) RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCronJob not implemented")
+}
+func (UnimplementedMsgServer) DepositCronJob(context.Context, *MsgDepositCronJob) (*MsgDepositCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCronJob not implemented")
+}
+func (UnimplementedMsgServer) DeregisterCronJob(context.Context, *MsgDeregisterCronJob) (*MsgDeregisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterCronJob not implemented")
+}
+func (UnimplementedMsgServer) SetPublishNamespace(context.Context, *MsgSetPublishNamespace) (*MsgSetPublishNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPublishNamespace not implemented")
+}
+func (UnimplementedMsgServer) SetAuthenticator(context.Context, *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthenticator not implemented")
+}
+func (UnimplementedMsgServer) SetSponsor(context.Context, *MsgSetSponsor) (*MsgSetSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsor not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -530,6 +693,168 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetRevenueRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRevenueRecipient)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetRevenueRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetRevenueRecipient_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRevenueRecipient(ctx, req.(*MsgSetRevenueRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ClaimRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ClaimRevenue_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRevenue(ctx, req.(*MsgClaimRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateRevenueRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRevenueRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRevenueRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateRevenueRatio_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRevenueRatio(ctx, req.(*MsgUpdateRevenueRatio))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RegisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RegisterCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCronJob(ctx, req.(*MsgRegisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DepositCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DepositCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositCronJob(ctx, req.(*MsgDepositCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_DeregisterCronJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterCronJob(ctx, req.(*MsgDeregisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPublishNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPublishNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPublishNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetPublishNamespace_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPublishNamespace(ctx, req.(*MsgSetPublishNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetAuthenticator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAuthenticator(ctx, req.(*MsgSetAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_SetSponsor_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsor(ctx, req.(*MsgSetSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -589,6 +914,42 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetRevenueRecipient",
+			Handler:    _Msg_SetRevenueRecipient_Handler,
+		},
+		{
+			MethodName: "ClaimRevenue",
+			Handler:    _Msg_ClaimRevenue_Handler,
+		},
+		{
+			MethodName: "UpdateRevenueRatio",
+			Handler:    _Msg_UpdateRevenueRatio_Handler,
+		},
+		{
+			MethodName: "RegisterCronJob",
+			Handler:    _Msg_RegisterCronJob_Handler,
+		},
+		{
+			MethodName: "DepositCronJob",
+			Handler:    _Msg_DepositCronJob_Handler,
+		},
+		{
+			MethodName: "DeregisterCronJob",
+			Handler:    _Msg_DeregisterCronJob_Handler,
+		},
+		{
+			MethodName: "SetPublishNamespace",
+			Handler:    _Msg_SetPublishNamespace_Handler,
+		},
+		{
+			MethodName: "SetAuthenticator",
+			Handler:    _Msg_SetAuthenticator_Handler,
+		},
+		{
+			MethodName: "SetSponsor",
+			Handler:    _Msg_SetSponsor_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/move/v1/tx.proto",
@@ -3,6 +3,7 @@ package movev1
 
 import (
 	_ "cosmossdk.io/api/amino"
+	v1beta1 "cosmossdk.io/api/cosmos/base/v1beta1"
 	fmt "fmt"
 	runtime "github.com/cosmos/cosmos-proto/runtime"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -14,59 +15,68 @@ import (
 	sync "sync"
 )
 
-var _ protoreflect.List = (*_Params_5_list)(nil)
+var _ protoreflect.List = (*_Params_4_list)(nil)
 
-type _Params_5_list struct {
+type _Params_4_list struct {
 	list *[]string
 }
 
-func (x *_Params_5_list) Len() int {
+func (x *_Params_4_list) Len() int {
 	if x.list == nil {
 		return 0
 	}
 	return len(*x.list)
 }
 
-func (x *_Params_5_list) Get(i int) protoreflect.Value {
+func (x *_Params_4_list) Get(i int) protoreflect.Value {
 	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (x *_Params_5_list) Set(i int, value protoreflect.Value) {
+func (x *_Params_4_list) Set(i int, value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	(*x.list)[i] = concreteValue
 }
 
-func (x *_Params_5_list) Append(value protoreflect.Value) {
+func (x *_Params_4_list) Append(value protoreflect.Value) {
 	valueUnwrapped := value.String()
 	concreteValue := valueUnwrapped
 	*x.list = append(*x.list, concreteValue)
 }
 
-func (x *_Params_5_list) AppendMutable() protoreflect.Value {
+func (x *_Params_4_list) AppendMutable() protoreflect.Value {
 	panic(fmt.Errorf("AppendMutable can not be called on message Params at list field AllowedPublishers as it is not of Message kind"))
 }
 
-func (x *_Params_5_list) Truncate(n int) {
+func (x *_Params_4_list) Truncate(n int) {
 	*x.list = (*x.list)[:n]
 }
 
-func (x *_Params_5_list) NewElement() protoreflect.Value {
+func (x *_Params_4_list) NewElement() protoreflect.Value {
 	v := ""
 	return protoreflect.ValueOfString(v)
 }
 
-func (x *_Params_5_list) IsValid() bool {
+func (x *_Params_4_list) IsValid() bool {
 	return x.list != nil
 }
 
 var (
-	md_Params                               protoreflect.MessageDescriptor
-	fd_Params_base_denom                    protoreflect.FieldDescriptor
-	fd_Params_base_min_gas_price            protoreflect.FieldDescriptor
-	fd_Params_arbitrary_enabled             protoreflect.FieldDescriptor
-	fd_Params_contract_shared_revenue_ratio protoreflect.FieldDescriptor
-	fd_Params_allowed_publishers            protoreflect.FieldDescriptor
+	md_Params                                     protoreflect.MessageDescriptor
+	fd_Params_base_denom                          protoreflect.FieldDescriptor
+	fd_Params_base_min_gas_price                  protoreflect.FieldDescriptor
+	fd_Params_contract_shared_revenue_ratio       protoreflect.FieldDescriptor
+	fd_Params_allowed_publishers                  protoreflect.FieldDescriptor
+	fd_Params_cron_max_gas_per_block              protoreflect.FieldDescriptor
+	fd_Params_cron_max_failures                   protoreflect.FieldDescriptor
+	fd_Params_publish_mode                        protoreflect.FieldDescriptor
+	fd_Params_publish_deposit                     protoreflect.FieldDescriptor
+	fd_Params_publish_quota                       protoreflect.FieldDescriptor
+	fd_Params_publish_quota_window                protoreflect.FieldDescriptor
+	fd_Params_authenticator_gas_limit             protoreflect.FieldDescriptor
+	fd_Params_sponsor_gas_limit                   protoreflect.FieldDescriptor
+	fd_Params_voting_power_twap_window            protoreflect.FieldDescriptor
+	fd_Params_max_voting_power_weight_change_rate protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -74,9 +84,18 @@ func init() {
 	md_Params = File_initia_move_v1_types_proto.Messages().ByName("Params")
 	fd_Params_base_denom = md_Params.Fields().ByName("base_denom")
 	fd_Params_base_min_gas_price = md_Params.Fields().ByName("base_min_gas_price")
-	fd_Params_arbitrary_enabled = md_Params.Fields().ByName("arbitrary_enabled")
 	fd_Params_contract_shared_revenue_ratio = md_Params.Fields().ByName("contract_shared_revenue_ratio")
 	fd_Params_allowed_publishers = md_Params.Fields().ByName("allowed_publishers")
+	fd_Params_cron_max_gas_per_block = md_Params.Fields().ByName("cron_max_gas_per_block")
+	fd_Params_cron_max_failures = md_Params.Fields().ByName("cron_max_failures")
+	fd_Params_publish_mode = md_Params.Fields().ByName("publish_mode")
+	fd_Params_publish_deposit = md_Params.Fields().ByName("publish_deposit")
+	fd_Params_publish_quota = md_Params.Fields().ByName("publish_quota")
+	fd_Params_publish_quota_window = md_Params.Fields().ByName("publish_quota_window")
+	fd_Params_authenticator_gas_limit = md_Params.Fields().ByName("authenticator_gas_limit")
+	fd_Params_sponsor_gas_limit = md_Params.Fields().ByName("sponsor_gas_limit")
+	fd_Params_voting_power_twap_window = md_Params.Fields().ByName("voting_power_twap_window")
+	fd_Params_max_voting_power_weight_change_rate = md_Params.Fields().ByName("max_voting_power_weight_change_rate")
 }
 
 var _ protoreflect.Message = (*fastReflection_Params)(nil)
@@ -156,12 +175,6 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 			return
 		}
 	}
-	if x.ArbitraryEnabled != false {
-		value := protoreflect.ValueOfBool(x.ArbitraryEnabled)
-		if !f(fd_Params_arbitrary_enabled, value) {
-			return
-		}
-	}
 	if x.ContractSharedRevenueRatio != "" {
 		value := protoreflect.ValueOfString(x.ContractSharedRevenueRatio)
 		if !f(fd_Params_contract_shared_revenue_ratio, value) {
@@ -169,11 +182,71 @@ func (x *fastReflection_Params) Range(f func(protoreflect.FieldDescriptor, proto
 		}
 	}
 	if len(x.AllowedPublishers) != 0 {
-		value := protoreflect.ValueOfList(&_Params_5_list{list: &x.AllowedPublishers})
+		value := protoreflect.ValueOfList(&_Params_4_list{list: &x.AllowedPublishers})
 		if !f(fd_Params_allowed_publishers, value) {
 			return
 		}
 	}
+	if x.CronMaxGasPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CronMaxGasPerBlock)
+		if !f(fd_Params_cron_max_gas_per_block, value) {
+			return
+		}
+	}
+	if x.CronMaxFailures != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CronMaxFailures)
+		if !f(fd_Params_cron_max_failures, value) {
+			return
+		}
+	}
+	if x.PublishMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PublishMode))
+		if !f(fd_Params_publish_mode, value) {
+			return
+		}
+	}
+	if x.PublishDeposit != "" {
+		value := protoreflect.ValueOfString(x.PublishDeposit)
+		if !f(fd_Params_publish_deposit, value) {
+			return
+		}
+	}
+	if x.PublishQuota != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PublishQuota)
+		if !f(fd_Params_publish_quota, value) {
+			return
+		}
+	}
+	if x.PublishQuotaWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PublishQuotaWindow)
+		if !f(fd_Params_publish_quota_window, value) {
+			return
+		}
+	}
+	if x.AuthenticatorGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuthenticatorGasLimit)
+		if !f(fd_Params_authenticator_gas_limit, value) {
+			return
+		}
+	}
+	if x.SponsorGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SponsorGasLimit)
+		if !f(fd_Params_sponsor_gas_limit, value) {
+			return
+		}
+	}
+	if x.VotingPowerTwapWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VotingPowerTwapWindow)
+		if !f(fd_Params_voting_power_twap_window, value) {
+			return
+		}
+	}
+	if x.MaxVotingPowerWeightChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxVotingPowerWeightChangeRate)
+		if !f(fd_Params_max_voting_power_weight_change_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -193,12 +266,30 @@ func (x *fastReflection_Params) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BaseDenom != ""
 	case "initia.move.v1.Params.base_min_gas_price":
 		return x.BaseMinGasPrice != ""
-	case "initia.move.v1.Params.arbitrary_enabled":
-		return x.ArbitraryEnabled != false
 	case "initia.move.v1.Params.contract_shared_revenue_ratio":
 		return x.ContractSharedRevenueRatio != ""
 	case "initia.move.v1.Params.allowed_publishers":
 		return len(x.AllowedPublishers) != 0
+	case "initia.move.v1.Params.cron_max_gas_per_block":
+		return x.CronMaxGasPerBlock != uint64(0)
+	case "initia.move.v1.Params.cron_max_failures":
+		return x.CronMaxFailures != uint64(0)
+	case "initia.move.v1.Params.publish_mode":
+		return x.PublishMode != 0
+	case "initia.move.v1.Params.publish_deposit":
+		return x.PublishDeposit != ""
+	case "initia.move.v1.Params.publish_quota":
+		return x.PublishQuota != uint64(0)
+	case "initia.move.v1.Params.publish_quota_window":
+		return x.PublishQuotaWindow != uint64(0)
+	case "initia.move.v1.Params.authenticator_gas_limit":
+		return x.AuthenticatorGasLimit != uint64(0)
+	case "initia.move.v1.Params.sponsor_gas_limit":
+		return x.SponsorGasLimit != uint64(0)
+	case "initia.move.v1.Params.voting_power_twap_window":
+		return x.VotingPowerTwapWindow != uint64(0)
+	case "initia.move.v1.Params.max_voting_power_weight_change_rate":
+		return x.MaxVotingPowerWeightChangeRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -219,12 +310,30 @@ func (x *fastReflection_Params) Clear(fd protoreflect.FieldDescriptor) {
 		x.BaseDenom = ""
 	case "initia.move.v1.Params.base_min_gas_price":
 		x.BaseMinGasPrice = ""
-	case "initia.move.v1.Params.arbitrary_enabled":
-		x.ArbitraryEnabled = false
 	case "initia.move.v1.Params.contract_shared_revenue_ratio":
 		x.ContractSharedRevenueRatio = ""
 	case "initia.move.v1.Params.allowed_publishers":
 		x.AllowedPublishers = nil
+	case "initia.move.v1.Params.cron_max_gas_per_block":
+		x.CronMaxGasPerBlock = uint64(0)
+	case "initia.move.v1.Params.cron_max_failures":
+		x.CronMaxFailures = uint64(0)
+	case "initia.move.v1.Params.publish_mode":
+		x.PublishMode = 0
+	case "initia.move.v1.Params.publish_deposit":
+		x.PublishDeposit = ""
+	case "initia.move.v1.Params.publish_quota":
+		x.PublishQuota = uint64(0)
+	case "initia.move.v1.Params.publish_quota_window":
+		x.PublishQuotaWindow = uint64(0)
+	case "initia.move.v1.Params.authenticator_gas_limit":
+		x.AuthenticatorGasLimit = uint64(0)
+	case "initia.move.v1.Params.sponsor_gas_limit":
+		x.SponsorGasLimit = uint64(0)
+	case "initia.move.v1.Params.voting_power_twap_window":
+		x.VotingPowerTwapWindow = uint64(0)
+	case "initia.move.v1.Params.max_voting_power_weight_change_rate":
+		x.MaxVotingPowerWeightChangeRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -247,18 +356,45 @@ func (x *fastReflection_Params) Get(descriptor protoreflect.FieldDescriptor) pro
 	case "initia.move.v1.Params.base_min_gas_price":
 		value := x.BaseMinGasPrice
 		return protoreflect.ValueOfString(value)
-	case "initia.move.v1.Params.arbitrary_enabled":
-		value := x.ArbitraryEnabled
-		return protoreflect.ValueOfBool(value)
 	case "initia.move.v1.Params.contract_shared_revenue_ratio":
 		value := x.ContractSharedRevenueRatio
 		return protoreflect.ValueOfString(value)
 	case "initia.move.v1.Params.allowed_publishers":
 		if len(x.AllowedPublishers) == 0 {
-			return protoreflect.ValueOfList(&_Params_5_list{})
+			return protoreflect.ValueOfList(&_Params_4_list{})
 		}
-		listValue := &_Params_5_list{list: &x.AllowedPublishers}
+		listValue := &_Params_4_list{list: &x.AllowedPublishers}
 		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.Params.cron_max_gas_per_block":
+		value := x.CronMaxGasPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.cron_max_failures":
+		value := x.CronMaxFailures
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.publish_mode":
+		value := x.PublishMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.move.v1.Params.publish_deposit":
+		value := x.PublishDeposit
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Params.publish_quota":
+		value := x.PublishQuota
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.publish_quota_window":
+		value := x.PublishQuotaWindow
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.authenticator_gas_limit":
+		value := x.AuthenticatorGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.sponsor_gas_limit":
+		value := x.SponsorGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.voting_power_twap_window":
+		value := x.VotingPowerTwapWindow
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.Params.max_voting_power_weight_change_rate":
+		value := x.MaxVotingPowerWeightChangeRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -283,14 +419,32 @@ func (x *fastReflection_Params) Set(fd protoreflect.FieldDescriptor, value proto
 		x.BaseDenom = value.Interface().(string)
 	case "initia.move.v1.Params.base_min_gas_price":
 		x.BaseMinGasPrice = value.Interface().(string)
-	case "initia.move.v1.Params.arbitrary_enabled":
-		x.ArbitraryEnabled = value.Bool()
 	case "initia.move.v1.Params.contract_shared_revenue_ratio":
 		x.ContractSharedRevenueRatio = value.Interface().(string)
 	case "initia.move.v1.Params.allowed_publishers":
 		lv := value.List()
-		clv := lv.(*_Params_5_list)
+		clv := lv.(*_Params_4_list)
 		x.AllowedPublishers = *clv.list
+	case "initia.move.v1.Params.cron_max_gas_per_block":
+		x.CronMaxGasPerBlock = value.Uint()
+	case "initia.move.v1.Params.cron_max_failures":
+		x.CronMaxFailures = value.Uint()
+	case "initia.move.v1.Params.publish_mode":
+		x.PublishMode = (PublishMode)(value.Enum())
+	case "initia.move.v1.Params.publish_deposit":
+		x.PublishDeposit = value.Interface().(string)
+	case "initia.move.v1.Params.publish_quota":
+		x.PublishQuota = value.Uint()
+	case "initia.move.v1.Params.publish_quota_window":
+		x.PublishQuotaWindow = value.Uint()
+	case "initia.move.v1.Params.authenticator_gas_limit":
+		x.AuthenticatorGasLimit = value.Uint()
+	case "initia.move.v1.Params.sponsor_gas_limit":
+		x.SponsorGasLimit = value.Uint()
+	case "initia.move.v1.Params.voting_power_twap_window":
+		x.VotingPowerTwapWindow = value.Uint()
+	case "initia.move.v1.Params.max_voting_power_weight_change_rate":
+		x.MaxVotingPowerWeightChangeRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -315,16 +469,34 @@ func (x *fastReflection_Params) Mutable(fd protoreflect.FieldDescriptor) protore
 		if x.AllowedPublishers == nil {
 			x.AllowedPublishers = []string{}
 		}
-		value := &_Params_5_list{list: &x.AllowedPublishers}
+		value := &_Params_4_list{list: &x.AllowedPublishers}
 		return protoreflect.ValueOfList(value)
 	case "initia.move.v1.Params.base_denom":
 		panic(fmt.Errorf("field base_denom of message initia.move.v1.Params is not mutable"))
 	case "initia.move.v1.Params.base_min_gas_price":
 		panic(fmt.Errorf("field base_min_gas_price of message initia.move.v1.Params is not mutable"))
-	case "initia.move.v1.Params.arbitrary_enabled":
-		panic(fmt.Errorf("field arbitrary_enabled of message initia.move.v1.Params is not mutable"))
 	case "initia.move.v1.Params.contract_shared_revenue_ratio":
 		panic(fmt.Errorf("field contract_shared_revenue_ratio of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.cron_max_gas_per_block":
+		panic(fmt.Errorf("field cron_max_gas_per_block of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.cron_max_failures":
+		panic(fmt.Errorf("field cron_max_failures of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.publish_mode":
+		panic(fmt.Errorf("field publish_mode of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.publish_deposit":
+		panic(fmt.Errorf("field publish_deposit of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.publish_quota":
+		panic(fmt.Errorf("field publish_quota of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.publish_quota_window":
+		panic(fmt.Errorf("field publish_quota_window of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.authenticator_gas_limit":
+		panic(fmt.Errorf("field authenticator_gas_limit of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.sponsor_gas_limit":
+		panic(fmt.Errorf("field sponsor_gas_limit of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.voting_power_twap_window":
+		panic(fmt.Errorf("field voting_power_twap_window of message initia.move.v1.Params is not mutable"))
+	case "initia.move.v1.Params.max_voting_power_weight_change_rate":
+		panic(fmt.Errorf("field max_voting_power_weight_change_rate of message initia.move.v1.Params is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -342,13 +514,31 @@ func (x *fastReflection_Params) NewField(fd protoreflect.FieldDescriptor) protor
 		return protoreflect.ValueOfString("")
 	case "initia.move.v1.Params.base_min_gas_price":
 		return protoreflect.ValueOfString("")
-	case "initia.move.v1.Params.arbitrary_enabled":
-		return protoreflect.ValueOfBool(false)
 	case "initia.move.v1.Params.contract_shared_revenue_ratio":
 		return protoreflect.ValueOfString("")
 	case "initia.move.v1.Params.allowed_publishers":
 		list := []string{}
-		return protoreflect.ValueOfList(&_Params_5_list{list: &list})
+		return protoreflect.ValueOfList(&_Params_4_list{list: &list})
+	case "initia.move.v1.Params.cron_max_gas_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.cron_max_failures":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.publish_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.move.v1.Params.publish_deposit":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Params.publish_quota":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.publish_quota_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.authenticator_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.sponsor_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.voting_power_twap_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.Params.max_voting_power_weight_change_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Params"))
@@ -426,9 +616,6 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
-		if x.ArbitraryEnabled {
-			n += 2
-		}
 		l = len(x.ContractSharedRevenueRatio)
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
@@ -439,6 +626,38 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if x.CronMaxGasPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.CronMaxGasPerBlock))
+		}
+		if x.CronMaxFailures != 0 {
+			n += 1 + runtime.Sov(uint64(x.CronMaxFailures))
+		}
+		if x.PublishMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.PublishMode))
+		}
+		l = len(x.PublishDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PublishQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.PublishQuota))
+		}
+		if x.PublishQuotaWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.PublishQuotaWindow))
+		}
+		if x.AuthenticatorGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuthenticatorGasLimit))
+		}
+		if x.SponsorGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.SponsorGasLimit))
+		}
+		if x.VotingPowerTwapWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.VotingPowerTwapWindow))
+		}
+		l = len(x.MaxVotingPowerWeightChangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -468,13 +687,67 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxVotingPowerWeightChangeRate) > 0 {
+			i -= len(x.MaxVotingPowerWeightChangeRate)
+			copy(dAtA[i:], x.MaxVotingPowerWeightChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVotingPowerWeightChangeRate)))
+			i--
+			dAtA[i] = 0x72
+		}
+		if x.VotingPowerTwapWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPowerTwapWindow))
+			i--
+			dAtA[i] = 0x68
+		}
+		if x.SponsorGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SponsorGasLimit))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.AuthenticatorGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuthenticatorGasLimit))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.PublishQuotaWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PublishQuotaWindow))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.PublishQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PublishQuota))
+			i--
+			dAtA[i] = 0x48
+		}
+		if len(x.PublishDeposit) > 0 {
+			i -= len(x.PublishDeposit)
+			copy(dAtA[i:], x.PublishDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublishDeposit)))
+			i--
+			dAtA[i] = 0x42
+		}
+		if x.PublishMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PublishMode))
+			i--
+			dAtA[i] = 0x38
+		}
+		if x.CronMaxFailures != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CronMaxFailures))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CronMaxGasPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CronMaxGasPerBlock))
+			i--
+			dAtA[i] = 0x28
+		}
 		if len(x.AllowedPublishers) > 0 {
 			for iNdEx := len(x.AllowedPublishers) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.AllowedPublishers[iNdEx])
 				copy(dAtA[i:], x.AllowedPublishers[iNdEx])
 				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedPublishers[iNdEx])))
 				i--
-				dAtA[i] = 0x2a
+				dAtA[i] = 0x22
 			}
 		}
 		if len(x.ContractSharedRevenueRatio) > 0 {
@@ -482,17 +755,7 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 			copy(dAtA[i:], x.ContractSharedRevenueRatio)
 			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ContractSharedRevenueRatio)))
 			i--
-			dAtA[i] = 0x22
-		}
-		if x.ArbitraryEnabled {
-			i--
-			if x.ArbitraryEnabled {
-				dAtA[i] = 1
-			} else {
-				dAtA[i] = 0
-			}
-			i--
-			dAtA[i] = 0x18
+			dAtA[i] = 0x1a
 		}
 		if len(x.BaseMinGasPrice) > 0 {
 			i -= len(x.BaseMinGasPrice)
@@ -622,10 +885,10 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				x.BaseMinGasPrice = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
 			case 3:
-				if wireType != 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArbitraryEnabled", wireType)
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractSharedRevenueRatio", wireType)
 				}
-				var v int
+				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
 					if shift >= 64 {
 						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
@@ -635,15 +898,27 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 					}
 					b := dAtA[iNdEx]
 					iNdEx++
-					v |= int(b&0x7F) << shift
+					stringLen |= uint64(b&0x7F) << shift
 					if b < 0x80 {
 						break
 					}
 				}
-				x.ArbitraryEnabled = bool(v != 0)
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ContractSharedRevenueRatio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
 			case 4:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ContractSharedRevenueRatio", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedPublishers", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -671,11 +946,68 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.ContractSharedRevenueRatio = string(dAtA[iNdEx:postIndex])
+				x.AllowedPublishers = append(x.AllowedPublishers, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
 			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronMaxGasPerBlock", wireType)
+				}
+				x.CronMaxGasPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CronMaxGasPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronMaxFailures", wireType)
+				}
+				x.CronMaxFailures = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CronMaxFailures |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishMode", wireType)
+				}
+				x.PublishMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PublishMode |= PublishMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 8:
 				if wireType != 2 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedPublishers", wireType)
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishDeposit", wireType)
 				}
 				var stringLen uint64
 				for shift := uint(0); ; shift += 7 {
@@ -703,86 +1035,233 @@ func (x *fastReflection_Params) ProtoMethods() *protoiface.Methods {
 				if postIndex > l {
 					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
 				}
-				x.AllowedPublishers = append(x.AllowedPublishers, string(dAtA[iNdEx:postIndex]))
+				x.PublishDeposit = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishQuota", wireType)
 				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				x.PublishQuota = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PublishQuota |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishQuotaWindow", wireType)
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				x.PublishQuotaWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PublishQuotaWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
-	return &protoiface.Methods{
-		NoUnkeyedLiterals: struct{}{},
-		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
-		Size:              size,
-		Marshal:           marshal,
-		Unmarshal:         unmarshal,
-		Merge:             nil,
-		CheckInitialized:  nil,
-	}
-}
-
-var (
-	md_RawParams                               protoreflect.MessageDescriptor
-	fd_RawParams_base_denom                    protoreflect.FieldDescriptor
-	fd_RawParams_base_min_gas_price            protoreflect.FieldDescriptor
-	fd_RawParams_contract_shared_revenue_ratio protoreflect.FieldDescriptor
-)
-
-func init() {
-	file_initia_move_v1_types_proto_init()
-	md_RawParams = File_initia_move_v1_types_proto.Messages().ByName("RawParams")
-	fd_RawParams_base_denom = md_RawParams.Fields().ByName("base_denom")
-	fd_RawParams_base_min_gas_price = md_RawParams.Fields().ByName("base_min_gas_price")
-	fd_RawParams_contract_shared_revenue_ratio = md_RawParams.Fields().ByName("contract_shared_revenue_ratio")
-}
-
-var _ protoreflect.Message = (*fastReflection_RawParams)(nil)
-
-type fastReflection_RawParams RawParams
-
-func (x *RawParams) ProtoReflect() protoreflect.Message {
-	return (*fastReflection_RawParams)(x)
-}
-
-func (x *RawParams) slowProtoReflect() protoreflect.Message {
-	mi := &file_initia_move_v1_types_proto_msgTypes[1]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
-}
-
-var _fastReflection_RawParams_messageType fastReflection_RawParams_messageType
-var _ protoreflect.MessageType = fastReflection_RawParams_messageType{}
-
-type fastReflection_RawParams_messageType struct{}
-
-func (x fastReflection_RawParams_messageType) Zero() protoreflect.Message {
-	return (*fastReflection_RawParams)(nil)
-}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorGasLimit", wireType)
+				}
+				x.AuthenticatorGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuthenticatorGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SponsorGasLimit", wireType)
+				}
+				x.SponsorGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SponsorGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTwapWindow", wireType)
+				}
+				x.VotingPowerTwapWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VotingPowerTwapWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 14:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerWeightChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxVotingPowerWeightChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_RawParams                                     protoreflect.MessageDescriptor
+	fd_RawParams_base_denom                          protoreflect.FieldDescriptor
+	fd_RawParams_base_min_gas_price                  protoreflect.FieldDescriptor
+	fd_RawParams_contract_shared_revenue_ratio       protoreflect.FieldDescriptor
+	fd_RawParams_cron_max_gas_per_block              protoreflect.FieldDescriptor
+	fd_RawParams_cron_max_failures                   protoreflect.FieldDescriptor
+	fd_RawParams_publish_mode                        protoreflect.FieldDescriptor
+	fd_RawParams_publish_deposit                     protoreflect.FieldDescriptor
+	fd_RawParams_publish_quota                       protoreflect.FieldDescriptor
+	fd_RawParams_publish_quota_window                protoreflect.FieldDescriptor
+	fd_RawParams_authenticator_gas_limit             protoreflect.FieldDescriptor
+	fd_RawParams_sponsor_gas_limit                   protoreflect.FieldDescriptor
+	fd_RawParams_voting_power_twap_window            protoreflect.FieldDescriptor
+	fd_RawParams_max_voting_power_weight_change_rate protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_RawParams = File_initia_move_v1_types_proto.Messages().ByName("RawParams")
+	fd_RawParams_base_denom = md_RawParams.Fields().ByName("base_denom")
+	fd_RawParams_base_min_gas_price = md_RawParams.Fields().ByName("base_min_gas_price")
+	fd_RawParams_contract_shared_revenue_ratio = md_RawParams.Fields().ByName("contract_shared_revenue_ratio")
+	fd_RawParams_cron_max_gas_per_block = md_RawParams.Fields().ByName("cron_max_gas_per_block")
+	fd_RawParams_cron_max_failures = md_RawParams.Fields().ByName("cron_max_failures")
+	fd_RawParams_publish_mode = md_RawParams.Fields().ByName("publish_mode")
+	fd_RawParams_publish_deposit = md_RawParams.Fields().ByName("publish_deposit")
+	fd_RawParams_publish_quota = md_RawParams.Fields().ByName("publish_quota")
+	fd_RawParams_publish_quota_window = md_RawParams.Fields().ByName("publish_quota_window")
+	fd_RawParams_authenticator_gas_limit = md_RawParams.Fields().ByName("authenticator_gas_limit")
+	fd_RawParams_sponsor_gas_limit = md_RawParams.Fields().ByName("sponsor_gas_limit")
+	fd_RawParams_voting_power_twap_window = md_RawParams.Fields().ByName("voting_power_twap_window")
+	fd_RawParams_max_voting_power_weight_change_rate = md_RawParams.Fields().ByName("max_voting_power_weight_change_rate")
+}
+
+var _ protoreflect.Message = (*fastReflection_RawParams)(nil)
+
+type fastReflection_RawParams RawParams
+
+func (x *RawParams) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_RawParams)(x)
+}
+
+func (x *RawParams) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_RawParams_messageType fastReflection_RawParams_messageType
+var _ protoreflect.MessageType = fastReflection_RawParams_messageType{}
+
+type fastReflection_RawParams_messageType struct{}
+
+func (x fastReflection_RawParams_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_RawParams)(nil)
+}
 func (x fastReflection_RawParams_messageType) New() protoreflect.Message {
 	return new(fastReflection_RawParams)
 }
@@ -838,6 +1317,66 @@ func (x *fastReflection_RawParams) Range(f func(protoreflect.FieldDescriptor, pr
 			return
 		}
 	}
+	if x.CronMaxGasPerBlock != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CronMaxGasPerBlock)
+		if !f(fd_RawParams_cron_max_gas_per_block, value) {
+			return
+		}
+	}
+	if x.CronMaxFailures != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.CronMaxFailures)
+		if !f(fd_RawParams_cron_max_failures, value) {
+			return
+		}
+	}
+	if x.PublishMode != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.PublishMode))
+		if !f(fd_RawParams_publish_mode, value) {
+			return
+		}
+	}
+	if x.PublishDeposit != "" {
+		value := protoreflect.ValueOfString(x.PublishDeposit)
+		if !f(fd_RawParams_publish_deposit, value) {
+			return
+		}
+	}
+	if x.PublishQuota != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PublishQuota)
+		if !f(fd_RawParams_publish_quota, value) {
+			return
+		}
+	}
+	if x.PublishQuotaWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.PublishQuotaWindow)
+		if !f(fd_RawParams_publish_quota_window, value) {
+			return
+		}
+	}
+	if x.AuthenticatorGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.AuthenticatorGasLimit)
+		if !f(fd_RawParams_authenticator_gas_limit, value) {
+			return
+		}
+	}
+	if x.SponsorGasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.SponsorGasLimit)
+		if !f(fd_RawParams_sponsor_gas_limit, value) {
+			return
+		}
+	}
+	if x.VotingPowerTwapWindow != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.VotingPowerTwapWindow)
+		if !f(fd_RawParams_voting_power_twap_window, value) {
+			return
+		}
+	}
+	if x.MaxVotingPowerWeightChangeRate != "" {
+		value := protoreflect.ValueOfString(x.MaxVotingPowerWeightChangeRate)
+		if !f(fd_RawParams_max_voting_power_weight_change_rate, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -859,6 +1398,26 @@ func (x *fastReflection_RawParams) Has(fd protoreflect.FieldDescriptor) bool {
 		return x.BaseMinGasPrice != ""
 	case "initia.move.v1.RawParams.contract_shared_revenue_ratio":
 		return x.ContractSharedRevenueRatio != ""
+	case "initia.move.v1.RawParams.cron_max_gas_per_block":
+		return x.CronMaxGasPerBlock != uint64(0)
+	case "initia.move.v1.RawParams.cron_max_failures":
+		return x.CronMaxFailures != uint64(0)
+	case "initia.move.v1.RawParams.publish_mode":
+		return x.PublishMode != 0
+	case "initia.move.v1.RawParams.publish_deposit":
+		return x.PublishDeposit != ""
+	case "initia.move.v1.RawParams.publish_quota":
+		return x.PublishQuota != uint64(0)
+	case "initia.move.v1.RawParams.publish_quota_window":
+		return x.PublishQuotaWindow != uint64(0)
+	case "initia.move.v1.RawParams.authenticator_gas_limit":
+		return x.AuthenticatorGasLimit != uint64(0)
+	case "initia.move.v1.RawParams.sponsor_gas_limit":
+		return x.SponsorGasLimit != uint64(0)
+	case "initia.move.v1.RawParams.voting_power_twap_window":
+		return x.VotingPowerTwapWindow != uint64(0)
+	case "initia.move.v1.RawParams.max_voting_power_weight_change_rate":
+		return x.MaxVotingPowerWeightChangeRate != ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -881,6 +1440,26 @@ func (x *fastReflection_RawParams) Clear(fd protoreflect.FieldDescriptor) {
 		x.BaseMinGasPrice = ""
 	case "initia.move.v1.RawParams.contract_shared_revenue_ratio":
 		x.ContractSharedRevenueRatio = ""
+	case "initia.move.v1.RawParams.cron_max_gas_per_block":
+		x.CronMaxGasPerBlock = uint64(0)
+	case "initia.move.v1.RawParams.cron_max_failures":
+		x.CronMaxFailures = uint64(0)
+	case "initia.move.v1.RawParams.publish_mode":
+		x.PublishMode = 0
+	case "initia.move.v1.RawParams.publish_deposit":
+		x.PublishDeposit = ""
+	case "initia.move.v1.RawParams.publish_quota":
+		x.PublishQuota = uint64(0)
+	case "initia.move.v1.RawParams.publish_quota_window":
+		x.PublishQuotaWindow = uint64(0)
+	case "initia.move.v1.RawParams.authenticator_gas_limit":
+		x.AuthenticatorGasLimit = uint64(0)
+	case "initia.move.v1.RawParams.sponsor_gas_limit":
+		x.SponsorGasLimit = uint64(0)
+	case "initia.move.v1.RawParams.voting_power_twap_window":
+		x.VotingPowerTwapWindow = uint64(0)
+	case "initia.move.v1.RawParams.max_voting_power_weight_change_rate":
+		x.MaxVotingPowerWeightChangeRate = ""
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -906,6 +1485,36 @@ func (x *fastReflection_RawParams) Get(descriptor protoreflect.FieldDescriptor)
 	case "initia.move.v1.RawParams.contract_shared_revenue_ratio":
 		value := x.ContractSharedRevenueRatio
 		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.RawParams.cron_max_gas_per_block":
+		value := x.CronMaxGasPerBlock
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.cron_max_failures":
+		value := x.CronMaxFailures
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.publish_mode":
+		value := x.PublishMode
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.move.v1.RawParams.publish_deposit":
+		value := x.PublishDeposit
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.RawParams.publish_quota":
+		value := x.PublishQuota
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.publish_quota_window":
+		value := x.PublishQuotaWindow
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.authenticator_gas_limit":
+		value := x.AuthenticatorGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.sponsor_gas_limit":
+		value := x.SponsorGasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.voting_power_twap_window":
+		value := x.VotingPowerTwapWindow
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.RawParams.max_voting_power_weight_change_rate":
+		value := x.MaxVotingPowerWeightChangeRate
+		return protoreflect.ValueOfString(value)
 	default:
 		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -932,6 +1541,26 @@ func (x *fastReflection_RawParams) Set(fd protoreflect.FieldDescriptor, value pr
 		x.BaseMinGasPrice = value.Interface().(string)
 	case "initia.move.v1.RawParams.contract_shared_revenue_ratio":
 		x.ContractSharedRevenueRatio = value.Interface().(string)
+	case "initia.move.v1.RawParams.cron_max_gas_per_block":
+		x.CronMaxGasPerBlock = value.Uint()
+	case "initia.move.v1.RawParams.cron_max_failures":
+		x.CronMaxFailures = value.Uint()
+	case "initia.move.v1.RawParams.publish_mode":
+		x.PublishMode = (PublishMode)(value.Enum())
+	case "initia.move.v1.RawParams.publish_deposit":
+		x.PublishDeposit = value.Interface().(string)
+	case "initia.move.v1.RawParams.publish_quota":
+		x.PublishQuota = value.Uint()
+	case "initia.move.v1.RawParams.publish_quota_window":
+		x.PublishQuotaWindow = value.Uint()
+	case "initia.move.v1.RawParams.authenticator_gas_limit":
+		x.AuthenticatorGasLimit = value.Uint()
+	case "initia.move.v1.RawParams.sponsor_gas_limit":
+		x.SponsorGasLimit = value.Uint()
+	case "initia.move.v1.RawParams.voting_power_twap_window":
+		x.VotingPowerTwapWindow = value.Uint()
+	case "initia.move.v1.RawParams.max_voting_power_weight_change_rate":
+		x.MaxVotingPowerWeightChangeRate = value.Interface().(string)
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -958,6 +1587,26 @@ func (x *fastReflection_RawParams) Mutable(fd protoreflect.FieldDescriptor) prot
 		panic(fmt.Errorf("field base_min_gas_price of message initia.move.v1.RawParams is not mutable"))
 	case "initia.move.v1.RawParams.contract_shared_revenue_ratio":
 		panic(fmt.Errorf("field contract_shared_revenue_ratio of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.cron_max_gas_per_block":
+		panic(fmt.Errorf("field cron_max_gas_per_block of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.cron_max_failures":
+		panic(fmt.Errorf("field cron_max_failures of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.publish_mode":
+		panic(fmt.Errorf("field publish_mode of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.publish_deposit":
+		panic(fmt.Errorf("field publish_deposit of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.publish_quota":
+		panic(fmt.Errorf("field publish_quota of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.publish_quota_window":
+		panic(fmt.Errorf("field publish_quota_window of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.authenticator_gas_limit":
+		panic(fmt.Errorf("field authenticator_gas_limit of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.sponsor_gas_limit":
+		panic(fmt.Errorf("field sponsor_gas_limit of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.voting_power_twap_window":
+		panic(fmt.Errorf("field voting_power_twap_window of message initia.move.v1.RawParams is not mutable"))
+	case "initia.move.v1.RawParams.max_voting_power_weight_change_rate":
+		panic(fmt.Errorf("field max_voting_power_weight_change_rate of message initia.move.v1.RawParams is not mutable"))
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -977,6 +1626,26 @@ func (x *fastReflection_RawParams) NewField(fd protoreflect.FieldDescriptor) pro
 		return protoreflect.ValueOfString("")
 	case "initia.move.v1.RawParams.contract_shared_revenue_ratio":
 		return protoreflect.ValueOfString("")
+	case "initia.move.v1.RawParams.cron_max_gas_per_block":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.cron_max_failures":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.publish_mode":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.move.v1.RawParams.publish_deposit":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.RawParams.publish_quota":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.publish_quota_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.authenticator_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.sponsor_gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.voting_power_twap_window":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.RawParams.max_voting_power_weight_change_rate":
+		return protoreflect.ValueOfString("")
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.RawParams"))
@@ -1058,6 +1727,38 @@ func (x *fastReflection_RawParams) ProtoMethods() *protoiface.Methods {
 		if l > 0 {
 			n += 1 + l + runtime.Sov(uint64(l))
 		}
+		if x.CronMaxGasPerBlock != 0 {
+			n += 1 + runtime.Sov(uint64(x.CronMaxGasPerBlock))
+		}
+		if x.CronMaxFailures != 0 {
+			n += 1 + runtime.Sov(uint64(x.CronMaxFailures))
+		}
+		if x.PublishMode != 0 {
+			n += 1 + runtime.Sov(uint64(x.PublishMode))
+		}
+		l = len(x.PublishDeposit)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.PublishQuota != 0 {
+			n += 1 + runtime.Sov(uint64(x.PublishQuota))
+		}
+		if x.PublishQuotaWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.PublishQuotaWindow))
+		}
+		if x.AuthenticatorGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.AuthenticatorGasLimit))
+		}
+		if x.SponsorGasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.SponsorGasLimit))
+		}
+		if x.VotingPowerTwapWindow != 0 {
+			n += 1 + runtime.Sov(uint64(x.VotingPowerTwapWindow))
+		}
+		l = len(x.MaxVotingPowerWeightChangeRate)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -1087,6 +1788,60 @@ func (x *fastReflection_RawParams) ProtoMethods() *protoiface.Methods {
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.MaxVotingPowerWeightChangeRate) > 0 {
+			i -= len(x.MaxVotingPowerWeightChangeRate)
+			copy(dAtA[i:], x.MaxVotingPowerWeightChangeRate)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxVotingPowerWeightChangeRate)))
+			i--
+			dAtA[i] = 0x6a
+		}
+		if x.VotingPowerTwapWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.VotingPowerTwapWindow))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.SponsorGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.SponsorGasLimit))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.AuthenticatorGasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.AuthenticatorGasLimit))
+			i--
+			dAtA[i] = 0x50
+		}
+		if x.PublishQuotaWindow != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PublishQuotaWindow))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.PublishQuota != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PublishQuota))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.PublishDeposit) > 0 {
+			i -= len(x.PublishDeposit)
+			copy(dAtA[i:], x.PublishDeposit)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.PublishDeposit)))
+			i--
+			dAtA[i] = 0x3a
+		}
+		if x.PublishMode != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.PublishMode))
+			i--
+			dAtA[i] = 0x30
+		}
+		if x.CronMaxFailures != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CronMaxFailures))
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.CronMaxGasPerBlock != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.CronMaxGasPerBlock))
+			i--
+			dAtA[i] = 0x20
+		}
 		if len(x.ContractSharedRevenueRatio) > 0 {
 			i -= len(x.ContractSharedRevenueRatio)
 			copy(dAtA[i:], x.ContractSharedRevenueRatio)
@@ -1253,30 +2008,246 @@ func (x *fastReflection_RawParams) ProtoMethods() *protoiface.Methods {
 				}
 				x.ContractSharedRevenueRatio = string(dAtA[iNdEx:postIndex])
 				iNdEx = postIndex
-			default:
-				iNdEx = preIndex
-				skippy, err := runtime.Skip(dAtA[iNdEx:])
-				if err != nil {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
-				}
-				if (skippy < 0) || (iNdEx+skippy) < 0 {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronMaxGasPerBlock", wireType)
 				}
-				if (iNdEx + skippy) > l {
-					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				x.CronMaxGasPerBlock = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CronMaxGasPerBlock |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if !options.DiscardUnknown {
-					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field CronMaxFailures", wireType)
 				}
-				iNdEx += skippy
-			}
-		}
-
-		if iNdEx > l {
-			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
-		}
-		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
-	}
+				x.CronMaxFailures = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.CronMaxFailures |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 6:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishMode", wireType)
+				}
+				x.PublishMode = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PublishMode |= PublishMode(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishDeposit", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.PublishDeposit = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishQuota", wireType)
+				}
+				x.PublishQuota = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PublishQuota |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field PublishQuotaWindow", wireType)
+				}
+				x.PublishQuotaWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.PublishQuotaWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorGasLimit", wireType)
+				}
+				x.AuthenticatorGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.AuthenticatorGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field SponsorGasLimit", wireType)
+				}
+				x.SponsorGasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.SponsorGasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTwapWindow", wireType)
+				}
+				x.VotingPowerTwapWindow = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.VotingPowerTwapWindow |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 13:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerWeightChangeRate", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxVotingPowerWeightChangeRate = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
 	return &protoiface.Methods{
 		NoUnkeyedLiterals: struct{}{},
 		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
@@ -4726,11 +5697,110 @@ func (x *_ExecuteAuthorizationItem_3_list) IsValid() bool {
 	return x.list != nil
 }
 
+var _ protoreflect.List = (*_ExecuteAuthorizationItem_4_list)(nil)
+
+type _ExecuteAuthorizationItem_4_list struct {
+	list *[]string
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ExecuteAuthorizationItem at list field TypeArgs as it is not of Message kind"))
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ExecuteAuthorizationItem_4_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ExecuteAuthorizationItem_5_list)(nil)
+
+type _ExecuteAuthorizationItem_5_list struct {
+	list *[]*ArgumentConstraint
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ArgumentConstraint)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ArgumentConstraint)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) AppendMutable() protoreflect.Value {
+	v := new(ArgumentConstraint)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) NewElement() protoreflect.Value {
+	v := new(ArgumentConstraint)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ExecuteAuthorizationItem_5_list) IsValid() bool {
+	return x.list != nil
+}
+
 var (
-	md_ExecuteAuthorizationItem                protoreflect.MessageDescriptor
-	fd_ExecuteAuthorizationItem_module_address protoreflect.FieldDescriptor
-	fd_ExecuteAuthorizationItem_module_name    protoreflect.FieldDescriptor
-	fd_ExecuteAuthorizationItem_function_names protoreflect.FieldDescriptor
+	md_ExecuteAuthorizationItem                 protoreflect.MessageDescriptor
+	fd_ExecuteAuthorizationItem_module_address  protoreflect.FieldDescriptor
+	fd_ExecuteAuthorizationItem_module_name     protoreflect.FieldDescriptor
+	fd_ExecuteAuthorizationItem_function_names  protoreflect.FieldDescriptor
+	fd_ExecuteAuthorizationItem_type_args       protoreflect.FieldDescriptor
+	fd_ExecuteAuthorizationItem_arg_constraints protoreflect.FieldDescriptor
 )
 
 func init() {
@@ -4739,6 +5809,8 @@ func init() {
 	fd_ExecuteAuthorizationItem_module_address = md_ExecuteAuthorizationItem.Fields().ByName("module_address")
 	fd_ExecuteAuthorizationItem_module_name = md_ExecuteAuthorizationItem.Fields().ByName("module_name")
 	fd_ExecuteAuthorizationItem_function_names = md_ExecuteAuthorizationItem.Fields().ByName("function_names")
+	fd_ExecuteAuthorizationItem_type_args = md_ExecuteAuthorizationItem.Fields().ByName("type_args")
+	fd_ExecuteAuthorizationItem_arg_constraints = md_ExecuteAuthorizationItem.Fields().ByName("arg_constraints")
 }
 
 var _ protoreflect.Message = (*fastReflection_ExecuteAuthorizationItem)(nil)
@@ -4824,6 +5896,18 @@ func (x *fastReflection_ExecuteAuthorizationItem) Range(f func(protoreflect.Fiel
 			return
 		}
 	}
+	if len(x.TypeArgs) != 0 {
+		value := protoreflect.ValueOfList(&_ExecuteAuthorizationItem_4_list{list: &x.TypeArgs})
+		if !f(fd_ExecuteAuthorizationItem_type_args, value) {
+			return
+		}
+	}
+	if len(x.ArgConstraints) != 0 {
+		value := protoreflect.ValueOfList(&_ExecuteAuthorizationItem_5_list{list: &x.ArgConstraints})
+		if !f(fd_ExecuteAuthorizationItem_arg_constraints, value) {
+			return
+		}
+	}
 }
 
 // Has reports whether a field is populated.
@@ -4845,6 +5929,10 @@ func (x *fastReflection_ExecuteAuthorizationItem) Has(fd protoreflect.FieldDescr
 		return x.ModuleName != ""
 	case "initia.move.v1.ExecuteAuthorizationItem.function_names":
 		return len(x.FunctionNames) != 0
+	case "initia.move.v1.ExecuteAuthorizationItem.type_args":
+		return len(x.TypeArgs) != 0
+	case "initia.move.v1.ExecuteAuthorizationItem.arg_constraints":
+		return len(x.ArgConstraints) != 0
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorizationItem"))
@@ -4867,6 +5955,10 @@ func (x *fastReflection_ExecuteAuthorizationItem) Clear(fd protoreflect.FieldDes
 		x.ModuleName = ""
 	case "initia.move.v1.ExecuteAuthorizationItem.function_names":
 		x.FunctionNames = nil
+	case "initia.move.v1.ExecuteAuthorizationItem.type_args":
+		x.TypeArgs = nil
+	case "initia.move.v1.ExecuteAuthorizationItem.arg_constraints":
+		x.ArgConstraints = nil
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorizationItem"))
@@ -4895,8 +5987,20 @@ func (x *fastReflection_ExecuteAuthorizationItem) Get(descriptor protoreflect.Fi
 		}
 		listValue := &_ExecuteAuthorizationItem_3_list{list: &x.FunctionNames}
 		return protoreflect.ValueOfList(listValue)
-	default:
-		if descriptor.IsExtension() {
+	case "initia.move.v1.ExecuteAuthorizationItem.type_args":
+		if len(x.TypeArgs) == 0 {
+			return protoreflect.ValueOfList(&_ExecuteAuthorizationItem_4_list{})
+		}
+		listValue := &_ExecuteAuthorizationItem_4_list{list: &x.TypeArgs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ExecuteAuthorizationItem.arg_constraints":
+		if len(x.ArgConstraints) == 0 {
+			return protoreflect.ValueOfList(&_ExecuteAuthorizationItem_5_list{})
+		}
+		listValue := &_ExecuteAuthorizationItem_5_list{list: &x.ArgConstraints}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorizationItem"))
 		}
 		panic(fmt.Errorf("message initia.move.v1.ExecuteAuthorizationItem does not contain field %s", descriptor.FullName()))
@@ -4923,6 +6027,14 @@ func (x *fastReflection_ExecuteAuthorizationItem) Set(fd protoreflect.FieldDescr
 		lv := value.List()
 		clv := lv.(*_ExecuteAuthorizationItem_3_list)
 		x.FunctionNames = *clv.list
+	case "initia.move.v1.ExecuteAuthorizationItem.type_args":
+		lv := value.List()
+		clv := lv.(*_ExecuteAuthorizationItem_4_list)
+		x.TypeArgs = *clv.list
+	case "initia.move.v1.ExecuteAuthorizationItem.arg_constraints":
+		lv := value.List()
+		clv := lv.(*_ExecuteAuthorizationItem_5_list)
+		x.ArgConstraints = *clv.list
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorizationItem"))
@@ -4949,6 +6061,18 @@ func (x *fastReflection_ExecuteAuthorizationItem) Mutable(fd protoreflect.FieldD
 		}
 		value := &_ExecuteAuthorizationItem_3_list{list: &x.FunctionNames}
 		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ExecuteAuthorizationItem.type_args":
+		if x.TypeArgs == nil {
+			x.TypeArgs = []string{}
+		}
+		value := &_ExecuteAuthorizationItem_4_list{list: &x.TypeArgs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ExecuteAuthorizationItem.arg_constraints":
+		if x.ArgConstraints == nil {
+			x.ArgConstraints = []*ArgumentConstraint{}
+		}
+		value := &_ExecuteAuthorizationItem_5_list{list: &x.ArgConstraints}
+		return protoreflect.ValueOfList(value)
 	case "initia.move.v1.ExecuteAuthorizationItem.module_address":
 		panic(fmt.Errorf("field module_address of message initia.move.v1.ExecuteAuthorizationItem is not mutable"))
 	case "initia.move.v1.ExecuteAuthorizationItem.module_name":
@@ -4973,6 +6097,12 @@ func (x *fastReflection_ExecuteAuthorizationItem) NewField(fd protoreflect.Field
 	case "initia.move.v1.ExecuteAuthorizationItem.function_names":
 		list := []string{}
 		return protoreflect.ValueOfList(&_ExecuteAuthorizationItem_3_list{list: &list})
+	case "initia.move.v1.ExecuteAuthorizationItem.type_args":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ExecuteAuthorizationItem_4_list{list: &list})
+	case "initia.move.v1.ExecuteAuthorizationItem.arg_constraints":
+		list := []*ArgumentConstraint{}
+		return protoreflect.ValueOfList(&_ExecuteAuthorizationItem_5_list{list: &list})
 	default:
 		if fd.IsExtension() {
 			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ExecuteAuthorizationItem"))
@@ -5056,6 +6186,18 @@ func (x *fastReflection_ExecuteAuthorizationItem) ProtoMethods() *protoiface.Met
 				n += 1 + l + runtime.Sov(uint64(l))
 			}
 		}
+		if len(x.TypeArgs) > 0 {
+			for _, s := range x.TypeArgs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ArgConstraints) > 0 {
+			for _, e := range x.ArgConstraints {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
 		if x.unknownFields != nil {
 			n += len(x.unknownFields)
 		}
@@ -5085,6 +6227,31 @@ func (x *fastReflection_ExecuteAuthorizationItem) ProtoMethods() *protoiface.Met
 			i -= len(x.unknownFields)
 			copy(dAtA[i:], x.unknownFields)
 		}
+		if len(x.ArgConstraints) > 0 {
+			for iNdEx := len(x.ArgConstraints) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.ArgConstraints[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x2a
+			}
+		}
+		if len(x.TypeArgs) > 0 {
+			for iNdEx := len(x.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TypeArgs[iNdEx])
+				copy(dAtA[i:], x.TypeArgs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypeArgs[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
 		if len(x.FunctionNames) > 0 {
 			for iNdEx := len(x.FunctionNames) - 1; iNdEx >= 0; iNdEx-- {
 				i -= len(x.FunctionNames[iNdEx])
@@ -5253,6 +6420,72 @@ func (x *fastReflection_ExecuteAuthorizationItem) ProtoMethods() *protoiface.Met
 				}
 				x.FunctionNames = append(x.FunctionNames, string(dAtA[iNdEx:postIndex]))
 				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypeArgs = append(x.TypeArgs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ArgConstraints", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ArgConstraints = append(x.ArgConstraints, &ArgumentConstraint{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.ArgConstraints[len(x.ArgConstraints)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
 			default:
 				iNdEx = preIndex
 				skippy, err := runtime.Skip(dAtA[iNdEx:])
@@ -5288,201 +6521,12151 @@ func (x *fastReflection_ExecuteAuthorizationItem) ProtoMethods() *protoiface.Met
 	}
 }
 
-// Code generated by protoc-gen-go. DO NOT EDIT.
-// versions:
-// 	protoc-gen-go v1.27.0
-// 	protoc        (unknown)
-// source: initia/move/v1/types.proto
-
-const (
-	// Verify that this generated code is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
-	// Verify that runtime/protoimpl is sufficiently up-to-date.
-	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
-)
-
-// UpgradePolicy
-//   - `arbitrary`
-//     Whether unconditional code upgrade with no compatibility check is allowed. This
-//     publication mode should only be used for modules which aren't shared with user others.
-//     The developer is responsible for not breaking memory layout of any resources he already
-//     stored on chain.
-//   - `compatible`
-//     Whether a compatibility check should be performed for upgrades. The check only passes if
-//     a new module has (a) the same public functions (b) for existing resources, no layout change.
-//   - `immutable`
-//     Whether the modules in the package are immutable and cannot be upgraded.
-type UpgradePolicy int32
-
-const (
-	UpgradePolicy_ARBITRARY  UpgradePolicy = 0
-	UpgradePolicy_COMPATIBLE UpgradePolicy = 1
-	UpgradePolicy_IMMUTABLE  UpgradePolicy = 2
-)
-
-// Enum value maps for UpgradePolicy.
-var (
-	UpgradePolicy_name = map[int32]string{
-		0: "ARBITRARY",
-		1: "COMPATIBLE",
-		2: "IMMUTABLE",
-	}
-	UpgradePolicy_value = map[string]int32{
-		"ARBITRARY":  0,
-		"COMPATIBLE": 1,
-		"IMMUTABLE":  2,
-	}
-)
+var _ protoreflect.List = (*_ArgumentConstraint_4_list)(nil)
 
-func (x UpgradePolicy) Enum() *UpgradePolicy {
-	p := new(UpgradePolicy)
-	*p = x
-	return p
+type _ArgumentConstraint_4_list struct {
+	list *[]string
 }
 
-func (x UpgradePolicy) String() string {
-	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+func (x *_ArgumentConstraint_4_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
 }
 
-func (UpgradePolicy) Descriptor() protoreflect.EnumDescriptor {
-	return file_initia_move_v1_types_proto_enumTypes[0].Descriptor()
+func (x *_ArgumentConstraint_4_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
 }
 
-func (UpgradePolicy) Type() protoreflect.EnumType {
-	return &file_initia_move_v1_types_proto_enumTypes[0]
+func (x *_ArgumentConstraint_4_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
 }
 
-func (x UpgradePolicy) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *_ArgumentConstraint_4_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
 }
 
-// Deprecated: Use UpgradePolicy.Descriptor instead.
-func (UpgradePolicy) EnumDescriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+func (x *_ArgumentConstraint_4_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ArgumentConstraint at list field AllowedAddresses as it is not of Message kind"))
 }
 
-// Params defines the set of move parameters.
-type Params struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	BaseDenom        string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	BaseMinGasPrice  string `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3" json:"base_min_gas_price,omitempty"`
-	ArbitraryEnabled bool   `protobuf:"varint,3,opt,name=arbitrary_enabled,json=arbitraryEnabled,proto3" json:"arbitrary_enabled,omitempty"`
-	// CSR: Percentage of fees distributed to developers
-	ContractSharedRevenueRatio string `protobuf:"bytes,4,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3" json:"contract_shared_revenue_ratio,omitempty"`
-	// It is a list of addresses with permission to distribute contracts,
-	// and an empty list is interpreted as allowing anyone to distribute.
-	AllowedPublishers []string `protobuf:"bytes,5,rep,name=allowed_publishers,json=allowedPublishers,proto3" json:"allowed_publishers,omitempty"`
+func (x *_ArgumentConstraint_4_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
 }
 
-func (x *Params) Reset() {
-	*x = Params{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[0]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x *_ArgumentConstraint_4_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
 }
 
-func (x *Params) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *_ArgumentConstraint_4_list) IsValid() bool {
+	return x.list != nil
 }
 
-func (*Params) ProtoMessage() {}
+var (
+	md_ArgumentConstraint                   protoreflect.MessageDescriptor
+	fd_ArgumentConstraint_index             protoreflect.FieldDescriptor
+	fd_ArgumentConstraint_equals            protoreflect.FieldDescriptor
+	fd_ArgumentConstraint_max_amount        protoreflect.FieldDescriptor
+	fd_ArgumentConstraint_allowed_addresses protoreflect.FieldDescriptor
+)
 
-// Deprecated: Use Params.ProtoReflect.Descriptor instead.
-func (*Params) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ArgumentConstraint = File_initia_move_v1_types_proto.Messages().ByName("ArgumentConstraint")
+	fd_ArgumentConstraint_index = md_ArgumentConstraint.Fields().ByName("index")
+	fd_ArgumentConstraint_equals = md_ArgumentConstraint.Fields().ByName("equals")
+	fd_ArgumentConstraint_max_amount = md_ArgumentConstraint.Fields().ByName("max_amount")
+	fd_ArgumentConstraint_allowed_addresses = md_ArgumentConstraint.Fields().ByName("allowed_addresses")
 }
 
-func (x *Params) GetBaseDenom() string {
-	if x != nil {
-		return x.BaseDenom
-	}
-	return ""
-}
+var _ protoreflect.Message = (*fastReflection_ArgumentConstraint)(nil)
 
-func (x *Params) GetBaseMinGasPrice() string {
-	if x != nil {
-		return x.BaseMinGasPrice
-	}
-	return ""
-}
+type fastReflection_ArgumentConstraint ArgumentConstraint
 
-func (x *Params) GetArbitraryEnabled() bool {
-	if x != nil {
-		return x.ArbitraryEnabled
-	}
-	return false
+func (x *ArgumentConstraint) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ArgumentConstraint)(x)
 }
 
-func (x *Params) GetContractSharedRevenueRatio() string {
-	if x != nil {
-		return x.ContractSharedRevenueRatio
+func (x *ArgumentConstraint) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Params) GetAllowedPublishers() []string {
-	if x != nil {
-		return x.AllowedPublishers
-	}
-	return nil
-}
+var _fastReflection_ArgumentConstraint_messageType fastReflection_ArgumentConstraint_messageType
+var _ protoreflect.MessageType = fastReflection_ArgumentConstraint_messageType{}
 
-// RawParams defines the raw params to store.
-type RawParams struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+type fastReflection_ArgumentConstraint_messageType struct{}
 
-	BaseDenom       string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	BaseMinGasPrice string `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3" json:"base_min_gas_price,omitempty"`
-	// CSR: Percentage of fees distributed to developers
-	ContractSharedRevenueRatio string `protobuf:"bytes,3,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3" json:"contract_shared_revenue_ratio,omitempty"`
+func (x fastReflection_ArgumentConstraint_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ArgumentConstraint)(nil)
 }
-
-func (x *RawParams) Reset() {
-	*x = RawParams{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[1]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+func (x fastReflection_ArgumentConstraint_messageType) New() protoreflect.Message {
+	return new(fastReflection_ArgumentConstraint)
 }
-
-func (x *RawParams) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x fastReflection_ArgumentConstraint_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ArgumentConstraint
 }
 
-func (*RawParams) ProtoMessage() {}
-
-// Deprecated: Use RawParams.ProtoReflect.Descriptor instead.
-func (*RawParams) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{1}
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ArgumentConstraint) Descriptor() protoreflect.MessageDescriptor {
+	return md_ArgumentConstraint
 }
 
-func (x *RawParams) GetBaseDenom() string {
-	if x != nil {
-		return x.BaseDenom
-	}
-	return ""
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ArgumentConstraint) Type() protoreflect.MessageType {
+	return _fastReflection_ArgumentConstraint_messageType
 }
 
-func (x *RawParams) GetBaseMinGasPrice() string {
-	if x != nil {
-		return x.BaseMinGasPrice
-	}
-	return ""
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ArgumentConstraint) New() protoreflect.Message {
+	return new(fastReflection_ArgumentConstraint)
 }
 
-func (x *RawParams) GetContractSharedRevenueRatio() string {
-	if x != nil {
-		return x.ContractSharedRevenueRatio
-	}
-	return ""
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ArgumentConstraint) Interface() protoreflect.ProtoMessage {
+	return (*ArgumentConstraint)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ArgumentConstraint) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Index != uint32(0) {
+		value := protoreflect.ValueOfUint32(x.Index)
+		if !f(fd_ArgumentConstraint_index, value) {
+			return
+		}
+	}
+	if len(x.Equals) != 0 {
+		value := protoreflect.ValueOfBytes(x.Equals)
+		if !f(fd_ArgumentConstraint_equals, value) {
+			return
+		}
+	}
+	if x.MaxAmount != "" {
+		value := protoreflect.ValueOfString(x.MaxAmount)
+		if !f(fd_ArgumentConstraint_max_amount, value) {
+			return
+		}
+	}
+	if len(x.AllowedAddresses) != 0 {
+		value := protoreflect.ValueOfList(&_ArgumentConstraint_4_list{list: &x.AllowedAddresses})
+		if !f(fd_ArgumentConstraint_allowed_addresses, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ArgumentConstraint) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ArgumentConstraint.index":
+		return x.Index != uint32(0)
+	case "initia.move.v1.ArgumentConstraint.equals":
+		return len(x.Equals) != 0
+	case "initia.move.v1.ArgumentConstraint.max_amount":
+		return x.MaxAmount != ""
+	case "initia.move.v1.ArgumentConstraint.allowed_addresses":
+		return len(x.AllowedAddresses) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ArgumentConstraint"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArgumentConstraint) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ArgumentConstraint.index":
+		x.Index = uint32(0)
+	case "initia.move.v1.ArgumentConstraint.equals":
+		x.Equals = nil
+	case "initia.move.v1.ArgumentConstraint.max_amount":
+		x.MaxAmount = ""
+	case "initia.move.v1.ArgumentConstraint.allowed_addresses":
+		x.AllowedAddresses = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ArgumentConstraint"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ArgumentConstraint) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ArgumentConstraint.index":
+		value := x.Index
+		return protoreflect.ValueOfUint32(value)
+	case "initia.move.v1.ArgumentConstraint.equals":
+		value := x.Equals
+		return protoreflect.ValueOfBytes(value)
+	case "initia.move.v1.ArgumentConstraint.max_amount":
+		value := x.MaxAmount
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ArgumentConstraint.allowed_addresses":
+		if len(x.AllowedAddresses) == 0 {
+			return protoreflect.ValueOfList(&_ArgumentConstraint_4_list{})
+		}
+		listValue := &_ArgumentConstraint_4_list{list: &x.AllowedAddresses}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ArgumentConstraint"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ArgumentConstraint does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArgumentConstraint) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ArgumentConstraint.index":
+		x.Index = uint32(value.Uint())
+	case "initia.move.v1.ArgumentConstraint.equals":
+		x.Equals = value.Bytes()
+	case "initia.move.v1.ArgumentConstraint.max_amount":
+		x.MaxAmount = value.Interface().(string)
+	case "initia.move.v1.ArgumentConstraint.allowed_addresses":
+		lv := value.List()
+		clv := lv.(*_ArgumentConstraint_4_list)
+		x.AllowedAddresses = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ArgumentConstraint"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArgumentConstraint) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ArgumentConstraint.allowed_addresses":
+		if x.AllowedAddresses == nil {
+			x.AllowedAddresses = []string{}
+		}
+		value := &_ArgumentConstraint_4_list{list: &x.AllowedAddresses}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ArgumentConstraint.index":
+		panic(fmt.Errorf("field index of message initia.move.v1.ArgumentConstraint is not mutable"))
+	case "initia.move.v1.ArgumentConstraint.equals":
+		panic(fmt.Errorf("field equals of message initia.move.v1.ArgumentConstraint is not mutable"))
+	case "initia.move.v1.ArgumentConstraint.max_amount":
+		panic(fmt.Errorf("field max_amount of message initia.move.v1.ArgumentConstraint is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ArgumentConstraint"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ArgumentConstraint) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ArgumentConstraint.index":
+		return protoreflect.ValueOfUint32(uint32(0))
+	case "initia.move.v1.ArgumentConstraint.equals":
+		return protoreflect.ValueOfBytes(nil)
+	case "initia.move.v1.ArgumentConstraint.max_amount":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ArgumentConstraint.allowed_addresses":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ArgumentConstraint_4_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ArgumentConstraint"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ArgumentConstraint does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ArgumentConstraint) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ArgumentConstraint", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ArgumentConstraint) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ArgumentConstraint) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ArgumentConstraint) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ArgumentConstraint) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ArgumentConstraint)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Index != 0 {
+			n += 1 + runtime.Sov(uint64(x.Index))
+		}
+		l = len(x.Equals)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.MaxAmount)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.AllowedAddresses) > 0 {
+			for _, s := range x.AllowedAddresses {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ArgumentConstraint)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.AllowedAddresses) > 0 {
+			for iNdEx := len(x.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.AllowedAddresses[iNdEx])
+				copy(dAtA[i:], x.AllowedAddresses[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.AllowedAddresses[iNdEx])))
+				i--
+				dAtA[i] = 0x22
+			}
+		}
+		if len(x.MaxAmount) > 0 {
+			i -= len(x.MaxAmount)
+			copy(dAtA[i:], x.MaxAmount)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.MaxAmount)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Equals) > 0 {
+			i -= len(x.Equals)
+			copy(dAtA[i:], x.Equals)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Equals)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Index != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Index))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ArgumentConstraint)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ArgumentConstraint: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ArgumentConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+				}
+				x.Index = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Index |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Equals = append(x.Equals[:0], dAtA[iNdEx:postIndex]...)
+				if x.Equals == nil {
+					x.Equals = []byte{}
+				}
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.MaxAmount = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.AllowedAddresses = append(x.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ModuleRevenueRecipient                protoreflect.MessageDescriptor
+	fd_ModuleRevenueRecipient_module_address protoreflect.FieldDescriptor
+	fd_ModuleRevenueRecipient_module_name    protoreflect.FieldDescriptor
+	fd_ModuleRevenueRecipient_recipient      protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ModuleRevenueRecipient = File_initia_move_v1_types_proto.Messages().ByName("ModuleRevenueRecipient")
+	fd_ModuleRevenueRecipient_module_address = md_ModuleRevenueRecipient.Fields().ByName("module_address")
+	fd_ModuleRevenueRecipient_module_name = md_ModuleRevenueRecipient.Fields().ByName("module_name")
+	fd_ModuleRevenueRecipient_recipient = md_ModuleRevenueRecipient.Fields().ByName("recipient")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleRevenueRecipient)(nil)
+
+type fastReflection_ModuleRevenueRecipient ModuleRevenueRecipient
+
+func (x *ModuleRevenueRecipient) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleRevenueRecipient)(x)
+}
+
+func (x *ModuleRevenueRecipient) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleRevenueRecipient_messageType fastReflection_ModuleRevenueRecipient_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleRevenueRecipient_messageType{}
+
+type fastReflection_ModuleRevenueRecipient_messageType struct{}
+
+func (x fastReflection_ModuleRevenueRecipient_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleRevenueRecipient)(nil)
+}
+func (x fastReflection_ModuleRevenueRecipient_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleRevenueRecipient)
+}
+func (x fastReflection_ModuleRevenueRecipient_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleRevenueRecipient
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleRevenueRecipient) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleRevenueRecipient
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleRevenueRecipient) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleRevenueRecipient_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleRevenueRecipient) New() protoreflect.Message {
+	return new(fastReflection_ModuleRevenueRecipient)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleRevenueRecipient) Interface() protoreflect.ProtoMessage {
+	return (*ModuleRevenueRecipient)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleRevenueRecipient) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_ModuleRevenueRecipient_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_ModuleRevenueRecipient_module_name, value) {
+			return
+		}
+	}
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_ModuleRevenueRecipient_recipient, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleRevenueRecipient) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRecipient.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.ModuleRevenueRecipient.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.ModuleRevenueRecipient.recipient":
+		return x.Recipient != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRecipient"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRecipient) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRecipient.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.ModuleRevenueRecipient.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.ModuleRevenueRecipient.recipient":
+		x.Recipient = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRecipient"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleRevenueRecipient) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ModuleRevenueRecipient.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleRevenueRecipient.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleRevenueRecipient.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRecipient"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRecipient does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRecipient) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRecipient.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.ModuleRevenueRecipient.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.ModuleRevenueRecipient.recipient":
+		x.Recipient = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRecipient"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRecipient) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRecipient.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.ModuleRevenueRecipient is not mutable"))
+	case "initia.move.v1.ModuleRevenueRecipient.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.ModuleRevenueRecipient is not mutable"))
+	case "initia.move.v1.ModuleRevenueRecipient.recipient":
+		panic(fmt.Errorf("field recipient of message initia.move.v1.ModuleRevenueRecipient is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRecipient"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleRevenueRecipient) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRecipient.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleRevenueRecipient.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleRevenueRecipient.recipient":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRecipient"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRecipient does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleRevenueRecipient) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ModuleRevenueRecipient", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleRevenueRecipient) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRecipient) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleRevenueRecipient) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleRevenueRecipient) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleRevenueRecipient)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleRevenueRecipient)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleRevenueRecipient)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleRevenueRecipient: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleRevenueRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ModuleRevenueRatio                protoreflect.MessageDescriptor
+	fd_ModuleRevenueRatio_module_address protoreflect.FieldDescriptor
+	fd_ModuleRevenueRatio_module_name    protoreflect.FieldDescriptor
+	fd_ModuleRevenueRatio_ratio          protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ModuleRevenueRatio = File_initia_move_v1_types_proto.Messages().ByName("ModuleRevenueRatio")
+	fd_ModuleRevenueRatio_module_address = md_ModuleRevenueRatio.Fields().ByName("module_address")
+	fd_ModuleRevenueRatio_module_name = md_ModuleRevenueRatio.Fields().ByName("module_name")
+	fd_ModuleRevenueRatio_ratio = md_ModuleRevenueRatio.Fields().ByName("ratio")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleRevenueRatio)(nil)
+
+type fastReflection_ModuleRevenueRatio ModuleRevenueRatio
+
+func (x *ModuleRevenueRatio) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleRevenueRatio)(x)
+}
+
+func (x *ModuleRevenueRatio) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleRevenueRatio_messageType fastReflection_ModuleRevenueRatio_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleRevenueRatio_messageType{}
+
+type fastReflection_ModuleRevenueRatio_messageType struct{}
+
+func (x fastReflection_ModuleRevenueRatio_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleRevenueRatio)(nil)
+}
+func (x fastReflection_ModuleRevenueRatio_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleRevenueRatio)
+}
+func (x fastReflection_ModuleRevenueRatio_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleRevenueRatio
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleRevenueRatio) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleRevenueRatio
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleRevenueRatio) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleRevenueRatio_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleRevenueRatio) New() protoreflect.Message {
+	return new(fastReflection_ModuleRevenueRatio)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleRevenueRatio) Interface() protoreflect.ProtoMessage {
+	return (*ModuleRevenueRatio)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleRevenueRatio) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_ModuleRevenueRatio_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_ModuleRevenueRatio_module_name, value) {
+			return
+		}
+	}
+	if x.Ratio != "" {
+		value := protoreflect.ValueOfString(x.Ratio)
+		if !f(fd_ModuleRevenueRatio_ratio, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleRevenueRatio) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRatio.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.ModuleRevenueRatio.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.ModuleRevenueRatio.ratio":
+		return x.Ratio != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRatio"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRatio does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRatio) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRatio.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.ModuleRevenueRatio.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.ModuleRevenueRatio.ratio":
+		x.Ratio = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRatio"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRatio does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleRevenueRatio) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ModuleRevenueRatio.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleRevenueRatio.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleRevenueRatio.ratio":
+		value := x.Ratio
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRatio"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRatio does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRatio) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRatio.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.ModuleRevenueRatio.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.ModuleRevenueRatio.ratio":
+		x.Ratio = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRatio"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRatio does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRatio) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRatio.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.ModuleRevenueRatio is not mutable"))
+	case "initia.move.v1.ModuleRevenueRatio.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.ModuleRevenueRatio is not mutable"))
+	case "initia.move.v1.ModuleRevenueRatio.ratio":
+		panic(fmt.Errorf("field ratio of message initia.move.v1.ModuleRevenueRatio is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRatio"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRatio does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleRevenueRatio) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenueRatio.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleRevenueRatio.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleRevenueRatio.ratio":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenueRatio"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenueRatio does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleRevenueRatio) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ModuleRevenueRatio", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleRevenueRatio) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenueRatio) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleRevenueRatio) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleRevenueRatio) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleRevenueRatio)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Ratio)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleRevenueRatio)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Ratio) > 0 {
+			i -= len(x.Ratio)
+			copy(dAtA[i:], x.Ratio)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Ratio)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleRevenueRatio)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleRevenueRatio: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleRevenueRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Ratio = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ModuleRevenue_3_list)(nil)
+
+type _ModuleRevenue_3_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ModuleRevenue_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleRevenue_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ModuleRevenue_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleRevenue_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleRevenue_3_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleRevenue_3_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleRevenue_3_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleRevenue_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ModuleRevenue                protoreflect.MessageDescriptor
+	fd_ModuleRevenue_module_address protoreflect.FieldDescriptor
+	fd_ModuleRevenue_module_name    protoreflect.FieldDescriptor
+	fd_ModuleRevenue_total_revenue  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ModuleRevenue = File_initia_move_v1_types_proto.Messages().ByName("ModuleRevenue")
+	fd_ModuleRevenue_module_address = md_ModuleRevenue.Fields().ByName("module_address")
+	fd_ModuleRevenue_module_name = md_ModuleRevenue.Fields().ByName("module_name")
+	fd_ModuleRevenue_total_revenue = md_ModuleRevenue.Fields().ByName("total_revenue")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleRevenue)(nil)
+
+type fastReflection_ModuleRevenue ModuleRevenue
+
+func (x *ModuleRevenue) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleRevenue)(x)
+}
+
+func (x *ModuleRevenue) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleRevenue_messageType fastReflection_ModuleRevenue_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleRevenue_messageType{}
+
+type fastReflection_ModuleRevenue_messageType struct{}
+
+func (x fastReflection_ModuleRevenue_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleRevenue)(nil)
+}
+func (x fastReflection_ModuleRevenue_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleRevenue)
+}
+func (x fastReflection_ModuleRevenue_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleRevenue
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleRevenue) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleRevenue
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleRevenue) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleRevenue_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleRevenue) New() protoreflect.Message {
+	return new(fastReflection_ModuleRevenue)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleRevenue) Interface() protoreflect.ProtoMessage {
+	return (*ModuleRevenue)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleRevenue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_ModuleRevenue_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_ModuleRevenue_module_name, value) {
+			return
+		}
+	}
+	if len(x.TotalRevenue) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleRevenue_3_list{list: &x.TotalRevenue})
+		if !f(fd_ModuleRevenue_total_revenue, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleRevenue) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenue.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.ModuleRevenue.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.ModuleRevenue.total_revenue":
+		return len(x.TotalRevenue) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenue) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenue.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.ModuleRevenue.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.ModuleRevenue.total_revenue":
+		x.TotalRevenue = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleRevenue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ModuleRevenue.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleRevenue.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleRevenue.total_revenue":
+		if len(x.TotalRevenue) == 0 {
+			return protoreflect.ValueOfList(&_ModuleRevenue_3_list{})
+		}
+		listValue := &_ModuleRevenue_3_list{list: &x.TotalRevenue}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenue does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenue.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.ModuleRevenue.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.ModuleRevenue.total_revenue":
+		lv := value.List()
+		clv := lv.(*_ModuleRevenue_3_list)
+		x.TotalRevenue = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenue.total_revenue":
+		if x.TotalRevenue == nil {
+			x.TotalRevenue = []*v1beta1.Coin{}
+		}
+		value := &_ModuleRevenue_3_list{list: &x.TotalRevenue}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleRevenue.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.ModuleRevenue is not mutable"))
+	case "initia.move.v1.ModuleRevenue.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.ModuleRevenue is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleRevenue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleRevenue.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleRevenue.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleRevenue.total_revenue":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ModuleRevenue_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleRevenue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ModuleRevenue", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleRevenue) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleRevenue) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleRevenue) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleRevenue) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleRevenue)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TotalRevenue) > 0 {
+			for _, e := range x.TotalRevenue {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleRevenue)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.TotalRevenue) > 0 {
+			for iNdEx := len(x.TotalRevenue) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.TotalRevenue[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleRevenue)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleRevenue: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TotalRevenue", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TotalRevenue = append(x.TotalRevenue, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.TotalRevenue[len(x.TotalRevenue)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ClaimableRevenue_2_list)(nil)
+
+type _ClaimableRevenue_2_list struct {
+	list *[]*v1beta1.Coin
+}
+
+func (x *_ClaimableRevenue_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ClaimableRevenue_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ClaimableRevenue_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ClaimableRevenue_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*v1beta1.Coin)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ClaimableRevenue_2_list) AppendMutable() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ClaimableRevenue_2_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ClaimableRevenue_2_list) NewElement() protoreflect.Value {
+	v := new(v1beta1.Coin)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ClaimableRevenue_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ClaimableRevenue           protoreflect.MessageDescriptor
+	fd_ClaimableRevenue_recipient protoreflect.FieldDescriptor
+	fd_ClaimableRevenue_amount    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ClaimableRevenue = File_initia_move_v1_types_proto.Messages().ByName("ClaimableRevenue")
+	fd_ClaimableRevenue_recipient = md_ClaimableRevenue.Fields().ByName("recipient")
+	fd_ClaimableRevenue_amount = md_ClaimableRevenue.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_ClaimableRevenue)(nil)
+
+type fastReflection_ClaimableRevenue ClaimableRevenue
+
+func (x *ClaimableRevenue) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ClaimableRevenue)(x)
+}
+
+func (x *ClaimableRevenue) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ClaimableRevenue_messageType fastReflection_ClaimableRevenue_messageType
+var _ protoreflect.MessageType = fastReflection_ClaimableRevenue_messageType{}
+
+type fastReflection_ClaimableRevenue_messageType struct{}
+
+func (x fastReflection_ClaimableRevenue_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ClaimableRevenue)(nil)
+}
+func (x fastReflection_ClaimableRevenue_messageType) New() protoreflect.Message {
+	return new(fastReflection_ClaimableRevenue)
+}
+func (x fastReflection_ClaimableRevenue_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClaimableRevenue
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ClaimableRevenue) Descriptor() protoreflect.MessageDescriptor {
+	return md_ClaimableRevenue
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ClaimableRevenue) Type() protoreflect.MessageType {
+	return _fastReflection_ClaimableRevenue_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ClaimableRevenue) New() protoreflect.Message {
+	return new(fastReflection_ClaimableRevenue)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ClaimableRevenue) Interface() protoreflect.ProtoMessage {
+	return (*ClaimableRevenue)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ClaimableRevenue) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Recipient != "" {
+		value := protoreflect.ValueOfString(x.Recipient)
+		if !f(fd_ClaimableRevenue_recipient, value) {
+			return
+		}
+	}
+	if len(x.Amount) != 0 {
+		value := protoreflect.ValueOfList(&_ClaimableRevenue_2_list{list: &x.Amount})
+		if !f(fd_ClaimableRevenue_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ClaimableRevenue) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ClaimableRevenue.recipient":
+		return x.Recipient != ""
+	case "initia.move.v1.ClaimableRevenue.amount":
+		return len(x.Amount) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ClaimableRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ClaimableRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableRevenue) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ClaimableRevenue.recipient":
+		x.Recipient = ""
+	case "initia.move.v1.ClaimableRevenue.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ClaimableRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ClaimableRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ClaimableRevenue) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ClaimableRevenue.recipient":
+		value := x.Recipient
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ClaimableRevenue.amount":
+		if len(x.Amount) == 0 {
+			return protoreflect.ValueOfList(&_ClaimableRevenue_2_list{})
+		}
+		listValue := &_ClaimableRevenue_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ClaimableRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ClaimableRevenue does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableRevenue) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ClaimableRevenue.recipient":
+		x.Recipient = value.Interface().(string)
+	case "initia.move.v1.ClaimableRevenue.amount":
+		lv := value.List()
+		clv := lv.(*_ClaimableRevenue_2_list)
+		x.Amount = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ClaimableRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ClaimableRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableRevenue) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ClaimableRevenue.amount":
+		if x.Amount == nil {
+			x.Amount = []*v1beta1.Coin{}
+		}
+		value := &_ClaimableRevenue_2_list{list: &x.Amount}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ClaimableRevenue.recipient":
+		panic(fmt.Errorf("field recipient of message initia.move.v1.ClaimableRevenue is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ClaimableRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ClaimableRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ClaimableRevenue) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ClaimableRevenue.recipient":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ClaimableRevenue.amount":
+		list := []*v1beta1.Coin{}
+		return protoreflect.ValueOfList(&_ClaimableRevenue_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ClaimableRevenue"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ClaimableRevenue does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ClaimableRevenue) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ClaimableRevenue", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ClaimableRevenue) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ClaimableRevenue) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ClaimableRevenue) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ClaimableRevenue) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ClaimableRevenue)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Recipient)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Amount) > 0 {
+			for _, e := range x.Amount {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ClaimableRevenue)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Amount) > 0 {
+			for iNdEx := len(x.Amount) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.Amount[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Recipient) > 0 {
+			i -= len(x.Recipient)
+			copy(dAtA[i:], x.Recipient)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Recipient)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ClaimableRevenue)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClaimableRevenue: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ClaimableRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Recipient = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Amount = append(x.Amount, &v1beta1.Coin{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount[len(x.Amount)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_CronJob_6_list)(nil)
+
+type _CronJob_6_list struct {
+	list *[]string
+}
+
+func (x *_CronJob_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CronJob_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_CronJob_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CronJob_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CronJob_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CronJob at list field TypeArgs as it is not of Message kind"))
+}
+
+func (x *_CronJob_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CronJob_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_CronJob_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_CronJob_7_list)(nil)
+
+type _CronJob_7_list struct {
+	list *[][]byte
+}
+
+func (x *_CronJob_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_CronJob_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfBytes((*x.list)[i])
+}
+
+func (x *_CronJob_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_CronJob_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Bytes()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_CronJob_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message CronJob at list field Args as it is not of Message kind"))
+}
+
+func (x *_CronJob_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_CronJob_7_list) NewElement() protoreflect.Value {
+	var v []byte
+	return protoreflect.ValueOfBytes(v)
+}
+
+func (x *_CronJob_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_CronJob                 protoreflect.MessageDescriptor
+	fd_CronJob_id              protoreflect.FieldDescriptor
+	fd_CronJob_creator         protoreflect.FieldDescriptor
+	fd_CronJob_module_address  protoreflect.FieldDescriptor
+	fd_CronJob_module_name     protoreflect.FieldDescriptor
+	fd_CronJob_function_name   protoreflect.FieldDescriptor
+	fd_CronJob_type_args       protoreflect.FieldDescriptor
+	fd_CronJob_args            protoreflect.FieldDescriptor
+	fd_CronJob_interval        protoreflect.FieldDescriptor
+	fd_CronJob_gas_limit       protoreflect.FieldDescriptor
+	fd_CronJob_deposit         protoreflect.FieldDescriptor
+	fd_CronJob_next_run_height protoreflect.FieldDescriptor
+	fd_CronJob_failure_count   protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_CronJob = File_initia_move_v1_types_proto.Messages().ByName("CronJob")
+	fd_CronJob_id = md_CronJob.Fields().ByName("id")
+	fd_CronJob_creator = md_CronJob.Fields().ByName("creator")
+	fd_CronJob_module_address = md_CronJob.Fields().ByName("module_address")
+	fd_CronJob_module_name = md_CronJob.Fields().ByName("module_name")
+	fd_CronJob_function_name = md_CronJob.Fields().ByName("function_name")
+	fd_CronJob_type_args = md_CronJob.Fields().ByName("type_args")
+	fd_CronJob_args = md_CronJob.Fields().ByName("args")
+	fd_CronJob_interval = md_CronJob.Fields().ByName("interval")
+	fd_CronJob_gas_limit = md_CronJob.Fields().ByName("gas_limit")
+	fd_CronJob_deposit = md_CronJob.Fields().ByName("deposit")
+	fd_CronJob_next_run_height = md_CronJob.Fields().ByName("next_run_height")
+	fd_CronJob_failure_count = md_CronJob.Fields().ByName("failure_count")
+}
+
+var _ protoreflect.Message = (*fastReflection_CronJob)(nil)
+
+type fastReflection_CronJob CronJob
+
+func (x *CronJob) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CronJob)(x)
+}
+
+func (x *CronJob) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CronJob_messageType fastReflection_CronJob_messageType
+var _ protoreflect.MessageType = fastReflection_CronJob_messageType{}
+
+type fastReflection_CronJob_messageType struct{}
+
+func (x fastReflection_CronJob_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CronJob)(nil)
+}
+func (x fastReflection_CronJob_messageType) New() protoreflect.Message {
+	return new(fastReflection_CronJob)
+}
+func (x fastReflection_CronJob_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CronJob
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CronJob) Descriptor() protoreflect.MessageDescriptor {
+	return md_CronJob
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CronJob) Type() protoreflect.MessageType {
+	return _fastReflection_CronJob_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CronJob) New() protoreflect.Message {
+	return new(fastReflection_CronJob)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CronJob) Interface() protoreflect.ProtoMessage {
+	return (*CronJob)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CronJob) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Id != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Id)
+		if !f(fd_CronJob_id, value) {
+			return
+		}
+	}
+	if x.Creator != "" {
+		value := protoreflect.ValueOfString(x.Creator)
+		if !f(fd_CronJob_creator, value) {
+			return
+		}
+	}
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_CronJob_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_CronJob_module_name, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_CronJob_function_name, value) {
+			return
+		}
+	}
+	if len(x.TypeArgs) != 0 {
+		value := protoreflect.ValueOfList(&_CronJob_6_list{list: &x.TypeArgs})
+		if !f(fd_CronJob_type_args, value) {
+			return
+		}
+	}
+	if len(x.Args) != 0 {
+		value := protoreflect.ValueOfList(&_CronJob_7_list{list: &x.Args})
+		if !f(fd_CronJob_args, value) {
+			return
+		}
+	}
+	if x.Interval != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Interval)
+		if !f(fd_CronJob_interval, value) {
+			return
+		}
+	}
+	if x.GasLimit != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasLimit)
+		if !f(fd_CronJob_gas_limit, value) {
+			return
+		}
+	}
+	if x.Deposit != nil {
+		value := protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+		if !f(fd_CronJob_deposit, value) {
+			return
+		}
+	}
+	if x.NextRunHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.NextRunHeight)
+		if !f(fd_CronJob_next_run_height, value) {
+			return
+		}
+	}
+	if x.FailureCount != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.FailureCount)
+		if !f(fd_CronJob_failure_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CronJob) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.CronJob.id":
+		return x.Id != uint64(0)
+	case "initia.move.v1.CronJob.creator":
+		return x.Creator != ""
+	case "initia.move.v1.CronJob.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.CronJob.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.CronJob.function_name":
+		return x.FunctionName != ""
+	case "initia.move.v1.CronJob.type_args":
+		return len(x.TypeArgs) != 0
+	case "initia.move.v1.CronJob.args":
+		return len(x.Args) != 0
+	case "initia.move.v1.CronJob.interval":
+		return x.Interval != uint64(0)
+	case "initia.move.v1.CronJob.gas_limit":
+		return x.GasLimit != uint64(0)
+	case "initia.move.v1.CronJob.deposit":
+		return x.Deposit != nil
+	case "initia.move.v1.CronJob.next_run_height":
+		return x.NextRunHeight != int64(0)
+	case "initia.move.v1.CronJob.failure_count":
+		return x.FailureCount != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronJob"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronJob does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronJob) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.CronJob.id":
+		x.Id = uint64(0)
+	case "initia.move.v1.CronJob.creator":
+		x.Creator = ""
+	case "initia.move.v1.CronJob.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.CronJob.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.CronJob.function_name":
+		x.FunctionName = ""
+	case "initia.move.v1.CronJob.type_args":
+		x.TypeArgs = nil
+	case "initia.move.v1.CronJob.args":
+		x.Args = nil
+	case "initia.move.v1.CronJob.interval":
+		x.Interval = uint64(0)
+	case "initia.move.v1.CronJob.gas_limit":
+		x.GasLimit = uint64(0)
+	case "initia.move.v1.CronJob.deposit":
+		x.Deposit = nil
+	case "initia.move.v1.CronJob.next_run_height":
+		x.NextRunHeight = int64(0)
+	case "initia.move.v1.CronJob.failure_count":
+		x.FailureCount = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronJob"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronJob does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CronJob) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.CronJob.id":
+		value := x.Id
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.CronJob.creator":
+		value := x.Creator
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.CronJob.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.CronJob.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.CronJob.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.CronJob.type_args":
+		if len(x.TypeArgs) == 0 {
+			return protoreflect.ValueOfList(&_CronJob_6_list{})
+		}
+		listValue := &_CronJob_6_list{list: &x.TypeArgs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.CronJob.args":
+		if len(x.Args) == 0 {
+			return protoreflect.ValueOfList(&_CronJob_7_list{})
+		}
+		listValue := &_CronJob_7_list{list: &x.Args}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.CronJob.interval":
+		value := x.Interval
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.CronJob.gas_limit":
+		value := x.GasLimit
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.CronJob.deposit":
+		value := x.Deposit
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.move.v1.CronJob.next_run_height":
+		value := x.NextRunHeight
+		return protoreflect.ValueOfInt64(value)
+	case "initia.move.v1.CronJob.failure_count":
+		value := x.FailureCount
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronJob"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronJob does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronJob) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.CronJob.id":
+		x.Id = value.Uint()
+	case "initia.move.v1.CronJob.creator":
+		x.Creator = value.Interface().(string)
+	case "initia.move.v1.CronJob.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.CronJob.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.CronJob.function_name":
+		x.FunctionName = value.Interface().(string)
+	case "initia.move.v1.CronJob.type_args":
+		lv := value.List()
+		clv := lv.(*_CronJob_6_list)
+		x.TypeArgs = *clv.list
+	case "initia.move.v1.CronJob.args":
+		lv := value.List()
+		clv := lv.(*_CronJob_7_list)
+		x.Args = *clv.list
+	case "initia.move.v1.CronJob.interval":
+		x.Interval = value.Uint()
+	case "initia.move.v1.CronJob.gas_limit":
+		x.GasLimit = value.Uint()
+	case "initia.move.v1.CronJob.deposit":
+		x.Deposit = value.Message().Interface().(*v1beta1.Coin)
+	case "initia.move.v1.CronJob.next_run_height":
+		x.NextRunHeight = value.Int()
+	case "initia.move.v1.CronJob.failure_count":
+		x.FailureCount = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronJob"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronJob does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronJob) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.CronJob.type_args":
+		if x.TypeArgs == nil {
+			x.TypeArgs = []string{}
+		}
+		value := &_CronJob_6_list{list: &x.TypeArgs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.CronJob.args":
+		if x.Args == nil {
+			x.Args = [][]byte{}
+		}
+		value := &_CronJob_7_list{list: &x.Args}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.CronJob.deposit":
+		if x.Deposit == nil {
+			x.Deposit = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Deposit.ProtoReflect())
+	case "initia.move.v1.CronJob.id":
+		panic(fmt.Errorf("field id of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.creator":
+		panic(fmt.Errorf("field creator of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.function_name":
+		panic(fmt.Errorf("field function_name of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.interval":
+		panic(fmt.Errorf("field interval of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.gas_limit":
+		panic(fmt.Errorf("field gas_limit of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.next_run_height":
+		panic(fmt.Errorf("field next_run_height of message initia.move.v1.CronJob is not mutable"))
+	case "initia.move.v1.CronJob.failure_count":
+		panic(fmt.Errorf("field failure_count of message initia.move.v1.CronJob is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronJob"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronJob does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CronJob) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.CronJob.id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.CronJob.creator":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.CronJob.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.CronJob.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.CronJob.function_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.CronJob.type_args":
+		list := []string{}
+		return protoreflect.ValueOfList(&_CronJob_6_list{list: &list})
+	case "initia.move.v1.CronJob.args":
+		list := [][]byte{}
+		return protoreflect.ValueOfList(&_CronJob_7_list{list: &list})
+	case "initia.move.v1.CronJob.interval":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.CronJob.gas_limit":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.CronJob.deposit":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.move.v1.CronJob.next_run_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.move.v1.CronJob.failure_count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronJob"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronJob does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CronJob) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.CronJob", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CronJob) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronJob) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CronJob) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CronJob) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CronJob)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.Id != 0 {
+			n += 1 + runtime.Sov(uint64(x.Id))
+		}
+		l = len(x.Creator)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.TypeArgs) > 0 {
+			for _, s := range x.TypeArgs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Args) > 0 {
+			for _, b := range x.Args {
+				l = len(b)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.Interval != 0 {
+			n += 1 + runtime.Sov(uint64(x.Interval))
+		}
+		if x.GasLimit != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasLimit))
+		}
+		if x.Deposit != nil {
+			l = options.Size(x.Deposit)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.NextRunHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.NextRunHeight))
+		}
+		if x.FailureCount != 0 {
+			n += 1 + runtime.Sov(uint64(x.FailureCount))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CronJob)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.FailureCount != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.FailureCount))
+			i--
+			dAtA[i] = 0x60
+		}
+		if x.NextRunHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.NextRunHeight))
+			i--
+			dAtA[i] = 0x58
+		}
+		if x.Deposit != nil {
+			encoded, err := options.Marshal(x.Deposit)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x52
+		}
+		if x.GasLimit != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasLimit))
+			i--
+			dAtA[i] = 0x48
+		}
+		if x.Interval != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Interval))
+			i--
+			dAtA[i] = 0x40
+		}
+		if len(x.Args) > 0 {
+			for iNdEx := len(x.Args) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Args[iNdEx])
+				copy(dAtA[i:], x.Args[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Args[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TypeArgs) > 0 {
+			for iNdEx := len(x.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TypeArgs[iNdEx])
+				copy(dAtA[i:], x.TypeArgs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypeArgs[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.Creator) > 0 {
+			i -= len(x.Creator)
+			copy(dAtA[i:], x.Creator)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Creator)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if x.Id != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Id))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CronJob)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CronJob: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CronJob: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+				}
+				x.Id = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Id |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Creator = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypeArgs = append(x.TypeArgs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				}
+				var byteLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					byteLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if byteLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + byteLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Args = append(x.Args, make([]byte, postIndex-iNdEx))
+				copy(x.Args[len(x.Args)-1], dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 8:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+				}
+				x.Interval = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Interval |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 9:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+				}
+				x.GasLimit = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasLimit |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Deposit == nil {
+					x.Deposit = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Deposit); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 11:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field NextRunHeight", wireType)
+				}
+				x.NextRunHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.NextRunHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 12:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+				}
+				x.FailureCount = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.FailureCount |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_CronRun          protoreflect.MessageDescriptor
+	fd_CronRun_job_id   protoreflect.FieldDescriptor
+	fd_CronRun_height   protoreflect.FieldDescriptor
+	fd_CronRun_success  protoreflect.FieldDescriptor
+	fd_CronRun_gas_used protoreflect.FieldDescriptor
+	fd_CronRun_fee      protoreflect.FieldDescriptor
+	fd_CronRun_error    protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_CronRun = File_initia_move_v1_types_proto.Messages().ByName("CronRun")
+	fd_CronRun_job_id = md_CronRun.Fields().ByName("job_id")
+	fd_CronRun_height = md_CronRun.Fields().ByName("height")
+	fd_CronRun_success = md_CronRun.Fields().ByName("success")
+	fd_CronRun_gas_used = md_CronRun.Fields().ByName("gas_used")
+	fd_CronRun_fee = md_CronRun.Fields().ByName("fee")
+	fd_CronRun_error = md_CronRun.Fields().ByName("error")
+}
+
+var _ protoreflect.Message = (*fastReflection_CronRun)(nil)
+
+type fastReflection_CronRun CronRun
+
+func (x *CronRun) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_CronRun)(x)
+}
+
+func (x *CronRun) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_CronRun_messageType fastReflection_CronRun_messageType
+var _ protoreflect.MessageType = fastReflection_CronRun_messageType{}
+
+type fastReflection_CronRun_messageType struct{}
+
+func (x fastReflection_CronRun_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_CronRun)(nil)
+}
+func (x fastReflection_CronRun_messageType) New() protoreflect.Message {
+	return new(fastReflection_CronRun)
+}
+func (x fastReflection_CronRun_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_CronRun
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_CronRun) Descriptor() protoreflect.MessageDescriptor {
+	return md_CronRun
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_CronRun) Type() protoreflect.MessageType {
+	return _fastReflection_CronRun_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_CronRun) New() protoreflect.Message {
+	return new(fastReflection_CronRun)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_CronRun) Interface() protoreflect.ProtoMessage {
+	return (*CronRun)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_CronRun) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.JobId != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.JobId)
+		if !f(fd_CronRun_job_id, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_CronRun_height, value) {
+			return
+		}
+	}
+	if x.Success != false {
+		value := protoreflect.ValueOfBool(x.Success)
+		if !f(fd_CronRun_success, value) {
+			return
+		}
+	}
+	if x.GasUsed != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.GasUsed)
+		if !f(fd_CronRun_gas_used, value) {
+			return
+		}
+	}
+	if x.Fee != nil {
+		value := protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+		if !f(fd_CronRun_fee, value) {
+			return
+		}
+	}
+	if x.Error != "" {
+		value := protoreflect.ValueOfString(x.Error)
+		if !f(fd_CronRun_error, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_CronRun) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.CronRun.job_id":
+		return x.JobId != uint64(0)
+	case "initia.move.v1.CronRun.height":
+		return x.Height != int64(0)
+	case "initia.move.v1.CronRun.success":
+		return x.Success != false
+	case "initia.move.v1.CronRun.gas_used":
+		return x.GasUsed != uint64(0)
+	case "initia.move.v1.CronRun.fee":
+		return x.Fee != nil
+	case "initia.move.v1.CronRun.error":
+		return x.Error != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronRun"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronRun) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.CronRun.job_id":
+		x.JobId = uint64(0)
+	case "initia.move.v1.CronRun.height":
+		x.Height = int64(0)
+	case "initia.move.v1.CronRun.success":
+		x.Success = false
+	case "initia.move.v1.CronRun.gas_used":
+		x.GasUsed = uint64(0)
+	case "initia.move.v1.CronRun.fee":
+		x.Fee = nil
+	case "initia.move.v1.CronRun.error":
+		x.Error = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronRun"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_CronRun) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.CronRun.job_id":
+		value := x.JobId
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.CronRun.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.move.v1.CronRun.success":
+		value := x.Success
+		return protoreflect.ValueOfBool(value)
+	case "initia.move.v1.CronRun.gas_used":
+		value := x.GasUsed
+		return protoreflect.ValueOfUint64(value)
+	case "initia.move.v1.CronRun.fee":
+		value := x.Fee
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	case "initia.move.v1.CronRun.error":
+		value := x.Error
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronRun"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronRun does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronRun) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.CronRun.job_id":
+		x.JobId = value.Uint()
+	case "initia.move.v1.CronRun.height":
+		x.Height = value.Int()
+	case "initia.move.v1.CronRun.success":
+		x.Success = value.Bool()
+	case "initia.move.v1.CronRun.gas_used":
+		x.GasUsed = value.Uint()
+	case "initia.move.v1.CronRun.fee":
+		x.Fee = value.Message().Interface().(*v1beta1.Coin)
+	case "initia.move.v1.CronRun.error":
+		x.Error = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronRun"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronRun) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.CronRun.fee":
+		if x.Fee == nil {
+			x.Fee = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Fee.ProtoReflect())
+	case "initia.move.v1.CronRun.job_id":
+		panic(fmt.Errorf("field job_id of message initia.move.v1.CronRun is not mutable"))
+	case "initia.move.v1.CronRun.height":
+		panic(fmt.Errorf("field height of message initia.move.v1.CronRun is not mutable"))
+	case "initia.move.v1.CronRun.success":
+		panic(fmt.Errorf("field success of message initia.move.v1.CronRun is not mutable"))
+	case "initia.move.v1.CronRun.gas_used":
+		panic(fmt.Errorf("field gas_used of message initia.move.v1.CronRun is not mutable"))
+	case "initia.move.v1.CronRun.error":
+		panic(fmt.Errorf("field error of message initia.move.v1.CronRun is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronRun"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_CronRun) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.CronRun.job_id":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.CronRun.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.move.v1.CronRun.success":
+		return protoreflect.ValueOfBool(false)
+	case "initia.move.v1.CronRun.gas_used":
+		return protoreflect.ValueOfUint64(uint64(0))
+	case "initia.move.v1.CronRun.fee":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	case "initia.move.v1.CronRun.error":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.CronRun"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.CronRun does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_CronRun) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.CronRun", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_CronRun) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_CronRun) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_CronRun) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_CronRun) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*CronRun)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		if x.JobId != 0 {
+			n += 1 + runtime.Sov(uint64(x.JobId))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		if x.Success {
+			n += 2
+		}
+		if x.GasUsed != 0 {
+			n += 1 + runtime.Sov(uint64(x.GasUsed))
+		}
+		if x.Fee != nil {
+			l = options.Size(x.Fee)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Error)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*CronRun)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Error) > 0 {
+			i -= len(x.Error)
+			copy(dAtA[i:], x.Error)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Error)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Fee != nil {
+			encoded, err := options.Marshal(x.Fee)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x2a
+		}
+		if x.GasUsed != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.GasUsed))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.Success {
+			i--
+			if x.Success {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if x.JobId != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.JobId))
+			i--
+			dAtA[i] = 0x8
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*CronRun)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CronRun: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: CronRun: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+				}
+				x.JobId = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.JobId |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Success = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+				}
+				x.GasUsed = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.GasUsed |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Fee == nil {
+					x.Fee = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Fee); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Error = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_PublishNamespace_2_list)(nil)
+
+type _PublishNamespace_2_list struct {
+	list *[]string
+}
+
+func (x *_PublishNamespace_2_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_PublishNamespace_2_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_PublishNamespace_2_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_PublishNamespace_2_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_PublishNamespace_2_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message PublishNamespace at list field Prefixes as it is not of Message kind"))
+}
+
+func (x *_PublishNamespace_2_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_PublishNamespace_2_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_PublishNamespace_2_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_PublishNamespace          protoreflect.MessageDescriptor
+	fd_PublishNamespace_address  protoreflect.FieldDescriptor
+	fd_PublishNamespace_prefixes protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_PublishNamespace = File_initia_move_v1_types_proto.Messages().ByName("PublishNamespace")
+	fd_PublishNamespace_address = md_PublishNamespace.Fields().ByName("address")
+	fd_PublishNamespace_prefixes = md_PublishNamespace.Fields().ByName("prefixes")
+}
+
+var _ protoreflect.Message = (*fastReflection_PublishNamespace)(nil)
+
+type fastReflection_PublishNamespace PublishNamespace
+
+func (x *PublishNamespace) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PublishNamespace)(x)
+}
+
+func (x *PublishNamespace) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PublishNamespace_messageType fastReflection_PublishNamespace_messageType
+var _ protoreflect.MessageType = fastReflection_PublishNamespace_messageType{}
+
+type fastReflection_PublishNamespace_messageType struct{}
+
+func (x fastReflection_PublishNamespace_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PublishNamespace)(nil)
+}
+func (x fastReflection_PublishNamespace_messageType) New() protoreflect.Message {
+	return new(fastReflection_PublishNamespace)
+}
+func (x fastReflection_PublishNamespace_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PublishNamespace
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PublishNamespace) Descriptor() protoreflect.MessageDescriptor {
+	return md_PublishNamespace
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PublishNamespace) Type() protoreflect.MessageType {
+	return _fastReflection_PublishNamespace_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PublishNamespace) New() protoreflect.Message {
+	return new(fastReflection_PublishNamespace)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PublishNamespace) Interface() protoreflect.ProtoMessage {
+	return (*PublishNamespace)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PublishNamespace) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PublishNamespace_address, value) {
+			return
+		}
+	}
+	if len(x.Prefixes) != 0 {
+		value := protoreflect.ValueOfList(&_PublishNamespace_2_list{list: &x.Prefixes})
+		if !f(fd_PublishNamespace_prefixes, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PublishNamespace) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishNamespace.address":
+		return x.Address != ""
+	case "initia.move.v1.PublishNamespace.prefixes":
+		return len(x.Prefixes) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishNamespace"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishNamespace does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishNamespace) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishNamespace.address":
+		x.Address = ""
+	case "initia.move.v1.PublishNamespace.prefixes":
+		x.Prefixes = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishNamespace"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishNamespace does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PublishNamespace) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.PublishNamespace.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.PublishNamespace.prefixes":
+		if len(x.Prefixes) == 0 {
+			return protoreflect.ValueOfList(&_PublishNamespace_2_list{})
+		}
+		listValue := &_PublishNamespace_2_list{list: &x.Prefixes}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishNamespace"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishNamespace does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishNamespace) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishNamespace.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.PublishNamespace.prefixes":
+		lv := value.List()
+		clv := lv.(*_PublishNamespace_2_list)
+		x.Prefixes = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishNamespace"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishNamespace does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishNamespace) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishNamespace.prefixes":
+		if x.Prefixes == nil {
+			x.Prefixes = []string{}
+		}
+		value := &_PublishNamespace_2_list{list: &x.Prefixes}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.PublishNamespace.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.PublishNamespace is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishNamespace"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishNamespace does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PublishNamespace) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishNamespace.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.PublishNamespace.prefixes":
+		list := []string{}
+		return protoreflect.ValueOfList(&_PublishNamespace_2_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishNamespace"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishNamespace does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PublishNamespace) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.PublishNamespace", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PublishNamespace) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishNamespace) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PublishNamespace) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PublishNamespace) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PublishNamespace)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Prefixes) > 0 {
+			for _, s := range x.Prefixes {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PublishNamespace)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Prefixes) > 0 {
+			for iNdEx := len(x.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Prefixes[iNdEx])
+				copy(dAtA[i:], x.Prefixes[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Prefixes[iNdEx])))
+				i--
+				dAtA[i] = 0x12
+			}
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PublishNamespace)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PublishNamespace: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PublishNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Prefixes = append(x.Prefixes, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PublishUsage                     protoreflect.MessageDescriptor
+	fd_PublishUsage_address             protoreflect.FieldDescriptor
+	fd_PublishUsage_window_start_height protoreflect.FieldDescriptor
+	fd_PublishUsage_count               protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_PublishUsage = File_initia_move_v1_types_proto.Messages().ByName("PublishUsage")
+	fd_PublishUsage_address = md_PublishUsage.Fields().ByName("address")
+	fd_PublishUsage_window_start_height = md_PublishUsage.Fields().ByName("window_start_height")
+	fd_PublishUsage_count = md_PublishUsage.Fields().ByName("count")
+}
+
+var _ protoreflect.Message = (*fastReflection_PublishUsage)(nil)
+
+type fastReflection_PublishUsage PublishUsage
+
+func (x *PublishUsage) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PublishUsage)(x)
+}
+
+func (x *PublishUsage) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PublishUsage_messageType fastReflection_PublishUsage_messageType
+var _ protoreflect.MessageType = fastReflection_PublishUsage_messageType{}
+
+type fastReflection_PublishUsage_messageType struct{}
+
+func (x fastReflection_PublishUsage_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PublishUsage)(nil)
+}
+func (x fastReflection_PublishUsage_messageType) New() protoreflect.Message {
+	return new(fastReflection_PublishUsage)
+}
+func (x fastReflection_PublishUsage_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PublishUsage
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PublishUsage) Descriptor() protoreflect.MessageDescriptor {
+	return md_PublishUsage
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PublishUsage) Type() protoreflect.MessageType {
+	return _fastReflection_PublishUsage_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PublishUsage) New() protoreflect.Message {
+	return new(fastReflection_PublishUsage)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PublishUsage) Interface() protoreflect.ProtoMessage {
+	return (*PublishUsage)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PublishUsage) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_PublishUsage_address, value) {
+			return
+		}
+	}
+	if x.WindowStartHeight != int64(0) {
+		value := protoreflect.ValueOfInt64(x.WindowStartHeight)
+		if !f(fd_PublishUsage_window_start_height, value) {
+			return
+		}
+	}
+	if x.Count != uint64(0) {
+		value := protoreflect.ValueOfUint64(x.Count)
+		if !f(fd_PublishUsage_count, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PublishUsage) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishUsage.address":
+		return x.Address != ""
+	case "initia.move.v1.PublishUsage.window_start_height":
+		return x.WindowStartHeight != int64(0)
+	case "initia.move.v1.PublishUsage.count":
+		return x.Count != uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishUsage"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishUsage) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishUsage.address":
+		x.Address = ""
+	case "initia.move.v1.PublishUsage.window_start_height":
+		x.WindowStartHeight = int64(0)
+	case "initia.move.v1.PublishUsage.count":
+		x.Count = uint64(0)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishUsage"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PublishUsage) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.PublishUsage.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.PublishUsage.window_start_height":
+		value := x.WindowStartHeight
+		return protoreflect.ValueOfInt64(value)
+	case "initia.move.v1.PublishUsage.count":
+		value := x.Count
+		return protoreflect.ValueOfUint64(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishUsage"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishUsage does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishUsage) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishUsage.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.PublishUsage.window_start_height":
+		x.WindowStartHeight = value.Int()
+	case "initia.move.v1.PublishUsage.count":
+		x.Count = value.Uint()
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishUsage"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishUsage) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishUsage.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.PublishUsage is not mutable"))
+	case "initia.move.v1.PublishUsage.window_start_height":
+		panic(fmt.Errorf("field window_start_height of message initia.move.v1.PublishUsage is not mutable"))
+	case "initia.move.v1.PublishUsage.count":
+		panic(fmt.Errorf("field count of message initia.move.v1.PublishUsage is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishUsage"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PublishUsage) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishUsage.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.PublishUsage.window_start_height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.move.v1.PublishUsage.count":
+		return protoreflect.ValueOfUint64(uint64(0))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishUsage"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishUsage does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PublishUsage) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.PublishUsage", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PublishUsage) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishUsage) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PublishUsage) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PublishUsage) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PublishUsage)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.WindowStartHeight != 0 {
+			n += 1 + runtime.Sov(uint64(x.WindowStartHeight))
+		}
+		if x.Count != 0 {
+			n += 1 + runtime.Sov(uint64(x.Count))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PublishUsage)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Count != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Count))
+			i--
+			dAtA[i] = 0x18
+		}
+		if x.WindowStartHeight != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.WindowStartHeight))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PublishUsage)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PublishUsage: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PublishUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+				}
+				x.WindowStartHeight = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.WindowStartHeight |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+				}
+				x.Count = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Count |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_PublishDeposit                protoreflect.MessageDescriptor
+	fd_PublishDeposit_module_address protoreflect.FieldDescriptor
+	fd_PublishDeposit_module_name    protoreflect.FieldDescriptor
+	fd_PublishDeposit_depositor      protoreflect.FieldDescriptor
+	fd_PublishDeposit_amount         protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_PublishDeposit = File_initia_move_v1_types_proto.Messages().ByName("PublishDeposit")
+	fd_PublishDeposit_module_address = md_PublishDeposit.Fields().ByName("module_address")
+	fd_PublishDeposit_module_name = md_PublishDeposit.Fields().ByName("module_name")
+	fd_PublishDeposit_depositor = md_PublishDeposit.Fields().ByName("depositor")
+	fd_PublishDeposit_amount = md_PublishDeposit.Fields().ByName("amount")
+}
+
+var _ protoreflect.Message = (*fastReflection_PublishDeposit)(nil)
+
+type fastReflection_PublishDeposit PublishDeposit
+
+func (x *PublishDeposit) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_PublishDeposit)(x)
+}
+
+func (x *PublishDeposit) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_PublishDeposit_messageType fastReflection_PublishDeposit_messageType
+var _ protoreflect.MessageType = fastReflection_PublishDeposit_messageType{}
+
+type fastReflection_PublishDeposit_messageType struct{}
+
+func (x fastReflection_PublishDeposit_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_PublishDeposit)(nil)
+}
+func (x fastReflection_PublishDeposit_messageType) New() protoreflect.Message {
+	return new(fastReflection_PublishDeposit)
+}
+func (x fastReflection_PublishDeposit_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_PublishDeposit
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_PublishDeposit) Descriptor() protoreflect.MessageDescriptor {
+	return md_PublishDeposit
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_PublishDeposit) Type() protoreflect.MessageType {
+	return _fastReflection_PublishDeposit_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_PublishDeposit) New() protoreflect.Message {
+	return new(fastReflection_PublishDeposit)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_PublishDeposit) Interface() protoreflect.ProtoMessage {
+	return (*PublishDeposit)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_PublishDeposit) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_PublishDeposit_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_PublishDeposit_module_name, value) {
+			return
+		}
+	}
+	if x.Depositor != "" {
+		value := protoreflect.ValueOfString(x.Depositor)
+		if !f(fd_PublishDeposit_depositor, value) {
+			return
+		}
+	}
+	if x.Amount != nil {
+		value := protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+		if !f(fd_PublishDeposit_amount, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_PublishDeposit) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishDeposit.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.PublishDeposit.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.PublishDeposit.depositor":
+		return x.Depositor != ""
+	case "initia.move.v1.PublishDeposit.amount":
+		return x.Amount != nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishDeposit"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishDeposit) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishDeposit.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.PublishDeposit.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.PublishDeposit.depositor":
+		x.Depositor = ""
+	case "initia.move.v1.PublishDeposit.amount":
+		x.Amount = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishDeposit"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_PublishDeposit) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.PublishDeposit.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.PublishDeposit.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.PublishDeposit.depositor":
+		value := x.Depositor
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.PublishDeposit.amount":
+		value := x.Amount
+		return protoreflect.ValueOfMessage(value.ProtoReflect())
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishDeposit"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishDeposit does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishDeposit) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishDeposit.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.PublishDeposit.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.PublishDeposit.depositor":
+		x.Depositor = value.Interface().(string)
+	case "initia.move.v1.PublishDeposit.amount":
+		x.Amount = value.Message().Interface().(*v1beta1.Coin)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishDeposit"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishDeposit) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishDeposit.amount":
+		if x.Amount == nil {
+			x.Amount = new(v1beta1.Coin)
+		}
+		return protoreflect.ValueOfMessage(x.Amount.ProtoReflect())
+	case "initia.move.v1.PublishDeposit.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.PublishDeposit is not mutable"))
+	case "initia.move.v1.PublishDeposit.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.PublishDeposit is not mutable"))
+	case "initia.move.v1.PublishDeposit.depositor":
+		panic(fmt.Errorf("field depositor of message initia.move.v1.PublishDeposit is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishDeposit"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_PublishDeposit) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.PublishDeposit.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.PublishDeposit.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.PublishDeposit.depositor":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.PublishDeposit.amount":
+		m := new(v1beta1.Coin)
+		return protoreflect.ValueOfMessage(m.ProtoReflect())
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.PublishDeposit"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.PublishDeposit does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_PublishDeposit) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.PublishDeposit", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_PublishDeposit) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_PublishDeposit) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_PublishDeposit) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_PublishDeposit) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*PublishDeposit)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Depositor)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Amount != nil {
+			l = options.Size(x.Amount)
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*PublishDeposit)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if x.Amount != nil {
+			encoded, err := options.Marshal(x.Amount)
+			if err != nil {
+				return protoiface.MarshalOutput{
+					NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+					Buf:               input.Buf,
+				}, err
+			}
+			i -= len(encoded)
+			copy(dAtA[i:], encoded)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.Depositor) > 0 {
+			i -= len(x.Depositor)
+			copy(dAtA[i:], x.Depositor)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Depositor)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*PublishDeposit)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PublishDeposit: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: PublishDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Depositor = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if x.Amount == nil {
+					x.Amount = &v1beta1.Coin{}
+				}
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.Amount); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_ModuleIdentifier             protoreflect.MessageDescriptor
+	fd_ModuleIdentifier_address     protoreflect.FieldDescriptor
+	fd_ModuleIdentifier_module_name protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ModuleIdentifier = File_initia_move_v1_types_proto.Messages().ByName("ModuleIdentifier")
+	fd_ModuleIdentifier_address = md_ModuleIdentifier.Fields().ByName("address")
+	fd_ModuleIdentifier_module_name = md_ModuleIdentifier.Fields().ByName("module_name")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleIdentifier)(nil)
+
+type fastReflection_ModuleIdentifier ModuleIdentifier
+
+func (x *ModuleIdentifier) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleIdentifier)(x)
+}
+
+func (x *ModuleIdentifier) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleIdentifier_messageType fastReflection_ModuleIdentifier_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleIdentifier_messageType{}
+
+type fastReflection_ModuleIdentifier_messageType struct{}
+
+func (x fastReflection_ModuleIdentifier_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleIdentifier)(nil)
+}
+func (x fastReflection_ModuleIdentifier_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleIdentifier)
+}
+func (x fastReflection_ModuleIdentifier_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleIdentifier
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleIdentifier) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleIdentifier
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleIdentifier) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleIdentifier_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleIdentifier) New() protoreflect.Message {
+	return new(fastReflection_ModuleIdentifier)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleIdentifier) Interface() protoreflect.ProtoMessage {
+	return (*ModuleIdentifier)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleIdentifier) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ModuleIdentifier_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_ModuleIdentifier_module_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleIdentifier) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleIdentifier.address":
+		return x.Address != ""
+	case "initia.move.v1.ModuleIdentifier.module_name":
+		return x.ModuleName != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleIdentifier"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleIdentifier) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleIdentifier.address":
+		x.Address = ""
+	case "initia.move.v1.ModuleIdentifier.module_name":
+		x.ModuleName = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleIdentifier"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleIdentifier) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ModuleIdentifier.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleIdentifier.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleIdentifier"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleIdentifier does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleIdentifier) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleIdentifier.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.ModuleIdentifier.module_name":
+		x.ModuleName = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleIdentifier"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleIdentifier) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleIdentifier.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.ModuleIdentifier is not mutable"))
+	case "initia.move.v1.ModuleIdentifier.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.ModuleIdentifier is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleIdentifier"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleIdentifier) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleIdentifier.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleIdentifier.module_name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleIdentifier"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleIdentifier does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleIdentifier) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ModuleIdentifier", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleIdentifier) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleIdentifier) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleIdentifier) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleIdentifier) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleIdentifier)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleIdentifier)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleIdentifier)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleIdentifier: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ModuleFriends_3_list)(nil)
+
+type _ModuleFriends_3_list struct {
+	list *[]string
+}
+
+func (x *_ModuleFriends_3_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleFriends_3_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ModuleFriends_3_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleFriends_3_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleFriends_3_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ModuleFriends at list field Friends as it is not of Message kind"))
+}
+
+func (x *_ModuleFriends_3_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleFriends_3_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ModuleFriends_3_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ModuleFriends             protoreflect.MessageDescriptor
+	fd_ModuleFriends_address     protoreflect.FieldDescriptor
+	fd_ModuleFriends_module_name protoreflect.FieldDescriptor
+	fd_ModuleFriends_friends     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ModuleFriends = File_initia_move_v1_types_proto.Messages().ByName("ModuleFriends")
+	fd_ModuleFriends_address = md_ModuleFriends.Fields().ByName("address")
+	fd_ModuleFriends_module_name = md_ModuleFriends.Fields().ByName("module_name")
+	fd_ModuleFriends_friends = md_ModuleFriends.Fields().ByName("friends")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleFriends)(nil)
+
+type fastReflection_ModuleFriends ModuleFriends
+
+func (x *ModuleFriends) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleFriends)(x)
+}
+
+func (x *ModuleFriends) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleFriends_messageType fastReflection_ModuleFriends_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleFriends_messageType{}
+
+type fastReflection_ModuleFriends_messageType struct{}
+
+func (x fastReflection_ModuleFriends_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleFriends)(nil)
+}
+func (x fastReflection_ModuleFriends_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleFriends)
+}
+func (x fastReflection_ModuleFriends_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleFriends
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleFriends) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleFriends
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleFriends) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleFriends_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleFriends) New() protoreflect.Message {
+	return new(fastReflection_ModuleFriends)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleFriends) Interface() protoreflect.ProtoMessage {
+	return (*ModuleFriends)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleFriends) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ModuleFriends_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_ModuleFriends_module_name, value) {
+			return
+		}
+	}
+	if len(x.Friends) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleFriends_3_list{list: &x.Friends})
+		if !f(fd_ModuleFriends_friends, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleFriends) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleFriends.address":
+		return x.Address != ""
+	case "initia.move.v1.ModuleFriends.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.ModuleFriends.friends":
+		return len(x.Friends) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleFriends"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleFriends does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleFriends) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleFriends.address":
+		x.Address = ""
+	case "initia.move.v1.ModuleFriends.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.ModuleFriends.friends":
+		x.Friends = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleFriends"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleFriends does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleFriends) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ModuleFriends.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleFriends.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleFriends.friends":
+		if len(x.Friends) == 0 {
+			return protoreflect.ValueOfList(&_ModuleFriends_3_list{})
+		}
+		listValue := &_ModuleFriends_3_list{list: &x.Friends}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleFriends"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleFriends does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleFriends) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleFriends.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.ModuleFriends.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.ModuleFriends.friends":
+		lv := value.List()
+		clv := lv.(*_ModuleFriends_3_list)
+		x.Friends = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleFriends"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleFriends does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleFriends) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleFriends.friends":
+		if x.Friends == nil {
+			x.Friends = []string{}
+		}
+		value := &_ModuleFriends_3_list{list: &x.Friends}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleFriends.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.ModuleFriends is not mutable"))
+	case "initia.move.v1.ModuleFriends.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.ModuleFriends is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleFriends"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleFriends does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleFriends) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleFriends.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleFriends.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleFriends.friends":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ModuleFriends_3_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleFriends"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleFriends does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleFriends) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ModuleFriends", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleFriends) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleFriends) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleFriends) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleFriends) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleFriends)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.Friends) > 0 {
+			for _, s := range x.Friends {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleFriends)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Friends) > 0 {
+			for iNdEx := len(x.Friends) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Friends[iNdEx])
+				copy(dAtA[i:], x.Friends[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Friends[iNdEx])))
+				i--
+				dAtA[i] = 0x1a
+			}
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleFriends)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleFriends: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleFriends: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Friends", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Friends = append(x.Friends, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_FunctionSignature_6_list)(nil)
+
+type _FunctionSignature_6_list struct {
+	list *[]string
+}
+
+func (x *_FunctionSignature_6_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FunctionSignature_6_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FunctionSignature_6_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FunctionSignature_6_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FunctionSignature_6_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FunctionSignature at list field TypeParams as it is not of Message kind"))
+}
+
+func (x *_FunctionSignature_6_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FunctionSignature_6_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FunctionSignature_6_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FunctionSignature_7_list)(nil)
+
+type _FunctionSignature_7_list struct {
+	list *[]string
+}
+
+func (x *_FunctionSignature_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FunctionSignature_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FunctionSignature_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FunctionSignature_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FunctionSignature_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FunctionSignature at list field Params as it is not of Message kind"))
+}
+
+func (x *_FunctionSignature_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FunctionSignature_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FunctionSignature_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_FunctionSignature_8_list)(nil)
+
+type _FunctionSignature_8_list struct {
+	list *[]string
+}
+
+func (x *_FunctionSignature_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_FunctionSignature_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_FunctionSignature_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_FunctionSignature_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_FunctionSignature_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message FunctionSignature at list field Returns as it is not of Message kind"))
+}
+
+func (x *_FunctionSignature_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_FunctionSignature_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_FunctionSignature_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_FunctionSignature             protoreflect.MessageDescriptor
+	fd_FunctionSignature_address     protoreflect.FieldDescriptor
+	fd_FunctionSignature_module_name protoreflect.FieldDescriptor
+	fd_FunctionSignature_name        protoreflect.FieldDescriptor
+	fd_FunctionSignature_is_entry    protoreflect.FieldDescriptor
+	fd_FunctionSignature_is_view     protoreflect.FieldDescriptor
+	fd_FunctionSignature_type_params protoreflect.FieldDescriptor
+	fd_FunctionSignature_params      protoreflect.FieldDescriptor
+	fd_FunctionSignature_returns     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_FunctionSignature = File_initia_move_v1_types_proto.Messages().ByName("FunctionSignature")
+	fd_FunctionSignature_address = md_FunctionSignature.Fields().ByName("address")
+	fd_FunctionSignature_module_name = md_FunctionSignature.Fields().ByName("module_name")
+	fd_FunctionSignature_name = md_FunctionSignature.Fields().ByName("name")
+	fd_FunctionSignature_is_entry = md_FunctionSignature.Fields().ByName("is_entry")
+	fd_FunctionSignature_is_view = md_FunctionSignature.Fields().ByName("is_view")
+	fd_FunctionSignature_type_params = md_FunctionSignature.Fields().ByName("type_params")
+	fd_FunctionSignature_params = md_FunctionSignature.Fields().ByName("params")
+	fd_FunctionSignature_returns = md_FunctionSignature.Fields().ByName("returns")
+}
+
+var _ protoreflect.Message = (*fastReflection_FunctionSignature)(nil)
+
+type fastReflection_FunctionSignature FunctionSignature
+
+func (x *FunctionSignature) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_FunctionSignature)(x)
+}
+
+func (x *FunctionSignature) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_FunctionSignature_messageType fastReflection_FunctionSignature_messageType
+var _ protoreflect.MessageType = fastReflection_FunctionSignature_messageType{}
+
+type fastReflection_FunctionSignature_messageType struct{}
+
+func (x fastReflection_FunctionSignature_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_FunctionSignature)(nil)
+}
+func (x fastReflection_FunctionSignature_messageType) New() protoreflect.Message {
+	return new(fastReflection_FunctionSignature)
+}
+func (x fastReflection_FunctionSignature_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunctionSignature
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_FunctionSignature) Descriptor() protoreflect.MessageDescriptor {
+	return md_FunctionSignature
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_FunctionSignature) Type() protoreflect.MessageType {
+	return _fastReflection_FunctionSignature_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_FunctionSignature) New() protoreflect.Message {
+	return new(fastReflection_FunctionSignature)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_FunctionSignature) Interface() protoreflect.ProtoMessage {
+	return (*FunctionSignature)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_FunctionSignature) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_FunctionSignature_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_FunctionSignature_module_name, value) {
+			return
+		}
+	}
+	if x.Name != "" {
+		value := protoreflect.ValueOfString(x.Name)
+		if !f(fd_FunctionSignature_name, value) {
+			return
+		}
+	}
+	if x.IsEntry != false {
+		value := protoreflect.ValueOfBool(x.IsEntry)
+		if !f(fd_FunctionSignature_is_entry, value) {
+			return
+		}
+	}
+	if x.IsView != false {
+		value := protoreflect.ValueOfBool(x.IsView)
+		if !f(fd_FunctionSignature_is_view, value) {
+			return
+		}
+	}
+	if len(x.TypeParams) != 0 {
+		value := protoreflect.ValueOfList(&_FunctionSignature_6_list{list: &x.TypeParams})
+		if !f(fd_FunctionSignature_type_params, value) {
+			return
+		}
+	}
+	if len(x.Params) != 0 {
+		value := protoreflect.ValueOfList(&_FunctionSignature_7_list{list: &x.Params})
+		if !f(fd_FunctionSignature_params, value) {
+			return
+		}
+	}
+	if len(x.Returns) != 0 {
+		value := protoreflect.ValueOfList(&_FunctionSignature_8_list{list: &x.Returns})
+		if !f(fd_FunctionSignature_returns, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_FunctionSignature) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.FunctionSignature.address":
+		return x.Address != ""
+	case "initia.move.v1.FunctionSignature.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.FunctionSignature.name":
+		return x.Name != ""
+	case "initia.move.v1.FunctionSignature.is_entry":
+		return x.IsEntry != false
+	case "initia.move.v1.FunctionSignature.is_view":
+		return x.IsView != false
+	case "initia.move.v1.FunctionSignature.type_params":
+		return len(x.TypeParams) != 0
+	case "initia.move.v1.FunctionSignature.params":
+		return len(x.Params) != 0
+	case "initia.move.v1.FunctionSignature.returns":
+		return len(x.Returns) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.FunctionSignature"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.FunctionSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionSignature) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.FunctionSignature.address":
+		x.Address = ""
+	case "initia.move.v1.FunctionSignature.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.FunctionSignature.name":
+		x.Name = ""
+	case "initia.move.v1.FunctionSignature.is_entry":
+		x.IsEntry = false
+	case "initia.move.v1.FunctionSignature.is_view":
+		x.IsView = false
+	case "initia.move.v1.FunctionSignature.type_params":
+		x.TypeParams = nil
+	case "initia.move.v1.FunctionSignature.params":
+		x.Params = nil
+	case "initia.move.v1.FunctionSignature.returns":
+		x.Returns = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.FunctionSignature"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.FunctionSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_FunctionSignature) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.FunctionSignature.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.FunctionSignature.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.FunctionSignature.name":
+		value := x.Name
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.FunctionSignature.is_entry":
+		value := x.IsEntry
+		return protoreflect.ValueOfBool(value)
+	case "initia.move.v1.FunctionSignature.is_view":
+		value := x.IsView
+		return protoreflect.ValueOfBool(value)
+	case "initia.move.v1.FunctionSignature.type_params":
+		if len(x.TypeParams) == 0 {
+			return protoreflect.ValueOfList(&_FunctionSignature_6_list{})
+		}
+		listValue := &_FunctionSignature_6_list{list: &x.TypeParams}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.FunctionSignature.params":
+		if len(x.Params) == 0 {
+			return protoreflect.ValueOfList(&_FunctionSignature_7_list{})
+		}
+		listValue := &_FunctionSignature_7_list{list: &x.Params}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.FunctionSignature.returns":
+		if len(x.Returns) == 0 {
+			return protoreflect.ValueOfList(&_FunctionSignature_8_list{})
+		}
+		listValue := &_FunctionSignature_8_list{list: &x.Returns}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.FunctionSignature"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.FunctionSignature does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionSignature) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.FunctionSignature.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.FunctionSignature.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.FunctionSignature.name":
+		x.Name = value.Interface().(string)
+	case "initia.move.v1.FunctionSignature.is_entry":
+		x.IsEntry = value.Bool()
+	case "initia.move.v1.FunctionSignature.is_view":
+		x.IsView = value.Bool()
+	case "initia.move.v1.FunctionSignature.type_params":
+		lv := value.List()
+		clv := lv.(*_FunctionSignature_6_list)
+		x.TypeParams = *clv.list
+	case "initia.move.v1.FunctionSignature.params":
+		lv := value.List()
+		clv := lv.(*_FunctionSignature_7_list)
+		x.Params = *clv.list
+	case "initia.move.v1.FunctionSignature.returns":
+		lv := value.List()
+		clv := lv.(*_FunctionSignature_8_list)
+		x.Returns = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.FunctionSignature"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.FunctionSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionSignature) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.FunctionSignature.type_params":
+		if x.TypeParams == nil {
+			x.TypeParams = []string{}
+		}
+		value := &_FunctionSignature_6_list{list: &x.TypeParams}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.FunctionSignature.params":
+		if x.Params == nil {
+			x.Params = []string{}
+		}
+		value := &_FunctionSignature_7_list{list: &x.Params}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.FunctionSignature.returns":
+		if x.Returns == nil {
+			x.Returns = []string{}
+		}
+		value := &_FunctionSignature_8_list{list: &x.Returns}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.FunctionSignature.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.FunctionSignature is not mutable"))
+	case "initia.move.v1.FunctionSignature.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.FunctionSignature is not mutable"))
+	case "initia.move.v1.FunctionSignature.name":
+		panic(fmt.Errorf("field name of message initia.move.v1.FunctionSignature is not mutable"))
+	case "initia.move.v1.FunctionSignature.is_entry":
+		panic(fmt.Errorf("field is_entry of message initia.move.v1.FunctionSignature is not mutable"))
+	case "initia.move.v1.FunctionSignature.is_view":
+		panic(fmt.Errorf("field is_view of message initia.move.v1.FunctionSignature is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.FunctionSignature"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.FunctionSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_FunctionSignature) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.FunctionSignature.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.FunctionSignature.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.FunctionSignature.name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.FunctionSignature.is_entry":
+		return protoreflect.ValueOfBool(false)
+	case "initia.move.v1.FunctionSignature.is_view":
+		return protoreflect.ValueOfBool(false)
+	case "initia.move.v1.FunctionSignature.type_params":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FunctionSignature_6_list{list: &list})
+	case "initia.move.v1.FunctionSignature.params":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FunctionSignature_7_list{list: &list})
+	case "initia.move.v1.FunctionSignature.returns":
+		list := []string{}
+		return protoreflect.ValueOfList(&_FunctionSignature_8_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.FunctionSignature"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.FunctionSignature does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_FunctionSignature) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.FunctionSignature", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_FunctionSignature) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_FunctionSignature) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_FunctionSignature) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_FunctionSignature) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*FunctionSignature)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.Name)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsEntry {
+			n += 2
+		}
+		if x.IsView {
+			n += 2
+		}
+		if len(x.TypeParams) > 0 {
+			for _, s := range x.TypeParams {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Params) > 0 {
+			for _, s := range x.Params {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.Returns) > 0 {
+			for _, s := range x.Returns {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*FunctionSignature)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Returns) > 0 {
+			for iNdEx := len(x.Returns) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Returns[iNdEx])
+				copy(dAtA[i:], x.Returns[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Returns[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.Params) > 0 {
+			for iNdEx := len(x.Params) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.Params[iNdEx])
+				copy(dAtA[i:], x.Params[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Params[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.TypeParams) > 0 {
+			for iNdEx := len(x.TypeParams) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.TypeParams[iNdEx])
+				copy(dAtA[i:], x.TypeParams[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.TypeParams[iNdEx])))
+				i--
+				dAtA[i] = 0x32
+			}
+		}
+		if x.IsView {
+			i--
+			if x.IsView {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.IsEntry {
+			i--
+			if x.IsEntry {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x20
+		}
+		if len(x.Name) > 0 {
+			i -= len(x.Name)
+			copy(dAtA[i:], x.Name)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Name)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*FunctionSignature)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunctionSignature: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: FunctionSignature: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Name = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsEntry", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsEntry = bool(v != 0)
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsView", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsView = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field TypeParams", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.TypeParams = append(x.TypeParams, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Params = append(x.Params, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Returns", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Returns = append(x.Returns, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var _ protoreflect.List = (*_ModuleUpgradeCompatibility_7_list)(nil)
+
+type _ModuleUpgradeCompatibility_7_list struct {
+	list *[]string
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ModuleUpgradeCompatibility at list field RemovedFunctions as it is not of Message kind"))
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ModuleUpgradeCompatibility_7_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ModuleUpgradeCompatibility_8_list)(nil)
+
+type _ModuleUpgradeCompatibility_8_list struct {
+	list *[]string
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ModuleUpgradeCompatibility at list field ChangedFunctions as it is not of Message kind"))
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ModuleUpgradeCompatibility_8_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ModuleUpgradeCompatibility_9_list)(nil)
+
+type _ModuleUpgradeCompatibility_9_list struct {
+	list *[]string
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ModuleUpgradeCompatibility at list field RemovedStructs as it is not of Message kind"))
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ModuleUpgradeCompatibility_9_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ModuleUpgradeCompatibility_10_list)(nil)
+
+type _ModuleUpgradeCompatibility_10_list struct {
+	list *[]string
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfString((*x.list)[i])
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.String()
+	concreteValue := valueUnwrapped
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) AppendMutable() protoreflect.Value {
+	panic(fmt.Errorf("AppendMutable can not be called on message ModuleUpgradeCompatibility at list field ChangedStructs as it is not of Message kind"))
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) Truncate(n int) {
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) NewElement() protoreflect.Value {
+	v := ""
+	return protoreflect.ValueOfString(v)
+}
+
+func (x *_ModuleUpgradeCompatibility_10_list) IsValid() bool {
+	return x.list != nil
+}
+
+var _ protoreflect.List = (*_ModuleUpgradeCompatibility_11_list)(nil)
+
+type _ModuleUpgradeCompatibility_11_list struct {
+	list *[]*ModuleIdentifier
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) Len() int {
+	if x.list == nil {
+		return 0
+	}
+	return len(*x.list)
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) Get(i int) protoreflect.Value {
+	return protoreflect.ValueOfMessage((*x.list)[i].ProtoReflect())
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) Set(i int, value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleIdentifier)
+	(*x.list)[i] = concreteValue
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) Append(value protoreflect.Value) {
+	valueUnwrapped := value.Message()
+	concreteValue := valueUnwrapped.Interface().(*ModuleIdentifier)
+	*x.list = append(*x.list, concreteValue)
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) AppendMutable() protoreflect.Value {
+	v := new(ModuleIdentifier)
+	*x.list = append(*x.list, v)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) Truncate(n int) {
+	for i := n; i < len(*x.list); i++ {
+		(*x.list)[i] = nil
+	}
+	*x.list = (*x.list)[:n]
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) NewElement() protoreflect.Value {
+	v := new(ModuleIdentifier)
+	return protoreflect.ValueOfMessage(v.ProtoReflect())
+}
+
+func (x *_ModuleUpgradeCompatibility_11_list) IsValid() bool {
+	return x.list != nil
+}
+
+var (
+	md_ModuleUpgradeCompatibility                       protoreflect.MessageDescriptor
+	fd_ModuleUpgradeCompatibility_address               protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_module_name           protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_is_new                protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_stored_upgrade_policy protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_allowed               protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_reason                protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_removed_functions     protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_changed_functions     protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_removed_structs       protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_changed_structs       protoreflect.FieldDescriptor
+	fd_ModuleUpgradeCompatibility_broken_dependents     protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_ModuleUpgradeCompatibility = File_initia_move_v1_types_proto.Messages().ByName("ModuleUpgradeCompatibility")
+	fd_ModuleUpgradeCompatibility_address = md_ModuleUpgradeCompatibility.Fields().ByName("address")
+	fd_ModuleUpgradeCompatibility_module_name = md_ModuleUpgradeCompatibility.Fields().ByName("module_name")
+	fd_ModuleUpgradeCompatibility_is_new = md_ModuleUpgradeCompatibility.Fields().ByName("is_new")
+	fd_ModuleUpgradeCompatibility_stored_upgrade_policy = md_ModuleUpgradeCompatibility.Fields().ByName("stored_upgrade_policy")
+	fd_ModuleUpgradeCompatibility_allowed = md_ModuleUpgradeCompatibility.Fields().ByName("allowed")
+	fd_ModuleUpgradeCompatibility_reason = md_ModuleUpgradeCompatibility.Fields().ByName("reason")
+	fd_ModuleUpgradeCompatibility_removed_functions = md_ModuleUpgradeCompatibility.Fields().ByName("removed_functions")
+	fd_ModuleUpgradeCompatibility_changed_functions = md_ModuleUpgradeCompatibility.Fields().ByName("changed_functions")
+	fd_ModuleUpgradeCompatibility_removed_structs = md_ModuleUpgradeCompatibility.Fields().ByName("removed_structs")
+	fd_ModuleUpgradeCompatibility_changed_structs = md_ModuleUpgradeCompatibility.Fields().ByName("changed_structs")
+	fd_ModuleUpgradeCompatibility_broken_dependents = md_ModuleUpgradeCompatibility.Fields().ByName("broken_dependents")
+}
+
+var _ protoreflect.Message = (*fastReflection_ModuleUpgradeCompatibility)(nil)
+
+type fastReflection_ModuleUpgradeCompatibility ModuleUpgradeCompatibility
+
+func (x *ModuleUpgradeCompatibility) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_ModuleUpgradeCompatibility)(x)
+}
+
+func (x *ModuleUpgradeCompatibility) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_ModuleUpgradeCompatibility_messageType fastReflection_ModuleUpgradeCompatibility_messageType
+var _ protoreflect.MessageType = fastReflection_ModuleUpgradeCompatibility_messageType{}
+
+type fastReflection_ModuleUpgradeCompatibility_messageType struct{}
+
+func (x fastReflection_ModuleUpgradeCompatibility_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_ModuleUpgradeCompatibility)(nil)
+}
+func (x fastReflection_ModuleUpgradeCompatibility_messageType) New() protoreflect.Message {
+	return new(fastReflection_ModuleUpgradeCompatibility)
+}
+func (x fastReflection_ModuleUpgradeCompatibility_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleUpgradeCompatibility
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_ModuleUpgradeCompatibility) Descriptor() protoreflect.MessageDescriptor {
+	return md_ModuleUpgradeCompatibility
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_ModuleUpgradeCompatibility) Type() protoreflect.MessageType {
+	return _fastReflection_ModuleUpgradeCompatibility_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_ModuleUpgradeCompatibility) New() protoreflect.Message {
+	return new(fastReflection_ModuleUpgradeCompatibility)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_ModuleUpgradeCompatibility) Interface() protoreflect.ProtoMessage {
+	return (*ModuleUpgradeCompatibility)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_ModuleUpgradeCompatibility) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_ModuleUpgradeCompatibility_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_ModuleUpgradeCompatibility_module_name, value) {
+			return
+		}
+	}
+	if x.IsNew != false {
+		value := protoreflect.ValueOfBool(x.IsNew)
+		if !f(fd_ModuleUpgradeCompatibility_is_new, value) {
+			return
+		}
+	}
+	if x.StoredUpgradePolicy != 0 {
+		value := protoreflect.ValueOfEnum((protoreflect.EnumNumber)(x.StoredUpgradePolicy))
+		if !f(fd_ModuleUpgradeCompatibility_stored_upgrade_policy, value) {
+			return
+		}
+	}
+	if x.Allowed != false {
+		value := protoreflect.ValueOfBool(x.Allowed)
+		if !f(fd_ModuleUpgradeCompatibility_allowed, value) {
+			return
+		}
+	}
+	if x.Reason != "" {
+		value := protoreflect.ValueOfString(x.Reason)
+		if !f(fd_ModuleUpgradeCompatibility_reason, value) {
+			return
+		}
+	}
+	if len(x.RemovedFunctions) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_7_list{list: &x.RemovedFunctions})
+		if !f(fd_ModuleUpgradeCompatibility_removed_functions, value) {
+			return
+		}
+	}
+	if len(x.ChangedFunctions) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_8_list{list: &x.ChangedFunctions})
+		if !f(fd_ModuleUpgradeCompatibility_changed_functions, value) {
+			return
+		}
+	}
+	if len(x.RemovedStructs) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_9_list{list: &x.RemovedStructs})
+		if !f(fd_ModuleUpgradeCompatibility_removed_structs, value) {
+			return
+		}
+	}
+	if len(x.ChangedStructs) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_10_list{list: &x.ChangedStructs})
+		if !f(fd_ModuleUpgradeCompatibility_changed_structs, value) {
+			return
+		}
+	}
+	if len(x.BrokenDependents) != 0 {
+		value := protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_11_list{list: &x.BrokenDependents})
+		if !f(fd_ModuleUpgradeCompatibility_broken_dependents, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_ModuleUpgradeCompatibility) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleUpgradeCompatibility.address":
+		return x.Address != ""
+	case "initia.move.v1.ModuleUpgradeCompatibility.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.ModuleUpgradeCompatibility.is_new":
+		return x.IsNew != false
+	case "initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy":
+		return x.StoredUpgradePolicy != 0
+	case "initia.move.v1.ModuleUpgradeCompatibility.allowed":
+		return x.Allowed != false
+	case "initia.move.v1.ModuleUpgradeCompatibility.reason":
+		return x.Reason != ""
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_functions":
+		return len(x.RemovedFunctions) != 0
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_functions":
+		return len(x.ChangedFunctions) != 0
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_structs":
+		return len(x.RemovedStructs) != 0
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_structs":
+		return len(x.ChangedStructs) != 0
+	case "initia.move.v1.ModuleUpgradeCompatibility.broken_dependents":
+		return len(x.BrokenDependents) != 0
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleUpgradeCompatibility"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleUpgradeCompatibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleUpgradeCompatibility) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleUpgradeCompatibility.address":
+		x.Address = ""
+	case "initia.move.v1.ModuleUpgradeCompatibility.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.ModuleUpgradeCompatibility.is_new":
+		x.IsNew = false
+	case "initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy":
+		x.StoredUpgradePolicy = 0
+	case "initia.move.v1.ModuleUpgradeCompatibility.allowed":
+		x.Allowed = false
+	case "initia.move.v1.ModuleUpgradeCompatibility.reason":
+		x.Reason = ""
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_functions":
+		x.RemovedFunctions = nil
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_functions":
+		x.ChangedFunctions = nil
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_structs":
+		x.RemovedStructs = nil
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_structs":
+		x.ChangedStructs = nil
+	case "initia.move.v1.ModuleUpgradeCompatibility.broken_dependents":
+		x.BrokenDependents = nil
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleUpgradeCompatibility"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleUpgradeCompatibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_ModuleUpgradeCompatibility) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.ModuleUpgradeCompatibility.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.is_new":
+		value := x.IsNew
+		return protoreflect.ValueOfBool(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy":
+		value := x.StoredUpgradePolicy
+		return protoreflect.ValueOfEnum((protoreflect.EnumNumber)(value))
+	case "initia.move.v1.ModuleUpgradeCompatibility.allowed":
+		value := x.Allowed
+		return protoreflect.ValueOfBool(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.reason":
+		value := x.Reason
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_functions":
+		if len(x.RemovedFunctions) == 0 {
+			return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_7_list{})
+		}
+		listValue := &_ModuleUpgradeCompatibility_7_list{list: &x.RemovedFunctions}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_functions":
+		if len(x.ChangedFunctions) == 0 {
+			return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_8_list{})
+		}
+		listValue := &_ModuleUpgradeCompatibility_8_list{list: &x.ChangedFunctions}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_structs":
+		if len(x.RemovedStructs) == 0 {
+			return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_9_list{})
+		}
+		listValue := &_ModuleUpgradeCompatibility_9_list{list: &x.RemovedStructs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_structs":
+		if len(x.ChangedStructs) == 0 {
+			return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_10_list{})
+		}
+		listValue := &_ModuleUpgradeCompatibility_10_list{list: &x.ChangedStructs}
+		return protoreflect.ValueOfList(listValue)
+	case "initia.move.v1.ModuleUpgradeCompatibility.broken_dependents":
+		if len(x.BrokenDependents) == 0 {
+			return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_11_list{})
+		}
+		listValue := &_ModuleUpgradeCompatibility_11_list{list: &x.BrokenDependents}
+		return protoreflect.ValueOfList(listValue)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleUpgradeCompatibility"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleUpgradeCompatibility does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleUpgradeCompatibility) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleUpgradeCompatibility.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.ModuleUpgradeCompatibility.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.ModuleUpgradeCompatibility.is_new":
+		x.IsNew = value.Bool()
+	case "initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy":
+		x.StoredUpgradePolicy = (UpgradePolicy)(value.Enum())
+	case "initia.move.v1.ModuleUpgradeCompatibility.allowed":
+		x.Allowed = value.Bool()
+	case "initia.move.v1.ModuleUpgradeCompatibility.reason":
+		x.Reason = value.Interface().(string)
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_functions":
+		lv := value.List()
+		clv := lv.(*_ModuleUpgradeCompatibility_7_list)
+		x.RemovedFunctions = *clv.list
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_functions":
+		lv := value.List()
+		clv := lv.(*_ModuleUpgradeCompatibility_8_list)
+		x.ChangedFunctions = *clv.list
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_structs":
+		lv := value.List()
+		clv := lv.(*_ModuleUpgradeCompatibility_9_list)
+		x.RemovedStructs = *clv.list
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_structs":
+		lv := value.List()
+		clv := lv.(*_ModuleUpgradeCompatibility_10_list)
+		x.ChangedStructs = *clv.list
+	case "initia.move.v1.ModuleUpgradeCompatibility.broken_dependents":
+		lv := value.List()
+		clv := lv.(*_ModuleUpgradeCompatibility_11_list)
+		x.BrokenDependents = *clv.list
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleUpgradeCompatibility"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleUpgradeCompatibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleUpgradeCompatibility) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_functions":
+		if x.RemovedFunctions == nil {
+			x.RemovedFunctions = []string{}
+		}
+		value := &_ModuleUpgradeCompatibility_7_list{list: &x.RemovedFunctions}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_functions":
+		if x.ChangedFunctions == nil {
+			x.ChangedFunctions = []string{}
+		}
+		value := &_ModuleUpgradeCompatibility_8_list{list: &x.ChangedFunctions}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_structs":
+		if x.RemovedStructs == nil {
+			x.RemovedStructs = []string{}
+		}
+		value := &_ModuleUpgradeCompatibility_9_list{list: &x.RemovedStructs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_structs":
+		if x.ChangedStructs == nil {
+			x.ChangedStructs = []string{}
+		}
+		value := &_ModuleUpgradeCompatibility_10_list{list: &x.ChangedStructs}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.broken_dependents":
+		if x.BrokenDependents == nil {
+			x.BrokenDependents = []*ModuleIdentifier{}
+		}
+		value := &_ModuleUpgradeCompatibility_11_list{list: &x.BrokenDependents}
+		return protoreflect.ValueOfList(value)
+	case "initia.move.v1.ModuleUpgradeCompatibility.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.ModuleUpgradeCompatibility is not mutable"))
+	case "initia.move.v1.ModuleUpgradeCompatibility.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.ModuleUpgradeCompatibility is not mutable"))
+	case "initia.move.v1.ModuleUpgradeCompatibility.is_new":
+		panic(fmt.Errorf("field is_new of message initia.move.v1.ModuleUpgradeCompatibility is not mutable"))
+	case "initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy":
+		panic(fmt.Errorf("field stored_upgrade_policy of message initia.move.v1.ModuleUpgradeCompatibility is not mutable"))
+	case "initia.move.v1.ModuleUpgradeCompatibility.allowed":
+		panic(fmt.Errorf("field allowed of message initia.move.v1.ModuleUpgradeCompatibility is not mutable"))
+	case "initia.move.v1.ModuleUpgradeCompatibility.reason":
+		panic(fmt.Errorf("field reason of message initia.move.v1.ModuleUpgradeCompatibility is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleUpgradeCompatibility"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleUpgradeCompatibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_ModuleUpgradeCompatibility) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.ModuleUpgradeCompatibility.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleUpgradeCompatibility.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleUpgradeCompatibility.is_new":
+		return protoreflect.ValueOfBool(false)
+	case "initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy":
+		return protoreflect.ValueOfEnum(0)
+	case "initia.move.v1.ModuleUpgradeCompatibility.allowed":
+		return protoreflect.ValueOfBool(false)
+	case "initia.move.v1.ModuleUpgradeCompatibility.reason":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_functions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_7_list{list: &list})
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_functions":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_8_list{list: &list})
+	case "initia.move.v1.ModuleUpgradeCompatibility.removed_structs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_9_list{list: &list})
+	case "initia.move.v1.ModuleUpgradeCompatibility.changed_structs":
+		list := []string{}
+		return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_10_list{list: &list})
+	case "initia.move.v1.ModuleUpgradeCompatibility.broken_dependents":
+		list := []*ModuleIdentifier{}
+		return protoreflect.ValueOfList(&_ModuleUpgradeCompatibility_11_list{list: &list})
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.ModuleUpgradeCompatibility"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.ModuleUpgradeCompatibility does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_ModuleUpgradeCompatibility) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.ModuleUpgradeCompatibility", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_ModuleUpgradeCompatibility) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_ModuleUpgradeCompatibility) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_ModuleUpgradeCompatibility) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_ModuleUpgradeCompatibility) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*ModuleUpgradeCompatibility)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.IsNew {
+			n += 2
+		}
+		if x.StoredUpgradePolicy != 0 {
+			n += 1 + runtime.Sov(uint64(x.StoredUpgradePolicy))
+		}
+		if x.Allowed {
+			n += 2
+		}
+		l = len(x.Reason)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if len(x.RemovedFunctions) > 0 {
+			for _, s := range x.RemovedFunctions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChangedFunctions) > 0 {
+			for _, s := range x.ChangedFunctions {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.RemovedStructs) > 0 {
+			for _, s := range x.RemovedStructs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.ChangedStructs) > 0 {
+			for _, s := range x.ChangedStructs {
+				l = len(s)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if len(x.BrokenDependents) > 0 {
+			for _, e := range x.BrokenDependents {
+				l = options.Size(e)
+				n += 1 + l + runtime.Sov(uint64(l))
+			}
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleUpgradeCompatibility)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.BrokenDependents) > 0 {
+			for iNdEx := len(x.BrokenDependents) - 1; iNdEx >= 0; iNdEx-- {
+				encoded, err := options.Marshal(x.BrokenDependents[iNdEx])
+				if err != nil {
+					return protoiface.MarshalOutput{
+						NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+						Buf:               input.Buf,
+					}, err
+				}
+				i -= len(encoded)
+				copy(dAtA[i:], encoded)
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(encoded)))
+				i--
+				dAtA[i] = 0x5a
+			}
+		}
+		if len(x.ChangedStructs) > 0 {
+			for iNdEx := len(x.ChangedStructs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ChangedStructs[iNdEx])
+				copy(dAtA[i:], x.ChangedStructs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChangedStructs[iNdEx])))
+				i--
+				dAtA[i] = 0x52
+			}
+		}
+		if len(x.RemovedStructs) > 0 {
+			for iNdEx := len(x.RemovedStructs) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedStructs[iNdEx])
+				copy(dAtA[i:], x.RemovedStructs[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedStructs[iNdEx])))
+				i--
+				dAtA[i] = 0x4a
+			}
+		}
+		if len(x.ChangedFunctions) > 0 {
+			for iNdEx := len(x.ChangedFunctions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.ChangedFunctions[iNdEx])
+				copy(dAtA[i:], x.ChangedFunctions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ChangedFunctions[iNdEx])))
+				i--
+				dAtA[i] = 0x42
+			}
+		}
+		if len(x.RemovedFunctions) > 0 {
+			for iNdEx := len(x.RemovedFunctions) - 1; iNdEx >= 0; iNdEx-- {
+				i -= len(x.RemovedFunctions[iNdEx])
+				copy(dAtA[i:], x.RemovedFunctions[iNdEx])
+				i = runtime.EncodeVarint(dAtA, i, uint64(len(x.RemovedFunctions[iNdEx])))
+				i--
+				dAtA[i] = 0x3a
+			}
+		}
+		if len(x.Reason) > 0 {
+			i -= len(x.Reason)
+			copy(dAtA[i:], x.Reason)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Reason)))
+			i--
+			dAtA[i] = 0x32
+		}
+		if x.Allowed {
+			i--
+			if x.Allowed {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x28
+		}
+		if x.StoredUpgradePolicy != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.StoredUpgradePolicy))
+			i--
+			dAtA[i] = 0x20
+		}
+		if x.IsNew {
+			i--
+			if x.IsNew {
+				dAtA[i] = 1
+			} else {
+				dAtA[i] = 0
+			}
+			i--
+			dAtA[i] = 0x18
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*ModuleUpgradeCompatibility)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleUpgradeCompatibility: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: ModuleUpgradeCompatibility: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field IsNew", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.IsNew = bool(v != 0)
+			case 4:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field StoredUpgradePolicy", wireType)
+				}
+				x.StoredUpgradePolicy = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.StoredUpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 5:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
+				}
+				var v int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				x.Allowed = bool(v != 0)
+			case 6:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Reason = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 7:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedFunctions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedFunctions = append(x.RemovedFunctions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 8:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangedFunctions", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChangedFunctions = append(x.ChangedFunctions, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 9:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field RemovedStructs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.RemovedStructs = append(x.RemovedStructs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 10:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ChangedStructs", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ChangedStructs = append(x.ChangedStructs, string(dAtA[iNdEx:postIndex]))
+				iNdEx = postIndex
+			case 11:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field BrokenDependents", wireType)
+				}
+				var msglen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					msglen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if msglen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + msglen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.BrokenDependents = append(x.BrokenDependents, &ModuleIdentifier{})
+				if err := options.Unmarshal(dAtA[iNdEx:postIndex], x.BrokenDependents[len(x.BrokenDependents)-1]); err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Authenticator                protoreflect.MessageDescriptor
+	fd_Authenticator_address        protoreflect.FieldDescriptor
+	fd_Authenticator_module_address protoreflect.FieldDescriptor
+	fd_Authenticator_module_name    protoreflect.FieldDescriptor
+	fd_Authenticator_function_name  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_Authenticator = File_initia_move_v1_types_proto.Messages().ByName("Authenticator")
+	fd_Authenticator_address = md_Authenticator.Fields().ByName("address")
+	fd_Authenticator_module_address = md_Authenticator.Fields().ByName("module_address")
+	fd_Authenticator_module_name = md_Authenticator.Fields().ByName("module_name")
+	fd_Authenticator_function_name = md_Authenticator.Fields().ByName("function_name")
+}
+
+var _ protoreflect.Message = (*fastReflection_Authenticator)(nil)
+
+type fastReflection_Authenticator Authenticator
+
+func (x *Authenticator) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Authenticator)(x)
+}
+
+func (x *Authenticator) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Authenticator_messageType fastReflection_Authenticator_messageType
+var _ protoreflect.MessageType = fastReflection_Authenticator_messageType{}
+
+type fastReflection_Authenticator_messageType struct{}
+
+func (x fastReflection_Authenticator_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Authenticator)(nil)
+}
+func (x fastReflection_Authenticator_messageType) New() protoreflect.Message {
+	return new(fastReflection_Authenticator)
+}
+func (x fastReflection_Authenticator_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Authenticator
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Authenticator) Descriptor() protoreflect.MessageDescriptor {
+	return md_Authenticator
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Authenticator) Type() protoreflect.MessageType {
+	return _fastReflection_Authenticator_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Authenticator) New() protoreflect.Message {
+	return new(fastReflection_Authenticator)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Authenticator) Interface() protoreflect.ProtoMessage {
+	return (*Authenticator)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Authenticator) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Authenticator_address, value) {
+			return
+		}
+	}
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_Authenticator_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_Authenticator_module_name, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_Authenticator_function_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Authenticator) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.Authenticator.address":
+		return x.Address != ""
+	case "initia.move.v1.Authenticator.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.Authenticator.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.Authenticator.function_name":
+		return x.FunctionName != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Authenticator"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Authenticator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Authenticator) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.Authenticator.address":
+		x.Address = ""
+	case "initia.move.v1.Authenticator.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.Authenticator.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.Authenticator.function_name":
+		x.FunctionName = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Authenticator"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Authenticator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Authenticator) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.Authenticator.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Authenticator.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Authenticator.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Authenticator.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Authenticator"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Authenticator does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Authenticator) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.Authenticator.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.Authenticator.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.Authenticator.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.Authenticator.function_name":
+		x.FunctionName = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Authenticator"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Authenticator does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Authenticator) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.Authenticator.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.Authenticator is not mutable"))
+	case "initia.move.v1.Authenticator.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.Authenticator is not mutable"))
+	case "initia.move.v1.Authenticator.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.Authenticator is not mutable"))
+	case "initia.move.v1.Authenticator.function_name":
+		panic(fmt.Errorf("field function_name of message initia.move.v1.Authenticator is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Authenticator"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Authenticator does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Authenticator) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.Authenticator.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Authenticator.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Authenticator.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Authenticator.function_name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Authenticator"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Authenticator does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Authenticator) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.Authenticator", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Authenticator) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Authenticator) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Authenticator) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Authenticator) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Authenticator)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Authenticator)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Authenticator)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Authenticator: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Authenticator: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_Sponsor                protoreflect.MessageDescriptor
+	fd_Sponsor_address        protoreflect.FieldDescriptor
+	fd_Sponsor_module_address protoreflect.FieldDescriptor
+	fd_Sponsor_module_name    protoreflect.FieldDescriptor
+	fd_Sponsor_function_name  protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_Sponsor = File_initia_move_v1_types_proto.Messages().ByName("Sponsor")
+	fd_Sponsor_address = md_Sponsor.Fields().ByName("address")
+	fd_Sponsor_module_address = md_Sponsor.Fields().ByName("module_address")
+	fd_Sponsor_module_name = md_Sponsor.Fields().ByName("module_name")
+	fd_Sponsor_function_name = md_Sponsor.Fields().ByName("function_name")
+}
+
+var _ protoreflect.Message = (*fastReflection_Sponsor)(nil)
+
+type fastReflection_Sponsor Sponsor
+
+func (x *Sponsor) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_Sponsor)(x)
+}
+
+func (x *Sponsor) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_Sponsor_messageType fastReflection_Sponsor_messageType
+var _ protoreflect.MessageType = fastReflection_Sponsor_messageType{}
+
+type fastReflection_Sponsor_messageType struct{}
+
+func (x fastReflection_Sponsor_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_Sponsor)(nil)
+}
+func (x fastReflection_Sponsor_messageType) New() protoreflect.Message {
+	return new(fastReflection_Sponsor)
+}
+func (x fastReflection_Sponsor_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_Sponsor
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_Sponsor) Descriptor() protoreflect.MessageDescriptor {
+	return md_Sponsor
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_Sponsor) Type() protoreflect.MessageType {
+	return _fastReflection_Sponsor_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_Sponsor) New() protoreflect.Message {
+	return new(fastReflection_Sponsor)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_Sponsor) Interface() protoreflect.ProtoMessage {
+	return (*Sponsor)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_Sponsor) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Address != "" {
+		value := protoreflect.ValueOfString(x.Address)
+		if !f(fd_Sponsor_address, value) {
+			return
+		}
+	}
+	if x.ModuleAddress != "" {
+		value := protoreflect.ValueOfString(x.ModuleAddress)
+		if !f(fd_Sponsor_module_address, value) {
+			return
+		}
+	}
+	if x.ModuleName != "" {
+		value := protoreflect.ValueOfString(x.ModuleName)
+		if !f(fd_Sponsor_module_name, value) {
+			return
+		}
+	}
+	if x.FunctionName != "" {
+		value := protoreflect.ValueOfString(x.FunctionName)
+		if !f(fd_Sponsor_function_name, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_Sponsor) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.Sponsor.address":
+		return x.Address != ""
+	case "initia.move.v1.Sponsor.module_address":
+		return x.ModuleAddress != ""
+	case "initia.move.v1.Sponsor.module_name":
+		return x.ModuleName != ""
+	case "initia.move.v1.Sponsor.function_name":
+		return x.FunctionName != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Sponsor"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Sponsor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Sponsor) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.Sponsor.address":
+		x.Address = ""
+	case "initia.move.v1.Sponsor.module_address":
+		x.ModuleAddress = ""
+	case "initia.move.v1.Sponsor.module_name":
+		x.ModuleName = ""
+	case "initia.move.v1.Sponsor.function_name":
+		x.FunctionName = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Sponsor"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Sponsor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_Sponsor) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.Sponsor.address":
+		value := x.Address
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Sponsor.module_address":
+		value := x.ModuleAddress
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Sponsor.module_name":
+		value := x.ModuleName
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.Sponsor.function_name":
+		value := x.FunctionName
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Sponsor"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Sponsor does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Sponsor) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.Sponsor.address":
+		x.Address = value.Interface().(string)
+	case "initia.move.v1.Sponsor.module_address":
+		x.ModuleAddress = value.Interface().(string)
+	case "initia.move.v1.Sponsor.module_name":
+		x.ModuleName = value.Interface().(string)
+	case "initia.move.v1.Sponsor.function_name":
+		x.FunctionName = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Sponsor"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Sponsor does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Sponsor) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.Sponsor.address":
+		panic(fmt.Errorf("field address of message initia.move.v1.Sponsor is not mutable"))
+	case "initia.move.v1.Sponsor.module_address":
+		panic(fmt.Errorf("field module_address of message initia.move.v1.Sponsor is not mutable"))
+	case "initia.move.v1.Sponsor.module_name":
+		panic(fmt.Errorf("field module_name of message initia.move.v1.Sponsor is not mutable"))
+	case "initia.move.v1.Sponsor.function_name":
+		panic(fmt.Errorf("field function_name of message initia.move.v1.Sponsor is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Sponsor"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Sponsor does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_Sponsor) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.Sponsor.address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Sponsor.module_address":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Sponsor.module_name":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.Sponsor.function_name":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.Sponsor"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.Sponsor does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_Sponsor) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.Sponsor", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_Sponsor) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_Sponsor) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_Sponsor) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_Sponsor) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*Sponsor)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Address)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleAddress)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.ModuleName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		l = len(x.FunctionName)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*Sponsor)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.FunctionName) > 0 {
+			i -= len(x.FunctionName)
+			copy(dAtA[i:], x.FunctionName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.FunctionName)))
+			i--
+			dAtA[i] = 0x22
+		}
+		if len(x.ModuleName) > 0 {
+			i -= len(x.ModuleName)
+			copy(dAtA[i:], x.ModuleName)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleName)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if len(x.ModuleAddress) > 0 {
+			i -= len(x.ModuleAddress)
+			copy(dAtA[i:], x.ModuleAddress)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.ModuleAddress)))
+			i--
+			dAtA[i] = 0x12
+		}
+		if len(x.Address) > 0 {
+			i -= len(x.Address)
+			copy(dAtA[i:], x.Address)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Address)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*Sponsor)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Sponsor: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: Sponsor: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Address = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleAddress = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.ModuleName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 4:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.FunctionName = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+var (
+	md_VotingPowerWeightObservation        protoreflect.MessageDescriptor
+	fd_VotingPowerWeightObservation_denom  protoreflect.FieldDescriptor
+	fd_VotingPowerWeightObservation_height protoreflect.FieldDescriptor
+	fd_VotingPowerWeightObservation_weight protoreflect.FieldDescriptor
+)
+
+func init() {
+	file_initia_move_v1_types_proto_init()
+	md_VotingPowerWeightObservation = File_initia_move_v1_types_proto.Messages().ByName("VotingPowerWeightObservation")
+	fd_VotingPowerWeightObservation_denom = md_VotingPowerWeightObservation.Fields().ByName("denom")
+	fd_VotingPowerWeightObservation_height = md_VotingPowerWeightObservation.Fields().ByName("height")
+	fd_VotingPowerWeightObservation_weight = md_VotingPowerWeightObservation.Fields().ByName("weight")
+}
+
+var _ protoreflect.Message = (*fastReflection_VotingPowerWeightObservation)(nil)
+
+type fastReflection_VotingPowerWeightObservation VotingPowerWeightObservation
+
+func (x *VotingPowerWeightObservation) ProtoReflect() protoreflect.Message {
+	return (*fastReflection_VotingPowerWeightObservation)(x)
+}
+
+func (x *VotingPowerWeightObservation) slowProtoReflect() protoreflect.Message {
+	mi := &file_initia_move_v1_types_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+var _fastReflection_VotingPowerWeightObservation_messageType fastReflection_VotingPowerWeightObservation_messageType
+var _ protoreflect.MessageType = fastReflection_VotingPowerWeightObservation_messageType{}
+
+type fastReflection_VotingPowerWeightObservation_messageType struct{}
+
+func (x fastReflection_VotingPowerWeightObservation_messageType) Zero() protoreflect.Message {
+	return (*fastReflection_VotingPowerWeightObservation)(nil)
+}
+func (x fastReflection_VotingPowerWeightObservation_messageType) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerWeightObservation)
+}
+func (x fastReflection_VotingPowerWeightObservation_messageType) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerWeightObservation
+}
+
+// Descriptor returns message descriptor, which contains only the protobuf
+// type information for the message.
+func (x *fastReflection_VotingPowerWeightObservation) Descriptor() protoreflect.MessageDescriptor {
+	return md_VotingPowerWeightObservation
+}
+
+// Type returns the message type, which encapsulates both Go and protobuf
+// type information. If the Go type information is not needed,
+// it is recommended that the message descriptor be used instead.
+func (x *fastReflection_VotingPowerWeightObservation) Type() protoreflect.MessageType {
+	return _fastReflection_VotingPowerWeightObservation_messageType
+}
+
+// New returns a newly allocated and mutable empty message.
+func (x *fastReflection_VotingPowerWeightObservation) New() protoreflect.Message {
+	return new(fastReflection_VotingPowerWeightObservation)
+}
+
+// Interface unwraps the message reflection interface and
+// returns the underlying ProtoMessage interface.
+func (x *fastReflection_VotingPowerWeightObservation) Interface() protoreflect.ProtoMessage {
+	return (*VotingPowerWeightObservation)(x)
+}
+
+// Range iterates over every populated field in an undefined order,
+// calling f for each field descriptor and value encountered.
+// Range returns immediately if f returns false.
+// While iterating, mutating operations may only be performed
+// on the current field descriptor.
+func (x *fastReflection_VotingPowerWeightObservation) Range(f func(protoreflect.FieldDescriptor, protoreflect.Value) bool) {
+	if x.Denom != "" {
+		value := protoreflect.ValueOfString(x.Denom)
+		if !f(fd_VotingPowerWeightObservation_denom, value) {
+			return
+		}
+	}
+	if x.Height != int64(0) {
+		value := protoreflect.ValueOfInt64(x.Height)
+		if !f(fd_VotingPowerWeightObservation_height, value) {
+			return
+		}
+	}
+	if x.Weight != "" {
+		value := protoreflect.ValueOfString(x.Weight)
+		if !f(fd_VotingPowerWeightObservation_weight, value) {
+			return
+		}
+	}
+}
+
+// Has reports whether a field is populated.
+//
+// Some fields have the property of nullability where it is possible to
+// distinguish between the default value of a field and whether the field
+// was explicitly populated with the default value. Singular message fields,
+// member fields of a oneof, and proto2 scalar fields are nullable. Such
+// fields are populated only if explicitly set.
+//
+// In other cases (aside from the nullable cases above),
+// a proto3 scalar field is populated if it contains a non-zero value, and
+// a repeated field is populated if it is non-empty.
+func (x *fastReflection_VotingPowerWeightObservation) Has(fd protoreflect.FieldDescriptor) bool {
+	switch fd.FullName() {
+	case "initia.move.v1.VotingPowerWeightObservation.denom":
+		return x.Denom != ""
+	case "initia.move.v1.VotingPowerWeightObservation.height":
+		return x.Height != int64(0)
+	case "initia.move.v1.VotingPowerWeightObservation.weight":
+		return x.Weight != ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.VotingPowerWeightObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.VotingPowerWeightObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Clear clears the field such that a subsequent Has call reports false.
+//
+// Clearing an extension field clears both the extension type and value
+// associated with the given field number.
+//
+// Clear is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerWeightObservation) Clear(fd protoreflect.FieldDescriptor) {
+	switch fd.FullName() {
+	case "initia.move.v1.VotingPowerWeightObservation.denom":
+		x.Denom = ""
+	case "initia.move.v1.VotingPowerWeightObservation.height":
+		x.Height = int64(0)
+	case "initia.move.v1.VotingPowerWeightObservation.weight":
+		x.Weight = ""
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.VotingPowerWeightObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.VotingPowerWeightObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Get retrieves the value for a field.
+//
+// For unpopulated scalars, it returns the default value, where
+// the default value of a bytes scalar is guaranteed to be a copy.
+// For unpopulated composite types, it returns an empty, read-only view
+// of the value; to obtain a mutable reference, use Mutable.
+func (x *fastReflection_VotingPowerWeightObservation) Get(descriptor protoreflect.FieldDescriptor) protoreflect.Value {
+	switch descriptor.FullName() {
+	case "initia.move.v1.VotingPowerWeightObservation.denom":
+		value := x.Denom
+		return protoreflect.ValueOfString(value)
+	case "initia.move.v1.VotingPowerWeightObservation.height":
+		value := x.Height
+		return protoreflect.ValueOfInt64(value)
+	case "initia.move.v1.VotingPowerWeightObservation.weight":
+		value := x.Weight
+		return protoreflect.ValueOfString(value)
+	default:
+		if descriptor.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.VotingPowerWeightObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.VotingPowerWeightObservation does not contain field %s", descriptor.FullName()))
+	}
+}
+
+// Set stores the value for a field.
+//
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType.
+// When setting a composite type, it is unspecified whether the stored value
+// aliases the source's memory in any way. If the composite value is an
+// empty, read-only value, then it panics.
+//
+// Set is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerWeightObservation) Set(fd protoreflect.FieldDescriptor, value protoreflect.Value) {
+	switch fd.FullName() {
+	case "initia.move.v1.VotingPowerWeightObservation.denom":
+		x.Denom = value.Interface().(string)
+	case "initia.move.v1.VotingPowerWeightObservation.height":
+		x.Height = value.Int()
+	case "initia.move.v1.VotingPowerWeightObservation.weight":
+		x.Weight = value.Interface().(string)
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.VotingPowerWeightObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.VotingPowerWeightObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// Mutable returns a mutable reference to a composite type.
+//
+// If the field is unpopulated, it may allocate a composite value.
+// For a field belonging to a oneof, it implicitly clears any other field
+// that may be currently set within the same oneof.
+// For extension fields, it implicitly stores the provided ExtensionType
+// if not already stored.
+// It panics if the field does not contain a composite type.
+//
+// Mutable is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerWeightObservation) Mutable(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.VotingPowerWeightObservation.denom":
+		panic(fmt.Errorf("field denom of message initia.move.v1.VotingPowerWeightObservation is not mutable"))
+	case "initia.move.v1.VotingPowerWeightObservation.height":
+		panic(fmt.Errorf("field height of message initia.move.v1.VotingPowerWeightObservation is not mutable"))
+	case "initia.move.v1.VotingPowerWeightObservation.weight":
+		panic(fmt.Errorf("field weight of message initia.move.v1.VotingPowerWeightObservation is not mutable"))
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.VotingPowerWeightObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.VotingPowerWeightObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// NewField returns a new value that is assignable to the field
+// for the given descriptor. For scalars, this returns the default value.
+// For lists, maps, and messages, this returns a new, empty, mutable value.
+func (x *fastReflection_VotingPowerWeightObservation) NewField(fd protoreflect.FieldDescriptor) protoreflect.Value {
+	switch fd.FullName() {
+	case "initia.move.v1.VotingPowerWeightObservation.denom":
+		return protoreflect.ValueOfString("")
+	case "initia.move.v1.VotingPowerWeightObservation.height":
+		return protoreflect.ValueOfInt64(int64(0))
+	case "initia.move.v1.VotingPowerWeightObservation.weight":
+		return protoreflect.ValueOfString("")
+	default:
+		if fd.IsExtension() {
+			panic(fmt.Errorf("proto3 declared messages do not support extensions: initia.move.v1.VotingPowerWeightObservation"))
+		}
+		panic(fmt.Errorf("message initia.move.v1.VotingPowerWeightObservation does not contain field %s", fd.FullName()))
+	}
+}
+
+// WhichOneof reports which field within the oneof is populated,
+// returning nil if none are populated.
+// It panics if the oneof descriptor does not belong to this message.
+func (x *fastReflection_VotingPowerWeightObservation) WhichOneof(d protoreflect.OneofDescriptor) protoreflect.FieldDescriptor {
+	switch d.FullName() {
+	default:
+		panic(fmt.Errorf("%s is not a oneof field in initia.move.v1.VotingPowerWeightObservation", d.FullName()))
+	}
+	panic("unreachable")
+}
+
+// GetUnknown retrieves the entire list of unknown fields.
+// The caller may only mutate the contents of the RawFields
+// if the mutated bytes are stored back into the message with SetUnknown.
+func (x *fastReflection_VotingPowerWeightObservation) GetUnknown() protoreflect.RawFields {
+	return x.unknownFields
+}
+
+// SetUnknown stores an entire list of unknown fields.
+// The raw fields must be syntactically valid according to the wire format.
+// An implementation may panic if this is not the case.
+// Once stored, the caller must not mutate the content of the RawFields.
+// An empty RawFields may be passed to clear the fields.
+//
+// SetUnknown is a mutating operation and unsafe for concurrent use.
+func (x *fastReflection_VotingPowerWeightObservation) SetUnknown(fields protoreflect.RawFields) {
+	x.unknownFields = fields
+}
+
+// IsValid reports whether the message is valid.
+//
+// An invalid message is an empty, read-only value.
+//
+// An invalid message often corresponds to a nil pointer of the concrete
+// message type, but the details are implementation dependent.
+// Validity is not part of the protobuf data model, and may not
+// be preserved in marshaling or other operations.
+func (x *fastReflection_VotingPowerWeightObservation) IsValid() bool {
+	return x != nil
+}
+
+// ProtoMethods returns optional fastReflectionFeature-path implementations of various operations.
+// This method may return nil.
+//
+// The returned methods type is identical to
+// "google.golang.org/protobuf/runtime/protoiface".Methods.
+// Consult the protoiface package documentation for details.
+func (x *fastReflection_VotingPowerWeightObservation) ProtoMethods() *protoiface.Methods {
+	size := func(input protoiface.SizeInput) protoiface.SizeOutput {
+		x := input.Message.Interface().(*VotingPowerWeightObservation)
+		if x == nil {
+			return protoiface.SizeOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Size:              0,
+			}
+		}
+		options := runtime.SizeInputToOptions(input)
+		_ = options
+		var n int
+		var l int
+		_ = l
+		l = len(x.Denom)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.Height != 0 {
+			n += 1 + runtime.Sov(uint64(x.Height))
+		}
+		l = len(x.Weight)
+		if l > 0 {
+			n += 1 + l + runtime.Sov(uint64(l))
+		}
+		if x.unknownFields != nil {
+			n += len(x.unknownFields)
+		}
+		return protoiface.SizeOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Size:              n,
+		}
+	}
+
+	marshal := func(input protoiface.MarshalInput) (protoiface.MarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerWeightObservation)
+		if x == nil {
+			return protoiface.MarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Buf:               input.Buf,
+			}, nil
+		}
+		options := runtime.MarshalInputToOptions(input)
+		_ = options
+		size := options.Size(x)
+		dAtA := make([]byte, size)
+		i := len(dAtA)
+		_ = i
+		var l int
+		_ = l
+		if x.unknownFields != nil {
+			i -= len(x.unknownFields)
+			copy(dAtA[i:], x.unknownFields)
+		}
+		if len(x.Weight) > 0 {
+			i -= len(x.Weight)
+			copy(dAtA[i:], x.Weight)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Weight)))
+			i--
+			dAtA[i] = 0x1a
+		}
+		if x.Height != 0 {
+			i = runtime.EncodeVarint(dAtA, i, uint64(x.Height))
+			i--
+			dAtA[i] = 0x10
+		}
+		if len(x.Denom) > 0 {
+			i -= len(x.Denom)
+			copy(dAtA[i:], x.Denom)
+			i = runtime.EncodeVarint(dAtA, i, uint64(len(x.Denom)))
+			i--
+			dAtA[i] = 0xa
+		}
+		if input.Buf != nil {
+			input.Buf = append(input.Buf, dAtA...)
+		} else {
+			input.Buf = dAtA
+		}
+		return protoiface.MarshalOutput{
+			NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+			Buf:               input.Buf,
+		}, nil
+	}
+	unmarshal := func(input protoiface.UnmarshalInput) (protoiface.UnmarshalOutput, error) {
+		x := input.Message.Interface().(*VotingPowerWeightObservation)
+		if x == nil {
+			return protoiface.UnmarshalOutput{
+				NoUnkeyedLiterals: input.NoUnkeyedLiterals,
+				Flags:             input.Flags,
+			}, nil
+		}
+		options := runtime.UnmarshalInputToOptions(input)
+		_ = options
+		dAtA := input.Buf
+		l := len(dAtA)
+		iNdEx := 0
+		for iNdEx < l {
+			preIndex := iNdEx
+			var wire uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+				}
+				if iNdEx >= l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				wire |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			fieldNum := int32(wire >> 3)
+			wireType := int(wire & 0x7)
+			if wireType == 4 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerWeightObservation: wiretype end group for non-group")
+			}
+			if fieldNum <= 0 {
+				return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: VotingPowerWeightObservation: illegal tag %d (wire type %d)", fieldNum, wire)
+			}
+			switch fieldNum {
+			case 1:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Denom = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			case 2:
+				if wireType != 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				}
+				x.Height = 0
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					x.Height |= int64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+			case 3:
+				if wireType != 2 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
+				}
+				var stringLen uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrIntOverflow
+					}
+					if iNdEx >= l {
+						return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					stringLen |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				intStringLen := int(stringLen)
+				if intStringLen < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				postIndex := iNdEx + intStringLen
+				if postIndex < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if postIndex > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				x.Weight = string(dAtA[iNdEx:postIndex])
+				iNdEx = postIndex
+			default:
+				iNdEx = preIndex
+				skippy, err := runtime.Skip(dAtA[iNdEx:])
+				if err != nil {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, err
+				}
+				if (skippy < 0) || (iNdEx+skippy) < 0 {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, runtime.ErrInvalidLength
+				}
+				if (iNdEx + skippy) > l {
+					return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+				}
+				if !options.DiscardUnknown {
+					x.unknownFields = append(x.unknownFields, dAtA[iNdEx:iNdEx+skippy]...)
+				}
+				iNdEx += skippy
+			}
+		}
+
+		if iNdEx > l {
+			return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, io.ErrUnexpectedEOF
+		}
+		return protoiface.UnmarshalOutput{NoUnkeyedLiterals: input.NoUnkeyedLiterals, Flags: input.Flags}, nil
+	}
+	return &protoiface.Methods{
+		NoUnkeyedLiterals: struct{}{},
+		Flags:             protoiface.SupportMarshalDeterministic | protoiface.SupportUnmarshalDiscardUnknown,
+		Size:              size,
+		Marshal:           marshal,
+		Unmarshal:         unmarshal,
+		Merge:             nil,
+		CheckInitialized:  nil,
+	}
+}
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.27.0
+// 	protoc        (unknown)
+// source: initia/move/v1/types.proto
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// UpgradePolicy
+//   - `compatible`
+//     Whether a compatibility check should be performed for upgrades. The check only passes if
+//     a new module has (a) the same public functions (b) for existing resources, no layout change.
+//   - `immutable`
+//     Whether the modules in the package are immutable and cannot be upgraded.
+type UpgradePolicy int32
+
+const (
+	UpgradePolicy_UNSPECIFIED UpgradePolicy = 0
+	UpgradePolicy_COMPATIBLE  UpgradePolicy = 1
+	UpgradePolicy_IMMUTABLE   UpgradePolicy = 2
+)
+
+// Enum value maps for UpgradePolicy.
+var (
+	UpgradePolicy_name = map[int32]string{
+		0: "UNSPECIFIED",
+		1: "COMPATIBLE",
+		2: "IMMUTABLE",
+	}
+	UpgradePolicy_value = map[string]int32{
+		"UNSPECIFIED": 0,
+		"COMPATIBLE":  1,
+		"IMMUTABLE":   2,
+	}
+)
+
+func (x UpgradePolicy) Enum() *UpgradePolicy {
+	p := new(UpgradePolicy)
+	*p = x
+	return p
+}
+
+func (x UpgradePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpgradePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_move_v1_types_proto_enumTypes[0].Descriptor()
+}
+
+func (UpgradePolicy) Type() protoreflect.EnumType {
+	return &file_initia_move_v1_types_proto_enumTypes[0]
+}
+
+func (x UpgradePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpgradePolicy.Descriptor instead.
+func (UpgradePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+// PublishMode defines who can publish the modules
+type PublishMode int32
+
+const (
+	// PUBLISH_MODE_UNSPECIFIED follows `allowed_publishers`; allowlist when
+	// it is not empty, otherwise open.
+	PublishMode_PUBLISH_MODE_UNSPECIFIED PublishMode = 0
+	// PUBLISH_MODE_OPEN allows anyone to publish.
+	PublishMode_PUBLISH_MODE_OPEN PublishMode = 1
+	// PUBLISH_MODE_ALLOWLIST allows only `allowed_publishers` to publish.
+	PublishMode_PUBLISH_MODE_ALLOWLIST PublishMode = 2
+	// PUBLISH_MODE_GOVERNANCE allows to publish only via governance proposal.
+	PublishMode_PUBLISH_MODE_GOVERNANCE PublishMode = 3
+)
+
+// Enum value maps for PublishMode.
+var (
+	PublishMode_name = map[int32]string{
+		0: "PUBLISH_MODE_UNSPECIFIED",
+		1: "PUBLISH_MODE_OPEN",
+		2: "PUBLISH_MODE_ALLOWLIST",
+		3: "PUBLISH_MODE_GOVERNANCE",
+	}
+	PublishMode_value = map[string]int32{
+		"PUBLISH_MODE_UNSPECIFIED": 0,
+		"PUBLISH_MODE_OPEN":        1,
+		"PUBLISH_MODE_ALLOWLIST":   2,
+		"PUBLISH_MODE_GOVERNANCE":  3,
+	}
+)
+
+func (x PublishMode) Enum() *PublishMode {
+	p := new(PublishMode)
+	*p = x
+	return p
+}
+
+func (x PublishMode) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PublishMode) Descriptor() protoreflect.EnumDescriptor {
+	return file_initia_move_v1_types_proto_enumTypes[1].Descriptor()
+}
+
+func (PublishMode) Type() protoreflect.EnumType {
+	return &file_initia_move_v1_types_proto_enumTypes[1]
+}
+
+func (x PublishMode) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PublishMode.Descriptor instead.
+func (PublishMode) EnumDescriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+// Params defines the set of move parameters.
+type Params struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom       string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	BaseMinGasPrice string `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3" json:"base_min_gas_price,omitempty"`
+	// CSR: Percentage of fees distributed to developers
+	ContractSharedRevenueRatio string `protobuf:"bytes,3,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3" json:"contract_shared_revenue_ratio,omitempty"`
+	// It is a list of addresses with permission to distribute contracts,
+	// and an empty list is interpreted as allowing anyone to distribute.
+	AllowedPublishers []string `protobuf:"bytes,4,rep,name=allowed_publishers,json=allowedPublishers,proto3" json:"allowed_publishers,omitempty"`
+	// The maximum total gas which can be used by the cron jobs in a block,
+	// and zero disables the cron job execution.
+	CronMaxGasPerBlock uint64 `protobuf:"varint,5,opt,name=cron_max_gas_per_block,json=cronMaxGasPerBlock,proto3" json:"cron_max_gas_per_block,omitempty"`
+	// The number of consecutive failures to deregister a cron job.
+	CronMaxFailures uint64 `protobuf:"varint,6,opt,name=cron_max_failures,json=cronMaxFailures,proto3" json:"cron_max_failures,omitempty"`
+	// The publish mode which decides who can publish the modules.
+	PublishMode PublishMode `protobuf:"varint,7,opt,name=publish_mode,json=publishMode,proto3,enum=initia.move.v1.PublishMode" json:"publish_mode,omitempty"`
+	// The deposit in the base denom which is charged on the new module
+	// publish and refunded when the module is frozen, and zero disables it.
+	PublishDeposit string `protobuf:"bytes,8,opt,name=publish_deposit,json=publishDeposit,proto3" json:"publish_deposit,omitempty"`
+	// The maximum number of modules which can be published by an address
+	// in a quota window, and zero disables the quota.
+	PublishQuota uint64 `protobuf:"varint,9,opt,name=publish_quota,json=publishQuota,proto3" json:"publish_quota,omitempty"`
+	// The number of blocks of a quota window.
+	PublishQuotaWindow uint64 `protobuf:"varint,10,opt,name=publish_quota_window,json=publishQuotaWindow,proto3" json:"publish_quota_window,omitempty"`
+	// The maximum gas which can be used by an account authenticator to
+	// authenticate a signature, and zero disables the authenticators.
+	AuthenticatorGasLimit uint64 `protobuf:"varint,11,opt,name=authenticator_gas_limit,json=authenticatorGasLimit,proto3" json:"authenticator_gas_limit,omitempty"`
+	// The maximum gas which can be used by a sponsor policy to decide
+	// whether to pay the fee of a tx, and zero disables the sponsors.
+	SponsorGasLimit uint64 `protobuf:"varint,12,opt,name=sponsor_gas_limit,json=sponsorGasLimit,proto3" json:"sponsor_gas_limit,omitempty"`
+	// The number of blocks over which the LP voting power weights are
+	// averaged, and zero disables the averaging.
+	VotingPowerTwapWindow uint64 `protobuf:"varint,13,opt,name=voting_power_twap_window,json=votingPowerTwapWindow,proto3" json:"voting_power_twap_window,omitempty"`
+	// The maximum ratio by which an LP voting power weight can change
+	// in a block, and zero disables the cap.
+	MaxVotingPowerWeightChangeRate string `protobuf:"bytes,14,opt,name=max_voting_power_weight_change_rate,json=maxVotingPowerWeightChangeRate,proto3" json:"max_voting_power_weight_change_rate,omitempty"`
+}
+
+func (x *Params) Reset() {
+	*x = Params{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Params) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Params) ProtoMessage() {}
+
+// Deprecated: Use Params.ProtoReflect.Descriptor instead.
+func (*Params) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Params) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *Params) GetBaseMinGasPrice() string {
+	if x != nil {
+		return x.BaseMinGasPrice
+	}
+	return ""
+}
+
+func (x *Params) GetContractSharedRevenueRatio() string {
+	if x != nil {
+		return x.ContractSharedRevenueRatio
+	}
+	return ""
+}
+
+func (x *Params) GetAllowedPublishers() []string {
+	if x != nil {
+		return x.AllowedPublishers
+	}
+	return nil
+}
+
+func (x *Params) GetCronMaxGasPerBlock() uint64 {
+	if x != nil {
+		return x.CronMaxGasPerBlock
+	}
+	return 0
+}
+
+func (x *Params) GetCronMaxFailures() uint64 {
+	if x != nil {
+		return x.CronMaxFailures
+	}
+	return 0
+}
+
+func (x *Params) GetPublishMode() PublishMode {
+	if x != nil {
+		return x.PublishMode
+	}
+	return PublishMode_PUBLISH_MODE_UNSPECIFIED
+}
+
+func (x *Params) GetPublishDeposit() string {
+	if x != nil {
+		return x.PublishDeposit
+	}
+	return ""
+}
+
+func (x *Params) GetPublishQuota() uint64 {
+	if x != nil {
+		return x.PublishQuota
+	}
+	return 0
+}
+
+func (x *Params) GetPublishQuotaWindow() uint64 {
+	if x != nil {
+		return x.PublishQuotaWindow
+	}
+	return 0
+}
+
+func (x *Params) GetAuthenticatorGasLimit() uint64 {
+	if x != nil {
+		return x.AuthenticatorGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetSponsorGasLimit() uint64 {
+	if x != nil {
+		return x.SponsorGasLimit
+	}
+	return 0
+}
+
+func (x *Params) GetVotingPowerTwapWindow() uint64 {
+	if x != nil {
+		return x.VotingPowerTwapWindow
+	}
+	return 0
+}
+
+func (x *Params) GetMaxVotingPowerWeightChangeRate() string {
+	if x != nil {
+		return x.MaxVotingPowerWeightChangeRate
+	}
+	return ""
+}
+
+// RawParams defines the raw params to store.
+type RawParams struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BaseDenom       string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	BaseMinGasPrice string `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3" json:"base_min_gas_price,omitempty"`
+	// CSR: Percentage of fees distributed to developers
+	ContractSharedRevenueRatio string `protobuf:"bytes,3,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3" json:"contract_shared_revenue_ratio,omitempty"`
+	// The maximum total gas which can be used by the cron jobs in a block,
+	// and zero disables the cron job execution.
+	CronMaxGasPerBlock uint64 `protobuf:"varint,4,opt,name=cron_max_gas_per_block,json=cronMaxGasPerBlock,proto3" json:"cron_max_gas_per_block,omitempty"`
+	// The number of consecutive failures to deregister a cron job.
+	CronMaxFailures uint64 `protobuf:"varint,5,opt,name=cron_max_failures,json=cronMaxFailures,proto3" json:"cron_max_failures,omitempty"`
+	// The publish mode which decides who can publish the modules.
+	PublishMode PublishMode `protobuf:"varint,6,opt,name=publish_mode,json=publishMode,proto3,enum=initia.move.v1.PublishMode" json:"publish_mode,omitempty"`
+	// The deposit in the base denom which is charged on the new module
+	// publish and refunded when the module is frozen, and zero disables it.
+	PublishDeposit string `protobuf:"bytes,7,opt,name=publish_deposit,json=publishDeposit,proto3" json:"publish_deposit,omitempty"`
+	// The maximum number of modules which can be published by an address
+	// in a quota window, and zero disables the quota.
+	PublishQuota uint64 `protobuf:"varint,8,opt,name=publish_quota,json=publishQuota,proto3" json:"publish_quota,omitempty"`
+	// The number of blocks of a quota window.
+	PublishQuotaWindow uint64 `protobuf:"varint,9,opt,name=publish_quota_window,json=publishQuotaWindow,proto3" json:"publish_quota_window,omitempty"`
+	// The maximum gas which can be used by an account authenticator to
+	// authenticate a signature, and zero disables the authenticators.
+	AuthenticatorGasLimit uint64 `protobuf:"varint,10,opt,name=authenticator_gas_limit,json=authenticatorGasLimit,proto3" json:"authenticator_gas_limit,omitempty"`
+	// The maximum gas which can be used by a sponsor policy to decide
+	// whether to pay the fee of a tx, and zero disables the sponsors.
+	SponsorGasLimit uint64 `protobuf:"varint,11,opt,name=sponsor_gas_limit,json=sponsorGasLimit,proto3" json:"sponsor_gas_limit,omitempty"`
+	// The number of blocks over which the LP voting power weights are
+	// averaged, and zero disables the averaging.
+	VotingPowerTwapWindow uint64 `protobuf:"varint,12,opt,name=voting_power_twap_window,json=votingPowerTwapWindow,proto3" json:"voting_power_twap_window,omitempty"`
+	// The maximum ratio by which an LP voting power weight can change
+	// in a block, and zero disables the cap.
+	MaxVotingPowerWeightChangeRate string `protobuf:"bytes,13,opt,name=max_voting_power_weight_change_rate,json=maxVotingPowerWeightChangeRate,proto3" json:"max_voting_power_weight_change_rate,omitempty"`
+}
+
+func (x *RawParams) Reset() {
+	*x = RawParams{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RawParams) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RawParams) ProtoMessage() {}
+
+// Deprecated: Use RawParams.ProtoReflect.Descriptor instead.
+func (*RawParams) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *RawParams) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *RawParams) GetBaseMinGasPrice() string {
+	if x != nil {
+		return x.BaseMinGasPrice
+	}
+	return ""
+}
+
+func (x *RawParams) GetContractSharedRevenueRatio() string {
+	if x != nil {
+		return x.ContractSharedRevenueRatio
+	}
+	return ""
+}
+
+func (x *RawParams) GetCronMaxGasPerBlock() uint64 {
+	if x != nil {
+		return x.CronMaxGasPerBlock
+	}
+	return 0
+}
+
+func (x *RawParams) GetCronMaxFailures() uint64 {
+	if x != nil {
+		return x.CronMaxFailures
+	}
+	return 0
+}
+
+func (x *RawParams) GetPublishMode() PublishMode {
+	if x != nil {
+		return x.PublishMode
+	}
+	return PublishMode_PUBLISH_MODE_UNSPECIFIED
+}
+
+func (x *RawParams) GetPublishDeposit() string {
+	if x != nil {
+		return x.PublishDeposit
+	}
+	return ""
+}
+
+func (x *RawParams) GetPublishQuota() uint64 {
+	if x != nil {
+		return x.PublishQuota
+	}
+	return 0
+}
+
+func (x *RawParams) GetPublishQuotaWindow() uint64 {
+	if x != nil {
+		return x.PublishQuotaWindow
+	}
+	return 0
+}
+
+func (x *RawParams) GetAuthenticatorGasLimit() uint64 {
+	if x != nil {
+		return x.AuthenticatorGasLimit
+	}
+	return 0
+}
+
+func (x *RawParams) GetSponsorGasLimit() uint64 {
+	if x != nil {
+		return x.SponsorGasLimit
+	}
+	return 0
+}
+
+func (x *RawParams) GetVotingPowerTwapWindow() uint64 {
+	if x != nil {
+		return x.VotingPowerTwapWindow
+	}
+	return 0
+}
+
+func (x *RawParams) GetMaxVotingPowerWeightChangeRate() string {
+	if x != nil {
+		return x.MaxVotingPowerWeightChangeRate
+	}
+	return ""
 }
 
 // Module is data for the uploaded contract move code
@@ -5492,383 +18675,1538 @@ type Module struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address       string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	ModuleName    string        `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
-	Abi           string        `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
-	RawBytes      []byte        `protobuf:"bytes,4,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
-	UpgradePolicy UpgradePolicy `protobuf:"varint,5,opt,name=upgrade_policy,json=upgradePolicy,proto3,enum=initia.move.v1.UpgradePolicy" json:"upgrade_policy,omitempty"`
+	Address       string        `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName    string        `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Abi           string        `protobuf:"bytes,3,opt,name=abi,proto3" json:"abi,omitempty"`
+	RawBytes      []byte        `protobuf:"bytes,4,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
+	UpgradePolicy UpgradePolicy `protobuf:"varint,5,opt,name=upgrade_policy,json=upgradePolicy,proto3,enum=initia.move.v1.UpgradePolicy" json:"upgrade_policy,omitempty"`
+}
+
+func (x *Module) Reset() {
+	*x = Module{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Module) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Module) ProtoMessage() {}
+
+// Deprecated: Use Module.ProtoReflect.Descriptor instead.
+func (*Module) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *Module) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Module) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *Module) GetAbi() string {
+	if x != nil {
+		return x.Abi
+	}
+	return ""
+}
+
+func (x *Module) GetRawBytes() []byte {
+	if x != nil {
+		return x.RawBytes
+	}
+	return nil
+}
+
+func (x *Module) GetUpgradePolicy() UpgradePolicy {
+	if x != nil {
+		return x.UpgradePolicy
+	}
+	return UpgradePolicy_UNSPECIFIED
+}
+
+// Resource is data for the stored move resource
+// ex) 0000000000000000000000000000000000000002/2/0x1::BasicCoin::Coin<0x1::BasicCoin::Initia>
+type Resource struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	StructTag    string `protobuf:"bytes,2,opt,name=struct_tag,json=structTag,proto3" json:"struct_tag,omitempty"`
+	MoveResource string `protobuf:"bytes,3,opt,name=move_resource,json=moveResource,proto3" json:"move_resource,omitempty"`
+	RawBytes     []byte `protobuf:"bytes,4,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
+}
+
+func (x *Resource) Reset() {
+	*x = Resource{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Resource) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Resource) ProtoMessage() {}
+
+// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
+func (*Resource) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Resource) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Resource) GetStructTag() string {
+	if x != nil {
+		return x.StructTag
+	}
+	return ""
+}
+
+func (x *Resource) GetMoveResource() string {
+	if x != nil {
+		return x.MoveResource
+	}
+	return ""
+}
+
+func (x *Resource) GetRawBytes() []byte {
+	if x != nil {
+		return x.RawBytes
+	}
+	return nil
+}
+
+// TableInfo is data stored under Table address
+type TableInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	KeyType   string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
+	ValueType string `protobuf:"bytes,3,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
+}
+
+func (x *TableInfo) Reset() {
+	*x = TableInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableInfo) ProtoMessage() {}
+
+// Deprecated: Use TableInfo.ProtoReflect.Descriptor instead.
+func (*TableInfo) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TableInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TableInfo) GetKeyType() string {
+	if x != nil {
+		return x.KeyType
+	}
+	return ""
+}
+
+func (x *TableInfo) GetValueType() string {
+	if x != nil {
+		return x.ValueType
+	}
+	return ""
+}
+
+// TableEntry is data stored under Table address and the key bytes
+type TableEntry struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	Value      string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	KeyBytes   []byte `protobuf:"bytes,4,opt,name=key_bytes,json=keyBytes,proto3" json:"key_bytes,omitempty"`
+	ValueBytes []byte `protobuf:"bytes,5,opt,name=value_bytes,json=valueBytes,proto3" json:"value_bytes,omitempty"`
+}
+
+func (x *TableEntry) Reset() {
+	*x = TableEntry{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TableEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TableEntry) ProtoMessage() {}
+
+// Deprecated: Use TableEntry.ProtoReflect.Descriptor instead.
+func (*TableEntry) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TableEntry) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *TableEntry) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *TableEntry) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+func (x *TableEntry) GetKeyBytes() []byte {
+	if x != nil {
+		return x.KeyBytes
+	}
+	return nil
+}
+
+func (x *TableEntry) GetValueBytes() []byte {
+	if x != nil {
+		return x.ValueBytes
+	}
+	return nil
+}
+
+// proto wrapper to store the value
+type UpgradePolicyProto struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Policy UpgradePolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=initia.move.v1.UpgradePolicy" json:"policy,omitempty"`
+}
+
+func (x *UpgradePolicyProto) Reset() {
+	*x = UpgradePolicyProto{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpgradePolicyProto) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpgradePolicyProto) ProtoMessage() {}
+
+// Deprecated: Use UpgradePolicyProto.ProtoReflect.Descriptor instead.
+func (*UpgradePolicyProto) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UpgradePolicyProto) GetPolicy() UpgradePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return UpgradePolicy_UNSPECIFIED
+}
+
+// DexPair contains coin metdata address
+// std::dex::Pool and std::dex::Config resources.
+type DexPair struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	MetadataQuote string `protobuf:"bytes,1,opt,name=metadata_quote,json=metadataQuote,proto3" json:"metadata_quote,omitempty"`
+	MetadataLp    string `protobuf:"bytes,2,opt,name=metadata_lp,json=metadataLp,proto3" json:"metadata_lp,omitempty"`
+}
+
+func (x *DexPair) Reset() {
+	*x = DexPair{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DexPair) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DexPair) ProtoMessage() {}
+
+// Deprecated: Use DexPair.ProtoReflect.Descriptor instead.
+func (*DexPair) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DexPair) GetMetadataQuote() string {
+	if x != nil {
+		return x.MetadataQuote
+	}
+	return ""
+}
+
+func (x *DexPair) GetMetadataLp() string {
+	if x != nil {
+		return x.MetadataLp
+	}
+	return ""
+}
+
+// ExecuteAuthorizationItem is the information for granting module execution
+type ExecuteAuthorizationItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// ModuleAddr is the address of the module deployer
+	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	// ModuleName is the names of module to execute
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// FunctionName is the name of function to execute with wildcard '*' support
+	FunctionNames []string `protobuf:"bytes,3,rep,name=function_names,json=functionNames,proto3" json:"function_names,omitempty"`
+	// TypeArgs is the type arguments the execution must use, and
+	// empty means any type arguments are allowed
+	TypeArgs []string `protobuf:"bytes,4,rep,name=type_args,json=typeArgs,proto3" json:"type_args,omitempty"`
+	// ArgConstraints is the constraints on the bcs encoded arguments
+	ArgConstraints []*ArgumentConstraint `protobuf:"bytes,5,rep,name=arg_constraints,json=argConstraints,proto3" json:"arg_constraints,omitempty"`
+}
+
+func (x *ExecuteAuthorizationItem) Reset() {
+	*x = ExecuteAuthorizationItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExecuteAuthorizationItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExecuteAuthorizationItem) ProtoMessage() {}
+
+// Deprecated: Use ExecuteAuthorizationItem.ProtoReflect.Descriptor instead.
+func (*ExecuteAuthorizationItem) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ExecuteAuthorizationItem) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
+	}
+	return ""
+}
+
+func (x *ExecuteAuthorizationItem) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ExecuteAuthorizationItem) GetFunctionNames() []string {
+	if x != nil {
+		return x.FunctionNames
+	}
+	return nil
+}
+
+func (x *ExecuteAuthorizationItem) GetTypeArgs() []string {
+	if x != nil {
+		return x.TypeArgs
+	}
+	return nil
+}
+
+func (x *ExecuteAuthorizationItem) GetArgConstraints() []*ArgumentConstraint {
+	if x != nil {
+		return x.ArgConstraints
+	}
+	return nil
+}
+
+// ArgumentConstraint is the constraint on an argument of the authorized
+// execution. Exactly one of equals, max_amount and allowed_addresses
+// must be set.
+type ArgumentConstraint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Index is the position of the argument
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Equals is the bcs bytes the argument must be equal to
+	Equals []byte `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"`
+	// MaxAmount is the maximum value of the unsigned integer argument
+	MaxAmount string `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3" json:"max_amount,omitempty"`
+	// AllowedAddresses is the addresses which the address argument must be one of
+	AllowedAddresses []string `protobuf:"bytes,4,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
+}
+
+func (x *ArgumentConstraint) Reset() {
+	*x = ArgumentConstraint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ArgumentConstraint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ArgumentConstraint) ProtoMessage() {}
+
+// Deprecated: Use ArgumentConstraint.ProtoReflect.Descriptor instead.
+func (*ArgumentConstraint) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ArgumentConstraint) GetIndex() uint32 {
+	if x != nil {
+		return x.Index
+	}
+	return 0
+}
+
+func (x *ArgumentConstraint) GetEquals() []byte {
+	if x != nil {
+		return x.Equals
+	}
+	return nil
+}
+
+func (x *ArgumentConstraint) GetMaxAmount() string {
+	if x != nil {
+		return x.MaxAmount
+	}
+	return ""
+}
+
+func (x *ArgumentConstraint) GetAllowedAddresses() []string {
+	if x != nil {
+		return x.AllowedAddresses
+	}
+	return nil
+}
+
+// ModuleRevenueRecipient is the registered recipient of the contract
+// shared revenue of a module. The recipient can be an account or
+// a move object address.
+type ModuleRevenueRecipient struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Recipient     string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (x *ModuleRevenueRecipient) Reset() {
+	*x = ModuleRevenueRecipient{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleRevenueRecipient) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleRevenueRecipient) ProtoMessage() {}
+
+// Deprecated: Use ModuleRevenueRecipient.ProtoReflect.Descriptor instead.
+func (*ModuleRevenueRecipient) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ModuleRevenueRecipient) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
+	}
+	return ""
+}
+
+func (x *ModuleRevenueRecipient) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ModuleRevenueRecipient) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+// ModuleRevenueRatio is the governance set contract shared revenue
+// ratio of a module, which overrides `Params.contract_shared_revenue_ratio`.
+type ModuleRevenueRatio struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Ratio         string `protobuf:"bytes,3,opt,name=ratio,proto3" json:"ratio,omitempty"`
+}
+
+func (x *ModuleRevenueRatio) Reset() {
+	*x = ModuleRevenueRatio{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleRevenueRatio) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleRevenueRatio) ProtoMessage() {}
+
+// Deprecated: Use ModuleRevenueRatio.ProtoReflect.Descriptor instead.
+func (*ModuleRevenueRatio) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ModuleRevenueRatio) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
+	}
+	return ""
+}
+
+func (x *ModuleRevenueRatio) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ModuleRevenueRatio) GetRatio() string {
+	if x != nil {
+		return x.Ratio
+	}
+	return ""
+}
+
+// ModuleRevenue is the accumulated contract shared revenue of a module.
+type ModuleRevenue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleAddress string          `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string          `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	TotalRevenue  []*v1beta1.Coin `protobuf:"bytes,3,rep,name=total_revenue,json=totalRevenue,proto3" json:"total_revenue,omitempty"`
+}
+
+func (x *ModuleRevenue) Reset() {
+	*x = ModuleRevenue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleRevenue) ProtoMessage() {}
+
+// Deprecated: Use ModuleRevenue.ProtoReflect.Descriptor instead.
+func (*ModuleRevenue) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *ModuleRevenue) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
+	}
+	return ""
+}
+
+func (x *ModuleRevenue) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ModuleRevenue) GetTotalRevenue() []*v1beta1.Coin {
+	if x != nil {
+		return x.TotalRevenue
+	}
+	return nil
+}
+
+// ClaimableRevenue is the contract shared revenue accrued in the escrow,
+// which is not claimed by the recipient yet.
+type ClaimableRevenue struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Recipient string          `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    []*v1beta1.Coin `protobuf:"bytes,2,rep,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *ClaimableRevenue) Reset() {
+	*x = ClaimableRevenue{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ClaimableRevenue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClaimableRevenue) ProtoMessage() {}
+
+// Deprecated: Use ClaimableRevenue.ProtoReflect.Descriptor instead.
+func (*ClaimableRevenue) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ClaimableRevenue) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
+	}
+	return ""
+}
+
+func (x *ClaimableRevenue) GetAmount() []*v1beta1.Coin {
+	if x != nil {
+		return x.Amount
+	}
+	return nil
+}
+
+// CronJob is the scheduled entry function call, which is executed
+// at the end of the block in every `interval` blocks with the prepaid fee.
+type CronJob struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the sender of the scheduled call, who prepaid the fee
+	Creator       string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	ModuleAddress string `protobuf:"bytes,3,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,4,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	FunctionName  string `protobuf:"bytes,5,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// type_args is the type arguments of the function
+	TypeArgs []string `protobuf:"bytes,6,rep,name=type_args,json=typeArgs,proto3" json:"type_args,omitempty"`
+	// args is the bcs encoded arguments of the function
+	Args [][]byte `protobuf:"bytes,7,rep,name=args,proto3" json:"args,omitempty"`
+	// interval is the number of blocks between the runs
+	Interval uint64 `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	// gas_limit is the gas budget of each run
+	GasLimit uint64 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// deposit is the remaining prepaid fee in the escrow
+	Deposit *v1beta1.Coin `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit,omitempty"`
+	// next_run_height is the height of the next run
+	NextRunHeight int64 `protobuf:"varint,11,opt,name=next_run_height,json=nextRunHeight,proto3" json:"next_run_height,omitempty"`
+	// failure_count is the number of consecutive failed runs
+	FailureCount uint64 `protobuf:"varint,12,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+}
+
+func (x *CronJob) Reset() {
+	*x = CronJob{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CronJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronJob) ProtoMessage() {}
+
+// Deprecated: Use CronJob.ProtoReflect.Descriptor instead.
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CronJob) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *CronJob) GetCreator() string {
+	if x != nil {
+		return x.Creator
+	}
+	return ""
+}
+
+func (x *CronJob) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
+	}
+	return ""
+}
+
+func (x *CronJob) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *CronJob) GetFunctionName() string {
+	if x != nil {
+		return x.FunctionName
+	}
+	return ""
+}
+
+func (x *CronJob) GetTypeArgs() []string {
+	if x != nil {
+		return x.TypeArgs
+	}
+	return nil
+}
+
+func (x *CronJob) GetArgs() [][]byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *CronJob) GetInterval() uint64 {
+	if x != nil {
+		return x.Interval
+	}
+	return 0
+}
+
+func (x *CronJob) GetGasLimit() uint64 {
+	if x != nil {
+		return x.GasLimit
+	}
+	return 0
+}
+
+func (x *CronJob) GetDeposit() *v1beta1.Coin {
+	if x != nil {
+		return x.Deposit
+	}
+	return nil
+}
+
+func (x *CronJob) GetNextRunHeight() int64 {
+	if x != nil {
+		return x.NextRunHeight
+	}
+	return 0
+}
+
+func (x *CronJob) GetFailureCount() uint64 {
+	if x != nil {
+		return x.FailureCount
+	}
+	return 0
+}
+
+// CronRun is the execution record of a cron job.
+type CronRun struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JobId   uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Height  int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Success bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee is the fee charged from the deposit
+	Fee *v1beta1.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee,omitempty"`
+	// error is the error message of the failed run
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *CronRun) Reset() {
+	*x = CronRun{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CronRun) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CronRun) ProtoMessage() {}
+
+// Deprecated: Use CronRun.ProtoReflect.Descriptor instead.
+func (*CronRun) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CronRun) GetJobId() uint64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+func (x *CronRun) GetHeight() int64 {
+	if x != nil {
+		return x.Height
+	}
+	return 0
+}
+
+func (x *CronRun) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *CronRun) GetGasUsed() uint64 {
+	if x != nil {
+		return x.GasUsed
+	}
+	return 0
+}
+
+func (x *CronRun) GetFee() *v1beta1.Coin {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *CronRun) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+// PublishNamespace is the module name prefixes which an address can publish.
+type PublishNamespace struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address  string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Prefixes []string `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
+}
+
+func (x *PublishNamespace) Reset() {
+	*x = PublishNamespace{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishNamespace) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishNamespace) ProtoMessage() {}
+
+// Deprecated: Use PublishNamespace.ProtoReflect.Descriptor instead.
+func (*PublishNamespace) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *PublishNamespace) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *PublishNamespace) GetPrefixes() []string {
+	if x != nil {
+		return x.Prefixes
+	}
+	return nil
+}
+
+// PublishUsage is the number of modules published by an address
+// in the current quota window.
+type PublishUsage struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	WindowStartHeight int64  `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	Count             uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *Module) Reset() {
-	*x = Module{}
+func (x *PublishUsage) Reset() {
+	*x = PublishUsage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[2]
+		mi := &file_initia_move_v1_types_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Module) String() string {
+func (x *PublishUsage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Module) ProtoMessage() {}
+func (*PublishUsage) ProtoMessage() {}
 
-// Deprecated: Use Module.ProtoReflect.Descriptor instead.
-func (*Module) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use PublishUsage.ProtoReflect.Descriptor instead.
+func (*PublishUsage) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *Module) GetAddress() string {
+func (x *PublishUsage) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *Module) GetModuleName() string {
+func (x *PublishUsage) GetWindowStartHeight() int64 {
 	if x != nil {
-		return x.ModuleName
+		return x.WindowStartHeight
+	}
+	return 0
+}
+
+func (x *PublishUsage) GetCount() uint64 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+// PublishDeposit is the deposit charged on the module publish, which is
+// refunded to the depositor when the module is frozen.
+type PublishDeposit struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ModuleAddress string        `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string        `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Depositor     string        `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount        *v1beta1.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+}
+
+func (x *PublishDeposit) Reset() {
+	*x = PublishDeposit{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PublishDeposit) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PublishDeposit) ProtoMessage() {}
+
+// Deprecated: Use PublishDeposit.ProtoReflect.Descriptor instead.
+func (*PublishDeposit) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *PublishDeposit) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
 	}
 	return ""
 }
 
-func (x *Module) GetAbi() string {
+func (x *PublishDeposit) GetModuleName() string {
 	if x != nil {
-		return x.Abi
+		return x.ModuleName
 	}
 	return ""
 }
 
-func (x *Module) GetRawBytes() []byte {
+func (x *PublishDeposit) GetDepositor() string {
 	if x != nil {
-		return x.RawBytes
+		return x.Depositor
 	}
-	return nil
+	return ""
 }
 
-func (x *Module) GetUpgradePolicy() UpgradePolicy {
+func (x *PublishDeposit) GetAmount() *v1beta1.Coin {
 	if x != nil {
-		return x.UpgradePolicy
+		return x.Amount
 	}
-	return UpgradePolicy_ARBITRARY
+	return nil
 }
 
-// Resource is data for the stored move resource
-// ex) 0000000000000000000000000000000000000002/2/0x1::BasicCoin::Coin<0x1::BasicCoin::Initia>
-type Resource struct {
+// ModuleIdentifier is the address and the name of a module.
+type ModuleIdentifier struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address      string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	StructTag    string `protobuf:"bytes,2,opt,name=struct_tag,json=structTag,proto3" json:"struct_tag,omitempty"`
-	MoveResource string `protobuf:"bytes,3,opt,name=move_resource,json=moveResource,proto3" json:"move_resource,omitempty"`
-	RawBytes     []byte `protobuf:"bytes,4,opt,name=raw_bytes,json=rawBytes,proto3" json:"raw_bytes,omitempty"`
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 }
 
-func (x *Resource) Reset() {
-	*x = Resource{}
+func (x *ModuleIdentifier) Reset() {
+	*x = ModuleIdentifier{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[3]
+		mi := &file_initia_move_v1_types_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Resource) String() string {
+func (x *ModuleIdentifier) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Resource) ProtoMessage() {}
+func (*ModuleIdentifier) ProtoMessage() {}
 
-// Deprecated: Use Resource.ProtoReflect.Descriptor instead.
-func (*Resource) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use ModuleIdentifier.ProtoReflect.Descriptor instead.
+func (*ModuleIdentifier) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *Resource) GetAddress() string {
+func (x *ModuleIdentifier) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *Resource) GetStructTag() string {
+func (x *ModuleIdentifier) GetModuleName() string {
 	if x != nil {
-		return x.StructTag
+		return x.ModuleName
 	}
 	return ""
 }
 
-func (x *Resource) GetMoveResource() string {
+// ModuleFriends is the friend declarations of a module.
+type ModuleFriends struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address    string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string   `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Friends    []string `protobuf:"bytes,3,rep,name=friends,proto3" json:"friends,omitempty"`
+}
+
+func (x *ModuleFriends) Reset() {
+	*x = ModuleFriends{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ModuleFriends) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ModuleFriends) ProtoMessage() {}
+
+// Deprecated: Use ModuleFriends.ProtoReflect.Descriptor instead.
+func (*ModuleFriends) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ModuleFriends) GetAddress() string {
 	if x != nil {
-		return x.MoveResource
+		return x.Address
 	}
 	return ""
 }
 
-func (x *Resource) GetRawBytes() []byte {
+func (x *ModuleFriends) GetModuleName() string {
 	if x != nil {
-		return x.RawBytes
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *ModuleFriends) GetFriends() []string {
+	if x != nil {
+		return x.Friends
 	}
 	return nil
 }
 
-// TableInfo is data stored under Table address
-type TableInfo struct {
+// FunctionSignature is the signature of an entry or view function.
+type FunctionSignature struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Address   string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	KeyType   string `protobuf:"bytes,2,opt,name=key_type,json=keyType,proto3" json:"key_type,omitempty"`
-	ValueType string `protobuf:"bytes,3,opt,name=value_type,json=valueType,proto3" json:"value_type,omitempty"`
-}
-
-func (x *TableInfo) Reset() {
-	*x = TableInfo{}
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsEntry    bool   `protobuf:"varint,4,opt,name=is_entry,json=isEntry,proto3" json:"is_entry,omitempty"`
+	IsView     bool   `protobuf:"varint,5,opt,name=is_view,json=isView,proto3" json:"is_view,omitempty"`
+	// type_params is the generic type parameters with the ability
+	// constraints, e.g. `T0: copy + drop`
+	TypeParams []string `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	Params     []string `protobuf:"bytes,7,rep,name=params,proto3" json:"params,omitempty"`
+	Returns    []string `protobuf:"bytes,8,rep,name=returns,proto3" json:"returns,omitempty"`
+}
+
+func (x *FunctionSignature) Reset() {
+	*x = FunctionSignature{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[4]
+		mi := &file_initia_move_v1_types_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableInfo) String() string {
+func (x *FunctionSignature) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableInfo) ProtoMessage() {}
+func (*FunctionSignature) ProtoMessage() {}
 
-// Deprecated: Use TableInfo.ProtoReflect.Descriptor instead.
-func (*TableInfo) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use FunctionSignature.ProtoReflect.Descriptor instead.
+func (*FunctionSignature) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *TableInfo) GetAddress() string {
+func (x *FunctionSignature) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *TableInfo) GetKeyType() string {
+func (x *FunctionSignature) GetModuleName() string {
 	if x != nil {
-		return x.KeyType
+		return x.ModuleName
 	}
 	return ""
 }
 
-func (x *TableInfo) GetValueType() string {
+func (x *FunctionSignature) GetName() string {
 	if x != nil {
-		return x.ValueType
+		return x.Name
 	}
 	return ""
 }
 
-// TableEntry is data stored under Table address and the key bytes
-type TableEntry struct {
+func (x *FunctionSignature) GetIsEntry() bool {
+	if x != nil {
+		return x.IsEntry
+	}
+	return false
+}
+
+func (x *FunctionSignature) GetIsView() bool {
+	if x != nil {
+		return x.IsView
+	}
+	return false
+}
+
+func (x *FunctionSignature) GetTypeParams() []string {
+	if x != nil {
+		return x.TypeParams
+	}
+	return nil
+}
+
+func (x *FunctionSignature) GetParams() []string {
+	if x != nil {
+		return x.Params
+	}
+	return nil
+}
+
+func (x *FunctionSignature) GetReturns() []string {
+	if x != nil {
+		return x.Returns
+	}
+	return nil
+}
+
+// ModuleUpgradeCompatibility is the pre-flight check result of publishing
+// a module of a module bundle.
+type ModuleUpgradeCompatibility struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
 	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
-	Key        string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	Value      string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
-	KeyBytes   []byte `protobuf:"bytes,4,opt,name=key_bytes,json=keyBytes,proto3" json:"key_bytes,omitempty"`
-	ValueBytes []byte `protobuf:"bytes,5,opt,name=value_bytes,json=valueBytes,proto3" json:"value_bytes,omitempty"`
-}
-
-func (x *TableEntry) Reset() {
-	*x = TableEntry{}
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// is_new is true if the module is not published yet
+	IsNew bool `protobuf:"varint,3,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+	// stored_upgrade_policy is the upgrade policy of the published module
+	StoredUpgradePolicy UpgradePolicy `protobuf:"varint,4,opt,name=stored_upgrade_policy,json=storedUpgradePolicy,proto3,enum=initia.move.v1.UpgradePolicy" json:"stored_upgrade_policy,omitempty"`
+	// allowed is true if the upgrade is allowed under the stored upgrade policy
+	Allowed bool `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// reason describes why the upgrade is not allowed
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// removed_functions and changed_functions are the public functions
+	// which are removed or whose signature is changed
+	RemovedFunctions []string `protobuf:"bytes,7,rep,name=removed_functions,json=removedFunctions,proto3" json:"removed_functions,omitempty"`
+	ChangedFunctions []string `protobuf:"bytes,8,rep,name=changed_functions,json=changedFunctions,proto3" json:"changed_functions,omitempty"`
+	// removed_structs and changed_structs are the structs which are
+	// removed or whose abilities, type parameters or fields are changed
+	RemovedStructs []string `protobuf:"bytes,9,rep,name=removed_structs,json=removedStructs,proto3" json:"removed_structs,omitempty"`
+	ChangedStructs []string `protobuf:"bytes,10,rep,name=changed_structs,json=changedStructs,proto3" json:"changed_structs,omitempty"`
+	// broken_dependents is the published modules which use the removed or
+	// changed functions and structs
+	BrokenDependents []*ModuleIdentifier `protobuf:"bytes,11,rep,name=broken_dependents,json=brokenDependents,proto3" json:"broken_dependents,omitempty"`
+}
+
+func (x *ModuleUpgradeCompatibility) Reset() {
+	*x = ModuleUpgradeCompatibility{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[5]
+		mi := &file_initia_move_v1_types_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TableEntry) String() string {
+func (x *ModuleUpgradeCompatibility) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TableEntry) ProtoMessage() {}
+func (*ModuleUpgradeCompatibility) ProtoMessage() {}
 
-// Deprecated: Use TableEntry.ProtoReflect.Descriptor instead.
-func (*TableEntry) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ModuleUpgradeCompatibility.ProtoReflect.Descriptor instead.
+func (*ModuleUpgradeCompatibility) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *TableEntry) GetAddress() string {
+func (x *ModuleUpgradeCompatibility) GetAddress() string {
 	if x != nil {
 		return x.Address
 	}
 	return ""
 }
 
-func (x *TableEntry) GetKey() string {
+func (x *ModuleUpgradeCompatibility) GetModuleName() string {
 	if x != nil {
-		return x.Key
+		return x.ModuleName
 	}
 	return ""
 }
 
-func (x *TableEntry) GetValue() string {
+func (x *ModuleUpgradeCompatibility) GetIsNew() bool {
 	if x != nil {
-		return x.Value
+		return x.IsNew
+	}
+	return false
+}
+
+func (x *ModuleUpgradeCompatibility) GetStoredUpgradePolicy() UpgradePolicy {
+	if x != nil {
+		return x.StoredUpgradePolicy
+	}
+	return UpgradePolicy_UNSPECIFIED
+}
+
+func (x *ModuleUpgradeCompatibility) GetAllowed() bool {
+	if x != nil {
+		return x.Allowed
+	}
+	return false
+}
+
+func (x *ModuleUpgradeCompatibility) GetReason() string {
+	if x != nil {
+		return x.Reason
 	}
 	return ""
 }
 
-func (x *TableEntry) GetKeyBytes() []byte {
+func (x *ModuleUpgradeCompatibility) GetRemovedFunctions() []string {
 	if x != nil {
-		return x.KeyBytes
+		return x.RemovedFunctions
+	}
+	return nil
+}
+
+func (x *ModuleUpgradeCompatibility) GetChangedFunctions() []string {
+	if x != nil {
+		return x.ChangedFunctions
+	}
+	return nil
+}
+
+func (x *ModuleUpgradeCompatibility) GetRemovedStructs() []string {
+	if x != nil {
+		return x.RemovedStructs
+	}
+	return nil
+}
+
+func (x *ModuleUpgradeCompatibility) GetChangedStructs() []string {
+	if x != nil {
+		return x.ChangedStructs
+	}
+	return nil
+}
+
+func (x *ModuleUpgradeCompatibility) GetBrokenDependents() []*ModuleIdentifier {
+	if x != nil {
+		return x.BrokenDependents
 	}
 	return nil
 }
 
-func (x *TableEntry) GetValueBytes() []byte {
+// Authenticator is the move view function which authenticates the signatures
+// of an account instead of the account public key. The function must be
+// `fun(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool`.
+type Authenticator struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	FunctionName  string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+}
+
+func (x *Authenticator) Reset() {
+	*x = Authenticator{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_initia_move_v1_types_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Authenticator) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Authenticator) ProtoMessage() {}
+
+// Deprecated: Use Authenticator.ProtoReflect.Descriptor instead.
+func (*Authenticator) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Authenticator) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Authenticator) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
+	}
+	return ""
+}
+
+func (x *Authenticator) GetModuleName() string {
+	if x != nil {
+		return x.ModuleName
+	}
+	return ""
+}
+
+func (x *Authenticator) GetFunctionName() string {
 	if x != nil {
-		return x.ValueBytes
+		return x.FunctionName
 	}
-	return nil
+	return ""
 }
 
-// proto wrapper to store the value
-type UpgradePolicyProto struct {
+// Sponsor is the move view function which decides whether the sponsor pays
+// the fee of a tx naming the sponsor as the fee granter. The function must be
+// `fun(sponsor: address, payer: address, msg_types: vector<String>,
+//
+//	fee_denoms: vector<String>, fee_amounts: vector<u64>, gas_limit: u64): bool`.
+type Sponsor struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Policy UpgradePolicy `protobuf:"varint,1,opt,name=policy,proto3,enum=initia.move.v1.UpgradePolicy" json:"policy,omitempty"`
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	FunctionName  string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
 }
 
-func (x *UpgradePolicyProto) Reset() {
-	*x = UpgradePolicyProto{}
+func (x *Sponsor) Reset() {
+	*x = Sponsor{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[6]
+		mi := &file_initia_move_v1_types_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpgradePolicyProto) String() string {
+func (x *Sponsor) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpgradePolicyProto) ProtoMessage() {}
+func (*Sponsor) ProtoMessage() {}
 
-// Deprecated: Use UpgradePolicyProto.ProtoReflect.Descriptor instead.
-func (*UpgradePolicyProto) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use Sponsor.ProtoReflect.Descriptor instead.
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{24}
 }
 
-func (x *UpgradePolicyProto) GetPolicy() UpgradePolicy {
+func (x *Sponsor) GetAddress() string {
 	if x != nil {
-		return x.Policy
+		return x.Address
 	}
-	return UpgradePolicy_ARBITRARY
-}
-
-// DexPair contains coin metdata address
-// std::dex::Pool and std::dex::Config resources.
-type DexPair struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	MetadataQuote string `protobuf:"bytes,1,opt,name=metadata_quote,json=metadataQuote,proto3" json:"metadata_quote,omitempty"`
-	MetadataLp    string `protobuf:"bytes,2,opt,name=metadata_lp,json=metadataLp,proto3" json:"metadata_lp,omitempty"`
+	return ""
 }
 
-func (x *DexPair) Reset() {
-	*x = DexPair{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[7]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Sponsor) GetModuleAddress() string {
+	if x != nil {
+		return x.ModuleAddress
 	}
+	return ""
 }
 
-func (x *DexPair) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*DexPair) ProtoMessage() {}
-
-// Deprecated: Use DexPair.ProtoReflect.Descriptor instead.
-func (*DexPair) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{7}
-}
-
-func (x *DexPair) GetMetadataQuote() string {
+func (x *Sponsor) GetModuleName() string {
 	if x != nil {
-		return x.MetadataQuote
+		return x.ModuleName
 	}
 	return ""
 }
 
-func (x *DexPair) GetMetadataLp() string {
+func (x *Sponsor) GetFunctionName() string {
 	if x != nil {
-		return x.MetadataLp
+		return x.FunctionName
 	}
 	return ""
 }
 
-// ExecuteAuthorizationItem is the information for granting module execution
-type ExecuteAuthorizationItem struct {
+// VotingPowerWeightObservation is the LP voting power weight observed at
+// the beginning of a block, which is the locked base balance of the dex
+// pool divided by the LP supply.
+type VotingPowerWeightObservation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// ModuleAddr is the address of the module deployer
-	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
-	// ModuleName is the names of module to execute
-	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
-	// FunctionName is the name of function to execute with wildcard '*' support
-	FunctionNames []string `protobuf:"bytes,3,rep,name=function_names,json=functionNames,proto3" json:"function_names,omitempty"`
+	Denom  string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Weight string `protobuf:"bytes,3,opt,name=weight,proto3" json:"weight,omitempty"`
 }
 
-func (x *ExecuteAuthorizationItem) Reset() {
-	*x = ExecuteAuthorizationItem{}
+func (x *VotingPowerWeightObservation) Reset() {
+	*x = VotingPowerWeightObservation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_initia_move_v1_types_proto_msgTypes[8]
+		mi := &file_initia_move_v1_types_proto_msgTypes[25]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ExecuteAuthorizationItem) String() string {
+func (x *VotingPowerWeightObservation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ExecuteAuthorizationItem) ProtoMessage() {}
+func (*VotingPowerWeightObservation) ProtoMessage() {}
 
-// Deprecated: Use ExecuteAuthorizationItem.ProtoReflect.Descriptor instead.
-func (*ExecuteAuthorizationItem) Descriptor() ([]byte, []int) {
-	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use VotingPowerWeightObservation.ProtoReflect.Descriptor instead.
+func (*VotingPowerWeightObservation) Descriptor() ([]byte, []int) {
+	return file_initia_move_v1_types_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *ExecuteAuthorizationItem) GetModuleAddress() string {
+func (x *VotingPowerWeightObservation) GetDenom() string {
 	if x != nil {
-		return x.ModuleAddress
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *ExecuteAuthorizationItem) GetModuleName() string {
+func (x *VotingPowerWeightObservation) GetHeight() int64 {
 	if x != nil {
-		return x.ModuleName
+		return x.Height
 	}
-	return ""
+	return 0
 }
 
-func (x *ExecuteAuthorizationItem) GetFunctionNames() []string {
+func (x *VotingPowerWeightObservation) GetWeight() string {
 	if x != nil {
-		return x.FunctionNames
+		return x.Weight
 	}
-	return nil
+	return ""
 }
 
 var File_initia_move_v1_types_proto protoreflect.FileDescriptor
@@ -5878,8 +20216,10 @@ var file_initia_move_v1_types_proto_rawDesc = []byte{
 	0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x0e, 0x69, 0x6e,
 	0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x1a, 0x11, 0x61, 0x6d,
 	0x69, 0x6e, 0x6f, 0x2f, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
+	0x1e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x62, 0x61, 0x73, 0x65, 0x2f, 0x76, 0x31, 0x62,
+	0x65, 0x74, 0x61, 0x31, 0x2f, 0x63, 0x6f, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a,
 	0x14, 0x67, 0x6f, 0x67, 0x6f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67, 0x6f, 0x67, 0x6f, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xcb, 0x03, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xbb, 0x0a, 0x0a, 0x06, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
 	0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
 	0x72, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f,
@@ -5889,26 +20229,81 @@ var file_initia_move_v1_types_proto_rawDesc = []byte{
 	0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d,
 	0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0xa8, 0xe7, 0xb0,
 	0x2a, 0x01, 0x52, 0x0f, 0x62, 0x61, 0x73, 0x65, 0x4d, 0x69, 0x6e, 0x47, 0x61, 0x73, 0x50, 0x72,
-	0x69, 0x63, 0x65, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x72, 0x79,
-	0x5f, 0x65, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x10,
-	0x61, 0x72, 0x62, 0x69, 0x74, 0x72, 0x61, 0x72, 0x79, 0x45, 0x6e, 0x61, 0x62, 0x6c, 0x65, 0x64,
-	0x12, 0x93, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x73, 0x68,
-	0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x50, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde,
-	0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d,
-	0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f,
-	0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f,
-	0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x72,
-	0x61, 0x74, 0x69, 0x6f, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x63, 0x6f, 0x6e, 0x74,
-	0x72, 0x61, 0x63, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
-	0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65,
-	0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x18, 0x05, 0x20, 0x03,
-	0x28, 0x09, 0x42, 0x22, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x6c,
-	0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73,
-	0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x50,
-	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x3a, 0x18, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
+	0x69, 0x63, 0x65, 0x12, 0x93, 0x01, 0x0a, 0x1d, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74,
+	0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x50, 0xc8, 0xde, 0x1f,
+	0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69,
+	0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63, 0x79, 0x44, 0x65, 0x63,
+	0xf2, 0xde, 0x1f, 0x24, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a, 0x63,
+	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65, 0x76,
+	0x65, 0x6e, 0x75, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x51, 0x0a, 0x12, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x18,
+	0x04, 0x20, 0x03, 0x28, 0x09, 0x42, 0x22, 0xf2, 0xde, 0x1f, 0x19, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x65, 0x72, 0x73, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77,
+	0x65, 0x64, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x65, 0x72, 0x73, 0x12, 0x55, 0x0a, 0x16,
+	0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72,
+	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde,
+	0x1f, 0x1d, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52,
+	0x12, 0x63, 0x72, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c,
+	0x6f, 0x63, 0x6b, 0x12, 0x48, 0x0a, 0x11, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f,
+	0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c,
+	0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d,
+	0x61, 0x78, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x72,
+	0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x57, 0x0a,
+	0x0c, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x07, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
+	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65,
+	0x42, 0x17, 0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x6c,
+	0x69, 0x73, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x3c, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde,
+	0x1f, 0x16, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3d, 0x0a,
+	0x0d, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x0c,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x14,
+	0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75,
+	0x6f, 0x74, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x12, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12,
+	0x5a, 0x0a, 0x17, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72,
+	0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x04,
+	0x42, 0x22, 0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68,
+	0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61,
+	0x74, 0x6f, 0x72, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c,
+	0x3a, 0x22, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x22, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x47, 0x61, 0x73,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x5c, 0x0a, 0x18, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f,
+	0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d,
+	0x6c, 0x3a, 0x22, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f,
+	0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x15, 0x76, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x77, 0x61, 0x70, 0x57, 0x69, 0x6e,
+	0x64, 0x6f, 0x77, 0x12, 0xa3, 0x01, 0x0a, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f,
+	0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0e, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x56, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67,
+	0x61, 0x63, 0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x2a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72,
+	0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72,
+	0x61, 0x74, 0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x56, 0x6f,
+	0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x3a, 0x18, 0x98, 0xa0, 0x1f, 0x00, 0xe8,
 	0xa0, 0x1f, 0x01, 0x8a, 0xe7, 0xb0, 0x2a, 0x0b, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x50, 0x61, 0x72,
-	0x61, 0x6d, 0x73, 0x22, 0xb4, 0x02, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d,
+	0x61, 0x6d, 0x73, 0x22, 0xd1, 0x09, 0x0a, 0x09, 0x52, 0x61, 0x77, 0x50, 0x61, 0x72, 0x61, 0x6d,
 	0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
 	0x12, 0x72, 0x0a, 0x12, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x67, 0x61, 0x73,
@@ -5927,79 +20322,357 @@ var file_initia_move_v1_types_proto_rawDesc = []byte{
 	0x61, 0x63, 0x74, 0x5f, 0x73, 0x68, 0x61, 0x72, 0x65, 0x64, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e,
 	0x75, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1a,
 	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x53, 0x68, 0x61, 0x72, 0x65, 0x64, 0x52, 0x65,
-	0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x4d,
-	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x76, 0x65, 0x6e, 0x75, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x55, 0x0a, 0x16, 0x63, 0x72,
+	0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62,
+	0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x42, 0x21, 0xf2, 0xde, 0x1f, 0x1d,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x22, 0x52, 0x12, 0x63,
+	0x72, 0x6f, 0x6e, 0x4d, 0x61, 0x78, 0x47, 0x61, 0x73, 0x50, 0x65, 0x72, 0x42, 0x6c, 0x6f, 0x63,
+	0x6b, 0x12, 0x48, 0x0a, 0x11, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x66, 0x61,
+	0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde,
+	0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x63, 0x72, 0x6f, 0x6e, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x22, 0x52, 0x0f, 0x63, 0x72, 0x6f, 0x6e,
+	0x4d, 0x61, 0x78, 0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x57, 0x0a, 0x0c, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1b, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e,
+	0x76, 0x31, 0x2e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x42, 0x17,
+	0xf2, 0xde, 0x1f, 0x13, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x5f, 0x6d, 0x6f, 0x64, 0x65, 0x22, 0x52, 0x0b, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x4d, 0x6f, 0x64, 0x65, 0x12, 0x65, 0x0a, 0x0f, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x3c, 0xc8,
+	0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b,
+	0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0xf2, 0xde, 0x1f, 0x16,
+	0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x64, 0x65,
+	0x70, 0x6f, 0x73, 0x69, 0x74, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x0e, 0x70, 0x75, 0x62,
+	0x6c, 0x69, 0x73, 0x68, 0x44, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x3d, 0x0a, 0x0d, 0x70,
+	0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01,
+	0x28, 0x04, 0x42, 0x18, 0xf2, 0xde, 0x1f, 0x14, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x22, 0x52, 0x0c, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x12, 0x51, 0x0a, 0x14, 0x70, 0x75,
+	0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64,
+	0x6f, 0x77, 0x18, 0x09, 0x20, 0x01, 0x28, 0x04, 0x42, 0x1f, 0xf2, 0xde, 0x1f, 0x1b, 0x79, 0x61,
+	0x6d, 0x6c, 0x3a, 0x22, 0x70, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x5f, 0x71, 0x75, 0x6f, 0x74,
+	0x61, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x12, 0x70, 0x75, 0x62, 0x6c, 0x69,
+	0x73, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x61, 0x57, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x12, 0x5a, 0x0a,
+	0x17, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x67,
+	0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x04, 0x42, 0x22,
+	0xf2, 0xde, 0x1f, 0x1e, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e,
+	0x74, 0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x52, 0x15, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x6f,
+	0x72, 0x47, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x48, 0x0a, 0x11, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x04, 0x42, 0x1c, 0xf2, 0xde, 0x1f, 0x18, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x5f, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69,
+	0x74, 0x22, 0x52, 0x0f, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x47, 0x61, 0x73, 0x4c, 0x69,
+	0x6d, 0x69, 0x74, 0x12, 0x5c, 0x0a, 0x18, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f,
+	0x77, 0x65, 0x72, 0x5f, 0x74, 0x77, 0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x04, 0x42, 0x23, 0xf2, 0xde, 0x1f, 0x1f, 0x79, 0x61, 0x6d, 0x6c, 0x3a,
+	0x22, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x74, 0x77,
+	0x61, 0x70, 0x5f, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x22, 0x52, 0x15, 0x76, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x54, 0x77, 0x61, 0x70, 0x57, 0x69, 0x6e, 0x64, 0x6f,
+	0x77, 0x12, 0xa3, 0x01, 0x0a, 0x23, 0x6d, 0x61, 0x78, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67,
+	0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x56, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xf2, 0xde, 0x1f, 0x2a, 0x79, 0x61, 0x6d, 0x6c, 0x3a, 0x22, 0x6d, 0x61,
+	0x78, 0x5f, 0x76, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x6f, 0x77, 0x65, 0x72, 0x5f, 0x77,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x22, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x1e, 0x6d, 0x61, 0x78, 0x56, 0x6f, 0x74, 0x69,
+	0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72, 0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x43, 0x68, 0x61,
+	0x6e, 0x67, 0x65, 0x52, 0x61, 0x74, 0x65, 0x22, 0xb8, 0x01, 0x0a, 0x06, 0x4d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x10, 0x0a,
+	0x03, 0x61, 0x62, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61, 0x62, 0x69, 0x12,
+	0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x44, 0x0a, 0x0e,
+	0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f,
+	0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12,
+	0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x73,
+	0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x6f, 0x76, 0x65,
+	0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5f, 0x0a, 0x09, 0x54, 0x61,
+	0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12, 0x1d, 0x0a, 0x0a,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8c, 0x01, 0x0a, 0x0a,
+	0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12, 0x1b, 0x0a, 0x09,
+	0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x08, 0x6b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a, 0x12, 0x55, 0x70,
+	0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72, 0x6f, 0x74, 0x6f,
+	0x12, 0x35, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76,
+	0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x61, 0x0a, 0x07, 0x44, 0x65, 0x78, 0x50, 0x61,
+	0x69, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x65, 0x74, 0x61,
+	0x64, 0x61, 0x74, 0x61, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b, 0x6d, 0x65, 0x74,
+	0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0e,
+	0xe2, 0xde, 0x1f, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x50, 0x52, 0x0a,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x70, 0x22, 0x84, 0x02, 0x0a, 0x18, 0x45,
+	0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69, 0x7a, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f,
+	0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12,
+	0x2b, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01, 0x52, 0x0d, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x12, 0x1b, 0x0a, 0x09,
+	0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x74, 0x79, 0x70, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x56, 0x0a, 0x0f, 0x61, 0x72, 0x67,
+	0x5f, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x05, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65,
+	0x2e, 0x76, 0x31, 0x2e, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f, 0x6e, 0x73,
+	0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a,
+	0x01, 0x52, 0x0e, 0x61, 0x72, 0x67, 0x43, 0x6f, 0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74,
+	0x73, 0x22, 0xad, 0x01, 0x0a, 0x12, 0x41, 0x72, 0x67, 0x75, 0x6d, 0x65, 0x6e, 0x74, 0x43, 0x6f,
+	0x6e, 0x73, 0x74, 0x72, 0x61, 0x69, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x69, 0x6e, 0x64, 0x65,
+	0x78, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x16,
+	0x0a, 0x06, 0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x06,
+	0x65, 0x71, 0x75, 0x61, 0x6c, 0x73, 0x12, 0x3c, 0x0a, 0x0a, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x1d, 0xc8, 0xde, 0x1f, 0x01,
+	0xda, 0xde, 0x1f, 0x15, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73, 0x64, 0x6b, 0x2e, 0x69, 0x6f,
+	0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x49, 0x6e, 0x74, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x10, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x22, 0x7e, 0x0a, 0x16, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x52, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e,
+	0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e,
+	0x74, 0x22, 0x9c, 0x01, 0x0a, 0x12, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65,
+	0x6e, 0x75, 0x65, 0x52, 0x61, 0x74, 0x69, 0x6f, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
 	0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65,
-	0x12, 0x10, 0x0a, 0x03, 0x61, 0x62, 0x69, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x61,
-	0x62, 0x69, 0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12,
-	0x44, 0x0a, 0x0e, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69, 0x63,
-	0x79, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61,
-	0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65,
-	0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x0d, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50,
-	0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x85, 0x01, 0x0a, 0x08, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72,
-	0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1d, 0x0a, 0x0a,
-	0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x5f, 0x74, 0x61, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x09, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x54, 0x61, 0x67, 0x12, 0x23, 0x0a, 0x0d, 0x6d,
-	0x6f, 0x76, 0x65, 0x5f, 0x72, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6d, 0x6f, 0x76, 0x65, 0x52, 0x65, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x12, 0x1b, 0x0a, 0x09, 0x72, 0x61, 0x77, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0c, 0x52, 0x08, 0x72, 0x61, 0x77, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x5f, 0x0a,
-	0x09, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
+	0x12, 0x3e, 0x0a, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x05, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x22, 0xce, 0x01, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x52, 0x65, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x75, 0x0a, 0x0d, 0x74, 0x6f,
+	0x74, 0x61, 0x6c, 0x5f, 0x72, 0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x18, 0x03, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e,
+	0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x35, 0xc8, 0xde,
+	0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+	0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2d, 0x73,
+	0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x73, 0xa8, 0xe7,
+	0xb0, 0x2a, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x52, 0x65, 0x76, 0x65, 0x6e, 0x75,
+	0x65, 0x22, 0x9a, 0x01, 0x0a, 0x10, 0x43, 0x6c, 0x61, 0x69, 0x6d, 0x61, 0x62, 0x6c, 0x65, 0x52,
+	0x65, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69,
+	0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70,
+	0x69, 0x65, 0x6e, 0x74, 0x12, 0x68, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61,
+	0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42,
+	0x35, 0xc8, 0xde, 0x1f, 0x00, 0xaa, 0xdf, 0x1f, 0x28, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e,
+	0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2f, 0x63, 0x6f, 0x73, 0x6d, 0x6f,
+	0x73, 0x2d, 0x73, 0x64, 0x6b, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x73, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x97,
+	0x03, 0x0a, 0x07, 0x43, 0x72, 0x6f, 0x6e, 0x4a, 0x6f, 0x62, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x63, 0x72,
+	0x65, 0x61, 0x74, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x6f, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d,
+	0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d,
+	0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d,
+	0x65, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x61, 0x72, 0x67, 0x73, 0x18, 0x06,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x41, 0x72, 0x67, 0x73, 0x12, 0x12,
+	0x0a, 0x04, 0x61, 0x72, 0x67, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x04, 0x61, 0x72,
+	0x67, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x12, 0x1b,
+	0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x08, 0x67, 0x61, 0x73, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x12, 0x3e, 0x0a, 0x07, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63,
+	0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74,
+	0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x07, 0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6e,
+	0x65, 0x78, 0x74, 0x5f, 0x72, 0x75, 0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x0b,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6e, 0x65, 0x78, 0x74, 0x52, 0x75, 0x6e, 0x48, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x66, 0x61, 0x69, 0x6c,
+	0x75, 0x72, 0x65, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xbb, 0x01, 0x0a, 0x07, 0x43, 0x72, 0x6f,
+	0x6e, 0x52, 0x75, 0x6e, 0x12, 0x15, 0x0a, 0x06, 0x6a, 0x6f, 0x62, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x6a, 0x6f, 0x62, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x68,
+	0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a,
+	0x08, 0x67, 0x61, 0x73, 0x5f, 0x75, 0x73, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x07, 0x67, 0x61, 0x73, 0x55, 0x73, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x2e, 0x62,
+	0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e, 0x43, 0x6f, 0x69, 0x6e,
+	0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x03, 0x66, 0x65, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x48, 0x0a, 0x10, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x70, 0x61, 0x63, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64,
 	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x6b, 0x65, 0x79, 0x5f, 0x74, 0x79, 0x70, 0x65,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6b, 0x65, 0x79, 0x54, 0x79, 0x70, 0x65, 0x12,
-	0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x54, 0x79, 0x70, 0x65, 0x22, 0x8c,
-	0x01, 0x0a, 0x0a, 0x54, 0x61, 0x62, 0x6c, 0x65, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x18, 0x0a,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c,
-	0x75, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x12,
-	0x1b, 0x0a, 0x09, 0x6b, 0x65, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x0c, 0x52, 0x08, 0x6b, 0x65, 0x79, 0x42, 0x79, 0x74, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x42, 0x79, 0x74, 0x65, 0x73, 0x22, 0x4b, 0x0a,
-	0x12, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x50, 0x72,
-	0x6f, 0x74, 0x6f, 0x12, 0x35, 0x0a, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76,
-	0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69,
-	0x63, 0x79, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x61, 0x0a, 0x07, 0x44, 0x65,
-	0x78, 0x50, 0x61, 0x69, 0x72, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74,
-	0x61, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d,
-	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x2f, 0x0a, 0x0b,
-	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x5f, 0x6c, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x42, 0x0e, 0xe2, 0xde, 0x1f, 0x0a, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c,
-	0x50, 0x52, 0x0a, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x4c, 0x70, 0x22, 0x8f, 0x01,
-	0x0a, 0x18, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x65, 0x41, 0x75, 0x74, 0x68, 0x6f, 0x72, 0x69,
-	0x7a, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f,
-	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x66, 0x69, 0x78, 0x65, 0x73,
+	0x22, 0x6e, 0x0a, 0x0c, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x55, 0x73, 0x61, 0x67, 0x65,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2e, 0x0a, 0x13, 0x77, 0x69,
+	0x6e, 0x64, 0x6f, 0x77, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x77, 0x69, 0x6e, 0x64, 0x6f, 0x77, 0x53,
+	0x74, 0x61, 0x72, 0x74, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x22, 0xb4, 0x01, 0x0a, 0x0e, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x44, 0x65, 0x70, 0x6f,
+	0x73, 0x69, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x1c, 0x0a, 0x09, 0x64,
+	0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x64, 0x65, 0x70, 0x6f, 0x73, 0x69, 0x74, 0x6f, 0x72, 0x12, 0x3c, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x63, 0x6f, 0x73, 0x6d,
+	0x6f, 0x73, 0x2e, 0x62, 0x61, 0x73, 0x65, 0x2e, 0x76, 0x31, 0x62, 0x65, 0x74, 0x61, 0x31, 0x2e,
+	0x43, 0x6f, 0x69, 0x6e, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x4d, 0x0a, 0x10, 0x4d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x49, 0x64, 0x65, 0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f,
+	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75,
+	0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x64, 0x0a, 0x0d, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x46, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
 	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61,
-	0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x0e, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e,
-	0x61, 0x6d, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x42, 0x04, 0xc8, 0xde, 0x1f, 0x01,
-	0x52, 0x0d, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x2a,
-	0x3d, 0x0a, 0x0d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79,
-	0x12, 0x0d, 0x0a, 0x09, 0x41, 0x52, 0x42, 0x49, 0x54, 0x52, 0x41, 0x52, 0x59, 0x10, 0x00, 0x12,
-	0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c, 0x45, 0x10, 0x01, 0x12,
-	0x0d, 0x0a, 0x09, 0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45, 0x10, 0x02, 0x42, 0xbb,
-	0x01, 0xc8, 0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54,
-	0x79, 0x70, 0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c,
-	0x61, 0x62, 0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f,
-	0x76, 0x65, 0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69,
-	0x74, 0x69, 0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e,
-	0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49,
-	0x6e, 0x69, 0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50,
-	0x42, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74,
-	0x69, 0x61, 0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+	0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x09, 0x52, 0x07, 0x66, 0x72, 0x69, 0x65, 0x6e, 0x64, 0x73, 0x22, 0xe9, 0x01, 0x0a,
+	0x11, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x53, 0x69, 0x67, 0x6e, 0x61, 0x74, 0x75,
+	0x72, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b,
+	0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x19, 0x0a, 0x08, 0x69, 0x73, 0x5f, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x17, 0x0a, 0x07,
+	0x69, 0x73, 0x5f, 0x76, 0x69, 0x65, 0x77, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69,
+	0x73, 0x56, 0x69, 0x65, 0x77, 0x12, 0x1f, 0x0a, 0x0b, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x70, 0x61,
+	0x72, 0x61, 0x6d, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x79, 0x70, 0x65,
+	0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73,
+	0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x12, 0x18,
+	0x0a, 0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x22, 0xf9, 0x03, 0x0a, 0x1a, 0x4d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x43, 0x6f, 0x6d, 0x70, 0x61, 0x74,
+	0x69, 0x62, 0x69, 0x6c, 0x69, 0x74, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61,
+	0x6d, 0x65, 0x12, 0x15, 0x0a, 0x06, 0x69, 0x73, 0x5f, 0x6e, 0x65, 0x77, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x05, 0x69, 0x73, 0x4e, 0x65, 0x77, 0x12, 0x51, 0x0a, 0x15, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x64, 0x5f, 0x75, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x5f, 0x70, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1d, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64,
+	0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52, 0x13, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x64, 0x55,
+	0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x18, 0x0a, 0x07,
+	0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x61,
+	0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x2b,
+	0x0a, 0x11, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x72, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x64, 0x46, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2b, 0x0a, 0x11, 0x63,
+	0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x08, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x46,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x6d, 0x6f,
+	0x76, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74,
+	0x73, 0x12, 0x27, 0x0a, 0x0f, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x64, 0x5f, 0x73, 0x74, 0x72,
+	0x75, 0x63, 0x74, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e, 0x63, 0x68, 0x61, 0x6e,
+	0x67, 0x65, 0x64, 0x53, 0x74, 0x72, 0x75, 0x63, 0x74, 0x73, 0x12, 0x58, 0x0a, 0x11, 0x62, 0x72,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x65, 0x6e, 0x74, 0x73, 0x18,
+	0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2e, 0x6d,
+	0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x49, 0x64, 0x65,
+	0x6e, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x42, 0x09, 0xc8, 0xde, 0x1f, 0x00, 0xa8, 0xe7, 0xb0,
+	0x2a, 0x01, 0x52, 0x10, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x65, 0x6e, 0x74, 0x73, 0x22, 0x96, 0x01, 0x0a, 0x0d, 0x41, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74,
+	0x69, 0x63, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f, 0x64, 0x75, 0x6c,
+	0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66, 0x75, 0x6e, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65, 0x22, 0x90, 0x01,
+	0x0a, 0x07, 0x53, 0x70, 0x6f, 0x6e, 0x73, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6d, 0x6f, 0x64,
+	0x75, 0x6c, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x6d, 0x6f,
+	0x64, 0x75, 0x6c, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x66,
+	0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0c, 0x66, 0x75, 0x6e, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x8e, 0x01, 0x0a, 0x1c, 0x56, 0x6f, 0x74, 0x69, 0x6e, 0x67, 0x50, 0x6f, 0x77, 0x65, 0x72,
+	0x57, 0x65, 0x69, 0x67, 0x68, 0x74, 0x4f, 0x62, 0x73, 0x65, 0x72, 0x76, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12,
+	0x40, 0x0a, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
+	0x28, 0xc8, 0xde, 0x1f, 0x00, 0xda, 0xde, 0x1f, 0x1b, 0x63, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x73,
+	0x64, 0x6b, 0x2e, 0x69, 0x6f, 0x2f, 0x6d, 0x61, 0x74, 0x68, 0x2e, 0x4c, 0x65, 0x67, 0x61, 0x63,
+	0x79, 0x44, 0x65, 0x63, 0xa8, 0xe7, 0xb0, 0x2a, 0x01, 0x52, 0x06, 0x77, 0x65, 0x69, 0x67, 0x68,
+	0x74, 0x2a, 0x3f, 0x0a, 0x0d, 0x55, 0x70, 0x67, 0x72, 0x61, 0x64, 0x65, 0x50, 0x6f, 0x6c, 0x69,
+	0x63, 0x79, 0x12, 0x0f, 0x0a, 0x0b, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45,
+	0x44, 0x10, 0x00, 0x12, 0x0e, 0x0a, 0x0a, 0x43, 0x4f, 0x4d, 0x50, 0x41, 0x54, 0x49, 0x42, 0x4c,
+	0x45, 0x10, 0x01, 0x12, 0x0d, 0x0a, 0x09, 0x49, 0x4d, 0x4d, 0x55, 0x54, 0x41, 0x42, 0x4c, 0x45,
+	0x10, 0x02, 0x2a, 0xe7, 0x01, 0x0a, 0x0b, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f,
+	0x64, 0x65, 0x12, 0x38, 0x0a, 0x18, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x4d, 0x4f,
+	0x44, 0x45, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10, 0x00,
+	0x1a, 0x1a, 0x8a, 0x9d, 0x20, 0x16, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64,
+	0x65, 0x55, 0x6e, 0x73, 0x70, 0x65, 0x63, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x2a, 0x0a, 0x11,
+	0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x4f, 0x50, 0x45,
+	0x4e, 0x10, 0x01, 0x1a, 0x13, 0x8a, 0x9d, 0x20, 0x0f, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68,
+	0x4d, 0x6f, 0x64, 0x65, 0x4f, 0x70, 0x65, 0x6e, 0x12, 0x34, 0x0a, 0x16, 0x50, 0x55, 0x42, 0x4c,
+	0x49, 0x53, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x41, 0x4c, 0x4c, 0x4f, 0x57, 0x4c, 0x49,
+	0x53, 0x54, 0x10, 0x02, 0x1a, 0x18, 0x8a, 0x9d, 0x20, 0x14, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73,
+	0x68, 0x4d, 0x6f, 0x64, 0x65, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x6c, 0x69, 0x73, 0x74, 0x12, 0x36,
+	0x0a, 0x17, 0x50, 0x55, 0x42, 0x4c, 0x49, 0x53, 0x48, 0x5f, 0x4d, 0x4f, 0x44, 0x45, 0x5f, 0x47,
+	0x4f, 0x56, 0x45, 0x52, 0x4e, 0x41, 0x4e, 0x43, 0x45, 0x10, 0x03, 0x1a, 0x19, 0x8a, 0x9d, 0x20,
+	0x15, 0x50, 0x75, 0x62, 0x6c, 0x69, 0x73, 0x68, 0x4d, 0x6f, 0x64, 0x65, 0x47, 0x6f, 0x76, 0x65,
+	0x72, 0x6e, 0x61, 0x6e, 0x63, 0x65, 0x1a, 0x04, 0x88, 0xa3, 0x1e, 0x00, 0x42, 0xbb, 0x01, 0xc8,
+	0xe1, 0x1e, 0x00, 0xa8, 0xe2, 0x1e, 0x01, 0x0a, 0x12, 0x63, 0x6f, 0x6d, 0x2e, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2e, 0x6d, 0x6f, 0x76, 0x65, 0x2e, 0x76, 0x31, 0x42, 0x0a, 0x54, 0x79, 0x70,
+	0x65, 0x73, 0x50, 0x72, 0x6f, 0x74, 0x6f, 0x50, 0x01, 0x5a, 0x37, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2d, 0x6c, 0x61, 0x62,
+	0x73, 0x2f, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x69, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x2f, 0x76, 0x31, 0x3b, 0x6d, 0x6f, 0x76, 0x65,
+	0x76, 0x31, 0xa2, 0x02, 0x03, 0x49, 0x4d, 0x58, 0xaa, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74, 0x69,
+	0x61, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x2e, 0x56, 0x31, 0xca, 0x02, 0x0e, 0x49, 0x6e, 0x69, 0x74,
+	0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0xe2, 0x02, 0x1a, 0x49, 0x6e, 0x69,
+	0x74, 0x69, 0x61, 0x5c, 0x4d, 0x6f, 0x76, 0x65, 0x5c, 0x56, 0x31, 0x5c, 0x47, 0x50, 0x42, 0x4d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0xea, 0x02, 0x10, 0x49, 0x6e, 0x69, 0x74, 0x69, 0x61,
+	0x3a, 0x3a, 0x4d, 0x6f, 0x76, 0x65, 0x3a, 0x3a, 0x56, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -6014,28 +20687,57 @@ func file_initia_move_v1_types_proto_rawDescGZIP() []byte {
 	return file_initia_move_v1_types_proto_rawDescData
 }
 
-var file_initia_move_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_initia_move_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
+var file_initia_move_v1_types_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_initia_move_v1_types_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
 var file_initia_move_v1_types_proto_goTypes = []interface{}{
-	(UpgradePolicy)(0),               // 0: initia.move.v1.UpgradePolicy
-	(*Params)(nil),                   // 1: initia.move.v1.Params
-	(*RawParams)(nil),                // 2: initia.move.v1.RawParams
-	(*Module)(nil),                   // 3: initia.move.v1.Module
-	(*Resource)(nil),                 // 4: initia.move.v1.Resource
-	(*TableInfo)(nil),                // 5: initia.move.v1.TableInfo
-	(*TableEntry)(nil),               // 6: initia.move.v1.TableEntry
-	(*UpgradePolicyProto)(nil),       // 7: initia.move.v1.UpgradePolicyProto
-	(*DexPair)(nil),                  // 8: initia.move.v1.DexPair
-	(*ExecuteAuthorizationItem)(nil), // 9: initia.move.v1.ExecuteAuthorizationItem
+	(UpgradePolicy)(0),                   // 0: initia.move.v1.UpgradePolicy
+	(PublishMode)(0),                     // 1: initia.move.v1.PublishMode
+	(*Params)(nil),                       // 2: initia.move.v1.Params
+	(*RawParams)(nil),                    // 3: initia.move.v1.RawParams
+	(*Module)(nil),                       // 4: initia.move.v1.Module
+	(*Resource)(nil),                     // 5: initia.move.v1.Resource
+	(*TableInfo)(nil),                    // 6: initia.move.v1.TableInfo
+	(*TableEntry)(nil),                   // 7: initia.move.v1.TableEntry
+	(*UpgradePolicyProto)(nil),           // 8: initia.move.v1.UpgradePolicyProto
+	(*DexPair)(nil),                      // 9: initia.move.v1.DexPair
+	(*ExecuteAuthorizationItem)(nil),     // 10: initia.move.v1.ExecuteAuthorizationItem
+	(*ArgumentConstraint)(nil),           // 11: initia.move.v1.ArgumentConstraint
+	(*ModuleRevenueRecipient)(nil),       // 12: initia.move.v1.ModuleRevenueRecipient
+	(*ModuleRevenueRatio)(nil),           // 13: initia.move.v1.ModuleRevenueRatio
+	(*ModuleRevenue)(nil),                // 14: initia.move.v1.ModuleRevenue
+	(*ClaimableRevenue)(nil),             // 15: initia.move.v1.ClaimableRevenue
+	(*CronJob)(nil),                      // 16: initia.move.v1.CronJob
+	(*CronRun)(nil),                      // 17: initia.move.v1.CronRun
+	(*PublishNamespace)(nil),             // 18: initia.move.v1.PublishNamespace
+	(*PublishUsage)(nil),                 // 19: initia.move.v1.PublishUsage
+	(*PublishDeposit)(nil),               // 20: initia.move.v1.PublishDeposit
+	(*ModuleIdentifier)(nil),             // 21: initia.move.v1.ModuleIdentifier
+	(*ModuleFriends)(nil),                // 22: initia.move.v1.ModuleFriends
+	(*FunctionSignature)(nil),            // 23: initia.move.v1.FunctionSignature
+	(*ModuleUpgradeCompatibility)(nil),   // 24: initia.move.v1.ModuleUpgradeCompatibility
+	(*Authenticator)(nil),                // 25: initia.move.v1.Authenticator
+	(*Sponsor)(nil),                      // 26: initia.move.v1.Sponsor
+	(*VotingPowerWeightObservation)(nil), // 27: initia.move.v1.VotingPowerWeightObservation
+	(*v1beta1.Coin)(nil),                 // 28: cosmos.base.v1beta1.Coin
 }
 var file_initia_move_v1_types_proto_depIdxs = []int32{
-	0, // 0: initia.move.v1.Module.upgrade_policy:type_name -> initia.move.v1.UpgradePolicy
-	0, // 1: initia.move.v1.UpgradePolicyProto.policy:type_name -> initia.move.v1.UpgradePolicy
-	2, // [2:2] is the sub-list for method output_type
-	2, // [2:2] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	1,  // 0: initia.move.v1.Params.publish_mode:type_name -> initia.move.v1.PublishMode
+	1,  // 1: initia.move.v1.RawParams.publish_mode:type_name -> initia.move.v1.PublishMode
+	0,  // 2: initia.move.v1.Module.upgrade_policy:type_name -> initia.move.v1.UpgradePolicy
+	0,  // 3: initia.move.v1.UpgradePolicyProto.policy:type_name -> initia.move.v1.UpgradePolicy
+	11, // 4: initia.move.v1.ExecuteAuthorizationItem.arg_constraints:type_name -> initia.move.v1.ArgumentConstraint
+	28, // 5: initia.move.v1.ModuleRevenue.total_revenue:type_name -> cosmos.base.v1beta1.Coin
+	28, // 6: initia.move.v1.ClaimableRevenue.amount:type_name -> cosmos.base.v1beta1.Coin
+	28, // 7: initia.move.v1.CronJob.deposit:type_name -> cosmos.base.v1beta1.Coin
+	28, // 8: initia.move.v1.CronRun.fee:type_name -> cosmos.base.v1beta1.Coin
+	28, // 9: initia.move.v1.PublishDeposit.amount:type_name -> cosmos.base.v1beta1.Coin
+	0,  // 10: initia.move.v1.ModuleUpgradeCompatibility.stored_upgrade_policy:type_name -> initia.move.v1.UpgradePolicy
+	21, // 11: initia.move.v1.ModuleUpgradeCompatibility.broken_dependents:type_name -> initia.move.v1.ModuleIdentifier
+	12, // [12:12] is the sub-list for method output_type
+	12, // [12:12] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_initia_move_v1_types_proto_init() }
@@ -6152,14 +20854,218 @@ func file_initia_move_v1_types_proto_init() {
 				return nil
 			}
 		}
+		file_initia_move_v1_types_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ArgumentConstraint); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleRevenueRecipient); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleRevenueRatio); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleRevenue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClaimableRevenue); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronJob); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CronRun); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishNamespace); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishUsage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PublishDeposit); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleIdentifier); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleFriends); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FunctionSignature); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ModuleUpgradeCompatibility); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Authenticator); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Sponsor); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_initia_move_v1_types_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VotingPowerWeightObservation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_initia_move_v1_types_proto_rawDesc,
-			NumEnums:      1,
-			NumMessages:   9,
+			NumEnums:      2,
+			NumMessages:   26,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
@@ -32,6 +32,11 @@ const (
 	Query_DelegatorValidator_FullMethodName            = "/initia.mstaking.v1.Query/DelegatorValidator"
 	Query_Pool_FullMethodName                          = "/initia.mstaking.v1.Query/Pool"
 	Query_Params_FullMethodName                        = "/initia.mstaking.v1.Query/Params"
+	Query_VotingPowerWeights_FullMethodName            = "/initia.mstaking.v1.Query/VotingPowerWeights"
+	Query_VotingPowerWeightsHistory_FullMethodName     = "/initia.mstaking.v1.Query/VotingPowerWeightsHistory"
+	Query_PendingCommissionChange_FullMethodName       = "/initia.mstaking.v1.Query/PendingCommissionChange"
+	Query_UnbondingCalendar_FullMethodName             = "/initia.mstaking.v1.Query/UnbondingCalendar"
+	Query_RedelegationCalendar_FullMethodName          = "/initia.mstaking.v1.Query/RedelegationCalendar"
 )
 
 // QueryClient is the client API for Query service.
@@ -68,6 +73,22 @@ type QueryClient interface {
 	Pool(ctx context.Context, in *QueryPoolRequest, opts ...grpc.CallOption) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// VotingPowerWeights queries the current voting power weights of the bond denoms.
+	VotingPowerWeights(ctx context.Context, in *QueryVotingPowerWeightsRequest, opts ...grpc.CallOption) (*QueryVotingPowerWeightsResponse, error)
+	// VotingPowerWeightsHistory queries the voting power weights applied at the
+	// recent blocks, pruned by the historical entries param.
+	VotingPowerWeightsHistory(ctx context.Context, in *QueryVotingPowerWeightsHistoryRequest, opts ...grpc.CallOption) (*QueryVotingPowerWeightsHistoryResponse, error)
+	// PendingCommissionChange queries the scheduled commission change of a validator.
+	PendingCommissionChange(ctx context.Context, in *QueryPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangeResponse, error)
+	// UnbondingCalendar queries the unbonding amounts aggregated per completion
+	// time of the unbonding queue, optionally filtered by a validator and a
+	// delegator.
+	UnbondingCalendar(ctx context.Context, in *QueryUnbondingCalendarRequest, opts ...grpc.CallOption) (*QueryUnbondingCalendarResponse, error)
+	// RedelegationCalendar queries the redelegation amounts aggregated per
+	// completion time of the redelegation queue, optionally filtered by a
+	// validator and a delegator. The amount of a redelegation is its initial
+	// balance, which does not reflect the slashes after the redelegation.
+	RedelegationCalendar(ctx context.Context, in *QueryRedelegationCalendarRequest, opts ...grpc.CallOption) (*QueryRedelegationCalendarResponse, error)
 }
 
 type queryClient struct {
@@ -195,6 +216,51 @@ func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts .
 	return out, nil
 }
 
+func (c *queryClient) VotingPowerWeights(ctx context.Context, in *QueryVotingPowerWeightsRequest, opts ...grpc.CallOption) (*QueryVotingPowerWeightsResponse, error) {
+	out := new(QueryVotingPowerWeightsResponse)
+	err := c.cc.Invoke(ctx, Query_VotingPowerWeights_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) VotingPowerWeightsHistory(ctx context.Context, in *QueryVotingPowerWeightsHistoryRequest, opts ...grpc.CallOption) (*QueryVotingPowerWeightsHistoryResponse, error) {
+	out := new(QueryVotingPowerWeightsHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_VotingPowerWeightsHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingCommissionChange(ctx context.Context, in *QueryPendingCommissionChangeRequest, opts ...grpc.CallOption) (*QueryPendingCommissionChangeResponse, error) {
+	out := new(QueryPendingCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Query_PendingCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UnbondingCalendar(ctx context.Context, in *QueryUnbondingCalendarRequest, opts ...grpc.CallOption) (*QueryUnbondingCalendarResponse, error) {
+	out := new(QueryUnbondingCalendarResponse)
+	err := c.cc.Invoke(ctx, Query_UnbondingCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RedelegationCalendar(ctx context.Context, in *QueryRedelegationCalendarRequest, opts ...grpc.CallOption) (*QueryRedelegationCalendarResponse, error) {
+	out := new(QueryRedelegationCalendarResponse)
+	err := c.cc.Invoke(ctx, Query_RedelegationCalendar_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 // All implementations must embed UnimplementedQueryServer
 // for forward compatibility
@@ -229,6 +295,22 @@ type QueryServer interface {
 	Pool(context.Context, *QueryPoolRequest) (*QueryPoolResponse, error)
 	// Parameters queries the staking parameters.
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// VotingPowerWeights queries the current voting power weights of the bond denoms.
+	VotingPowerWeights(context.Context, *QueryVotingPowerWeightsRequest) (*QueryVotingPowerWeightsResponse, error)
+	// VotingPowerWeightsHistory queries the voting power weights applied at the
+	// recent blocks, pruned by the historical entries param.
+	VotingPowerWeightsHistory(context.Context, *QueryVotingPowerWeightsHistoryRequest) (*QueryVotingPowerWeightsHistoryResponse, error)
+	// PendingCommissionChange queries the scheduled commission change of a validator.
+	PendingCommissionChange(context.Context, *QueryPendingCommissionChangeRequest) (*QueryPendingCommissionChangeResponse, error)
+	// UnbondingCalendar queries the unbonding amounts aggregated per completion
+	// time of the unbonding queue, optionally filtered by a validator and a
+	// delegator.
+	UnbondingCalendar(context.Context, *QueryUnbondingCalendarRequest) (*QueryUnbondingCalendarResponse, error)
+	// RedelegationCalendar queries the redelegation amounts aggregated per
+	// completion time of the redelegation queue, optionally filtered by a
+	// validator and a delegator. The amount of a redelegation is its initial
+	// balance, which does not reflect the slashes after the redelegation.
+	RedelegationCalendar(context.Context, *QueryRedelegationCalendarRequest) (*QueryRedelegationCalendarResponse, error)
 	mustEmbedUnimplementedQueryServer()
 }
 
@@ -275,6 +357,21 @@ func (UnimplementedQueryServer) Pool(context.Context, *QueryPoolRequest) (*Query
 func (UnimplementedQueryServer) Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (UnimplementedQueryServer) VotingPowerWeights(context.Context, *QueryVotingPowerWeightsRequest) (*QueryVotingPowerWeightsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerWeights not implemented")
+}
+func (UnimplementedQueryServer) VotingPowerWeightsHistory(context.Context, *QueryVotingPowerWeightsHistoryRequest) (*QueryVotingPowerWeightsHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VotingPowerWeightsHistory not implemented")
+}
+func (UnimplementedQueryServer) PendingCommissionChange(context.Context, *QueryPendingCommissionChangeRequest) (*QueryPendingCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCommissionChange not implemented")
+}
+func (UnimplementedQueryServer) UnbondingCalendar(context.Context, *QueryUnbondingCalendarRequest) (*QueryUnbondingCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnbondingCalendar not implemented")
+}
+func (UnimplementedQueryServer) RedelegationCalendar(context.Context, *QueryRedelegationCalendarRequest) (*QueryRedelegationCalendarResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RedelegationCalendar not implemented")
+}
 func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
 
 // UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
@@ -522,6 +619,96 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_VotingPowerWeights_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerWeightsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerWeights(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingPowerWeights_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerWeights(ctx, req.(*QueryVotingPowerWeightsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_VotingPowerWeightsHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryVotingPowerWeightsHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).VotingPowerWeightsHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_VotingPowerWeightsHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).VotingPowerWeightsHistory(ctx, req.(*QueryVotingPowerWeightsHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCommissionChangeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_PendingCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCommissionChange(ctx, req.(*QueryPendingCommissionChangeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UnbondingCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnbondingCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UnbondingCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UnbondingCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UnbondingCalendar(ctx, req.(*QueryUnbondingCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RedelegationCalendar_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRedelegationCalendarRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RedelegationCalendar(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_RedelegationCalendar_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RedelegationCalendar(ctx, req.(*QueryRedelegationCalendarRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Query_ServiceDesc is the grpc.ServiceDesc for Query service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -581,6 +768,26 @@ var Query_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Params",
 			Handler:    _Query_Params_Handler,
 		},
+		{
+			MethodName: "VotingPowerWeights",
+			Handler:    _Query_VotingPowerWeights_Handler,
+		},
+		{
+			MethodName: "VotingPowerWeightsHistory",
+			Handler:    _Query_VotingPowerWeightsHistory_Handler,
+		},
+		{
+			MethodName: "PendingCommissionChange",
+			Handler:    _Query_PendingCommissionChange_Handler,
+		},
+		{
+			MethodName: "UnbondingCalendar",
+			Handler:    _Query_UnbondingCalendar_Handler,
+		},
+		{
+			MethodName: "RedelegationCalendar",
+			Handler:    _Query_RedelegationCalendar_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/mstaking/v1/query.proto",
@@ -26,6 +26,10 @@ const (
 	Msg_Undelegate_FullMethodName                = "/initia.mstaking.v1.Msg/Undelegate"
 	Msg_CancelUnbondingDelegation_FullMethodName = "/initia.mstaking.v1.Msg/CancelUnbondingDelegation"
 	Msg_UpdateParams_FullMethodName              = "/initia.mstaking.v1.Msg/UpdateParams"
+	Msg_RotateConsPubKey_FullMethodName          = "/initia.mstaking.v1.Msg/RotateConsPubKey"
+	Msg_ConvertDelegation_FullMethodName         = "/initia.mstaking.v1.Msg/ConvertDelegation"
+	Msg_ScheduleCommissionChange_FullMethodName  = "/initia.mstaking.v1.Msg/ScheduleCommissionChange"
+	Msg_RetireValidator_FullMethodName           = "/initia.mstaking.v1.Msg/RetireValidator"
 )
 
 // MsgClient is the client API for Msg service.
@@ -51,6 +55,18 @@ type MsgClient interface {
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// RotateConsPubKey defines an operation for rotating the consensus public
+	// key of a validator.
+	RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error)
+	// ConvertDelegation defines a method for converting a delegation of a bond
+	// denom to another bond denom at the same validator through the dex.
+	ConvertDelegation(ctx context.Context, in *MsgConvertDelegation, opts ...grpc.CallOption) (*MsgConvertDelegationResponse, error)
+	// ScheduleCommissionChange defines a method for scheduling a commission
+	// change of a validator, which is applied after the notice period.
+	ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error)
+	// RetireValidator defines a method for voluntarily retiring a validator, which
+	// leaves the active set at the next end block without slashing.
+	RetireValidator(ctx context.Context, in *MsgRetireValidator, opts ...grpc.CallOption) (*MsgRetireValidatorResponse, error)
 }
 
 type msgClient struct {
@@ -124,6 +140,42 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) RotateConsPubKey(ctx context.Context, in *MsgRotateConsPubKey, opts ...grpc.CallOption) (*MsgRotateConsPubKeyResponse, error) {
+	out := new(MsgRotateConsPubKeyResponse)
+	err := c.cc.Invoke(ctx, Msg_RotateConsPubKey_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConvertDelegation(ctx context.Context, in *MsgConvertDelegation, opts ...grpc.CallOption) (*MsgConvertDelegationResponse, error) {
+	out := new(MsgConvertDelegationResponse)
+	err := c.cc.Invoke(ctx, Msg_ConvertDelegation_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ScheduleCommissionChange(ctx context.Context, in *MsgScheduleCommissionChange, opts ...grpc.CallOption) (*MsgScheduleCommissionChangeResponse, error) {
+	out := new(MsgScheduleCommissionChangeResponse)
+	err := c.cc.Invoke(ctx, Msg_ScheduleCommissionChange_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RetireValidator(ctx context.Context, in *MsgRetireValidator, opts ...grpc.CallOption) (*MsgRetireValidatorResponse, error) {
+	out := new(MsgRetireValidatorResponse)
+	err := c.cc.Invoke(ctx, Msg_RetireValidator_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 // All implementations must embed UnimplementedMsgServer
 // for forward compatibility
@@ -147,6 +199,18 @@ type MsgServer interface {
 	// UpdateParams defines an operation for updating the x/staking module
 	// parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// RotateConsPubKey defines an operation for rotating the consensus public
+	// key of a validator.
+	RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error)
+	// ConvertDelegation defines a method for converting a delegation of a bond
+	// denom to another bond denom at the same validator through the dex.
+	ConvertDelegation(context.Context, *MsgConvertDelegation) (*MsgConvertDelegationResponse, error)
+	// ScheduleCommissionChange defines a method for scheduling a commission
+	// change of a validator, which is applied after the notice period.
+	ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error)
+	// RetireValidator defines a method for voluntarily retiring a validator, which
+	// leaves the active set at the next end block without slashing.
+	RetireValidator(context.Context, *MsgRetireValidator) (*MsgRetireValidatorResponse, error)
 	mustEmbedUnimplementedMsgServer()
 }
 
@@ -175,6 +239,18 @@ func (UnimplementedMsgServer) CancelUnbondingDelegation(context.Context, *MsgCan
 func (UnimplementedMsgServer) UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (UnimplementedMsgServer) RotateConsPubKey(context.Context, *MsgRotateConsPubKey) (*MsgRotateConsPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RotateConsPubKey not implemented")
+}
+func (UnimplementedMsgServer) ConvertDelegation(context.Context, *MsgConvertDelegation) (*MsgConvertDelegationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertDelegation not implemented")
+}
+func (UnimplementedMsgServer) ScheduleCommissionChange(context.Context, *MsgScheduleCommissionChange) (*MsgScheduleCommissionChangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleCommissionChange not implemented")
+}
+func (UnimplementedMsgServer) RetireValidator(context.Context, *MsgRetireValidator) (*MsgRetireValidatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RetireValidator not implemented")
+}
 func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
 
 // UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
@@ -314,6 +390,78 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_RotateConsPubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRotateConsPubKey)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RotateConsPubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RotateConsPubKey_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RotateConsPubKey(ctx, req.(*MsgRotateConsPubKey))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ConvertDelegation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConvertDelegation)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConvertDelegation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ConvertDelegation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConvertDelegation(ctx, req.(*MsgConvertDelegation))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ScheduleCommissionChange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScheduleCommissionChange)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_ScheduleCommissionChange_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScheduleCommissionChange(ctx, req.(*MsgScheduleCommissionChange))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_RetireValidator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRetireValidator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RetireValidator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_RetireValidator_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RetireValidator(ctx, req.(*MsgRetireValidator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -349,6 +497,22 @@ var Msg_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "RotateConsPubKey",
+			Handler:    _Msg_RotateConsPubKey_Handler,
+		},
+		{
+			MethodName: "ConvertDelegation",
+			Handler:    _Msg_ConvertDelegation_Handler,
+		},
+		{
+			MethodName: "ScheduleCommissionChange",
+			Handler:    _Msg_ScheduleCommissionChange_Handler,
+		},
+		{
+			MethodName: "RetireValidator",
+			Handler:    _Msg_RetireValidator_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "initia/mstaking/v1/tx.proto",
@@ -0,0 +1,308 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: initia/slashing/v1/query.proto
+
+package slashingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Query_OracleParams_FullMethodName       = "/initia.slashing.v1.Query/OracleParams"
+	Query_OracleSigningInfo_FullMethodName  = "/initia.slashing.v1.Query/OracleSigningInfo"
+	Query_OracleSigningInfos_FullMethodName = "/initia.slashing.v1.Query/OracleSigningInfos"
+	Query_UptimeParams_FullMethodName       = "/initia.slashing.v1.Query/UptimeParams"
+	Query_MissedBlockBitmap_FullMethodName  = "/initia.slashing.v1.Query/MissedBlockBitmap"
+	Query_UptimeHistory_FullMethodName      = "/initia.slashing.v1.Query/UptimeHistory"
+)
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type QueryClient interface {
+	// OracleParams queries the parameters of the oracle vote liveness tracking.
+	OracleParams(ctx context.Context, in *QueryOracleParamsRequest, opts ...grpc.CallOption) (*QueryOracleParamsResponse, error)
+	// OracleSigningInfo queries the oracle vote liveness info of given cons address.
+	OracleSigningInfo(ctx context.Context, in *QueryOracleSigningInfoRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfoResponse, error)
+	// OracleSigningInfos queries the oracle vote liveness infos of all validators.
+	OracleSigningInfos(ctx context.Context, in *QueryOracleSigningInfosRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfosResponse, error)
+	// UptimeParams queries the parameters of the per-epoch uptime history.
+	UptimeParams(ctx context.Context, in *QueryUptimeParamsRequest, opts ...grpc.CallOption) (*QueryUptimeParamsResponse, error)
+	// MissedBlockBitmap queries the missed block bitmap of the current signed
+	// blocks window of given cons address.
+	MissedBlockBitmap(ctx context.Context, in *QueryMissedBlockBitmapRequest, opts ...grpc.CallOption) (*QueryMissedBlockBitmapResponse, error)
+	// UptimeHistory queries the retained per-epoch uptime history of given cons address.
+	UptimeHistory(ctx context.Context, in *QueryUptimeHistoryRequest, opts ...grpc.CallOption) (*QueryUptimeHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewQueryClient(cc grpc.ClientConnInterface) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OracleParams(ctx context.Context, in *QueryOracleParamsRequest, opts ...grpc.CallOption) (*QueryOracleParamsResponse, error) {
+	out := new(QueryOracleParamsResponse)
+	err := c.cc.Invoke(ctx, Query_OracleParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleSigningInfo(ctx context.Context, in *QueryOracleSigningInfoRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfoResponse, error) {
+	out := new(QueryOracleSigningInfoResponse)
+	err := c.cc.Invoke(ctx, Query_OracleSigningInfo_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleSigningInfos(ctx context.Context, in *QueryOracleSigningInfosRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfosResponse, error) {
+	out := new(QueryOracleSigningInfosResponse)
+	err := c.cc.Invoke(ctx, Query_OracleSigningInfos_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UptimeParams(ctx context.Context, in *QueryUptimeParamsRequest, opts ...grpc.CallOption) (*QueryUptimeParamsResponse, error) {
+	out := new(QueryUptimeParamsResponse)
+	err := c.cc.Invoke(ctx, Query_UptimeParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissedBlockBitmap(ctx context.Context, in *QueryMissedBlockBitmapRequest, opts ...grpc.CallOption) (*QueryMissedBlockBitmapResponse, error) {
+	out := new(QueryMissedBlockBitmapResponse)
+	err := c.cc.Invoke(ctx, Query_MissedBlockBitmap_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UptimeHistory(ctx context.Context, in *QueryUptimeHistoryRequest, opts ...grpc.CallOption) (*QueryUptimeHistoryResponse, error) {
+	out := new(QueryUptimeHistoryResponse)
+	err := c.cc.Invoke(ctx, Query_UptimeHistory_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+// All implementations must embed UnimplementedQueryServer
+// for forward compatibility
+type QueryServer interface {
+	// OracleParams queries the parameters of the oracle vote liveness tracking.
+	OracleParams(context.Context, *QueryOracleParamsRequest) (*QueryOracleParamsResponse, error)
+	// OracleSigningInfo queries the oracle vote liveness info of given cons address.
+	OracleSigningInfo(context.Context, *QueryOracleSigningInfoRequest) (*QueryOracleSigningInfoResponse, error)
+	// OracleSigningInfos queries the oracle vote liveness infos of all validators.
+	OracleSigningInfos(context.Context, *QueryOracleSigningInfosRequest) (*QueryOracleSigningInfosResponse, error)
+	// UptimeParams queries the parameters of the per-epoch uptime history.
+	UptimeParams(context.Context, *QueryUptimeParamsRequest) (*QueryUptimeParamsResponse, error)
+	// MissedBlockBitmap queries the missed block bitmap of the current signed
+	// blocks window of given cons address.
+	MissedBlockBitmap(context.Context, *QueryMissedBlockBitmapRequest) (*QueryMissedBlockBitmapResponse, error)
+	// UptimeHistory queries the retained per-epoch uptime history of given cons address.
+	UptimeHistory(context.Context, *QueryUptimeHistoryRequest) (*QueryUptimeHistoryResponse, error)
+	mustEmbedUnimplementedQueryServer()
+}
+
+// UnimplementedQueryServer must be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (UnimplementedQueryServer) OracleParams(context.Context, *QueryOracleParamsRequest) (*QueryOracleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleParams not implemented")
+}
+func (UnimplementedQueryServer) OracleSigningInfo(context.Context, *QueryOracleSigningInfoRequest) (*QueryOracleSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleSigningInfo not implemented")
+}
+func (UnimplementedQueryServer) OracleSigningInfos(context.Context, *QueryOracleSigningInfosRequest) (*QueryOracleSigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleSigningInfos not implemented")
+}
+func (UnimplementedQueryServer) UptimeParams(context.Context, *QueryUptimeParamsRequest) (*QueryUptimeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UptimeParams not implemented")
+}
+func (UnimplementedQueryServer) MissedBlockBitmap(context.Context, *QueryMissedBlockBitmapRequest) (*QueryMissedBlockBitmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlockBitmap not implemented")
+}
+func (UnimplementedQueryServer) UptimeHistory(context.Context, *QueryUptimeHistoryRequest) (*QueryUptimeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UptimeHistory not implemented")
+}
+func (UnimplementedQueryServer) mustEmbedUnimplementedQueryServer() {}
+
+// UnsafeQueryServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to QueryServer will
+// result in compilation errors.
+type UnsafeQueryServer interface {
+	mustEmbedUnimplementedQueryServer()
+}
+
+func RegisterQueryServer(s grpc.ServiceRegistrar, srv QueryServer) {
+	s.RegisterService(&Query_ServiceDesc, srv)
+}
+
+func _Query_OracleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleParams(ctx, req.(*QueryOracleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleSigningInfo_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleSigningInfo(ctx, req.(*QueryOracleSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleSigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleSigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleSigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_OracleSigningInfos_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleSigningInfos(ctx, req.(*QueryOracleSigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UptimeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUptimeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UptimeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UptimeParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UptimeParams(ctx, req.(*QueryUptimeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissedBlockBitmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlockBitmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlockBitmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_MissedBlockBitmap_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlockBitmap(ctx, req.(*QueryMissedBlockBitmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UptimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUptimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UptimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Query_UptimeHistory_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UptimeHistory(ctx, req.(*QueryUptimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Query_ServiceDesc is the grpc.ServiceDesc for Query service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Query_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OracleParams",
+			Handler:    _Query_OracleParams_Handler,
+		},
+		{
+			MethodName: "OracleSigningInfo",
+			Handler:    _Query_OracleSigningInfo_Handler,
+		},
+		{
+			MethodName: "OracleSigningInfos",
+			Handler:    _Query_OracleSigningInfos_Handler,
+		},
+		{
+			MethodName: "UptimeParams",
+			Handler:    _Query_UptimeParams_Handler,
+		},
+		{
+			MethodName: "MissedBlockBitmap",
+			Handler:    _Query_MissedBlockBitmap_Handler,
+		},
+		{
+			MethodName: "UptimeHistory",
+			Handler:    _Query_UptimeHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/query.proto",
+}
@@ -0,0 +1,146 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             (unknown)
+// source: initia/slashing/v1/tx.proto
+
+package slashingv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Msg_UpdateOracleParams_FullMethodName = "/initia.slashing.v1.Msg/UpdateOracleParams"
+	Msg_UpdateUptimeParams_FullMethodName = "/initia.slashing.v1.Msg/UpdateUptimeParams"
+)
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateOracleParams(ctx context.Context, in *MsgUpdateOracleParams, opts ...grpc.CallOption) (*MsgUpdateOracleParamsResponse, error)
+	UpdateUptimeParams(ctx context.Context, in *MsgUpdateUptimeParams, opts ...grpc.CallOption) (*MsgUpdateUptimeParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewMsgClient(cc grpc.ClientConnInterface) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateOracleParams(ctx context.Context, in *MsgUpdateOracleParams, opts ...grpc.CallOption) (*MsgUpdateOracleParamsResponse, error) {
+	out := new(MsgUpdateOracleParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateOracleParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateUptimeParams(ctx context.Context, in *MsgUpdateUptimeParams, opts ...grpc.CallOption) (*MsgUpdateUptimeParamsResponse, error) {
+	out := new(MsgUpdateUptimeParamsResponse)
+	err := c.cc.Invoke(ctx, Msg_UpdateUptimeParams_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+// All implementations must embed UnimplementedMsgServer
+// for forward compatibility
+type MsgServer interface {
+	UpdateOracleParams(context.Context, *MsgUpdateOracleParams) (*MsgUpdateOracleParamsResponse, error)
+	UpdateUptimeParams(context.Context, *MsgUpdateUptimeParams) (*MsgUpdateUptimeParamsResponse, error)
+	mustEmbedUnimplementedMsgServer()
+}
+
+// UnimplementedMsgServer must be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (UnimplementedMsgServer) UpdateOracleParams(context.Context, *MsgUpdateOracleParams) (*MsgUpdateOracleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleParams not implemented")
+}
+func (UnimplementedMsgServer) UpdateUptimeParams(context.Context, *MsgUpdateUptimeParams) (*MsgUpdateUptimeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUptimeParams not implemented")
+}
+func (UnimplementedMsgServer) mustEmbedUnimplementedMsgServer() {}
+
+// UnsafeMsgServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to MsgServer will
+// result in compilation errors.
+type UnsafeMsgServer interface {
+	mustEmbedUnimplementedMsgServer()
+}
+
+func RegisterMsgServer(s grpc.ServiceRegistrar, srv MsgServer) {
+	s.RegisterService(&Msg_ServiceDesc, srv)
+}
+
+func _Msg_UpdateOracleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateOracleParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleParams(ctx, req.(*MsgUpdateOracleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateUptimeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateUptimeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateUptimeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Msg_UpdateUptimeParams_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateUptimeParams(ctx, req.(*MsgUpdateUptimeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Msg_ServiceDesc is the grpc.ServiceDesc for Msg service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Msg_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateOracleParams",
+			Handler:    _Msg_UpdateOracleParams_Handler,
+		},
+		{
+			MethodName: "UpdateUptimeParams",
+			Handler:    _Msg_UpdateUptimeParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/tx.proto",
+}
@@ -22,13 +22,15 @@ import (
 // channel keeper.
 type HandlerOptions struct {
 	ante.HandlerOptions
-	Codec         codec.BinaryCodec
-	MoveKeeper    movetypes.AnteKeeper
-	IBCkeeper     *ibckeeper.Keeper
-	AuctionKeeper auctionkeeper.Keeper
-	TxEncoder     sdk.TxEncoder
-	MevLane       auctionante.MEVLane
-	FreeLane      block.Lane
+	Codec               codec.BinaryCodec
+	MoveKeeper          movetypes.AnteKeeper
+	AuthenticatorKeeper movetypes.AuthenticatorKeeper
+	SponsorKeeper       movetypes.SponsorKeeper
+	IBCkeeper           *ibckeeper.Keeper
+	AuctionKeeper       auctionkeeper.Keeper
+	TxEncoder           sdk.TxEncoder
+	MevLane             auctionante.MEVLane
+	FreeLane            block.Lane
 }
 
 // NewAnteHandler returns an AnteHandler that checks and increments sequence
@@ -47,6 +49,14 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, errors.Wrap(sdkerrors.ErrLogic, "sign mode handler is required for ante builder")
 	}
 
+	if options.AuthenticatorKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "authenticator keeper is required for ante builder")
+	}
+
+	if options.SponsorKeeper == nil {
+		return nil, errors.Wrap(sdkerrors.ErrLogic, "sponsor keeper is required for ante builder")
+	}
+
 	sigGasConsumer := options.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
@@ -72,20 +82,24 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return feeTx.GetFee(), 1 /* FIFO */, nil
 	}
 
+	// the fee granters with a move sponsor policy pay the fees of the
+	// accepted txs, and the other granters use the feegrant module.
+	feegrantKeeper := moveante.NewSponsorFeegrantKeeper(options.SponsorKeeper, options.FeegrantKeeper)
+
 	anteDecorators := []sdk.AnteDecorator{
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
 		ante.NewExtensionOptionsDecorator(options.ExtensionOptionChecker),
 		moveante.NewGasPricesDecorator(),
+		moveante.NewExecuteSpendDecorator(),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(options.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(options.AccountKeeper),
-		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, options.FeegrantKeeper, freeLaneFeeChecker),
+		ante.NewDeductFeeDecorator(options.AccountKeeper, options.BankKeeper, feegrantKeeper, freeLaneFeeChecker),
 		// SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewSetPubKeyDecorator(options.AccountKeeper),
 		ante.NewValidateSigCountDecorator(options.AccountKeeper),
-		ante.NewSigGasConsumeDecorator(options.AccountKeeper, sigGasConsumer),
-		ante.NewSigVerificationDecorator(options.AccountKeeper, options.SignModeHandler),
+		moveante.NewSigVerificationDecorator(options.AccountKeeper, options.AuthenticatorKeeper, options.SignModeHandler, sigGasConsumer),
 		ante.NewIncrementSequenceDecorator(options.AccountKeeper),
 		ibcante.NewRedundantRelayDecorator(options.IBCkeeper),
 		auctionante.NewAuctionDecorator(options.AuctionKeeper, options.TxEncoder, options.MevLane),
@@ -116,6 +116,7 @@ import (
 	// this line is used by starport scaffolding # stargate/app/moduleImport
 
 	appante "github.com/initia-labs/initia/app/ante"
+	"github.com/initia-labs/initia/app/blockstm"
 	appheaderinfo "github.com/initia-labs/initia/app/header_info"
 	apphook "github.com/initia-labs/initia/app/hook"
 	applanes "github.com/initia-labs/initia/app/lanes"
@@ -128,6 +129,7 @@ import (
 	distrkeeper "github.com/initia-labs/initia/x/distribution/keeper"
 	"github.com/initia-labs/initia/x/evidence"
 	evidencekeeper "github.com/initia-labs/initia/x/evidence/keeper"
+	evidencecustomtypes "github.com/initia-labs/initia/x/evidence/types"
 	"github.com/initia-labs/initia/x/genutil"
 	"github.com/initia-labs/initia/x/gov"
 	govkeeper "github.com/initia-labs/initia/x/gov/keeper"
@@ -206,6 +208,9 @@ var (
 		govtypes.ModuleName:             {authtypes.Burner},
 		ibctransfertypes.ModuleName:     {authtypes.Minter, authtypes.Burner},
 		movetypes.MoveStakingModuleName: nil,
+		movetypes.MoveRevenueModuleName: nil,
+		movetypes.MoveCronModuleName:    nil,
+		movetypes.MovePublishModuleName: nil,
 		// x/auction's module account must be instantiated upon genesis to accrue auction rewards not
 		// distributed to proposers
 		auctiontypes.ModuleName: nil,
@@ -287,6 +292,7 @@ type InitiaApp struct {
 	// other slinky oracle services
 	OracleClient          oracleclient.OracleClient
 	oraclePreBlockHandler *oraclepreblock.PreBlockHandler
+	oracleLivenessBlocker sdk.PreBlocker
 
 	// make scoped keepers public for test purposes
 	ScopedIBCKeeper           capabilitykeeper.ScopedKeeper
@@ -305,6 +311,12 @@ type InitiaApp struct {
 
 	// Override of BaseApp's CheckTx
 	checkTxHandler blockchecktx.CheckTx
+
+	// parallel executor of the block txs; nil if disabled
+	txExecutor    *blockstm.Executor
+	parallelBlock *parallelBlock
+	postHandler   sdk.PostHandler
+	indexEvents   map[string]struct{}
 }
 
 // NewInitiaApp returns a reference to an initialized Initia.
@@ -422,6 +434,7 @@ func NewInitiaApp(
 		app.AccountKeeper,
 		app.BankKeeper,
 		movekeeper.NewVotingPowerKeeper(app.MoveKeeper),
+		movekeeper.NewDexKeeper(app.MoveKeeper),
 		authorityAddr,
 		vc,
 		cc,
@@ -503,6 +516,15 @@ func NewInitiaApp(
 		ac,
 		runtime.ProvideCometInfoService(),
 	)
+	evidenceRouter := evidencetypes.NewRouter().
+		AddRoute(evidencecustomtypes.RouteConflictingOracleReports, evidencekeeper.NewConflictingOracleReportsHandler(
+			*app.EvidenceKeeper,
+			compression.NewCompressionVoteExtensionCodec(
+				compression.NewDefaultVoteExtensionCodec(),
+				compression.NewZLibCompressor(),
+			),
+		))
+	app.EvidenceKeeper.SetRouter(evidenceRouter)
 
 	groupConfig := group.DefaultConfig()
 	groupKeeper := groupkeeper.NewKeeper(
@@ -809,6 +831,37 @@ func NewInitiaApp(
 		ac, vc,
 	)
 
+	// the authz keeper is used to enforce the spend limit of the execute authorization
+	app.MoveKeeper.SetAuthzKeeper(app.AuthzKeeper)
+
+	// open the node local resource index; the index is fed by the committed
+	// state changes of the move store, see registerMoveListeners.
+	if moveConfig.ResourceIndexEnabled {
+		resourceIndex, err := movekeeper.OpenResourceIndex(filepath.Join(homePath, "data"), server.GetAppDBBackend(appOpts))
+		if err != nil {
+			panic(err)
+		}
+
+		app.MoveKeeper.SetResourceIndex(resourceIndex)
+	}
+
+	// open the node local module usage record, which is used to warm up the
+	// module cache on start, see warmupModuleCache.
+	if moveConfig.ModuleCacheWarmupCount > 0 && homePath != "" {
+		moduleUsage, err := movekeeper.OpenModuleUsage(filepath.Join(homePath, "data"), moveConfig.ModuleCacheWarmupCount)
+		if err != nil {
+			panic(err)
+		}
+
+		app.MoveKeeper.SetModuleUsage(moduleUsage)
+	}
+
+	// create the node local move event stream; the stream is fed by the
+	// finalized blocks, see registerMoveListeners.
+	if moveConfig.EventStreamBufferSize > 0 {
+		app.MoveKeeper.SetEventStream(movekeeper.NewEventStream(moveConfig.EventStreamBufferSize))
+	}
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
@@ -943,6 +996,7 @@ func NewInitiaApp(
 		oracletypes.ModuleName,
 		marketmaptypes.ModuleName,
 		forwardingtypes.ModuleName,
+		movetypes.ModuleName,
 	)
 
 	// NOTE: The genutils module must occur after staking so that pools are
@@ -995,6 +1049,16 @@ func NewInitiaApp(
 	app.setPostHandler()
 	app.SetEndBlocker(app.EndBlocker)
 
+	// set the parallel executor of the block txs, which follows the tx
+	// execution of BaseApp with the events indexed by BaseApp
+	app.indexEvents = make(map[string]struct{})
+	for _, event := range cast.ToStringSlice(appOpts.Get(server.FlagIndexEvents)) {
+		app.indexEvents[event] = struct{}{}
+	}
+	if blockstmConfig := blockstm.GetConfig(appOpts); blockstmConfig.Enabled {
+		app.SetTxExecutor(blockstm.NewExecutor(blockstmConfig.Workers))
+	}
+
 	//////////////////
 	/// lane start ///
 	//////////////////
@@ -1162,6 +1226,20 @@ func NewInitiaApp(
 		),
 	)
 
+	// track the oracle vote liveness of validators from the same vote extensions
+	app.oracleLivenessBlocker = slashing.OraclePreBlocker(
+		*app.SlashingKeeper,
+		app.MarketMapKeeper,
+		compression.NewCompressionVoteExtensionCodec(
+			compression.NewDefaultVoteExtensionCodec(),
+			compression.NewZLibCompressor(),
+		),
+		compression.NewCompressionExtendedCommitCodec(
+			compression.NewDefaultExtendedCommitCodec(),
+			compression.NewZStdCompressor(),
+		),
+	)
+
 	// Create the vote extensions handler that will be used to extend and verify
 	// vote extensions (i.e. oracle data).
 	voteExtensionsHandler := ve.NewVoteExtensionHandler(
@@ -1202,11 +1280,52 @@ func NewInitiaApp(
 		if err := app.LoadLatestVersion(); err != nil {
 			tmos.Exit(err.Error())
 		}
+
+		app.registerMoveListeners()
+		app.warmupModuleCache()
 	}
 
 	return app
 }
 
+// warmupModuleCache loads the most used modules of the previous run into the
+// module cache of the move vm. The warmup is a best effort, so the failure
+// is logged and does not stop the node.
+func (app *InitiaApp) warmupModuleCache() {
+	if app.MoveKeeper.GetModuleUsage() == nil {
+		return
+	}
+
+	if err := app.MoveKeeper.WarmupModuleCache(app.NewContext(true)); err != nil {
+		app.Logger().Error("failed to warm up the move module cache", "err", err)
+	}
+}
+
+// registerMoveListeners registers the move resource index and the move event
+// stream as ABCI listeners in addition to the listeners of the streaming
+// plugins. BaseApp does not expose the streaming manager, so it is read back
+// from the check state, which is available only after the latest version is
+// loaded.
+func (app *InitiaApp) registerMoveListeners() {
+	var listeners []storetypes.ABCIListener
+	if resourceIndex := app.MoveKeeper.GetResourceIndex(); resourceIndex != nil {
+		app.CommitMultiStore().AddListeners([]storetypes.StoreKey{app.keys[movetypes.StoreKey]})
+		listeners = append(listeners, resourceIndex)
+	}
+
+	if eventStream := app.MoveKeeper.GetEventStream(); eventStream != nil {
+		listeners = append(listeners, eventStream)
+	}
+
+	if len(listeners) == 0 {
+		return
+	}
+
+	streamingManager := app.NewContext(true).StreamingManager()
+	streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, listeners...)
+	app.SetStreamingManager(streamingManager)
+}
+
 // CheckTx will check the transaction with the provided checkTxHandler. We override the default
 // handler so that we can verify bid transactions before they are inserted into the mempool.
 // With the POB CheckTx, we can verify the bid transaction and all of the bundled transactions
@@ -1232,13 +1351,15 @@ func (app *InitiaApp) setAnteHandler(
 				FeegrantKeeper:  app.FeeGrantKeeper,
 				SignModeHandler: app.txConfig.SignModeHandler(),
 			},
-			IBCkeeper:     app.IBCKeeper,
-			MoveKeeper:    movekeeper.NewDexKeeper(app.MoveKeeper),
-			Codec:         app.appCodec,
-			TxEncoder:     app.txConfig.TxEncoder(),
-			AuctionKeeper: *app.AuctionKeeper,
-			MevLane:       mevLane,
-			FreeLane:      freeLane,
+			IBCkeeper:           app.IBCKeeper,
+			MoveKeeper:          movekeeper.NewDexKeeper(app.MoveKeeper),
+			AuthenticatorKeeper: app.MoveKeeper,
+			SponsorKeeper:       app.MoveKeeper,
+			Codec:               app.appCodec,
+			TxEncoder:           app.txConfig.TxEncoder(),
+			AuctionKeeper:       *app.AuctionKeeper,
+			MevLane:             mevLane,
+			FreeLane:            freeLane,
 		},
 	)
 	if err != nil {
@@ -1258,6 +1379,7 @@ func (app *InitiaApp) setPostHandler() {
 	}
 
 	app.SetPostHandler(postHandler)
+	app.postHandler = postHandler
 }
 
 // Name returns the name of the App
@@ -1265,12 +1387,21 @@ func (app *InitiaApp) Name() string { return app.BaseApp.Name() }
 
 // PreBlocker application updates every pre block
 func (app *InitiaApp) PreBlocker(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+	// the oracle reads the txs taken out of the request, see FinalizeBlock
+	if app.parallelBlock != nil {
+		req = app.parallelBlock.req
+	}
+
 	res, err := app.ModuleManager.PreBlock(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	_, err = app.oraclePreBlockHandler.PreBlocker()(ctx, req)
+	if _, err = app.oraclePreBlockHandler.PreBlocker()(ctx, req); err != nil {
+		return res, err
+	}
+
+	_, err = app.oracleLivenessBlocker(ctx, req)
 	return res, err
 }
 
@@ -1281,6 +1412,10 @@ func (app *InitiaApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker application updates every end block
 func (app *InitiaApp) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
+	if app.parallelBlock != nil {
+		app.executeTxs(ctx, app.parallelBlock)
+	}
+
 	return app.ModuleManager.EndBlock(ctx)
 }
 
@@ -1485,6 +1620,13 @@ func (app *InitiaApp) DefaultGenesis() map[string]json.RawMessage {
 // Close closes the underlying baseapp, the oracle service, and the prometheus server if required.
 // This method blocks on the closure of both the prometheus server, and the oracle-service
 func (app *InitiaApp) Close() error {
+	// save the module usage record before the stores are closed
+	if app.MoveKeeper.GetModuleUsage() != nil {
+		if err := app.MoveKeeper.SaveModuleUsage(app.NewContext(true)); err != nil {
+			app.Logger().Error("failed to save the move module usage", "err", err)
+		}
+	}
+
 	if err := app.BaseApp.Close(); err != nil {
 		return err
 	}
@@ -1494,5 +1636,10 @@ func (app *InitiaApp) Close() error {
 		app.OracleClient.Stop()
 	}
 
+	// close the resource index
+	if resourceIndex := app.MoveKeeper.GetResourceIndex(); resourceIndex != nil {
+		return resourceIndex.Close()
+	}
+
 	return nil
 }
@@ -0,0 +1,59 @@
+package blockstm
+
+import (
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+// DefaultEnabled - parallel execution is disabled by default
+const DefaultEnabled = false
+
+// DefaultWorkers - zero uses the number of the cpus
+const DefaultWorkers = 0
+
+const (
+	flagEnabled = "blockstm.enabled"
+	flagWorkers = "blockstm.workers"
+)
+
+// Config is the config of the parallel tx executor
+type Config struct {
+	Enabled bool `mapstructure:"enabled"`
+	Workers int  `mapstructure:"workers"`
+}
+
+// DefaultConfig returns the default settings for Config
+func DefaultConfig() Config {
+	return Config{
+		Enabled: DefaultEnabled,
+		Workers: DefaultWorkers,
+	}
+}
+
+// GetConfig load config values from the app options
+func GetConfig(appOpts servertypes.AppOptions) Config {
+	return Config{
+		Enabled: cast.ToBool(appOpts.Get(flagEnabled)),
+		Workers: cast.ToInt(appOpts.Get(flagWorkers)),
+	}
+}
+
+// DefaultConfigTemplate default config template for the parallel tx executor
+const DefaultConfigTemplate = `
+###############################################################################
+###                         Block-STM                                       ###
+###############################################################################
+
+[blockstm]
+# Enable the parallel execution of the block txs. The txs are executed
+# speculatively on the multi-version view of the stores and committed
+# in the order of the block after their reads are validated, so the
+# results are identical to the sequential execution. This is a node
+# local setting and does not affect the consensus state.
+enabled = {{ .BlockSTM.Enabled }}
+
+# The number of the workers executing the txs speculatively. Zero
+# uses the number of the cpus.
+workers = {{ .BlockSTM.Workers }}
+`
@@ -0,0 +1,174 @@
+package blockstm
+
+import (
+	"runtime"
+	"sync"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/store/dbadapter"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// Task is a tx of the block executed by the Executor.
+type Task interface {
+	// Speculative reports whether the tx can be executed before the preceding
+	// txs are committed. A tx which changes the state outside of the stores
+	// must not be executed speculatively, so it is executed once in the order
+	// of the block instead.
+	Speculative() bool
+
+	// Execute executes the tx on the multi-version view of the stores. A tx
+	// can be executed more than once, and only the last execution is
+	// committed.
+	Execute(ms *MultiStore) Execution
+}
+
+// Execution is the outcome of an execution of a tx.
+type Execution interface {
+	// Commit is called in the order of the block after the reads of the
+	// execution are validated, and returns the number of the write sets of
+	// the execution to be committed. See MultiStore.Checkpoint.
+	Commit() int
+}
+
+// Executor executes the txs of a block in parallel in the manner of Block-STM.
+//
+// The txs are executed speculatively by the workers on the multi-version view
+// of the stores, where a tx reads the values written by the preceding txs.
+// The executions are committed in the order of the block after their reads
+// are validated against the committed values of the preceding txs, and an
+// execution with the stale reads is executed again on the committed values.
+// So the results are identical to the sequential execution.
+type Executor struct {
+	workers int
+}
+
+// NewExecutor returns new Executor instance with the number of the workers.
+// Zero uses the number of the cpus.
+func NewExecutor(workers int) *Executor {
+	if workers <= 0 {
+		workers = runtime.NumCPU()
+	}
+
+	return &Executor{workers: workers}
+}
+
+// execution is an execution of a tx with its view of the stores.
+type execution struct {
+	ms   *MultiStore
+	exec Execution
+}
+
+// mvMemory is the multi-version memory of the stores of the block.
+type mvMemory struct {
+	base storetypes.MultiStore
+	db   storetypes.KVStore
+	keys []storetypes.StoreKey
+
+	stores map[storetypes.StoreKey]*mvStore
+	bases  map[storetypes.StoreKey]*baseStore
+}
+
+func newMVMemory(base storetypes.MultiStore, keys []storetypes.StoreKey) *mvMemory {
+	mv := &mvMemory{
+		base:   base,
+		db:     dbadapter.Store{DB: dbm.NewMemDB()},
+		keys:   keys,
+		stores: make(map[storetypes.StoreKey]*mvStore, len(keys)),
+		bases:  make(map[storetypes.StoreKey]*baseStore, len(keys)),
+	}
+
+	for _, key := range keys {
+		mv.stores[key] = newMVStore()
+		mv.bases[key] = &baseStore{parent: base.GetKVStore(key)}
+	}
+
+	return mv
+}
+
+// execute executes the task of the index on a new view of the stores.
+func (mv *mvMemory) execute(txIdx int, task Task) execution {
+	ms := &MultiStore{
+		base:   mv.base,
+		db:     mv.db,
+		stores: make(map[storetypes.StoreKey]*txStore, len(mv.keys)),
+	}
+
+	for _, key := range mv.keys {
+		ms.stores[key] = newTxStore(txIdx, mv.stores[key], mv.bases[key])
+	}
+
+	return execution{ms: ms, exec: task.Execute(ms)}
+}
+
+// publish replaces the writes of the tx of the index with the first n write
+// sets of the execution.
+func (mv *mvMemory) publish(txIdx int, ms *MultiStore, n int) {
+	for key, store := range ms.stores {
+		mv.stores[key].set(txIdx, store.writeSet(n))
+	}
+}
+
+// write writes the committed values to the base stores.
+func (mv *mvMemory) write() {
+	for _, key := range mv.keys {
+		mv.stores[key].write(mv.bases[key].parent)
+	}
+}
+
+// Run executes the tasks on the stores of the keys and writes the committed
+// values to the stores. It returns the committed executions in the order of
+// the tasks.
+func (e *Executor) Run(base storetypes.MultiStore, keys []storetypes.StoreKey, tasks []Task) []Execution {
+	mv := newMVMemory(base, keys)
+
+	executions := make([]execution, len(tasks))
+	done := make([]chan struct{}, len(tasks))
+	jobs := make(chan int, len(tasks))
+	for i, task := range tasks {
+		done[i] = make(chan struct{})
+		if task.Speculative() {
+			jobs <- i
+		} else {
+			close(done[i])
+		}
+	}
+	close(jobs)
+
+	// the speculative writes are published, so the following txs read them
+	// before the tx is committed
+	var wg sync.WaitGroup
+	for w := 0; w < e.workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for i := range jobs {
+				executions[i] = mv.execute(i, tasks[i])
+				mv.publish(i, executions[i].ms, executions[i].ms.numWriteSets())
+				close(done[i])
+			}
+		}()
+	}
+
+	// the preceding txs are committed when a tx is validated, so the tx is
+	// executed again on the committed values when the validation fails
+	results := make([]Execution, len(tasks))
+	for i, task := range tasks {
+		<-done[i]
+
+		exec := executions[i]
+		if exec.ms == nil || !exec.ms.validate() {
+			exec = mv.execute(i, task)
+		}
+
+		mv.publish(i, exec.ms, exec.exec.Commit())
+		results[i] = exec.exec
+	}
+
+	wg.Wait()
+	mv.write()
+
+	return results
+}
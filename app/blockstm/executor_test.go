@@ -0,0 +1,225 @@
+package blockstm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+)
+
+var (
+	keyA = storetypes.NewKVStoreKey("a")
+	keyB = storetypes.NewKVStoreKey("b")
+)
+
+func setupBase(t *testing.T) storetypes.CacheMultiStore {
+	ms := rootmulti.NewStore(dbm.NewMemDB(), log.NewNopLogger(), metrics.NewNoOpMetrics())
+	ms.MountStoreWithDB(keyA, storetypes.StoreTypeIAVL, nil)
+	ms.MountStoreWithDB(keyB, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+
+	return ms.CacheMultiStore()
+}
+
+type testTask struct {
+	speculative bool
+	execute     func(ms *MultiStore) int
+}
+
+func (task testTask) Speculative() bool {
+	return task.speculative
+}
+
+func (task testTask) Execute(ms *MultiStore) Execution {
+	return testExecution(task.execute(ms))
+}
+
+type testExecution int
+
+func (exec testExecution) Commit() int {
+	return int(exec)
+}
+
+func encodeUint64(n uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, n)
+}
+
+func decodeUint64(bz []byte) uint64 {
+	if bz == nil {
+		return 0
+	}
+
+	return binary.BigEndian.Uint64(bz)
+}
+
+func Test_Executor_Disjoint(t *testing.T) {
+	base := setupBase(t)
+
+	tasks := make([]Task, 100)
+	for i := range tasks {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		tasks[i] = testTask{true, func(ms *MultiStore) int {
+			ms.GetKVStore(keyA).Set(key, encodeUint64(uint64(i)))
+			return 1
+		}}
+	}
+
+	NewExecutor(4).Run(base, []storetypes.StoreKey{keyA, keyB}, tasks)
+
+	for i := range tasks {
+		require.Equal(t, encodeUint64(uint64(i)), base.GetKVStore(keyA).Get([]byte(fmt.Sprintf("key%03d", i))))
+	}
+}
+
+func Test_Executor_Conflict(t *testing.T) {
+	base := setupBase(t)
+	base.GetKVStore(keyB).Set([]byte("counter"), encodeUint64(10))
+
+	// every tx increments the counter, and the non-speculative txs are
+	// executed in the order of the block
+	tasks := make([]Task, 100)
+	for i := range tasks {
+		key := []byte(fmt.Sprintf("key%03d", i))
+		tasks[i] = testTask{i%10 != 0, func(ms *MultiStore) int {
+			store := ms.GetKVStore(keyB)
+			counter := decodeUint64(store.Get([]byte("counter")))
+			store.Set([]byte("counter"), encodeUint64(counter+1))
+			ms.GetKVStore(keyA).Set(key, encodeUint64(counter))
+			return 1
+		}}
+	}
+
+	NewExecutor(4).Run(base, []storetypes.StoreKey{keyA, keyB}, tasks)
+
+	require.Equal(t, encodeUint64(110), base.GetKVStore(keyB).Get([]byte("counter")))
+	for i := range tasks {
+		require.Equal(t, encodeUint64(uint64(10+i)), base.GetKVStore(keyA).Get([]byte(fmt.Sprintf("key%03d", i))))
+	}
+}
+
+func Test_Executor_Iterator(t *testing.T) {
+	base := setupBase(t)
+	base.GetKVStore(keyA).Set([]byte("v/000"), encodeUint64(1000))
+	base.GetKVStore(keyA).Set([]byte("v/050"), encodeUint64(1000))
+
+	// every tx sums the values of the prefix, writes a new value and deletes
+	// the value of the base store in the middle of the block
+	tasks := make([]Task, 100)
+	for i := range tasks {
+		tasks[i] = testTask{true, func(ms *MultiStore) int {
+			store := ms.GetKVStore(keyA)
+
+			var sum, count uint64
+			iter := storetypes.KVStoreReversePrefixIterator(store, []byte("v/"))
+			for ; iter.Valid(); iter.Next() {
+				sum += decodeUint64(iter.Value())
+				count++
+			}
+			iter.Close()
+
+			store.Set([]byte(fmt.Sprintf("v/%03d", i)), encodeUint64(uint64(i)))
+			if i == 70 {
+				store.Delete([]byte("v/000"))
+			}
+
+			store.Set([]byte(fmt.Sprintf("sum/%03d", i)), encodeUint64(sum))
+			store.Set([]byte(fmt.Sprintf("count/%03d", i)), encodeUint64(count))
+
+			return 1
+		}}
+	}
+
+	NewExecutor(4).Run(base, []storetypes.StoreKey{keyA}, tasks)
+
+	store := base.GetKVStore(keyA)
+	values := map[int]uint64{0: 1000, 50: 1000}
+	for i := range tasks {
+		var sum, count uint64
+		for _, value := range values {
+			sum += value
+			count++
+		}
+
+		require.Equal(t, encodeUint64(sum), store.Get([]byte(fmt.Sprintf("sum/%03d", i))), i)
+		require.Equal(t, encodeUint64(count), store.Get([]byte(fmt.Sprintf("count/%03d", i))), i)
+
+		values[i] = uint64(i)
+		if i == 70 {
+			delete(values, 0)
+		}
+	}
+
+	require.Nil(t, store.Get([]byte("v/000")))
+	require.Equal(t, encodeUint64(99), store.Get([]byte("v/099")))
+}
+
+func Test_Executor_CommitWriteSets(t *testing.T) {
+	base := setupBase(t)
+
+	tasks := []Task{
+		testTask{true, func(ms *MultiStore) int {
+			ms.GetKVStore(keyA).Set([]byte("ante"), []byte("1"))
+			ms.Checkpoint()
+			ms.GetKVStore(keyA).Set([]byte("msgs"), []byte("1"))
+
+			// drop the writes after the checkpoint
+			return 1
+		}},
+		testTask{true, func(ms *MultiStore) int {
+			store := ms.GetKVStore(keyA)
+			if store.Has([]byte("msgs")) {
+				store.Set([]byte("seen"), []byte("msgs"))
+			} else {
+				store.Set([]byte("seen"), store.Get([]byte("ante")))
+			}
+
+			return 1
+		}},
+	}
+
+	NewExecutor(2).Run(base, []storetypes.StoreKey{keyA}, tasks)
+
+	store := base.GetKVStore(keyA)
+	require.Equal(t, []byte("1"), store.Get([]byte("ante")))
+	require.Nil(t, store.Get([]byte("msgs")))
+	require.Equal(t, []byte("1"), store.Get([]byte("seen")))
+}
+
+func Test_Executor_CacheMultiStore(t *testing.T) {
+	base := setupBase(t)
+	base.GetKVStore(keyA).Set([]byte("key"), []byte("base"))
+
+	tasks := []Task{
+		testTask{true, func(ms *MultiStore) int {
+			// the writes of the discarded branch are not visible
+			cacheMs := ms.CacheMultiStore()
+			cacheMs.GetKVStore(keyA).Set([]byte("key"), []byte("discarded"))
+
+			cacheMs = ms.CacheMultiStore()
+			cacheMs.GetKVStore(keyA).Set([]byte("key"), []byte("written"))
+			cacheMs.Write()
+
+			return 1
+		}},
+		testTask{true, func(ms *MultiStore) int {
+			store := ms.GetKVStore(keyA)
+			store.Set([]byte("copy"), store.Get([]byte("key")))
+
+			return 1
+		}},
+	}
+
+	NewExecutor(2).Run(base, []storetypes.StoreKey{keyA}, tasks)
+
+	store := base.GetKVStore(keyA)
+	require.Equal(t, []byte("written"), store.Get([]byte("key")))
+	require.Equal(t, []byte("written"), store.Get([]byte("copy")))
+}
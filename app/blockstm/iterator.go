@@ -0,0 +1,330 @@
+package blockstm
+
+import (
+	"bytes"
+	"errors"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// memIterator iterates over the key value pairs sorted in the order of the
+// iteration. The nil values are the deletions.
+type memIterator struct {
+	start, end []byte
+
+	pairs []kvPair
+	pos   int
+}
+
+var _ storetypes.Iterator = (*memIterator)(nil)
+
+func newMemIterator(start, end []byte, pairs []kvPair) *memIterator {
+	return &memIterator{start: start, end: end, pairs: pairs}
+}
+
+func (it *memIterator) Domain() (start, end []byte) {
+	return it.start, it.end
+}
+
+func (it *memIterator) Valid() bool {
+	return it.pos < len(it.pairs)
+}
+
+func (it *memIterator) Next() {
+	it.assertValid()
+	it.pos++
+}
+
+func (it *memIterator) Key() []byte {
+	it.assertValid()
+	return it.pairs[it.pos].key
+}
+
+func (it *memIterator) Value() []byte {
+	it.assertValid()
+	return it.pairs[it.pos].value
+}
+
+func (it *memIterator) Close() error {
+	it.pairs = nil
+	return nil
+}
+
+func (it *memIterator) Error() error {
+	if !it.Valid() {
+		return errors.New("invalid memIterator")
+	}
+
+	return nil
+}
+
+func (it *memIterator) assertValid() {
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
+}
+
+// mergeIterator merges the parent iterator and the cache iterator, where the
+// cache shadows the parent and the nil values of the cache are the deletions.
+// It follows the merge iterator of the cachekv store.
+type mergeIterator struct {
+	parent    storetypes.Iterator
+	cache     storetypes.Iterator
+	ascending bool
+
+	valid bool
+}
+
+var _ storetypes.Iterator = (*mergeIterator)(nil)
+
+func newMergeIterator(parent, cache storetypes.Iterator, ascending bool) *mergeIterator {
+	it := &mergeIterator{
+		parent:    parent,
+		cache:     cache,
+		ascending: ascending,
+	}
+
+	it.valid = it.skipUntilExistsOrInvalid()
+	return it
+}
+
+func (it *mergeIterator) Domain() (start, end []byte) {
+	return it.parent.Domain()
+}
+
+func (it *mergeIterator) Valid() bool {
+	return it.valid
+}
+
+func (it *mergeIterator) Next() {
+	it.assertValid()
+
+	switch {
+	case !it.parent.Valid():
+		it.cache.Next()
+	case !it.cache.Valid():
+		it.parent.Next()
+	default:
+		switch it.compare(it.parent.Key(), it.cache.Key()) {
+		case -1:
+			it.parent.Next()
+		case 0:
+			it.parent.Next()
+			it.cache.Next()
+		case 1:
+			it.cache.Next()
+		}
+	}
+
+	it.valid = it.skipUntilExistsOrInvalid()
+}
+
+func (it *mergeIterator) Key() []byte {
+	it.assertValid()
+
+	if it.useParent() {
+		return it.parent.Key()
+	}
+
+	return it.cache.Key()
+}
+
+func (it *mergeIterator) Value() []byte {
+	it.assertValid()
+
+	if it.useParent() {
+		return it.parent.Value()
+	}
+
+	return it.cache.Value()
+}
+
+func (it *mergeIterator) Close() error {
+	err := it.cache.Close()
+	if perr := it.parent.Close(); perr != nil {
+		return perr
+	}
+
+	return err
+}
+
+func (it *mergeIterator) Error() error {
+	if !it.Valid() {
+		return errors.New("invalid mergeIterator")
+	}
+
+	return nil
+}
+
+func (it *mergeIterator) assertValid() {
+	if err := it.Error(); err != nil {
+		panic(err)
+	}
+}
+
+// useParent reports whether the current item is the item of the parent.
+func (it *mergeIterator) useParent() bool {
+	if !it.parent.Valid() {
+		return false
+	}
+
+	if !it.cache.Valid() {
+		return true
+	}
+
+	return it.compare(it.parent.Key(), it.cache.Key()) < 0
+}
+
+func (it *mergeIterator) compare(a, b []byte) int {
+	if it.ascending {
+		return bytes.Compare(a, b)
+	}
+
+	return -bytes.Compare(a, b)
+}
+
+// skipCacheDeletes skips the deletions of the cache before the until key, or
+// all of them if until is nil.
+func (it *mergeIterator) skipCacheDeletes(until []byte) {
+	for it.cache.Valid() &&
+		it.cache.Value() == nil &&
+		(until == nil || it.compare(it.cache.Key(), until) < 0) {
+		it.cache.Next()
+	}
+}
+
+// skipUntilExistsOrInvalid moves the iterators forward until the current item
+// exists, and returns whether the iterator is valid.
+func (it *mergeIterator) skipUntilExistsOrInvalid() bool {
+	for {
+		if !it.parent.Valid() {
+			it.skipCacheDeletes(nil)
+			return it.cache.Valid()
+		}
+
+		if !it.cache.Valid() {
+			return true
+		}
+
+		keyP, keyC := it.parent.Key(), it.cache.Key()
+		switch it.compare(keyP, keyC) {
+		case -1:
+			return true
+		case 0:
+			if it.cache.Value() == nil {
+				it.parent.Next()
+				it.cache.Next()
+
+				continue
+			}
+
+			return true
+		case 1:
+			if it.cache.Value() == nil {
+				it.skipCacheDeletes(keyP)
+
+				continue
+			}
+
+			return true
+		}
+	}
+}
+
+// lockedIterator serializes the calls to the iterator of the base store.
+type lockedIterator struct {
+	store *baseStore
+	it    storetypes.Iterator
+}
+
+var _ storetypes.Iterator = (*lockedIterator)(nil)
+
+func (it *lockedIterator) Domain() (start, end []byte) {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	return it.it.Domain()
+}
+
+func (it *lockedIterator) Valid() bool {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	return it.it.Valid()
+}
+
+func (it *lockedIterator) Next() {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	it.it.Next()
+}
+
+func (it *lockedIterator) Key() []byte {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	return it.it.Key()
+}
+
+func (it *lockedIterator) Value() []byte {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	return it.it.Value()
+}
+
+func (it *lockedIterator) Close() error {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	return it.it.Close()
+}
+
+func (it *lockedIterator) Error() error {
+	it.store.mtx.Lock()
+	defer it.store.mtx.Unlock()
+
+	return it.it.Error()
+}
+
+// readIterator records the items of the iteration over the values written by
+// the preceding txs and the base store, which are validated on the commit.
+type readIterator struct {
+	storetypes.Iterator
+
+	read *iteratorRead
+}
+
+// iteratorRead is an iteration of a tx over a range.
+type iteratorRead struct {
+	start, end []byte
+	ascending  bool
+
+	items     []kvPair
+	exhausted bool
+}
+
+func newReadIterator(it storetypes.Iterator, read *iteratorRead) *readIterator {
+	ri := &readIterator{Iterator: it, read: read}
+	ri.record()
+
+	return ri
+}
+
+func (it *readIterator) Next() {
+	it.Iterator.Next()
+	it.record()
+}
+
+func (it *readIterator) record() {
+	if !it.Iterator.Valid() {
+		it.read.exhausted = true
+		return
+	}
+
+	it.read.items = append(it.read.items, kvPair{
+		key:   bytes.Clone(it.Iterator.Key()),
+		value: bytes.Clone(it.Iterator.Value()),
+	})
+}
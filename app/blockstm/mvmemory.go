@@ -0,0 +1,193 @@
+package blockstm
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/tidwall/btree"
+
+	storetypes "cosmossdk.io/store/types"
+)
+
+// kvPair is a key value pair of a store, where the nil value is a deletion.
+type kvPair struct {
+	key   []byte
+	value []byte
+}
+
+func kvPairLess(a, b kvPair) bool {
+	return bytes.Compare(a.key, b.key) < 0
+}
+
+// writeSet is the set of the writes of a tx, ordered by the key.
+type writeSet = btree.BTreeG[kvPair]
+
+func newWriteSet() *writeSet {
+	return btree.NewBTreeGOptions(kvPairLess, btree.Options{NoLocks: true})
+}
+
+// mvVersion is a value written by the tx of the index.
+type mvVersion struct {
+	txIdx int
+	value []byte
+}
+
+// mvItem is a key of the multi-version memory with the values written by the
+// txs, ordered by the tx index.
+type mvItem struct {
+	key      []byte
+	versions []mvVersion
+}
+
+func mvItemLess(a, b *mvItem) bool {
+	return bytes.Compare(a.key, b.key) < 0
+}
+
+// latest returns the value written by the last tx preceding the tx of the index.
+func (item *mvItem) latest(txIdx int) ([]byte, bool) {
+	i := sort.Search(len(item.versions), func(i int) bool {
+		return item.versions[i].txIdx >= txIdx
+	})
+	if i == 0 {
+		return nil, false
+	}
+
+	return item.versions[i-1].value, true
+}
+
+// mvStore is the multi-version memory of a store. It keeps the values written
+// by the txs of the block, so a tx reads the values written by the preceding
+// txs without waiting for them to be written to the store.
+type mvStore struct {
+	mtx sync.RWMutex
+
+	items *btree.BTreeG[*mvItem]
+
+	// keys written by the txs, used to replace the writes of a tx
+	written map[int][][]byte
+}
+
+func newMVStore() *mvStore {
+	return &mvStore{
+		items:   btree.NewBTreeGOptions(mvItemLess, btree.Options{NoLocks: true}),
+		written: make(map[int][][]byte),
+	}
+}
+
+// get returns the value written by the last tx preceding the tx of the index.
+// It returns false if none of the preceding txs wrote the key.
+func (s *mvStore) get(txIdx int, key []byte) ([]byte, bool) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	item, found := s.items.Get(&mvItem{key: key})
+	if !found {
+		return nil, false
+	}
+
+	return item.latest(txIdx)
+}
+
+// snapshot returns the values written by the preceding txs of the tx of the
+// index in the range, in the order of the iteration. The deletions are kept
+// as the nil values, so they shadow the values of the store.
+func (s *mvStore) snapshot(txIdx int, start, end []byte, ascending bool) []kvPair {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	var pairs []kvPair
+	iter := func(item *mvItem) bool {
+		if end != nil && bytes.Compare(item.key, end) >= 0 {
+			return false
+		}
+
+		if value, found := item.latest(txIdx); found {
+			pairs = append(pairs, kvPair{item.key, value})
+		}
+
+		return true
+	}
+
+	if start == nil {
+		s.items.Scan(iter)
+	} else {
+		s.items.Ascend(&mvItem{key: start}, iter)
+	}
+
+	if !ascending {
+		for i, j := 0, len(pairs)-1; i < j; i, j = i+1, j-1 {
+			pairs[i], pairs[j] = pairs[j], pairs[i]
+		}
+	}
+
+	return pairs
+}
+
+// set replaces the writes of the tx of the index with the write set.
+func (s *mvStore) set(txIdx int, writes *writeSet) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	for _, key := range s.written[txIdx] {
+		item, found := s.items.Get(&mvItem{key: key})
+		if !found {
+			continue
+		}
+
+		i := sort.Search(len(item.versions), func(i int) bool {
+			return item.versions[i].txIdx >= txIdx
+		})
+		if i < len(item.versions) && item.versions[i].txIdx == txIdx {
+			item.versions = append(item.versions[:i], item.versions[i+1:]...)
+		}
+
+		if len(item.versions) == 0 {
+			s.items.Delete(item)
+		}
+	}
+
+	keys := make([][]byte, 0, writes.Len())
+	writes.Scan(func(pair kvPair) bool {
+		keys = append(keys, pair.key)
+
+		item, found := s.items.Get(&mvItem{key: pair.key})
+		if !found {
+			item = &mvItem{key: pair.key}
+			s.items.Set(item)
+		}
+
+		version := mvVersion{txIdx, pair.value}
+		i := sort.Search(len(item.versions), func(i int) bool {
+			return item.versions[i].txIdx >= txIdx
+		})
+		if i < len(item.versions) && item.versions[i].txIdx == txIdx {
+			item.versions[i] = version
+		} else {
+			item.versions = append(item.versions, mvVersion{})
+			copy(item.versions[i+1:], item.versions[i:])
+			item.versions[i] = version
+		}
+
+		return true
+	})
+
+	s.written[txIdx] = keys
+}
+
+// write writes the values of the last writers to the store.
+func (s *mvStore) write(store storetypes.KVStore) {
+	s.mtx.RLock()
+	defer s.mtx.RUnlock()
+
+	s.items.Scan(func(item *mvItem) bool {
+		value := item.versions[len(item.versions)-1].value
+		if value == nil {
+			store.Delete(item.key)
+		} else {
+			store.Set(item.key, value)
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,335 @@
+package blockstm
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sync"
+
+	"cosmossdk.io/store/cachekv"
+	"cosmossdk.io/store/cachemulti"
+	"cosmossdk.io/store/tracekv"
+	storetypes "cosmossdk.io/store/types"
+)
+
+// baseStore serializes the accesses to a store of the block state, which is
+// not safe for the concurrent use. The store is not written until all txs of
+// the block are committed.
+type baseStore struct {
+	mtx    sync.Mutex
+	parent storetypes.KVStore
+}
+
+func (s *baseStore) get(key []byte) []byte {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	return s.parent.Get(key)
+}
+
+func (s *baseStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+
+	var it storetypes.Iterator
+	if ascending {
+		it = s.parent.Iterator(start, end)
+	} else {
+		it = s.parent.ReverseIterator(start, end)
+	}
+
+	return &lockedIterator{store: s, it: it}
+}
+
+// txStore is the view of a store for an execution of a tx. It reads the
+// values written by the preceding txs from the multi-version memory, or the
+// values of the base store otherwise, and records them for the validation.
+// The writes are kept in the write sets of the execution.
+type txStore struct {
+	txIdx int
+
+	mv   *mvStore
+	base *baseStore
+
+	// write sets of the execution, see MultiStore.Checkpoint
+	writes []*writeSet
+
+	reads     map[string][]byte
+	iterators []*iteratorRead
+}
+
+var _ storetypes.KVStore = (*txStore)(nil)
+
+func newTxStore(txIdx int, mv *mvStore, base *baseStore) *txStore {
+	return &txStore{
+		txIdx:  txIdx,
+		mv:     mv,
+		base:   base,
+		writes: []*writeSet{newWriteSet()},
+		reads:  make(map[string][]byte),
+	}
+}
+
+// read returns the value written by the preceding txs, or the value of the
+// base store.
+func (s *txStore) read(key []byte) []byte {
+	if value, found := s.mv.get(s.txIdx, key); found {
+		return value
+	}
+
+	return s.base.get(key)
+}
+
+// readIterator returns the iterator over the values written by the preceding
+// txs and the values of the base store.
+func (s *txStore) readIterator(start, end []byte, ascending bool) storetypes.Iterator {
+	return newMergeIterator(
+		s.base.iterator(start, end, ascending),
+		newMemIterator(start, end, s.mv.snapshot(s.txIdx, start, end, ascending)),
+		ascending,
+	)
+}
+
+// ownWrites returns the writes of the execution in the range, in the order of
+// the iteration.
+func (s *txStore) ownWrites(start, end []byte, ascending bool) []kvPair {
+	merged := newWriteSet()
+	for _, writes := range s.writes {
+		writes.Scan(func(pair kvPair) bool {
+			if (start == nil || bytes.Compare(pair.key, start) >= 0) &&
+				(end == nil || bytes.Compare(pair.key, end) < 0) {
+				merged.Set(pair)
+			}
+
+			return true
+		})
+	}
+
+	pairs := make([]kvPair, 0, merged.Len())
+	if ascending {
+		merged.Scan(func(pair kvPair) bool {
+			pairs = append(pairs, pair)
+			return true
+		})
+	} else {
+		merged.Reverse(func(pair kvPair) bool {
+			pairs = append(pairs, pair)
+			return true
+		})
+	}
+
+	return pairs
+}
+
+// validate reports whether the reads of the execution are still the values
+// written by the preceding txs or the values of the base store.
+func (s *txStore) validate() bool {
+	for key, value := range s.reads {
+		current := s.read([]byte(key))
+		if (current == nil) != (value == nil) || !bytes.Equal(current, value) {
+			return false
+		}
+	}
+
+	for _, read := range s.iterators {
+		if !s.validateIterator(read) {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (s *txStore) validateIterator(read *iteratorRead) bool {
+	it := s.readIterator(read.start, read.end, read.ascending)
+	defer it.Close()
+
+	for _, item := range read.items {
+		if !it.Valid() || !bytes.Equal(it.Key(), item.key) || !bytes.Equal(it.Value(), item.value) {
+			return false
+		}
+
+		it.Next()
+	}
+
+	return !read.exhausted || !it.Valid()
+}
+
+// writeSet returns the merged write set of the first n write sets.
+func (s *txStore) writeSet(n int) *writeSet {
+	merged := newWriteSet()
+	for _, writes := range s.writes[:n] {
+		writes.Scan(func(pair kvPair) bool {
+			merged.Set(pair)
+			return true
+		})
+	}
+
+	return merged
+}
+
+func (s *txStore) GetStoreType() storetypes.StoreType {
+	return s.base.parent.GetStoreType()
+}
+
+func (s *txStore) CacheWrap() storetypes.CacheWrap {
+	return cachekv.NewStore(s)
+}
+
+func (s *txStore) CacheWrapWithTrace(w io.Writer, tc storetypes.TraceContext) storetypes.CacheWrap {
+	return cachekv.NewStore(tracekv.NewStore(s, w, tc))
+}
+
+func (s *txStore) Get(key []byte) []byte {
+	storetypes.AssertValidKey(key)
+
+	for i := len(s.writes) - 1; i >= 0; i-- {
+		if pair, found := s.writes[i].Get(kvPair{key: key}); found {
+			return pair.value
+		}
+	}
+
+	if value, found := s.reads[string(key)]; found {
+		return value
+	}
+
+	value := s.read(key)
+	s.reads[string(key)] = value
+
+	return value
+}
+
+func (s *txStore) Has(key []byte) bool {
+	return s.Get(key) != nil
+}
+
+func (s *txStore) Set(key, value []byte) {
+	storetypes.AssertValidKey(key)
+	storetypes.AssertValidValue(value)
+
+	s.writes[len(s.writes)-1].Set(kvPair{bytes.Clone(key), bytes.Clone(value)})
+}
+
+func (s *txStore) Delete(key []byte) {
+	storetypes.AssertValidKey(key)
+
+	s.writes[len(s.writes)-1].Set(kvPair{bytes.Clone(key), nil})
+}
+
+func (s *txStore) Iterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, true)
+}
+
+func (s *txStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	return s.iterator(start, end, false)
+}
+
+func (s *txStore) iterator(start, end []byte, ascending bool) storetypes.Iterator {
+	read := &iteratorRead{
+		start:     bytes.Clone(start),
+		end:       bytes.Clone(end),
+		ascending: ascending,
+	}
+	s.iterators = append(s.iterators, read)
+
+	return newMergeIterator(
+		newReadIterator(s.readIterator(start, end, ascending), read),
+		newMemIterator(start, end, s.ownWrites(start, end, ascending)),
+		ascending,
+	)
+}
+
+// MultiStore is the multi-version view of the stores for an execution of a
+// tx. The writes of the execution are not visible to the other txs until the
+// execution is finished.
+type MultiStore struct {
+	base storetypes.MultiStore
+	db   storetypes.KVStore
+
+	stores map[storetypes.StoreKey]*txStore
+}
+
+var _ storetypes.MultiStore = (*MultiStore)(nil)
+
+// Checkpoint starts a new write set of the execution. The later writes are
+// kept apart from the previous ones, so the commit of the execution can
+// drop them. See Execution.Commit.
+func (ms *MultiStore) Checkpoint() {
+	for _, store := range ms.stores {
+		store.writes = append(store.writes, newWriteSet())
+	}
+}
+
+// numWriteSets returns the number of the write sets of the execution.
+func (ms *MultiStore) numWriteSets() int {
+	for _, store := range ms.stores {
+		return len(store.writes)
+	}
+
+	return 0
+}
+
+// validate reports whether the reads of the execution are still valid.
+func (ms *MultiStore) validate() bool {
+	for _, store := range ms.stores {
+		if !store.validate() {
+			return false
+		}
+	}
+
+	return true
+}
+
+func (ms *MultiStore) GetStoreType() storetypes.StoreType {
+	return storetypes.StoreTypeMulti
+}
+
+func (ms *MultiStore) CacheWrap() storetypes.CacheWrap {
+	return ms.CacheMultiStore()
+}
+
+func (ms *MultiStore) CacheWrapWithTrace(_ io.Writer, _ storetypes.TraceContext) storetypes.CacheWrap {
+	return ms.CacheWrap()
+}
+
+func (ms *MultiStore) CacheMultiStore() storetypes.CacheMultiStore {
+	stores := make(map[storetypes.StoreKey]storetypes.CacheWrapper, len(ms.stores))
+	for key, store := range ms.stores {
+		stores[key] = store
+	}
+
+	return cachemulti.NewFromKVStore(ms.db, stores, nil, nil, nil)
+}
+
+func (ms *MultiStore) CacheMultiStoreWithVersion(_ int64) (storetypes.CacheMultiStore, error) {
+	panic("cannot branch the multi-version view with a version")
+}
+
+func (ms *MultiStore) GetStore(key storetypes.StoreKey) storetypes.Store {
+	return ms.GetKVStore(key)
+}
+
+func (ms *MultiStore) GetKVStore(key storetypes.StoreKey) storetypes.KVStore {
+	store, found := ms.stores[key]
+	if !found {
+		panic(fmt.Sprintf("kv store with key %v has not been registered in stores", key))
+	}
+
+	return store
+}
+
+func (ms *MultiStore) TracingEnabled() bool {
+	return false
+}
+
+func (ms *MultiStore) SetTracer(_ io.Writer) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *MultiStore) SetTracingContext(_ storetypes.TraceContext) storetypes.MultiStore {
+	return ms
+}
+
+func (ms *MultiStore) LatestVersion() int64 {
+	return ms.base.LatestVersion()
+}
@@ -0,0 +1,520 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"runtime/debug"
+	"sort"
+	"strconv"
+	"sync"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/mempool"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/initia/app/blockstm"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+)
+
+// parallelBlock is the block finalized with the parallel executor.
+type parallelBlock struct {
+	req *abci.RequestFinalizeBlock
+
+	// block state context and streaming manager of the EndBlocker
+	ctx              sdk.Context
+	streamingManager storetypes.StreamingManager
+	detached         bool
+
+	txResults []*abci.ExecTxResult
+}
+
+// SetTxExecutor sets the parallel executor of the block txs. The txs are
+// executed sequentially by BaseApp if the executor is nil.
+func (app *InitiaApp) SetTxExecutor(executor *blockstm.Executor) {
+	app.txExecutor = executor
+}
+
+// FinalizeBlock executes the txs of the block with the parallel executor when
+// it is set. BaseApp executes the txs one by one between the BeginBlocker and
+// the EndBlocker without a hook to replace it, so the txs are taken out of the
+// request and executed at the beginning of the EndBlocker on the same block
+// state instead. The tracing of the stores is not supported by the executor,
+// so the txs are executed sequentially when it is enabled.
+func (app *InitiaApp) FinalizeBlock(req *abci.RequestFinalizeBlock) (*abci.ResponseFinalizeBlock, error) {
+	if app.txExecutor == nil || app.CommitMultiStore().TracingEnabled() {
+		return app.BaseApp.FinalizeBlock(req)
+	}
+
+	block := &parallelBlock{req: req}
+	app.parallelBlock = block
+	defer func() {
+		app.parallelBlock = nil
+		if block.detached {
+			app.SetStreamingManager(block.streamingManager)
+		}
+	}()
+
+	stripped := *req
+	stripped.Txs = nil
+
+	res, err := app.BaseApp.FinalizeBlock(&stripped)
+	if err != nil {
+		return res, err
+	}
+
+	res.TxResults = block.txResults
+
+	// BaseApp calls the listeners with the stripped request, so they are
+	// detached in the EndBlocker and called here with the original request
+	for _, streamingListener := range block.streamingManager.ABCIListeners {
+		if err := streamingListener.ListenFinalizeBlock(block.ctx, *req, *res); err != nil {
+			app.Logger().Error("ListenFinalizeBlock listening hook failed", "height", req.Height, "err", err)
+		}
+	}
+
+	return res, nil
+}
+
+// executeTxs executes the txs of the block with the parallel executor on the
+// block state, where BaseApp would execute them before the EndBlocker.
+func (app *InitiaApp) executeTxs(ctx sdk.Context, block *parallelBlock) {
+	tasks := make([]blockstm.Task, len(block.req.Txs))
+	for i, txBytes := range block.req.Txs {
+		tasks[i] = app.newTxTask(ctx, txBytes)
+	}
+
+	executions := app.txExecutor.Run(ctx.MultiStore(), app.storeKeys(), tasks)
+
+	block.txResults = make([]*abci.ExecTxResult, len(executions))
+	for i, exec := range executions {
+		block.txResults[i] = exec.(*txExecution).res
+	}
+
+	block.ctx = ctx
+	block.streamingManager = ctx.StreamingManager()
+
+	detached := block.streamingManager
+	detached.ABCIListeners = nil
+	app.SetStreamingManager(detached)
+	block.detached = true
+}
+
+// storeKeys returns the keys of all mounted stores ordered by the name.
+func (app *InitiaApp) storeKeys() []storetypes.StoreKey {
+	keys := make([]storetypes.StoreKey, 0, len(app.keys)+len(app.tkeys)+len(app.memKeys))
+	for _, key := range app.keys {
+		keys = append(keys, key)
+	}
+	for _, key := range app.tkeys {
+		keys = append(keys, key)
+	}
+	for _, key := range app.memKeys {
+		keys = append(keys, key)
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return keys[i].Name() < keys[j].Name()
+	})
+
+	return keys
+}
+
+// isSpeculativeMsg reports whether the msg changes the state only through
+// the stores, so the tx of the msg can be executed speculatively.
+func isSpeculativeMsg(msg sdk.Msg) bool {
+	switch msg.(type) {
+	case *movetypes.MsgExecute, *movetypes.MsgExecuteJSON,
+		*movetypes.MsgScript, *movetypes.MsgScriptJSON,
+		*banktypes.MsgSend:
+		return true
+	default:
+		return false
+	}
+}
+
+// txTask is a tx of the block executed by the parallel executor. It follows
+// the tx execution of BaseApp, except for the block gas and the mempool which
+// are handled on the commit in the order of the block.
+type txTask struct {
+	app *InitiaApp
+	ctx sdk.Context
+
+	txBytes []byte
+	tx      sdk.Tx
+}
+
+var _ blockstm.Task = (*txTask)(nil)
+
+func (app *InitiaApp) newTxTask(ctx sdk.Context, txBytes []byte) *txTask {
+	// the tx is not executed if it cannot be decoded
+	tx, err := app.TxDecode(txBytes)
+	if err != nil {
+		tx = nil
+	}
+
+	// the signers are cached by the tx on the first call, so the tx does not
+	// call the get signers funcs of the signing context concurrently; the
+	// error is returned again by the ante handler
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		_, _ = sigTx.GetSigners()
+	}
+
+	return &txTask{app: app, ctx: ctx, txBytes: txBytes, tx: tx}
+}
+
+// Speculative implements blockstm.Task.
+func (t *txTask) Speculative() bool {
+	if t.tx == nil {
+		return false
+	}
+
+	for _, msg := range t.tx.GetMsgs() {
+		if !isSpeculativeMsg(msg) {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Execute implements blockstm.Task.
+func (t *txTask) Execute(ms *blockstm.MultiStore) blockstm.Execution {
+	e := &txExecution{task: t, ms: ms}
+	if t.tx == nil {
+		e.res = sdkerrors.ResponseExecTxResultWithEvents(sdkerrors.ErrTxDecode, 0, 0, nil, false)
+		return e
+	}
+
+	ctx := t.ctx.
+		WithMultiStore(ms).
+		WithTxBytes(t.txBytes).
+		WithExecMode(sdk.ExecModeFinalize).
+		WithGasMeter(storetypes.NewInfiniteGasMeter()).
+		WithEventManager(sdk.NewEventManager())
+	ctx = ctx.WithConsensusParams(t.app.GetConsensusParams(ctx))
+
+	e.run(ctx)
+	return e
+}
+
+// txExecution is an execution of a tx. The ante handler writes to the first
+// write set of the view and the msgs write to the second one, which is
+// dropped when the tx fails on the commit.
+type txExecution struct {
+	task *txTask
+	ms   *blockstm.MultiStore
+
+	gasWanted uint64
+	gasMeter  storetypes.GasMeter
+
+	// anteDone is set when the ante handler passes, where BaseApp removes
+	// the tx from the mempool
+	anteDone   bool
+	anteEvents []abci.Event
+
+	// msgsDone is set when the msgs and the post handler pass
+	msgsDone bool
+	result   *sdk.Result
+	err      error
+
+	res *abci.ExecTxResult
+}
+
+var _ blockstm.Execution = (*txExecution)(nil)
+
+func (e *txExecution) run(ctx sdk.Context) {
+	app, tx, ms := e.task.app, e.task.tx, e.ms
+
+	var gasWanted uint64
+	defer func() {
+		if r := recover(); r != nil {
+			e.err, e.result = recoverTx(r, gasWanted, ctx.GasMeter()), nil
+			ctx.Logger().Error("panic recovered in runTx", "err", e.err)
+		}
+
+		e.gasWanted, e.gasMeter = gasWanted, ctx.GasMeter()
+	}()
+
+	msgs := tx.GetMsgs()
+	if err := validateBasicTxMsgs(msgs); err != nil {
+		e.err = err
+		return
+	}
+
+	for _, msg := range msgs {
+		if handler := app.MsgServiceRouter().Handler(msg); handler == nil {
+			e.err = errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
+			return
+		}
+	}
+
+	if anteHandler := app.AnteHandler(); anteHandler != nil {
+		msCache := ms.CacheMultiStore()
+		anteCtx := ctx.WithMultiStore(msCache).WithEventManager(sdk.NewEventManager())
+		newCtx, err := anteHandler(anteCtx, tx, false)
+
+		if !newCtx.IsZero() {
+			ctx = newCtx.WithMultiStore(ms)
+		}
+
+		events := ctx.EventManager().Events()
+		gasWanted = ctx.GasMeter().Limit()
+
+		if err != nil {
+			e.err = err
+			return
+		}
+
+		msCache.Write()
+		e.anteEvents = events.ToABCIEvents()
+	}
+
+	e.anteDone = true
+	ms.Checkpoint()
+
+	msCache := ms.CacheMultiStore()
+	runMsgCtx := ctx.WithMultiStore(msCache)
+
+	var result *sdk.Result
+	msgsV2, err := tx.GetMsgsV2()
+	if err == nil {
+		result, err = runMsgs(app, runMsgCtx, msgs, msgsV2)
+	}
+
+	if app.postHandler != nil {
+		postCtx := runMsgCtx.WithEventManager(sdk.NewEventManager())
+
+		newCtx, errPostHandler := app.postHandler(postCtx, tx, false, err == nil)
+		if errPostHandler != nil {
+			e.err = errors.Join(err, errPostHandler)
+			return
+		}
+
+		if result == nil {
+			result = &sdk.Result{}
+		}
+		result.Events = append(result.Events, newCtx.EventManager().ABCIEvents()...)
+	}
+
+	if err == nil {
+		msCache.Write()
+		e.msgsDone = true
+	}
+
+	e.result, e.err = result, err
+}
+
+// Commit implements blockstm.Execution. It consumes the block gas and removes
+// the tx from the mempool as BaseApp does, and decides the write sets to be
+// committed.
+func (e *txExecution) Commit() int {
+	if e.task.tx == nil {
+		return 0
+	}
+
+	app, blockGasMeter := e.task.app, e.task.ctx.BlockGasMeter()
+
+	resultStr := "successful"
+	var gInfo sdk.GasInfo
+	defer func() {
+		telemetry.IncrCounter(1, "tx", "count")
+		telemetry.IncrCounter(1, "tx", resultStr)
+		telemetry.SetGauge(float32(gInfo.GasUsed), "tx", "gas", "used")
+		telemetry.SetGauge(float32(gInfo.GasWanted), "tx", "gas", "wanted")
+	}()
+
+	if blockGasMeter.IsOutOfGas() {
+		resultStr = "failed"
+		e.res = sdkerrors.ResponseExecTxResultWithEvents(
+			errorsmod.Wrap(sdkerrors.ErrOutOfGas, "no block gas left to run tx"), 0, 0, nil, app.Trace(),
+		)
+
+		return 0
+	}
+
+	gInfo = sdk.GasInfo{GasWanted: e.gasWanted, GasUsed: e.gasMeter.GasConsumed()}
+	err, result, anteEvents, succeeded := e.err, e.result, e.anteEvents, e.msgsDone
+
+	if e.anteDone {
+		if rmErr := app.Mempool().Remove(e.task.tx); rmErr != nil && !errors.Is(rmErr, mempool.ErrTxNotFound) {
+			err, result, succeeded = fmt.Errorf("failed to remove tx from mempool: %w", rmErr), nil, false
+		}
+	}
+
+	consumeBlockGas := func() (err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				err = recoverTx(r, e.gasWanted, e.gasMeter)
+			}
+		}()
+
+		blockGasMeter.ConsumeGas(e.gasMeter.GasConsumedToLimit(), "block gas meter")
+		return nil
+	}
+
+	writes := 1
+	if gasErr := consumeBlockGas(); gasErr != nil {
+		err, result = gasErr, nil
+	} else if succeeded {
+		writes = 2
+
+		if len(anteEvents) > 0 {
+			result.Events = append(anteEvents, result.Events...)
+		}
+	}
+
+	if err != nil {
+		resultStr = "failed"
+		e.res = sdkerrors.ResponseExecTxResultWithEvents(
+			err,
+			gInfo.GasWanted,
+			gInfo.GasUsed,
+			sdk.MarkEventsToIndex(anteEvents, app.indexEvents),
+			app.Trace(),
+		)
+
+		return writes
+	}
+
+	e.res = &abci.ExecTxResult{
+		GasWanted: int64(gInfo.GasWanted),
+		GasUsed:   int64(gInfo.GasUsed),
+		Log:       result.Log,
+		Data:      result.Data,
+		Events:    sdk.MarkEventsToIndex(result.Events, app.indexEvents),
+	}
+
+	return writes
+}
+
+// recoverTx converts the recovered panic of a tx to the error as the recovery
+// middlewares of BaseApp do.
+func recoverTx(r interface{}, gasWanted uint64, gasMeter storetypes.GasMeter) error {
+	if err, ok := r.(storetypes.ErrorOutOfGas); ok {
+		return errorsmod.Wrap(
+			sdkerrors.ErrOutOfGas, fmt.Sprintf(
+				"out of gas in location: %v; gasWanted: %d, gasUsed: %d",
+				err.Descriptor, gasWanted, gasMeter.GasConsumed(),
+			),
+		)
+	}
+
+	return errorsmod.Wrap(
+		sdkerrors.ErrPanic, fmt.Sprintf(
+			"recovered: %v\nstack:\n%v", r, string(debug.Stack()),
+		),
+	)
+}
+
+// validateBasicTxMsgs follows the basic validation of the msgs of BaseApp.
+func validateBasicTxMsgs(msgs []sdk.Msg) error {
+	if len(msgs) == 0 {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "must contain at least one message")
+	}
+
+	for _, msg := range msgs {
+		m, ok := msg.(sdk.HasValidateBasic)
+		if !ok {
+			continue
+		}
+
+		if err := m.ValidateBasic(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// runMsgs follows the msg execution of BaseApp.
+func runMsgs(app *InitiaApp, ctx sdk.Context, msgs []sdk.Msg, msgsV2 []protov2.Message) (*sdk.Result, error) {
+	events := sdk.EmptyEvents()
+	var msgResponses []*codectypes.Any
+
+	for i, msg := range msgs {
+		handler := app.MsgServiceRouter().Handler(msg)
+		if handler == nil {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnknownRequest, "no message handler found for %T", msg)
+		}
+
+		msgResult, err := handler(ctx, msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute message; message index: %d", i)
+		}
+
+		msgEvents, err := createEvents(app.appCodec, msgResult.GetEvents(), msg, msgsV2[i])
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to create message events; message index: %d", i)
+		}
+
+		for j, event := range msgEvents {
+			msgEvents[j] = event.AppendAttributes(sdk.NewAttribute("msg_index", strconv.Itoa(i)))
+		}
+
+		events = events.AppendEvents(msgEvents)
+
+		if len(msgResult.MsgResponses) > 0 {
+			msgResponse := msgResult.MsgResponses[0]
+			if msgResponse == nil {
+				return nil, sdkerrors.ErrLogic.Wrapf("got nil Msg response at index %d for msg %s", i, sdk.MsgTypeURL(msg))
+			}
+			msgResponses = append(msgResponses, msgResponse)
+		}
+	}
+
+	data, err := proto.Marshal(&sdk.TxMsgData{MsgResponses: msgResponses})
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
+	}
+
+	return &sdk.Result{
+		Data:         data,
+		Events:       events.ToABCIEvents(),
+		MsgResponses: msgResponses,
+	}, nil
+}
+
+// getSignersMtx serializes the get signers funcs of the signing context, which
+// share the error variable between the concurrent calls.
+var getSignersMtx sync.Mutex
+
+// createEvents follows the msg events of BaseApp.
+func createEvents(cdc codec.Codec, events sdk.Events, msg sdk.Msg, msgV2 protov2.Message) (sdk.Events, error) {
+	eventMsgName := sdk.MsgTypeURL(msg)
+	msgEvent := sdk.NewEvent(sdk.EventTypeMessage, sdk.NewAttribute(sdk.AttributeKeyAction, eventMsgName))
+
+	getSignersMtx.Lock()
+	signers, err := cdc.GetMsgV2Signers(msgV2)
+	getSignersMtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(signers) > 0 && signers[0] != nil {
+		addrStr, err := cdc.InterfaceRegistry().SigningContext().AddressCodec().BytesToString(signers[0])
+		if err != nil {
+			return nil, err
+		}
+		msgEvent = msgEvent.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeySender, addrStr))
+	}
+
+	if _, found := events.GetAttributes(sdk.AttributeKeyModule); !found {
+		if moduleName := sdk.GetModuleNameFromTypeURL(eventMsgName); moduleName != "" {
+			msgEvent = msgEvent.AppendAttributes(sdk.NewAttribute(sdk.AttributeKeyModule, moduleName))
+		}
+	}
+
+	return sdk.Events{msgEvent}.AppendEvents(events), nil
+}
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"bytes"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/initia/app/blockstm"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+type executorTestAccount struct {
+	key      *secp256k1.PrivKey
+	addr     sdk.AccAddress
+	accNum   uint64
+	sequence uint64
+}
+
+func setupExecutorTestApps(t *testing.T, numAccounts int) (*InitiaApp, *InitiaApp, []*executorTestAccount) {
+	accounts := make([]*executorTestAccount, numAccounts)
+	genAccs := make([]authtypes.GenesisAccount, numAccounts)
+	bals := make([]banktypes.Balance, numAccounts)
+	for i := range accounts {
+		key := secp256k1.GenPrivKeyFromSecret([]byte(fmt.Sprintf("account%d", i)))
+		addr := sdk.AccAddress(key.PubKey().Address())
+
+		accounts[i] = &executorTestAccount{key: key, addr: addr}
+		genAccs[i] = &authtypes.BaseAccount{Address: addr.String()}
+		bals[i] = banktypes.Balance{Address: addr.String(), Coins: sdk.NewCoins(sdk.NewInt64Coin(BondDenom, 1_000_000_000))}
+	}
+
+	// the default genesis of the reward module uses the current time, so the
+	// apps are initialized with the same genesis state
+	sequential, genesisState := setup(nil, true)
+	genesisState = genesisStateWithValSet(sequential, genesisState, nil, genAccs, bals...)
+	initChain(sequential, genesisState)
+
+	parallel, _ := setup(nil, false)
+	initChain(parallel, genesisState)
+	parallel.SetTxExecutor(blockstm.NewExecutor(4))
+
+	ctx := sequential.BaseApp.NewContext(true)
+	for _, account := range accounts {
+		account.accNum = sequential.AccountKeeper.GetAccount(ctx, account.addr).GetAccountNumber()
+	}
+
+	return sequential, parallel, accounts
+}
+
+func (app *InitiaApp) signExecutorTestTx(t *testing.T, account *executorTestAccount, sequence uint64, msgs ...sdk.Msg) []byte {
+	tx, err := simtestutil.GenSignedMockTx(
+		rand.New(rand.NewSource(int64(sequence))),
+		app.TxConfig(),
+		msgs,
+		sdk.NewCoins(sdk.NewInt64Coin(BondDenom, 100_000)),
+		3_000_000,
+		"",
+		[]uint64{account.accNum},
+		[]uint64{sequence},
+		account.key,
+	)
+	require.NoError(t, err)
+
+	txBytes, err := app.TxConfig().TxEncoder()(tx)
+	require.NoError(t, err)
+
+	return txBytes
+}
+
+func (app *InitiaApp) executorTestTx(t *testing.T, account *executorTestAccount, msgs ...sdk.Msg) []byte {
+	txBytes := app.signExecutorTestTx(t, account, account.sequence, msgs...)
+	account.sequence++
+
+	return txBytes
+}
+
+func executorTestCoinTransfer(t *testing.T, from, to *executorTestAccount, amount uint64) sdk.Msg {
+	metadata, err := movetypes.MetadataAddressFromDenom(BondDenom)
+	require.NoError(t, err)
+
+	amountBz, err := vmtypes.SerializeUint64(amount)
+	require.NoError(t, err)
+
+	return &movetypes.MsgExecute{
+		Sender:        from.addr.String(),
+		ModuleAddress: movetypes.StdAddr.String(),
+		ModuleName:    movetypes.MoveModuleNameCoin,
+		FunctionName:  movetypes.FunctionNameCoinTransfer,
+		TypeArgs:      []string{},
+		Args:          [][]byte{append(bytes.Repeat([]byte{0}, 12), to.addr...), metadata[:], amountBz},
+	}
+}
+
+func executorTestBankSend(from, to *executorTestAccount, amount int64) sdk.Msg {
+	return banktypes.NewMsgSend(from.addr, to.addr, sdk.NewCoins(sdk.NewInt64Coin(BondDenom, amount)))
+}
+
+func finalizeExecutorTestBlock(t *testing.T, app *InitiaApp, height int64, txs [][]byte) *abci.ResponseFinalizeBlock {
+	res, err := app.FinalizeBlock(&abci.RequestFinalizeBlock{
+		Height: height,
+		Time:   time.Unix(1_700_000_000+height, 0).UTC(),
+		Txs:    txs,
+	})
+	require.NoError(t, err)
+
+	_, err = app.Commit()
+	require.NoError(t, err)
+
+	return res
+}
+
+func requireSameBlockResults(t *testing.T, expected, actual *abci.ResponseFinalizeBlock) {
+	require.Len(t, actual.TxResults, len(expected.TxResults))
+
+	for i := range expected.TxResults {
+		require.Equal(t, expected.TxResults[i].Code, actual.TxResults[i].Code, i)
+		require.Equal(t, expected.TxResults[i].Codespace, actual.TxResults[i].Codespace, i)
+		require.Equal(t, expected.TxResults[i].Log, actual.TxResults[i].Log, i)
+		require.Equal(t, expected.TxResults[i].Data, actual.TxResults[i].Data, i)
+		require.Equal(t, expected.TxResults[i].GasWanted, actual.TxResults[i].GasWanted, i)
+		require.Equal(t, expected.TxResults[i].GasUsed, actual.TxResults[i].GasUsed, i)
+		require.Equal(t, expected.TxResults[i].Events, actual.TxResults[i].Events, i)
+	}
+
+	require.Equal(t, expected.Events, actual.Events)
+	require.Equal(t, expected.AppHash, actual.AppHash)
+}
+
+func TestParallelExecutor(t *testing.T) {
+	sequential, parallel, accounts := setupExecutorTestApps(t, 8)
+	height := sequential.LastBlockHeight() + 1
+
+	grant, err := authz.NewMsgGrant(accounts[6].addr, accounts[7].addr, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), nil)
+	require.NoError(t, err)
+
+	txs := [][]byte{
+		// txs of the same sender
+		sequential.executorTestTx(t, accounts[0], executorTestBankSend(accounts[0], accounts[1], 100)),
+		sequential.executorTestTx(t, accounts[0], executorTestCoinTransfer(t, accounts[0], accounts[2], 200)),
+
+		// the receiver of the preceding tx spends the received coins
+		sequential.executorTestTx(t, accounts[1], executorTestBankSend(accounts[1], accounts[3], 999_900_050)),
+
+		// disjoint move executions
+		sequential.executorTestTx(t, accounts[2], executorTestCoinTransfer(t, accounts[2], accounts[4], 300)),
+		sequential.executorTestTx(t, accounts[3], executorTestCoinTransfer(t, accounts[3], accounts[5], 400)),
+
+		// failure on the msgs
+		sequential.executorTestTx(t, accounts[4], executorTestBankSend(accounts[4], accounts[5], 10_000_000_000)),
+
+		// failure on the ante handler
+		sequential.signExecutorTestTx(t, accounts[5], 10, executorTestBankSend(accounts[5], accounts[4], 100)),
+
+		// undecodable tx
+		[]byte("invalid tx"),
+
+		// non-speculative tx
+		sequential.executorTestTx(t, accounts[6], grant),
+
+		// the receiver of the failed tx
+		sequential.executorTestTx(t, accounts[5], executorTestCoinTransfer(t, accounts[5], accounts[0], 500)),
+	}
+
+	expected := finalizeExecutorTestBlock(t, sequential, height, txs)
+	actual := finalizeExecutorTestBlock(t, parallel, height, txs)
+	requireSameBlockResults(t, expected, actual)
+
+	codes := make([]uint32, len(expected.TxResults))
+	for i, res := range expected.TxResults {
+		codes[i] = res.Code
+	}
+	require.Equal(t, []uint32{0, 0, 0, 0, 0, 1, 32, 2, 0, 0}, codes)
+
+	// a block of the conflicting txs, except the account which spent all
+	txs = nil
+	for i := 0; i < 40; i++ {
+		from := accounts[2+i%6]
+		to := accounts[2+(i+1)%6]
+		if i%2 == 0 {
+			txs = append(txs, sequential.executorTestTx(t, from, executorTestCoinTransfer(t, from, to, uint64(i))))
+		} else {
+			txs = append(txs, sequential.executorTestTx(t, from, executorTestBankSend(from, to, int64(i))))
+		}
+	}
+
+	height++
+	expected = finalizeExecutorTestBlock(t, sequential, height, txs)
+	actual = finalizeExecutorTestBlock(t, parallel, height, txs)
+	requireSameBlockResults(t, expected, actual)
+
+	for i, res := range expected.TxResults {
+		require.Equal(t, uint32(0), res.Code, i)
+	}
+}
@@ -10,6 +10,7 @@ import (
 
 	staking "github.com/initia-labs/initia/x/mstaking"
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	slashingcustomtypes "github.com/initia-labs/initia/x/slashing/types"
 )
 
 // ExportAppStateAndValidators exports the state of the application for a genesis
@@ -261,5 +262,26 @@ func (app *InitiaApp) prepForZeroHeightGenesis(ctx sdk.Context, jailAllowedAddrs
 		return err
 	}
 
-	return nil
+	// reset start height on oracle signing infos
+	var oracleSignerAddrs []sdk.ConsAddress
+	var oracleSigningInfos []slashingcustomtypes.OracleSigningInfo
+	err = app.SlashingKeeper.OracleSigningInfos.Walk(ctx, nil, func(addr sdk.ConsAddress, info slashingcustomtypes.OracleSigningInfo) (stop bool, err error) {
+		oracleSignerAddrs = append(oracleSignerAddrs, addr)
+		oracleSigningInfos = append(oracleSigningInfos, info)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, info := range oracleSigningInfos {
+		info.StartHeight = 0
+		if err := app.SlashingKeeper.OracleSigningInfos.Set(ctx, oracleSignerAddrs[i], info); err != nil {
+			return err
+		}
+	}
+
+	// the uptime epochs are numbered by the block height, so clear the history
+	// which does not apply to the restarted heights
+	return app.SlashingKeeper.EpochUptimes.Clear(ctx, nil)
 }
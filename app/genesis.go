@@ -53,6 +53,9 @@ func (genState GenesisState) ConfigureBondDenom(cdc codec.JSONCodec, bondDenom s
 	var stakingGenState stakingtypes.GenesisState
 	cdc.MustUnmarshalJSON(genState[stakingtypes.ModuleName], &stakingGenState)
 	stakingGenState.Params.BondDenoms = []string{bondDenom}
+	for i := range stakingGenState.Params.KeyRotationFee {
+		stakingGenState.Params.KeyRotationFee[i].Denom = bondDenom
+	}
 	genState[stakingtypes.ModuleName] = cdc.MustMarshalJSON(&stakingGenState)
 
 	var distrGenState customdistrtypes.GenesisState
@@ -79,7 +79,21 @@ func SetupWithGenesisAccounts(
 	balances ...banktypes.Balance,
 ) *InitiaApp {
 	app, genesisState := setup(nil, true)
+	genesisState = genesisStateWithValSet(app, genesisState, valSet, genAccs, balances...)
+	initChain(app, genesisState)
 
+	return app
+}
+
+// genesisStateWithValSet returns the genesis state with the validator set,
+// the genesis accounts and the balances.
+func genesisStateWithValSet(
+	app *InitiaApp,
+	genesisState GenesisState,
+	valSet *tmtypes.ValidatorSet,
+	genAccs []authtypes.GenesisAccount,
+	balances ...banktypes.Balance,
+) GenesisState {
 	if len(genAccs) == 0 {
 		privAcc := secp256k1.GenPrivKey()
 		genAccs = []authtypes.GenesisAccount{
@@ -154,6 +168,12 @@ func SetupWithGenesisAccounts(
 	bankGenesis := banktypes.NewGenesisState(banktypes.DefaultGenesisState().Params, balances, sdk.NewCoins(), []banktypes.Metadata{}, []banktypes.SendEnabled{})
 	genesisState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(bankGenesis)
 
+	return genesisState
+}
+
+// initChain initializes the chain with the genesis state and commits the
+// first block.
+func initChain(app *InitiaApp, genesisState GenesisState) {
 	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 	if err != nil {
 		panic(err)
@@ -179,6 +199,4 @@ func SetupWithGenesisAccounts(
 	if err != nil {
 		panic(err)
 	}
-
-	return app
 }
@@ -131,6 +131,46 @@ func GenSequenceOfTxs(b *testing.B, info *AppInfo, msgGen func(*AppInfo, int) ([
 	return txs
 }
 
+// GenDisjointTxs generates txs which are signed by the generated accounts in
+// a round-robin manner, so the txs of a block do not share their senders.
+func GenDisjointTxs(b *testing.B, info *AppInfo, msgGen func(*AppInfo, int) ([]sdk.Msg, error), numToGenerate int) []sdk.Tx {
+	fees := sdk.NewCoins(sdk.NewInt64Coin(info.Denom, 1_000))
+	txs := make([]sdk.Tx, numToGenerate)
+
+	numSenders := len(info.AccKeys) / 2
+	accNums := make([]uint64, numSenders)
+	sequences := make([]uint64, numSenders)
+
+	ctx := info.App.BaseApp.NewContext(false)
+	for i := 0; i < numSenders; i++ {
+		acc := info.App.AccountKeeper.GetAccount(ctx, sdk.AccAddress(info.AccKeys[i].PubKey().Address()))
+		require.NotNil(b, acc)
+
+		accNums[i] = acc.GetAccountNumber()
+	}
+
+	for i := 0; i < numToGenerate; i++ {
+		msgs, err := msgGen(info, i)
+		require.NoError(b, err)
+
+		sender := i % numSenders
+		txs[i], err = simtestutil.GenSignedMockTx(
+			rand.New(rand.NewSource(time.Now().UnixNano())),
+			info.TxConfig,
+			msgs,
+			fees,
+			3_000_000,
+			"",
+			[]uint64{accNums[sender]},
+			[]uint64{sequences[sender]},
+			&info.AccKeys[sender],
+		)
+		require.NoError(b, err)
+		sequences[sender] += 1
+	}
+	return txs
+}
+
 var transferAmount, _ = vmtypes.SerializeUint64(100)
 
 func coinTransferMsg(info *AppInfo, idx int) ([]sdk.Msg, error) {
@@ -151,6 +191,28 @@ func coinTransferMsg(info *AppInfo, idx int) ([]sdk.Msg, error) {
 	return []sdk.Msg{msgTransfer}, nil
 }
 
+// disjointTransferMsg transfers coins from the first half of the accounts
+// to the second half, so the txs of the senders touch disjoint resources.
+func disjointTransferMsg(info *AppInfo, idx int) ([]sdk.Msg, error) {
+	numSenders := len(info.AccKeys) / 2
+	sender := sdk.AccAddress(info.AccKeys[idx%numSenders].PubKey().Address())
+	rcpt := info.AccKeys[numSenders+idx%numSenders].PubKey().Address()
+	mt, err := movetypes.MetadataAddressFromDenom("uinit")
+	if err != nil {
+		return nil, err
+	}
+
+	msgTransfer := &movetypes.MsgExecute{
+		Sender:        sender.String(),
+		ModuleAddress: movetypes.StdAddr.String(),
+		ModuleName:    movetypes.MoveModuleNameCoin,
+		FunctionName:  movetypes.FunctionNameCoinTransfer,
+		TypeArgs:      []string{},
+		Args:          [][]byte{append(bytes.Repeat([]byte{0}, 12), rcpt...), mt[:], transferAmount},
+	}
+	return []sdk.Msg{msgTransfer}, nil
+}
+
 func buildTxFromMsg(builder func(*AppInfo, int) ([]sdk.Msg, error), numTxs int) func(b *testing.B, info *AppInfo) []sdk.Tx {
 	return func(b *testing.B, info *AppInfo) []sdk.Tx {
 		return GenSequenceOfTxs(b, info, builder, b.N*numTxs)
@@ -166,3 +228,9 @@ func buildLevelDB(b *testing.B) dbm.DB {
 	require.NoError(b, err)
 	return levelDB
 }
+
+func buildDisjointTxFromMsg(builder func(*AppInfo, int) ([]sdk.Msg, error), numTxs int) func(b *testing.B, info *AppInfo) []sdk.Tx {
+	return func(b *testing.B, info *AppInfo) []sdk.Tx {
+		return GenDisjointTxs(b, info, builder, b.N*numTxs)
+	}
+}
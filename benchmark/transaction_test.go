@@ -2,9 +2,14 @@ package benchmarks
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/initia-labs/initia/app/blockstm"
+
 	dbm "github.com/cosmos/cosmos-db"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
@@ -15,6 +20,7 @@ func BenchmarkTPS(b *testing.B) {
 		txBuilder   func(*testing.B, *AppInfo) []sdk.Tx
 		numTxs      int
 		numAccounts int
+		parallel    bool
 	}{
 		"native_uinit transfer - memdb - 100 accounts - 100 txs": {
 			db:          buildMemDB,
@@ -28,27 +34,74 @@ func BenchmarkTPS(b *testing.B) {
 			txBuilder:   buildTxFromMsg(coinTransferMsg, 100),
 			numAccounts: 100,
 		},
+		"native_uinit transfer - memdb - 100 accounts - 100 txs - parallel": {
+			db:          buildMemDB,
+			numTxs:      100,
+			txBuilder:   buildTxFromMsg(coinTransferMsg, 100),
+			numAccounts: 100,
+			parallel:    true,
+		},
+		"disjoint move execute - memdb - 200 accounts - 100 txs": {
+			db:          buildMemDB,
+			numTxs:      100,
+			txBuilder:   buildDisjointTxFromMsg(disjointTransferMsg, 100),
+			numAccounts: 200,
+		},
+		"disjoint move execute - memdb - 200 accounts - 100 txs - parallel": {
+			db:          buildMemDB,
+			numTxs:      100,
+			txBuilder:   buildDisjointTxFromMsg(disjointTransferMsg, 100),
+			numAccounts: 200,
+			parallel:    true,
+		},
 	}
 
 	for name, tc := range cases {
 		b.Run(name, func(b *testing.B) {
 			db := tc.db(b)
 			appInfo := InitializeBenchApp(b, &db, tc.numAccounts)
+			if tc.parallel {
+				appInfo.App.SetTxExecutor(blockstm.NewExecutor(0))
+			}
+
 			txs := tc.txBuilder(b, &appInfo)
 
 			// number of Tx per block for the benchmarks
 			numTxs := tc.numTxs
 			txEncoder := appInfo.TxConfig.TxEncoder()
-			b.ResetTimer()
 
-			for i := 0; i < b.N/numTxs; i++ {
+			blocks := make([][][]byte, b.N/numTxs)
+			for i := range blocks {
+				blocks[i] = make([][]byte, numTxs)
 				for j := 0; j < numTxs; j++ {
-					idx := i*numTxs + j
-
-					_, _, err := appInfo.App.SimDeliver(txEncoder, txs[idx])
+					txBytes, err := txEncoder(txs[i*numTxs+j])
 					require.NoError(b, err)
+
+					blocks[i][j] = txBytes
 				}
 			}
+
+			b.ResetTimer()
+
+			// the blocks are finalized, so the txs are executed by the
+			// executor of the block
+			for _, block := range blocks {
+				res, err := appInfo.App.FinalizeBlock(&abci.RequestFinalizeBlock{
+					Height: appInfo.App.LastBlockHeight() + 1,
+					Time:   time.Now(),
+					Txs:    block,
+				})
+				require.NoError(b, err)
+
+				for _, txRes := range res.TxResults {
+					require.Equal(b, abci.CodeTypeOK, txRes.Code, txRes.Log)
+				}
+
+				_, err = appInfo.App.Commit()
+				require.NoError(b, err)
+			}
+
+			b.ReportMetric(float64(len(blocks)*numTxs)/b.Elapsed().Seconds(), "tx/s")
 		})
 	}
 }
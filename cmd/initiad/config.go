@@ -11,6 +11,7 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
 	initiaapp "github.com/initia-labs/initia/app"
+	"github.com/initia-labs/initia/app/blockstm"
 	initiaapporacle "github.com/initia-labs/initia/app/oracle"
 	moveconfig "github.com/initia-labs/initia/x/move/config"
 )
@@ -20,6 +21,7 @@ type initiaappConfig struct {
 	serverconfig.Config
 	MoveConfig moveconfig.MoveConfig  `mapstructure:"move"`
 	Oracle     oracleconfig.AppConfig `mapstructure:"oracle"`
+	BlockSTM   blockstm.Config        `mapstructure:"blockstm"`
 }
 
 // initAppConfig helps to override default appConfig template and configs.
@@ -47,11 +49,13 @@ func initAppConfig() (string, interface{}) {
 		Config:     *srvCfg,
 		MoveConfig: moveconfig.DefaultMoveConfig(),
 		Oracle:     initiaapporacle.DefaultConfig(),
+		BlockSTM:   blockstm.DefaultConfig(),
 	}
 
 	initiaappTemplate := serverconfig.DefaultConfigTemplate +
 		moveconfig.DefaultConfigTemplate +
-		oracleconfig.DefaultConfigTemplate
+		oracleconfig.DefaultConfigTemplate +
+		blockstm.DefaultConfigTemplate
 
 	return initiaappTemplate, initiaappConfig
 }
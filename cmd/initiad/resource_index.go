@@ -0,0 +1,88 @@
+package main
+
+import (
+	"fmt"
+	"path/filepath"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
+
+	initiaapp "github.com/initia-labs/initia/app"
+	initiaapporacle "github.com/initia-labs/initia/app/oracle"
+	moveconfig "github.com/initia-labs/initia/x/move/config"
+	movekeeper "github.com/initia-labs/initia/x/move/keeper"
+)
+
+const flagAppDBBackend = "app-db-backend"
+
+// rebuildResourceIndexCmd rebuilds the node local move resource index from
+// the latest committed state. The node must be stopped while rebuilding.
+func rebuildResourceIndexCmd(defaultNodeHome string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rebuild-resource-index",
+		Short: "Rebuild the move resource index from the latest committed state",
+		Long: `Rebuild the node local move resource index from the latest committed state.
+
+The index serves the ResourceHolders and ResourcesByType queries when
+"move.resource-index-enabled" is set. Run this command once with the node
+stopped after enabling the index on a node with existing state.`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			vp := viper.New()
+			if err := vp.BindPFlags(cmd.Flags()); err != nil {
+				return err
+			}
+
+			home := vp.GetString(flags.FlagHome)
+			if home == "" {
+				home = defaultNodeHome
+			}
+
+			dataDir := filepath.Join(home, "data")
+			backend := server.GetAppDBBackend(vp)
+			db, err := dbm.NewDB("application", backend, dataDir)
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			latestHeight := rootmulti.GetLatestVersion(db)
+			if latestHeight <= 0 {
+				return fmt.Errorf("the database has no valid heights to index, the latest height: %v", latestHeight)
+			}
+
+			// the resource index is opened manually, so do not enable it on the app
+			logger := log.NewLogger(cmd.OutOrStdout())
+			app := initiaapp.NewInitiaApp(logger, db, nil, true, moveconfig.DefaultMoveConfig(), initiaapporacle.DefaultConfig(), vp)
+
+			resourceIndex, err := movekeeper.OpenResourceIndex(dataDir, backend)
+			if err != nil {
+				return err
+			}
+			defer resourceIndex.Close()
+
+			cmd.Printf("rebuilding the resource index at height %v\n", latestHeight)
+
+			ctx := app.NewUncachedContext(false, cmtproto.Header{Height: latestHeight})
+			if err := resourceIndex.Rebuild(ctx, app.MoveKeeper); err != nil {
+				return err
+			}
+
+			cmd.Println("successfully rebuilt the resource index")
+			return nil
+		},
+	}
+
+	cmd.Flags().String(flags.FlagHome, defaultNodeHome, "The application home directory")
+	cmd.Flags().String(flagAppDBBackend, "", "The type of database for application and resource index databases")
+
+	return cmd
+}
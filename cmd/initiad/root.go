@@ -150,6 +150,7 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig params.EncodingConfig, b
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(a.newApp, initiaapp.DefaultNodeHome),
 		snapshot.Cmd(a.newApp),
+		rebuildResourceIndexCmd(initiaapp.DefaultNodeHome),
 	)
 
 	server.AddCommands(
@@ -1,6 +1,7 @@
 package movecmd
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"path"
@@ -114,6 +115,7 @@ func MoveCommand(ac address.Codec) *cobra.Command {
 		moveProveCmd(),
 		moveVerifyCmd(),
 		moveDocgenCmd(),
+		moveProfileCmd(ac),
 	)
 
 	coverageCmd := &cobra.Command{
@@ -366,7 +368,7 @@ func moveDeployCmd(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "deploy [flags]",
 		Short: "deploy a whole move package",
-		Long:  "deploy a whole move package. This command occurs a tx to publish module bundle.",
+		Long:  "deploy a whole move package. This command occurs a tx to publish module bundle. With --dry-run, the upgrade compatibility of the package is checked before the tx simulation.",
 		Args:  cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, args []string) error {
 
@@ -438,6 +440,13 @@ func moveDeployCmd(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			// check the upgrade compatibility before the tx simulation
+			if clientCtx.Simulate {
+				if err := checkUpgradeCompatibility(clientCtx, &msg); err != nil {
+					return err
+				}
+			}
+
 			err = tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
 			if err != nil {
 				return err
@@ -464,6 +473,33 @@ func moveDeployCmd(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+// checkUpgradeCompatibility prints the upgrade compatibility of the module
+// bundle, and returns an error if the module bundle can not be published.
+func checkUpgradeCompatibility(clientCtx client.Context, msg *movetypes.MsgPublish) error {
+	queryClient := movetypes.NewQueryClient(clientCtx)
+	res, err := queryClient.CheckUpgradeCompatibility(
+		context.Background(),
+		&movetypes.QueryCheckUpgradeCompatibilityRequest{
+			Sender:        msg.Sender,
+			CodeBytes:     msg.CodeBytes,
+			UpgradePolicy: msg.UpgradePolicy,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := clientCtx.PrintProto(res); err != nil {
+		return err
+	}
+
+	if !res.Allowed {
+		return fmt.Errorf("the package can not be published: %s", res.Error)
+	}
+
+	return nil
+}
+
 func moveProveCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "prove [flags]",
@@ -0,0 +1,160 @@
+package movecmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"cosmossdk.io/core/address"
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	movecli "github.com/initia-labs/initia/x/move/client/cli"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+)
+
+const (
+	// profile options
+	flagOutputFile     = "output-file"
+	flagWithStateTrace = "with-state-trace"
+)
+
+func moveProfileCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "profile [sender] [module owner] [module name] [function name] [flags]",
+		Short: "profile the gas usage of an entry function",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Execute an entry function on the node without committing the state changes and
+render the gas usage of each module as a folded stack file, which can be passed
+to flamegraph tools (e.g. flamegraph.pl or speedscope).
+
+The move vm reports gas usage per module only, so every module is rendered as
+a single root frame and the gas which is not attributed to any module (e.g.
+intrinsic and storage gas) as the "[unattributed]" frame. The frames are not
+call stacks, and no per-function or instruction-level profile is available.
+
+The node must enable the profile query with "move.contract-profile-enabled".
+
+When --output-file is given, the folded stacks are written to the file and the
+full profile response (including the state accesses with --with-state-trace)
+is printed.
+
+Example:
+$ %s move profile init1... 0x1 BasicCoin mint \
+    --type-args '0x1::BasicCoin::Initia' \
+    --args 'u64:100' \
+    --output-file mint.folded
+`, version.AppName),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if _, err := movetypes.AccAddressFromString(ac, args[1]); err != nil {
+				return err
+			}
+
+			var typeArgs []string
+			flagTypeArgs, err := cmd.Flags().GetString(movecli.FlagTypeArgs)
+			if err != nil {
+				return err
+			}
+			if flagTypeArgs != "" {
+				typeArgs = strings.Split(flagTypeArgs, " ")
+			}
+
+			flagArgs, err := cmd.Flags().GetString(movecli.FlagArgs)
+			if err != nil {
+				return err
+			}
+
+			bcsArgs, err := movecli.BcsSerializeArgs(flagArgs, ac)
+			if err != nil {
+				return err
+			}
+
+			withStateTrace, err := cmd.Flags().GetBool(flagWithStateTrace)
+			if err != nil {
+				return err
+			}
+
+			outputFile, err := cmd.Flags().GetString(flagOutputFile)
+			if err != nil {
+				return err
+			}
+
+			queryClient := movetypes.NewQueryClient(clientCtx)
+			res, err := queryClient.Profile(
+				context.Background(),
+				&movetypes.QueryProfileRequest{
+					Sender:           args[0],
+					Address:          args[1],
+					ModuleName:       args[2],
+					FunctionName:     args[3],
+					TypeArgs:         typeArgs,
+					Args:             bcsArgs,
+					TraceStateAccess: withStateTrace,
+				},
+			)
+			if err != nil {
+				return err
+			}
+
+			if outputFile == "" {
+				return writeFoldedStacks(cmd.OutOrStdout(), res)
+			}
+
+			f, err := os.Create(outputFile)
+			if err != nil {
+				return err
+			}
+			defer f.Close()
+
+			if err := writeFoldedStacks(f, res); err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().AddFlagSet(movecli.FlagSetTypeArgs())
+	cmd.Flags().AddFlagSet(movecli.FlagSetArgs())
+	cmd.Flags().String(flagOutputFile, "", "Write the folded stacks to the file instead of stdout")
+	cmd.Flags().Bool(flagWithStateTrace, false, "Request the trace of the state accesses made by the vm")
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// writeFoldedStacks writes the gas profile in the folded stack format,
+// `frame gas_used` per line. The vm reports the gas usage per module, so each
+// module is written as a single root frame, and the rest of the gas (e.g.
+// intrinsic and storage gas) as the `[unattributed]` frame.
+func writeFoldedStacks(w io.Writer, res *movetypes.QueryProfileResponse) error {
+	attributed := uint64(0)
+	for _, entry := range res.GasProfile {
+		attributed += entry.GasUsed
+
+		frame := fmt.Sprintf("%s::%s", entry.ModuleAddress, entry.ModuleName)
+		if _, err := fmt.Fprintf(w, "%s %d\n", frame, entry.GasUsed); err != nil {
+			return err
+		}
+	}
+
+	if res.GasUsed > attributed {
+		if _, err := fmt.Fprintf(w, "[unattributed] %d\n", res.GasUsed-attributed); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -35,6 +35,7 @@ const (
 	FlagType              = "type"
 	FlagItems             = "items"
 	FlagModules           = "modules"
+	FlagMaxUses           = "max-uses"
 	generic               = "generic"
 	delegate              = "delegate"
 	redelegate            = "redelegate"
@@ -76,6 +77,7 @@ Examples:
  $ %s tx %s grant init1vrit.. generic --msg-type=/cosmos.gov.v1beta1.MsgVote --from=init1vrit..
  $ %s tx %s grant init1vrit.. move --type publish --modules "secp256*1,ed*"  --from=init1vrit..
  $ %s tx %s grant init1vrit.. move --type execute --items ./authzItems.json --from=init1vrit..
+ $ %s tx %s grant init1vrit.. move --type execute --items ./authzItems.json --spend-limit=1000uinit --max-uses=10 --from=init1vrit..
 
 Where authzItems.json contains:
 [
@@ -87,10 +89,15 @@ Where authzItems.json contains:
   	{
         "module_address": "init1vr...",
         "module_name": "bar",
-        "function_names": ["baz"]
+        "function_names": ["baz"],
+        "type_args": ["0x1::bar::Baz"],
+        "arg_constraints": [
+            {"index": 0, "allowed_addresses": ["init1vr..."]},
+            {"index": 1, "max_amount": "1000"}
+        ]
     }
 ]
-    `, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
+    `, version.AppName, authz.ModuleName, bank.SendAuthorization{}.MsgTypeURL(), version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName, version.AppName, authz.ModuleName),
 		),
 		Args: cobra.ExactArgs(2),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -225,7 +232,29 @@ Where authzItems.json contains:
 					if err = json.Unmarshal(itemsBytes, &authzItems); err != nil {
 						return fmt.Errorf("invalid authorization item, %s", items)
 					}
-					authorization, err = movetypes.NewExecuteAuthorization(ac, authzItems)
+					var execAuthorization *movetypes.ExecuteAuthorization
+					execAuthorization, err = movetypes.NewExecuteAuthorization(ac, authzItems)
+					if err != nil {
+						return err
+					}
+
+					limit, err := cmd.Flags().GetString(FlagSpendLimit)
+					if err != nil {
+						return err
+					}
+					if limit != "" {
+						execAuthorization.SpendLimit, err = sdk.ParseCoinsNormalized(limit)
+						if err != nil {
+							return err
+						}
+					}
+
+					execAuthorization.RemainingUses, err = cmd.Flags().GetUint64(FlagMaxUses)
+					if err != nil {
+						return err
+					}
+
+					authorization = execAuthorization
 				default:
 					return fmt.Errorf("invalid type, %s", typ)
 				}
@@ -260,6 +289,7 @@ Where authzItems.json contains:
 	cmd.Flags().String(FlagType, "", "The type of move authorization, {publish|excute}")
 	cmd.Flags().String(FlagItems, "", "The items of move execute authorization, a json file path.")
 	cmd.Flags().String(FlagModules, "", "The items of move publish authorization, a comma-separated string of module names.")
+	cmd.Flags().Uint64(FlagMaxUses, 0, "The max number of uses of move execute authorization, 0 for unlimited.")
 	return cmd
 }
 
@@ -47,7 +47,7 @@ func (AppModuleBasic) Name() string {
 // module-specific gRPC queries.
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	authz.RegisterQueryServer(cfg.QueryServer(), am.keeper)
-	authz.RegisterMsgServer(cfg.MsgServer(), am.keeper)
+	authz.RegisterMsgServer(cfg.MsgServer(), msgServer{am.keeper, am.registry.SigningContext().AddressCodec()})
 }
 
 // RegisterLegacyAminoCodec registers the authz module's types for the given codec.
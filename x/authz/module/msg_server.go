@@ -0,0 +1,33 @@
+package authz
+
+import (
+	"context"
+
+	"cosmossdk.io/core/address"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	movetypes "github.com/initia-labs/initia/x/move/types"
+)
+
+var _ authz.MsgServer = msgServer{}
+
+// msgServer wraps the authz msg server to pass the address codec and the
+// grantee of the exec message to the dispatched messages, which are used to
+// check the move execute authorization.
+type msgServer struct {
+	authz.MsgServer
+
+	ac address.Codec
+}
+
+// Exec implements authz.MsgServer
+func (ms msgServer) Exec(ctx context.Context, msg *authz.MsgExec) (*authz.MsgExecResponse, error) {
+	// invalid grantee is rejected by the wrapped msg server
+	if grantee, err := ms.ac.StringToBytes(msg.Grantee); err == nil {
+		ctx = movetypes.WithExecuteGrant(sdk.UnwrapSDKContext(ctx), ms.ac, grantee)
+	}
+
+	return ms.MsgServer.Exec(ctx, msg)
+}
@@ -281,6 +281,9 @@ func _createTestInput(
 		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:             {authtypes.Burner},
 		movetypes.MoveStakingModuleName: nil,
+		movetypes.MoveRevenueModuleName: nil,
+		movetypes.MoveCronModuleName:    nil,
+		movetypes.MovePublishModuleName: nil,
 
 		// for testing
 		authtypes.Minter: {authtypes.Minter, authtypes.Burner},
@@ -319,6 +322,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
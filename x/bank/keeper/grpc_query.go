@@ -224,15 +224,44 @@ func (k BaseKeeper) DenomMetadataByQueryString(c context.Context, req *types.Que
 	}, nil
 }
 
+// DenomOwners implements the Query/DenomOwners gRPC method, which is backed by
+// the denom owner index of the primary fungible stores.
 func (k BaseKeeper) DenomOwners(
 	goCtx context.Context,
 	req *types.QueryDenomOwnersRequest,
 ) (*types.QueryDenomOwnersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "not supported")
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denomOwners, pageRes, err := k.mk.GetPaginatedDenomOwners(goCtx, req.Pagination, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomOwnersResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
 
+// DenomOwnersByQuery implements the Query/DenomOwnersByQuery gRPC method
 func (k BaseKeeper) DenomOwnersByQuery(ctx context.Context, req *types.QueryDenomOwnersByQueryRequest) (*types.QueryDenomOwnersByQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "not supported")
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	denomOwners, pageRes, err := k.mk.GetPaginatedDenomOwners(ctx, req.Pagination, req.Denom)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryDenomOwnersByQueryResponse{DenomOwners: denomOwners, Pagination: pageRes}, nil
 }
 
 func (k BaseKeeper) SendEnabled(goCtx context.Context, req *types.QuerySendEnabledRequest) (*types.QuerySendEnabledResponse, error) {
@@ -152,3 +152,54 @@ func TestQueryDenomMetadata(t *testing.T) {
 		},
 	}, metadata.DenomUnits)
 }
+
+func TestQueryDenomOwners(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	_, _, addr1 := testdata.KeyTestPubAddr()
+	_, _, addr2 := testdata.KeyTestPubAddr()
+
+	_, err := input.BankKeeper.DenomOwners(ctx, &types.QueryDenomOwnersRequest{})
+	require.Error(t, err)
+
+	testDenom := testDenoms[0]
+	input.Faucet.Fund(ctx, addr1, sdk.NewCoin(testDenom, math.NewInt(50)))
+	input.Faucet.Fund(ctx, addr2, sdk.NewCoin(testDenom, math.NewInt(30)))
+
+	denomOwners := func() map[string]math.Int {
+		res, err := input.BankKeeper.DenomOwners(ctx, &types.QueryDenomOwnersRequest{Denom: testDenom})
+		require.NoError(t, err)
+
+		byQueryRes, err := input.BankKeeper.DenomOwnersByQuery(ctx, &types.QueryDenomOwnersByQueryRequest{Denom: testDenom})
+		require.NoError(t, err)
+		require.Equal(t, res.DenomOwners, byQueryRes.DenomOwners)
+
+		owners := make(map[string]math.Int)
+		for _, owner := range res.DenomOwners {
+			require.Equal(t, testDenom, owner.Balance.Denom)
+			owners[owner.Address] = owner.Balance.Amount
+		}
+
+		return owners
+	}
+
+	owners := denomOwners()
+	require.Equal(t, math.NewInt(50), owners[addr1.String()])
+	require.Equal(t, math.NewInt(30), owners[addr2.String()])
+
+	// the owner is removed when the balance becomes zero
+	err = input.BankKeeper.SendCoins(ctx, addr1, addr2, sdk.NewCoins(sdk.NewCoin(testDenom, math.NewInt(50))))
+	require.NoError(t, err)
+
+	owners = denomOwners()
+	require.NotContains(t, owners, addr1.String())
+	require.Equal(t, math.NewInt(80), owners[addr2.String()])
+
+	// pagination
+	res, err := input.BankKeeper.DenomOwners(ctx, &types.QueryDenomOwnersRequest{
+		Denom:      testDenom,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.DenomOwners, 1)
+	require.Equal(t, uint64(len(owners)), res.Pagination.Total)
+}
@@ -17,6 +17,7 @@ type MoveBankKeeper interface {
 	GetPaginatedSupply(ctx context.Context, pageReq *query.PageRequest) (sdk.Coins, *query.PageResponse, error)
 	IterateAccountBalances(ctx context.Context, addr sdk.AccAddress, cb func(sdk.Coin) (bool, error)) error
 	IterateSupply(ctx context.Context, cb func(supply sdk.Coin) (bool, error)) error
+	GetPaginatedDenomOwners(ctx context.Context, pageReq *query.PageRequest, denom string) ([]*cosmosbanktypes.DenomOwner, *query.PageResponse, error)
 
 	// operations
 	SendCoins(ctx context.Context, fromAddr sdk.AccAddress, toAddr sdk.AccAddress, amt sdk.Coins) error
@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/collections"
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/distribution/types"
 
@@ -198,3 +199,15 @@ func (h Hooks) BeforeDelegationRemoved(_ context.Context, _ sdk.AccAddress, _ sd
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate adds the rotation fee, already transferred to the
+// distribution module account, to the community pool
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, _ sdk.ValAddress, _, _ cryptotypes.PubKey, rotationFee sdk.Coins) error {
+	feePool, err := h.k.FeePool.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	feePool.CommunityPool = feePool.CommunityPool.Add(sdk.NewDecCoinsFromCoins(rotationFee...)...)
+	return h.k.FeePool.Set(ctx, feePool)
+}
@@ -2,11 +2,17 @@ package keeper
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/x/evidence/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	customtypes "github.com/initia-labs/initia/x/evidence/types"
+	mstakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 )
 
 // HandleEquivocationEvidence implements an equivocation evidence handler. Assuming the
@@ -20,23 +26,149 @@ import (
 // - the validator is unbonded or does not exist
 // - the signing info does not exist (will panic)
 // - is already tombstoned
-//
-// TODO: Some of the invalid constraints listed above may need to be reconsidered
-// in the case of a lunatic attack.
 func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.Equivocation) error {
-	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	logger := k.Logger(ctx)
-	consAddr := evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
 
-	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	validator, consAddr, err := k.getInfractionValidator(ctx, evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec()))
+	if err != nil || validator == nil {
+		return err
+	}
+
+	infractionHeight := evidence.GetHeight()
+	infractionTime := evidence.GetTime()
+	if k.isEvidenceTooOld(ctx, "equivocation", consAddr, infractionHeight, infractionTime) {
+		return nil
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	}
+
+	// ignore if the validator is already tombstoned
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		logger.Info(
+			"ignored equivocation; validator already tombstoned",
+			"validator", consAddr,
+			"infraction_height", infractionHeight,
+			"infraction_time", infractionTime,
+		)
+		return nil
+	}
+
+	logger.Info(
+		"confirmed equivocation",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_time", infractionTime,
+	)
+
+	// We need to retrieve the stake distribution which signed the block, so we
+	// subtract ValidatorUpdateDelay from the evidence height.
+	// Note, that this *can* result in a negative "distributionHeight", up to
+	// -ValidatorUpdateDelay, i.e. at the end of the
+	// pre-genesis block (none) = at the beginning of the genesis block.
+	// That's fine since this is just used to filter unbonding delegations & redelegations.
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	if err := k.slashAndTombstone(ctx, validator, consAddr, evidence.GetValidatorPower(), distributionHeight); err != nil {
+		return err
+	}
+
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// handleLightClientAttackEvidence implements a light client attack evidence
+// handler. CometBFT attributes a light client attack to the validators which
+// signed the conflicting header; each of them is slashed, jailed and tombstoned
+// in the same way as an equivocation.
+//
+// The handling is amnesia aware. Lunatic and equivocation attacks can only be
+// committed by validators of the validator set at the common height, so a
+// validator which is not part of the stored historical validator set at that
+// height can not be held accountable (e.g. an amnesia attack, where the faulty
+// validators can not be determined) and is ignored. When the historical info
+// has already been pruned, the attribution of CometBFT is trusted.
+//
+// The evidence is considered invalid if:
+// - the evidence is too old
+// - the validator is unbonded or does not exist
+// - the validator was not in the validator set at the common height
+// - the signing info does not exist (will panic)
+// - is already tombstoned
+func (k Keeper) handleLightClientAttackEvidence(ctx context.Context, evidence *customtypes.LightClientAttack) error {
+	logger := k.Logger(ctx)
+	evidenceConsAddr := evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
+
+	validator, consAddr, err := k.getInfractionValidator(ctx, evidenceConsAddr)
+	if err != nil || validator == nil {
+		return err
+	}
+
+	commonHeight := evidence.GetHeight()
+	commonTime := evidence.GetTime()
+	if k.isEvidenceTooOld(ctx, "light client attack", consAddr, commonHeight, commonTime) {
+		return nil
+	}
+
+	// the validator set at the common height is stored at the previous height
+	// due to the validator update delay.
+	distributionHeight := commonHeight - sdk.ValidatorUpdateDelay
+
+	attributable, err := k.isInValidatorSet(ctx, distributionHeight, evidenceConsAddr)
 	if err != nil {
 		return err
+	} else if !attributable {
+		logger.Info(
+			"ignored light client attack; validator not in the validator set at the common height",
+			"validator", consAddr,
+			"common_height", commonHeight,
+			"common_time", commonTime,
+		)
+		return nil
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
+	}
+
+	// ignore if the validator is already tombstoned
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		logger.Info(
+			"ignored light client attack; validator already tombstoned",
+			"validator", consAddr,
+			"common_height", commonHeight,
+			"common_time", commonTime,
+		)
+		return nil
+	}
+
+	logger.Info(
+		"confirmed light client attack",
+		"validator", consAddr,
+		"common_height", commonHeight,
+		"common_time", commonTime,
+	)
+
+	if err := k.slashAndTombstone(ctx, validator, consAddr, evidence.GetValidatorPower(), distributionHeight); err != nil {
+		return err
+	}
+
+	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+}
+
+// getInfractionValidator returns the validator which committed the infraction
+// with the given consensus address, and its current consensus address. A nil
+// validator is returned when the evidence can not be handled.
+func (k Keeper) getInfractionValidator(ctx context.Context, consAddr sdk.ConsAddress) (mstakingtypes.ValidatorI, sdk.ConsAddress, error) {
+	validator, err := k.stakingKeeper.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, nil, err
 	}
 
 	if validator == nil || validator.IsUnbonded() {
 		// Defensive: Simulation doesn't take unbonding periods into account, and
 		// CometBFT might break this assumption at some point.
-		return nil
+		return nil, nil, nil
 	}
 
 	if len(validator.GetOperator()) != 0 {
@@ -50,65 +182,76 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 			// allowable but none of the disallowed evidence types.  Instead of
 			// getting this coordination right, it is easier to relax the
 			// constraints and ignore evidence that cannot be handled.
-			logger.Error(fmt.Sprintf("ignore evidence; expected public key for validator %s not found", consAddr))
-			return nil
+			k.Logger(ctx).Error(fmt.Sprintf("ignore evidence; expected public key for validator %s not found", consAddr))
+			return nil, nil, nil
 		}
 	}
 
-	// calculate the age of the evidence
-	infractionHeight := evidence.GetHeight()
-	infractionTime := evidence.GetTime()
+	// the evidence can be committed with a rotated out consensus key, so
+	// handle it with the validator's current consensus address
+	currentConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return validator, currentConsAddr, nil
+}
+
+// isEvidenceTooOld returns true if the infraction is too old to be punished.
+// Evidence is considered stale if the difference in time and number of blocks
+// is greater than the allowed parameters defined.
+func (k Keeper) isEvidenceTooOld(ctx context.Context, infraction string, consAddr sdk.ConsAddress, infractionHeight int64, infractionTime time.Time) bool {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
 	ageDuration := sdkCtx.BlockHeader().Time.Sub(infractionTime)
 	ageBlocks := sdkCtx.BlockHeader().Height - infractionHeight
 
-	// Reject evidence if the double-sign is too old. Evidence is considered stale
-	// if the difference in time and number of blocks is greater than the allowed
-	// parameters defined.
 	cp := sdkCtx.ConsensusParams()
 	if cp.Evidence != nil {
 		if ageDuration > cp.Evidence.MaxAgeDuration && ageBlocks > cp.Evidence.MaxAgeNumBlocks {
-			logger.Info(
-				"ignored equivocation; evidence too old",
+			k.Logger(ctx).Info(
+				fmt.Sprintf("ignored %s; evidence too old", infraction),
 				"validator", consAddr,
 				"infraction_height", infractionHeight,
 				"max_age_num_blocks", cp.Evidence.MaxAgeNumBlocks,
 				"infraction_time", infractionTime,
 				"max_age_duration", cp.Evidence.MaxAgeDuration,
 			)
-			return nil
+			return true
 		}
 	}
 
-	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
-		panic(fmt.Sprintf("expected signing info for validator %s but not found", consAddr))
-	}
+	return false
+}
 
-	// ignore if the validator is already tombstoned
-	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
-		logger.Info(
-			"ignored equivocation; validator already tombstoned",
-			"validator", consAddr,
-			"infraction_height", infractionHeight,
-			"infraction_time", infractionTime,
-		)
-		return nil
+// isInValidatorSet returns true if the consensus address was a member of the
+// historical validator set stored at the given height. It also returns true
+// when the historical info does not exist anymore.
+func (k Keeper) isInValidatorSet(ctx context.Context, height int64, consAddr sdk.ConsAddress) (bool, error) {
+	historicalInfo, err := k.stakingKeeper.GetHistoricalInfo(ctx, height)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return true, nil
+	} else if err != nil {
+		return false, err
 	}
 
-	logger.Info(
-		"confirmed equivocation",
-		"validator", consAddr,
-		"infraction_height", infractionHeight,
-		"infraction_time", infractionTime,
-	)
+	for _, val := range historicalInfo.Valset {
+		valConsAddr, err := val.GetConsAddr()
+		if err != nil {
+			return false, err
+		}
 
-	// We need to retrieve the stake distribution which signed the block, so we
-	// subtract ValidatorUpdateDelay from the evidence height.
-	// Note, that this *can* result in a negative "distributionHeight", up to
-	// -ValidatorUpdateDelay, i.e. at the end of the
-	// pre-genesis block (none) = at the beginning of the genesis block.
-	// That's fine since this is just used to filter unbonding delegations & redelegations.
-	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+		if consAddr.Equals(sdk.ConsAddress(valConsAddr)) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
 
+// slashAndTombstone slashes the validator with the double sign slash fraction,
+// then jails and tombstones it. Once tombstoned, the validator will not be
+// able to recover.
+func (k Keeper) slashAndTombstone(ctx context.Context, validator mstakingtypes.ValidatorI, consAddr sdk.ConsAddress, power, distributionHeight int64) error {
 	// Slash validator. The `power` is the int64 power of the validator as provided
 	// to/by CometBFT. This value is validator.Tokens as sent to CometBFT via
 	// ABCI, and now received as evidence. The fraction is passed in to separately
@@ -122,7 +265,7 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 		ctx,
 		consAddr,
 		slashFractionDoubleSign,
-		evidence.GetValidatorPower(), distributionHeight,
+		power, distributionHeight,
 		stakingtypes.Infraction_INFRACTION_DOUBLE_SIGN,
 	); err != nil {
 		return err
@@ -139,9 +282,6 @@ func (k Keeper) handleEquivocationEvidence(ctx context.Context, evidence *types.
 	if err := k.slashingKeeper.JailUntil(ctx, consAddr, types.DoubleSignJailEndTime); err != nil {
 		return err
 	}
-	if err := k.slashingKeeper.Tombstone(ctx, consAddr); err != nil {
-		return err
-	}
 
-	return k.Evidences.Set(ctx, evidence.Hash(), evidence)
+	return k.slashingKeeper.Tombstone(ctx, consAddr)
 }
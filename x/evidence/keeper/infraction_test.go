@@ -0,0 +1,119 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	customtypes "github.com/initia-labs/initia/x/evidence/types"
+)
+
+// withLightClientAttack returns the context with the light client attack of
+// the validator at the common height.
+func withLightClientAttack(ctx sdk.Context, consAddr sdk.ConsAddress, commonHeight, power int64) sdk.Context {
+	return ctx.WithCometInfo(baseapp.NewBlockInfo([]abci.Misbehavior{{
+		Type:             abci.MisbehaviorType_LIGHT_CLIENT_ATTACK,
+		Validator:        abci.Validator{Address: consAddr, Power: power},
+		Height:           commonHeight,
+		Time:             ctx.BlockTime(),
+		TotalVotingPower: power * 2,
+	}}, nil, nil, abci.CommitInfo{}))
+}
+
+func Test_HandleLightClientAttackEvidence(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	privKey1, privKey2 := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey2)
+	consAddr1 := sdk.ConsAddress(privKey1.PubKey().Address())
+	consAddr2 := sdk.ConsAddress(privKey2.PubKey().Address())
+
+	// both validators are in the validator set at the common height
+	commonHeight := ctx.BlockHeight() - 10
+	require.NoError(t, input.StakingKeeper.TrackHistoricalInfo(ctx.WithBlockHeight(commonHeight-1)))
+
+	validator, err := input.StakingKeeper.Validator(ctx, valAddr1)
+	require.NoError(t, err)
+	tokens := validator.GetTokens()
+	power := validator.GetConsensusPower(input.StakingKeeper.PowerReduction(ctx))
+
+	require.NoError(t, input.EvidenceKeeper.BeginBlocker(withLightClientAttack(ctx, consAddr1, commonHeight, power)))
+
+	// slashed, jailed and tombstoned
+	validator, err = input.StakingKeeper.Validator(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.GetTokens().IsAllLT(tokens))
+	require.True(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr1))
+
+	evidence := customtypes.FromABCILightClientAttack(
+		withLightClientAttack(ctx, consAddr1, commonHeight, power).CometInfo().GetEvidence().Get(0),
+		input.StakingKeeper.ConsensusAddressCodec(),
+	)
+	ok, err := input.EvidenceKeeper.Evidences.Has(ctx, evidence.Hash())
+	require.NoError(t, err)
+	require.True(t, ok)
+
+	// the other validator is not affected
+	validator, err = input.StakingKeeper.Validator(ctx, valAddr2)
+	require.NoError(t, err)
+	require.False(t, validator.IsJailed())
+	require.False(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr2))
+}
+
+func Test_HandleLightClientAttackEvidence_NotInValidatorSet(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	privKey1, privKey2 := ed25519.GenPrivKey(), ed25519.GenPrivKey()
+	_ = createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey1)
+
+	// only the first validator is in the validator set at the common height
+	commonHeight := ctx.BlockHeight() - 10
+	require.NoError(t, input.StakingKeeper.TrackHistoricalInfo(ctx.WithBlockHeight(commonHeight-1)))
+
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey2)
+	consAddr2 := sdk.ConsAddress(privKey2.PubKey().Address())
+
+	validator, err := input.StakingKeeper.Validator(ctx, valAddr2)
+	require.NoError(t, err)
+	tokens := validator.GetTokens()
+	power := validator.GetConsensusPower(input.StakingKeeper.PowerReduction(ctx))
+
+	// the attack can not be attributed to the validator (e.g. amnesia), so it is ignored
+	require.NoError(t, input.EvidenceKeeper.BeginBlocker(withLightClientAttack(ctx, consAddr2, commonHeight, power)))
+
+	validator, err = input.StakingKeeper.Validator(ctx, valAddr2)
+	require.NoError(t, err)
+	require.False(t, validator.IsJailed())
+	require.Equal(t, tokens, validator.GetTokens())
+	require.False(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr2))
+}
+
+func Test_HandleLightClientAttackEvidence_HistoricalInfoPruned(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	privKey := ed25519.GenPrivKey()
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey)
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+
+	validator, err := input.StakingKeeper.Validator(ctx, valAddr)
+	require.NoError(t, err)
+	tokens := validator.GetTokens()
+	power := validator.GetConsensusPower(input.StakingKeeper.PowerReduction(ctx))
+
+	// without the historical info, the attribution of CometBFT is trusted
+	require.NoError(t, input.EvidenceKeeper.BeginBlocker(withLightClientAttack(ctx, consAddr, ctx.BlockHeight()-10, power)))
+
+	validator, err = input.StakingKeeper.Validator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	require.True(t, validator.GetTokens().IsAllLT(tokens))
+	require.True(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+}
@@ -0,0 +1,150 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/x/evidence/exported"
+	"cosmossdk.io/x/evidence/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	compression "github.com/skip-mev/slinky/abci/strategies/codec"
+
+	customtypes "github.com/initia-labs/initia/x/evidence/types"
+)
+
+// NewConflictingOracleReportsHandler returns the evidence handler for the
+// conflicting oracle reports, which decodes the vote extensions with the given
+// vote extension codec.
+func NewConflictingOracleReportsHandler(k Keeper, veCodec compression.VoteExtensionCodec) types.Handler {
+	return func(ctx context.Context, evidence exported.Evidence) error {
+		reports, ok := evidence.(*customtypes.ConflictingOracleReports)
+		if !ok {
+			return fmt.Errorf("unexpected evidence type: %T", evidence)
+		}
+
+		return k.handleConflictingOracleReports(ctx, reports, veCodec)
+	}
+}
+
+// handleConflictingOracleReports handles the evidence of a validator which signed
+// two vote extensions with different oracle prices at the same height and round.
+// Assuming the evidence is valid, the validator is slashed, jailed and tombstoned
+// in the same way as an equivocation.
+//
+// The reports must carry different extension payloads of the same height and
+// round, and must be signed with the validator's current consensus key, so the
+// reports signed with a key rotated out of the validator, which may be leaked
+// after the rotation, cannot frame the validator.
+//
+// The evidence is considered invalid if:
+// - the evidence is too old or from the future
+// - the signatures are not valid signatures of the validator
+// - the vote extensions do not report different prices
+// - the signer is not the validator's current consensus key
+// - the validator is unbonded or does not exist
+// - the signing info does not exist
+// - is already tombstoned
+func (k Keeper) handleConflictingOracleReports(
+	ctx context.Context,
+	evidence *customtypes.ConflictingOracleReports,
+	veCodec compression.VoteExtensionCodec,
+) error {
+	if err := evidence.ValidateBasic(); err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	infractionHeight := evidence.GetHeight()
+	if infractionHeight >= sdkCtx.BlockHeight() {
+		return fmt.Errorf("conflicting oracle reports height %d is not in the past", infractionHeight)
+	}
+
+	cp := sdkCtx.ConsensusParams()
+	if cp.Evidence != nil && sdkCtx.BlockHeight()-infractionHeight > cp.Evidence.MaxAgeNumBlocks {
+		return fmt.Errorf("conflicting oracle reports too old; max age num blocks %d", cp.Evidence.MaxAgeNumBlocks)
+	}
+
+	evidenceConsAddr := evidence.GetConsensusAddress(k.stakingKeeper.ConsensusAddressCodec())
+	pubKey, err := k.slashingKeeper.GetPubkey(ctx, evidenceConsAddr.Bytes())
+	if err != nil {
+		return err
+	}
+
+	for _, report := range []struct{ extension, signature []byte }{
+		{evidence.ExtensionA, evidence.SignatureA},
+		{evidence.ExtensionB, evidence.SignatureB},
+	} {
+		signBytes := cmttypes.VoteExtensionSignBytes(sdkCtx.ChainID(), &cmtproto.Vote{
+			Height:    evidence.Height,
+			Round:     int32(evidence.Round),
+			Extension: report.extension,
+		})
+		if !pubKey.VerifySignature(signBytes, report.signature) {
+			return fmt.Errorf("invalid vote extension signature of validator %s", evidenceConsAddr)
+		}
+	}
+
+	veA, err := veCodec.Decode(evidence.ExtensionA)
+	if err != nil {
+		return err
+	}
+	veB, err := veCodec.Decode(evidence.ExtensionB)
+	if err != nil {
+		return err
+	}
+	if isSamePrices(veA.Prices, veB.Prices) {
+		return fmt.Errorf("vote extensions report the same prices")
+	}
+
+	validator, consAddr, err := k.getInfractionValidator(ctx, evidenceConsAddr)
+	if err != nil {
+		return err
+	} else if validator == nil {
+		return fmt.Errorf("validator %s not found or unbonded", evidenceConsAddr)
+	}
+
+	if !consAddr.Equals(evidenceConsAddr) {
+		return fmt.Errorf("conflicting oracle reports are not signed with the current consensus key of validator %s", consAddr)
+	}
+
+	if ok := k.slashingKeeper.HasValidatorSigningInfo(ctx, consAddr); !ok {
+		return fmt.Errorf("expected signing info for validator %s but not found", consAddr)
+	}
+
+	if k.slashingKeeper.IsTombstoned(ctx, consAddr) {
+		return fmt.Errorf("validator %s already tombstoned", consAddr)
+	}
+
+	k.Logger(ctx).Info(
+		"confirmed conflicting oracle reports",
+		"validator", consAddr,
+		"infraction_height", infractionHeight,
+		"infraction_round", evidence.Round,
+	)
+
+	power := validator.GetConsensusPower(k.stakingKeeper.PowerReduction(ctx))
+	distributionHeight := infractionHeight - sdk.ValidatorUpdateDelay
+
+	return k.slashAndTombstone(ctx, validator, consAddr, power, distributionHeight)
+}
+
+// isSamePrices returns true if both price reports contain the same prices.
+func isSamePrices(pricesA, pricesB map[uint64][]byte) bool {
+	if len(pricesA) != len(pricesB) {
+		return false
+	}
+
+	for id, priceA := range pricesA {
+		priceB, ok := pricesB[id]
+		if !ok || !bytes.Equal(priceA, priceB) {
+			return false
+		}
+	}
+
+	return true
+}
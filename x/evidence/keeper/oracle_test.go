@@ -0,0 +1,129 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/math"
+	evidencetypes "cosmossdk.io/x/evidence/types"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	vetypes "github.com/skip-mev/slinky/abci/ve/types"
+
+	customtypes "github.com/initia-labs/initia/x/evidence/types"
+)
+
+// newConflictingOracleReports returns the conflicting oracle reports of the
+// given prices signed by the private key.
+func newConflictingOracleReports(
+	t *testing.T, ctx sdk.Context, input TestKeepers, privKey cryptotypes.PrivKey,
+	height, round int64, pricesA, pricesB map[uint64][]byte,
+) *customtypes.ConflictingOracleReports {
+	consAddr, err := input.StakingKeeper.ConsensusAddressCodec().BytesToString(privKey.PubKey().Address())
+	require.NoError(t, err)
+
+	evidence := &customtypes.ConflictingOracleReports{
+		ConsensusAddress: consAddr,
+		Height:           height,
+		Round:            round,
+	}
+
+	extensionA, err := veCodec.Encode(vetypes.OracleVoteExtension{Prices: pricesA})
+	require.NoError(t, err)
+	extensionB, err := veCodec.Encode(vetypes.OracleVoteExtension{Prices: pricesB})
+	require.NoError(t, err)
+	if string(extensionA) > string(extensionB) {
+		extensionA, extensionB = extensionB, extensionA
+	}
+
+	sign := func(extension []byte) []byte {
+		signature, err := privKey.Sign(cmttypes.VoteExtensionSignBytes(ctx.ChainID(), &cmtproto.Vote{
+			Height:    height,
+			Round:     int32(round),
+			Extension: extension,
+		}))
+		require.NoError(t, err)
+		return signature
+	}
+
+	evidence.ExtensionA, evidence.SignatureA = extensionA, sign(extensionA)
+	evidence.ExtensionB, evidence.SignatureB = extensionB, sign(extensionB)
+	return evidence
+}
+
+func Test_HandleConflictingOracleReports(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ctx = ctx.WithChainID("test-chain")
+
+	privKey := ed25519.GenPrivKey()
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey)
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+
+	validator, err := input.StakingKeeper.Validator(ctx, valAddr)
+	require.NoError(t, err)
+	tokens := validator.GetTokens()
+
+	height := ctx.BlockHeight() - 10
+	pricesA := map[uint64][]byte{0: []byte("100")}
+	pricesB := map[uint64][]byte{0: []byte("200")}
+
+	// the same reports do not conflict
+	evidence := newConflictingOracleReports(t, ctx, input, privKey, height, 0, pricesA, pricesA)
+	require.Error(t, input.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// the reports must be signed by the validator
+	evidence = newConflictingOracleReports(t, ctx, input, privKey, height, 0, pricesA, pricesB)
+	evidence.SignatureB = evidence.SignatureA
+	require.Error(t, input.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	evidence = newConflictingOracleReports(t, ctx, input, privKey, height, 0, pricesA, pricesB)
+	require.NoError(t, input.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	// slashed with the double sign slash fraction, jailed and tombstoned
+	validator, err = input.StakingKeeper.Validator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	slashed := math.LegacyNewDecFromInt(tokens.AmountOf(bondDenom)).Mul(slashingtypes.DefaultSlashFractionDoubleSign).TruncateInt()
+	require.Equal(t, tokens.AmountOf(bondDenom).Sub(slashed), validator.GetTokens().AmountOf(bondDenom))
+	require.True(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+
+	signingInfo, err := input.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(t, err)
+	require.True(t, evidencetypes.DoubleSignJailEndTime.Equal(signingInfo.JailedUntil))
+
+	// the tombstoned validator is not punished again
+	evidence = newConflictingOracleReports(t, ctx, input, privKey, height, 1, pricesA, map[uint64][]byte{0: []byte("300")})
+	require.Error(t, input.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+}
+
+func Test_HandleConflictingOracleReports_RotatedConsPubKey(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ctx = ctx.WithChainID("test-chain")
+
+	privKey := ed25519.GenPrivKey()
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 10_000_000, privKey)
+	consAddr := sdk.ConsAddress(privKey.PubKey().Address())
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.NoError(t, input.StakingKeeper.RotateConsPubKey(ctx, validator, ed25519.GenPrivKey().PubKey()))
+
+	// the reports signed with the rotated out key cannot frame the validator
+	height := ctx.BlockHeight() - 10
+	evidence := newConflictingOracleReports(t, ctx, input, privKey, height, 0,
+		map[uint64][]byte{0: []byte("100")}, map[uint64][]byte{0: []byte("200")})
+	require.Error(t, input.EvidenceKeeper.SubmitEvidence(ctx, evidence))
+
+	validator, err = input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, validator.IsJailed())
+	require.False(t, input.SlashingKeeper.IsTombstoned(ctx, consAddr))
+}
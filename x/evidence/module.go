@@ -21,6 +21,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 
 	"github.com/initia-labs/initia/x/evidence/keeper"
+	customtypes "github.com/initia-labs/initia/x/evidence/types"
 )
 
 const ConsensusVersion = 1
@@ -58,6 +59,7 @@ func (AppModuleBasic) Name() string {
 // RegisterLegacyAminoCodec registers the evidence module's types to the LegacyAmino codec.
 func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	types.RegisterLegacyAminoCodec(cdc)
+	customtypes.RegisterLegacyAminoCodec(cdc)
 }
 
 // DefaultGenesis returns the evidence module's default genesis state.
@@ -95,6 +97,7 @@ func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 
 func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 	types.RegisterInterfaces(registry)
+	customtypes.RegisterInterfaces(registry)
 }
 
 // ----------------------------------------------------------------------------
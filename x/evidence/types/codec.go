@@ -0,0 +1,24 @@
+package types
+
+import (
+	"cosmossdk.io/x/evidence/exported"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// RegisterLegacyAminoCodec registers the initia evidence types on the LegacyAmino codec.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	cdc.RegisterConcrete(&LightClientAttack{}, "evidence/LightClientAttack", nil)
+	cdc.RegisterConcrete(&ConflictingOracleReports{}, "evidence/ConflictingOracleReports", nil)
+}
+
+// RegisterInterfaces registers the initia evidence types as implementations of
+// the evidence interface.
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations(
+		(*exported.Evidence)(nil),
+		&LightClientAttack{},
+		&ConflictingOracleReports{},
+	)
+}
@@ -0,0 +1,153 @@
+package types
+
+import (
+	"bytes"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/cometbft/cometbft/crypto/tmhash"
+
+	"cosmossdk.io/core/address"
+	"cosmossdk.io/core/comet"
+	"cosmossdk.io/x/evidence/exported"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Evidence type constants
+const (
+	RouteLightClientAttack        = "lightclientattack"
+	RouteConflictingOracleReports = "conflictingoraclereports"
+)
+
+var (
+	_ exported.Evidence = &LightClientAttack{}
+	_ exported.Evidence = &ConflictingOracleReports{}
+)
+
+// Route returns the Evidence Handler route for a LightClientAttack type.
+func (e *LightClientAttack) Route() string { return RouteLightClientAttack }
+
+// Hash returns the hash of a LightClientAttack object.
+func (e *LightClientAttack) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a LightClientAttack object.
+func (e *LightClientAttack) ValidateBasic() error {
+	if e.Time.Unix() <= 0 {
+		return fmt.Errorf("invalid light client attack time: %s", e.Time)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid light client attack height: %d", e.Height)
+	}
+	if e.Power < 1 {
+		return fmt.Errorf("invalid light client attack validator power: %d", e.Power)
+	}
+	if e.TotalVotingPower < e.Power {
+		return fmt.Errorf("invalid light client attack total voting power: %d", e.TotalVotingPower)
+	}
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid light client attack validator consensus address: %s", e.ConsensusAddress)
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at the common
+// height of the LightClientAttack.
+func (e LightClientAttack) GetConsensusAddress(consAc address.Codec) sdk.ConsAddress {
+	addr, _ := consAc.StringToBytes(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the common height of the LightClientAttack.
+func (e LightClientAttack) GetHeight() int64 {
+	return e.Height
+}
+
+// GetTime returns the block time of the common height of the LightClientAttack.
+func (e LightClientAttack) GetTime() time.Time {
+	return e.Time
+}
+
+// GetValidatorPower returns the validator's power at the common height of the
+// LightClientAttack.
+func (e LightClientAttack) GetValidatorPower() int64 {
+	return e.Power
+}
+
+// GetTotalPower returns the total voting power of the validator set at the
+// common height of the LightClientAttack.
+func (e LightClientAttack) GetTotalPower() int64 {
+	return e.TotalVotingPower
+}
+
+// FromABCILightClientAttack converts a CometBFT light client attack misbehavior
+// to SDK Evidence using LightClientAttack as the concrete type.
+func FromABCILightClientAttack(e comet.Evidence, conAc address.Codec) *LightClientAttack {
+	consAddr, err := conAc.BytesToString(e.Validator().Address())
+	if err != nil {
+		panic(err)
+	}
+
+	return &LightClientAttack{
+		Height:           e.Height(),
+		Time:             e.Time(),
+		Power:            e.Validator().Power(),
+		TotalVotingPower: e.TotalVotingPower(),
+		ConsensusAddress: consAddr,
+	}
+}
+
+// Route returns the Evidence Handler route for a ConflictingOracleReports type.
+func (e *ConflictingOracleReports) Route() string { return RouteConflictingOracleReports }
+
+// Hash returns the hash of a ConflictingOracleReports object.
+func (e *ConflictingOracleReports) Hash() []byte {
+	bz, err := e.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	return tmhash.Sum(bz)
+}
+
+// ValidateBasic performs basic stateless validation checks on a ConflictingOracleReports
+// object. The extensions must be ordered, so the same pair of reports always
+// produces the same evidence hash.
+func (e *ConflictingOracleReports) ValidateBasic() error {
+	if e.ConsensusAddress == "" {
+		return fmt.Errorf("invalid conflicting oracle reports validator consensus address: %s", e.ConsensusAddress)
+	}
+	if e.Height < 1 {
+		return fmt.Errorf("invalid conflicting oracle reports height: %d", e.Height)
+	}
+	if e.Round < 0 || e.Round > math.MaxInt32 {
+		return fmt.Errorf("invalid conflicting oracle reports round: %d", e.Round)
+	}
+	if len(e.SignatureA) == 0 || len(e.SignatureB) == 0 {
+		return fmt.Errorf("empty conflicting oracle reports signature")
+	}
+	if bytes.Compare(e.ExtensionA, e.ExtensionB) >= 0 {
+		return fmt.Errorf("conflicting oracle reports extensions must be distinct and in ascending order")
+	}
+
+	return nil
+}
+
+// GetConsensusAddress returns the validator's consensus address at the time the
+// conflicting oracle reports were signed.
+func (e ConflictingOracleReports) GetConsensusAddress(consAc address.Codec) sdk.ConsAddress {
+	addr, _ := consAc.StringToBytes(e.ConsensusAddress)
+	return addr
+}
+
+// GetHeight returns the height of the conflicting oracle reports.
+func (e ConflictingOracleReports) GetHeight() int64 {
+	return e.Height
+}
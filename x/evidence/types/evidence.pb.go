@@ -0,0 +1,963 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/evidence/v1/evidence.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// LightClientAttack implements the Evidence interface and defines the evidence
+// of a validator attributed to a light client attack by CometBFT.
+type LightClientAttack struct {
+	// height is the common height of the attack, which is the last height the
+	// attacked light client and the chain agree on.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the common height.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// power is the voting power of the validator at the common height.
+	Power int64 `protobuf:"varint,3,opt,name=power,proto3" json:"power,omitempty"`
+	// total_voting_power is the total voting power of the validator set at the common height.
+	TotalVotingPower int64 `protobuf:"varint,4,opt,name=total_voting_power,json=totalVotingPower,proto3" json:"total_voting_power,omitempty"`
+	// consensus_address is the consensus address of the attributed validator.
+	ConsensusAddress string `protobuf:"bytes,5,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+}
+
+func (m *LightClientAttack) Reset()         { *m = LightClientAttack{} }
+func (m *LightClientAttack) String() string { return proto.CompactTextString(m) }
+func (*LightClientAttack) ProtoMessage()    {}
+func (*LightClientAttack) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459702c2476c76be, []int{0}
+}
+func (m *LightClientAttack) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *LightClientAttack) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_LightClientAttack.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *LightClientAttack) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LightClientAttack.Merge(m, src)
+}
+func (m *LightClientAttack) XXX_Size() int {
+	return m.Size()
+}
+func (m *LightClientAttack) XXX_DiscardUnknown() {
+	xxx_messageInfo_LightClientAttack.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LightClientAttack proto.InternalMessageInfo
+
+// ConflictingOracleReports implements the Evidence interface and defines the
+// evidence of a validator which signed two vote extensions with different oracle
+// prices at the same height and round.
+type ConflictingOracleReports struct {
+	// consensus_address is the consensus address of the signing validator.
+	ConsensusAddress string `protobuf:"bytes,1,opt,name=consensus_address,json=consensusAddress,proto3" json:"consensus_address,omitempty"`
+	// height is the height of the vote extensions.
+	Height int64 `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	// round is the round of the vote extensions.
+	Round int64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	// extension_a is the first vote extension, which must be ordered before extension_b.
+	ExtensionA []byte `protobuf:"bytes,4,opt,name=extension_a,json=extensionA,proto3" json:"extension_a,omitempty"`
+	// signature_a is the vote extension signature of extension_a.
+	SignatureA []byte `protobuf:"bytes,5,opt,name=signature_a,json=signatureA,proto3" json:"signature_a,omitempty"`
+	// extension_b is the second vote extension.
+	ExtensionB []byte `protobuf:"bytes,6,opt,name=extension_b,json=extensionB,proto3" json:"extension_b,omitempty"`
+	// signature_b is the vote extension signature of extension_b.
+	SignatureB []byte `protobuf:"bytes,7,opt,name=signature_b,json=signatureB,proto3" json:"signature_b,omitempty"`
+}
+
+func (m *ConflictingOracleReports) Reset()         { *m = ConflictingOracleReports{} }
+func (m *ConflictingOracleReports) String() string { return proto.CompactTextString(m) }
+func (*ConflictingOracleReports) ProtoMessage()    {}
+func (*ConflictingOracleReports) Descriptor() ([]byte, []int) {
+	return fileDescriptor_459702c2476c76be, []int{1}
+}
+func (m *ConflictingOracleReports) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConflictingOracleReports) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConflictingOracleReports.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConflictingOracleReports) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConflictingOracleReports.Merge(m, src)
+}
+func (m *ConflictingOracleReports) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConflictingOracleReports) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConflictingOracleReports.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConflictingOracleReports proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*LightClientAttack)(nil), "initia.evidence.v1.LightClientAttack")
+	proto.RegisterType((*ConflictingOracleReports)(nil), "initia.evidence.v1.ConflictingOracleReports")
+}
+
+func init() { proto.RegisterFile("initia/evidence/v1/evidence.proto", fileDescriptor_459702c2476c76be) }
+
+var fileDescriptor_459702c2476c76be = []byte{
+	// 485 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xb1, 0x6f, 0xd3, 0x40,
+	0x18, 0xc5, 0x73, 0x69, 0x13, 0xc4, 0xb5, 0x48, 0x8d, 0x15, 0x21, 0x93, 0xc1, 0x4e, 0xcb, 0x12,
+	0x45, 0xd4, 0x56, 0x61, 0xab, 0xc4, 0x10, 0x57, 0x6c, 0x95, 0x40, 0x06, 0x31, 0xb0, 0x58, 0x67,
+	0xe7, 0x7a, 0x39, 0x61, 0xdf, 0x67, 0xf9, 0xce, 0xa1, 0xfc, 0x07, 0x88, 0xa9, 0x33, 0x13, 0x63,
+	0xc7, 0x0e, 0xec, 0xac, 0x1d, 0x2b, 0x26, 0x26, 0x40, 0xc9, 0xd0, 0x7f, 0x03, 0xf9, 0xce, 0x31,
+	0xa4, 0xc0, 0xc0, 0x12, 0xdd, 0x7b, 0xdf, 0xbb, 0xa7, 0x4f, 0xbf, 0xf8, 0xf0, 0x2e, 0x17, 0x5c,
+	0x71, 0xe2, 0xd3, 0x39, 0x9f, 0x52, 0x91, 0x50, 0x7f, 0x7e, 0xd0, 0x9c, 0xbd, 0xbc, 0x00, 0x05,
+	0x96, 0x65, 0x22, 0x5e, 0x63, 0xcf, 0x0f, 0x06, 0x3d, 0x92, 0x71, 0x01, 0xbe, 0xfe, 0x35, 0xb1,
+	0xc1, 0xbd, 0x04, 0x64, 0x06, 0x32, 0xd2, 0xca, 0x37, 0xa2, 0x1e, 0xf5, 0x19, 0x30, 0x30, 0x7e,
+	0x75, 0xaa, 0x5d, 0x97, 0x01, 0xb0, 0x94, 0xfa, 0x5a, 0xc5, 0xe5, 0x89, 0xaf, 0x78, 0x46, 0xa5,
+	0x22, 0x59, 0x6e, 0x02, 0x7b, 0x1f, 0xda, 0xb8, 0x77, 0xcc, 0xd9, 0x4c, 0x1d, 0xa5, 0x9c, 0x0a,
+	0x35, 0x51, 0x8a, 0x24, 0xaf, 0xad, 0xbb, 0xb8, 0x3b, 0xa3, 0x95, 0x6b, 0xa3, 0x21, 0x1a, 0x6d,
+	0x84, 0xb5, 0xb2, 0x1e, 0xe3, 0xcd, 0xaa, 0xc0, 0x6e, 0x0f, 0xd1, 0x68, 0xeb, 0xe1, 0xc0, 0x33,
+	0xed, 0xde, 0xaa, 0xdd, 0x7b, 0xb1, 0x6a, 0x0f, 0xee, 0x5c, 0x7e, 0x73, 0x5b, 0x67, 0xdf, 0x5d,
+	0x74, 0x7e, 0x7d, 0x31, 0x46, 0xa1, 0xbe, 0x66, 0xf5, 0x71, 0x27, 0x87, 0x37, 0xb4, 0xb0, 0x37,
+	0x74, 0xab, 0x11, 0xd6, 0x03, 0x6c, 0x29, 0x50, 0x24, 0x8d, 0xe6, 0xa0, 0xb8, 0x60, 0x91, 0x89,
+	0x6c, 0xea, 0xc8, 0x8e, 0x9e, 0xbc, 0xd4, 0x83, 0x67, 0x3a, 0xfd, 0x04, 0xf7, 0x12, 0x10, 0x92,
+	0x0a, 0x59, 0xca, 0x88, 0x4c, 0xa7, 0x05, 0x95, 0xd2, 0xee, 0x0c, 0xd1, 0xe8, 0x76, 0x60, 0x7f,
+	0xf9, 0xb4, 0xdf, 0xaf, 0xa1, 0x4c, 0xcc, 0xe4, 0xb9, 0x2a, 0xb8, 0x60, 0xe1, 0x4e, 0x73, 0xa5,
+	0xf6, 0x0f, 0xef, 0xbf, 0xfb, 0xe8, 0xb6, 0xde, 0x5f, 0x5f, 0x8c, 0x07, 0xcd, 0xbf, 0xf2, 0x07,
+	0x86, 0xbd, 0xcf, 0x6d, 0x6c, 0x1f, 0x81, 0x38, 0x49, 0x79, 0x52, 0x2d, 0xf0, 0xb4, 0x20, 0x49,
+	0x4a, 0x43, 0x9a, 0x43, 0xa1, 0xe4, 0xdf, 0x17, 0x41, 0xff, 0xbb, 0xc8, 0x6f, 0xa8, 0xdb, 0x6b,
+	0xa8, 0xfb, 0xb8, 0x53, 0x40, 0x29, 0xa6, 0x2b, 0x56, 0x5a, 0x58, 0x2e, 0xde, 0xa2, 0xa7, 0x8a,
+	0x0a, 0xc9, 0x41, 0x44, 0x44, 0x43, 0xda, 0x0e, 0x71, 0x63, 0x4d, 0xaa, 0x80, 0xe4, 0x4c, 0x10,
+	0x55, 0x16, 0x34, 0x22, 0x1a, 0xcc, 0x76, 0x88, 0x1b, 0x6b, 0xb2, 0xde, 0x10, 0xdb, 0xdd, 0x1b,
+	0x0d, 0xc1, 0x7a, 0x43, 0x6c, 0xdf, 0xba, 0xd1, 0x10, 0x1c, 0x8e, 0x57, 0xe8, 0x76, 0x1b, 0x74,
+	0xff, 0x82, 0x14, 0x1c, 0x9f, 0x2f, 0x1c, 0x74, 0xb9, 0x70, 0xd0, 0xd5, 0xc2, 0x41, 0x3f, 0x16,
+	0x0e, 0x3a, 0x5b, 0x3a, 0xad, 0xab, 0xa5, 0xd3, 0xfa, 0xba, 0x74, 0x5a, 0xaf, 0x3c, 0xc6, 0xd5,
+	0xac, 0x8c, 0xbd, 0x04, 0x32, 0xdf, 0x3c, 0x80, 0xfd, 0x94, 0xc4, 0xb2, 0x3e, 0xfb, 0xa7, 0xbf,
+	0x5e, 0x8c, 0x7a, 0x9b, 0x53, 0x19, 0x77, 0xf5, 0x87, 0xf6, 0xe8, 0xe7, 0x00, 0x8b, 0xf4, 0x65,
+	0x24, 0x51, 0x03, 0x00, 0x00,
+}
+
+func (this *LightClientAttack) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*LightClientAttack)
+	if !ok {
+		that2, ok := that.(LightClientAttack)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Time.Equal(that1.Time) {
+		return false
+	}
+	if this.Power != that1.Power {
+		return false
+	}
+	if this.TotalVotingPower != that1.TotalVotingPower {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	return true
+}
+func (this *ConflictingOracleReports) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ConflictingOracleReports)
+	if !ok {
+		that2, ok := that.(ConflictingOracleReports)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ConsensusAddress != that1.ConsensusAddress {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Round != that1.Round {
+		return false
+	}
+	if !bytes.Equal(this.ExtensionA, that1.ExtensionA) {
+		return false
+	}
+	if !bytes.Equal(this.SignatureA, that1.SignatureA) {
+		return false
+	}
+	if !bytes.Equal(this.ExtensionB, that1.ExtensionB) {
+		return false
+	}
+	if !bytes.Equal(this.SignatureB, that1.SignatureB) {
+		return false
+	}
+	return true
+}
+func (m *LightClientAttack) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *LightClientAttack) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *LightClientAttack) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.TotalVotingPower != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.TotalVotingPower))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Power != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Power))
+		i--
+		dAtA[i] = 0x18
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintEvidence(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ConflictingOracleReports) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ConflictingOracleReports) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ConflictingOracleReports) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SignatureB) > 0 {
+		i -= len(m.SignatureB)
+		copy(dAtA[i:], m.SignatureB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SignatureB)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ExtensionB) > 0 {
+		i -= len(m.ExtensionB)
+		copy(dAtA[i:], m.ExtensionB)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExtensionB)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.SignatureA) > 0 {
+		i -= len(m.SignatureA)
+		copy(dAtA[i:], m.SignatureA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.SignatureA)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ExtensionA) > 0 {
+		i -= len(m.ExtensionA)
+		copy(dAtA[i:], m.ExtensionA)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ExtensionA)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Round != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintEvidence(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ConsensusAddress) > 0 {
+		i -= len(m.ConsensusAddress)
+		copy(dAtA[i:], m.ConsensusAddress)
+		i = encodeVarintEvidence(dAtA, i, uint64(len(m.ConsensusAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvidence(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvidence(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *LightClientAttack) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovEvidence(uint64(l))
+	if m.Power != 0 {
+		n += 1 + sovEvidence(uint64(m.Power))
+	}
+	if m.TotalVotingPower != 0 {
+		n += 1 + sovEvidence(uint64(m.TotalVotingPower))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func (m *ConflictingOracleReports) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovEvidence(uint64(m.Height))
+	}
+	if m.Round != 0 {
+		n += 1 + sovEvidence(uint64(m.Round))
+	}
+	l = len(m.ExtensionA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SignatureA)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.ExtensionB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	l = len(m.SignatureB)
+	if l > 0 {
+		n += 1 + l + sovEvidence(uint64(l))
+	}
+	return n
+}
+
+func sovEvidence(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvidence(x uint64) (n int) {
+	return sovEvidence(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *LightClientAttack) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LightClientAttack: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LightClientAttack: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
+			}
+			m.Power = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Power |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalVotingPower", wireType)
+			}
+			m.TotalVotingPower = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalVotingPower |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConflictingOracleReports) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConflictingOracleReports: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConflictingOracleReports: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionA = append(m.ExtensionA[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionA == nil {
+				m.ExtensionA = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureA", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureA = append(m.SignatureA[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignatureA == nil {
+				m.SignatureA = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtensionB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ExtensionB = append(m.ExtensionB[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtensionB == nil {
+				m.ExtensionB = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignatureB", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SignatureB = append(m.SignatureB[:0], dAtA[iNdEx:postIndex]...)
+			if m.SignatureB == nil {
+				m.SignatureB = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvidence(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvidence
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipEvidence(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowEvidence
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowEvidence
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthEvidence
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupEvidence
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthEvidence
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthEvidence        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowEvidence          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupEvidence = fmt.Errorf("proto: unexpected end of group")
+)
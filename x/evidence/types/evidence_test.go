@@ -0,0 +1,84 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/evidence/types"
+)
+
+func Test_LightClientAttack(t *testing.T) {
+	consAc := address.NewBech32Codec("initvalcons")
+	consAddr, err := consAc.BytesToString(sdk.ConsAddress([]byte("validator_consaddr__")))
+	require.NoError(t, err)
+
+	e := types.LightClientAttack{
+		Height:           100,
+		Time:             time.Unix(1_000_000, 0).UTC(),
+		Power:            10,
+		TotalVotingPower: 100,
+		ConsensusAddress: consAddr,
+	}
+	require.NoError(t, e.ValidateBasic())
+	require.Equal(t, types.RouteLightClientAttack, e.Route())
+	require.Equal(t, int64(100), e.GetHeight())
+	require.Equal(t, int64(10), e.GetValidatorPower())
+	require.Equal(t, int64(100), e.GetTotalPower())
+	require.Equal(t, sdk.ConsAddress([]byte("validator_consaddr__")), e.GetConsensusAddress(consAc))
+	require.Len(t, e.Hash(), 32)
+
+	invalid := e
+	invalid.Height = 0
+	require.Error(t, invalid.ValidateBasic())
+
+	invalid = e
+	invalid.TotalVotingPower = 5
+	require.Error(t, invalid.ValidateBasic())
+
+	invalid = e
+	invalid.ConsensusAddress = ""
+	require.Error(t, invalid.ValidateBasic())
+}
+
+func Test_ConflictingOracleReports(t *testing.T) {
+	consAc := address.NewBech32Codec("initvalcons")
+	consAddr, err := consAc.BytesToString(sdk.ConsAddress([]byte("validator_consaddr__")))
+	require.NoError(t, err)
+
+	e := types.ConflictingOracleReports{
+		ConsensusAddress: consAddr,
+		Height:           100,
+		Round:            0,
+		ExtensionA:       []byte("extension_a"),
+		SignatureA:       []byte("signature_a"),
+		ExtensionB:       []byte("extension_b"),
+		SignatureB:       []byte("signature_b"),
+	}
+	require.NoError(t, e.ValidateBasic())
+	require.Equal(t, types.RouteConflictingOracleReports, e.Route())
+	require.Equal(t, int64(100), e.GetHeight())
+	require.Len(t, e.Hash(), 32)
+
+	// extensions must be ordered
+	invalid := e
+	invalid.ExtensionA, invalid.ExtensionB = e.ExtensionB, e.ExtensionA
+	require.Error(t, invalid.ValidateBasic())
+
+	// extensions must be different
+	invalid = e
+	invalid.ExtensionB = e.ExtensionA
+	require.Error(t, invalid.ValidateBasic())
+
+	invalid = e
+	invalid.SignatureB = nil
+	require.Error(t, invalid.ValidateBasic())
+
+	invalid = e
+	invalid.Round = -1
+	require.Error(t, invalid.ValidateBasic())
+}
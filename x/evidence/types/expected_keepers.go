@@ -4,9 +4,11 @@ import (
 	"context"
 
 	"cosmossdk.io/core/address"
+	"cosmossdk.io/math"
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	cosmostypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 )
 
 type (
@@ -15,5 +17,7 @@ type (
 	StakingKeeper interface {
 		ConsensusAddressCodec() address.Codec
 		ValidatorByConsAddr(context.Context, sdk.ConsAddress) (stakingtypes.ValidatorI, error)
+		GetHistoricalInfo(ctx context.Context, height int64) (cosmostypes.HistoricalInfo, error)
+		PowerReduction(ctx context.Context) math.Int
 	}
 )
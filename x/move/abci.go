@@ -22,6 +22,15 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, vc address.Codec) error
 		return nil
 	}
 
+	// track the LP voting power weights before the txs of the block are applied
+	bondDenoms, err := k.StakingKeeper.BondDenoms(ctx)
+	if err != nil {
+		return err
+	}
+	if err := keeper.NewVotingPowerKeeper(&k).TrackVotingPowerWeights(ctx, bondDenoms); err != nil {
+		return err
+	}
+
 	// get rewards from active validators
 	activeValidators, err := k.StakingKeeper.GetBondedValidatorsByPower(ctx)
 	if err != nil {
@@ -99,3 +108,9 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper, vc address.Codec) error
 
 	return nil
 }
+
+func EndBlocker(ctx context.Context, k keeper.Keeper) error {
+	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	return k.RunCronJobs(ctx)
+}
@@ -0,0 +1,229 @@
+package ante
+
+import (
+	"cosmossdk.io/errors"
+	txsigning "cosmossdk.io/x/tx/signing"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// authenticatableTx is the tx whose signatures can be verified by the sign
+// mode handler or the account authenticators.
+type authenticatableTx interface {
+	authsigning.Tx
+	authsigning.V2AdaptableTx
+}
+
+// SigVerificationDecorator ante decorator to verify the signatures of the
+// accounts with the move authenticators, and to verify the other signatures
+// with the account public keys. It replaces the SigGasConsumeDecorator and
+// the SigVerificationDecorator of the auth module.
+type SigVerificationDecorator struct {
+	ak              ante.AccountKeeper
+	keeper          types.AuthenticatorKeeper
+	signModeHandler *txsigning.HandlerMap
+
+	sigGasConsume ante.SigGasConsumeDecorator
+	sigVerify     ante.SigVerificationDecorator
+}
+
+// NewSigVerificationDecorator constructor of the SigVerificationDecorator
+func NewSigVerificationDecorator(
+	ak ante.AccountKeeper,
+	keeper types.AuthenticatorKeeper,
+	signModeHandler *txsigning.HandlerMap,
+	sigGasConsumer ante.SignatureVerificationGasConsumer,
+) SigVerificationDecorator {
+	return SigVerificationDecorator{
+		ak:              ak,
+		keeper:          keeper,
+		signModeHandler: signModeHandler,
+		sigGasConsume:   ante.NewSigGasConsumeDecorator(ak, sigGasConsumer),
+		sigVerify:       ante.NewSigVerificationDecorator(ak, signModeHandler),
+	}
+}
+
+// AnteHandle that authenticates the signers with the move authenticators, and
+// passes the other signers to the signature verification of the auth module.
+func (d SigVerificationDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authenticatableTx)
+	if !ok {
+		return ctx, errors.Wrap(sdkerrors.ErrTxDecode, "invalid transaction type")
+	}
+
+	gasLimit, err := d.keeper.AuthenticatorGasLimit(ctx)
+	if err != nil {
+		return ctx, err
+	}
+
+	// authenticators are disabled
+	if gasLimit == 0 {
+		return d.verify(ctx, tx, simulate, next)
+	}
+
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	if len(sigs) != len(signers) {
+		return ctx, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid number of signer;  expected: %d, got %d", len(signers), len(sigs))
+	}
+
+	var authenticated []bool
+	for i, signer := range signers {
+		authenticator, found, err := d.keeper.GetAuthenticator(ctx, types.ConvertSDKAddressToVMAddress(signer))
+		if err != nil {
+			return ctx, err
+		} else if !found {
+			continue
+		}
+
+		if err := d.authenticate(ctx, sigTx, signer, sigs[i], authenticator, gasLimit, simulate); err != nil {
+			return ctx, err
+		}
+
+		if authenticated == nil {
+			authenticated = make([]bool, len(signers))
+		}
+		authenticated[i] = true
+	}
+
+	if authenticated == nil {
+		return d.verify(ctx, tx, simulate, next)
+	}
+
+	// verify the other signers with the account public keys
+	filteredTx := unauthenticatedTx{sigTx, authenticated}
+	return d.verify(ctx, filteredTx, simulate, func(ctx sdk.Context, _ sdk.Tx, simulate bool) (sdk.Context, error) {
+		return next(ctx, tx, simulate)
+	})
+}
+
+// verify runs the signature verification of the auth module.
+func (d SigVerificationDecorator) verify(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	return d.sigGasConsume.AnteHandle(ctx, tx, simulate, func(ctx sdk.Context, tx sdk.Tx, simulate bool) (sdk.Context, error) {
+		return d.sigVerify.AnteHandle(ctx, tx, simulate, next)
+	})
+}
+
+// authenticate runs the authenticator of the signer with the sign bytes of the signature.
+func (d SigVerificationDecorator) authenticate(
+	ctx sdk.Context,
+	tx authenticatableTx,
+	signer []byte,
+	sig signing.SignatureV2,
+	authenticator types.Authenticator,
+	gasLimit uint64,
+	simulate bool,
+) error {
+	acc, err := ante.GetSignerAcc(ctx, d.ak, signer)
+	if err != nil {
+		return err
+	}
+
+	if sig.Sequence != acc.GetSequence() {
+		return errors.Wrapf(
+			sdkerrors.ErrWrongSequence,
+			"account sequence mismatch, expected %d, got %d", acc.GetSequence(), sig.Sequence,
+		)
+	}
+
+	// the simulated tx has no signatures, so consume the max gas of the authenticator
+	if simulate {
+		ctx.GasMeter().ConsumeGas(gasLimit, "move authenticator")
+		return nil
+	}
+
+	// no need to verify signatures on recheck tx
+	if ctx.IsReCheckTx() {
+		return nil
+	}
+
+	data, ok := sig.Data.(*signing.SingleSignatureData)
+	if !ok {
+		return errors.Wrap(sdkerrors.ErrUnauthorized, "authenticator accounts must sign with a single signature")
+	}
+
+	var accNum uint64
+	if ctx.BlockHeight() != 0 {
+		accNum = acc.GetAccountNumber()
+	}
+
+	signBytes, err := authsigning.GetSignBytesAdapter(ctx, d.signModeHandler, data.SignMode, authsigning.SignerData{
+		Address:       acc.GetAddress().String(),
+		ChainID:       ctx.ChainID(),
+		AccountNumber: accNum,
+		Sequence:      acc.GetSequence(),
+		PubKey:        acc.GetPubKey(),
+	}, tx)
+	if err != nil {
+		return err
+	}
+
+	if err := d.keeper.Authenticate(ctx, authenticator, signBytes, data.Signature); err != nil {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "authentication failed; %s", err)
+	}
+
+	return nil
+}
+
+// unauthenticatedTx hides the signers authenticated by the move
+// authenticators from the signature verification of the auth module.
+type unauthenticatedTx struct {
+	authenticatableTx
+
+	authenticated []bool
+}
+
+func (tx unauthenticatedTx) GetSigners() ([][]byte, error) {
+	signers, err := tx.authenticatableTx.GetSigners()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterAuthenticated(signers, tx.authenticated), nil
+}
+
+func (tx unauthenticatedTx) GetPubKeys() ([]cryptotypes.PubKey, error) {
+	pubKeys, err := tx.authenticatableTx.GetPubKeys()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterAuthenticated(pubKeys, tx.authenticated), nil
+}
+
+func (tx unauthenticatedTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	sigs, err := tx.authenticatableTx.GetSignaturesV2()
+	if err != nil {
+		return nil, err
+	}
+
+	return filterAuthenticated(sigs, tx.authenticated), nil
+}
+
+func filterAuthenticated[T any](items []T, authenticated []bool) []T {
+	filtered := make([]T, 0, len(items))
+	for i, item := range items {
+		if i < len(authenticated) && authenticated[i] {
+			continue
+		}
+
+		filtered = append(filtered, item)
+	}
+
+	return filtered
+}
@@ -0,0 +1,120 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsign "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/initia-labs/initia/x/move/ante"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+var authenticatedSignature = []byte("authenticated")
+
+type TestAuthenticatorKeeper struct {
+	gasLimit       uint64
+	authenticators map[string]movetypes.Authenticator
+	signBytes      [][]byte
+}
+
+func (k *TestAuthenticatorKeeper) GetAuthenticator(_ context.Context, addr vmtypes.AccountAddress) (movetypes.Authenticator, bool, error) {
+	authenticator, found := k.authenticators[addr.String()]
+	return authenticator, found, nil
+}
+
+func (k *TestAuthenticatorKeeper) Authenticate(_ context.Context, _ movetypes.Authenticator, signBytes []byte, signature []byte) error {
+	k.signBytes = append(k.signBytes, signBytes)
+	if string(signature) != string(authenticatedSignature) {
+		return errors.New("invalid signature")
+	}
+
+	return nil
+}
+
+func (k *TestAuthenticatorKeeper) AuthenticatorGasLimit(_ context.Context) (uint64, error) {
+	return k.gasLimit, nil
+}
+
+func (suite *AnteTestSuite) TestSigVerificationDecorator() {
+	suite.SetupTest(true) // setup
+	suite.txBuilder = suite.clientCtx.TxConfig.NewTxBuilder()
+
+	// keys and addresses
+	priv1, _, addr1 := testdata.KeyTestPubAddr()
+	priv2, pub2, addr2 := testdata.KeyTestPubAddr()
+
+	acc1 := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr1)
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc1)
+	acc2 := suite.app.AccountKeeper.NewAccountWithAddress(suite.ctx, addr2)
+	suite.Require().NoError(acc2.SetPubKey(pub2))
+	suite.app.AccountKeeper.SetAccount(suite.ctx, acc2)
+
+	keeper := &TestAuthenticatorKeeper{
+		gasLimit: 200_000,
+		authenticators: map[string]movetypes.Authenticator{
+			movetypes.ConvertSDKAddressToVMAddress(addr1).String(): {
+				Address:       addr1.String(),
+				ModuleAddress: "0x1",
+				ModuleName:    "TestAuthenticator",
+				FunctionName:  "authenticate",
+			},
+		},
+	}
+	antehandler := sdk.ChainAnteDecorators(ante.NewSigVerificationDecorator(
+		suite.app.AccountKeeper, keeper, suite.clientCtx.TxConfig.SignModeHandler(), authante.DefaultSigVerificationGasConsumer,
+	))
+
+	suite.Require().NoError(suite.txBuilder.SetMsgs(testdata.NewTestMsg(addr1, addr2)))
+	suite.txBuilder.SetGasLimit(200_000)
+
+	privs := []cryptotypes.PrivKey{priv1, priv2}
+	accNums := []uint64{acc1.GetAccountNumber(), acc2.GetAccountNumber()}
+	tx, err := suite.CreateTestTx(privs, accNums, []uint64{0, 0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+
+	// the authenticator rejects the public key signature
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+	suite.Require().Len(keeper.signBytes, 1)
+	suite.Require().NotEmpty(keeper.signBytes[0])
+
+	// the authenticator accepts the signature, and the other signer is
+	// verified with the public key
+	tx = suite.replaceSignature(tx, 0, authenticatedSignature)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().NoError(err)
+
+	// the public key signature of the other signer is still verified
+	tx = suite.replaceSignature(tx, 1, authenticatedSignature)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().ErrorIs(err, sdkerrors.ErrUnauthorized)
+
+	// the authenticators are disabled
+	keeper.gasLimit = 0
+	tx, err = suite.CreateTestTx(privs, accNums, []uint64{0, 0}, suite.ctx.ChainID())
+	suite.Require().NoError(err)
+	_, err = antehandler(suite.ctx, tx, false)
+	suite.Require().Error(err)
+}
+
+func (suite *AnteTestSuite) replaceSignature(tx authsign.Tx, idx int, signature []byte) authsign.Tx {
+	sigs, err := tx.GetSignaturesV2()
+	suite.Require().NoError(err)
+
+	data := sigs[idx].Data.(*signing.SingleSignatureData)
+	sigs[idx].Data = &signing.SingleSignatureData{
+		SignMode:  data.SignMode,
+		Signature: signature,
+	}
+	suite.Require().NoError(suite.txBuilder.SetSignatures(sigs...))
+
+	return suite.txBuilder.GetTx()
+}
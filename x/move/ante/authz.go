@@ -0,0 +1,27 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// ExecuteSpendDecorator ante decorator to set the spend tracker of the execute authorizations to a context
+type ExecuteSpendDecorator struct{}
+
+// NewExecuteSpendDecorator constructor of the ExecuteSpendDecorator
+func NewExecuteSpendDecorator() *ExecuteSpendDecorator {
+	return &ExecuteSpendDecorator{}
+}
+
+// AnteHandle that store the spend tracker to a context to let the move keeper
+// enforce the spend limits of the execute authorizations.
+func (d ExecuteSpendDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	ctx = types.WithExecuteSpendTracker(ctx)
+
+	if next != nil {
+		return next(ctx, tx, simulate)
+	}
+
+	return ctx, nil
+}
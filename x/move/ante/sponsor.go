@@ -0,0 +1,51 @@
+package ante
+
+import (
+	"context"
+
+	"cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+var _ ante.FeegrantKeeper = SponsorFeegrantKeeper{}
+
+// SponsorFeegrantKeeper is the fee grant keeper of the DeductFeeDecorator,
+// which lets the fee granters with a move sponsor policy decide whether to
+// pay the fee of a tx, and falls back to the fee grants of the feegrant
+// module for the other granters.
+type SponsorFeegrantKeeper struct {
+	keeper         types.SponsorKeeper
+	feegrantKeeper ante.FeegrantKeeper
+}
+
+// NewSponsorFeegrantKeeper constructor of the SponsorFeegrantKeeper
+func NewSponsorFeegrantKeeper(keeper types.SponsorKeeper, feegrantKeeper ante.FeegrantKeeper) SponsorFeegrantKeeper {
+	return SponsorFeegrantKeeper{
+		keeper:         keeper,
+		feegrantKeeper: feegrantKeeper,
+	}
+}
+
+// UseGrantedFees runs the sponsor policy of the granter, or uses the fee
+// grant of the granter when the granter has no sponsor policy.
+func (k SponsorFeegrantKeeper) UseGrantedFees(ctx context.Context, granter, grantee sdk.AccAddress, fee sdk.Coins, msgs []sdk.Msg) error {
+	sponsor, found, err := k.keeper.GetSponsor(ctx, types.ConvertSDKAddressToVMAddress(granter))
+	if err != nil {
+		return err
+	}
+
+	if found {
+		return k.keeper.CheckSponsorPolicy(ctx, sponsor, types.ConvertSDKAddressToVMAddress(grantee), fee, msgs)
+	}
+
+	if k.feegrantKeeper == nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "fee grants are not enabled")
+	}
+
+	return k.feegrantKeeper.UseGrantedFees(ctx, granter, grantee, fee, msgs)
+}
@@ -0,0 +1,80 @@
+package ante_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/testutil/testdata"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/ante"
+	movetypes "github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+type TestSponsorKeeper struct {
+	sponsors map[string]movetypes.Sponsor
+	payers   []vmtypes.AccountAddress
+}
+
+func (k *TestSponsorKeeper) GetSponsor(_ context.Context, addr vmtypes.AccountAddress) (movetypes.Sponsor, bool, error) {
+	sponsor, found := k.sponsors[addr.String()]
+	return sponsor, found, nil
+}
+
+func (k *TestSponsorKeeper) CheckSponsorPolicy(_ context.Context, _ movetypes.Sponsor, payer vmtypes.AccountAddress, fee sdk.Coins, _ []sdk.Msg) error {
+	k.payers = append(k.payers, payer)
+	if fee.IsAnyGT(sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))) {
+		return errors.New("rejected")
+	}
+
+	return nil
+}
+
+type TestFeegrantKeeper struct {
+	granters []sdk.AccAddress
+}
+
+func (k *TestFeegrantKeeper) UseGrantedFees(_ context.Context, granter, _ sdk.AccAddress, _ sdk.Coins, _ []sdk.Msg) error {
+	k.granters = append(k.granters, granter)
+	return nil
+}
+
+func TestSponsorFeegrantKeeper(t *testing.T) {
+	_, _, sponsor := testdata.KeyTestPubAddr()
+	_, _, granter := testdata.KeyTestPubAddr()
+	_, _, payer := testdata.KeyTestPubAddr()
+
+	sponsorKeeper := &TestSponsorKeeper{
+		sponsors: map[string]movetypes.Sponsor{
+			movetypes.ConvertSDKAddressToVMAddress(sponsor).String(): {Address: sponsor.String()},
+		},
+	}
+	feegrantKeeper := &TestFeegrantKeeper{}
+	k := ante.NewSponsorFeegrantKeeper(sponsorKeeper, feegrantKeeper)
+
+	ctx := context.Background()
+	msgs := []sdk.Msg{testdata.NewTestMsg(payer)}
+	fee := sdk.NewCoins(sdk.NewInt64Coin(baseDenom, 1000))
+
+	// the sponsor policy decides
+	require.NoError(t, k.UseGrantedFees(ctx, sponsor, payer, fee, msgs))
+	require.Error(t, k.UseGrantedFees(ctx, sponsor, payer, fee.Add(sdk.NewInt64Coin(baseDenom, 1)), msgs))
+	require.Equal(t, []vmtypes.AccountAddress{
+		movetypes.ConvertSDKAddressToVMAddress(payer),
+		movetypes.ConvertSDKAddressToVMAddress(payer),
+	}, sponsorKeeper.payers)
+	require.Empty(t, feegrantKeeper.granters)
+
+	// the other granters use the fee grants
+	require.NoError(t, k.UseGrantedFees(ctx, granter, payer, fee, msgs))
+	require.Equal(t, []sdk.AccAddress{granter}, feegrantKeeper.granters)
+
+	// the fee grants are disabled
+	k = ante.NewSponsorFeegrantKeeper(sponsorKeeper, nil)
+	require.NoError(t, k.UseGrantedFees(ctx, sponsor, payer, fee, msgs))
+	require.Error(t, k.UseGrantedFees(ctx, granter, payer, fee, msgs))
+}
@@ -12,6 +12,10 @@ const (
 	FlagUpgradePolicy = "upgrade-policy"
 	FlagTypeArgs      = "type-args"
 	FlagArgs          = "args"
+	FlagStartKey      = "start-key"
+	FlagEndKey        = "end-key"
+	FlagKeyPrefix     = "key-prefix"
+	FlagJSONKeys      = "json-keys"
 )
 
 // FlagSetUpgradePolicy Returns the FlagSet for upgrade policy related operations.
@@ -40,3 +44,13 @@ func FlagSetTypeArgs() *flag.FlagSet {
 ex) 0x1::BasicCoin::getBalance<u8>`)
 	return fs
 }
+
+// FlagSetTableKeyRange Returns the FlagSet for table key range related operations.
+func FlagSetTableKeyRange() *flag.FlagSet {
+	fs := flag.NewFlagSet("", flag.ContinueOnError)
+	fs.String(FlagStartKey, "", "The inclusive start of the key range, as hex encoded bcs bytes or a json value with --json-keys")
+	fs.String(FlagEndKey, "", "The exclusive end of the key range, as hex encoded bcs bytes or a json value with --json-keys")
+	fs.String(FlagKeyPrefix, "", "The hex encoded bcs prefix of the keys, not supported with --json-keys")
+	fs.Bool(FlagJSONKeys, false, `Read the range keys as json values of the table key type, ex) '"100"' for u64`)
+	return fs
+}
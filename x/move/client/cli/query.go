@@ -2,7 +2,10 @@ package cli
 
 import (
 	"context"
+	"encoding/hex"
 	"fmt"
+	"os"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -28,11 +31,27 @@ func GetQueryCmd(ac address.Codec) *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdModule(ac),
 		GetCmdModules(ac),
+		GetCmdModuleDependencies(ac),
+		GetCmdModuleDependents(ac),
+		GetCmdModuleFriends(ac),
+		GetCmdFunctionSignatures(ac),
+		GetCmdCheckUpgradeCompatibility(ac),
 		GetCmdResource(ac),
 		GetCmdResources(ac),
+		GetCmdResourceHolders(),
+		GetCmdResourcesByType(ac),
 		GetCmdTableEntry(ac),
 		GetCmdTableEntries(ac),
 		GetCmdQueryEntryFunction(ac),
+		GetCmdModuleRevenue(ac),
+		GetCmdClaimableRevenue(ac),
+		GetCmdCronJob(),
+		GetCmdCronJobs(),
+		GetCmdCronRuns(),
+		GetCmdPublishPolicy(ac),
+		GetCmdPublishDeposit(ac),
+		GetCmdAuthenticator(ac),
+		GetCmdSponsor(ac),
 		GetCmdQueryParams(),
 	)
 	return queryCmd
@@ -110,6 +129,237 @@ func GetCmdModules(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdModuleDependencies(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-dependencies [module owner] [module name]",
+		Short: "Get the modules imported by a module",
+		Long:  "Get the modules imported by a module",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleDependencies(
+				context.Background(),
+				&types.QueryModuleDependenciesRequest{
+					Address:    args[0],
+					ModuleName: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdModuleDependents(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-dependents [module owner] [module name]",
+		Short: "Get the published modules which import a module",
+		Long:  "Get the published modules which import a module, to assess the impact of the module upgrade",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleDependents(
+				context.Background(),
+				&types.QueryModuleDependentsRequest{
+					Address:    args[0],
+					ModuleName: args[1],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "module dependents")
+	return cmd
+}
+
+func GetCmdModuleFriends(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-friends [module owner]",
+		Short: "Get the friend declarations of the modules of an account",
+		Long:  "Get the friend declarations of the modules of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleFriends(
+				context.Background(),
+				&types.QueryModuleFriendsRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "modules")
+	return cmd
+}
+
+func GetCmdFunctionSignatures(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "function-signatures [module owner]",
+		Short: "Get the entry and view function signatures of the modules of an account",
+		Long:  "Get the entry and view function signatures of the modules of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.FunctionSignatures(
+				context.Background(),
+				&types.QueryFunctionSignaturesRequest{
+					Address:    args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "modules")
+	return cmd
+}
+
+func GetCmdCheckUpgradeCompatibility(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "check-upgrade-compatibility [sender] [move file1] [move file2] [...]",
+		Short: "Check whether move binary files can be published",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Check whether move binary files can be published by the sender without
+publishing them, and report the changed public functions and structs of
+the published modules and the published dependents which would break.
+
+Example:
+$ %s query move check-upgrade-compatibility init1... \
+    module1.mv \
+    module2.mv \
+    --upgrade-policy COMPATIBLE
+`, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(2, 101),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			bundle := make([][]byte, len(args)-1)
+			for i, arg := range args[1:] {
+				// check arg has .mv suffix
+				if !strings.HasSuffix(arg, ".mv") {
+					return fmt.Errorf("invalid move file: %s", arg)
+				}
+				bundle[i], err = os.ReadFile(arg)
+				if err != nil {
+					return err
+				}
+			}
+
+			upgradePolicyStr, err := cmd.Flags().GetString(FlagUpgradePolicy)
+			if err != nil {
+				return err
+			}
+
+			upgradePolicy, found := types.UpgradePolicy_value[upgradePolicyStr]
+			if !found {
+				return fmt.Errorf("invalid upgrade-policy `%s`", upgradePolicyStr)
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CheckUpgradeCompatibility(
+				context.Background(),
+				&types.QueryCheckUpgradeCompatibilityRequest{
+					Sender:        args[0],
+					CodeBytes:     bundle,
+					UpgradePolicy: types.UpgradePolicy(upgradePolicy),
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cmd.Flags().AddFlagSet(FlagSetUpgradePolicy())
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func GetCmdResource(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:     "resource [resource owner] [struct tag]",
@@ -187,6 +437,94 @@ func GetCmdResources(ac address.Codec) *cobra.Command {
 	return cmd
 }
 
+func GetCmdResourceHolders() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resource-holders [struct tag]",
+		Short: "Get the accounts holding a resource of the struct tag",
+		Long: fmt.Sprintf(`Get the accounts holding a resource of the struct tag, including the type arguments.
+The query requires the node to enable the resource index.
+
+Example:
+$ %s query move resource-holders 0x1::fungible_asset::FungibleStore
+`, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ResourceHolders(
+				context.Background(),
+				&types.QueryResourceHoldersRequest{
+					StructTag:  args[0],
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "resource holders")
+	return cmd
+}
+
+func GetCmdResourcesByType(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resources-by-type [resource owner] [struct tag prefix]",
+		Short: "Get the resources of an account whose struct tag starts with the prefix",
+		Long: fmt.Sprintf(`Get the resources of an account whose struct tag starts with the prefix.
+The query requires the node to enable the resource index.
+
+Example:
+$ %s query move resources-by-type 0x1 0x1::fungible_asset::
+`, version.AppName),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ResourcesByType(
+				context.Background(),
+				&types.QueryResourcesByTypeRequest{
+					Address:         args[0],
+					StructTagPrefix: args[1],
+					Pagination:      pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "resources")
+	return cmd
+}
+
 func GetCmdTableEntry(ac address.Codec) *cobra.Command {
 	decoder := newArgDecoder(asciiDecodeString)
 	cmd := &cobra.Command{
@@ -234,9 +572,15 @@ func GetCmdTableEntry(ac address.Codec) *cobra.Command {
 
 func GetCmdTableEntries(ac address.Codec) *cobra.Command {
 	cmd := &cobra.Command{
-		Use:     "table-entries [table addr]",
-		Short:   "Get all table entries",
-		Long:    "Get all table entries",
+		Use:   "table-entries [table addr]",
+		Short: "Get all table entries",
+		Long: fmt.Sprintf(`Get all table entries, optionally in the key range or with the key prefix.
+The entries are ordered by the bcs encoded key bytes.
+
+Example:
+$ %s query move table-entries 0x1234... --start-key 0100000000000000
+$ %s query move table-entries 0x1234... --start-key '"1"' --end-key '"3"' --json-keys
+`, version.AppName, version.AppName),
 		Aliases: []string{"entries"},
 		Args:    cobra.ExactArgs(1),
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -250,11 +594,76 @@ func GetCmdTableEntries(ac address.Codec) *cobra.Command {
 				return err
 			}
 
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			startKey, err := cmd.Flags().GetString(FlagStartKey)
+			if err != nil {
+				return err
+			}
+
+			endKey, err := cmd.Flags().GetString(FlagEndKey)
+			if err != nil {
+				return err
+			}
+
+			keyPrefix, err := cmd.Flags().GetString(FlagKeyPrefix)
+			if err != nil {
+				return err
+			}
+
+			jsonKeys, err := cmd.Flags().GetBool(FlagJSONKeys)
+			if err != nil {
+				return err
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
+			if jsonKeys {
+				if keyPrefix != "" {
+					return fmt.Errorf("--%s is not supported with --%s", FlagKeyPrefix, FlagJSONKeys)
+				}
+
+				res, err := queryClient.TableEntriesByJSON(
+					context.Background(),
+					&types.QueryTableEntriesByJSONRequest{
+						Address:    args[0],
+						StartKey:   startKey,
+						EndKey:     endKey,
+						Pagination: pageReq,
+					},
+				)
+				if err != nil {
+					return err
+				}
+
+				return clientCtx.PrintProto(res)
+			}
+
+			startKeyBz, err := hex.DecodeString(startKey)
+			if err != nil {
+				return err
+			}
+
+			endKeyBz, err := hex.DecodeString(endKey)
+			if err != nil {
+				return err
+			}
+
+			keyPrefixBz, err := hex.DecodeString(keyPrefix)
+			if err != nil {
+				return err
+			}
+
 			res, err := queryClient.TableEntries(
 				context.Background(),
 				&types.QueryTableEntriesRequest{
-					Address: args[0],
+					Address:    args[0],
+					StartKey:   startKeyBz,
+					EndKey:     endKeyBz,
+					KeyPrefix:  keyPrefixBz,
+					Pagination: pageReq,
 				},
 			)
 			if err != nil {
@@ -265,6 +674,7 @@ func GetCmdTableEntries(ac address.Codec) *cobra.Command {
 		},
 	}
 
+	cmd.Flags().AddFlagSet(FlagSetTableKeyRange())
 	flags.AddQueryFlagsToCmd(cmd)
 	flags.AddPaginationFlagsToCmd(cmd, "table entries")
 
@@ -396,3 +806,319 @@ $ %s query move params
 
 	return cmd
 }
+
+func GetCmdModuleRevenue(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "module-revenue [module owner] [module name]",
+		Short: "Get the contract shared revenue info of a module",
+		Long:  "Get the revenue recipient, the effective revenue ratio and the accumulated revenue of a module",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ModuleRevenue(
+				context.Background(),
+				&types.QueryModuleRevenueRequest{
+					Address:    args[0],
+					ModuleName: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdClaimableRevenue(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claimable-revenue [recipient]",
+		Short: "Get the claimable contract shared revenue of a recipient",
+		Long:  "Get the claimable contract shared revenue of a recipient",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.ClaimableRevenue(
+				context.Background(),
+				&types.QueryClaimableRevenueRequest{
+					Recipient: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdAuthenticator(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "authenticator [address]",
+		Short: "Get the authenticator of an account",
+		Long:  "Get the move view function which authenticates the signatures of an account",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Authenticator(
+				context.Background(),
+				&types.QueryAuthenticatorRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdSponsor(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sponsor [address]",
+		Short: "Get the fee sponsor policy of an address",
+		Long:  "Get the move view function which decides whether the sponsor pays the fee of a tx",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Sponsor(
+				context.Background(),
+				&types.QuerySponsorRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPublishPolicy(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish-policy [address]",
+		Short: "Get the publish policy applied to an address",
+		Long:  "Get the publish mode, the namespace, the quota usage and the deposit applied to an address",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PublishPolicy(
+				context.Background(),
+				&types.QueryPublishPolicyRequest{
+					Address: args[0],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdPublishDeposit(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "publish-deposit [module owner] [module name]",
+		Short: "Get the publish deposit of a module",
+		Long:  "Get the publish deposit of a module",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			_, err = types.AccAddressFromString(ac, args[0])
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PublishDeposit(
+				context.Background(),
+				&types.QueryPublishDepositRequest{
+					Address:    args[0],
+					ModuleName: args[1],
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCronJob() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-job [id]",
+		Short: "Get a cron job",
+		Long:  "Get a cron job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronJob(
+				context.Background(),
+				&types.QueryCronJobRequest{
+					Id: id,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdCronJobs() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-jobs",
+		Short: "Get all cron jobs",
+		Long:  "Get all cron jobs",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronJobs(
+				context.Background(),
+				&types.QueryCronJobsRequest{
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cron jobs")
+	return cmd
+}
+
+func GetCmdCronRuns() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cron-runs [id]",
+		Short: "Get the recent run history of a cron job",
+		Long:  "Get the recent run history of a cron job",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.CronRuns(
+				context.Background(),
+				&types.QueryCronRunsRequest{
+					Id:         id,
+					Pagination: pageReq,
+				},
+			)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "cron runs")
+	return cmd
+}
@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"os"
+	"strconv"
 	"strings"
 
 	"cosmossdk.io/core/address"
@@ -30,6 +31,15 @@ func GetTxCmd(ac address.Codec) *cobra.Command {
 		PublishCmd(ac),
 		ExecuteCmd(ac),
 		ScriptCmd(ac),
+		SetRevenueRecipientCmd(ac),
+		ClaimRevenueCmd(ac),
+		RegisterCronJobCmd(ac),
+		DepositCronJobCmd(ac),
+		DeregisterCronJobCmd(ac),
+		SetAuthenticatorCmd(ac),
+		RemoveAuthenticatorCmd(ac),
+		SetSponsorCmd(ac),
+		RemoveSponsorCmd(ac),
 	)
 	return txCmd
 }
@@ -291,3 +301,426 @@ $ %s tx move script \
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// SetRevenueRecipientCmd will register the contract shared revenue recipient of a module
+func SetRevenueRecipientCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-revenue-recipient [module name] [recipient]",
+		Short: "Register the contract shared revenue recipient of a module",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Register the contract shared revenue recipient of a module. The signer must be
+the module publisher, and the recipient can be an account or an object address.
+
+Example:
+$ %s tx move set-revenue-recipient BasicCoin init1... --from mykey
+`, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetRevenueRecipient{
+				Sender:     sender,
+				ModuleName: args[0],
+				Recipient:  args[1],
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// ClaimRevenueCmd will claim the accrued contract shared revenue
+func ClaimRevenueCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "claim-revenue [recipient]",
+		Short: "Claim the accrued contract shared revenue",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Claim the accrued contract shared revenue of the recipient. The revenue is
+always sent to the recipient, and the signer is used when the recipient is omitted.
+
+Example:
+$ %s tx move claim-revenue --from mykey
+$ %s tx move claim-revenue 0x1234... --from mykey
+`, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.RangeArgs(0, 1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgClaimRevenue{
+				Sender: sender,
+			}
+			if len(args) == 1 {
+				msg.Recipient = args[0]
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RegisterCronJobCmd will register a scheduled entry function call
+func RegisterCronJobCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-cron-job [module address] [module name] [function name] [interval] [gas limit] [deposit]",
+		Short: "Register a scheduled entry function call",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Register an entry function call which is executed at the end of the block
+in every [interval] blocks with the signer as the sender. The fee of each run
+is charged from the prepaid [deposit] in the base denom, and the remaining
+deposit is refunded on the deregistration.
+
+Example:
+$ %s tx move register-cron-job 0x1 BasicCoin rebalance 100 200000 1000000uinit \
+    --args 'u64:100' \
+    --from mykey
+`, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(6),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			interval, err := strconv.ParseUint(args[3], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			gasLimit, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[5])
+			if err != nil {
+				return err
+			}
+
+			var typeArgs []string
+			flagTypeArgs, err := cmd.Flags().GetString(FlagTypeArgs)
+			if err != nil {
+				return err
+			}
+			if flagTypeArgs != "" {
+				typeArgs = strings.Split(flagTypeArgs, " ")
+			}
+
+			flagArgs, err := cmd.Flags().GetString(FlagArgs)
+			if err != nil {
+				return err
+			}
+
+			bcsArgs, err := BcsSerializeArgs(flagArgs, ac)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRegisterCronJob{
+				Sender:        sender,
+				ModuleAddress: args[0],
+				ModuleName:    args[1],
+				FunctionName:  args[2],
+				TypeArgs:      typeArgs,
+				Args:          bcsArgs,
+				Interval:      interval,
+				GasLimit:      gasLimit,
+				Deposit:       deposit,
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	cmd.Flags().AddFlagSet(FlagSetTypeArgs())
+	cmd.Flags().AddFlagSet(FlagSetArgs())
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DepositCronJobCmd will add the prepaid fee to a cron job
+func DepositCronJobCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deposit-cron-job [id] [amount]",
+		Short: "Add the prepaid fee to a cron job",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDepositCronJob{
+				Sender: sender,
+				Id:     id,
+				Amount: amount,
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// DeregisterCronJobCmd will remove a cron job and refund the remaining deposit
+func DeregisterCronJobCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "deregister-cron-job [id]",
+		Short: "Remove a cron job and refund the remaining deposit",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgDeregisterCronJob{
+				Sender: sender,
+				Id:     id,
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetAuthenticatorCmd will set the authenticator of the sender
+func SetAuthenticatorCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-authenticator [module address] [module name] [function name]",
+		Short: "Set the move view function which authenticates the signatures of the sender",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Set the move view function which authenticates the signatures of the sender
+instead of the account public key. The function must be a view function of
+fun(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool.
+
+Example:
+$ %s tx move set-authenticator 0x1... multisig authenticate --from mykey
+`, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetAuthenticator{
+				Sender:        sender,
+				ModuleAddress: args[0],
+				ModuleName:    args[1],
+				FunctionName:  args[2],
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RemoveAuthenticatorCmd will remove the authenticator of the sender
+func RemoveAuthenticatorCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-authenticator",
+		Short: "Remove the authenticator of the sender to use the account public key again",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetAuthenticator{
+				Sender: sender,
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// SetSponsorCmd will set the fee sponsor policy of a sponsor
+func SetSponsorCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-sponsor [sponsor] [module address] [module name] [function name]",
+		Short: "Set the move view function which decides whether the sponsor pays the fee of a tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`
+Set the move view function which decides whether the sponsor pays the fee of
+a tx naming the sponsor as the fee granter. The sponsor is the sender account
+or a move object owned by the sender. The function must be a view function of
+fun(sponsor: address, payer: address, msg_types: vector<String>,
+fee_denoms: vector<String>, fee_amounts: vector<u64>, gas_limit: u64): bool.
+
+Example:
+$ %s tx move set-sponsor 0x1... 0x1... paymaster sponsor --from mykey
+$ %s tx bank send ... --fee-granter 0x1...
+`, version.AppName, version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetSponsor{
+				Sender:        sender,
+				Sponsor:       args[0],
+				ModuleAddress: args[1],
+				ModuleName:    args[2],
+				FunctionName:  args[3],
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// RemoveSponsorCmd will remove the fee sponsor policy of a sponsor
+func RemoveSponsorCmd(ac address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-sponsor [sponsor]",
+		Short: "Remove the fee sponsor policy of a sponsor",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			sender, err := ac.BytesToString(clientCtx.FromAddress)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgSetSponsor{
+				Sender:  sender,
+				Sponsor: args[0],
+			}
+
+			if err = msg.Validate(ac); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -356,3 +356,25 @@ func parseArguments(s string) (tt []string, args []string) {
 
 	return
 }
+
+// BcsSerializeArgs parses the `--args` flag value and serializes each
+// argument into bcs bytes.
+func BcsSerializeArgs(flagArgs string, ac address.Codec) ([][]byte, error) {
+	moveArgTypes, moveArgs := parseArguments(flagArgs)
+	if len(moveArgTypes) != len(moveArgs) {
+		return nil, fmt.Errorf("invalid argument format len(moveArgTypes) != len(moveArgs)")
+	}
+
+	bcsArgs := [][]byte{}
+	for i := range moveArgTypes {
+		serializer := NewSerializer()
+		bcsArg, err := BcsSerializeArg(moveArgTypes[i], moveArgs[i], serializer, ac)
+		if err != nil {
+			return nil, err
+		}
+
+		bcsArgs = append(bcsArgs, bcsArg)
+	}
+
+	return bcsArgs, nil
+}
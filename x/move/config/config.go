@@ -22,12 +22,28 @@ const DefaultContractSimulationGasLimit = uint64(3_000_000)
 // DefaultContractViewBatchLimit - default max view batch limit
 const DefaultContractViewBatchLimit = uint64(10)
 
+// DefaultContractProfileEnabled - gas profile query is disabled by default
+const DefaultContractProfileEnabled = false
+
+// DefaultResourceIndexEnabled - resource index is disabled by default
+const DefaultResourceIndexEnabled = false
+
+// DefaultModuleCacheWarmupCount - module cache warmup is disabled by default
+const DefaultModuleCacheWarmupCount = uint64(0)
+
+// DefaultEventStreamBufferSize - event stream is disabled by default
+const DefaultEventStreamBufferSize = uint64(0)
+
 const (
 	flagModuleCacheCapacity        = "move.module-cache-capacity"
 	flagScriptCacheCapacity        = "move.script-cache-capacity"
 	flagContractSimulationGasLimit = "move.contract-simulation-gas-limit"
 	flagContractQueryGasLimit      = "move.contract-query-gas-limit"
 	flagContractViewBatchLimit     = "move.contract-view-batch-limit"
+	flagContractProfileEnabled     = "move.contract-profile-enabled"
+	flagResourceIndexEnabled       = "move.resource-index-enabled"
+	flagModuleCacheWarmupCount     = "move.module-cache-warmup-count"
+	flagEventStreamBufferSize      = "move.event-stream-buffer-size"
 )
 
 // MoveConfig is the extra config required for move
@@ -37,6 +53,10 @@ type MoveConfig struct {
 	ContractSimulationGasLimit uint64 `mapstructure:"contract-simulation-gas-limit"`
 	ContractQueryGasLimit      uint64 `mapstructure:"contract-query-gas-limit"`
 	ContractViewBatchLimit     uint64 `mapstructure:"contract-view-batch-limit"`
+	ContractProfileEnabled     bool   `mapstructure:"contract-profile-enabled"`
+	ResourceIndexEnabled       bool   `mapstructure:"resource-index-enabled"`
+	ModuleCacheWarmupCount     uint64 `mapstructure:"module-cache-warmup-count"`
+	EventStreamBufferSize      uint64 `mapstructure:"event-stream-buffer-size"`
 }
 
 // DefaultMoveConfig returns the default settings for MoveConfig
@@ -47,6 +67,10 @@ func DefaultMoveConfig() MoveConfig {
 		ContractSimulationGasLimit: DefaultContractSimulationGasLimit,
 		ContractQueryGasLimit:      DefaultContractQueryGasLimit,
 		ContractViewBatchLimit:     DefaultContractViewBatchLimit,
+		ContractProfileEnabled:     DefaultContractProfileEnabled,
+		ResourceIndexEnabled:       DefaultResourceIndexEnabled,
+		ModuleCacheWarmupCount:     DefaultModuleCacheWarmupCount,
+		EventStreamBufferSize:      DefaultEventStreamBufferSize,
 	}
 }
 
@@ -58,6 +82,10 @@ func GetConfig(appOpts servertypes.AppOptions) MoveConfig {
 		ContractSimulationGasLimit: cast.ToUint64(appOpts.Get(flagContractSimulationGasLimit)),
 		ContractQueryGasLimit:      cast.ToUint64(appOpts.Get(flagContractQueryGasLimit)),
 		ContractViewBatchLimit:     cast.ToUint64(appOpts.Get(flagContractViewBatchLimit)),
+		ContractProfileEnabled:     cast.ToBool(appOpts.Get(flagContractProfileEnabled)),
+		ResourceIndexEnabled:       cast.ToBool(appOpts.Get(flagResourceIndexEnabled)),
+		ModuleCacheWarmupCount:     cast.ToUint64(appOpts.Get(flagModuleCacheWarmupCount)),
+		EventStreamBufferSize:      cast.ToUint64(appOpts.Get(flagEventStreamBufferSize)),
 	}
 }
 
@@ -68,6 +96,10 @@ func AddConfigFlags(startCmd *cobra.Command) {
 	startCmd.Flags().Uint64(flagContractSimulationGasLimit, DefaultContractSimulationGasLimit, "Set the max simulation gas for move contract execution")
 	startCmd.Flags().Uint64(flagContractQueryGasLimit, DefaultContractQueryGasLimit, "Set the max gas that can be spent on executing a query with a Move contract")
 	startCmd.Flags().Uint64(flagContractViewBatchLimit, DefaultContractViewBatchLimit, "Set the maximum number of view function call requests that can be performed by a single ViewBatch gRPC call.")
+	startCmd.Flags().Bool(flagContractProfileEnabled, DefaultContractProfileEnabled, "Enable the gas profile query, which executes move entry functions without committing the state changes")
+	startCmd.Flags().Bool(flagResourceIndexEnabled, DefaultResourceIndexEnabled, "Enable the node local resource index, which serves the resource holders and resources by type queries")
+	startCmd.Flags().Uint64(flagModuleCacheWarmupCount, DefaultModuleCacheWarmupCount, "Set the number of most used modules which are recorded and loaded into the module cache on start, and zero disables the warmup")
+	startCmd.Flags().Uint64(flagEventStreamBufferSize, DefaultEventStreamBufferSize, "Set the number of recent blocks whose move events are kept for the event stream, and zero disables the event stream")
 }
 
 // DefaultConfigTemplate default config template for move module
@@ -96,4 +128,32 @@ contract-query-gas-limit = "{{ .MoveConfig.ContractQueryGasLimit }}"
 # limit will result in an error. This limit is set to prevent 
 # overloading the system with too many requests at once.
 contract-view-batch-limit = "{{ .MoveConfig.ContractViewBatchLimit }}"
+
+# Enable the gas profile query, which executes an entry function
+# without committing the state changes and reports the gas usage
+# broken down by module. This is a debugging feature, so keep it
+# disabled on public nodes.
+contract-profile-enabled = {{ .MoveConfig.ContractProfileEnabled }}
+
+# Enable the node local resource index, which tracks the resource
+# holders by struct tag and serves the ResourceHolders and
+# ResourcesByType queries. The index is stored in the data directory
+# and does not affect the consensus state. Run
+# "initiad rebuild-resource-index" to build the index from the
+# existing state when enabling it on a running node.
+resource-index-enabled = {{ .MoveConfig.ResourceIndexEnabled }}
+
+# The number of most used modules which are recorded while the node
+# runs and loaded into the module cache on start, so the first blocks
+# after a restart do not pay the module loading cost. Only the list of
+# the modules is stored in the data directory, and the modules are
+# loaded and verified again on start. Zero disables the warmup.
+module-cache-warmup-count = "{{ .MoveConfig.ModuleCacheWarmupCount }}"
+
+# The number of recent blocks whose move events are kept in memory
+# for the SubscribeEvents gRPC stream. Subscribers can replay the
+# kept blocks from a start height before following the new blocks.
+# The buffer does not affect the consensus state and zero disables
+# the event stream.
+event-stream-buffer-size = "{{ .MoveConfig.EventStreamBufferSize }}"
 `
@@ -0,0 +1,117 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// authenticatorParams is the parameter types of the authenticator function,
+// `fun(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool`.
+var authenticatorParams = []string{"address", "vector<u8>", "vector<u8>"}
+
+// GetAuthenticator returns the authenticator of the account, and false
+// if the account is authenticated by the account public key.
+func (k Keeper) GetAuthenticator(ctx context.Context, addr vmtypes.AccountAddress) (types.Authenticator, bool, error) {
+	authenticator, err := k.Authenticators.Get(ctx, addr.Bytes())
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.Authenticator{}, false, nil
+	} else if err != nil {
+		return types.Authenticator{}, false, err
+	}
+
+	return authenticator, true, nil
+}
+
+// SetAuthenticator sets the view function which authenticates the signatures
+// of the account. The function must be a non-generic view function of
+// `fun(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool`.
+func (k Keeper) SetAuthenticator(
+	ctx context.Context,
+	addr vmtypes.AccountAddress,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	functionName string,
+) error {
+	if err := k.validatePolicyFunction(
+		ctx, moduleAddr, moduleName, functionName,
+		authenticatorParams, types.ErrInvalidAuthenticator,
+	); err != nil {
+		return err
+	}
+
+	return k.Authenticators.Set(ctx, addr.Bytes(), types.Authenticator{
+		Address:       addr.String(),
+		ModuleAddress: moduleAddr.String(),
+		ModuleName:    moduleName,
+		FunctionName:  functionName,
+	})
+}
+
+// RemoveAuthenticator removes the authenticator of the account, so the
+// account is authenticated by the account public key again.
+func (k Keeper) RemoveAuthenticator(ctx context.Context, addr vmtypes.AccountAddress) error {
+	return k.Authenticators.Remove(ctx, addr.Bytes())
+}
+
+// Authenticate runs the authenticator of the account with the sign bytes and
+// the signature. The gas used by the authenticator is bounded by the
+// `AuthenticatorGasLimit` param and consumed from the gas meter of the context.
+func (k Keeper) Authenticate(
+	ctx context.Context,
+	authenticator types.Authenticator,
+	signBytes []byte,
+	signature []byte,
+) error {
+	gasLimit, err := k.AuthenticatorGasLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if gasLimit == 0 {
+		return types.ErrInvalidAuthenticator.Wrap("authenticators are disabled")
+	}
+
+	addr, err := types.AccAddressFromString(k.ac, authenticator.Address)
+	if err != nil {
+		return err
+	}
+
+	moduleAddr, err := types.AccAddressFromString(k.ac, authenticator.ModuleAddress)
+	if err != nil {
+		return err
+	}
+
+	signBytesArg, err := vmtypes.SerializeBytes(signBytes)
+	if err != nil {
+		return err
+	}
+
+	signatureArg, err := vmtypes.SerializeBytes(signature)
+	if err != nil {
+		return err
+	}
+
+	accepted, err := k.executePolicyFunction(
+		ctx,
+		gasLimit,
+		moduleAddr,
+		authenticator.ModuleName,
+		authenticator.FunctionName,
+		[][]byte{addr.Bytes(), signBytesArg, signatureArg},
+		"move authenticator",
+	)
+	if err != nil {
+		return err
+	}
+
+	if !accepted {
+		return types.ErrInvalidAuthenticator.Wrapf("rejected by %s::%s::%s", authenticator.ModuleAddress, authenticator.ModuleName, authenticator.FunctionName)
+	}
+
+	return nil
+}
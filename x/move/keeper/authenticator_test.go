@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"crypto/sha256"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func TestAuthenticator(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testAuthenticatorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	account, err := vmtypes.NewAccountAddressFromBytes(addrs[0])
+	require.NoError(t, err)
+
+	// invalid authenticators
+	err = input.MoveKeeper.SetAuthenticator(ctx, account, vmtypes.TestAddress, "Unknown", "authenticate")
+	require.ErrorIs(t, err, types.ErrModuleNotFound)
+	err = input.MoveKeeper.SetAuthenticator(ctx, account, vmtypes.TestAddress, "TestAuthenticator", "unknown")
+	require.ErrorIs(t, err, types.ErrInvalidAuthenticator)
+	err = input.MoveKeeper.SetAuthenticator(ctx, account, vmtypes.TestAddress, "TestAuthenticator", "not_view")
+	require.ErrorIs(t, err, types.ErrInvalidAuthenticator)
+	err = input.MoveKeeper.SetAuthenticator(ctx, account, vmtypes.TestAddress, "TestAuthenticator", "wrong_params")
+	require.ErrorIs(t, err, types.ErrInvalidAuthenticator)
+
+	_, found, err := input.MoveKeeper.GetAuthenticator(ctx, account)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	_, err = ms.SetAuthenticator(ctx, &types.MsgSetAuthenticator{
+		Sender:        addrs[0].String(),
+		ModuleAddress: vmtypes.TestAddress.String(),
+		ModuleName:    "TestAuthenticator",
+		FunctionName:  "authenticate",
+	})
+	require.NoError(t, err)
+
+	res, err := querier.Authenticator(ctx, &types.QueryAuthenticatorRequest{Address: addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, types.Authenticator{
+		Address:       account.String(),
+		ModuleAddress: vmtypes.TestAddress.String(),
+		ModuleName:    "TestAuthenticator",
+		FunctionName:  "authenticate",
+	}, res.Authenticator)
+
+	// authenticate
+	signBytes := []byte("sign bytes")
+	signature := sha256.Sum256(signBytes)
+
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	require.NoError(t, input.MoveKeeper.Authenticate(ctx, res.Authenticator, signBytes, signature[:]))
+	require.NotZero(t, ctx.GasMeter().GasConsumed())
+
+	err = input.MoveKeeper.Authenticate(ctx, res.Authenticator, signBytes, signBytes)
+	require.ErrorIs(t, err, types.ErrInvalidAuthenticator)
+
+	// the gas is bounded by the param
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.AuthenticatorGasLimit = 1
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+	require.Error(t, input.MoveKeeper.Authenticate(ctx, res.Authenticator, signBytes, signature[:]))
+
+	// remove the authenticator
+	_, err = ms.SetAuthenticator(ctx, &types.MsgSetAuthenticator{
+		Sender: addrs[0].String(),
+	})
+	require.NoError(t, err)
+
+	_, found, err = input.MoveKeeper.GetAuthenticator(ctx, account)
+	require.NoError(t, err)
+	require.False(t, found)
+}
@@ -0,0 +1,74 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// SetAuthzKeeper sets the authz keeper, which is required to enforce the
+// spend limit of the execute authorization.
+func (k *Keeper) SetAuthzKeeper(authzKeeper types.AuthzKeeper) {
+	k.authzKeeper = authzKeeper
+}
+
+// executeWithSpendLimit runs the execution, and enforces the spend limit of
+// the execute authorization accepted for the execution, if any. The outflow
+// is the decrease of the granter balances observed during the execution.
+func (k Keeper) executeWithSpendLimit(ctx context.Context, sender vmtypes.AccountAddress, execute func() error) error {
+	spend, found := types.GetExecuteSpendTracker(ctx).Pop(sender)
+	if !found {
+		return execute()
+	}
+
+	granter := types.ConvertVMAddressToSDKAddress(sender)
+	before := k.bankKeeper.GetAllBalances(ctx, granter)
+	if err := execute(); err != nil {
+		return err
+	}
+	after := k.bankKeeper.GetAllBalances(ctx, granter)
+
+	outflow := sdk.NewCoins()
+	for _, coin := range before {
+		if amount := after.AmountOf(coin.Denom); coin.Amount.GT(amount) {
+			outflow = outflow.Add(sdk.NewCoin(coin.Denom, coin.Amount.Sub(amount)))
+		}
+	}
+
+	remaining, hasNeg := spend.Authorization.SpendLimit.SafeSub(outflow...)
+	if hasNeg {
+		return types.ErrLimit.Wrapf("outflow %s exceeds the spend limit %s", outflow, spend.Authorization.SpendLimit)
+	}
+
+	if spend.Deleted || outflow.IsZero() {
+		return nil
+	}
+
+	return k.updateSpendLimit(ctx, granter, spend, remaining)
+}
+
+// updateSpendLimit updates the spend limit of the grant of the accepted
+// authorization, which is keyed by the granter, the grantee and the msg type.
+// The grant is deleted when the spend limit is used up.
+func (k Keeper) updateSpendLimit(ctx context.Context, granter sdk.AccAddress, spend types.ExecuteSpend, remaining sdk.Coins) error {
+	if k.authzKeeper == nil {
+		return types.ErrUnauthorized.Wrap("authz keeper is not set")
+	}
+
+	msgType := sdk.MsgTypeURL(&types.MsgExecute{})
+	authorization, expiration := k.authzKeeper.GetAuthorization(ctx, spend.Grantee, granter, msgType)
+	if authorization == nil {
+		return types.ErrUnauthorized.Wrap("failed to find the grant of the spend limit")
+	}
+
+	if remaining.IsZero() {
+		return k.authzKeeper.DeleteGrant(ctx, spend.Grantee, granter, msgType)
+	}
+
+	updated := spend.Authorization
+	updated.SpendLimit = remaining
+	return k.authzKeeper.SaveGrant(ctx, spend.Grantee, granter, &updated, expiration)
+}
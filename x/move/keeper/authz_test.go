@@ -0,0 +1,207 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/codec/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func TestExecuteSpendLimit(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+	input.Faucet.Fund(ctx, granter, sdk.NewCoin(bondDenom, math.NewInt(1000)))
+
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	auth, err := types.NewExecuteAuthorization(ac, []types.ExecuteAuthorizationItem{
+		{ModuleAddress: vmtypes.StdAddress.String(), ModuleName: "coin", FunctionNames: []string{"transfer"}},
+	})
+	require.NoError(t, err)
+	auth.SpendLimit = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(150)))
+	require.NoError(t, input.AuthzKeeper.SaveGrant(ctx, grantee, granter, auth, nil))
+
+	metadata, err := types.MetadataAddressFromDenom(bondDenom)
+	require.NoError(t, err)
+
+	recipientAddr, err := vmtypes.NewAccountAddressFromBytes(recipient)
+	require.NoError(t, err)
+
+	transfer := func(amount uint64) error {
+		amountBz, err := vmtypes.SerializeUint64(amount)
+		require.NoError(t, err)
+
+		msg := &types.MsgExecute{
+			Sender:        granter.String(),
+			ModuleAddress: vmtypes.StdAddress.String(),
+			ModuleName:    "coin",
+			FunctionName:  "transfer",
+			Args:          [][]byte{recipientAddr[:], metadata[:], amountBz},
+		}
+
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = types.WithExecuteSpendTracker(cacheCtx)
+		cacheCtx = types.WithExecuteGrant(cacheCtx, ac, grantee)
+		if _, err := input.AuthzKeeper.DispatchActions(cacheCtx, grantee, []sdk.Msg{msg}); err != nil {
+			return err
+		}
+
+		write()
+		return nil
+	}
+
+	// without the spend tracker, the spend limit cannot be enforced
+	amountBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+	_, err = input.AuthzKeeper.DispatchActions(types.WithExecuteGrant(ctx, ac, grantee), grantee, []sdk.Msg{&types.MsgExecute{
+		Sender:        granter.String(),
+		ModuleAddress: vmtypes.StdAddress.String(),
+		ModuleName:    "coin",
+		FunctionName:  "transfer",
+		Args:          [][]byte{recipientAddr[:], metadata[:], amountBz},
+	}})
+	require.Error(t, err)
+
+	require.NoError(t, transfer(100))
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	// the spend limit is decreased by the outflow
+	authorization, _ := input.AuthzKeeper.GetAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(&types.MsgExecute{}))
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(50))).String(), authorization.(*types.ExecuteAuthorization).SpendLimit.String())
+
+	// exceeds the remaining spend limit
+	require.ErrorIs(t, transfer(51), types.ErrLimit)
+	require.Equal(t, math.NewInt(100), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	// the grant is deleted when the spend limit is used up
+	require.NoError(t, transfer(50))
+	authorization, _ = input.AuthzKeeper.GetAuthorization(ctx, grantee, granter, sdk.MsgTypeURL(&types.MsgExecute{}))
+	require.Nil(t, authorization)
+}
+
+func TestExecuteSpendLimit_SameAuthorization(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	granter, grantee, otherGrantee, recipient := addrs[0], addrs[1], addrs[2], addrs[3]
+	input.Faucet.Fund(ctx, granter, sdk.NewCoin(bondDenom, math.NewInt(1000)))
+
+	// both grantees have the same authorization from the granter
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	auth, err := types.NewExecuteAuthorization(ac, []types.ExecuteAuthorizationItem{
+		{ModuleAddress: vmtypes.StdAddress.String(), ModuleName: "coin", FunctionNames: []string{"transfer"}},
+	})
+	require.NoError(t, err)
+	auth.SpendLimit = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(150)))
+	require.NoError(t, input.AuthzKeeper.SaveGrant(ctx, otherGrantee, granter, auth, nil))
+	require.NoError(t, input.AuthzKeeper.SaveGrant(ctx, grantee, granter, auth, nil))
+
+	metadata, err := types.MetadataAddressFromDenom(bondDenom)
+	require.NoError(t, err)
+
+	recipientAddr, err := vmtypes.NewAccountAddressFromBytes(recipient)
+	require.NoError(t, err)
+
+	amountBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	execCtx := types.WithExecuteSpendTracker(ctx)
+	execCtx = types.WithExecuteGrant(execCtx, ac, grantee)
+	_, err = input.AuthzKeeper.DispatchActions(execCtx, grantee, []sdk.Msg{&types.MsgExecute{
+		Sender:        granter.String(),
+		ModuleAddress: vmtypes.StdAddress.String(),
+		ModuleName:    "coin",
+		FunctionName:  "transfer",
+		Args:          [][]byte{recipientAddr[:], metadata[:], amountBz},
+	}})
+	require.NoError(t, err)
+
+	// only the grant of the dispatching grantee is updated
+	msgType := sdk.MsgTypeURL(&types.MsgExecute{})
+	authorization, _ := input.AuthzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(50))).String(), authorization.(*types.ExecuteAuthorization).SpendLimit.String())
+
+	authorization, _ = input.AuthzKeeper.GetAuthorization(ctx, otherGrantee, granter, msgType)
+	require.Equal(t, auth.SpendLimit.String(), authorization.(*types.ExecuteAuthorization).SpendLimit.String())
+}
+
+func TestExecuteSpendLimit_MultipleMsgs(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	granter, grantee, recipient := addrs[0], addrs[1], addrs[2]
+	input.Faucet.Fund(ctx, granter, sdk.NewCoin(bondDenom, math.NewInt(1000)))
+
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	auth, err := types.NewExecuteAuthorization(ac, []types.ExecuteAuthorizationItem{
+		{ModuleAddress: vmtypes.StdAddress.String(), ModuleName: "coin", FunctionNames: []string{"transfer"}},
+	})
+	require.NoError(t, err)
+	auth.SpendLimit = sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100)))
+	require.NoError(t, input.AuthzKeeper.SaveGrant(ctx, grantee, granter, auth, nil))
+	require.NoError(t, input.AuthzKeeper.SaveGrant(ctx, grantee, granter, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), nil))
+
+	metadata, err := types.MetadataAddressFromDenom(bondDenom)
+	require.NoError(t, err)
+
+	recipientAddr, err := vmtypes.NewAccountAddressFromBytes(recipient)
+	require.NoError(t, err)
+
+	transferMsg := func(amount uint64) sdk.Msg {
+		amountBz, err := vmtypes.SerializeUint64(amount)
+		require.NoError(t, err)
+
+		return &types.MsgExecute{
+			Sender:        granter.String(),
+			ModuleAddress: vmtypes.StdAddress.String(),
+			ModuleName:    "coin",
+			FunctionName:  "transfer",
+			Args:          [][]byte{recipientAddr[:], metadata[:], amountBz},
+		}
+	}
+	exec := func(msgs ...sdk.Msg) error {
+		cacheCtx, write := ctx.CacheContext()
+		cacheCtx = types.WithExecuteSpendTracker(cacheCtx)
+		cacheCtx = types.WithExecuteGrant(cacheCtx, ac, grantee)
+		if _, err := input.AuthzKeeper.DispatchActions(cacheCtx, grantee, msgs); err != nil {
+			return err
+		}
+
+		write()
+		return nil
+	}
+
+	// the spend limit applies only to the move execution, not to the bank send
+	require.NoError(t, exec(
+		&banktypes.MsgSend{
+			FromAddress: granter.String(),
+			ToAddress:   recipient.String(),
+			Amount:      sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(500))),
+		},
+		transferMsg(60),
+	))
+	require.Equal(t, math.NewInt(560), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	msgType := sdk.MsgTypeURL(&types.MsgExecute{})
+	authorization, _ := input.AuthzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(40))).String(), authorization.(*types.ExecuteAuthorization).SpendLimit.String())
+
+	// the executions in the same exec message cannot overspend together
+	require.ErrorIs(t, exec(transferMsg(30), transferMsg(30)), types.ErrLimit)
+	require.Equal(t, math.NewInt(560), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	authorization, _ = input.AuthzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(40))).String(), authorization.(*types.ExecuteAuthorization).SpendLimit.String())
+
+	require.NoError(t, exec(transferMsg(20), transferMsg(20)))
+	require.Equal(t, math.NewInt(600), input.BankKeeper.GetBalance(ctx, recipient, bondDenom).Amount)
+
+	authorization, _ = input.AuthzKeeper.GetAuthorization(ctx, grantee, granter, msgType)
+	require.Nil(t, authorization)
+}
@@ -11,6 +11,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
+	cosmosbanktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	banktypes "github.com/initia-labs/initia/x/bank/types"
 	"github.com/initia-labs/initia/x/move/types"
@@ -182,6 +183,32 @@ func (k MoveBankKeeper) GetPaginatedBalances(ctx context.Context, pageReq *query
 	return sdk.Coins(coins).Sort(), pageRes, nil
 }
 
+// GetPaginatedDenomOwners returns the owners of the primary fungible stores of the denom
+// with their balances.
+func (k MoveBankKeeper) GetPaginatedDenomOwners(ctx context.Context, pageReq *query.PageRequest, denom string) ([]*cosmosbanktypes.DenomOwner, *query.PageResponse, error) {
+	metadata, err := types.MetadataAddressFromDenom(denom)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return query.CollectionPaginate(ctx, k.DenomOwners, pageReq, func(key collections.Pair[[]byte, []byte], _ collections.NoValue) (*cosmosbanktypes.DenomOwner, error) {
+		owner, err := vmtypes.NewAccountAddressFromBytes(key.K2())
+		if err != nil {
+			return nil, err
+		}
+
+		_, balance, err := k.Balance(ctx, types.UserDerivedObjectAddress(owner, metadata))
+		if err != nil {
+			return nil, err
+		}
+
+		return &cosmosbanktypes.DenomOwner{
+			Address: types.ConvertVMAddressToSDKAddress(owner).String(),
+			Balance: sdk.NewCoin(denom, balance),
+		}, nil
+	}, query.WithCollectionPaginationPairPrefix[[]byte, []byte](metadata.Bytes()))
+}
+
 // GetSupply return move coin supply
 func (k MoveBankKeeper) GetSupply(
 	ctx context.Context,
@@ -51,6 +51,7 @@ import (
 
 	initiaapp "github.com/initia-labs/initia/app"
 	initiaappparams "github.com/initia-labs/initia/app/params"
+	authzmodule "github.com/initia-labs/initia/x/authz/module"
 	"github.com/initia-labs/initia/x/bank"
 	movebank "github.com/initia-labs/initia/x/bank/keeper"
 	"github.com/initia-labs/initia/x/distribution"
@@ -82,6 +83,7 @@ import (
 
 var ModuleBasics = module.NewBasicManager(
 	auth.AppModuleBasic{},
+	authzmodule.AppModuleBasic{},
 	bank.AppModuleBasic{},
 	staking.AppModuleBasic{},
 	reward.AppModuleBasic{},
@@ -226,6 +228,7 @@ type TestKeepers struct {
 	DistKeeper     distrkeeper.Keeper
 	BankKeeper     bankkeeper.Keeper
 	GovKeeper      govkeeper.Keeper
+	AuthzKeeper    authzkeeper.Keeper
 	MoveKeeper     movekeeper.Keeper
 	OracleKeeper   oraclekeeper.Keeper
 	EncodingConfig initiaappparams.EncodingConfig
@@ -299,6 +302,9 @@ func _createTestInput(
 		stakingtypes.NotBondedPoolName:  {authtypes.Burner, authtypes.Staking},
 		govtypes.ModuleName:             {authtypes.Burner},
 		movetypes.MoveStakingModuleName: nil,
+		movetypes.MoveRevenueModuleName: nil,
+		movetypes.MoveCronModuleName:    nil,
+		movetypes.MovePublishModuleName: nil,
 
 		// for testing
 		authtypes.Minter: {authtypes.Minter, authtypes.Burner},
@@ -338,6 +344,7 @@ func _createTestInput(
 		accountKeeper,
 		bankKeeper,
 		movekeeper.NewVotingPowerKeeper(moveKeeper),
+		movekeeper.NewDexKeeper(moveKeeper),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 		vc, cc,
 	)
@@ -450,6 +457,14 @@ func _createTestInput(
 	require.NoError(t, govKeeper.ProposalID.Set(ctx, govtypesv1.DefaultStartingProposalID))
 	require.NoError(t, govKeeper.Params.Set(ctx, customgovtypes.DefaultParams()))
 
+	authzKeeper := authzkeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[authzkeeper.StoreKey]),
+		appCodec,
+		msgRouter,
+		accountKeeper,
+	)
+	moveKeeper.SetAuthzKeeper(authzKeeper)
+
 	cfg := sdk.GetConfig()
 	cfg.SetAddressVerifier(initiaapp.VerifyAddressLen())
 
@@ -470,6 +485,7 @@ func _createTestInput(
 		MoveKeeper:    *moveKeeper,
 		BankKeeper:    bankKeeper,
 		GovKeeper:     *govKeeper,
+		AuthzKeeper:   authzKeeper,
 		OracleKeeper:  oracleKeeper,
 		// NftTransferKeeper: nftTransferKeeper,
 		EncodingConfig: encodingConfig,
@@ -485,6 +501,11 @@ var stdCoinTestModule []byte
 var basicCoinMintScript []byte
 var tableGeneratorModule []byte
 var testAddressModule []byte
+var testAuthenticatorModule []byte
+var testSponsorModule []byte
+var testUpgradeModule []byte
+var testUpgradeV2Module []byte
+var testUpgradeDependentModule []byte
 
 func init() {
 	basicCoinModule = ReadMoveFile("BasicCoin")
@@ -492,6 +513,11 @@ func init() {
 	stdCoinTestModule = ReadMoveFile("StdCoinTest")
 	tableGeneratorModule = ReadMoveFile("TableGenerator")
 	testAddressModule = ReadMoveFile("TestAddress")
+	testAuthenticatorModule = ReadMoveFile("TestAuthenticator")
+	testSponsorModule = ReadMoveFile("TestSponsor")
+	testUpgradeModule = ReadMoveFile("TestUpgrade")
+	testUpgradeV2Module = ReadMoveFile("TestUpgradeV2")
+	testUpgradeDependentModule = ReadMoveFile("TestUpgradeDependent")
 
 	basicCoinMintScript = ReadScriptFile("main")
 }
@@ -11,4 +11,8 @@ rm -rf ../binaries
 mkdir ../binaries
 
 find build/test1/bytecode_modules -type f -name "*.mv"  -depth 1 -exec cp {} ../binaries \;
-find build/test1/bytecode_scripts -type f -name "*.mv"  -depth 1 -exec cp {} ../binaries \;
\ No newline at end of file
+find build/test1/bytecode_scripts -type f -name "*.mv"  -depth 1 -exec cp {} ../binaries \;
+
+# the upgraded module, which has the same name with a module of the main package
+(cd upgrade && initiad move build)
+cp upgrade/build/test_upgrade/bytecode_modules/TestUpgrade.mv ../binaries/TestUpgradeV2.mv
@@ -0,0 +1,22 @@
+module TestAccount::TestAuthenticator {
+    use std::hash;
+
+    // Accepts the signature which is the sha256 hash of the sign bytes.
+    #[view]
+    public fun authenticate(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool {
+        let _ = account;
+        hash::sha2_256(sign_bytes) == signature
+    }
+
+    // Not an authenticator, which is not a view function.
+    public fun not_view(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool {
+        let _ = account;
+        sign_bytes == signature
+    }
+
+    // Not an authenticator, which has the wrong parameters.
+    #[view]
+    public fun wrong_params(sign_bytes: vector<u8>, signature: vector<u8>): bool {
+        sign_bytes == signature
+    }
+}
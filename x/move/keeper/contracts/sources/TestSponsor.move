@@ -0,0 +1,43 @@
+module TestAccount::TestSponsor {
+    use std::string::{Self, String};
+    use std::vector;
+
+    // Pays the fees of the single bank send txs which use at most
+    // 1000 of each fee denom and 1,000,000 gas.
+    #[view]
+    public fun sponsor(
+        sponsor: address,
+        payer: address,
+        msg_types: vector<String>,
+        fee_denoms: vector<String>,
+        fee_amounts: vector<u64>,
+        gas_limit: u64,
+    ): bool {
+        let _ = sponsor;
+        let _ = payer;
+        let _ = fee_denoms;
+        if (vector::length(&msg_types) != 1 || gas_limit > 1000000) {
+            return false
+        };
+
+        if (*vector::borrow(&msg_types, 0) != string::utf8(b"/cosmos.bank.v1beta1.MsgSend")) {
+            return false
+        };
+
+        let i = 0;
+        while (i < vector::length(&fee_amounts)) {
+            if (*vector::borrow(&fee_amounts, i) > 1000) {
+                return false
+            };
+            i = i + 1;
+        };
+
+        true
+    }
+
+    // Not a sponsor policy, which has the wrong parameters.
+    #[view]
+    public fun wrong_params(sponsor: address, payer: address): bool {
+        sponsor != payer
+    }
+}
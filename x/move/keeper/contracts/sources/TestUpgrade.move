@@ -0,0 +1,13 @@
+module TestAccount::TestUpgrade {
+    struct Config has key {
+        value: u64,
+    }
+
+    public fun value(): u64 {
+        1
+    }
+
+    public fun double(x: u64): u64 {
+        x * 2
+    }
+}
@@ -0,0 +1,7 @@
+module TestAccount::TestUpgradeDependent {
+    use TestAccount::TestUpgrade;
+
+    public fun value(): u64 {
+        TestUpgrade::value()
+    }
+}
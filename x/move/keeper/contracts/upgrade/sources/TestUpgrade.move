@@ -0,0 +1,12 @@
+// The incompatible upgrade of TestUpgrade, which removes `value`, changes
+// the signature of `double` and adds a field to `Config`.
+module TestAccount::TestUpgrade {
+    struct Config has key {
+        value: u64,
+        enabled: bool,
+    }
+
+    public fun double(x: u128): u128 {
+        x * 2
+    }
+}
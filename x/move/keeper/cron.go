@@ -0,0 +1,379 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// RegisterCronJob escrows the deposit and schedules the first run of the cron job
+// at `interval` blocks after the current height.
+func (k Keeper) RegisterCronJob(ctx context.Context, job types.CronJob) (uint64, error) {
+	creator, err := k.ac.StringToBytes(job.Creator)
+	if err != nil {
+		return 0, err
+	}
+
+	moduleAddr, err := types.AccAddressFromString(k.ac, job.ModuleAddress)
+	if err != nil {
+		return 0, err
+	}
+
+	if found, err := k.HasModule(ctx, moduleAddr, job.ModuleName); err != nil {
+		return 0, err
+	} else if !found {
+		return 0, types.ErrModuleNotFound.Wrapf("%s::%s", moduleAddr, job.ModuleName)
+	}
+
+	if _, err := types.TypeTagsFromTypeArgs(job.TypeArgs); err != nil {
+		return 0, err
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if job.GasLimit > params.CronMaxGasPerBlock {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "gas limit exceeds the cron max gas per block %d", params.CronMaxGasPerBlock)
+	}
+
+	if job.Deposit.Denom != params.BaseDenom {
+		return 0, errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit denom must be %s", params.BaseDenom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, creator, types.MoveCronModuleName, sdk.NewCoins(job.Deposit)); err != nil {
+		return 0, err
+	}
+
+	id, err := k.CronJobSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	job.Id = id
+	job.NextRunHeight = sdk.UnwrapSDKContext(ctx).BlockHeight() + int64(job.Interval)
+	job.FailureCount = 0
+	if err := k.setCronJob(ctx, job); err != nil {
+		return 0, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeRegisterCronJob,
+		sdk.NewAttribute(types.AttributeKeyCronJobId, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyCreator, job.Creator),
+		sdk.NewAttribute(types.AttributeKeyModuleAddr, moduleAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyModuleName, job.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyFunctionName, job.FunctionName),
+	))
+
+	return id, nil
+}
+
+// GetCronJob returns the cron job of the given id.
+func (k Keeper) GetCronJob(ctx context.Context, id uint64) (types.CronJob, error) {
+	job, err := k.CronJobs.Get(ctx, id)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.CronJob{}, types.ErrCronJobNotFound.Wrapf("id %d", id)
+	}
+
+	return job, err
+}
+
+// DepositCronJob adds the prepaid fee to the cron job.
+func (k Keeper) DepositCronJob(ctx context.Context, sender sdk.AccAddress, id uint64, amount sdk.Coin) error {
+	job, err := k.GetCronJob(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if amount.Denom != job.Deposit.Denom {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidCoins, "deposit denom must be %s", job.Deposit.Denom)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.MoveCronModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	job.Deposit = job.Deposit.Add(amount)
+	return k.CronJobs.Set(ctx, id, job)
+}
+
+// DeregisterCronJob removes the cron job and refunds the remaining deposit to the creator.
+func (k Keeper) DeregisterCronJob(ctx context.Context, id uint64, reason string) error {
+	job, err := k.GetCronJob(ctx, id)
+	if err != nil {
+		return err
+	}
+
+	if err := k.CronQueue.Remove(ctx, collections.Join(job.NextRunHeight, id)); err != nil {
+		return err
+	}
+
+	if err := k.CronJobs.Remove(ctx, id); err != nil {
+		return err
+	}
+
+	if job.Deposit.IsPositive() {
+		creator, err := k.ac.StringToBytes(job.Creator)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.MoveCronModuleName, creator, sdk.NewCoins(job.Deposit)); err != nil {
+			return err
+		}
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeDeregisterCronJob,
+		sdk.NewAttribute(types.AttributeKeyCronJobId, strconv.FormatUint(id, 10)),
+		sdk.NewAttribute(types.AttributeKeyReason, reason),
+		sdk.NewAttribute(types.AttributeKeyRefund, job.Deposit.String()),
+	))
+
+	return nil
+}
+
+// RunCronJobs executes the cron jobs scheduled at or before the current height
+// within the `CronMaxGasPerBlock` limit, up to `CronMaxJobsPerBlock` jobs. The
+// gas consumed outside of the vm (e.g. fee payment and rescheduling) is also
+// counted against the limit. The jobs which do not fit in the remaining gas of
+// the block are postponed to the next block, and the jobs whose gas limit
+// exceeds `CronMaxGasPerBlock` are deregistered with a refund.
+func (k Keeper) RunCronJobs(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	if params.CronMaxGasPerBlock == 0 {
+		return nil
+	}
+
+	height := sdkCtx.BlockHeight()
+
+	var dueJobIds []uint64
+	err = k.CronQueue.Walk(ctx, collections.NewPrefixUntilPairRange[int64, uint64](height), func(key collections.Pair[int64, uint64]) (bool, error) {
+		dueJobIds = append(dueJobIds, key.K2())
+		return len(dueJobIds) >= types.CronMaxJobsPerBlock, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	remainingGas := params.CronMaxGasPerBlock
+	for _, id := range dueJobIds {
+		gasBefore := sdkCtx.GasMeter().GasConsumed()
+
+		vmGasUsed, err := k.processCronJob(sdkCtx, params, id, remainingGas)
+		if err != nil {
+			return err
+		}
+
+		gasUsed := vmGasUsed + sdkCtx.GasMeter().GasConsumed() - gasBefore
+		if gasUsed >= remainingGas {
+			remainingGas = 0
+		} else {
+			remainingGas -= gasUsed
+		}
+	}
+
+	return nil
+}
+
+// processCronJob runs the due cron job when it fits in the remaining gas of the
+// block, and returns the gas used by the vm execution.
+func (k Keeper) processCronJob(ctx sdk.Context, params types.Params, id uint64, remainingGas uint64) (uint64, error) {
+	job, err := k.GetCronJob(ctx, id)
+	if err != nil {
+		return 0, err
+	}
+
+	// deregister the job when it can never fit in a block, e.g. after the
+	// cron max gas per block is lowered
+	if job.GasLimit > params.CronMaxGasPerBlock {
+		return 0, k.DeregisterCronJob(ctx, id, types.AttributeValueReasonGasLimitExceeded)
+	}
+
+	// postpone the job to the next block when the remaining gas is not enough
+	if job.GasLimit > remainingGas {
+		return 0, k.rescheduleCronJob(ctx, job, ctx.BlockHeight()+1)
+	}
+
+	// deregister the job when the deposit cannot cover the gas limit
+	maxFee := computeCronFee(params.BaseMinGasPrice, max(job.GasLimit, types.CronMinGasPerRun))
+	if job.Deposit.Amount.LT(maxFee) {
+		return 0, k.DeregisterCronJob(ctx, id, types.AttributeValueReasonInsufficientDeposit)
+	}
+
+	run, err := k.runCronJob(ctx, job, params.BaseMinGasPrice)
+	if err != nil {
+		return 0, err
+	}
+
+	job.Deposit = job.Deposit.Sub(run.Fee)
+	if run.Success {
+		job.FailureCount = 0
+	} else {
+		job.FailureCount++
+	}
+
+	if err := k.recordCronRun(ctx, run); err != nil {
+		return 0, err
+	}
+
+	if job.FailureCount >= params.CronMaxFailures {
+		if err := k.CronJobs.Set(ctx, id, job); err != nil {
+			return 0, err
+		}
+
+		return run.GasUsed, k.DeregisterCronJob(ctx, id, types.AttributeValueReasonMaxFailures)
+	}
+
+	return run.GasUsed, k.rescheduleCronJob(ctx, job, ctx.BlockHeight()+int64(job.Interval))
+}
+
+// runCronJob executes the cron job with its gas limit and charges the fee for the used gas
+// from the deposit. At least `CronMinGasPerRun` gas is charged per run, so a job which
+// fails before using any gas still pays for its run. The state changes are discarded
+// when the execution fails.
+func (k Keeper) runCronJob(ctx sdk.Context, job types.CronJob, gasPrice sdkmath.LegacyDec) (types.CronRun, error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	gasMeter := storetypes.NewGasMeter(job.GasLimit)
+	cacheCtx = cacheCtx.WithGasMeter(gasMeter)
+
+	execErr := k.executeCronJob(cacheCtx, job)
+	if execErr == nil {
+		writeCache()
+	}
+
+	gasUsed := gasMeter.GasConsumedToLimit()
+	fee := sdk.NewCoin(job.Deposit.Denom, computeCronFee(gasPrice, max(gasUsed, types.CronMinGasPerRun)))
+	if fee.Amount.GT(job.Deposit.Amount) {
+		fee = job.Deposit
+	}
+
+	if fee.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.MoveCronModuleName, k.feeCollector, sdk.NewCoins(fee)); err != nil {
+			return types.CronRun{}, err
+		}
+	}
+
+	run := types.CronRun{
+		JobId:   job.Id,
+		Height:  ctx.BlockHeight(),
+		Success: execErr == nil,
+		GasUsed: gasUsed,
+		Fee:     fee,
+	}
+	if execErr != nil {
+		run.Error = execErr.Error()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCronJobRun,
+		sdk.NewAttribute(types.AttributeKeyCronJobId, strconv.FormatUint(job.Id, 10)),
+		sdk.NewAttribute(types.AttributeKeySuccess, strconv.FormatBool(run.Success)),
+		sdk.NewAttribute(types.AttributeKeyGasUsed, strconv.FormatUint(run.GasUsed, 10)),
+		sdk.NewAttribute(types.AttributeKeyFee, run.Fee.String()),
+		sdk.NewAttribute(types.AttributeKeyError, run.Error),
+	))
+
+	return run, nil
+}
+
+func (k Keeper) executeCronJob(ctx sdk.Context, job types.CronJob) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			if oog, ok := r.(storetypes.ErrorOutOfGas); ok {
+				err = errorsmod.Wrapf(sdkerrors.ErrOutOfGas, "out of gas in location: %v", oog.Descriptor)
+			} else {
+				err = errorsmod.Wrap(sdkerrors.ErrPanic, fmt.Sprint(r))
+			}
+		}
+	}()
+
+	sender, err := types.AccAddressFromString(k.ac, job.Creator)
+	if err != nil {
+		return err
+	}
+
+	moduleAddr, err := types.AccAddressFromString(k.ac, job.ModuleAddress)
+	if err != nil {
+		return err
+	}
+
+	typeTags, err := types.TypeTagsFromTypeArgs(job.TypeArgs)
+	if err != nil {
+		return err
+	}
+
+	return k.ExecuteEntryFunction(
+		ctx,
+		sender,
+		moduleAddr,
+		job.ModuleName,
+		job.FunctionName,
+		typeTags,
+		job.Args,
+	)
+}
+
+func (k Keeper) setCronJob(ctx context.Context, job types.CronJob) error {
+	if err := k.CronJobs.Set(ctx, job.Id, job); err != nil {
+		return err
+	}
+
+	return k.CronQueue.Set(ctx, collections.Join(job.NextRunHeight, job.Id))
+}
+
+func (k Keeper) rescheduleCronJob(ctx context.Context, job types.CronJob, nextRunHeight int64) error {
+	if err := k.CronQueue.Remove(ctx, collections.Join(job.NextRunHeight, job.Id)); err != nil {
+		return err
+	}
+
+	job.NextRunHeight = nextRunHeight
+	return k.setCronJob(ctx, job)
+}
+
+// recordCronRun stores the run and prunes the oldest runs of the job
+// exceeding `CronRunHistoryLimit`.
+func (k Keeper) recordCronRun(ctx context.Context, run types.CronRun) error {
+	if err := k.CronRuns.Set(ctx, collections.Join(run.JobId, run.Height), run); err != nil {
+		return err
+	}
+
+	var keys []collections.Pair[uint64, int64]
+	err := k.CronRuns.Walk(ctx, collections.NewPrefixedPairRange[uint64, int64](run.JobId), func(key collections.Pair[uint64, int64], _ types.CronRun) (bool, error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < len(keys)-types.CronRunHistoryLimit; i++ {
+		if err := k.CronRuns.Remove(ctx, keys[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func computeCronFee(gasPrice sdkmath.LegacyDec, gas uint64) sdkmath.Int {
+	return gasPrice.MulInt(sdkmath.NewIntFromUint64(gas)).Ceil().TruncateInt()
+}
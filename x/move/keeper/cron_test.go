@@ -0,0 +1,252 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func registerMintCronJob(t *testing.T, ctx sdk.Context, input TestKeepers, creator sdk.AccAddress, args [][]byte, gasLimit uint64) uint64 {
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+	res, err := ms.RegisterCronJob(ctx, &types.MsgRegisterCronJob{
+		Sender:        creator.String(),
+		ModuleAddress: "0x1",
+		ModuleName:    "BasicCoin",
+		FunctionName:  "mint",
+		TypeArgs:      []string{"0x1::BasicCoin::Initia"},
+		Args:          args,
+		Interval:      2,
+		GasLimit:      gasLimit,
+		Deposit:       sdk.NewInt64Coin(bondDenom, 1_000_000),
+	})
+	require.NoError(t, err)
+
+	return res.Id
+}
+
+func Test_RunCronJobs(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, _, creator := keyPubAddr()
+	input.Faucet.Fund(ctx, creator, sdk.NewInt64Coin(bondDenom, 1_000_000))
+
+	argBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	height := ctx.BlockHeight()
+	id := registerMintCronJob(t, ctx, input, creator, [][]byte{argBz}, 1_000_000)
+	require.True(t, input.BankKeeper.GetBalance(ctx, creator, bondDenom).IsZero())
+
+	// not scheduled yet
+	err = input.MoveKeeper.RunCronJobs(ctx.WithBlockHeight(height + 1))
+	require.NoError(t, err)
+
+	job, err := input.MoveKeeper.GetCronJob(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, height+2, job.NextRunHeight)
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	feeBefore := input.BankKeeper.GetBalance(ctx, feeCollectorAddr, bondDenom)
+
+	ctx = ctx.WithBlockHeight(height + 2)
+	err = input.MoveKeeper.RunCronJobs(ctx)
+	require.NoError(t, err)
+
+	// the job is executed and rescheduled
+	job, err = input.MoveKeeper.GetCronJob(ctx, id)
+	require.NoError(t, err)
+	require.Equal(t, height+4, job.NextRunHeight)
+	require.Equal(t, uint64(0), job.FailureCount)
+
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	res, err := querier.CronRuns(ctx, &types.QueryCronRunsRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, res.CronRuns, 1)
+
+	run := res.CronRuns[0]
+	require.True(t, run.Success)
+	require.Equal(t, height+2, run.Height)
+	require.True(t, run.Fee.IsPositive())
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1_000_000).Sub(run.Fee), job.Deposit)
+	require.Equal(t, feeBefore.Add(run.Fee), input.BankKeeper.GetBalance(ctx, feeCollectorAddr, bondDenom))
+
+	output, err := input.MoveKeeper.ExecuteViewFunctionJSON(
+		ctx,
+		vmtypes.StdAddress,
+		"BasicCoin",
+		"get",
+		[]vmtypes.TypeTag{MustConvertStringToTypeTag("0x1::BasicCoin::Initia")},
+		[]string{`"` + types.ConvertSDKAddressToVMAddress(creator).String() + `"`},
+	)
+	require.NoError(t, err)
+	require.Equal(t, `"100"`, output.Ret)
+
+	// deregister by the creator and refund the remaining deposit
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+	_, err = ms.DeregisterCronJob(ctx, &types.MsgDeregisterCronJob{Sender: feeCollectorAddr.String(), Id: id})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.DeregisterCronJob(ctx, &types.MsgDeregisterCronJob{Sender: creator.String(), Id: id})
+	require.NoError(t, err)
+	require.Equal(t, job.Deposit, input.BankKeeper.GetBalance(ctx, creator, bondDenom))
+
+	_, err = input.MoveKeeper.GetCronJob(ctx, id)
+	require.ErrorIs(t, err, types.ErrCronJobNotFound)
+}
+
+func Test_RunCronJobs_MaxFailures(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, _, creator := keyPubAddr()
+	input.Faucet.Fund(ctx, creator, sdk.NewInt64Coin(bondDenom, 1_000_000))
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	// missing argument makes the execution fail
+	height := ctx.BlockHeight()
+	id := registerMintCronJob(t, ctx, input, creator, nil, 1_000_000)
+
+	for i := uint64(1); i <= params.CronMaxFailures; i++ {
+		err = input.MoveKeeper.RunCronJobs(ctx.WithBlockHeight(height + int64(2*i)))
+		require.NoError(t, err)
+	}
+
+	_, err = input.MoveKeeper.GetCronJob(ctx, id)
+	require.ErrorIs(t, err, types.ErrCronJobNotFound)
+
+	// the run history is kept after the deregistration
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	res, err := querier.CronRuns(ctx, &types.QueryCronRunsRequest{Id: id})
+	require.NoError(t, err)
+	require.Len(t, res.CronRuns, int(params.CronMaxFailures))
+
+	// a failed run pays at least the minimum fee
+	minFee := params.BaseMinGasPrice.MulInt64(int64(types.CronMinGasPerRun)).Ceil().TruncateInt()
+
+	fees := sdk.NewInt64Coin(bondDenom, 0)
+	for _, run := range res.CronRuns {
+		require.False(t, run.Success)
+		require.NotEmpty(t, run.Error)
+		require.True(t, run.Fee.Amount.GTE(minFee))
+		fees = fees.Add(run.Fee)
+	}
+
+	// the remaining deposit is refunded
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1_000_000).Sub(fees), input.BankKeeper.GetBalance(ctx, creator, bondDenom))
+}
+
+func Test_RunCronJobs_GasCap(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.CronMaxGasPerBlock = 1_000_001
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	_, _, creator := keyPubAddr()
+	input.Faucet.Fund(ctx, creator, sdk.NewInt64Coin(bondDenom, 2_000_000))
+
+	argBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	height := ctx.BlockHeight()
+	id1 := registerMintCronJob(t, ctx, input, creator, [][]byte{argBz}, 1_000_000)
+	id2 := registerMintCronJob(t, ctx, input, creator, [][]byte{argBz}, 1_000_000)
+
+	err = input.MoveKeeper.RunCronJobs(ctx.WithBlockHeight(height + 2))
+	require.NoError(t, err)
+
+	// the second job does not fit in the remaining gas and is postponed
+	job1, err := input.MoveKeeper.GetCronJob(ctx, id1)
+	require.NoError(t, err)
+	require.Equal(t, height+4, job1.NextRunHeight)
+
+	job2, err := input.MoveKeeper.GetCronJob(ctx, id2)
+	require.NoError(t, err)
+	require.Equal(t, height+3, job2.NextRunHeight)
+
+	err = input.MoveKeeper.RunCronJobs(ctx.WithBlockHeight(height + 3))
+	require.NoError(t, err)
+
+	job2, err = input.MoveKeeper.GetCronJob(ctx, id2)
+	require.NoError(t, err)
+	require.Equal(t, height+5, job2.NextRunHeight)
+
+	_, err = input.MoveKeeper.RegisterCronJob(ctx, types.CronJob{
+		Creator:       creator.String(),
+		ModuleAddress: "0x1",
+		ModuleName:    "BasicCoin",
+		FunctionName:  "mint",
+		Interval:      1,
+		GasLimit:      params.CronMaxGasPerBlock + 1,
+		Deposit:       sdk.NewCoin(bondDenom, math.NewInt(1)),
+	})
+	require.Error(t, err)
+}
+
+func Test_RunCronJobs_GasLimitExceeded(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, _, creator := keyPubAddr()
+	input.Faucet.Fund(ctx, creator, sdk.NewInt64Coin(bondDenom, 1_000_000))
+
+	argBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	height := ctx.BlockHeight()
+	id := registerMintCronJob(t, ctx, input, creator, [][]byte{argBz}, 1_000_000)
+
+	// lower the cron max gas per block below the gas limit of the job
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.CronMaxGasPerBlock = 500_000
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	err = input.MoveKeeper.RunCronJobs(ctx.WithBlockHeight(height + 2))
+	require.NoError(t, err)
+
+	// the job is deregistered and the whole deposit is refunded
+	_, err = input.MoveKeeper.GetCronJob(ctx, id)
+	require.ErrorIs(t, err, types.ErrCronJobNotFound)
+	require.Equal(t, sdk.NewInt64Coin(bondDenom, 1_000_000), input.BankKeeper.GetBalance(ctx, creator, bondDenom))
+}
+
+func Test_RunCronJobs_MaxJobsPerBlock(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	numJobs := types.CronMaxJobsPerBlock + 1
+
+	_, _, creator := keyPubAddr()
+	input.Faucet.Fund(ctx, creator, sdk.NewInt64Coin(bondDenom, int64(numJobs)*1_000_000))
+
+	// missing argument makes the execution fail, which keeps the vm gas small
+	height := ctx.BlockHeight()
+	ids := make([]uint64, numJobs)
+	for i := range ids {
+		ids[i] = registerMintCronJob(t, ctx, input, creator, nil, 20_000)
+	}
+
+	err := input.MoveKeeper.RunCronJobs(ctx.WithBlockHeight(height + 2))
+	require.NoError(t, err)
+
+	// only `CronMaxJobsPerBlock` jobs are executed and the rest stay due
+	for i, id := range ids {
+		job, err := input.MoveKeeper.GetCronJob(ctx, id)
+		require.NoError(t, err)
+
+		if i < types.CronMaxJobsPerBlock {
+			require.Equal(t, height+4, job.NextRunHeight)
+		} else {
+			require.Equal(t, height+2, job.NextRunHeight)
+		}
+	}
+}
@@ -0,0 +1,175 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// fungibleAssetEvent is the json data of fungible_asset::DepositEvent and fungible_asset::WithdrawEvent.
+type fungibleAssetEvent struct {
+	StoreAddr    string `json:"store_addr"`
+	MetadataAddr string `json:"metadata_addr"`
+}
+
+// updateDenomOwners updates the denom owner index of the primary fungible
+// stores touched by the fungible asset deposit and withdraw events.
+func (k Keeper) updateDenomOwners(ctx context.Context, eventDatas []string) error {
+	if len(eventDatas) == 0 {
+		return nil
+	}
+
+	// dedup the stores in the event order to keep the update deterministic
+	visited := make(map[vmtypes.AccountAddress]bool)
+	for _, eventData := range eventDatas {
+		var event fungibleAssetEvent
+		if err := json.Unmarshal([]byte(eventData), &event); err != nil {
+			return err
+		}
+
+		storeAddr, err := vmtypes.NewAccountAddress(event.StoreAddr)
+		if err != nil {
+			return err
+		}
+		if visited[storeAddr] {
+			continue
+		}
+		visited[storeAddr] = true
+
+		metadata, err := vmtypes.NewAccountAddress(event.MetadataAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.updateDenomOwner(ctx, storeAddr, metadata); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// updateDenomOwner adds the owner of the primary fungible store to the index
+// when the store has a positive balance, and removes the owner otherwise.
+// Stores which are not primary fungible stores are ignored.
+func (k Keeper) updateDenomOwner(ctx context.Context, storeAddr, metadata vmtypes.AccountAddress) error {
+	bz, err := k.GetResourceBytes(ctx, storeAddr, vmtypes.StructTag{
+		Address:  vmtypes.StdAddress,
+		Module:   types.MoveModuleNameObject,
+		Name:     types.ResourceNameObjectCore,
+		TypeArgs: []vmtypes.TypeTag{},
+	})
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	owner, err := types.ReadOwnerFromObjectCore(bz)
+	if err != nil {
+		return err
+	}
+
+	if types.UserDerivedObjectAddress(owner, metadata) != storeAddr {
+		return nil
+	}
+
+	_, balance, err := NewMoveBankKeeper(&k).Balance(ctx, storeAddr)
+	if err != nil {
+		return err
+	}
+
+	key := collections.Join(metadata.Bytes(), owner.Bytes())
+	if balance.IsPositive() {
+		return k.DenomOwners.Set(ctx, key)
+	}
+
+	return k.DenomOwners.Remove(ctx, key)
+}
+
+// rebuildDenomOwners rebuilds the denom owner index from the user stores
+// table of primary_fungible_store::ModuleStore.
+func (k Keeper) rebuildDenomOwners(ctx context.Context) error {
+	if err := k.DenomOwners.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	bz, err := k.GetResourceBytes(ctx, vmtypes.StdAddress, vmtypes.StructTag{
+		Address:  vmtypes.StdAddress,
+		Module:   types.MoveModuleNamePrimaryFungibleStore,
+		Name:     types.ResourceNameModuleStore,
+		TypeArgs: []vmtypes.TypeTag{},
+	})
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	userStoresTable, err := types.ReadUserStoresTableHandleFromModuleStore(bz)
+	if err != nil {
+		return err
+	}
+
+	// collect the user stores tables first; the store must not be modified while iterating
+	userStores := make(map[vmtypes.AccountAddress]vmtypes.AccountAddress)
+	var owners []vmtypes.AccountAddress
+	prefix := types.GetTableEntryPrefix(userStoresTable)
+	err = k.VMStore.Walk(ctx, new(collections.Range[[]byte]).Prefix(collections.NewPrefix[[]byte](prefix)), func(key, value []byte) (bool, error) {
+		owner, err := vmtypes.NewAccountAddressFromBytes(key[len(prefix):])
+		if err != nil {
+			return true, err
+		}
+
+		tableAddr, err := types.ReadTableHandleFromTable(value)
+		if err != nil {
+			return true, err
+		}
+
+		owners = append(owners, owner)
+		userStores[owner] = tableAddr
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	moveBankKeeper := NewMoveBankKeeper(&k)
+	for _, owner := range owners {
+		var keys []collections.Pair[[]byte, []byte]
+		prefix := types.GetTableEntryPrefix(userStores[owner])
+		err := k.VMStore.Walk(ctx, new(collections.Range[[]byte]).Prefix(collections.NewPrefix[[]byte](prefix)), func(_, value []byte) (bool, error) {
+			storeAddr, err := vmtypes.NewAccountAddressFromBytes(value)
+			if err != nil {
+				return true, err
+			}
+
+			metadata, balance, err := moveBankKeeper.Balance(ctx, storeAddr)
+			if err != nil {
+				return true, err
+			}
+
+			if balance.IsPositive() {
+				keys = append(keys, collections.Join(metadata.Bytes(), owner.Bytes()))
+			}
+
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range keys {
+			if err := k.DenomOwners.Set(ctx, key); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
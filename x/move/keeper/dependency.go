@@ -0,0 +1,221 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"cosmossdk.io/collections"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// indexModuleDependencies replaces the dependency edges of the module
+// with the module handles read from the module bytes.
+func (k Keeper) indexModuleDependencies(ctx context.Context, moduleId vmtypes.ModuleId, code []byte) error {
+	dependencies, err := types.ReadModuleDependencies(code, moduleId)
+	if err != nil {
+		return err
+	}
+
+	dependent := moduleId.String()
+
+	// collect the old edges first; the store must not be modified while iterating
+	var oldDependencies []string
+	err = k.ModuleDependencies.Walk(ctx, collections.NewPrefixedPairRange[string, string](dependent), func(key collections.Pair[string, string]) (bool, error) {
+		oldDependencies = append(oldDependencies, key.K2())
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, dependency := range oldDependencies {
+		if err := k.ModuleDependencies.Remove(ctx, collections.Join(dependent, dependency)); err != nil {
+			return err
+		}
+		if err := k.ModuleDependents.Remove(ctx, collections.Join(dependency, dependent)); err != nil {
+			return err
+		}
+	}
+
+	for _, moduleId := range dependencies {
+		dependency := moduleId.String()
+		if err := k.ModuleDependencies.Set(ctx, collections.Join(dependent, dependency)); err != nil {
+			return err
+		}
+		if err := k.ModuleDependents.Set(ctx, collections.Join(dependency, dependent)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// indexModuleBytesDependencies indexes the dependencies of the module
+// identified by the module bytes.
+func (k Keeper) indexModuleBytesDependencies(ctx context.Context, code []byte) error {
+	moduleAddr, moduleName, err := vmapi.ReadModuleInfo(code)
+	if err != nil {
+		return err
+	}
+
+	return k.indexModuleDependencies(ctx, vmtypes.NewModuleId(moduleAddr, moduleName), code)
+}
+
+// indexPublishedModuleDependencies indexes the dependencies of the module
+// with the module bytes written to the store by the vm.
+func (k Keeper) indexPublishedModuleDependencies(ctx context.Context, moduleId vmtypes.ModuleId) error {
+	key, err := types.GetModuleKey(moduleId.Address, string(moduleId.Name))
+	if err != nil {
+		return err
+	}
+
+	code, err := k.VMStore.Get(ctx, key)
+	if err != nil {
+		return err
+	}
+
+	return k.indexModuleDependencies(ctx, moduleId, code)
+}
+
+// RebuildModuleDependencies clears the module dependency index and rebuilds
+// it from all the modules in the store.
+func (k Keeper) RebuildModuleDependencies(ctx context.Context) error {
+	if err := k.ModuleDependencies.Clear(ctx, nil); err != nil {
+		return err
+	}
+	if err := k.ModuleDependents.Clear(ctx, nil); err != nil {
+		return err
+	}
+
+	// collect the modules first; the store must not be modified while iterating
+	var moduleIds []vmtypes.ModuleId
+	var codes [][]byte
+	err := k.VMStore.Walk(ctx, nil, func(key, value []byte) (bool, error) {
+		if len(key) <= types.AddressBytesLength || key[types.AddressBytesLength] != types.ModuleSeparator {
+			return false, nil
+		}
+
+		moduleAddr, moduleName, err := vmapi.ReadModuleInfo(value)
+		if err != nil {
+			return true, err
+		}
+
+		moduleIds = append(moduleIds, vmtypes.NewModuleId(moduleAddr, moduleName))
+		codes = append(codes, value)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, moduleId := range moduleIds {
+		if err := k.indexModuleDependencies(ctx, moduleId, codes[i]); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetModuleDependencies returns the modules imported by the module.
+func (k Keeper) GetModuleDependencies(ctx context.Context, moduleAddr vmtypes.AccountAddress, moduleName string) ([]types.ModuleIdentifier, error) {
+	dependent := vmtypes.NewModuleId(moduleAddr, moduleName).String()
+
+	var dependencies []types.ModuleIdentifier
+	err := k.ModuleDependencies.Walk(ctx, collections.NewPrefixedPairRange[string, string](dependent), func(key collections.Pair[string, string]) (bool, error) {
+		dependency, err := types.ParseModuleIdentifier(key.K2())
+		if err != nil {
+			return true, err
+		}
+
+		dependencies = append(dependencies, dependency)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return dependencies, nil
+}
+
+// moduleABI is the subset of the decoded module json used by the queries.
+type moduleABI struct {
+	Friends          []string      `json:"friends"`
+	ExposedFunctions []functionABI `json:"exposed_functions"`
+	Structs          []structABI   `json:"structs"`
+}
+
+type functionABI struct {
+	Name              string `json:"name"`
+	Visibility        string `json:"visibility"`
+	IsEntry           bool   `json:"is_entry"`
+	IsView            bool   `json:"is_view"`
+	GenericTypeParams []struct {
+		Constraints []string `json:"constraints"`
+	} `json:"generic_type_params"`
+	Params []string `json:"params"`
+	Return []string `json:"return"`
+}
+
+type structABI struct {
+	Name              string   `json:"name"`
+	Abilities         []string `json:"abilities"`
+	GenericTypeParams []struct {
+		Constraints []string `json:"constraints"`
+		IsPhantom   bool     `json:"is_phantom"`
+	} `json:"generic_type_params"`
+	Fields []struct {
+		Name string `json:"name"`
+		Type string `json:"type"`
+	} `json:"fields"`
+}
+
+func (k Keeper) decodeModuleABI(moduleBytes []byte) (moduleABI, error) {
+	bz, err := k.DecodeModuleBytes(moduleBytes)
+	if err != nil {
+		return moduleABI{}, err
+	}
+
+	var abi moduleABI
+	if err := json.Unmarshal(bz, &abi); err != nil {
+		return moduleABI{}, err
+	}
+
+	return abi, nil
+}
+
+// functionSignatures returns the entry and view function signatures of the module.
+func (abi moduleABI) functionSignatures(moduleAddr vmtypes.AccountAddress, moduleName string) []types.FunctionSignature {
+	signatures := []types.FunctionSignature{}
+	for _, function := range abi.ExposedFunctions {
+		if !function.IsEntry && !function.IsView {
+			continue
+		}
+
+		typeParams := make([]string, len(function.GenericTypeParams))
+		for i, typeParam := range function.GenericTypeParams {
+			typeParams[i] = fmt.Sprintf("T%d", i)
+			if len(typeParam.Constraints) > 0 {
+				typeParams[i] += ": " + strings.Join(typeParam.Constraints, " + ")
+			}
+		}
+
+		signatures = append(signatures, types.FunctionSignature{
+			Address:    moduleAddr.String(),
+			ModuleName: moduleName,
+			Name:       function.Name,
+			IsEntry:    function.IsEntry,
+			IsView:     function.IsView,
+			TypeParams: typeParams,
+			Params:     function.Params,
+			Returns:    function.Return,
+		})
+	}
+
+	return signatures
+}
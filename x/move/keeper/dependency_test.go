@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_ModuleDependencies_DirectCodePublish(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	moduleIdsArg, err := vmtypes.SerializeStringVector([]string{"0x2::TableGenerator"})
+	require.NoError(t, err)
+	codesArg, err := vmtypes.SerializeBytesVector([][]byte{tableGeneratorModule})
+	require.NoError(t, err)
+
+	// publish by calling `0x1::code::publish` directly
+	_, err = ms.Execute(ctx, &types.MsgExecute{
+		Sender:        types.TestAddr.String(),
+		ModuleAddress: "0x1",
+		ModuleName:    types.MoveModuleNameCode,
+		FunctionName:  types.FunctionNameCodePublish,
+		Args:          [][]byte{moduleIdsArg, codesArg, {1}},
+	})
+	require.NoError(t, err)
+
+	dependencies, err := input.MoveKeeper.GetModuleDependencies(ctx, vmtypes.TestAddress, "TableGenerator")
+	require.NoError(t, err)
+	require.Equal(t, []types.ModuleIdentifier{{Address: "0x1", ModuleName: "table"}}, dependencies)
+}
+
+func Test_RebuildModuleDependencies(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress,
+		vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)),
+		types.UpgradePolicy_COMPATIBLE,
+	)
+	require.NoError(t, err)
+
+	// drop the index
+	require.NoError(t, input.MoveKeeper.ModuleDependencies.Clear(ctx, nil))
+	require.NoError(t, input.MoveKeeper.ModuleDependents.Clear(ctx, nil))
+
+	dependencies, err := input.MoveKeeper.GetModuleDependencies(ctx, vmtypes.TestAddress, "TableGenerator")
+	require.NoError(t, err)
+	require.Empty(t, dependencies)
+
+	m := keeper.NewMigrator(&input.MoveKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	dependencies, err = input.MoveKeeper.GetModuleDependencies(ctx, vmtypes.TestAddress, "TableGenerator")
+	require.NoError(t, err)
+	require.Equal(t, []types.ModuleIdentifier{{Address: "0x1", ModuleName: "table"}}, dependencies)
+
+	// the stdlib modules are indexed too
+	dependencies, err = input.MoveKeeper.GetModuleDependencies(ctx, vmtypes.StdAddress, "coin")
+	require.NoError(t, err)
+	require.NotEmpty(t, dependencies)
+}
@@ -352,6 +352,168 @@ func (k DexKeeper) SwapToBase(
 	)
 }
 
+// ConvertCoin converts the coin of the account between the base denom and the LP denom
+// of a whitelisted dex pair, and returns the converted coin. The LP coin is withdrawn
+// from the pool and the quote part is swapped to the base coin, and the base coin is
+// provided to the pool as a single asset.
+func (k DexKeeper) ConvertCoin(
+	ctx context.Context,
+	addr sdk.AccAddress,
+	offerCoin sdk.Coin,
+	targetDenom string,
+) (sdk.Coin, error) {
+	baseDenom, err := k.BaseDenom(ctx)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	vmAddr, err := vmtypes.NewAccountAddressFromBytes(addr[:])
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !offerCoin.Amount.IsUint64() {
+		return sdk.Coin{}, types.ErrInvalidRequest.Wrapf("offer amount overflow: %s", offerCoin)
+	}
+
+	offerAmountBz, err := vmtypes.SerializeUint64(offerCoin.Amount.Uint64())
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	bankKeeper := NewMoveBankKeeper(k.Keeper)
+
+	var quoteDenom string
+	var functionName string
+	var args [][]byte
+	switch {
+	case offerCoin.Denom != baseDenom && targetDenom == baseDenom:
+		metadataLP, metadataQuote, err := k.whitelistedPair(ctx, offerCoin.Denom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		// the quote coin withdrawn from the pool is swapped to the base coin
+		quoteDenom, err = types.DenomFromMetadataAddress(ctx, bankKeeper, metadataQuote)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		functionName = types.FunctionNameDexWithdrawLiquidity
+		args = [][]byte{metadataLP[:], offerAmountBz, {0}, {0}}
+	case offerCoin.Denom == baseDenom && targetDenom != baseDenom:
+		metadataLP, _, err := k.whitelistedPair(ctx, targetDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		metadataBase, err := types.MetadataAddressFromDenom(baseDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		functionName = types.FunctionNameDexSingleAssetProvideLiquidity
+		args = [][]byte{metadataLP[:], metadataBase[:], offerAmountBz, {0}}
+	default:
+		return sdk.Coin{}, types.ErrInvalidConversion.Wrapf("cannot convert %s to %s", offerCoin.Denom, targetDenom)
+	}
+
+	balanceBefore, err := bankKeeper.GetBalance(ctx, addr, targetDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	quoteBefore := math.ZeroInt()
+	if quoteDenom != "" {
+		quoteBefore, err = bankKeeper.GetBalance(ctx, addr, quoteDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	if err := k.ExecuteEntryFunction(
+		ctx,
+		vmAddr,
+		vmtypes.StdAddress,
+		types.MoveModuleNameDex,
+		functionName,
+		[]vmtypes.TypeTag{},
+		args,
+	); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if quoteDenom != "" {
+		quoteAfter, err := bankKeeper.GetBalance(ctx, addr, quoteDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+
+		if quoteAmount := quoteAfter.Sub(quoteBefore); quoteAmount.IsPositive() {
+			if err := k.SwapToBase(ctx, addr, sdk.NewCoin(quoteDenom, quoteAmount)); err != nil {
+				return sdk.Coin{}, err
+			}
+		}
+	}
+
+	balanceAfter, err := bankKeeper.GetBalance(ctx, addr, targetDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return sdk.NewCoin(targetDenom, balanceAfter.Sub(balanceBefore)), nil
+}
+
+// whitelistedPair returns the metadata of the LP denom and its quote, which must be
+// the LP of a whitelisted dex pair with the base denom.
+func (k DexKeeper) whitelistedPair(ctx context.Context, denomLP string) (metadataLP, metadataQuote vmtypes.AccountAddress, err error) {
+	metadataLP, err = types.MetadataAddressFromDenom(denomLP)
+	if err != nil {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, err
+	}
+
+	baseDenom, err := k.BaseDenom(ctx)
+	if err != nil {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, err
+	}
+
+	metadataBase, err := types.MetadataAddressFromDenom(baseDenom)
+	if err != nil {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, err
+	}
+
+	metadataA, metadataB, err := k.GetPoolMetadata(ctx, metadataLP)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, types.ErrInvalidConversion.Wrapf("%s is not a dex pair", denomLP)
+	} else if err != nil {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, err
+	}
+
+	switch {
+	case metadataA == metadataBase:
+		metadataQuote = metadataB
+	case metadataB == metadataBase:
+		metadataQuote = metadataA
+	default:
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, types.ErrInvalidConversion.Wrapf("%s is not paired with the base denom", denomLP)
+	}
+
+	// the dex pair must be whitelisted with the LP
+	if found, err := k.hasDexPair(ctx, metadataQuote); err != nil {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, err
+	} else if !found {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, types.ErrInvalidConversion.Wrapf("%s is not a whitelisted dex pair", denomLP)
+	}
+
+	if whitelistedLP, err := k.getMetadataLP(ctx, metadataQuote); err != nil {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, err
+	} else if whitelistedLP != metadataLP {
+		return vmtypes.AccountAddress{}, vmtypes.AccountAddress{}, types.ErrInvalidConversion.Wrapf("%s is not a whitelisted dex pair", denomLP)
+	}
+
+	return metadataLP, metadataQuote, nil
+}
+
 func (k DexKeeper) GetPoolMetadata(
 	ctx context.Context,
 	metadataLP vmtypes.AccountAddress,
@@ -274,3 +274,61 @@ func TestDexPair(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, metadataLP, res)
 }
+
+func Test_ConvertCoin(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	dexKeeper := keeper.NewDexKeeper(&input.MoveKeeper)
+
+	baseDenom := bondDenom
+	baseAmount := math.NewInt(1_000_000_000_000)
+
+	denomQuote := "uusdc"
+	quoteAmount := math.NewInt(2_500_000_000_000)
+
+	metadataQuote, err := types.MetadataAddressFromDenom(denomQuote)
+	require.NoError(t, err)
+
+	metadataLP := createDexPool(
+		t, ctx, input,
+		sdk.NewCoin(baseDenom, baseAmount), sdk.NewCoin(denomQuote, quoteAmount),
+		math.LegacyNewDecWithPrec(8, 1), math.LegacyNewDecWithPrec(2, 1),
+	)
+
+	denomLP, err := types.DenomFromMetadataAddress(ctx, keeper.NewMoveBankKeeper(&input.MoveKeeper), metadataLP)
+	require.NoError(t, err)
+
+	fundedAddr := input.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin(baseDenom, 1_000_000))
+
+	// the dex pair is not whitelisted
+	_, err = dexKeeper.ConvertCoin(ctx, fundedAddr, sdk.NewInt64Coin(baseDenom, 1_000_000), denomLP)
+	require.ErrorIs(t, err, types.ErrInvalidConversion)
+
+	err = dexKeeper.SetDexPair(ctx, types.DexPair{
+		MetadataQuote: metadataQuote.String(),
+		MetadataLP:    metadataLP.String(),
+	})
+	require.NoError(t, err)
+
+	// base to LP with a single asset liquidity provision
+	lpCoin, err := dexKeeper.ConvertCoin(ctx, fundedAddr, sdk.NewInt64Coin(baseDenom, 1_000_000), denomLP)
+	require.NoError(t, err)
+	require.Equal(t, denomLP, lpCoin.Denom)
+	require.True(t, lpCoin.IsPositive())
+	require.Equal(t, sdk.NewCoins(lpCoin), input.BankKeeper.GetAllBalances(ctx, fundedAddr))
+
+	// LP to base with a withdrawal and a swap of the quote coin
+	baseCoin, err := dexKeeper.ConvertCoin(ctx, fundedAddr, lpCoin, baseDenom)
+	require.NoError(t, err)
+	require.Equal(t, baseDenom, baseCoin.Denom)
+	require.True(t, baseCoin.Amount.LT(math.NewInt(1_000_000)) && baseCoin.Amount.GT(math.NewInt(990_000)))
+	require.Equal(t, sdk.NewCoins(baseCoin), input.BankKeeper.GetAllBalances(ctx, fundedAddr))
+
+	// only the base denom and the LP denom can be converted to each other
+	_, err = dexKeeper.ConvertCoin(ctx, fundedAddr, baseCoin, denomQuote)
+	require.ErrorIs(t, err, types.ErrInvalidConversion)
+
+	// the offer amount must fit in u64
+	overflowCoin := sdk.NewCoin(baseDenom, math.NewIntFromUint64(^uint64(0)).AddRaw(1))
+	_, err = dexKeeper.ConvertCoin(ctx, fundedAddr, overflowCoin, denomLP)
+	require.ErrorIs(t, err, types.ErrInvalidRequest)
+}
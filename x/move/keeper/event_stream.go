@@ -0,0 +1,338 @@
+package keeper
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// eventSubscriberBufferSize is the number of blocks which can be queued for
+// a subscriber; the subscription is closed when the subscriber falls behind.
+const eventSubscriberBufferSize = 64
+
+var (
+	_ storetypes.ABCIListener = &EventStream{}
+	_ types.EventStreamServer = &Querier{}
+)
+
+// moveEventBlock is the move events of a committed block.
+type moveEventBlock struct {
+	height int64
+	events []types.MoveEvent
+}
+
+// eventSubscriber is a subscription of the new blocks.
+type eventSubscriber struct {
+	blocks chan moveEventBlock
+}
+
+// EventStream is a node local ring buffer of the move events of the recent
+// blocks, which streams the events of the committed blocks to the
+// subscribers. The stream is fed by the finalized blocks, so it never
+// affects the consensus state.
+type EventStream struct {
+	mu sync.RWMutex
+
+	// ring buffer of the recent blocks
+	blocks []moveEventBlock
+	start  int
+	size   int
+
+	// events of the finalized block, which is published on commit
+	pending *moveEventBlock
+
+	subscribers map[*eventSubscriber]struct{}
+}
+
+// NewEventStream returns new EventStream instance, which keeps the events
+// of the capacity number of recent blocks.
+func NewEventStream(capacity uint64) *EventStream {
+	return &EventStream{
+		blocks:      make([]moveEventBlock, capacity),
+		subscribers: make(map[*eventSubscriber]struct{}),
+	}
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener. It collects the
+// move events of the txs in the finalized block.
+func (s *EventStream) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	block := moveEventBlock{height: req.Height}
+	for i, txResult := range res.TxResults {
+		if i >= len(req.Txs) {
+			break
+		}
+
+		txHash := sha256.Sum256(req.Txs[i])
+		block.events = append(block.events, collectMoveEvents(req.Height, strings.ToUpper(hex.EncodeToString(txHash[:])), txResult.Events)...)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pending = &block
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It pushes the events of
+// the committed block to the ring buffer and the subscribers.
+func (s *EventStream) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.pending == nil {
+		return nil
+	}
+
+	block := *s.pending
+	s.pending = nil
+
+	if len(s.blocks) != 0 {
+		if s.size < len(s.blocks) {
+			s.blocks[(s.start+s.size)%len(s.blocks)] = block
+			s.size++
+		} else {
+			s.blocks[s.start] = block
+			s.start = (s.start + 1) % len(s.blocks)
+		}
+	}
+
+	for sub := range s.subscribers {
+		select {
+		case sub.blocks <- block:
+		default:
+			// the subscriber falls behind, so close the subscription
+			close(sub.blocks)
+			delete(s.subscribers, sub)
+		}
+	}
+
+	return nil
+}
+
+// subscribe returns the buffered blocks from the start height and the
+// subscription of the new blocks. The start height zero skips the buffered
+// blocks.
+func (s *EventStream) subscribe(startHeight int64) ([]moveEventBlock, *eventSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var replay []moveEventBlock
+	if startHeight > 0 {
+		for i := 0; i < s.size; i++ {
+			block := s.blocks[(s.start+i)%len(s.blocks)]
+			if block.height >= startHeight {
+				replay = append(replay, block)
+			}
+		}
+	}
+
+	sub := &eventSubscriber{blocks: make(chan moveEventBlock, eventSubscriberBufferSize)}
+	s.subscribers[sub] = struct{}{}
+
+	return replay, sub
+}
+
+// unsubscribe removes the subscription.
+func (s *EventStream) unsubscribe(sub *eventSubscriber) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, found := s.subscribers[sub]; found {
+		close(sub.blocks)
+		delete(s.subscribers, sub)
+	}
+}
+
+// collectMoveEvents collects the move events of a tx. The senders and the
+// module of a move event are read from the preceding execute or script
+// event, which is emitted by the same move execution.
+func collectMoveEvents(height int64, txHash string, events []abci.Event) []types.MoveEvent {
+	var moveEvents []types.MoveEvent
+	var senders []string
+	var module string
+
+	// the index among the move events of the tx
+	var eventIndex uint32
+	for _, event := range events {
+		switch event.Type {
+		case types.EventTypeExecute, types.EventTypeScript:
+			senders, module = nil, ""
+
+			var moduleAddr, moduleName string
+			for _, attr := range event.Attributes {
+				switch attr.Key {
+				case types.AttributeKeySender:
+					senders = strings.Split(attr.Value, ",")
+				case types.AttributeKeyModuleAddr:
+					moduleAddr = attr.Value
+				case types.AttributeKeyModuleName:
+					moduleName = attr.Value
+				}
+			}
+
+			if moduleAddr != "" && moduleName != "" {
+				module = moduleAddr + "::" + moduleName
+			}
+		case types.EventTypeMove:
+			moveEvent := types.MoveEvent{
+				Height:     height,
+				TxHash:     txHash,
+				EventIndex: eventIndex,
+				Senders:    senders,
+				Module:     module,
+			}
+
+			for _, attr := range event.Attributes {
+				switch attr.Key {
+				case types.AttributeKeyTypeTag:
+					moveEvent.TypeTag = attr.Value
+				case types.AttributeKeyData:
+					moveEvent.Data = attr.Value
+				}
+			}
+
+			moveEvents = append(moveEvents, moveEvent)
+			eventIndex++
+		}
+	}
+
+	return moveEvents
+}
+
+// SetEventStream sets the node local move event stream.
+func (k *Keeper) SetEventStream(eventStream *EventStream) {
+	k.eventStream = eventStream
+}
+
+// GetEventStream returns the node local move event stream, or nil if disabled.
+func (k Keeper) GetEventStream() *EventStream {
+	return k.eventStream
+}
+
+// SubscribeEvents implements types.EventStreamServer. It streams the move
+// events of the buffered blocks from the start height and the new blocks,
+// which match the filters of the request.
+func (q Querier) SubscribeEvents(req *types.SubscribeEventsRequest, stream types.EventStream_SubscribeEventsServer) error {
+	if q.eventStream == nil {
+		return types.ErrEventStreamDisabled
+	}
+
+	filter, err := newMoveEventFilter(q.Keeper, req)
+	if err != nil {
+		return err
+	}
+
+	replay, sub := q.eventStream.subscribe(req.StartHeight)
+	defer q.eventStream.unsubscribe(sub)
+
+	send := func(block moveEventBlock) error {
+		for _, event := range block.events {
+			if !filter.match(event) {
+				continue
+			}
+
+			if err := stream.Send(&types.SubscribeEventsResponse{Event: event}); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	}
+
+	for _, block := range replay {
+		if err := send(block); err != nil {
+			return err
+		}
+	}
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case block, ok := <-sub.blocks:
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "subscriber falls behind the event stream")
+			}
+
+			if err := send(block); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// moveEventFilter is the normalized filters of a subscription.
+type moveEventFilter struct {
+	typeTagPatterns []string
+	senders         []string
+	modules         []string
+}
+
+func newMoveEventFilter(k *Keeper, req *types.SubscribeEventsRequest) (moveEventFilter, error) {
+	filter := moveEventFilter{typeTagPatterns: req.TypeTagPatterns}
+	for _, pattern := range req.TypeTagPatterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return moveEventFilter{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid type tag pattern %s: %s", pattern, err)
+		}
+	}
+
+	for _, sender := range req.Senders {
+		addr, err := types.AccAddressFromString(k.ac, sender)
+		if err != nil {
+			return moveEventFilter{}, err
+		}
+
+		filter.senders = append(filter.senders, addr.String())
+	}
+
+	for _, module := range req.Modules {
+		moduleAddr, moduleName, found := strings.Cut(module, "::")
+		if !found {
+			return moveEventFilter{}, sdkerrors.ErrInvalidRequest.Wrapf("invalid module %s", module)
+		}
+
+		addr, err := types.AccAddressFromString(k.ac, moduleAddr)
+		if err != nil {
+			return moveEventFilter{}, err
+		}
+
+		filter.modules = append(filter.modules, addr.String()+"::"+moduleName)
+	}
+
+	return filter, nil
+}
+
+func (f moveEventFilter) match(event types.MoveEvent) bool {
+	if len(f.typeTagPatterns) != 0 && !slices.ContainsFunc(f.typeTagPatterns, func(pattern string) bool {
+		matched, _ := path.Match(pattern, event.TypeTag)
+		return matched
+	}) {
+		return false
+	}
+
+	if len(f.senders) != 0 && !slices.ContainsFunc(event.Senders, func(sender string) bool {
+		return slices.Contains(f.senders, sender)
+	}) {
+		return false
+	}
+
+	if len(f.modules) != 0 && !slices.Contains(f.modules, event.Module) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,135 @@
+package keeper_test
+
+import (
+	"context"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+type mockEventStreamServer struct {
+	grpc.ServerStream
+
+	ctx context.Context
+
+	mu     sync.Mutex
+	events []types.MoveEvent
+}
+
+func (s *mockEventStreamServer) Context() context.Context {
+	return s.ctx
+}
+
+func (s *mockEventStreamServer) Send(res *types.SubscribeEventsResponse) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.events = append(s.events, res.Event)
+	return nil
+}
+
+func (s *mockEventStreamServer) received() []types.MoveEvent {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]types.MoveEvent{}, s.events...)
+}
+
+func moveEventBlock(height int64, sender string, module string, typeTags ...string) (abci.RequestFinalizeBlock, abci.ResponseFinalizeBlock) {
+	moduleAddr, moduleName, _ := strings.Cut(module, "::")
+	events := []abci.Event{{
+		Type: types.EventTypeExecute,
+		Attributes: []abci.EventAttribute{
+			{Key: types.AttributeKeySender, Value: sender},
+			{Key: types.AttributeKeyModuleAddr, Value: moduleAddr},
+			{Key: types.AttributeKeyModuleName, Value: moduleName},
+		},
+	}}
+	for _, typeTag := range typeTags {
+		events = append(events, abci.Event{
+			Type: types.EventTypeMove,
+			Attributes: []abci.EventAttribute{
+				{Key: types.AttributeKeyTypeTag, Value: typeTag},
+				{Key: types.AttributeKeyData, Value: `{"amount":"100"}`},
+			},
+		})
+	}
+
+	return abci.RequestFinalizeBlock{Height: height, Txs: [][]byte{[]byte("tx")}},
+		abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{{Events: events}}}
+}
+
+func commitMoveEventBlock(t *testing.T, eventStream *keeper.EventStream, height int64, sender string, module string, typeTags ...string) {
+	req, res := moveEventBlock(height, sender, module, typeTags...)
+	require.NoError(t, eventStream.ListenFinalizeBlock(context.Background(), req, res))
+	require.NoError(t, eventStream.ListenCommit(context.Background(), abci.ResponseCommit{}, nil))
+}
+
+func TestSubscribeEvents(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+
+	err := querier.SubscribeEvents(&types.SubscribeEventsRequest{}, &mockEventStreamServer{ctx: ctx})
+	require.ErrorIs(t, err, types.ErrEventStreamDisabled)
+
+	eventStream := keeper.NewEventStream(2)
+	input.MoveKeeper.SetEventStream(eventStream)
+
+	// invalid filters
+	err = querier.SubscribeEvents(&types.SubscribeEventsRequest{TypeTagPatterns: []string{"0x1::coin::["}}, &mockEventStreamServer{ctx: ctx})
+	require.Error(t, err)
+	err = querier.SubscribeEvents(&types.SubscribeEventsRequest{Modules: []string{"0x1"}}, &mockEventStreamServer{ctx: ctx})
+	require.Error(t, err)
+
+	// the first block is overwritten by the third block
+	commitMoveEventBlock(t, eventStream, 1, "0x2", "0x1::coin", "0x1::coin::DepositEvent")
+	commitMoveEventBlock(t, eventStream, 2, "0x2", "0x1::coin", "0x1::coin::WithdrawEvent", "0x1::coin::DepositEvent")
+	commitMoveEventBlock(t, eventStream, 3, "0x3", "0x1::coin", "0x1::coin::DepositEvent")
+
+	subCtx, cancel := context.WithCancel(ctx)
+	stream := &mockEventStreamServer{ctx: subCtx}
+
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+
+		err := querier.SubscribeEvents(&types.SubscribeEventsRequest{
+			TypeTagPatterns: []string{"0x1::coin::Deposit*"},
+			Senders:         []string{"0x0000000000000000000000000000000000000002"},
+			Modules:         []string{"0x1::coin"},
+			StartHeight:     1,
+		}, stream)
+		require.ErrorIs(t, err, context.Canceled)
+	}()
+
+	// replay of the buffered blocks
+	require.Eventually(t, func() bool { return len(stream.received()) == 1 }, time.Second, 10*time.Millisecond)
+
+	// new blocks
+	commitMoveEventBlock(t, eventStream, 4, "0x2", "0x1::dex", "0x1::coin::DepositEvent")
+	commitMoveEventBlock(t, eventStream, 5, "0x2", "0x1::coin", "0x1::coin::DepositEvent")
+	require.Eventually(t, func() bool { return len(stream.received()) == 2 }, time.Second, 10*time.Millisecond)
+
+	cancel()
+	wg.Wait()
+
+	events := stream.received()
+	require.Equal(t, int64(2), events[0].Height)
+	// the index counts only the move events of the tx
+	require.Equal(t, uint32(1), events[0].EventIndex)
+	require.Equal(t, "0x1::coin::DepositEvent", events[0].TypeTag)
+	require.Equal(t, `{"amount":"100"}`, events[0].Data)
+	require.Equal(t, []string{"0x2"}, events[0].Senders)
+	require.Equal(t, "0x1::coin", events[0].Module)
+	require.Len(t, events[0].TxHash, 64)
+	require.Equal(t, int64(5), events[1].Height)
+}
@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/initia-labs/initia/x/move/types"
@@ -58,6 +60,9 @@ func (k Keeper) Initialize(
 // InitGenesis sets supply information for genesis.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
 	k.authKeeper.GetModuleAccount(ctx, types.MoveStakingModuleName)
+	k.authKeeper.GetModuleAccount(ctx, types.MoveRevenueModuleName)
+	k.authKeeper.GetModuleAccount(ctx, types.MoveCronModuleName)
+	k.authKeeper.GetModuleAccount(ctx, types.MovePublishModuleName)
 
 	params := genState.GetParams()
 	if err := k.SetRawParams(ctx, params.ToRaw()); err != nil {
@@ -71,6 +76,12 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.Initialize(ctx, genState.GetStdlibs(), params.AllowedPublishers); err != nil {
 			return err
 		}
+
+		for _, moduleBz := range genState.GetStdlibs() {
+			if err := k.indexModuleBytesDependencies(ctx, moduleBz); err != nil {
+				return err
+			}
+		}
 	}
 
 	for _, module := range genState.GetModules() {
@@ -82,6 +93,10 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		if err := k.SetModule(ctx, addr, module.ModuleName, module.RawBytes); err != nil {
 			return err
 		}
+
+		if err := k.indexModuleDependencies(ctx, vmtypes.NewModuleId(addr, module.ModuleName), module.RawBytes); err != nil {
+			return err
+		}
 	}
 
 	for _, resource := range genState.GetResources() {
@@ -120,6 +135,171 @@ func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) er
 		}
 	}
 
+	if err := k.initRevenueGenesis(ctx, genState); err != nil {
+		return err
+	}
+
+	if err := k.initCronGenesis(ctx, genState); err != nil {
+		return err
+	}
+
+	if err := k.initPublishGenesis(ctx, genState); err != nil {
+		return err
+	}
+
+	for _, authenticator := range genState.GetAuthenticators() {
+		addr, err := types.AccAddressFromString(k.ac, authenticator.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Authenticators.Set(ctx, addr.Bytes(), authenticator); err != nil {
+			return err
+		}
+	}
+
+	for _, sponsor := range genState.GetSponsors() {
+		addr, err := types.AccAddressFromString(k.ac, sponsor.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.Sponsors.Set(ctx, addr.Bytes(), sponsor); err != nil {
+			return err
+		}
+	}
+
+	for _, observation := range genState.GetVotingPowerWeightObservations() {
+		if err := k.VotingPowerWeightObservations.Set(ctx, collections.Join(observation.Denom, observation.Height), observation); err != nil {
+			return err
+		}
+	}
+
+	for _, weight := range genState.GetVotingPowerWeights() {
+		if err := k.VotingPowerWeights.Set(ctx, weight.Denom, weight); err != nil {
+			return err
+		}
+	}
+
+	// the denom owner index is not exported, so rebuild it from the imported stores
+	if err := k.rebuildDenomOwners(ctx); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (k Keeper) initRevenueGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, recipient := range genState.GetRevenueRecipients() {
+		moduleAddr, err := types.AccAddressFromString(k.ac, recipient.ModuleAddress)
+		if err != nil {
+			return err
+		}
+
+		recipientAddr, err := types.AccAddressFromString(k.ac, recipient.Recipient)
+		if err != nil {
+			return err
+		}
+
+		if err := k.RevenueRecipients.Set(ctx, collections.Join(moduleAddr.Bytes(), recipient.ModuleName), recipientAddr.Bytes()); err != nil {
+			return err
+		}
+	}
+
+	for _, ratio := range genState.GetRevenueRatios() {
+		moduleAddr, err := types.AccAddressFromString(k.ac, ratio.ModuleAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.SetRevenueRatio(ctx, moduleAddr, ratio.ModuleName, &ratio.Ratio); err != nil {
+			return err
+		}
+	}
+
+	for _, revenue := range genState.GetModuleRevenues() {
+		moduleAddr, err := types.AccAddressFromString(k.ac, revenue.ModuleAddress)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range revenue.TotalRevenue {
+			if err := k.ModuleRevenues.Set(ctx, collections.Join3(moduleAddr.Bytes(), revenue.ModuleName, coin.Denom), coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, claimable := range genState.GetClaimableRevenues() {
+		recipient, err := types.AccAddressFromString(k.ac, claimable.Recipient)
+		if err != nil {
+			return err
+		}
+
+		for _, coin := range claimable.Amount {
+			if err := k.ClaimableRevenues.Set(ctx, collections.Join(recipient.Bytes(), coin.Denom), coin.Amount); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) initCronGenesis(ctx context.Context, genState types.GenesisState) error {
+	if err := k.CronJobSequence.Set(ctx, genState.CronJobSequence); err != nil {
+		return err
+	}
+
+	for _, job := range genState.GetCronJobs() {
+		if err := k.setCronJob(ctx, job); err != nil {
+			return err
+		}
+	}
+
+	for _, run := range genState.GetCronRuns() {
+		if err := k.CronRuns.Set(ctx, collections.Join(run.JobId, run.Height), run); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) initPublishGenesis(ctx context.Context, genState types.GenesisState) error {
+	for _, namespace := range genState.GetPublishNamespaces() {
+		addr, err := types.AccAddressFromString(k.ac, namespace.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PublishNamespaces.Set(ctx, addr.Bytes(), namespace); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range genState.GetPublishUsages() {
+		addr, err := types.AccAddressFromString(k.ac, usage.Address)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PublishUsages.Set(ctx, addr.Bytes(), usage); err != nil {
+			return err
+		}
+	}
+
+	for _, deposit := range genState.GetPublishDeposits() {
+		moduleAddr, err := types.AccAddressFromString(k.ac, deposit.ModuleAddress)
+		if err != nil {
+			return err
+		}
+
+		if err := k.PublishDeposits.Set(ctx, collections.Join(moduleAddr.Bytes(), deposit.ModuleName), deposit); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
@@ -186,5 +366,170 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	if err := k.exportRevenueGenesis(ctx, &genState); err != nil {
+		panic(err)
+	}
+
+	if err := k.exportCronGenesis(ctx, &genState); err != nil {
+		panic(err)
+	}
+
+	if err := k.exportPublishGenesis(ctx, &genState); err != nil {
+		panic(err)
+	}
+
+	err = k.Authenticators.Walk(ctx, nil, func(_ []byte, authenticator types.Authenticator) (bool, error) {
+		genState.Authenticators = append(genState.Authenticators, authenticator)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.Sponsors.Walk(ctx, nil, func(_ []byte, sponsor types.Sponsor) (bool, error) {
+		genState.Sponsors = append(genState.Sponsors, sponsor)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.VotingPowerWeightObservations.Walk(ctx, nil, func(_ collections.Pair[string, int64], observation types.VotingPowerWeightObservation) (bool, error) {
+		genState.VotingPowerWeightObservations = append(genState.VotingPowerWeightObservations, observation)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	err = k.VotingPowerWeights.Walk(ctx, nil, func(_ string, weight sdk.DecCoin) (bool, error) {
+		genState.VotingPowerWeights = append(genState.VotingPowerWeights, weight)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
 	return &genState
 }
+
+func (k Keeper) exportRevenueGenesis(ctx context.Context, genState *types.GenesisState) error {
+	err := k.RevenueRecipients.Walk(ctx, nil, func(key collections.Pair[[]byte, string], bz []byte) (bool, error) {
+		moduleAddr, err := vmtypes.NewAccountAddressFromBytes(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		recipient, err := vmtypes.NewAccountAddressFromBytes(bz)
+		if err != nil {
+			return true, err
+		}
+
+		genState.RevenueRecipients = append(genState.RevenueRecipients, types.ModuleRevenueRecipient{
+			ModuleAddress: moduleAddr.String(),
+			ModuleName:    key.K2(),
+			Recipient:     recipient.String(),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.RevenueRatios.Walk(ctx, nil, func(_ collections.Pair[[]byte, string], ratio types.ModuleRevenueRatio) (bool, error) {
+		genState.RevenueRatios = append(genState.RevenueRatios, ratio)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.ModuleRevenues.Walk(ctx, nil, func(key collections.Triple[[]byte, string, string], amount sdkmath.Int) (bool, error) {
+		moduleAddr, err := vmtypes.NewAccountAddressFromBytes(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		coin := sdk.NewCoin(key.K3(), amount)
+		if n := len(genState.ModuleRevenues); n > 0 &&
+			genState.ModuleRevenues[n-1].ModuleAddress == moduleAddr.String() &&
+			genState.ModuleRevenues[n-1].ModuleName == key.K2() {
+			genState.ModuleRevenues[n-1].TotalRevenue = genState.ModuleRevenues[n-1].TotalRevenue.Add(coin)
+			return false, nil
+		}
+
+		genState.ModuleRevenues = append(genState.ModuleRevenues, types.ModuleRevenue{
+			ModuleAddress: moduleAddr.String(),
+			ModuleName:    key.K2(),
+			TotalRevenue:  sdk.NewCoins(coin),
+		})
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.ClaimableRevenues.Walk(ctx, nil, func(key collections.Pair[[]byte, string], amount sdkmath.Int) (bool, error) {
+		recipient, err := vmtypes.NewAccountAddressFromBytes(key.K1())
+		if err != nil {
+			return true, err
+		}
+
+		coin := sdk.NewCoin(key.K2(), amount)
+		if n := len(genState.ClaimableRevenues); n > 0 &&
+			genState.ClaimableRevenues[n-1].Recipient == recipient.String() {
+			genState.ClaimableRevenues[n-1].Amount = genState.ClaimableRevenues[n-1].Amount.Add(coin)
+			return false, nil
+		}
+
+		genState.ClaimableRevenues = append(genState.ClaimableRevenues, types.ClaimableRevenue{
+			Recipient: recipient.String(),
+			Amount:    sdk.NewCoins(coin),
+		})
+		return false, nil
+	})
+}
+
+func (k Keeper) exportCronGenesis(ctx context.Context, genState *types.GenesisState) error {
+	var err error
+	genState.CronJobSequence, err = k.CronJobSequence.Peek(ctx)
+	if err != nil {
+		return err
+	}
+
+	err = k.CronJobs.Walk(ctx, nil, func(_ uint64, job types.CronJob) (bool, error) {
+		genState.CronJobs = append(genState.CronJobs, job)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.CronRuns.Walk(ctx, nil, func(_ collections.Pair[uint64, int64], run types.CronRun) (bool, error) {
+		genState.CronRuns = append(genState.CronRuns, run)
+		return false, nil
+	})
+}
+
+func (k Keeper) exportPublishGenesis(ctx context.Context, genState *types.GenesisState) error {
+	err := k.PublishNamespaces.Walk(ctx, nil, func(_ []byte, namespace types.PublishNamespace) (bool, error) {
+		genState.PublishNamespaces = append(genState.PublishNamespaces, namespace)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	err = k.PublishUsages.Walk(ctx, nil, func(_ []byte, usage types.PublishUsage) (bool, error) {
+		genState.PublishUsages = append(genState.PublishUsages, usage)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return k.PublishDeposits.Walk(ctx, nil, func(_ collections.Pair[[]byte, string], deposit types.PublishDeposit) (bool, error) {
+		genState.PublishDeposits = append(genState.PublishDeposits, deposit)
+		return false, nil
+	})
+}
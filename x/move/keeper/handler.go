@@ -32,36 +32,56 @@ func isSimulation(
 	return sdkCtx.ExecMode() == sdk.ExecModeSimulate
 }
 
-// extract module address and module name from the compiled module bytes
-func (k Keeper) extractModuleIdentifier(moduleBundle vmtypes.ModuleBundle) ([]string, error) {
-	modules := make([]string, len(moduleBundle.Codes))
-	for i, moduleBz := range moduleBundle.Codes {
-		moduleAddr, moduleName, err := vmapi.ReadModuleInfo(moduleBz.Code)
-		if err != nil {
-			return nil, err
-		}
+////////////////////////////////////////
+// Publish Functions
 
-		modules[i] = vmtypes.NewModuleId(moduleAddr, moduleName).String()
+// PublishModuleBundle publishes the module bundle. The publish policy (mode,
+// namespace, quota and deposit) is enforced on the published modules when
+// handling the execution result, like any other `0x1::code::publish` call.
+func (k Keeper) PublishModuleBundle(
+	ctx context.Context,
+	sender vmtypes.AccountAddress,
+	moduleBundle vmtypes.ModuleBundle,
+	upgradePolicy types.UpgradePolicy,
+) error {
+	moduleIds, err := readModuleIds(moduleBundle)
+	if err != nil {
+		return err
 	}
 
-	return modules, nil
+	return k.publishModuleBundle(ctx, sender, moduleIds, moduleBundle, upgradePolicy)
 }
 
-////////////////////////////////////////
-// Publish Functions
-
-func (k Keeper) PublishModuleBundle(
+// GovPublishModuleBundle publishes the module bundle without the publish
+// policy enforcement, which is used by the governance proposal.
+func (k Keeper) GovPublishModuleBundle(
 	ctx context.Context,
 	sender vmtypes.AccountAddress,
 	moduleBundle vmtypes.ModuleBundle,
 	upgradePolicy types.UpgradePolicy,
 ) error {
-	moduleIds, err := k.extractModuleIdentifier(moduleBundle)
+	moduleIds, err := readModuleIds(moduleBundle)
 	if err != nil {
 		return err
 	}
 
-	moduleIdBz, err := json.Marshal(&moduleIds)
+	sdkCtx := types.WithGovPublish(sdk.UnwrapSDKContext(ctx))
+	return k.publishModuleBundle(sdkCtx, sender, moduleIds, moduleBundle, upgradePolicy)
+}
+
+func (k Keeper) publishModuleBundle(
+	ctx context.Context,
+	sender vmtypes.AccountAddress,
+	moduleIds []vmtypes.ModuleId,
+	moduleBundle vmtypes.ModuleBundle,
+	upgradePolicy types.UpgradePolicy,
+) error {
+	moduleIdStrs := make([]string, len(moduleIds))
+	for i, moduleId := range moduleIds {
+		moduleIdStrs[i] = moduleId.String()
+	}
+
+	moduleIdBz, err := json.Marshal(&moduleIdStrs)
 	if err != nil {
 		return err
 	}
@@ -96,11 +116,7 @@ func (k Keeper) PublishModuleBundle(
 			unsafe.String(unsafe.SliceData(upgradePolicyBz), len(upgradePolicyBz)),
 		},
 	)
-	if err != nil {
-		return err
-	}
-
-	return nil
+	return err
 }
 
 ////////////////////////////////////////
@@ -202,6 +218,8 @@ func (k Keeper) executeEntryFunction(
 	// delegate gas metering to move vm
 	sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
+	k.recordModuleUsage(moduleAddr, moduleName)
+
 	// run vm
 	execRes, err := k.moveVM.ExecuteEntryFunction(
 		types.NewVMStore(sdkCtx, k.VMStore),
@@ -318,6 +336,8 @@ func (k Keeper) executeScript(
 	// delegate gas metering to move vm
 	sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
 
+	k.recordScriptModuleUsage(byteCodes)
+
 	// run vm
 	execRes, err := k.moveVM.ExecuteScript(
 		types.NewVMStore(sdkCtx, k.VMStore),
@@ -343,6 +363,91 @@ func (k Keeper) executeScript(
 	return k.handleExecuteResponse(sdkCtx, gasMeter, execRes)
 }
 
+////////////////////////////////////////
+// Profile Functions
+
+// ProfileEntryFunction executes an entry function on a branched context and
+// returns the gas usage of each module touched by the execution. The vm only
+// reports gas usage per module, so there is no per-function breakdown, and the
+// optional trace records the state accesses of the vm rather than the executed
+// instructions. The state changes and the returned cosmos messages are discarded.
+func (k Keeper) ProfileEntryFunction(
+	ctx context.Context,
+	sender vmtypes.AccountAddress,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	functionName string,
+	typeArgs []vmtypes.TypeTag,
+	args [][]byte,
+	traceStateAccess bool,
+) (*types.QueryProfileResponse, error) {
+	if !k.config.ContractProfileEnabled {
+		return nil, types.ErrProfileDisabled
+	}
+
+	payload, err := types.BuildExecuteEntryFunctionPayload(
+		moduleAddr,
+		moduleName,
+		functionName,
+		typeArgs,
+		args,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// never write the execution results to the parent context
+	sdkCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	ac := types.NextAccountNumber(sdkCtx, k.authKeeper)
+	ec, err := k.ExecutionCounter.Next(sdkCtx)
+	if err != nil {
+		return nil, err
+	}
+
+	vmStore := types.NewVMStore(sdkCtx, k.VMStore)
+	traceStore := types.NewTraceVMStore(vmStore)
+
+	var kvStore vmapi.KVStore = vmStore
+	if traceStateAccess {
+		kvStore = traceStore
+	}
+
+	execRes, err := k.moveVM.ExecuteEntryFunction(
+		kvStore,
+		NewApi(k, sdkCtx),
+		types.NewEnv(sdkCtx, ac, ec),
+		k.config.ContractSimulationGasLimit,
+		[]vmtypes.AccountAddress{sender},
+		payload,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	gasProfile := make([]types.GasProfileEntry, len(execRes.GasUsages))
+	for i, gasUsage := range execRes.GasUsages {
+		gasProfile[i] = types.GasProfileEntry{
+			ModuleAddress: gasUsage.ModuleId.Address.String(),
+			ModuleName:    string(gasUsage.ModuleId.Name),
+			GasUsed:       gasUsage.GasUsed,
+		}
+	}
+
+	res := &types.QueryProfileResponse{
+		GasUsed:       execRes.GasUsed,
+		GasProfile:    gasProfile,
+		StateAccesses: []types.StateAccess{},
+	}
+	if traceStateAccess {
+		res.StateAccesses = traceStore.Entries()
+	}
+
+	return res, nil
+}
+
 ////////////////////////////////////////
 // Response Handler
 
@@ -352,6 +457,8 @@ func (k Keeper) handleExecuteResponse(
 	execRes vmtypes.ExecutionResult,
 ) error {
 	// Emit contract events
+	var fungibleAssetEvents []string
+	var modulePublishedEvents []string
 	for _, event := range execRes.Events {
 		typeTag, err := vmapi.StringifyTypeTag(event.TypeTag)
 		if err != nil {
@@ -362,6 +469,12 @@ func (k Keeper) handleExecuteResponse(
 			sdk.NewAttribute(types.AttributeKeyTypeTag, typeTag),
 			sdk.NewAttribute(types.AttributeKeyData, event.EventData),
 		))
+
+		if typeTag == types.EventTypeTagFungibleAssetDeposit || typeTag == types.EventTypeTagFungibleAssetWithdraw {
+			fungibleAssetEvents = append(fungibleAssetEvents, event.EventData)
+		} else if typeTag == types.EventTypeTagModulePublished {
+			modulePublishedEvents = append(modulePublishedEvents, event.EventData)
+		}
 	}
 
 	// Create cosmos accounts
@@ -402,6 +515,17 @@ func (k Keeper) handleExecuteResponse(
 	// NOTE: this line should be here to avoid charging any extra gas for CSR
 	ctx = ctx.WithGasMeter(gasMeter)
 
+	// update denom owners with the balance changes; the index writes are
+	// charged to the restored gas meter
+	if err := k.updateDenomOwners(ctx, fungibleAssetEvents); err != nil {
+		return err
+	}
+
+	// enforce the publish policy on the published modules
+	if err := k.handlePublishedModules(ctx, modulePublishedEvents); err != nil {
+		return err
+	}
+
 	// apply staking delta
 	if err := k.ApplyStakingDeltas(ctx, execRes.StakingDeltas); err != nil {
 		return err
@@ -460,7 +584,8 @@ func (k Keeper) DispatchMessages(ctx context.Context, messages []vmtypes.CosmosM
 	return nil
 }
 
-// DistributeContractSharedRevenue distribute a portion of gas fee to contract creator account
+// DistributeContractSharedRevenue escrows a portion of gas fee for the revenue recipient of each module,
+// which can be claimed later by the recipient.
 func (k Keeper) DistributeContractSharedRevenue(ctx context.Context, gasUsages []vmtypes.GasUsage) error {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
@@ -470,14 +595,13 @@ func (k Keeper) DistributeContractSharedRevenue(ctx context.Context, gasUsages [
 	}
 
 	gasPrices := value.(sdk.DecCoins)
-	revenueRatio, err := k.ContractSharedRevenueRatio(ctx)
-	if err != nil {
-		return err
+	if gasPrices.IsZero() {
+		return nil
 	}
 
-	revenueGasPrices := gasPrices.MulDec(revenueRatio)
-	if revenueGasPrices.IsZero() {
-		return nil
+	defaultRevenueRatio, err := k.ContractSharedRevenueRatio(ctx)
+	if err != nil {
+		return err
 	}
 
 	for _, gasUsage := range gasUsages {
@@ -487,19 +611,39 @@ func (k Keeper) DistributeContractSharedRevenue(ctx context.Context, gasUsages [
 			continue
 		}
 
-		revenue, _ := revenueGasPrices.MulDec(sdkmath.LegacyNewDec(int64(gasUsage.GasUsed))).TruncateDecimal()
+		moduleAddr := gasUsage.ModuleId.Address
+		moduleName := string(gasUsage.ModuleId.Name)
+
+		revenueRatio, found, err := k.getRevenueRatioOverride(ctx, moduleAddr, moduleName)
+		if err != nil {
+			return err
+		} else if !found {
+			revenueRatio = defaultRevenueRatio
+		}
+
+		revenue, _ := gasPrices.MulDec(revenueRatio).MulDec(sdkmath.LegacyNewDec(int64(gasUsage.GasUsed))).TruncateDecimal()
 		if revenue.IsZero() {
 			continue
 		}
 
-		creatorAddr := types.ConvertVMAddressToSDKAddress(gasUsage.ModuleId.Address)
-		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, k.feeCollector, creatorAddr, revenue); err != nil {
+		recipient, err := k.GetRevenueRecipient(ctx, moduleAddr, moduleName)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, k.feeCollector, types.MoveRevenueModuleName, revenue); err != nil {
+			return err
+		}
+
+		if err := k.AccrueRevenue(ctx, moduleAddr, moduleName, recipient, revenue); err != nil {
 			return err
 		}
 
 		sdkCtx.EventManager().EmitEvent(sdk.NewEvent(
 			types.EventTypeContractSharedRevenue,
-			sdk.NewAttribute(types.AttributeKeyCreator, gasUsage.ModuleId.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyCreator, moduleAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyModuleName, moduleName),
+			sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
 			sdk.NewAttribute(types.AttributeKeyRevenue, revenue.String()),
 		))
 	}
@@ -527,6 +671,7 @@ func (k Keeper) ExecuteViewFunction(
 		typeArgs,
 		args,
 		false,
+		k.config.ContractQueryGasLimit,
 	)
 }
 
@@ -552,6 +697,7 @@ func (k Keeper) ExecuteViewFunctionJSON(
 		typeArgs,
 		args,
 		true,
+		k.config.ContractQueryGasLimit,
 	)
 }
 
@@ -563,6 +709,7 @@ func (k Keeper) executeViewFunction(
 	typeArgs []vmtypes.TypeTag,
 	args [][]byte,
 	isJSON bool,
+	gasLimit uint64,
 ) (vmtypes.ViewOutput, error) {
 	if payload, err := types.BuildExecuteViewFunctionPayload(
 		moduleAddr,
@@ -574,6 +721,8 @@ func (k Keeper) executeViewFunction(
 	); err != nil {
 		return vmtypes.ViewOutput{}, err
 	} else {
+		k.recordModuleUsage(moduleAddr, moduleName)
+
 		executionCounter, err := k.ExecutionCounter.Next(ctx)
 		if err != nil {
 			return vmtypes.ViewOutput{}, err
@@ -590,7 +739,7 @@ func (k Keeper) executeViewFunction(
 			types.NewVMStore(ctx, k.VMStore),
 			api,
 			env,
-			k.config.ContractQueryGasLimit,
+			gasLimit,
 			payload,
 		)
 	}
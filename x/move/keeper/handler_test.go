@@ -486,7 +486,27 @@ func Test_ContractSharedRevenue(t *testing.T) {
 	revenueRatio, err := input.MoveKeeper.ContractSharedRevenueRatio(ctx)
 	require.NoError(t, err)
 
-	// 0x1 should be zero, but 0x2 should receive the coins
+	// 0x1 should be zero, but 0x2 should accrue the coins in the escrow
+	expected := revenueRatio.MulInt64(200).TruncateInt()
+	require.Equal(t, math.ZeroInt(), input.BankKeeper.GetBalance(ctx, types.ConvertVMAddressToSDKAddress(twoAddr), bondDenom).Amount)
+	require.Equal(t, expected, input.BankKeeper.GetBalance(ctx, types.MoveRevenueModuleAddress, bondDenom).Amount)
+
+	claimable, err := input.MoveKeeper.GetClaimableRevenue(ctx, stdAddr)
+	require.NoError(t, err)
+	require.True(t, claimable.IsZero())
+
+	claimable, err = input.MoveKeeper.GetClaimableRevenue(ctx, twoAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(bondDenom, expected)), claimable)
+
+	// claim the revenue
+	claimed, err := input.MoveKeeper.ClaimRevenue(ctx, twoAddr)
+	require.NoError(t, err)
+	require.Equal(t, claimable, claimed)
 	require.Equal(t, math.ZeroInt(), input.BankKeeper.GetBalance(ctx, types.ConvertVMAddressToSDKAddress(stdAddr), bondDenom).Amount)
-	require.Equal(t, revenueRatio.MulInt64(200).TruncateInt(), input.BankKeeper.GetBalance(ctx, types.ConvertVMAddressToSDKAddress(twoAddr), bondDenom).Amount)
+	require.Equal(t, expected, input.BankKeeper.GetBalance(ctx, types.ConvertVMAddressToSDKAddress(twoAddr), bondDenom).Amount)
+
+	claimable, err = input.MoveKeeper.GetClaimableRevenue(ctx, twoAddr)
+	require.NoError(t, err)
+	require.True(t, claimable.IsZero())
 }
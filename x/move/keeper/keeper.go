@@ -8,6 +8,7 @@ import (
 	"cosmossdk.io/core/address"
 	corestoretypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -54,10 +55,58 @@ type Keeper struct {
 	DexPairs         collections.Map[[]byte, []byte]
 	VMStore          collections.Map[[]byte, []byte]
 
+	// contract shared revenue
+	RevenueRecipients collections.Map[collections.Pair[[]byte, string], []byte]
+	RevenueRatios     collections.Map[collections.Pair[[]byte, string], types.ModuleRevenueRatio]
+	ModuleRevenues    collections.Map[collections.Triple[[]byte, string, string], math.Int]
+	ClaimableRevenues collections.Map[collections.Pair[[]byte, string], math.Int]
+
+	// cron jobs
+	CronJobSequence collections.Sequence
+	CronJobs        collections.Map[uint64, types.CronJob]
+	CronQueue       collections.KeySet[collections.Pair[int64, uint64]]
+	CronRuns        collections.Map[collections.Pair[uint64, int64], types.CronRun]
+
+	// publish policy
+	PublishNamespaces collections.Map[[]byte, types.PublishNamespace]
+	PublishUsages     collections.Map[[]byte, types.PublishUsage]
+	PublishDeposits   collections.Map[collections.Pair[[]byte, string], types.PublishDeposit]
+
+	// module dependency graph, keyed by module ids (`0x1::coin`)
+	ModuleDependencies collections.KeySet[collections.Pair[string, string]]
+	ModuleDependents   collections.KeySet[collections.Pair[string, string]]
+
+	// primary fungible store owners, keyed by (metadata, owner)
+	DenomOwners collections.KeySet[collections.Pair[[]byte, []byte]]
+
+	// account authenticators, keyed by the account address
+	Authenticators collections.Map[[]byte, types.Authenticator]
+
+	// fee sponsor policies, keyed by the sponsor address
+	Sponsors collections.Map[[]byte, types.Sponsor]
+
+	// LP voting power weights observed at the beginning of the recent blocks,
+	// keyed by the LP denom and the height
+	VotingPowerWeightObservations collections.Map[collections.Pair[string, int64], types.VotingPowerWeightObservation]
+	// smoothed LP voting power weights used for the consensus power, keyed by the LP denom
+	VotingPowerWeights collections.Map[string, sdk.DecCoin]
+
 	ac address.Codec
 	vc address.Codec
 
 	vmQueryWhiteList types.VMQueryWhiteList
+
+	// node local resource index; nil if disabled
+	resourceIndex *ResourceIndex
+
+	// node local module usage record; nil if disabled
+	moduleUsage *ModuleUsage
+
+	// node local move event stream; nil if disabled
+	eventStream *EventStream
+
+	// used only for the spend limit of the execute authorization
+	authzKeeper types.AuthzKeeper
 }
 
 func NewKeeper(
@@ -127,6 +176,31 @@ func NewKeeper(
 		DexPairs:         collections.NewMap(sb, types.DexPairPrefix, "dex_pairs", collections.BytesKey, collections.BytesValue),
 		VMStore:          collections.NewMap(sb, types.VMStorePrefix, "vm_store", collections.BytesKey, collections.BytesValue),
 
+		RevenueRecipients: collections.NewMap(sb, types.RevenueRecipientPrefix, "revenue_recipients", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), collections.BytesValue),
+		RevenueRatios:     collections.NewMap(sb, types.RevenueRatioPrefix, "revenue_ratios", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), codec.CollValue[types.ModuleRevenueRatio](cdc)),
+		ModuleRevenues:    collections.NewMap(sb, types.ModuleRevenuePrefix, "module_revenues", collections.TripleKeyCodec(collections.BytesKey, collections.StringKey, collections.StringKey), sdk.IntValue),
+		ClaimableRevenues: collections.NewMap(sb, types.ClaimableRevenuePrefix, "claimable_revenues", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), sdk.IntValue),
+
+		CronJobSequence: collections.NewSequence(sb, types.CronJobSequenceKey, "cron_job_sequence"),
+		CronJobs:        collections.NewMap(sb, types.CronJobPrefix, "cron_jobs", collections.Uint64Key, codec.CollValue[types.CronJob](cdc)),
+		CronQueue:       collections.NewKeySet(sb, types.CronQueuePrefix, "cron_queue", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		CronRuns:        collections.NewMap(sb, types.CronRunPrefix, "cron_runs", collections.PairKeyCodec(collections.Uint64Key, collections.Int64Key), codec.CollValue[types.CronRun](cdc)),
+
+		PublishNamespaces: collections.NewMap(sb, types.PublishNamespacePrefix, "publish_namespaces", collections.BytesKey, codec.CollValue[types.PublishNamespace](cdc)),
+		PublishUsages:     collections.NewMap(sb, types.PublishUsagePrefix, "publish_usages", collections.BytesKey, codec.CollValue[types.PublishUsage](cdc)),
+		PublishDeposits:   collections.NewMap(sb, types.PublishDepositPrefix, "publish_deposits", collections.PairKeyCodec(collections.BytesKey, collections.StringKey), codec.CollValue[types.PublishDeposit](cdc)),
+
+		ModuleDependencies: collections.NewKeySet(sb, types.ModuleDependencyPrefix, "module_dependencies", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+		ModuleDependents:   collections.NewKeySet(sb, types.ModuleDependentPrefix, "module_dependents", collections.PairKeyCodec(collections.StringKey, collections.StringKey)),
+
+		DenomOwners: collections.NewKeySet(sb, types.DenomOwnerPrefix, "denom_owners", collections.PairKeyCodec(collections.BytesKey, collections.BytesKey)),
+
+		Authenticators: collections.NewMap(sb, types.AuthenticatorPrefix, "authenticators", collections.BytesKey, codec.CollValue[types.Authenticator](cdc)),
+		Sponsors:       collections.NewMap(sb, types.SponsorPrefix, "sponsors", collections.BytesKey, codec.CollValue[types.Sponsor](cdc)),
+
+		VotingPowerWeightObservations: collections.NewMap(sb, types.VotingPowerWeightObservationPrefix, "voting_power_weight_observations", collections.PairKeyCodec(collections.StringKey, collections.Int64Key), codec.CollValue[types.VotingPowerWeightObservation](cdc)),
+		VotingPowerWeights:            collections.NewMap(sb, types.VotingPowerWeightPrefix, "voting_power_weights", collections.StringKey, codec.CollValue[sdk.DecCoin](cdc)),
+
 		ac: ac,
 		vc: vc,
 
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the defaults of the params introduced in version 2 to the
+// params stored before, rebuilds the module dependency index, which misses
+// the modules published through entry functions and scripts before version 2,
+// and builds the denom owner index from the primary fungible stores.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	if err := m.migrateParams(ctx); err != nil {
+		return err
+	}
+
+	if err := m.keeper.RebuildModuleDependencies(ctx); err != nil {
+		return err
+	}
+
+	return m.keeper.rebuildDenomOwners(ctx)
+}
+
+// migrateParams sets the default values of the new params, when the stored
+// params are in the encoding of version 1 (i.e. without the publish deposit).
+func (m Migrator) migrateParams(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	} else if !params.PublishDeposit.IsNil() {
+		return nil
+	}
+
+	defaultParams := types.DefaultParams()
+	params.CronMaxGasPerBlock = defaultParams.CronMaxGasPerBlock
+	params.CronMaxFailures = defaultParams.CronMaxFailures
+	params.PublishMode = defaultParams.PublishMode
+	params.PublishDeposit = defaultParams.PublishDeposit
+	params.PublishQuota = defaultParams.PublishQuota
+	params.PublishQuotaWindow = defaultParams.PublishQuotaWindow
+	params.AuthenticatorGasLimit = defaultParams.AuthenticatorGasLimit
+	params.SponsorGasLimit = defaultParams.SponsorGasLimit
+	params.VotingPowerTwapWindow = defaultParams.VotingPowerTwapWindow
+	params.MaxVotingPowerWeightChangeRate = defaultParams.MaxVotingPowerWeightChangeRate
+	if err := params.Validate(m.keeper.ac); err != nil {
+		return err
+	}
+
+	return m.keeper.SetRawParams(ctx, params.ToRaw())
+}
@@ -0,0 +1,96 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/rootmulti"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_Migrate1to2_Params(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	// keep only the fields of the params stored before version 2
+	storeKey := input.MultiStore.(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	var stripped []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		if num <= 3 {
+			stripped = append(stripped, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	store.Set(types.ParamsKey, stripped)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, params.PublishDeposit.IsNil())
+	require.True(t, params.MaxVotingPowerWeightChangeRate.IsNil())
+
+	m := keeper.NewMigrator(&input.MoveKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	params, err = input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.NoError(t, params.Validate(input.AccountKeeper.AddressCodec()))
+
+	defaultParams := types.DefaultParams()
+	require.Equal(t, defaultParams.CronMaxGasPerBlock, params.CronMaxGasPerBlock)
+	require.Equal(t, defaultParams.CronMaxFailures, params.CronMaxFailures)
+	require.Equal(t, defaultParams.PublishMode, params.PublishMode)
+	require.Equal(t, defaultParams.PublishDeposit, params.PublishDeposit)
+	require.Equal(t, defaultParams.AuthenticatorGasLimit, params.AuthenticatorGasLimit)
+	require.Equal(t, defaultParams.SponsorGasLimit, params.SponsorGasLimit)
+	require.Equal(t, defaultParams.VotingPowerTwapWindow, params.VotingPowerTwapWindow)
+	require.Equal(t, defaultParams.MaxVotingPowerWeightChangeRate, params.MaxVotingPowerWeightChangeRate)
+
+	// the old params are kept
+	require.Equal(t, bondDenom, params.BaseDenom)
+
+	// publish works after the migration
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+}
+
+func Test_Migrate1to2_DenomOwners(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	testDenom := testDenoms[0]
+	addr := input.Faucet.NewFundedAccount(ctx, sdk.NewCoin(testDenom, math.NewInt(100)))
+
+	metadata, err := types.MetadataAddressFromDenom(testDenom)
+	require.NoError(t, err)
+	owner, err := vmtypes.NewAccountAddressFromBytes(addr)
+	require.NoError(t, err)
+	key := collections.Join(metadata.Bytes(), owner.Bytes())
+
+	// drop the index, as on the chains before version 2
+	require.NoError(t, input.MoveKeeper.DenomOwners.Clear(ctx, nil))
+	found, err := input.MoveKeeper.DenomOwners.Has(ctx, key)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	m := keeper.NewMigrator(&input.MoveKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	found, err = input.MoveKeeper.DenomOwners.Has(ctx, key)
+	require.NoError(t, err)
+	require.True(t, found)
+}
@@ -0,0 +1,314 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/telemetry"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// ModuleUsageFileName is the name of the module usage file in the data directory.
+const ModuleUsageFileName = "move_module_usage.json"
+
+// moduleUsageEntry is the persisted usage of a module. The checksum is the
+// checksum of the module when the usage was saved, and the usage is dropped
+// when the module is republished with the other checksum.
+type moduleUsageEntry struct {
+	Address  string `json:"address"`
+	Name     string `json:"name"`
+	Checksum []byte `json:"checksum,omitempty"`
+	Hits     uint64 `json:"hits"`
+}
+
+type moduleUsageKey struct {
+	addr string
+	name string
+}
+
+// ModuleUsage is a node local record of the module executions, which is used
+// to warm up the module cache of the vm with the most used modules after the
+// node restart. The record is kept in memory and saved to the data directory
+// when the node stops, so it never affects the consensus state.
+//
+// Only the list of the modules is persisted. The vm does not expose its
+// module cache, so neither the verified modules nor the cache hits can be
+// saved or observed from here, and the modules are reloaded on start instead.
+type ModuleUsage struct {
+	mu sync.Mutex
+
+	path     string
+	capacity uint64
+
+	entries map[moduleUsageKey]*moduleUsageEntry
+}
+
+// NewModuleUsage returns new ModuleUsage instance, which records at most
+// the capacity number of modules to the file of the path.
+func NewModuleUsage(path string, capacity uint64) *ModuleUsage {
+	return &ModuleUsage{
+		path:     path,
+		capacity: capacity,
+		entries:  make(map[moduleUsageKey]*moduleUsageEntry),
+	}
+}
+
+// OpenModuleUsage opens the module usage file in the data directory.
+func OpenModuleUsage(dataDir string, capacity uint64) (*ModuleUsage, error) {
+	usage := NewModuleUsage(filepath.Join(dataDir, ModuleUsageFileName), capacity)
+
+	bz, err := os.ReadFile(usage.path)
+	if err != nil && errors.Is(err, os.ErrNotExist) {
+		return usage, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var entries []moduleUsageEntry
+	if err := json.Unmarshal(bz, &entries); err != nil {
+		return nil, err
+	}
+
+	for _, entry := range entries {
+		entry := entry
+		usage.entries[moduleUsageKey{entry.Address, entry.Name}] = &entry
+	}
+
+	return usage, nil
+}
+
+// Record increases the hit count of the module.
+func (u *ModuleUsage) Record(addr vmtypes.AccountAddress, name string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	key := moduleUsageKey{addr.String(), name}
+	entry, found := u.entries[key]
+	if !found {
+		entry = &moduleUsageEntry{Address: addr.String(), Name: name}
+		u.entries[key] = entry
+	}
+
+	entry.Hits++
+}
+
+// hot returns the most used modules ordered by the hit count, up to the capacity.
+func (u *ModuleUsage) hot() []moduleUsageEntry {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	entries := make([]moduleUsageEntry, 0, len(u.entries))
+	for _, entry := range u.entries {
+		entries = append(entries, *entry)
+	}
+
+	slices.SortFunc(entries, func(a, b moduleUsageEntry) int {
+		if a.Hits != b.Hits {
+			if a.Hits > b.Hits {
+				return -1
+			}
+
+			return 1
+		}
+
+		if c := strings.Compare(a.Address, b.Address); c != 0 {
+			return c
+		}
+
+		return strings.Compare(a.Name, b.Name)
+	})
+
+	if uint64(len(entries)) > u.capacity {
+		entries = entries[:u.capacity]
+	}
+
+	return entries
+}
+
+// drop removes the usage of the module.
+func (u *ModuleUsage) drop(addr string, name string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	delete(u.entries, moduleUsageKey{addr, name})
+}
+
+// SetModuleUsage sets the node local module usage record.
+func (k *Keeper) SetModuleUsage(moduleUsage *ModuleUsage) {
+	k.moduleUsage = moduleUsage
+}
+
+// GetModuleUsage returns the node local module usage record, or nil if disabled.
+func (k Keeper) GetModuleUsage() *ModuleUsage {
+	return k.moduleUsage
+}
+
+// recordModuleUsage records the execution of the module, if the module usage is enabled.
+func (k Keeper) recordModuleUsage(addr vmtypes.AccountAddress, name string) {
+	if k.moduleUsage != nil {
+		k.moduleUsage.Record(addr, name)
+	}
+}
+
+// recordScriptModuleUsage records the modules the script depends on, if the
+// module usage is enabled. The invalid script is ignored, which is rejected
+// by the vm anyway.
+func (k Keeper) recordScriptModuleUsage(script []byte) {
+	if k.moduleUsage == nil {
+		return
+	}
+
+	moduleIds, err := types.ReadScriptDependencies(script)
+	if err != nil {
+		return
+	}
+
+	for _, moduleId := range moduleIds {
+		k.moduleUsage.Record(moduleId.Address, string(moduleId.Name))
+	}
+}
+
+// getModuleChecksum returns the checksum of the module, or nil if the module does not exist.
+func (k Keeper) getModuleChecksum(ctx context.Context, addr vmtypes.AccountAddress, name string) ([]byte, error) {
+	checksumKey, err := types.GetChecksumKey(addr, name)
+	if err != nil {
+		return nil, err
+	}
+
+	checksum, err := k.VMStore.Get(ctx, checksumKey)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	return checksum, nil
+}
+
+// WarmupModuleCache loads the most used modules of the module usage record
+// into the module cache of the vm, by executing a script which depends on
+// the modules. The modules which are removed or republished since the record
+// was saved are dropped from the record. The state changes are discarded.
+func (k Keeper) WarmupModuleCache(ctx context.Context) error {
+	if k.moduleUsage == nil {
+		return nil
+	}
+
+	defer telemetry.MeasureSince(time.Now(), "move", "module_cache", "warmup")
+
+	var moduleIds []vmtypes.ModuleId
+	for _, entry := range k.moduleUsage.hot() {
+		addr, err := vmtypes.NewAccountAddress(entry.Address)
+		if err != nil {
+			return err
+		}
+
+		checksum, err := k.getModuleChecksum(ctx, addr, entry.Name)
+		if err != nil {
+			return err
+		}
+
+		if checksum == nil || !bytes.Equal(checksum, entry.Checksum) {
+			k.moduleUsage.drop(entry.Address, entry.Name)
+			continue
+		}
+
+		moduleIds = append(moduleIds, vmtypes.ModuleId{
+			Address: addr,
+			Name:    vmtypes.Identifier(entry.Name),
+		})
+	}
+
+	if len(moduleIds) == 0 {
+		return nil
+	}
+
+	script, err := types.BuildModuleLoaderScript(moduleIds)
+	if err != nil {
+		return err
+	}
+
+	payload, err := types.BuildExecuteScriptPayload(script, []vmtypes.TypeTag{}, [][]byte{}, false)
+	if err != nil {
+		return err
+	}
+
+	// never write the execution results to the parent context
+	sdkCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	ac := types.NextAccountNumber(sdkCtx, k.authKeeper)
+	ec, err := k.ExecutionCounter.Next(sdkCtx)
+	if err != nil {
+		return err
+	}
+
+	_, err = k.moveVM.ExecuteScript(
+		types.NewVMStore(sdkCtx, k.VMStore),
+		NewApi(k, sdkCtx),
+		types.NewEnv(sdkCtx, ac, ec),
+		math.MaxUint64,
+		[]vmtypes.AccountAddress{},
+		payload,
+	)
+	if err != nil {
+		return err
+	}
+
+	telemetry.SetGauge(float32(len(moduleIds)), "move", "module_cache", "warmup_modules")
+
+	return nil
+}
+
+// SaveModuleUsage saves the most used modules of the module usage record
+// with the current checksums of the modules to the data directory.
+func (k Keeper) SaveModuleUsage(ctx context.Context) error {
+	if k.moduleUsage == nil {
+		return nil
+	}
+
+	entries := k.moduleUsage.hot()
+	saved := make([]moduleUsageEntry, 0, len(entries))
+	for _, entry := range entries {
+		addr, err := vmtypes.NewAccountAddress(entry.Address)
+		if err != nil {
+			return err
+		}
+
+		checksum, err := k.getModuleChecksum(ctx, addr, entry.Name)
+		if err != nil {
+			return err
+		} else if checksum == nil {
+			continue
+		}
+
+		entry.Checksum = checksum
+		saved = append(saved, entry)
+	}
+
+	bz, err := json.Marshal(saved)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(k.moduleUsage.path), 0o755); err != nil {
+		return err
+	}
+
+	return os.WriteFile(k.moduleUsage.path, bz, 0o600)
+}
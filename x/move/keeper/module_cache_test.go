@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/move/config"
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func TestModuleCacheWarmup(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	dataDir := t.TempDir()
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testAddressModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	// nothing to warm up without the record
+	require.NoError(t, input.MoveKeeper.WarmupModuleCache(ctx))
+
+	moduleUsage, err := keeper.OpenModuleUsage(dataDir, 10)
+	require.NoError(t, err)
+	input.MoveKeeper.SetModuleUsage(moduleUsage)
+
+	// record the module executions
+	vmAddr, err := vmtypes.NewAccountAddressFromBytes(addrs[0])
+	require.NoError(t, err)
+	for i := 0; i < 2; i++ {
+		_, err = input.MoveKeeper.ExecuteViewFunction(ctx, vmtypes.TestAddress, "TestAddress", "to_sdk", []vmtypes.TypeTag{}, [][]byte{vmAddr.Bytes()})
+		require.NoError(t, err)
+	}
+	_, err = input.MoveKeeper.ExecuteViewFunction(ctx, vmtypes.StdAddress, "coin", "decimals", []vmtypes.TypeTag{}, [][]byte{make([]byte, 32)})
+	require.Error(t, err)
+
+	require.NoError(t, input.MoveKeeper.SaveModuleUsage(ctx))
+
+	bz, err := os.ReadFile(filepath.Join(dataDir, keeper.ModuleUsageFileName))
+	require.NoError(t, err)
+
+	var entries []struct {
+		Address  string `json:"address"`
+		Name     string `json:"name"`
+		Checksum []byte `json:"checksum"`
+		Hits     uint64 `json:"hits"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &entries))
+	require.Len(t, entries, 2)
+	require.Equal(t, vmtypes.TestAddress.String(), entries[0].Address)
+	require.Equal(t, "TestAddress", entries[0].Name)
+	require.Equal(t, uint64(2), entries[0].Hits)
+	require.NotEmpty(t, entries[0].Checksum)
+	require.Equal(t, "coin", entries[1].Name)
+	require.Equal(t, uint64(1), entries[1].Hits)
+
+	// the module republished with the other checksum and the removed module are dropped
+	entries[1].Checksum = []byte("republished")
+	entries = append(entries, entries[0])
+	entries[2].Name = "Unknown"
+	bz, err = json.Marshal(entries)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dataDir, keeper.ModuleUsageFileName), bz, 0o600))
+
+	// restart
+	moduleUsage, err = keeper.OpenModuleUsage(dataDir, 10)
+	require.NoError(t, err)
+	input.MoveKeeper.SetModuleUsage(moduleUsage)
+
+	require.NoError(t, input.MoveKeeper.WarmupModuleCache(ctx))
+	require.NoError(t, input.MoveKeeper.SaveModuleUsage(ctx))
+
+	bz, err = os.ReadFile(filepath.Join(dataDir, keeper.ModuleUsageFileName))
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(bz, &entries))
+	require.Len(t, entries, 1)
+	require.Equal(t, "TestAddress", entries[0].Name)
+	require.Equal(t, uint64(2), entries[0].Hits)
+}
+
+func TestModuleUsage_Script(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	dataDir := t.TempDir()
+
+	moduleUsage, err := keeper.OpenModuleUsage(dataDir, 10)
+	require.NoError(t, err)
+	input.MoveKeeper.SetModuleUsage(moduleUsage)
+
+	// the modules loaded by the script are recorded
+	argBz, err := vmtypes.SerializeUint64(200)
+	require.NoError(t, err)
+	err = input.MoveKeeper.ExecuteScript(ctx, vmtypes.TestAddress,
+		basicCoinMintScript,
+		[]vmtypes.TypeTag{MustConvertStringToTypeTag("0x1::BasicCoin::Initia"), MustConvertStringToTypeTag("bool")},
+		[][]byte{argBz},
+	)
+	require.NoError(t, err)
+
+	require.NoError(t, input.MoveKeeper.SaveModuleUsage(ctx))
+
+	bz, err := os.ReadFile(filepath.Join(dataDir, keeper.ModuleUsageFileName))
+	require.NoError(t, err)
+
+	var entries []struct {
+		Address string `json:"address"`
+		Name    string `json:"name"`
+		Hits    uint64 `json:"hits"`
+	}
+	require.NoError(t, json.Unmarshal(bz, &entries))
+
+	names := []string{}
+	for _, entry := range entries {
+		names = append(names, entry.Name)
+	}
+	require.Contains(t, names, "BasicCoin")
+
+	// the warmup is disabled by default
+	require.Zero(t, config.DefaultModuleCacheWarmupCount)
+}
@@ -80,15 +80,17 @@ func (ms MsgServer) Execute(context context.Context, req *types.MsgExecute) (*ty
 		return nil, err
 	}
 
-	err = ms.Keeper.ExecuteEntryFunction(
-		ctx,
-		sender,
-		moduleAddr,
-		req.ModuleName,
-		req.FunctionName,
-		typeTags,
-		req.Args,
-	)
+	err = ms.Keeper.executeWithSpendLimit(ctx, sender, func() error {
+		return ms.Keeper.ExecuteEntryFunction(
+			ctx,
+			sender,
+			moduleAddr,
+			req.ModuleName,
+			req.FunctionName,
+			typeTags,
+			req.Args,
+		)
+	})
 	if err != nil {
 		return nil, err
 	}
@@ -225,7 +227,7 @@ func (ms MsgServer) GovPublish(context context.Context, req *types.MsgGovPublish
 		modules = append(modules, vmtypes.NewModule(module))
 	}
 
-	err = ms.Keeper.PublishModuleBundle(
+	err = ms.Keeper.GovPublishModuleBundle(
 		ctx,
 		sender,
 		vmtypes.NewModuleBundle(modules...),
@@ -455,3 +457,263 @@ func (ms MsgServer) UpdateParams(context context.Context, req *types.MsgUpdatePa
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (ms MsgServer) SetRevenueRecipient(context context.Context, req *types.MsgSetRevenueRecipient) (*types.MsgSetRevenueRecipientResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "set-revenue-recipient")
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	// only the module publisher, which is the module address, can register the recipient
+	moduleAddr, err := types.AccAddressFromString(ms.ac, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := types.AccAddressFromString(ms.ac, req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetRevenueRecipient(ctx, moduleAddr, req.ModuleName, recipient); err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSetRevenueRecipient,
+		sdk.NewAttribute(types.AttributeKeyModuleAddr, moduleAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyModuleName, req.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+	))
+
+	return &types.MsgSetRevenueRecipientResponse{}, nil
+}
+
+func (ms MsgServer) ClaimRevenue(context context.Context, req *types.MsgClaimRevenue) (*types.MsgClaimRevenueResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "claim-revenue")
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	recipientStr := req.Recipient
+	if recipientStr == "" {
+		recipientStr = req.Sender
+	}
+
+	recipient, err := types.AccAddressFromString(ms.ac, recipientStr)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := ms.Keeper.ClaimRevenue(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimRevenueResponse{Amount: amount}, nil
+}
+
+func (ms MsgServer) UpdateRevenueRatio(context context.Context, req *types.MsgUpdateRevenueRatio) (*types.MsgUpdateRevenueRatioResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "update-revenue-ratio")
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	moduleAddr, err := types.AccAddressFromString(ms.ac, req.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetRevenueRatio(ctx, moduleAddr, req.ModuleName, req.Ratio); err != nil {
+		return nil, err
+	}
+
+	ratio := ""
+	if req.Ratio != nil {
+		ratio = req.Ratio.String()
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeUpdateRevenueRatio,
+		sdk.NewAttribute(types.AttributeKeyModuleAddr, moduleAddr.String()),
+		sdk.NewAttribute(types.AttributeKeyModuleName, req.ModuleName),
+		sdk.NewAttribute(types.AttributeKeyRatio, ratio),
+	))
+
+	return &types.MsgUpdateRevenueRatioResponse{}, nil
+}
+
+func (ms MsgServer) RegisterCronJob(context context.Context, req *types.MsgRegisterCronJob) (*types.MsgRegisterCronJobResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "register-cron-job")
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	id, err := ms.Keeper.RegisterCronJob(ctx, types.CronJob{
+		Creator:       req.Sender,
+		ModuleAddress: req.ModuleAddress,
+		ModuleName:    req.ModuleName,
+		FunctionName:  req.FunctionName,
+		TypeArgs:      req.TypeArgs,
+		Args:          req.Args,
+		Interval:      req.Interval,
+		GasLimit:      req.GasLimit,
+		Deposit:       req.Deposit,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterCronJobResponse{Id: id}, nil
+}
+
+func (ms MsgServer) DepositCronJob(context context.Context, req *types.MsgDepositCronJob) (*types.MsgDepositCronJobResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "deposit-cron-job")
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	sender, err := ms.ac.StringToBytes(req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.DepositCronJob(ctx, sender, req.Id, req.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDepositCronJobResponse{}, nil
+}
+
+func (ms MsgServer) DeregisterCronJob(context context.Context, req *types.MsgDeregisterCronJob) (*types.MsgDeregisterCronJobResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "deregister-cron-job")
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	job, err := ms.Keeper.GetCronJob(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	if job.Creator != req.Sender {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "only the creator can deregister the cron job; expected %s, got %s", job.Creator, req.Sender)
+	}
+
+	if err := ms.Keeper.DeregisterCronJob(ctx, req.Id, types.AttributeValueReasonDeregistered); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterCronJobResponse{}, nil
+}
+
+// SetPublishNamespace implements setting the publish namespace of a publisher via gov proposal
+func (ms MsgServer) SetPublishNamespace(context context.Context, req *types.MsgSetPublishNamespace) (*types.MsgSetPublishNamespaceResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "set-publish-namespace")
+	if ms.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", ms.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	addr, err := types.AccAddressFromString(ms.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetPublishNamespace(ctx, addr, req.Prefixes); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetPublishNamespaceResponse{}, nil
+}
+
+// SetAuthenticator implements setting or removing the authenticator of the sender
+func (ms MsgServer) SetAuthenticator(context context.Context, req *types.MsgSetAuthenticator) (*types.MsgSetAuthenticatorResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "set-authenticator")
+
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	sender, err := types.AccAddressFromString(ms.ac, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.ModuleAddress) == 0 {
+		if err := ms.Keeper.RemoveAuthenticator(ctx, sender); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSetAuthenticatorResponse{}, nil
+	}
+
+	moduleAddr, err := types.AccAddressFromString(ms.ac, req.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetAuthenticator(ctx, sender, moduleAddr, req.ModuleName, req.FunctionName); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetAuthenticatorResponse{}, nil
+}
+
+// SetSponsor implements setting or removing the fee sponsor policy
+func (ms MsgServer) SetSponsor(context context.Context, req *types.MsgSetSponsor) (*types.MsgSetSponsorResponse, error) {
+	defer telemetry.MeasureSince(time.Now(), "move", "msg", "set-sponsor")
+
+	ctx := sdk.UnwrapSDKContext(context)
+	if err := req.Validate(ms.ac); err != nil {
+		return nil, err
+	}
+
+	sender, err := types.AccAddressFromString(ms.ac, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsor, err := types.AccAddressFromString(ms.ac, req.Sponsor)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.CheckSponsorOwner(ctx, sender, sponsor); err != nil {
+		return nil, err
+	}
+
+	if len(req.ModuleAddress) == 0 {
+		if err := ms.Keeper.RemoveSponsor(ctx, sponsor); err != nil {
+			return nil, err
+		}
+
+		return &types.MsgSetSponsorResponse{}, nil
+	}
+
+	moduleAddr, err := types.AccAddressFromString(ms.ac, req.ModuleAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ms.Keeper.SetSponsor(ctx, sponsor, moduleAddr, req.ModuleName, req.FunctionName); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetSponsorResponse{}, nil
+}
@@ -28,6 +28,24 @@ func (k Keeper) BaseMinGasPrice(ctx context.Context) (math.LegacyDec, error) {
 	return params.BaseMinGasPrice, nil
 }
 
+// AuthenticatorGasLimit - max gas of an account authenticator run
+func (k Keeper) AuthenticatorGasLimit(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return params.AuthenticatorGasLimit, nil
+}
+
+// SponsorGasLimit - max gas of a sponsor policy run
+func (k Keeper) SponsorGasLimit(ctx context.Context) (uint64, error) {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, err
+	}
+	return params.SponsorGasLimit, nil
+}
+
 // AllowedPublishers - allowed publishers
 func (k Keeper) AllowedPublishers(ctx context.Context) ([]vmtypes.AccountAddress, error) {
 	return NewCodeKeeper(&k).GetAllowedPublishers(ctx)
@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"math"
+	"slices"
+	"strings"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// validatePolicyFunction checks the function is a non-generic view function
+// with the given parameter types which returns a bool, so it can be used by
+// the authenticators and the sponsors.
+func (k Keeper) validatePolicyFunction(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	functionName string,
+	params []string,
+	errType *errorsmod.Error,
+) error {
+	module, err := k.GetModule(ctx, moduleAddr, moduleName)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.ErrModuleNotFound.Wrapf("%s::%s", moduleAddr, moduleName)
+	} else if err != nil {
+		return err
+	}
+
+	var abi moduleABI
+	if err := json.Unmarshal([]byte(module.Abi), &abi); err != nil {
+		return err
+	}
+
+	idx := slices.IndexFunc(abi.ExposedFunctions, func(function functionABI) bool {
+		return function.Name == functionName
+	})
+	if idx == -1 {
+		return errType.Wrapf("function not found: %s::%s::%s", moduleAddr, moduleName, functionName)
+	}
+
+	function := abi.ExposedFunctions[idx]
+	if !function.IsView ||
+		len(function.GenericTypeParams) != 0 ||
+		!slices.Equal(function.Params, params) ||
+		!slices.Equal(function.Return, []string{"bool"}) {
+		return errType.Wrapf(
+			"%s::%s::%s must be a view function of fun(%s): bool",
+			moduleAddr, moduleName, functionName, strings.Join(params, ", "),
+		)
+	}
+
+	return nil
+}
+
+// executePolicyFunction executes the view function of a policy and returns
+// whether the policy accepts the args. The gas used by the function is bounded
+// by the gas limit and the remaining gas of the context, and consumed from
+// the gas meter of the context.
+func (k Keeper) executePolicyFunction(
+	ctx context.Context,
+	gasLimit uint64,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	functionName string,
+	args [][]byte,
+	descriptor string,
+) (bool, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	gasMeter := sdkCtx.GasMeter()
+	if gasMeter.Limit() != 0 && gasMeter.Limit() != math.MaxUint64 {
+		gasLimit = min(gasLimit, gasMeter.Limit()-gasMeter.GasConsumedToLimit())
+	}
+
+	// delegate gas metering to move vm
+	output, err := k.executeViewFunction(
+		sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter()),
+		moduleAddr,
+		moduleName,
+		functionName,
+		[]vmtypes.TypeTag{},
+		args,
+		false,
+		gasLimit,
+	)
+
+	// consume gas first and check error
+	gasMeter.ConsumeGas(output.GasUsed, descriptor)
+	if err != nil {
+		return false, err
+	}
+
+	return output.Ret == "true", nil
+}
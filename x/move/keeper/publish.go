@@ -0,0 +1,285 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// handlePublishedModules handles the modules published by `0x1::code::publish`
+// during an execution, whatever the entry point is (publish message, entry
+// function, script or cron job). The publish policy is enforced on the owner of
+// the modules unless the modules are published by the governance, the module
+// dependencies are indexed and the publish deposits of the frozen modules are
+// released.
+func (k Keeper) handlePublishedModules(ctx context.Context, eventDatas []string) error {
+	if len(eventDatas) == 0 {
+		return nil
+	}
+
+	var owners []vmtypes.AccountAddress
+	ownerModuleIds := make(map[vmtypes.AccountAddress][]vmtypes.ModuleId)
+	var frozenModuleIds []vmtypes.ModuleId
+	for _, eventData := range eventDatas {
+		moduleId, upgradePolicy, err := types.ParseModulePublishedEvent(eventData)
+		if err != nil {
+			return err
+		}
+
+		if _, found := ownerModuleIds[moduleId.Address]; !found {
+			owners = append(owners, moduleId.Address)
+		}
+		ownerModuleIds[moduleId.Address] = append(ownerModuleIds[moduleId.Address], moduleId)
+
+		if upgradePolicy == types.UpgradePolicy_IMMUTABLE {
+			frozenModuleIds = append(frozenModuleIds, moduleId)
+		}
+	}
+
+	if !types.IsGovPublish(ctx) {
+		for _, owner := range owners {
+			if err := k.enforcePublishPolicy(ctx, owner, ownerModuleIds[owner]); err != nil {
+				return err
+			}
+		}
+	}
+
+	for _, owner := range owners {
+		for _, moduleId := range ownerModuleIds[owner] {
+			if err := k.indexPublishedModuleDependencies(ctx, moduleId); err != nil {
+				return err
+			}
+		}
+	}
+
+	// the frozen modules can not be upgraded anymore, so release the publish deposits
+	return k.refundPublishDeposits(ctx, frozenModuleIds)
+}
+
+// enforcePublishPolicy checks the publish mode, the namespace and the quota of
+// the sender, and charges the publish deposit for the modules which do not
+// hold a deposit yet. Modules published before the deposit was required, or by
+// the governance, are charged on their next publish by the owner.
+func (k Keeper) enforcePublishPolicy(
+	ctx context.Context,
+	sender vmtypes.AccountAddress,
+	moduleIds []vmtypes.ModuleId,
+) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	switch params.EffectivePublishMode() {
+	case types.PublishModeGovernance:
+		return types.ErrUnauthorized.Wrap("publish is allowed only via governance proposal")
+	case types.PublishModeAllowlist:
+		if allowed, err := k.isAllowedPublisher(ctx, sender); err != nil {
+			return err
+		} else if !allowed {
+			return types.ErrUnauthorized.Wrapf("%s is not an allowed publisher", sender)
+		}
+	}
+
+	namespace, err := k.GetPublishNamespace(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	for _, moduleId := range moduleIds {
+		if !namespace.HasPrefix(string(moduleId.Name)) {
+			return types.ErrUnauthorized.Wrapf("module name %s is out of the publish namespace of %s", moduleId.Name, sender)
+		}
+	}
+
+	if err := k.consumePublishQuota(ctx, params, sender, uint64(len(moduleIds))); err != nil {
+		return err
+	}
+
+	if !params.PublishDeposit.IsPositive() {
+		return nil
+	}
+
+	for _, moduleId := range moduleIds {
+		if found, err := k.PublishDeposits.Has(ctx, collections.Join(moduleId.Address.Bytes(), string(moduleId.Name))); err != nil {
+			return err
+		} else if found {
+			continue
+		}
+
+		if err := k.chargePublishDeposit(ctx, sender, moduleId, sdk.NewCoin(params.BaseDenom, params.PublishDeposit)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (k Keeper) isAllowedPublisher(ctx context.Context, sender vmtypes.AccountAddress) (bool, error) {
+	allowedPublishers, err := k.AllowedPublishers(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, allowedPublisher := range allowedPublishers {
+		if allowedPublisher.Equals(sender) {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
+
+// consumePublishQuota increases the publish usage of the sender in the current
+// quota window, and returns an error when the usage exceeds the quota.
+func (k Keeper) consumePublishQuota(ctx context.Context, params types.Params, sender vmtypes.AccountAddress, count uint64) error {
+	if params.PublishQuota == 0 {
+		return nil
+	}
+
+	usage, err := k.GetPublishUsage(ctx, params, sender)
+	if err != nil {
+		return err
+	}
+
+	usage.Count += count
+	if usage.Count > params.PublishQuota {
+		return types.ErrLimit.Wrapf("publish quota %d modules per %d blocks", params.PublishQuota, params.PublishQuotaWindow)
+	}
+
+	return k.PublishUsages.Set(ctx, sender.Bytes(), usage)
+}
+
+// GetPublishUsage returns the publish usage of the address in the current quota window.
+func (k Keeper) GetPublishUsage(ctx context.Context, params types.Params, addr vmtypes.AccountAddress) (types.PublishUsage, error) {
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+
+	usage, err := k.PublishUsages.Get(ctx, addr.Bytes())
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return types.PublishUsage{}, err
+	}
+
+	// start a new window when the last window is over
+	if err != nil || height >= usage.WindowStartHeight+int64(params.PublishQuotaWindow) {
+		return types.PublishUsage{
+			Address:           addr.String(),
+			WindowStartHeight: height,
+			Count:             0,
+		}, nil
+	}
+
+	return usage, nil
+}
+
+func (k Keeper) chargePublishDeposit(ctx context.Context, sender vmtypes.AccountAddress, moduleId vmtypes.ModuleId, amount sdk.Coin) error {
+	depositor := types.ConvertVMAddressToSDKAddress(sender)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, depositor, types.MovePublishModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	moduleName := string(moduleId.Name)
+	if err := k.PublishDeposits.Set(ctx, collections.Join(moduleId.Address.Bytes(), moduleName), types.PublishDeposit{
+		ModuleAddress: moduleId.Address.String(),
+		ModuleName:    moduleName,
+		Depositor:     depositor.String(),
+		Amount:        amount,
+	}); err != nil {
+		return err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypePublishDeposit,
+		sdk.NewAttribute(types.AttributeKeyModuleAddr, moduleId.Address.String()),
+		sdk.NewAttribute(types.AttributeKeyModuleName, moduleName),
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
+
+// refundPublishDeposits refunds the publish deposits of the frozen modules to the depositors.
+func (k Keeper) refundPublishDeposits(ctx context.Context, moduleIds []vmtypes.ModuleId) error {
+	for _, moduleId := range moduleIds {
+		moduleName := string(moduleId.Name)
+		key := collections.Join(moduleId.Address.Bytes(), moduleName)
+
+		deposit, err := k.PublishDeposits.Get(ctx, key)
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			continue
+		} else if err != nil {
+			return err
+		}
+
+		depositor, err := k.ac.StringToBytes(deposit.Depositor)
+		if err != nil {
+			return err
+		}
+
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.MovePublishModuleName, depositor, sdk.NewCoins(deposit.Amount)); err != nil {
+			return err
+		}
+
+		if err := k.PublishDeposits.Remove(ctx, key); err != nil {
+			return err
+		}
+
+		sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeRefundPublishDeposit,
+			sdk.NewAttribute(types.AttributeKeyModuleAddr, moduleId.Address.String()),
+			sdk.NewAttribute(types.AttributeKeyModuleName, moduleName),
+			sdk.NewAttribute(types.AttributeKeyAmount, deposit.Amount.String()),
+		))
+	}
+
+	return nil
+}
+
+// GetPublishDeposit returns the publish deposit of the module.
+func (k Keeper) GetPublishDeposit(ctx context.Context, moduleAddr vmtypes.AccountAddress, moduleName string) (types.PublishDeposit, error) {
+	return k.PublishDeposits.Get(ctx, collections.Join(moduleAddr.Bytes(), moduleName))
+}
+
+// GetPublishNamespace returns the publish namespace of the address, and
+// an empty namespace is returned when it is not registered.
+func (k Keeper) GetPublishNamespace(ctx context.Context, addr vmtypes.AccountAddress) (types.PublishNamespace, error) {
+	namespace, err := k.PublishNamespaces.Get(ctx, addr.Bytes())
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.PublishNamespace{Address: addr.String()}, nil
+	}
+
+	return namespace, err
+}
+
+// SetPublishNamespace sets the publish namespace of the address, and
+// removes the namespace when the prefixes are empty.
+func (k Keeper) SetPublishNamespace(ctx context.Context, addr vmtypes.AccountAddress, prefixes []string) error {
+	if len(prefixes) == 0 {
+		return k.PublishNamespaces.Remove(ctx, addr.Bytes())
+	}
+
+	return k.PublishNamespaces.Set(ctx, addr.Bytes(), types.PublishNamespace{
+		Address:  addr.String(),
+		Prefixes: prefixes,
+	})
+}
+
+// readModuleIds reads the module ids from the compiled module bytes
+func readModuleIds(moduleBundle vmtypes.ModuleBundle) ([]vmtypes.ModuleId, error) {
+	moduleIds := make([]vmtypes.ModuleId, len(moduleBundle.Codes))
+	for i, moduleBz := range moduleBundle.Codes {
+		moduleAddr, moduleName, err := vmapi.ReadModuleInfo(moduleBz.Code)
+		if err != nil {
+			return nil, err
+		}
+
+		moduleIds[i] = vmtypes.NewModuleId(moduleAddr, moduleName)
+	}
+
+	return moduleIds, nil
+}
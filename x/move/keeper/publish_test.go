@@ -0,0 +1,198 @@
+package keeper_test
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_PublishMode_Governance(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.PublishMode = types.PublishModeGovernance
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// governance proposal bypasses the publish policy
+	_, err = ms.GovPublish(ctx, &types.MsgGovPublish{
+		Authority:     input.MoveKeeper.GetAuthority(),
+		Sender:        types.TestAddr.String(),
+		CodeBytes:     [][]byte{tableGeneratorModule},
+		UpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+	})
+	require.NoError(t, err)
+
+	found, err := input.MoveKeeper.HasModule(ctx, vmtypes.TestAddress, "TableGenerator")
+	require.NoError(t, err)
+	require.True(t, found)
+}
+
+func Test_PublishNamespace(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	_, err := ms.SetPublishNamespace(ctx, &types.MsgSetPublishNamespace{
+		Authority: input.MoveKeeper.GetAuthority(),
+		Address:   types.TestAddr.String(),
+		Prefixes:  []string{"Foo"},
+	})
+	require.NoError(t, err)
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	_, err = ms.SetPublishNamespace(ctx, &types.MsgSetPublishNamespace{
+		Authority: input.MoveKeeper.GetAuthority(),
+		Address:   types.TestAddr.String(),
+		Prefixes:  []string{"Foo", "Table"},
+	})
+	require.NoError(t, err)
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	// remove the namespace
+	_, err = ms.SetPublishNamespace(ctx, &types.MsgSetPublishNamespace{
+		Authority: input.MoveKeeper.GetAuthority(),
+		Address:   types.TestAddr.String(),
+	})
+	require.NoError(t, err)
+
+	namespace, err := input.MoveKeeper.GetPublishNamespace(ctx, vmtypes.TestAddress)
+	require.NoError(t, err)
+	require.Empty(t, namespace.Prefixes)
+}
+
+func Test_PublishQuota(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.PublishQuota = 1
+	params.PublishQuotaWindow = 10
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.ErrorIs(t, err, types.ErrLimit)
+
+	// new quota window
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 10)
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	usage, err := input.MoveKeeper.GetPublishUsage(ctx, params, vmtypes.TestAddress)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), usage.Count)
+	require.Equal(t, ctx.BlockHeight(), usage.WindowStartHeight)
+}
+
+func Test_PublishDeposit(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.PublishDeposit = math.NewInt(1_000_000)
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	deposit := sdk.NewCoin(bondDenom, params.PublishDeposit)
+	input.Faucet.Fund(ctx, types.TestAddr, deposit)
+	before := input.BankKeeper.GetBalance(ctx, types.TestAddr, bondDenom)
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+	require.Equal(t, before.Sub(deposit).String(), input.BankKeeper.GetBalance(ctx, types.TestAddr, bondDenom).String())
+
+	publishDeposit, err := input.MoveKeeper.GetPublishDeposit(ctx, vmtypes.TestAddress, "TableGenerator")
+	require.NoError(t, err)
+	require.Equal(t, deposit, publishDeposit.Amount)
+	require.Equal(t, types.ConvertVMAddressToSDKAddress(vmtypes.TestAddress).String(), publishDeposit.Depositor)
+
+	// upgrade does not charge the deposit again
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+	require.Equal(t, before.Sub(deposit).String(), input.BankKeeper.GetBalance(ctx, types.TestAddr, bondDenom).String())
+
+	// freeze the module to get the deposit back
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)), types.UpgradePolicy_IMMUTABLE)
+	require.NoError(t, err)
+	require.Equal(t, before.String(), input.BankKeeper.GetBalance(ctx, types.TestAddr, bondDenom).String())
+
+	_, err = input.MoveKeeper.GetPublishDeposit(ctx, vmtypes.TestAddress, "TableGenerator")
+	require.Error(t, err)
+}
+
+func Test_PublishPolicy_DirectCodePublish(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.PublishMode = types.PublishModeGovernance
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	moduleIdsBz, err := json.Marshal([]string{"0x2::TableGenerator"})
+	require.NoError(t, err)
+	codesBz, err := json.Marshal([]string{hex.EncodeToString(tableGeneratorModule)})
+	require.NoError(t, err)
+
+	// publish by calling `0x1::code::publish` directly
+	_, err = ms.ExecuteJSON(ctx, &types.MsgExecuteJSON{
+		Sender:        types.TestAddr.String(),
+		ModuleAddress: "0x1",
+		ModuleName:    types.MoveModuleNameCode,
+		FunctionName:  types.FunctionNameCodePublish,
+		Args:          []string{string(moduleIdsBz), string(codesBz), "1"},
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	moduleIdsArg, err := vmtypes.SerializeStringVector([]string{"0x2::TableGenerator"})
+	require.NoError(t, err)
+	codesArg, err := vmtypes.SerializeBytesVector([][]byte{tableGeneratorModule})
+	require.NoError(t, err)
+	policyArg := []byte{1} // u8 upgrade policy
+
+	_, err = ms.Execute(ctx, &types.MsgExecute{
+		Sender:        types.TestAddr.String(),
+		ModuleAddress: "0x1",
+		ModuleName:    types.MoveModuleNameCode,
+		FunctionName:  types.FunctionNameCodePublish,
+		Args:          [][]byte{moduleIdsArg, codesArg, policyArg},
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+
+	// the namespace is enforced on the direct publish too
+	params.PublishMode = types.PublishModeOpen
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+	_, err = ms.SetPublishNamespace(ctx, &types.MsgSetPublishNamespace{
+		Authority: input.MoveKeeper.GetAuthority(),
+		Address:   types.TestAddr.String(),
+		Prefixes:  []string{"Foo"},
+	})
+	require.NoError(t, err)
+
+	_, err = ms.Execute(ctx, &types.MsgExecute{
+		Sender:        types.TestAddr.String(),
+		ModuleAddress: "0x1",
+		ModuleName:    types.MoveModuleNameCode,
+		FunctionName:  types.FunctionNameCodePublish,
+		Args:          [][]byte{moduleIdsArg, codesArg, policyArg},
+	})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
@@ -86,6 +88,139 @@ func (q Querier) Modules(ctx context.Context, req *types.QueryModulesRequest) (*
 	}, nil
 }
 
+func (q Querier) ModuleDependencies(ctx context.Context, req *types.QueryModuleDependenciesRequest) (*types.QueryModuleDependenciesResponse, error) {
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies, err := q.GetModuleDependencies(ctx, moduleAddr, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryModuleDependenciesResponse{
+		Dependencies: dependencies,
+	}, nil
+}
+
+func (q Querier) ModuleDependents(ctx context.Context, req *types.QueryModuleDependentsRequest) (*types.QueryModuleDependentsResponse, error) {
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	dependency := vmtypes.NewModuleId(moduleAddr, req.ModuleName).String()
+	dependents, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.ModuleDependents, req.Pagination, func(key collections.Pair[string, string], _ collections.NoValue) (types.ModuleIdentifier, error) {
+		return types.ParseModuleIdentifier(key.K2())
+	}, query.WithCollectionPaginationPairPrefix[string, string](dependency))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryModuleDependentsResponse{
+		Dependents: dependents,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) ModuleFriends(ctx context.Context, req *types.QueryModuleFriendsRequest) (*types.QueryModuleFriendsResponse, error) {
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixBytes := types.GetModulePrefix(moduleAddr)
+	moduleFriends, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.VMStore, req.Pagination, func(key []byte, rawBytes []byte) (types.ModuleFriends, error) {
+		moduleName, err := vmtypes.BcsDeserializeIdentifier(key[len(prefixBytes):])
+		if err != nil {
+			return types.ModuleFriends{}, err
+		}
+
+		abi, err := q.decodeModuleABI(rawBytes)
+		if err != nil {
+			return types.ModuleFriends{}, err
+		}
+
+		return types.ModuleFriends{
+			Address:    moduleAddr.String(),
+			ModuleName: string(moduleName),
+			Friends:    abi.Friends,
+		}, nil
+	}, func(opt *query.CollectionsPaginateOptions[[]byte]) {
+		opt.Prefix = &prefixBytes
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryModuleFriendsResponse{
+		ModuleFriends: moduleFriends,
+		Pagination:    pageRes,
+	}, nil
+}
+
+func (q Querier) FunctionSignatures(ctx context.Context, req *types.QueryFunctionSignaturesRequest) (*types.QueryFunctionSignaturesResponse, error) {
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	prefixBytes := types.GetModulePrefix(moduleAddr)
+	moduleSignatures, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.VMStore, req.Pagination, func(key []byte, rawBytes []byte) ([]types.FunctionSignature, error) {
+		moduleName, err := vmtypes.BcsDeserializeIdentifier(key[len(prefixBytes):])
+		if err != nil {
+			return nil, err
+		}
+
+		abi, err := q.decodeModuleABI(rawBytes)
+		if err != nil {
+			return nil, err
+		}
+
+		return abi.functionSignatures(moduleAddr, string(moduleName)), nil
+	}, func(opt *query.CollectionsPaginateOptions[[]byte]) {
+		opt.Prefix = &prefixBytes
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	signatures := []types.FunctionSignature{}
+	for _, moduleSignature := range moduleSignatures {
+		signatures = append(signatures, moduleSignature...)
+	}
+
+	return &types.QueryFunctionSignaturesResponse{
+		FunctionSignatures: signatures,
+		Pagination:         pageRes,
+	}, nil
+}
+
+func (q Querier) CheckUpgradeCompatibility(ctx context.Context, req *types.QueryCheckUpgradeCompatibilityRequest) (res *types.QueryCheckUpgradeCompatibilityResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrap(types.ErrInvalidRequest, fmt.Sprintf("vm panic: %v", r))
+		}
+	}()
+
+	sender, err := types.AccAddressFromString(q.ac, req.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(req.CodeBytes) == 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty code bytes")
+	}
+
+	modules := make([]vmtypes.Module, len(req.CodeBytes))
+	for i, code := range req.CodeBytes {
+		modules[i] = vmtypes.NewModule(code)
+	}
+
+	return q.Keeper.CheckUpgradeCompatibility(ctx, sender, vmtypes.NewModuleBundle(modules...), req.UpgradePolicy)
+}
+
 func (q Querier) Resource(ctx context.Context, req *types.QueryResourceRequest) (*types.QueryResourceResponse, error) {
 	addr, err := types.AccAddressFromString(q.ac, req.Address)
 	if err != nil {
@@ -151,6 +286,77 @@ func (q Querier) Resources(ctx context.Context, req *types.QueryResourcesRequest
 	}, nil
 }
 
+func (q Querier) ResourceHolders(ctx context.Context, req *types.QueryResourceHoldersRequest) (*types.QueryResourceHoldersResponse, error) {
+	if q.resourceIndex == nil {
+		return nil, types.ErrResourceIndexDisabled
+	}
+
+	structTag, err := vmapi.ParseStructTag(req.StructTag)
+	if err != nil {
+		return nil, err
+	}
+
+	// normalize the struct tag to the indexed form
+	structTagStr, err := vmapi.StringifyStructTag(structTag)
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := []string{}
+	pageRes, err := query.Paginate(q.resourceIndex.holderStore(structTagStr), req.Pagination, func(key []byte, _ []byte) error {
+		addr, err := vmtypes.NewAccountAddressFromBytes(key)
+		if err != nil {
+			return err
+		}
+
+		addresses = append(addresses, addr.String())
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResourceHoldersResponse{
+		Addresses:  addresses,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) ResourcesByType(ctx context.Context, req *types.QueryResourcesByTypeRequest) (*types.QueryResourcesByTypeResponse, error) {
+	if q.resourceIndex == nil {
+		return nil, types.ErrResourceIndexDisabled
+	}
+
+	addr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	resources := []types.Resource{}
+	pageRes, err := query.Paginate(q.resourceIndex.ownerStore(addr, req.StructTagPrefix), req.Pagination, func(_ []byte, value []byte) error {
+		structTag, err := vmtypes.BcsDeserializeStructTag(value)
+		if err != nil {
+			return err
+		}
+
+		resource, err := q.GetResource(ctx, addr, structTag)
+		if err != nil {
+			return err
+		}
+
+		resources = append(resources, resource)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryResourcesByTypeResponse{
+		Resources:  resources,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q Querier) TableInfo(ctx context.Context, req *types.QueryTableInfoRequest) (*types.QueryTableInfoResponse, error) {
 	address, err := types.AccAddressFromString(q.ac, req.Address)
 	if err != nil {
@@ -189,56 +395,44 @@ func (q Querier) TableEntry(ctx context.Context, req *types.QueryTableEntryReque
 }
 
 func (q Querier) TableEntries(ctx context.Context, req *types.QueryTableEntriesRequest) (*types.QueryTableEntriesResponse, error) {
-	vmStore := types.NewVMStore(ctx, q.VMStore)
-
 	addr, err := types.AccAddressFromString(q.ac, req.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	info, err := q.GetTableInfo(ctx, addr)
+	entries, pageRes, err := q.paginateTableEntries(ctx, addr, req.StartKey, req.EndKey, req.KeyPrefix, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	keyTypeTag, err := vmapi.TypeTagFromString(info.KeyType)
+	return &types.QueryTableEntriesResponse{
+		TableEntries: entries,
+		Pagination:   pageRes,
+	}, nil
+}
+
+func (q Querier) TableEntriesByJSON(ctx context.Context, req *types.QueryTableEntriesByJSONRequest) (*types.QueryTableEntriesByJSONResponse, error) {
+	addr, err := types.AccAddressFromString(q.ac, req.Address)
 	if err != nil {
 		return nil, err
 	}
 
-	valueTypeTag, err := vmapi.TypeTagFromString(info.ValueType)
+	startKey, err := q.serializeTableKeyJSON(ctx, addr, req.StartKey)
 	if err != nil {
-		return nil, err
+		return nil, errors.Wrap(types.ErrInvalidQueryRequest, err.Error())
 	}
 
-	prefixBytes := types.GetTableEntryPrefix(addr)
-	entries, pageRes, err := query.CollectionPaginate(ctx, q.VMStore, req.Pagination, func(key []byte, value []byte) (types.TableEntry, error) {
-		key = key[len(prefixBytes):]
-		keyStr, err := vmapi.DecodeMoveValue(vmStore, keyTypeTag, key)
-		if err != nil {
-			return types.TableEntry{}, err
-		}
-
-		valueStr, err := vmapi.DecodeMoveValue(vmStore, valueTypeTag, value)
-		if err != nil {
-			return types.TableEntry{}, err
-		}
+	endKey, err := q.serializeTableKeyJSON(ctx, addr, req.EndKey)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidQueryRequest, err.Error())
+	}
 
-		return types.TableEntry{
-			Address:    addr.String(),
-			Key:        string(keyStr),
-			Value:      string(valueStr),
-			KeyBytes:   key,
-			ValueBytes: value,
-		}, nil
-	}, func(opt *query.CollectionsPaginateOptions[[]byte]) {
-		opt.Prefix = &prefixBytes
-	})
+	entries, pageRes, err := q.paginateTableEntries(ctx, addr, startKey, endKey, nil, req.Pagination)
 	if err != nil {
 		return nil, err
 	}
 
-	return &types.QueryTableEntriesResponse{
+	return &types.QueryTableEntriesByJSONResponse{
 		TableEntries: entries,
 		Pagination:   pageRes,
 	}, nil
@@ -431,6 +625,225 @@ func (q Querier) ScriptABI(ctx context.Context, req *types.QueryScriptABIRequest
 	}, nil
 }
 
+func (q Querier) Profile(ctx context.Context, req *types.QueryProfileRequest) (res *types.QueryProfileResponse, err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = errors.Wrap(types.ErrInvalidRequest, fmt.Sprintf("vm panic: %v", r))
+		}
+	}()
+
+	sender, err := types.AccAddressFromString(q.ac, req.Sender)
+	if err != nil {
+		return
+	}
+
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return
+	}
+
+	typeTags, err := types.TypeTagsFromTypeArgs(req.TypeArgs)
+	if err != nil {
+		return
+	}
+
+	if len(req.ModuleName) == 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty module name")
+	}
+
+	if len(req.FunctionName) == 0 {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty function name")
+	}
+
+	return q.ProfileEntryFunction(
+		ctx,
+		sender,
+		moduleAddr,
+		req.ModuleName,
+		req.FunctionName,
+		typeTags,
+		req.Args,
+		req.TraceStateAccess,
+	)
+}
+
+func (q Querier) ModuleRevenue(ctx context.Context, req *types.QueryModuleRevenueRequest) (*types.QueryModuleRevenueResponse, error) {
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	recipient, err := q.GetRevenueRecipient(ctx, moduleAddr, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	revenueRatio, err := q.GetRevenueRatio(ctx, moduleAddr, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	totalRevenue, err := q.GetModuleRevenue(ctx, moduleAddr, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryModuleRevenueResponse{
+		Recipient:    recipient.String(),
+		RevenueRatio: revenueRatio,
+		TotalRevenue: totalRevenue,
+	}, nil
+}
+
+func (q Querier) ClaimableRevenue(ctx context.Context, req *types.QueryClaimableRevenueRequest) (*types.QueryClaimableRevenueResponse, error) {
+	recipient, err := types.AccAddressFromString(q.ac, req.Recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	amount, err := q.GetClaimableRevenue(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryClaimableRevenueResponse{
+		Amount: amount,
+	}, nil
+}
+
+func (q Querier) CronJob(ctx context.Context, req *types.QueryCronJobRequest) (*types.QueryCronJobResponse, error) {
+	job, err := q.GetCronJob(ctx, req.Id)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCronJobResponse{
+		CronJob: job,
+	}, nil
+}
+
+func (q Querier) CronJobs(ctx context.Context, req *types.QueryCronJobsRequest) (*types.QueryCronJobsResponse, error) {
+	jobs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.CronJobs, req.Pagination, func(_ uint64, job types.CronJob) (types.CronJob, error) {
+		return job, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCronJobsResponse{
+		CronJobs:   jobs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) CronRuns(ctx context.Context, req *types.QueryCronRunsRequest) (*types.QueryCronRunsResponse, error) {
+	runs, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.CronRuns, req.Pagination, func(_ collections.Pair[uint64, int64], run types.CronRun) (types.CronRun, error) {
+		return run, nil
+	}, query.WithCollectionPaginationPairPrefix[uint64, int64](req.Id))
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryCronRunsResponse{
+		CronRuns:   runs,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (q Querier) PublishPolicy(ctx context.Context, req *types.QueryPublishPolicyRequest) (*types.QueryPublishPolicyResponse, error) {
+	addr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := q.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	publishMode := params.EffectivePublishMode()
+	allowed := publishMode == types.PublishModeOpen
+	if publishMode == types.PublishModeAllowlist {
+		allowed, err = q.isAllowedPublisher(ctx, addr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	namespace, err := q.GetPublishNamespace(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &types.QueryPublishPolicyResponse{
+		PublishMode:       publishMode,
+		Allowed:           allowed,
+		NamespacePrefixes: namespace.Prefixes,
+		Quota:             params.PublishQuota,
+		PublishDeposit:    sdk.NewCoin(params.BaseDenom, params.PublishDeposit),
+	}
+
+	if params.PublishQuota > 0 {
+		usage, err := q.GetPublishUsage(ctx, params, addr)
+		if err != nil {
+			return nil, err
+		}
+
+		res.QuotaUsed = usage.Count
+		res.QuotaWindowEndHeight = usage.WindowStartHeight + int64(params.PublishQuotaWindow)
+	}
+
+	return res, nil
+}
+
+func (q Querier) PublishDeposit(ctx context.Context, req *types.QueryPublishDepositRequest) (*types.QueryPublishDepositResponse, error) {
+	moduleAddr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	deposit, err := q.GetPublishDeposit(ctx, moduleAddr, req.ModuleName)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryPublishDepositResponse{
+		PublishDeposit: deposit,
+	}, nil
+}
+
+func (q Querier) Authenticator(ctx context.Context, req *types.QueryAuthenticatorRequest) (*types.QueryAuthenticatorResponse, error) {
+	addr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticator, err := q.Authenticators.Get(ctx, addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryAuthenticatorResponse{
+		Authenticator: authenticator,
+	}, nil
+}
+
+func (q Querier) Sponsor(ctx context.Context, req *types.QuerySponsorRequest) (*types.QuerySponsorResponse, error) {
+	addr, err := types.AccAddressFromString(q.ac, req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	sponsor, err := q.Sponsors.Get(ctx, addr.Bytes())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QuerySponsorResponse{
+		Sponsor: sponsor,
+	}, nil
+}
+
 func (q Querier) Params(ctx context.Context, req *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params, err := q.GetParams(ctx)
 	if err != nil {
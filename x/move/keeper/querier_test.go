@@ -5,12 +5,14 @@ import (
 	"testing"
 
 	"cosmossdk.io/math"
+	dbm "github.com/cosmos/cosmos-db"
 	"github.com/stretchr/testify/require"
 
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 
+	moveconfig "github.com/initia-labs/initia/x/move/config"
 	"github.com/initia-labs/initia/x/move/keeper"
 	"github.com/initia-labs/initia/x/move/types"
 	vmtypes "github.com/initia-labs/movevm/types"
@@ -410,6 +412,144 @@ func TestTableEntries(t *testing.T) {
 			ValueBytes: thirdBz,
 		}}, entriesRes.TableEntries)
 
+	// range scan over the bcs encoded keys
+	entriesRes, err = querier.TableEntries(
+		ctx,
+		&types.QueryTableEntriesRequest{
+			Address:  tableAddr.String(),
+			StartKey: oneBz,
+			EndKey:   thirdBz,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, entriesRes.TableEntries, 2)
+	require.Equal(t, oneBz, entriesRes.TableEntries[0].KeyBytes)
+	require.Equal(t, twoBz, entriesRes.TableEntries[1].KeyBytes)
+
+	// prefix scan over the bcs encoded keys
+	entriesRes, err = querier.TableEntries(
+		ctx,
+		&types.QueryTableEntriesRequest{
+			Address:   tableAddr.String(),
+			KeyPrefix: twoBz[:1],
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, entriesRes.TableEntries, 1)
+	require.Equal(t, twoBz, entriesRes.TableEntries[0].KeyBytes)
+
+	// range scan with the json keys
+	jsonEntriesRes, err := querier.TableEntriesByJSON(
+		ctx,
+		&types.QueryTableEntriesByJSONRequest{
+			Address:  tableAddr.String(),
+			StartKey: `"2"`,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, jsonEntriesRes.TableEntries, 2)
+	require.Equal(t, twoBz, jsonEntriesRes.TableEntries[0].KeyBytes)
+	require.Equal(t, thirdBz, jsonEntriesRes.TableEntries[1].KeyBytes)
+}
+
+func TestTableEntries_IntegerKeyRange(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	twoAddr, err := types.AccAddressFromString(ac, "0x2")
+	require.NoError(t, err)
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, twoAddr,
+		vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)),
+		types.UpgradePolicy_COMPATIBLE,
+	)
+	require.NoError(t, err)
+
+	argBz, err := vmtypes.SerializeUint64(300)
+	require.NoError(t, err)
+
+	// 0:0, 1:1, ..., 299:299 table
+	err = input.MoveKeeper.ExecuteEntryFunction(
+		ctx,
+		twoAddr,
+		twoAddr,
+		"TableGenerator",
+		"generate_table",
+		[]vmtypes.TypeTag{},
+		[][]byte{argBz},
+	)
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	resource, err := querier.Resource(
+		ctx,
+		&types.QueryResourceRequest{
+			Address:   vmtypes.TestAddress.String(),
+			StructTag: "0x2::TableGenerator::S<u64,u64>",
+		},
+	)
+	require.NoError(t, err)
+
+	tableAddr, err := vmtypes.NewAccountAddressFromBytes(resource.RawBytes[0:types.AddressBytesLength])
+	require.NoError(t, err)
+
+	// the little-endian keys 250..259 cross the 256 boundary, so they are
+	// not contiguous in the byte order of the store
+	startBz, err := vmtypes.SerializeUint64(250)
+	require.NoError(t, err)
+	endBz, err := vmtypes.SerializeUint64(260)
+	require.NoError(t, err)
+
+	entriesRes, err := querier.TableEntries(
+		ctx,
+		&types.QueryTableEntriesRequest{
+			Address:  tableAddr.String(),
+			StartKey: startBz,
+			EndKey:   endBz,
+		},
+	)
+	require.NoError(t, err)
+
+	keys := []string{}
+	for _, entry := range entriesRes.TableEntries {
+		keys = append(keys, entry.Key)
+	}
+	require.ElementsMatch(t, []string{
+		`"250"`, `"251"`, `"252"`, `"253"`, `"254"`,
+		`"255"`, `"256"`, `"257"`, `"258"`, `"259"`,
+	}, keys)
+
+	// json bounds are compared numerically as well
+	jsonEntriesRes, err := querier.TableEntriesByJSON(
+		ctx,
+		&types.QueryTableEntriesByJSONRequest{
+			Address:  tableAddr.String(),
+			StartKey: `"255"`,
+			EndKey:   `"257"`,
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, jsonEntriesRes.TableEntries, 2)
+
+	keys = []string{}
+	for _, entry := range jsonEntriesRes.TableEntries {
+		keys = append(keys, entry.Key)
+	}
+	require.ElementsMatch(t, []string{`"255"`, `"256"`}, keys)
+
+	// pagination counts only the entries in the range
+	entriesRes, err = querier.TableEntries(
+		ctx,
+		&types.QueryTableEntriesRequest{
+			Address:    tableAddr.String(),
+			StartKey:   startBz,
+			EndKey:     endBz,
+			Pagination: &query.PageRequest{Limit: 4, CountTotal: true},
+		},
+	)
+	require.NoError(t, err)
+	require.Len(t, entriesRes.TableEntries, 4)
+	require.Equal(t, uint64(10), entriesRes.Pagination.Total)
 }
 
 func TestScriptABI(t *testing.T) {
@@ -427,6 +567,78 @@ func TestScriptABI(t *testing.T) {
 	require.Equal(t, expectedABI, string(abi.Abi))
 }
 
+func TestProfile(t *testing.T) {
+	moveConfig := moveconfig.DefaultMoveConfig()
+	moveConfig.ContractProfileEnabled = true
+	ctx, input := _createTestInput(t, false, moveConfig, dbm.NewMemDB())
+
+	argBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	req := &types.QueryProfileRequest{
+		Sender:       vmtypes.TestAddress.String(),
+		Address:      vmtypes.StdAddress.String(),
+		ModuleName:   "BasicCoin",
+		FunctionName: "mint",
+		TypeArgs:     []string{"0x1::BasicCoin::Initia"},
+		Args:         [][]byte{argBz},
+	}
+
+	res, err := querier.Profile(ctx, req)
+	require.NoError(t, err)
+	require.NotZero(t, res.GasUsed)
+	require.Empty(t, res.StateAccesses)
+
+	found := false
+	for _, entry := range res.GasProfile {
+		if entry.ModuleAddress == vmtypes.StdAddress.String() && entry.ModuleName == "BasicCoin" {
+			require.NotZero(t, entry.GasUsed)
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	// with state access trace
+	req.TraceStateAccess = true
+	res, err = querier.Profile(ctx, req)
+	require.NoError(t, err)
+	require.NotEmpty(t, res.StateAccesses)
+
+	hasWrite := false
+	for _, entry := range res.StateAccesses {
+		if entry.Op == "set" {
+			hasWrite = true
+		}
+	}
+	require.True(t, hasWrite)
+
+	// state changes must be discarded, so the coin store should not exist
+	_, err = querier.View(ctx, &types.QueryViewRequest{
+		Address:      vmtypes.StdAddress.String(),
+		ModuleName:   "BasicCoin",
+		FunctionName: "get",
+		TypeArgs:     []string{"0x1::BasicCoin::Initia"},
+		Args:         [][]byte{vmtypes.TestAddress.Bytes()},
+	})
+	require.Error(t, err)
+}
+
+func TestProfile_Disabled(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	_, err := querier.Profile(ctx, &types.QueryProfileRequest{
+		Sender:       vmtypes.TestAddress.String(),
+		Address:      vmtypes.StdAddress.String(),
+		ModuleName:   "BasicCoin",
+		FunctionName: "mint",
+		TypeArgs:     []string{"0x1::BasicCoin::Initia"},
+		Args:         [][]byte{},
+	})
+	require.ErrorIs(t, err, types.ErrProfileDisabled)
+}
+
 func TestParams(t *testing.T) {
 	ctx, input := createDefaultTestInput(t)
 
@@ -466,3 +678,49 @@ func Test_Query_Denom(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, bondDenom, res.Denom)
 }
+
+func TestModuleDependencyGraph(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress,
+		vmtypes.NewModuleBundle(vmtypes.NewModule(tableGeneratorModule)),
+		types.UpgradePolicy_COMPATIBLE,
+	)
+	require.NoError(t, err)
+
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	depsRes, err := querier.ModuleDependencies(ctx, &types.QueryModuleDependenciesRequest{
+		Address:    vmtypes.TestAddress.String(),
+		ModuleName: "TableGenerator",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ModuleIdentifier{{Address: "0x1", ModuleName: "table"}}, depsRes.Dependencies)
+
+	dependentsRes, err := querier.ModuleDependents(ctx, &types.QueryModuleDependentsRequest{
+		Address:    vmtypes.StdAddress.String(),
+		ModuleName: "table",
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.ModuleIdentifier{{Address: "0x2", ModuleName: "TableGenerator"}}, dependentsRes.Dependents)
+
+	sigRes, err := querier.FunctionSignatures(ctx, &types.QueryFunctionSignaturesRequest{
+		Address: vmtypes.TestAddress.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, []types.FunctionSignature{{
+		Address:    "0x2",
+		ModuleName: "TableGenerator",
+		Name:       "generate_table",
+		IsEntry:    true,
+		TypeParams: []string{},
+		Params:     []string{"&signer", "u64"},
+		Returns:    []string{},
+	}}, sigRes.FunctionSignatures)
+
+	friendRes, err := querier.ModuleFriends(ctx, &types.QueryModuleFriendsRequest{
+		Address:    vmtypes.StdAddress.String(),
+		Pagination: &query.PageRequest{Limit: 3},
+	})
+	require.NoError(t, err)
+	require.Len(t, friendRes.ModuleFriends, 3)
+}
@@ -0,0 +1,214 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// ResourceIndexDBName is the name of the resource index database in the data directory.
+const ResourceIndexDBName = "move_resource_index"
+
+// resourceIndexBatchSize is the number of entries written at once while rebuilding the index.
+const resourceIndexBatchSize = 10_000
+
+// Keys for the resource index database
+var (
+	resourceHolderPrefix = []byte{0x01} // struct tag | 0x00 | address => []
+	ownerResourcePrefix  = []byte{0x02} // address | struct tag => bcs(struct tag)
+)
+
+var _ storetypes.ABCIListener = &ResourceIndex{}
+
+// ResourceIndex is a node local secondary index of the move resources keyed
+// by the struct tag string, including the type arguments of the generic
+// instantiations. The index is fed by the committed state changes of the move
+// store, so it always reflects the latest committed state and never affects
+// the consensus state.
+type ResourceIndex struct {
+	db dbm.DB
+}
+
+// NewResourceIndex returns new ResourceIndex instance.
+func NewResourceIndex(db dbm.DB) *ResourceIndex {
+	return &ResourceIndex{db}
+}
+
+// OpenResourceIndex opens the resource index database in the data directory.
+func OpenResourceIndex(dataDir string, backend dbm.BackendType) (*ResourceIndex, error) {
+	db, err := dbm.NewDB(ResourceIndexDBName, backend, dataDir)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewResourceIndex(db), nil
+}
+
+// Close closes the resource index database.
+func (idx *ResourceIndex) Close() error {
+	return idx.db.Close()
+}
+
+// ListenFinalizeBlock implements storetypes.ABCIListener.
+func (idx *ResourceIndex) ListenFinalizeBlock(_ context.Context, _ abci.RequestFinalizeBlock, _ abci.ResponseFinalizeBlock) error {
+	return nil
+}
+
+// ListenCommit implements storetypes.ABCIListener. It applies the resource
+// writes of the committed block to the index.
+func (idx *ResourceIndex) ListenCommit(_ context.Context, _ abci.ResponseCommit, changeSet []*storetypes.StoreKVPair) error {
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for _, pair := range changeSet {
+		if pair.StoreKey != types.StoreKey || !bytes.HasPrefix(pair.Key, types.VMStorePrefix) {
+			continue
+		}
+
+		if err := idx.index(batch, pair.Key[len(types.VMStorePrefix):], pair.Delete); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// Rebuild clears the index and rebuilds it from the resources in the move store.
+func (idx *ResourceIndex) Rebuild(ctx context.Context, k *Keeper) error {
+	if err := idx.clear(); err != nil {
+		return err
+	}
+
+	batch := idx.db.NewBatch()
+	count := 0
+	err := k.VMStore.Walk(ctx, nil, func(key, _ []byte) (bool, error) {
+		if !isResourceKey(key) {
+			return false, nil
+		}
+
+		if err := idx.index(batch, key, false); err != nil {
+			return true, err
+		}
+
+		if count++; count%resourceIndexBatchSize == 0 {
+			if err := batch.Write(); err != nil {
+				return true, err
+			}
+			if err := batch.Close(); err != nil {
+				return true, err
+			}
+
+			batch = idx.db.NewBatch()
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		batch.Close()
+		return err
+	}
+
+	defer batch.Close()
+	return batch.WriteSync()
+}
+
+func (idx *ResourceIndex) clear() error {
+	iter, err := idx.db.Iterator(nil, nil)
+	if err != nil {
+		return err
+	}
+	defer iter.Close()
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if err := batch.Delete(iter.Key()); err != nil {
+			return err
+		}
+	}
+
+	return batch.Write()
+}
+
+// index adds or removes the index entries of the vm store key when the key
+// is a resource key, `address | ResourceSeparator | bcs(struct tag)`.
+func (idx *ResourceIndex) index(batch dbm.Batch, key []byte, delete bool) error {
+	if !isResourceKey(key) {
+		return nil
+	}
+
+	addr := key[:types.AddressBytesLength]
+	structTagBytes := key[types.AddressBytesLength+1:]
+	structTag, err := vmtypes.BcsDeserializeStructTag(structTagBytes)
+	if err != nil {
+		return err
+	}
+
+	structTagStr, err := vmapi.StringifyStructTag(structTag)
+	if err != nil {
+		return err
+	}
+
+	holderKey := append(getResourceHolderPrefix(structTagStr), addr...)
+	ownerKey := append(getOwnerResourcePrefix(addr), structTagStr...)
+	if delete {
+		if err := batch.Delete(holderKey); err != nil {
+			return err
+		}
+
+		return batch.Delete(ownerKey)
+	}
+
+	if err := batch.Set(holderKey, []byte{}); err != nil {
+		return err
+	}
+
+	return batch.Set(ownerKey, bytes.Clone(structTagBytes))
+}
+
+// holderStore returns the store of the holder addresses of the struct tag.
+func (idx *ResourceIndex) holderStore(structTag string) storetypes.KVStore {
+	return prefix.NewStore(dbadapter.Store{DB: idx.db}, getResourceHolderPrefix(structTag))
+}
+
+// ownerStore returns the store of the resources of the owner whose struct
+// tag starts with the struct tag prefix.
+func (idx *ResourceIndex) ownerStore(addr vmtypes.AccountAddress, structTagPrefix string) storetypes.KVStore {
+	return prefix.NewStore(dbadapter.Store{DB: idx.db}, append(getOwnerResourcePrefix(addr[:]), structTagPrefix...))
+}
+
+func isResourceKey(key []byte) bool {
+	return len(key) > types.AddressBytesLength+1 && key[types.AddressBytesLength] == types.ResourceSeparator
+}
+
+func getResourceHolderPrefix(structTag string) []byte {
+	return append(append(bytes.Clone(resourceHolderPrefix), structTag...), 0)
+}
+
+func getOwnerResourcePrefix(addr []byte) []byte {
+	return append(bytes.Clone(ownerResourcePrefix), addr...)
+}
+
+// SetResourceIndex sets the node local resource index, which serves the
+// resource holders and resources by type queries.
+func (k *Keeper) SetResourceIndex(resourceIndex *ResourceIndex) {
+	k.resourceIndex = resourceIndex
+}
+
+// GetResourceIndex returns the node local resource index, or nil if the
+// index is disabled.
+func (k Keeper) GetResourceIndex() *ResourceIndex {
+	return k.resourceIndex
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func TestResourceIndex(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+
+	structTagStr := "0x1::BasicCoin::Coin<0x1::BasicCoin::Initia>"
+	_, err := querier.ResourceHolders(ctx, &types.QueryResourceHoldersRequest{StructTag: structTagStr})
+	require.ErrorIs(t, err, types.ErrResourceIndexDisabled)
+
+	input.Faucet.Fund(ctx, types.TestAddr, sdk.NewCoin(bondDenom, math.NewInt(1000000)))
+
+	argBz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	err = input.MoveKeeper.ExecuteEntryFunction(ctx, vmtypes.TestAddress, vmtypes.StdAddress,
+		"BasicCoin",
+		"mint",
+		[]vmtypes.TypeTag{MustConvertStringToTypeTag("0x1::BasicCoin::Initia")},
+		[][]byte{argBz})
+	require.NoError(t, err)
+
+	// build the index from the existing state
+	resourceIndex := keeper.NewResourceIndex(dbm.NewMemDB())
+	require.NoError(t, resourceIndex.Rebuild(ctx, &input.MoveKeeper))
+	input.MoveKeeper.SetResourceIndex(resourceIndex)
+
+	holdersRes, err := querier.ResourceHolders(ctx, &types.QueryResourceHoldersRequest{StructTag: structTagStr})
+	require.NoError(t, err)
+	require.Equal(t, []string{"0x2"}, holdersRes.Addresses)
+
+	resourcesRes, err := querier.ResourcesByType(ctx, &types.QueryResourcesByTypeRequest{
+		Address:         vmtypes.TestAddress.String(),
+		StructTagPrefix: "0x1::BasicCoin::",
+	})
+	require.NoError(t, err)
+	require.Len(t, resourcesRes.Resources, 1)
+	require.Equal(t, structTagStr, resourcesRes.Resources[0].StructTag)
+
+	// remove the resource with the committed state changes
+	structTag, err := vmapi.ParseStructTag(structTagStr)
+	require.NoError(t, err)
+	key, err := types.GetResourceKey(vmtypes.TestAddress, structTag)
+	require.NoError(t, err)
+
+	err = resourceIndex.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{
+			StoreKey: types.StoreKey,
+			Delete:   true,
+			Key:      append(types.VMStorePrefix, key...),
+		},
+	})
+	require.NoError(t, err)
+
+	holdersRes, err = querier.ResourceHolders(ctx, &types.QueryResourceHoldersRequest{StructTag: structTagStr})
+	require.NoError(t, err)
+	require.Empty(t, holdersRes.Addresses)
+
+	// add the resource back
+	err = resourceIndex.ListenCommit(ctx, abci.ResponseCommit{}, []*storetypes.StoreKVPair{
+		{
+			StoreKey: types.StoreKey,
+			Key:      append(types.VMStorePrefix, key...),
+			Value:    append(argBz, 1),
+		},
+	})
+	require.NoError(t, err)
+
+	holdersRes, err = querier.ResourceHolders(ctx, &types.QueryResourceHoldersRequest{StructTag: structTagStr})
+	require.NoError(t, err)
+	require.Equal(t, []string{"0x2"}, holdersRes.Addresses)
+}
@@ -0,0 +1,204 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// GetRevenueRecipient returns the contract shared revenue recipient of the module.
+// The module address is returned when the recipient is not registered.
+func (k Keeper) GetRevenueRecipient(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+) (vmtypes.AccountAddress, error) {
+	bz, err := k.RevenueRecipients.Get(ctx, collections.Join(moduleAddr.Bytes(), moduleName))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return moduleAddr, nil
+	} else if err != nil {
+		return vmtypes.AccountAddress{}, err
+	}
+
+	return vmtypes.NewAccountAddressFromBytes(bz)
+}
+
+// SetRevenueRecipient registers the contract shared revenue recipient of the module.
+func (k Keeper) SetRevenueRecipient(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	recipient vmtypes.AccountAddress,
+) error {
+	if found, err := k.HasModule(ctx, moduleAddr, moduleName); err != nil {
+		return err
+	} else if !found {
+		return types.ErrModuleNotFound.Wrapf("%s::%s", moduleAddr, moduleName)
+	}
+
+	return k.RevenueRecipients.Set(ctx, collections.Join(moduleAddr.Bytes(), moduleName), recipient.Bytes())
+}
+
+// GetRevenueRatio returns the effective contract shared revenue ratio of the module,
+// which is the governance set override or `Params.ContractSharedRevenueRatio`.
+func (k Keeper) GetRevenueRatio(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+) (sdkmath.LegacyDec, error) {
+	ratio, found, err := k.getRevenueRatioOverride(ctx, moduleAddr, moduleName)
+	if err != nil {
+		return sdkmath.LegacyDec{}, err
+	} else if found {
+		return ratio, nil
+	}
+
+	return k.ContractSharedRevenueRatio(ctx)
+}
+
+func (k Keeper) getRevenueRatioOverride(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+) (sdkmath.LegacyDec, bool, error) {
+	ratio, err := k.RevenueRatios.Get(ctx, collections.Join(moduleAddr.Bytes(), moduleName))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return sdkmath.LegacyDec{}, false, nil
+	} else if err != nil {
+		return sdkmath.LegacyDec{}, false, err
+	}
+
+	return ratio.Ratio, true, nil
+}
+
+// SetRevenueRatio sets the contract shared revenue ratio override of the module,
+// and removes the override when the ratio is nil.
+func (k Keeper) SetRevenueRatio(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	ratio *sdkmath.LegacyDec,
+) error {
+	key := collections.Join(moduleAddr.Bytes(), moduleName)
+	if ratio == nil {
+		return k.RevenueRatios.Remove(ctx, key)
+	}
+
+	return k.RevenueRatios.Set(ctx, key, types.ModuleRevenueRatio{
+		ModuleAddress: moduleAddr.String(),
+		ModuleName:    moduleName,
+		Ratio:         *ratio,
+	})
+}
+
+// AccrueRevenue escrows the revenue, which is already transferred to the
+// escrow module account, and records it to the module and the recipient.
+func (k Keeper) AccrueRevenue(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	recipient vmtypes.AccountAddress,
+	revenue sdk.Coins,
+) error {
+	for _, coin := range revenue {
+		moduleKey := collections.Join3(moduleAddr.Bytes(), moduleName, coin.Denom)
+		total, err := k.ModuleRevenues.Get(ctx, moduleKey)
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			total = sdkmath.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.ModuleRevenues.Set(ctx, moduleKey, total.Add(coin.Amount)); err != nil {
+			return err
+		}
+
+		recipientKey := collections.Join(recipient.Bytes(), coin.Denom)
+		claimable, err := k.ClaimableRevenues.Get(ctx, recipientKey)
+		if err != nil && errors.Is(err, collections.ErrNotFound) {
+			claimable = sdkmath.ZeroInt()
+		} else if err != nil {
+			return err
+		}
+		if err := k.ClaimableRevenues.Set(ctx, recipientKey, claimable.Add(coin.Amount)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// GetModuleRevenue returns the accumulated contract shared revenue of the module.
+func (k Keeper) GetModuleRevenue(
+	ctx context.Context,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+) (sdk.Coins, error) {
+	revenue := sdk.NewCoins()
+	err := k.ModuleRevenues.Walk(ctx, collections.NewSuperPrefixedTripleRange[[]byte, string, string](moduleAddr.Bytes(), moduleName), func(key collections.Triple[[]byte, string, string], amount sdkmath.Int) (bool, error) {
+		revenue = revenue.Add(sdk.NewCoin(key.K3(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revenue, nil
+}
+
+// GetClaimableRevenue returns the contract shared revenue which can be claimed by the recipient.
+func (k Keeper) GetClaimableRevenue(
+	ctx context.Context,
+	recipient vmtypes.AccountAddress,
+) (sdk.Coins, error) {
+	claimable := sdk.NewCoins()
+	err := k.ClaimableRevenues.Walk(ctx, collections.NewPrefixedPairRange[[]byte, string](recipient.Bytes()), func(key collections.Pair[[]byte, string], amount sdkmath.Int) (bool, error) {
+		claimable = claimable.Add(sdk.NewCoin(key.K2(), amount))
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return claimable, nil
+}
+
+// ClaimRevenue transfers the claimable contract shared revenue of
+// the recipient from the escrow module account to the recipient.
+func (k Keeper) ClaimRevenue(
+	ctx context.Context,
+	recipient vmtypes.AccountAddress,
+) (sdk.Coins, error) {
+	claimable, err := k.GetClaimableRevenue(ctx, recipient)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, coin := range claimable {
+		if err := k.ClaimableRevenues.Remove(ctx, collections.Join(recipient.Bytes(), coin.Denom)); err != nil {
+			return nil, err
+		}
+	}
+
+	if claimable.IsZero() {
+		return claimable, nil
+	}
+
+	recipientAddr := types.ConvertVMAddressToSDKAddress(recipient)
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.MoveRevenueModuleName, recipientAddr, claimable); err != nil {
+		return nil, err
+	}
+
+	sdk.UnwrapSDKContext(ctx).EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeClaimRevenue,
+		sdk.NewAttribute(types.AttributeKeyRecipient, recipient.String()),
+		sdk.NewAttribute(types.AttributeKeyRevenue, claimable.String()),
+	))
+
+	return claimable, nil
+}
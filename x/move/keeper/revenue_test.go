@@ -0,0 +1,149 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/initia-labs/initia/x/move/ante"
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_SetRevenueRecipient(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.StdAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(basicCoinModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	// default recipient is the module address
+	recipient, err := input.MoveKeeper.GetRevenueRecipient(ctx, vmtypes.StdAddress, "BasicCoin")
+	require.NoError(t, err)
+	require.Equal(t, vmtypes.StdAddress, recipient)
+
+	_, _, addr := keyPubAddr()
+	_, err = ms.SetRevenueRecipient(ctx, &types.MsgSetRevenueRecipient{
+		Sender:     types.StdAddr.String(),
+		ModuleName: "BasicCoin",
+		Recipient:  addr.String(),
+	})
+	require.NoError(t, err)
+
+	recipient, err = input.MoveKeeper.GetRevenueRecipient(ctx, vmtypes.StdAddress, "BasicCoin")
+	require.NoError(t, err)
+	require.Equal(t, types.ConvertSDKAddressToVMAddress(addr), recipient)
+
+	// the sender is not the publisher of the module
+	_, err = ms.SetRevenueRecipient(ctx, &types.MsgSetRevenueRecipient{
+		Sender:     addr.String(),
+		ModuleName: "BasicCoin",
+		Recipient:  addr.String(),
+	})
+	require.ErrorIs(t, err, types.ErrModuleNotFound)
+}
+
+func Test_RevenueRatioOverride(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	twoAddr, err := vmtypes.NewAccountAddress("0x2")
+	require.NoError(t, err)
+
+	_, _, addr := keyPubAddr()
+	recipient := types.ConvertSDKAddressToVMAddress(addr)
+	err = input.MoveKeeper.RevenueRecipients.Set(ctx, collections.Join(twoAddr.Bytes(), "foo"), recipient.Bytes())
+	require.NoError(t, err)
+
+	ratio := math.LegacyNewDecWithPrec(1, 1)
+	_, err = ms.UpdateRevenueRatio(ctx, &types.MsgUpdateRevenueRatio{
+		Authority:     input.MoveKeeper.GetAuthority(),
+		ModuleAddress: twoAddr.String(),
+		ModuleName:    "foo",
+		Ratio:         &ratio,
+	})
+	require.NoError(t, err)
+
+	// invalid authority
+	_, err = ms.UpdateRevenueRatio(ctx, &types.MsgUpdateRevenueRatio{
+		Authority:     addr.String(),
+		ModuleAddress: twoAddr.String(),
+		ModuleName:    "foo",
+		Ratio:         &ratio,
+	})
+	require.Error(t, err)
+
+	gasUsages := []vmtypes.GasUsage{
+		{ModuleId: vmtypes.ModuleId{Address: twoAddr, Name: "foo"}, GasUsed: 1000},
+		{ModuleId: vmtypes.ModuleId{Address: twoAddr, Name: "bar"}, GasUsed: 1000},
+	}
+
+	feeCollectorAddr := authtypes.NewModuleAddress(authtypes.FeeCollectorName)
+	input.Faucet.Fund(ctx, feeCollectorAddr, sdk.NewCoin(bondDenom, math.NewInt(1_000_000_000_000)))
+	ctx = ctx.WithValue(ante.GasPricesContextKey, sdk.NewDecCoinsFromCoins(sdk.NewCoin(bondDenom, math.NewInt(1))))
+
+	err = input.MoveKeeper.DistributeContractSharedRevenue(ctx, gasUsages)
+	require.NoError(t, err)
+
+	defaultRatio, err := input.MoveKeeper.ContractSharedRevenueRatio(ctx)
+	require.NoError(t, err)
+
+	// foo uses the override and pays to the registered recipient
+	fooRevenue := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(100)))
+	claimable, err := input.MoveKeeper.GetClaimableRevenue(ctx, recipient)
+	require.NoError(t, err)
+	require.Equal(t, fooRevenue, claimable)
+
+	// bar uses the default ratio and pays to the module address
+	barRevenue := sdk.NewCoins(sdk.NewCoin(bondDenom, defaultRatio.MulInt64(1000).TruncateInt()))
+	claimable, err = input.MoveKeeper.GetClaimableRevenue(ctx, twoAddr)
+	require.NoError(t, err)
+	require.Equal(t, barRevenue, claimable)
+
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+	res, err := querier.ModuleRevenue(ctx, &types.QueryModuleRevenueRequest{
+		Address:    twoAddr.String(),
+		ModuleName: "foo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, recipient.String(), res.Recipient)
+	require.Equal(t, ratio, res.RevenueRatio)
+	require.Equal(t, fooRevenue, res.TotalRevenue)
+
+	// claim by others, but the revenue is sent to the recipient
+	_, _, other := keyPubAddr()
+	claimRes, err := ms.ClaimRevenue(ctx, &types.MsgClaimRevenue{
+		Sender:    other.String(),
+		Recipient: addr.String(),
+	})
+	require.NoError(t, err)
+	require.Equal(t, fooRevenue, claimRes.Amount)
+	require.Equal(t, fooRevenue, input.BankKeeper.GetAllBalances(ctx, addr))
+	require.True(t, input.BankKeeper.GetAllBalances(ctx, other).IsZero())
+
+	// total revenue is not reset by the claim
+	res, err = querier.ModuleRevenue(ctx, &types.QueryModuleRevenueRequest{
+		Address:    twoAddr.String(),
+		ModuleName: "foo",
+	})
+	require.NoError(t, err)
+	require.Equal(t, fooRevenue, res.TotalRevenue)
+
+	// remove the override
+	_, err = ms.UpdateRevenueRatio(ctx, &types.MsgUpdateRevenueRatio{
+		Authority:     input.MoveKeeper.GetAuthority(),
+		ModuleAddress: twoAddr.String(),
+		ModuleName:    "foo",
+	})
+	require.NoError(t, err)
+
+	effectiveRatio, err := input.MoveKeeper.GetRevenueRatio(ctx, twoAddr, "foo")
+	require.NoError(t, err)
+	require.Equal(t, defaultRatio, effectiveRatio)
+}
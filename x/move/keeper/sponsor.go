@@ -0,0 +1,187 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// sponsorParams is the parameter types of the sponsor policy function,
+// `fun(sponsor: address, payer: address, msg_types: vector<String>,
+// fee_denoms: vector<String>, fee_amounts: vector<u64>, gas_limit: u64): bool`.
+var sponsorParams = []string{
+	"address",
+	"address",
+	"vector<0x1::string::String>",
+	"vector<0x1::string::String>",
+	"vector<u64>",
+	"u64",
+}
+
+// GetSponsor returns the sponsor policy of the address, and false
+// if the address does not sponsor any tx.
+func (k Keeper) GetSponsor(ctx context.Context, addr vmtypes.AccountAddress) (types.Sponsor, bool, error) {
+	sponsor, err := k.Sponsors.Get(ctx, addr.Bytes())
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return types.Sponsor{}, false, nil
+	} else if err != nil {
+		return types.Sponsor{}, false, err
+	}
+
+	return sponsor, true, nil
+}
+
+// SetSponsor sets the view function which decides whether the sponsor pays
+// the fee of a tx. The function must be a non-generic view function of
+// `fun(sponsor: address, payer: address, msg_types: vector<String>,
+// fee_denoms: vector<String>, fee_amounts: vector<u64>, gas_limit: u64): bool`.
+func (k Keeper) SetSponsor(
+	ctx context.Context,
+	addr vmtypes.AccountAddress,
+	moduleAddr vmtypes.AccountAddress,
+	moduleName string,
+	functionName string,
+) error {
+	if err := k.validatePolicyFunction(
+		ctx, moduleAddr, moduleName, functionName,
+		sponsorParams, types.ErrInvalidSponsor,
+	); err != nil {
+		return err
+	}
+
+	return k.Sponsors.Set(ctx, addr.Bytes(), types.Sponsor{
+		Address:       addr.String(),
+		ModuleAddress: moduleAddr.String(),
+		ModuleName:    moduleName,
+		FunctionName:  functionName,
+	})
+}
+
+// RemoveSponsor removes the sponsor policy of the address.
+func (k Keeper) RemoveSponsor(ctx context.Context, addr vmtypes.AccountAddress) error {
+	return k.Sponsors.Remove(ctx, addr.Bytes())
+}
+
+// CheckSponsorOwner checks the sender can manage the sponsor policy of the
+// address, which is the address itself or the owner of the move object.
+func (k Keeper) CheckSponsorOwner(ctx context.Context, sender, addr vmtypes.AccountAddress) error {
+	if sender == addr {
+		return nil
+	}
+
+	bz, err := k.GetResourceBytes(ctx, addr, vmtypes.StructTag{
+		Address:  vmtypes.StdAddress,
+		Module:   types.MoveModuleNameObject,
+		Name:     types.ResourceNameObjectCore,
+		TypeArgs: []vmtypes.TypeTag{},
+	})
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not the sponsor", sender)
+	} else if err != nil {
+		return err
+	}
+
+	owner, err := types.ReadOwnerFromObjectCore(bz)
+	if err != nil {
+		return err
+	}
+
+	if owner != sender {
+		return sdkerrors.ErrUnauthorized.Wrapf("%s is not the owner of the sponsor object", sender)
+	}
+
+	return nil
+}
+
+// CheckSponsorPolicy runs the sponsor policy with the fee payer, the msgs,
+// the fee and the gas limit of the tx, and returns an error if the policy
+// rejects to pay the fee. The gas used by the policy is bounded by the
+// `SponsorGasLimit` param and consumed from the gas meter of the context.
+func (k Keeper) CheckSponsorPolicy(
+	ctx context.Context,
+	sponsor types.Sponsor,
+	payer vmtypes.AccountAddress,
+	fee sdk.Coins,
+	msgs []sdk.Msg,
+) error {
+	gasLimit, err := k.SponsorGasLimit(ctx)
+	if err != nil {
+		return err
+	}
+
+	if gasLimit == 0 {
+		return types.ErrInvalidSponsor.Wrap("sponsors are disabled")
+	}
+
+	addr, err := types.AccAddressFromString(k.ac, sponsor.Address)
+	if err != nil {
+		return err
+	}
+
+	moduleAddr, err := types.AccAddressFromString(k.ac, sponsor.ModuleAddress)
+	if err != nil {
+		return err
+	}
+
+	msgTypes := make([]string, len(msgs))
+	for i, msg := range msgs {
+		msgTypes[i] = sdk.MsgTypeURL(msg)
+	}
+
+	feeDenoms := make([]string, len(fee))
+	feeAmounts := make([]uint64, len(fee))
+	for i, coin := range fee {
+		if !coin.Amount.IsUint64() {
+			return types.ErrInvalidSponsor.Wrapf("fee amount overflow: %s", coin)
+		}
+
+		feeDenoms[i] = coin.Denom
+		feeAmounts[i] = coin.Amount.Uint64()
+	}
+
+	msgTypesArg, err := vmtypes.SerializeStringVector(msgTypes)
+	if err != nil {
+		return err
+	}
+
+	feeDenomsArg, err := vmtypes.SerializeStringVector(feeDenoms)
+	if err != nil {
+		return err
+	}
+
+	feeAmountsArg, err := vmtypes.SerializeUint64Vector(feeAmounts)
+	if err != nil {
+		return err
+	}
+
+	txGasLimitArg, err := vmtypes.SerializeUint64(sdk.UnwrapSDKContext(ctx).GasMeter().Limit())
+	if err != nil {
+		return err
+	}
+
+	accepted, err := k.executePolicyFunction(
+		ctx,
+		gasLimit,
+		moduleAddr,
+		sponsor.ModuleName,
+		sponsor.FunctionName,
+		[][]byte{addr.Bytes(), payer.Bytes(), msgTypesArg, feeDenomsArg, feeAmountsArg, txGasLimitArg},
+		"move sponsor",
+	)
+	if err != nil {
+		return err
+	}
+
+	if !accepted {
+		return types.ErrInvalidSponsor.Wrapf("rejected by %s::%s::%s", sponsor.ModuleAddress, sponsor.ModuleName, sponsor.FunctionName)
+	}
+
+	return nil
+}
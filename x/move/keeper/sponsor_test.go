@@ -0,0 +1,130 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func TestSponsor(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testSponsorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	sponsor, err := vmtypes.NewAccountAddressFromBytes(addrs[0])
+	require.NoError(t, err)
+	payer, err := vmtypes.NewAccountAddressFromBytes(addrs[1])
+	require.NoError(t, err)
+
+	// invalid sponsor policies
+	err = input.MoveKeeper.SetSponsor(ctx, sponsor, vmtypes.TestAddress, "Unknown", "sponsor")
+	require.ErrorIs(t, err, types.ErrModuleNotFound)
+	err = input.MoveKeeper.SetSponsor(ctx, sponsor, vmtypes.TestAddress, "TestSponsor", "unknown")
+	require.ErrorIs(t, err, types.ErrInvalidSponsor)
+	err = input.MoveKeeper.SetSponsor(ctx, sponsor, vmtypes.TestAddress, "TestSponsor", "wrong_params")
+	require.ErrorIs(t, err, types.ErrInvalidSponsor)
+
+	// only the sponsor can set the policy of the sponsor account
+	msg := &types.MsgSetSponsor{
+		Sender:        addrs[1].String(),
+		Sponsor:       addrs[0].String(),
+		ModuleAddress: vmtypes.TestAddress.String(),
+		ModuleName:    "TestSponsor",
+		FunctionName:  "sponsor",
+	}
+	_, err = ms.SetSponsor(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.Sender = addrs[0].String()
+	_, err = ms.SetSponsor(ctx, msg)
+	require.NoError(t, err)
+
+	res, err := querier.Sponsor(ctx, &types.QuerySponsorRequest{Address: addrs[0].String()})
+	require.NoError(t, err)
+	require.Equal(t, types.Sponsor{
+		Address:       sponsor.String(),
+		ModuleAddress: vmtypes.TestAddress.String(),
+		ModuleName:    "TestSponsor",
+		FunctionName:  "sponsor",
+	}, res.Sponsor)
+
+	// run the policy
+	ctx = ctx.WithGasMeter(storetypes.NewGasMeter(1_000_000))
+	sendMsgs := []sdk.Msg{&banktypes.MsgSend{}}
+	fee := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1000)))
+	require.NoError(t, input.MoveKeeper.CheckSponsorPolicy(ctx, res.Sponsor, payer, fee, sendMsgs))
+	require.NotZero(t, ctx.GasMeter().GasConsumed())
+
+	err = input.MoveKeeper.CheckSponsorPolicy(ctx, res.Sponsor, payer, fee.Add(sdk.NewInt64Coin(bondDenom, 1)), sendMsgs)
+	require.ErrorIs(t, err, types.ErrInvalidSponsor)
+	err = input.MoveKeeper.CheckSponsorPolicy(ctx, res.Sponsor, payer, fee, []sdk.Msg{&types.MsgExecute{}})
+	require.ErrorIs(t, err, types.ErrInvalidSponsor)
+	err = input.MoveKeeper.CheckSponsorPolicy(ctx.WithGasMeter(storetypes.NewGasMeter(2_000_000)), res.Sponsor, payer, fee, sendMsgs)
+	require.ErrorIs(t, err, types.ErrInvalidSponsor)
+
+	// the gas is bounded by the param
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.SponsorGasLimit = 1
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+	require.Error(t, input.MoveKeeper.CheckSponsorPolicy(ctx, res.Sponsor, payer, fee, sendMsgs))
+
+	// remove the sponsor policy
+	_, err = ms.SetSponsor(ctx, &types.MsgSetSponsor{
+		Sender:  addrs[0].String(),
+		Sponsor: addrs[0].String(),
+	})
+	require.NoError(t, err)
+
+	_, found, err := input.MoveKeeper.GetSponsor(ctx, sponsor)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func TestSponsorObject(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ms := keeper.NewMsgServerImpl(&input.MoveKeeper)
+
+	err := input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testSponsorModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	// the primary store of the owner is an object owned by the owner
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewInt64Coin(bondDenom, 1_000_000))
+	owner, err := vmtypes.NewAccountAddressFromBytes(addrs[0])
+	require.NoError(t, err)
+	metadata, err := types.MetadataAddressFromDenom(bondDenom)
+	require.NoError(t, err)
+	object := types.UserDerivedObjectAddress(owner, metadata)
+
+	msg := &types.MsgSetSponsor{
+		Sender:        addrs[1].String(),
+		Sponsor:       object.String(),
+		ModuleAddress: vmtypes.TestAddress.String(),
+		ModuleName:    "TestSponsor",
+		FunctionName:  "sponsor",
+	}
+	_, err = ms.SetSponsor(ctx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	msg.Sender = addrs[0].String()
+	_, err = ms.SetSponsor(ctx, msg)
+	require.NoError(t, err)
+
+	_, found, err := input.MoveKeeper.GetSponsor(ctx, object)
+	require.NoError(t, err)
+	require.True(t, found)
+}
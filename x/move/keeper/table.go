@@ -0,0 +1,188 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"slices"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// paginateTableEntries pages through the table entries whose bcs encoded key
+// is in the range [startKey, endKey) and starts with the key prefix. The empty
+// start and end keys mean the range is unbounded.
+//
+// BCS encodes the integers in little-endian, so the byte order of integer keys
+// does not follow their numeric order. For integer key types the range bounds
+// are compared numerically against the decoded keys instead, while the entries
+// are still returned in the store order.
+func (k Keeper) paginateTableEntries(
+	ctx context.Context,
+	tableAddr vmtypes.AccountAddress,
+	startKey, endKey, keyPrefix []byte,
+	pageReq *query.PageRequest,
+) ([]types.TableEntry, *query.PageResponse, error) {
+	info, err := k.GetTableInfo(ctx, tableAddr)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	keyTypeTag, err := vmapi.TypeTagFromString(info.KeyType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	valueTypeTag, err := vmapi.TypeTagFromString(info.ValueType)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// integer keys are filtered by their numeric value
+	var numStart, numEnd *big.Int
+	if isIntegerTypeTag(keyTypeTag) {
+		if len(startKey) > 0 {
+			numStart = decodeLittleEndian(startKey)
+		}
+		if len(endKey) > 0 {
+			numEnd = decodeLittleEndian(endKey)
+		}
+
+		startKey, endKey = nil, nil
+	}
+
+	// narrow the range to the key prefix
+	if len(keyPrefix) > 0 {
+		if bytes.Compare(startKey, keyPrefix) < 0 {
+			startKey = keyPrefix
+		}
+
+		if prefixEnd := storetypes.PrefixEndBytes(keyPrefix); len(endKey) == 0 || (prefixEnd != nil && bytes.Compare(prefixEnd, endKey) < 0) {
+			endKey = prefixEnd
+		}
+	}
+
+	vmStore := types.NewVMStore(ctx, k.VMStore)
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), append(types.VMStorePrefix, types.GetTableEntryPrefix(tableAddr)...))
+
+	entries := []types.TableEntry{}
+	pageRes, err := query.FilteredPaginate(rangeStore{store, startKey, endKey}, pageReq, func(key []byte, value []byte, accumulate bool) (bool, error) {
+		if numStart != nil || numEnd != nil {
+			num := decodeLittleEndian(key)
+			if numStart != nil && num.Cmp(numStart) < 0 {
+				return false, nil
+			}
+			if numEnd != nil && num.Cmp(numEnd) >= 0 {
+				return false, nil
+			}
+		}
+
+		if !accumulate {
+			return true, nil
+		}
+
+		keyStr, err := vmapi.DecodeMoveValue(vmStore, keyTypeTag, key)
+		if err != nil {
+			return false, err
+		}
+
+		valueStr, err := vmapi.DecodeMoveValue(vmStore, valueTypeTag, value)
+		if err != nil {
+			return false, err
+		}
+
+		entries = append(entries, types.TableEntry{
+			Address:    tableAddr.String(),
+			Key:        string(keyStr),
+			Value:      string(valueStr),
+			KeyBytes:   key,
+			ValueBytes: value,
+		})
+		return true, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return entries, pageRes, nil
+}
+
+// serializeTableKeyJSON serializes the json key of the table into bcs bytes.
+func (k Keeper) serializeTableKeyJSON(ctx context.Context, tableAddr vmtypes.AccountAddress, key string) ([]byte, error) {
+	if key == "" {
+		return nil, nil
+	}
+
+	info, err := k.GetTableInfo(ctx, tableAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	keyTypeTag, err := vmapi.TypeTagFromString(info.KeyType)
+	if err != nil {
+		return nil, err
+	}
+
+	return types.SerializeJSONValue(keyTypeTag, []byte(key))
+}
+
+// isIntegerTypeTag returns true if the type tag is one of the unsigned integer types.
+func isIntegerTypeTag(typeTag vmtypes.TypeTag) bool {
+	switch typeTag.(type) {
+	case *vmtypes.TypeTag__U8, *vmtypes.TypeTag__U16, *vmtypes.TypeTag__U32,
+		*vmtypes.TypeTag__U64, *vmtypes.TypeTag__U128, *vmtypes.TypeTag__U256:
+		return true
+	default:
+		return false
+	}
+}
+
+// decodeLittleEndian decodes the bcs encoded unsigned integer.
+func decodeLittleEndian(bz []byte) *big.Int {
+	be := slices.Clone(bz)
+	slices.Reverse(be)
+	return new(big.Int).SetBytes(be)
+}
+
+// rangeStore restricts the iterators of the store to the range [start, end).
+type rangeStore struct {
+	storetypes.KVStore
+
+	start []byte
+	end   []byte
+}
+
+func (s rangeStore) clamp(start, end []byte) ([]byte, []byte) {
+	if len(s.start) > 0 && bytes.Compare(start, s.start) < 0 {
+		start = s.start
+	}
+
+	if len(s.end) > 0 && (end == nil || bytes.Compare(s.end, end) < 0) {
+		end = s.end
+	}
+
+	// an empty range; keep the bounds valid for the iterator
+	if end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+
+	return start, end
+}
+
+func (s rangeStore) Iterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.Iterator(start, end)
+}
+
+func (s rangeStore) ReverseIterator(start, end []byte) storetypes.Iterator {
+	start, end = s.clamp(start, end)
+	return s.KVStore.ReverseIterator(start, end)
+}
@@ -0,0 +1,243 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// CheckUpgradeCompatibility checks whether the module bundle can be published
+// by the sender. The publish is simulated on a cache context, so the result of
+// the vm is authoritative and the state changes are discarded. The per module
+// results report the changed public functions and structs compared to the
+// published modules and the published dependents which use them.
+func (k Keeper) CheckUpgradeCompatibility(
+	ctx context.Context,
+	sender vmtypes.AccountAddress,
+	moduleBundle vmtypes.ModuleBundle,
+	upgradePolicy types.UpgradePolicy,
+) (*types.QueryCheckUpgradeCompatibilityResponse, error) {
+	moduleIds, err := readModuleIds(moduleBundle)
+	if err != nil {
+		return nil, err
+	}
+
+	bundleModules := make(map[string]bool, len(moduleIds))
+	for _, moduleId := range moduleIds {
+		bundleModules[moduleId.String()] = true
+	}
+
+	res := &types.QueryCheckUpgradeCompatibilityResponse{
+		Modules: make([]types.ModuleUpgradeCompatibility, len(moduleIds)),
+	}
+	for i, moduleId := range moduleIds {
+		compatibility, err := k.checkModuleUpgradeCompatibility(ctx, moduleId, moduleBundle.Codes[i].Code, upgradePolicy, bundleModules)
+		if err != nil {
+			return nil, err
+		}
+
+		res.Modules[i] = compatibility
+	}
+
+	// never write the publish results to the parent context
+	sdkCtx, _ := sdk.UnwrapSDKContext(ctx).CacheContext()
+	sdkCtx = sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	if err := k.PublishModuleBundle(sdkCtx, sender, moduleBundle, upgradePolicy); err != nil {
+		res.Error = err.Error()
+	} else {
+		res.Allowed = true
+	}
+
+	return res, nil
+}
+
+func (k Keeper) checkModuleUpgradeCompatibility(
+	ctx context.Context,
+	moduleId vmtypes.ModuleId,
+	code []byte,
+	upgradePolicy types.UpgradePolicy,
+	bundleModules map[string]bool,
+) (types.ModuleUpgradeCompatibility, error) {
+	compatibility := types.ModuleUpgradeCompatibility{
+		Address:          moduleId.Address.String(),
+		ModuleName:       string(moduleId.Name),
+		BrokenDependents: []types.ModuleIdentifier{},
+	}
+
+	oldModule, err := k.GetModule(ctx, moduleId.Address, string(moduleId.Name))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		compatibility.IsNew = true
+		compatibility.Allowed = true
+		return compatibility, nil
+	} else if err != nil {
+		return compatibility, err
+	}
+
+	oldABI, err := k.decodeModuleABI(oldModule.RawBytes)
+	if err != nil {
+		return compatibility, err
+	}
+
+	newABI, err := k.decodeModuleABI(code)
+	if err != nil {
+		return compatibility, err
+	}
+
+	compatibility.StoredUpgradePolicy = oldModule.UpgradePolicy
+	compatibility.RemovedFunctions, compatibility.ChangedFunctions = diffPublicFunctions(oldABI, newABI)
+	compatibility.RemovedStructs, compatibility.ChangedStructs = diffStructs(oldABI, newABI)
+
+	incompatible := len(compatibility.RemovedFunctions) != 0 || len(compatibility.ChangedFunctions) != 0 ||
+		len(compatibility.RemovedStructs) != 0 || len(compatibility.ChangedStructs) != 0
+
+	switch {
+	case oldModule.UpgradePolicy == types.UpgradePolicy_IMMUTABLE:
+		compatibility.Reason = "the module is immutable"
+	case upgradePolicy < oldModule.UpgradePolicy:
+		compatibility.Reason = "the upgrade policy can not be weakened"
+	case incompatible:
+		compatibility.Reason = "the public functions or structs are not compatible"
+	default:
+		compatibility.Allowed = true
+	}
+
+	if !incompatible {
+		return compatibility, nil
+	}
+
+	brokenStructs := append(slices.Clone(compatibility.RemovedStructs), compatibility.ChangedStructs...)
+	brokenFunctions := append(slices.Clone(compatibility.RemovedFunctions), compatibility.ChangedFunctions...)
+
+	// collect the dependents first; the dependents in the bundle are replaced together
+	var dependents []string
+	err = k.ModuleDependents.Walk(ctx, collections.NewPrefixedPairRange[string, string](moduleId.String()), func(key collections.Pair[string, string]) (bool, error) {
+		if !bundleModules[key.K2()] {
+			dependents = append(dependents, key.K2())
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return compatibility, err
+	}
+
+	for _, dependent := range dependents {
+		dependentId, err := types.ParseModuleIdentifier(dependent)
+		if err != nil {
+			return compatibility, err
+		}
+
+		dependentAddr, err := vmtypes.NewAccountAddress(dependentId.Address)
+		if err != nil {
+			return compatibility, err
+		}
+
+		moduleKey, err := types.GetModuleKey(dependentAddr, dependentId.ModuleName)
+		if err != nil {
+			return compatibility, err
+		}
+
+		dependentCode, err := k.VMStore.Get(ctx, moduleKey)
+		if err != nil {
+			return compatibility, err
+		}
+
+		structs, functions, err := types.ReadModuleReferences(dependentCode, moduleId)
+		if err != nil {
+			return compatibility, err
+		}
+
+		if slices.ContainsFunc(structs, func(name string) bool { return slices.Contains(brokenStructs, name) }) ||
+			slices.ContainsFunc(functions, func(name string) bool { return slices.Contains(brokenFunctions, name) }) {
+			compatibility.BrokenDependents = append(compatibility.BrokenDependents, dependentId)
+		}
+	}
+
+	return compatibility, nil
+}
+
+// diffPublicFunctions returns the public functions of the old module which
+// are removed or made non-public, and the ones whose signature is changed.
+// The type parameter constraints can be relaxed.
+func diffPublicFunctions(oldABI, newABI moduleABI) (removed []string, changed []string) {
+	for _, oldFunction := range oldABI.ExposedFunctions {
+		if oldFunction.Visibility != "public" {
+			continue
+		}
+
+		idx := slices.IndexFunc(newABI.ExposedFunctions, func(function functionABI) bool {
+			return function.Name == oldFunction.Name
+		})
+		if idx == -1 || newABI.ExposedFunctions[idx].Visibility != "public" {
+			removed = append(removed, oldFunction.Name)
+			continue
+		}
+
+		newFunction := newABI.ExposedFunctions[idx]
+		compatible := slices.Equal(oldFunction.Params, newFunction.Params) &&
+			slices.Equal(oldFunction.Return, newFunction.Return) &&
+			len(oldFunction.GenericTypeParams) == len(newFunction.GenericTypeParams)
+		for i := 0; compatible && i < len(oldFunction.GenericTypeParams); i++ {
+			compatible = isSubset(newFunction.GenericTypeParams[i].Constraints, oldFunction.GenericTypeParams[i].Constraints)
+		}
+
+		if !compatible {
+			changed = append(changed, oldFunction.Name)
+		}
+	}
+
+	return removed, changed
+}
+
+// diffStructs returns the structs of the old module which are removed, and
+// the ones whose layout is changed. The abilities can be added, the type
+// parameter constraints can be relaxed and the type parameters can be made
+// phantom.
+func diffStructs(oldABI, newABI moduleABI) (removed []string, changed []string) {
+	for _, oldStruct := range oldABI.Structs {
+		idx := slices.IndexFunc(newABI.Structs, func(s structABI) bool {
+			return s.Name == oldStruct.Name
+		})
+		if idx == -1 {
+			removed = append(removed, oldStruct.Name)
+			continue
+		}
+
+		newStruct := newABI.Structs[idx]
+		compatible := isSubset(oldStruct.Abilities, newStruct.Abilities) &&
+			len(oldStruct.GenericTypeParams) == len(newStruct.GenericTypeParams) &&
+			len(oldStruct.Fields) == len(newStruct.Fields)
+		for i := 0; compatible && i < len(oldStruct.GenericTypeParams); i++ {
+			oldParam, newParam := oldStruct.GenericTypeParams[i], newStruct.GenericTypeParams[i]
+			compatible = isSubset(newParam.Constraints, oldParam.Constraints) && (!oldParam.IsPhantom || newParam.IsPhantom)
+		}
+		for i := 0; compatible && i < len(oldStruct.Fields); i++ {
+			compatible = oldStruct.Fields[i] == newStruct.Fields[i]
+		}
+
+		if !compatible {
+			changed = append(changed, oldStruct.Name)
+		}
+	}
+
+	return removed, changed
+}
+
+func isSubset(a, b []string) bool {
+	for _, v := range a {
+		if !slices.Contains(b, v) {
+			return false
+		}
+	}
+
+	return true
+}
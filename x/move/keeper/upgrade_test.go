@@ -0,0 +1,94 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/move/keeper"
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func TestCheckUpgradeCompatibility(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	querier := keeper.NewQuerier(&input.MoveKeeper)
+
+	// new modules
+	res, err := querier.CheckUpgradeCompatibility(ctx, &types.QueryCheckUpgradeCompatibilityRequest{
+		Sender:        vmtypes.TestAddress.String(),
+		CodeBytes:     [][]byte{testUpgradeModule},
+		UpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Len(t, res.Modules, 1)
+	require.True(t, res.Modules[0].IsNew)
+	require.True(t, res.Modules[0].Allowed)
+
+	// the check never publishes the modules
+	found, err := input.MoveKeeper.HasModule(ctx, vmtypes.TestAddress, "TestUpgrade")
+	require.NoError(t, err)
+	require.False(t, found)
+
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testUpgradeModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testUpgradeDependentModule)), types.UpgradePolicy_COMPATIBLE)
+	require.NoError(t, err)
+
+	// compatible upgrade
+	res, err = querier.CheckUpgradeCompatibility(ctx, &types.QueryCheckUpgradeCompatibilityRequest{
+		Sender:        vmtypes.TestAddress.String(),
+		CodeBytes:     [][]byte{testUpgradeModule},
+		UpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+	})
+	require.NoError(t, err)
+	require.True(t, res.Allowed)
+	require.Equal(t, types.ModuleUpgradeCompatibility{
+		Address:             "0x2",
+		ModuleName:          "TestUpgrade",
+		StoredUpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+		Allowed:             true,
+		BrokenDependents:    []types.ModuleIdentifier{},
+	}, res.Modules[0])
+
+	// incompatible upgrade
+	res, err = querier.CheckUpgradeCompatibility(ctx, &types.QueryCheckUpgradeCompatibilityRequest{
+		Sender:        vmtypes.TestAddress.String(),
+		CodeBytes:     [][]byte{testUpgradeV2Module},
+		UpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.NotEmpty(t, res.Error)
+	require.Equal(t, types.ModuleUpgradeCompatibility{
+		Address:             "0x2",
+		ModuleName:          "TestUpgrade",
+		StoredUpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+		Reason:              "the public functions or structs are not compatible",
+		RemovedFunctions:    []string{"value"},
+		ChangedFunctions:    []string{"double"},
+		ChangedStructs:      []string{"Config"},
+		BrokenDependents:    []types.ModuleIdentifier{{Address: "0x2", ModuleName: "TestUpgradeDependent"}},
+	}, res.Modules[0])
+
+	// the upgrade policy can not be weakened
+	err = input.MoveKeeper.PublishModuleBundle(ctx, vmtypes.TestAddress, vmtypes.NewModuleBundle(vmtypes.NewModule(testUpgradeModule)), types.UpgradePolicy_IMMUTABLE)
+	require.NoError(t, err)
+
+	res, err = querier.CheckUpgradeCompatibility(ctx, &types.QueryCheckUpgradeCompatibilityRequest{
+		Sender:        vmtypes.TestAddress.String(),
+		CodeBytes:     [][]byte{testUpgradeModule},
+		UpgradePolicy: types.UpgradePolicy_COMPATIBLE,
+	})
+	require.NoError(t, err)
+	require.False(t, res.Allowed)
+	require.False(t, res.Modules[0].Allowed)
+	require.Equal(t, types.UpgradePolicy_IMMUTABLE, res.Modules[0].StoredUpgradePolicy)
+	require.Equal(t, "the module is immutable", res.Modules[0].Reason)
+
+	_, err = querier.CheckUpgradeCompatibility(ctx, &types.QueryCheckUpgradeCompatibilityRequest{
+		Sender: vmtypes.TestAddress.String(),
+	})
+	require.Error(t, err)
+}
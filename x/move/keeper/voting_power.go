@@ -2,7 +2,9 @@ package keeper
 
 import (
 	"context"
+	"errors"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -26,6 +28,9 @@ func NewVotingPowerKeeper(k *Keeper) VotingPowerKeeper {
 // if denom is base denom, weight is 1.
 // if denom is not base denom, weight = locked base balance / total share,
 // which means we only consider locked base balance for voting power.
+//
+// the weight of a LP denom is the smoothed weight tracked at the beginning
+// of the block, and the spot weight is used only before it is tracked.
 func (k VotingPowerKeeper) GetVotingPowerWeights(ctx context.Context, bondDenoms []string) (sdk.DecCoins, error) {
 	baseDenom, err := k.BaseDenom(ctx)
 	if err != nil {
@@ -37,36 +42,170 @@ func (k VotingPowerKeeper) GetVotingPowerWeights(ctx context.Context, bondDenoms
 		var powerWeight math.LegacyDec
 		if denom == baseDenom {
 			powerWeight = math.LegacyOneDec()
+		} else if weight, err := k.VotingPowerWeights.Get(ctx, denom); err == nil {
+			powerWeight = weight.Amount
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return nil, err
+		} else if weight, found := k.getSpotVotingPowerWeight(ctx, denom); found {
+			powerWeight = weight
 		} else {
-			metadataLP, err := types.MetadataAddressFromDenom(denom)
-			if err != nil {
-				// ignore error to avoid chain halt due to wrong denom
-				continue
-			}
+			continue
+		}
 
-			balanceBase, _, err := NewDexKeeper(k.Keeper).getPoolBalances(ctx, metadataLP)
-			if err != nil {
-				// ignore error to avoid chain halt due to wrong denom
-				continue
-			}
+		// if balance is zero, use zero power
+		if !powerWeight.IsPositive() {
+			continue
+		}
+
+		powerWeights = powerWeights.Add(sdk.NewDecCoinFromDec(denom, powerWeight))
+	}
+
+	return powerWeights, nil
+}
+
+// getSpotVotingPowerWeight returns the current locked base balance per LP share of
+// the dex pool. The wrong denoms are ignored to avoid chain halt.
+func (k VotingPowerKeeper) getSpotVotingPowerWeight(ctx context.Context, denom string) (math.LegacyDec, bool) {
+	metadataLP, err := types.MetadataAddressFromDenom(denom)
+	if err != nil {
+		return math.LegacyDec{}, false
+	}
+
+	balanceBase, _, err := NewDexKeeper(k.Keeper).getPoolBalances(ctx, metadataLP)
+	if err != nil {
+		return math.LegacyDec{}, false
+	}
+
+	totalShare, err := NewMoveBankKeeper(k.Keeper).GetSupply(ctx, denom)
+	if err != nil {
+		return math.LegacyDec{}, false
+	}
+
+	if balanceBase.IsZero() || totalShare.IsZero() {
+		return math.LegacyZeroDec(), true
+	}
+
+	// weight = balanceBase / totalShare => compute locked base balance
+	return math.LegacyNewDecFromInt(balanceBase).QuoInt(totalShare), true
+}
+
+// TrackVotingPowerWeights records the spot weights of the LP bond denoms and updates
+// the smoothed weights to the average of the observations in the TWAP window, which
+// can move at most `MaxVotingPowerWeightChangeRate` of the previous weight in a block.
+//
+// It is called at the beginning of the block, so a swap or a deposit must survive a
+// block boundary to be observed, and it affects a single observation of the window.
+func (k VotingPowerKeeper) TrackVotingPowerWeights(ctx context.Context, bondDenoms []string) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	// the window of one block uses the spot weights
+	window := int64(params.VotingPowerTwapWindow)
+	if window == 0 {
+		window = 1
+	}
+
+	lpDenoms := make(map[string]bool, len(bondDenoms))
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, denom := range bondDenoms {
+		if denom == params.BaseDenom {
+			continue
+		}
+
+		// the previous weight is kept when the spot weight is unavailable in the block
+		lpDenoms[denom] = true
+		spotWeight, found := k.getSpotVotingPowerWeight(ctx, denom)
+		if !found {
+			continue
+		}
 
-			totalShare, err := NewMoveBankKeeper(k.Keeper).GetSupply(ctx, denom)
-			if err != nil {
-				// ignore error to avoid chain halt due to wrong denom
-				continue
+		if err := k.VotingPowerWeightObservations.Set(ctx, collections.Join(denom, height), types.VotingPowerWeightObservation{
+			Denom:  denom,
+			Height: height,
+			Weight: spotWeight,
+		}); err != nil {
+			return err
+		}
+
+		// prune the observations out of the window, and average the rest
+		sum := math.LegacyZeroDec()
+		count := int64(0)
+		var outdated []collections.Pair[string, int64]
+		err = k.VotingPowerWeightObservations.Walk(ctx, collections.NewPrefixedPairRange[string, int64](denom), func(key collections.Pair[string, int64], observation types.VotingPowerWeightObservation) (stop bool, err error) {
+			if key.K2() <= height-window {
+				outdated = append(outdated, key)
+				return false, nil
 			}
 
-			// if balance is zero, use zero power
-			if balanceBase.IsZero() || totalShare.IsZero() {
-				continue
+			sum = sum.Add(observation.Weight)
+			count++
+			return false, nil
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, key := range outdated {
+			if err := k.VotingPowerWeightObservations.Remove(ctx, key); err != nil {
+				return err
 			}
+		}
 
-			// weight = balanceBase / totalShare => compute locked base balance
-			powerWeight = math.LegacyNewDecFromInt(balanceBase).QuoInt(totalShare)
+		weight := sum.QuoInt64(count)
+		if prev, err := k.VotingPowerWeights.Get(ctx, denom); err == nil {
+			weight = capVotingPowerWeightChange(prev.Amount, weight, params.MaxVotingPowerWeightChangeRate)
+		} else if !errors.Is(err, collections.ErrNotFound) {
+			return err
 		}
 
-		powerWeights = powerWeights.Add(sdk.NewDecCoinFromDec(denom, powerWeight))
+		if err := k.VotingPowerWeights.Set(ctx, denom, sdk.NewDecCoinFromDec(denom, weight)); err != nil {
+			return err
+		}
 	}
 
-	return powerWeights, nil
+	// clear the states of the denoms removed from the bond denoms
+	var removed []string
+	err = k.VotingPowerWeights.Walk(ctx, nil, func(denom string, _ sdk.DecCoin) (stop bool, err error) {
+		if !lpDenoms[denom] {
+			removed = append(removed, denom)
+		}
+
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, denom := range removed {
+		if err := k.VotingPowerWeights.Remove(ctx, denom); err != nil {
+			return err
+		}
+
+		if err := k.VotingPowerWeightObservations.Clear(ctx, collections.NewPrefixedPairRange[string, int64](denom)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// capVotingPowerWeightChange limits the change from the previous weight to the rate
+// of the previous weight. The zero or empty rate disables the cap, and the cap is
+// not applied to the zero previous weight, which cannot move otherwise.
+func capVotingPowerWeightChange(prev, weight, rate math.LegacyDec) math.LegacyDec {
+	if rate.IsNil() || !rate.IsPositive() || !prev.IsPositive() {
+		return weight
+	}
+
+	maxChange := prev.Mul(rate)
+	if upper := prev.Add(maxChange); weight.GT(upper) {
+		return upper
+	}
+	if lower := prev.Sub(maxChange); weight.LT(lower) {
+		return math.LegacyMaxDec(lower, math.LegacyZeroDec())
+	}
+
+	return weight
 }
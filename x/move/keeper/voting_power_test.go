@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/math"
 	"github.com/initia-labs/initia/x/move/keeper"
 	"github.com/initia-labs/initia/x/move/types"
@@ -48,3 +49,91 @@ func Test_GetVotingPowerWeights(t *testing.T) {
 		sdk.NewDecCoinFromDec(denomLP, math.LegacyNewDecWithPrec(4, 1))),
 		votingPowerWeights)
 }
+
+func Test_TrackVotingPowerWeights(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.MoveKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.VotingPowerTwapWindow = 2
+	params.MaxVotingPowerWeightChangeRate = math.LegacyNewDecWithPrec(1, 2)
+	require.NoError(t, input.MoveKeeper.SetParams(ctx, params))
+
+	baseDenom := bondDenom
+	baseAmount := math.NewInt(1_000_000_000_000)
+
+	denomQuote := "uusdc"
+	quoteAmount := math.NewInt(2_500_000_000_000)
+
+	metadataQuote, err := types.MetadataAddressFromDenom(denomQuote)
+	require.NoError(t, err)
+
+	metadataLP := createDexPool(
+		t, ctx, input,
+		sdk.NewCoin(baseDenom, baseAmount), sdk.NewCoin(denomQuote, quoteAmount),
+		math.LegacyNewDecWithPrec(8, 1), math.LegacyNewDecWithPrec(2, 1),
+	)
+
+	dexKeeper := keeper.NewDexKeeper(&input.MoveKeeper)
+	err = dexKeeper.SetDexPair(ctx, types.DexPair{
+		MetadataQuote: metadataQuote.String(),
+		MetadataLP:    metadataLP.String(),
+	})
+	require.NoError(t, err)
+
+	denomLP, err := types.DenomFromMetadataAddress(ctx, keeper.NewMoveBankKeeper(&input.MoveKeeper), metadataLP)
+	require.NoError(t, err)
+
+	votingPowerKeeper := keeper.NewVotingPowerKeeper(&input.MoveKeeper)
+	bondDenoms := []string{bondDenom, denomLP}
+
+	// the first observation is used as it is
+	require.NoError(t, votingPowerKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(1), bondDenoms))
+	weights, err := votingPowerKeeper.GetVotingPowerWeights(ctx, bondDenoms)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(4, 1), weights.AmountOf(denomLP))
+
+	// drain the base balance of the pool with a large swap
+	quoteOfferCoin := sdk.NewInt64Coin(denomQuote, 1_000_000_000_000)
+	fundedAddr := input.Faucet.NewFundedAccount(ctx, quoteOfferCoin)
+	require.NoError(t, dexKeeper.SwapToBase(ctx, fundedAddr, quoteOfferCoin))
+
+	balanceBase, _, err := dexKeeper.GetPoolBalances(ctx, denomQuote)
+	require.NoError(t, err)
+	totalShare, err := keeper.NewMoveBankKeeper(&input.MoveKeeper).GetSupply(ctx, denomLP)
+	require.NoError(t, err)
+	spotWeight := math.LegacyNewDecFromInt(balanceBase).QuoInt(totalShare)
+	require.True(t, spotWeight.LT(math.LegacyNewDecWithPrec(38, 2)))
+
+	// the average of the window moves more than 1%, so the change is capped
+	require.NoError(t, votingPowerKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(2), bondDenoms))
+	weights, err = votingPowerKeeper.GetVotingPowerWeights(ctx, bondDenoms)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(396, 3), weights.AmountOf(denomLP))
+
+	// the observation of the height 1 is out of the window, and the weight keeps moving by 1%
+	require.NoError(t, votingPowerKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(3), bondDenoms))
+	weights, err = votingPowerKeeper.GetVotingPowerWeights(ctx, bondDenoms)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(39204, 5), weights.AmountOf(denomLP))
+
+	_, err = input.MoveKeeper.VotingPowerWeightObservations.Get(ctx, collections.Join(denomLP, int64(1)))
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	// the previous weight is kept while the spot weight of a bond denom is unavailable
+	// (the metadata of the denom cannot be resolved)
+	denomUnavailable := "move/unavailable"
+	prevWeight := sdk.NewDecCoinFromDec(denomUnavailable, math.LegacyNewDecWithPrec(5, 1))
+	require.NoError(t, input.MoveKeeper.VotingPowerWeights.Set(ctx, denomUnavailable, prevWeight))
+	require.NoError(t, votingPowerKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(4), append(bondDenoms, denomUnavailable)))
+	weight, err := input.MoveKeeper.VotingPowerWeights.Get(ctx, denomUnavailable)
+	require.NoError(t, err)
+	require.Equal(t, prevWeight, weight)
+
+	// the states are cleared when the denom is removed from the bond denoms
+	require.NoError(t, votingPowerKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(5), []string{bondDenom}))
+	_, err = input.MoveKeeper.VotingPowerWeights.Get(ctx, denomLP)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+	_, err = input.MoveKeeper.VotingPowerWeights.Get(ctx, denomUnavailable)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+}
@@ -1,19 +1,22 @@
 package types
 
 import (
+	"bytes"
 	context "context"
+	"math/big"
 
 	"github.com/IGLOU-EU/go-wildcard"
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
-	"github.com/cosmos/cosmos-sdk/x/auth/codec"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	"github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
 )
 
 // it has similar issue with gasCostPerIterarion in staking/type/authz.go
@@ -100,10 +103,20 @@ func NewExecuteAuthorization(ac address.Codec, moduleIdentifiers []ExecuteAuthor
 		if err != nil {
 			return nil, sdkerrors.ErrInvalidAddress.Wrap("invalid module address")
 		}
+		for _, constraint := range moduleIdentifier.ArgConstraints {
+			for _, allowedAddr := range constraint.AllowedAddresses {
+				if _, err := AccAddressFromString(ac, allowedAddr); err != nil {
+					return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid allowed address: %s", allowedAddr)
+				}
+			}
+		}
+
 		a.Items = append(a.Items, ExecuteAuthorizationItem{
-			ModuleAddress: addr.String(),
-			ModuleName:    moduleIdentifier.ModuleName,
-			FunctionNames: moduleIdentifier.FunctionNames,
+			ModuleAddress:  addr.String(),
+			ModuleName:     moduleIdentifier.ModuleName,
+			FunctionNames:  moduleIdentifier.FunctionNames,
+			TypeArgs:       moduleIdentifier.TypeArgs,
+			ArgConstraints: moduleIdentifier.ArgConstraints,
 		})
 	}
 	return &a, nil
@@ -133,6 +146,21 @@ func (a ExecuteAuthorization) ValidateBasic() error {
 		} else {
 			moduleMap[v.ModuleAddress] = []string{v.ModuleName}
 		}
+		for _, typeArg := range v.TypeArgs {
+			if _, err := api.TypeTagFromString(typeArg); err != nil {
+				return errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid type arg: %s", typeArg)
+			}
+		}
+		for _, constraint := range v.ArgConstraints {
+			if err := constraint.Validate(); err != nil {
+				return err
+			}
+		}
+	}
+	if !a.SpendLimit.Empty() {
+		if err := a.SpendLimit.Validate(); err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid spend limit: %s", err)
+		}
 	}
 	return nil
 }
@@ -142,8 +170,12 @@ func (a ExecuteAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Ac
 	case *MsgExecute:
 		sdkCtx := sdk.UnwrapSDKContext(ctx)
 
-		// TODO - cannot retrieve address codec here
-		ac := codec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+		grant, found := getExecuteGrant(ctx)
+		if !found {
+			return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("execute authorization must be dispatched by MsgExec")
+		}
+
+		ac := grant.ac
 		msgModuleAddr, err := AccAddressFromString(ac, msg.ModuleAddress)
 		if err != nil {
 			return authz.AcceptResponse{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid module address: %s", msg.ModuleAddress)
@@ -165,7 +197,11 @@ func (a ExecuteAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Ac
 			}
 			sdkCtx.GasMeter().ConsumeGas(gasCostPerMatchTest, "execute authorization match test")
 			if isMatching(msg.FunctionName, v.FunctionNames) {
-				return authz.AcceptResponse{Accept: true}, nil
+				if err := v.checkExecution(sdkCtx, ac, msg); err != nil {
+					return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap(err.Error())
+				}
+
+				return a.accept(sdkCtx, grant, msg)
 			}
 		}
 		// all items are checked, but no match
@@ -174,3 +210,243 @@ func (a ExecuteAuthorization) Accept(ctx context.Context, msg sdk.Msg) (authz.Ac
 		return authz.AcceptResponse{}, sdkerrors.ErrInvalidRequest.Wrap("unknown msg type")
 	}
 }
+
+// accept consumes a use of the authorization, and registers the spend limit
+// to the tracker, which is enforced by the move keeper after the execution.
+func (a ExecuteAuthorization) accept(ctx sdk.Context, grant executeGrant, msg *MsgExecute) (authz.AcceptResponse, error) {
+	resp := authz.AcceptResponse{Accept: true}
+
+	updated := a
+	switch a.RemainingUses {
+	case 0:
+		// no limit on the uses
+	case 1:
+		resp.Delete = true
+	default:
+		updated.RemainingUses--
+		resp.Updated = &updated
+	}
+
+	if a.SpendLimit.Empty() {
+		return resp, nil
+	}
+
+	tracker := GetExecuteSpendTracker(ctx)
+	if tracker == nil {
+		return authz.AcceptResponse{}, sdkerrors.ErrUnauthorized.Wrap("spend limit cannot be tracked")
+	}
+
+	granter, err := AccAddressFromString(grant.ac, msg.Sender)
+	if err != nil {
+		return authz.AcceptResponse{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid sender address: %s", msg.Sender)
+	}
+
+	tracker.Push(ExecuteSpend{
+		Granter:       granter,
+		Grantee:       grant.grantee,
+		Authorization: updated,
+		Deleted:       resp.Delete,
+	})
+
+	return resp, nil
+}
+
+// checkExecution checks the type args and the argument constraints of the item.
+func (v ExecuteAuthorizationItem) checkExecution(ctx sdk.Context, ac address.Codec, msg *MsgExecute) error {
+	if len(v.TypeArgs) > 0 {
+		if len(v.TypeArgs) != len(msg.TypeArgs) {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "expected %d type args, got %d", len(v.TypeArgs), len(msg.TypeArgs))
+		}
+
+		for i, typeArg := range v.TypeArgs {
+			ctx.GasMeter().ConsumeGas(gasCostPerMatchTest, "execute authorization type arg test")
+			if !isSameTypeArg(typeArg, msg.TypeArgs[i]) {
+				return errors.Wrapf(sdkerrors.ErrInvalidRequest, "type arg %d is not allowed: %s", i, msg.TypeArgs[i])
+			}
+		}
+	}
+
+	for _, constraint := range v.ArgConstraints {
+		ctx.GasMeter().ConsumeGas(gasCostPerMatchTest, "execute authorization arg constraint test")
+		if err := constraint.check(ac, msg.Args); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// isSameTypeArg compares the type args in the canonical form.
+func isSameTypeArg(expected, actual string) bool {
+	expectedTag, err := api.TypeTagFromString(expected)
+	if err != nil {
+		return false
+	}
+
+	actualTag, err := api.TypeTagFromString(actual)
+	if err != nil {
+		return false
+	}
+
+	expectedStr, err := api.StringifyTypeTag(expectedTag)
+	if err != nil {
+		return false
+	}
+
+	actualStr, err := api.StringifyTypeTag(actualTag)
+	if err != nil {
+		return false
+	}
+
+	return expectedStr == actualStr
+}
+
+// Validate checks exactly one of the constraints is set.
+func (c ArgumentConstraint) Validate() error {
+	set := 0
+	if c.Equals != nil {
+		set++
+	}
+	if c.MaxAmount != nil {
+		if c.MaxAmount.IsNegative() {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "negative max amount of arg %d", c.Index)
+		}
+		set++
+	}
+	if len(c.AllowedAddresses) > 0 {
+		set++
+	}
+
+	if set != 1 {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "exactly one constraint must be set for arg %d", c.Index)
+	}
+
+	return nil
+}
+
+func (c ArgumentConstraint) check(ac address.Codec, args [][]byte) error {
+	if int(c.Index) >= len(args) {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "missing arg %d", c.Index)
+	}
+
+	arg := args[c.Index]
+	switch {
+	case c.Equals != nil:
+		if !bytes.Equal(arg, c.Equals) {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "arg %d is not allowed", c.Index)
+		}
+	case c.MaxAmount != nil:
+		amount, err := readUintArg(arg)
+		if err != nil {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "arg %d is not an unsigned integer", c.Index)
+		}
+
+		if amount.GT(*c.MaxAmount) {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "arg %d exceeds the max amount %s", c.Index, c.MaxAmount)
+		}
+	default:
+		addr, err := vmtypes.NewAccountAddressFromBytes(arg)
+		if err != nil || len(arg) != AddressBytesLength {
+			return errors.Wrapf(sdkerrors.ErrInvalidRequest, "arg %d is not an address", c.Index)
+		}
+
+		for _, allowedAddr := range c.AllowedAddresses {
+			allowed, err := AccAddressFromString(ac, allowedAddr)
+			if err == nil && allowed.Equals(addr) {
+				return nil
+			}
+		}
+
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "arg %d is not an allowed address", c.Index)
+	}
+
+	return nil
+}
+
+// readUintArg reads the bcs encoded unsigned integer, from u8 to u256.
+func readUintArg(arg []byte) (math.Int, error) {
+	switch len(arg) {
+	case 1, 2, 4, 8, 16, 32:
+	default:
+		return math.Int{}, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid integer length: %d", len(arg))
+	}
+
+	// little endian to big endian
+	bz := make([]byte, len(arg))
+	for i := range arg {
+		bz[len(arg)-1-i] = arg[i]
+	}
+
+	return math.NewIntFromBigInt(new(big.Int).SetBytes(bz)), nil
+}
+
+type executeSpendTrackerKey struct{}
+type executeGrantKey struct{}
+
+// executeGrant is the grant of the authz exec message being dispatched.
+type executeGrant struct {
+	ac      address.Codec
+	grantee sdk.AccAddress
+}
+
+// ExecuteSpend is the spend limit of an accepted execute authorization.
+type ExecuteSpend struct {
+	Granter vmtypes.AccountAddress
+	Grantee sdk.AccAddress
+	// Authorization is the authorization stored after the acceptance
+	Authorization ExecuteAuthorization
+	// Deleted is true if the grant is deleted by the acceptance
+	Deleted bool
+}
+
+// ExecuteSpendTracker passes the spend limits of the accepted execute
+// authorizations to the move keeper, which observes the outflow of the
+// granter during the execution and enforces the limits.
+type ExecuteSpendTracker struct {
+	spends []ExecuteSpend
+}
+
+// WithExecuteSpendTracker returns the context with a new spend tracker.
+func WithExecuteSpendTracker(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(executeSpendTrackerKey{}, &ExecuteSpendTracker{})
+}
+
+// WithExecuteGrant returns the context with the address codec and the grantee
+// of the authz exec message being dispatched, which are used to check the
+// execute authorization.
+func WithExecuteGrant(ctx sdk.Context, ac address.Codec, grantee sdk.AccAddress) sdk.Context {
+	return ctx.WithValue(executeGrantKey{}, executeGrant{ac: ac, grantee: grantee})
+}
+
+// getExecuteGrant returns the grant of the authz exec message being dispatched.
+func getExecuteGrant(ctx context.Context) (executeGrant, bool) {
+	grant, ok := ctx.Value(executeGrantKey{}).(executeGrant)
+	return grant, ok
+}
+
+// GetExecuteSpendTracker returns the spend tracker of the context, or nil if not set.
+func GetExecuteSpendTracker(ctx context.Context) *ExecuteSpendTracker {
+	tracker, _ := ctx.Value(executeSpendTrackerKey{}).(*ExecuteSpendTracker)
+	return tracker
+}
+
+// Push registers the spend limit of the accepted execution.
+func (t *ExecuteSpendTracker) Push(spend ExecuteSpend) {
+	t.spends = append(t.spends, spend)
+}
+
+// Pop returns and removes the spend limit of the last accepted execution,
+// if the execution is sent by the granter.
+func (t *ExecuteSpendTracker) Pop(granter vmtypes.AccountAddress) (ExecuteSpend, bool) {
+	if t == nil || len(t.spends) == 0 {
+		return ExecuteSpend{}, false
+	}
+
+	spend := t.spends[len(t.spends)-1]
+	if !spend.Granter.Equals(granter) {
+		return ExecuteSpend{}, false
+	}
+
+	t.spends = t.spends[:len(t.spends)-1]
+	return spend, true
+}
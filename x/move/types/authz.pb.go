@@ -6,6 +6,8 @@ package types
 import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -73,6 +75,12 @@ func (m *PublishAuthorization) GetModuleNames() []string {
 // ExecuteAuthorization defines authorization for execute a move function.
 type ExecuteAuthorization struct {
 	Items []ExecuteAuthorizationItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items"`
+	// SpendLimit is the remaining cap of the coins which can flow out of the
+	// granter account during the executions, and empty means no cap.
+	SpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=spend_limit,json=spendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"spend_limit"`
+	// RemainingUses is the number of executions left before the grant
+	// expires, and zero means no limit.
+	RemainingUses uint64 `protobuf:"varint,3,opt,name=remaining_uses,json=remainingUses,proto3" json:"remaining_uses,omitempty"`
 }
 
 func (m *ExecuteAuthorization) Reset()         { *m = ExecuteAuthorization{} }
@@ -115,6 +123,20 @@ func (m *ExecuteAuthorization) GetItems() []ExecuteAuthorizationItem {
 	return nil
 }
 
+func (m *ExecuteAuthorization) GetSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.SpendLimit
+	}
+	return nil
+}
+
+func (m *ExecuteAuthorization) GetRemainingUses() uint64 {
+	if m != nil {
+		return m.RemainingUses
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PublishAuthorization)(nil), "initia.move.v1.PublishAuthorization")
 	proto.RegisterType((*ExecuteAuthorization)(nil), "initia.move.v1.ExecuteAuthorization")
@@ -123,26 +145,34 @@ func init() {
 func init() { proto.RegisterFile("initia/move/v1/authz.proto", fileDescriptor_0fa53ff24ab5601c) }
 
 var fileDescriptor_0fa53ff24ab5601c = []byte{
-	// 297 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0xca, 0xcc, 0xcb, 0x2c,
-	0xc9, 0x4c, 0xd4, 0xcf, 0xcd, 0x2f, 0x4b, 0xd5, 0x2f, 0x33, 0xd4, 0x4f, 0x2c, 0x2d, 0xc9, 0xa8,
-	0xd2, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x83, 0xc8, 0xe9, 0x81, 0xe4, 0xf4, 0xca, 0x0c,
-	0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x89, 0x94, 0x64, 0x72, 0x7e,
-	0x71, 0x6e, 0x7e, 0x71, 0x3c, 0x98, 0xa7, 0x0f, 0xe1, 0x40, 0xa5, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0x21, 0xe2, 0x20, 0x16, 0x54, 0x14, 0xdd, 0xbe, 0x92, 0xca, 0x82, 0x54, 0xa8, 0x0e, 0xa5, 0x2c,
-	0x2e, 0x91, 0x80, 0xd2, 0xa4, 0x9c, 0xcc, 0xe2, 0x0c, 0xc7, 0xd2, 0x92, 0x8c, 0xfc, 0xa2, 0xcc,
-	0xaa, 0xc4, 0x92, 0xcc, 0xfc, 0x3c, 0x21, 0x45, 0x2e, 0x9e, 0xdc, 0xfc, 0x94, 0xd2, 0x9c, 0xd4,
-	0xf8, 0xbc, 0xc4, 0xdc, 0xd4, 0x62, 0x09, 0x46, 0x05, 0x66, 0x0d, 0xce, 0x20, 0x6e, 0x88, 0x98,
-	0x1f, 0x48, 0xc8, 0x4a, 0xff, 0xd4, 0x16, 0x5d, 0x5e, 0x14, 0x5d, 0x5d, 0xcf, 0x37, 0x68, 0x49,
-	0x82, 0x6d, 0xc1, 0x66, 0xa6, 0xd2, 0x24, 0x46, 0x2e, 0x11, 0xd7, 0x8a, 0xd4, 0xe4, 0xd2, 0x92,
-	0x54, 0x54, 0xcb, 0x3c, 0xb9, 0x58, 0x33, 0x4b, 0x52, 0x73, 0x21, 0xb6, 0x70, 0x1b, 0x69, 0xe8,
-	0xa1, 0x06, 0x82, 0x1e, 0x36, 0x4d, 0x9e, 0x25, 0xa9, 0xb9, 0x4e, 0x9c, 0x27, 0xee, 0xc9, 0x33,
-	0xac, 0x78, 0xbe, 0x41, 0x8b, 0x31, 0x08, 0x62, 0x02, 0x5e, 0x47, 0x61, 0x33, 0xc6, 0xc9, 0xe5,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xb4, 0xd2, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x21, 0x0e, 0xd2, 0xcd, 0x49, 0x4c, 0x2a, 0x86, 0xb2, 0xf5,
-	0x2b, 0x20, 0xe1, 0x09, 0x0e, 0xcc, 0x24, 0x36, 0x70, 0x68, 0x1a, 0x03, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0xbf, 0x2a, 0x48, 0xc6, 0xdb, 0x01, 0x00, 0x00,
+	// 417 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x51, 0xbf, 0xeb, 0x13, 0x31,
+	0x1c, 0xbd, 0x6b, 0x55, 0x68, 0xfa, 0x03, 0x3c, 0x3a, 0xb4, 0x1d, 0xae, 0xb5, 0x20, 0x1c, 0x07,
+	0x4d, 0xa8, 0x6e, 0x6e, 0xd6, 0x1f, 0x50, 0x10, 0x91, 0x82, 0x8b, 0xcb, 0x91, 0xbb, 0x86, 0xbb,
+	0xe8, 0x25, 0x29, 0x4d, 0xae, 0xb4, 0x1d, 0x1d, 0x9d, 0x9c, 0xfd, 0x0b, 0xc4, 0xa9, 0x83, 0xb3,
+	0x73, 0x71, 0xea, 0xe8, 0xa4, 0xd2, 0x0e, 0xfd, 0x37, 0x24, 0x97, 0x20, 0x56, 0x0a, 0xdf, 0xe5,
+	0x2e, 0x79, 0x2f, 0xef, 0xbd, 0xe4, 0x7d, 0x40, 0x8f, 0x72, 0xaa, 0x28, 0x46, 0x4c, 0xac, 0x08,
+	0x5a, 0x8d, 0x11, 0x2e, 0x54, 0xb6, 0x85, 0x8b, 0xa5, 0x50, 0xc2, 0x6b, 0x19, 0x0e, 0x6a, 0x0e,
+	0xae, 0xc6, 0xbd, 0xbb, 0x98, 0x51, 0x2e, 0x50, 0xf9, 0x35, 0x47, 0x7a, 0x7e, 0x22, 0x24, 0x13,
+	0x12, 0xc5, 0x58, 0x6a, 0x79, 0x4c, 0x14, 0x1e, 0xa3, 0x44, 0x50, 0x6e, 0xf9, 0xae, 0xe1, 0xa3,
+	0x72, 0x87, 0xcc, 0xc6, 0x52, 0xed, 0x54, 0xa4, 0xc2, 0xe0, 0x7a, 0x65, 0xd1, 0xff, 0xef, 0xa3,
+	0x36, 0x0b, 0x62, 0x15, 0xc3, 0xb7, 0xa0, 0xfd, 0xaa, 0x88, 0x73, 0x2a, 0xb3, 0xc7, 0x85, 0xca,
+	0xc4, 0x92, 0x6e, 0xb1, 0xa2, 0x82, 0x7b, 0xf7, 0x40, 0x83, 0x89, 0x79, 0x91, 0x93, 0x88, 0x63,
+	0x46, 0x64, 0xc7, 0x1d, 0x54, 0x83, 0xda, 0xac, 0x6e, 0xb0, 0x97, 0x1a, 0x7a, 0x84, 0xbe, 0x7f,
+	0x1d, 0x35, 0x2f, 0x54, 0x1f, 0xce, 0xbb, 0xb0, 0x5b, 0xa6, 0x5c, 0xf3, 0x1c, 0x7e, 0xab, 0x80,
+	0xf6, 0xb3, 0x35, 0x49, 0x0a, 0x45, 0x2e, 0xc3, 0xa6, 0xe0, 0x36, 0x55, 0x84, 0x99, 0x94, 0xfa,
+	0x83, 0x00, 0x5e, 0x96, 0x04, 0xaf, 0x89, 0xa6, 0x8a, 0xb0, 0x49, 0x6d, 0xff, 0xb3, 0xef, 0x7c,
+	0x3e, 0xef, 0x42, 0x77, 0x66, 0x1c, 0xbc, 0xf7, 0x2e, 0xa8, 0xcb, 0x05, 0xe1, 0xf3, 0x28, 0xa7,
+	0x8c, 0xaa, 0x4e, 0xa5, 0x74, 0xec, 0x42, 0x5b, 0x93, 0xee, 0x14, 0xda, 0x4e, 0xe1, 0x13, 0x41,
+	0xf9, 0xe4, 0xb9, 0xb6, 0xf8, 0xf2, 0xab, 0x1f, 0xa4, 0x54, 0x65, 0x45, 0x0c, 0x13, 0xc1, 0x6c,
+	0xa7, 0xf6, 0x37, 0x92, 0xf3, 0x77, 0xb6, 0x32, 0x2d, 0x90, 0x9f, 0xce, 0xbb, 0xb0, 0x91, 0x93,
+	0x14, 0x27, 0x9b, 0x48, 0x4f, 0x45, 0x9a, 0x7c, 0x50, 0xa6, 0xbe, 0xd0, 0xa1, 0xde, 0x7d, 0xd0,
+	0x5a, 0x12, 0x86, 0x29, 0xa7, 0x3c, 0x8d, 0x0a, 0x49, 0x64, 0xa7, 0x3a, 0x70, 0x83, 0x5b, 0xb3,
+	0xe6, 0x5f, 0xf4, 0xb5, 0xbc, 0xa1, 0xc0, 0x6b, 0x4f, 0x9e, 0x3c, 0xdd, 0x1f, 0x7d, 0xf7, 0x70,
+	0xf4, 0xdd, 0xdf, 0x47, 0xdf, 0xfd, 0x78, 0xf2, 0x9d, 0xc3, 0xc9, 0x77, 0x7e, 0x9c, 0x7c, 0xe7,
+	0x4d, 0xf8, 0xcf, 0xed, 0x4d, 0x79, 0xa3, 0x1c, 0xc7, 0xd2, 0xae, 0xd1, 0xda, 0xcc, 0xbe, 0x7c,
+	0x45, 0x7c, 0xa7, 0x9c, 0xfc, 0xc3, 0x3f, 0x03, 0x00, 0x6d, 0xf6, 0x50, 0xba, 0xa7, 0x02, 0x00,
+	0x00,
 }
 
 func (m *PublishAuthorization) Marshal() (dAtA []byte, err error) {
@@ -197,6 +227,25 @@ func (m *ExecuteAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.RemainingUses != 0 {
+		i = encodeVarintAuthz(dAtA, i, uint64(m.RemainingUses))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.SpendLimit) > 0 {
+		for iNdEx := len(m.SpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	if len(m.Items) > 0 {
 		for iNdEx := len(m.Items) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -252,6 +301,15 @@ func (m *ExecuteAuthorization) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if len(m.SpendLimit) > 0 {
+		for _, e := range m.SpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if m.RemainingUses != 0 {
+		n += 1 + sovAuthz(uint64(m.RemainingUses))
+	}
 	return n
 }
 
@@ -406,6 +464,59 @@ func (m *ExecuteAuthorization) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpendLimit = append(m.SpendLimit, types.Coin{})
+			if err := m.SpendLimit[len(m.SpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemainingUses", wireType)
+			}
+			m.RemainingUses = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RemainingUses |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthz(dAtA[iNdEx:])
@@ -7,7 +7,9 @@ import (
 	"github.com/stretchr/testify/require"
 
 	movetypes "github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
 
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
 	"github.com/cosmos/cosmos-sdk/codec/address"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -136,6 +138,7 @@ func TestAuthzPublishAuthorization(t *testing.T) {
 func TestAuthzExecuteAuthorization(t *testing.T) {
 	app := createApp(t)
 	ctx := app.BaseApp.NewContext(false).WithGasMeter(storetypes.NewInfiniteGasMeter())
+	ctx = movetypes.WithExecuteGrant(ctx, address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix()), sdk.AccAddress("grantee"))
 	sender := "init1vrq4g0vq5ccq9khnnn9s3nzrlpvecaj2ext5an"
 	addr1bech := "init1mz6qgwyu850l6xlnlauspwug9n7xun7g7m3n8m"
 	addr1hex := "0xD8B404389C3D1FFD1BF3FF7900BB882CFC6E4FC8"
@@ -419,3 +422,128 @@ func TestAuthzExecuteAuthorizationDuplicate(t *testing.T) {
 	require.Error(t, execAuth.ValidateBasic())
 
 }
+
+func TestAuthzExecuteAuthorizationConstraints(t *testing.T) {
+	app := createApp(t)
+	ctx := app.BaseApp.NewContext(false).WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	sender := "init1vrq4g0vq5ccq9khnnn9s3nzrlpvecaj2ext5an"
+	addr1bech := "init1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqpqr5e3d"
+	addr1hex := "0x0000000000000000000000000000000000000001"
+	addr2bech := "init1emrdsw8wwj0y4y903qzzaagxqqvgsgns32dkqp"
+
+	ac := address.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	addr2, err := ac.StringToBytes(addr2bech)
+	require.NoError(t, err)
+
+	// the authorization is checked with the address codec of the exec message
+	_, err = movetypes.ExecuteAuthorization{}.Accept(ctx, &movetypes.MsgExecute{Sender: sender})
+	require.Error(t, err)
+
+	grantee := sdk.AccAddress("grantee")
+	ctx = movetypes.WithExecuteGrant(ctx, ac, grantee)
+
+	maxAmount := math.NewInt(100)
+	newAuth := func(item movetypes.ExecuteAuthorizationItem) *movetypes.ExecuteAuthorization {
+		item.ModuleAddress = addr1bech
+		item.ModuleName = "foo"
+		item.FunctionNames = []string{"bar"}
+
+		auth, err := movetypes.NewExecuteAuthorization(ac, []movetypes.ExecuteAuthorizationItem{item})
+		require.NoError(t, err)
+		require.NoError(t, auth.ValidateBasic())
+		return auth
+	}
+	newMsg := func(typeArgs []string, args ...[]byte) *movetypes.MsgExecute {
+		return &movetypes.MsgExecute{
+			Sender:        sender,
+			ModuleAddress: addr1hex,
+			ModuleName:    "foo",
+			FunctionName:  "bar",
+			TypeArgs:      typeArgs,
+			Args:          args,
+		}
+	}
+
+	// type args
+	auth := newAuth(movetypes.ExecuteAuthorizationItem{TypeArgs: []string{"0x1::BasicCoin::Initia"}})
+	_, err = auth.Accept(ctx, newMsg([]string{"0x0000000000000000000000000000000000000001::BasicCoin::Initia"}))
+	require.NoError(t, err)
+	_, err = auth.Accept(ctx, newMsg([]string{"0x1::BasicCoin::Other"}))
+	require.Error(t, err)
+	_, err = auth.Accept(ctx, newMsg(nil))
+	require.Error(t, err)
+
+	// equals
+	auth = newAuth(movetypes.ExecuteAuthorizationItem{ArgConstraints: []movetypes.ArgumentConstraint{{Index: 0, Equals: []byte{1, 2}}}})
+	_, err = auth.Accept(ctx, newMsg(nil, []byte{1, 2}))
+	require.NoError(t, err)
+	_, err = auth.Accept(ctx, newMsg(nil, []byte{1, 3}))
+	require.Error(t, err)
+	_, err = auth.Accept(ctx, newMsg(nil))
+	require.Error(t, err)
+
+	// max amount of u64
+	auth = newAuth(movetypes.ExecuteAuthorizationItem{ArgConstraints: []movetypes.ArgumentConstraint{{Index: 1, MaxAmount: &maxAmount}}})
+	_, err = auth.Accept(ctx, newMsg(nil, []byte{}, []byte{100, 0, 0, 0, 0, 0, 0, 0}))
+	require.NoError(t, err)
+	_, err = auth.Accept(ctx, newMsg(nil, []byte{}, []byte{101, 0, 0, 0, 0, 0, 0, 0}))
+	require.Error(t, err)
+	_, err = auth.Accept(ctx, newMsg(nil, []byte{}, []byte{0, 0, 0}))
+	require.Error(t, err)
+
+	// allowed addresses
+	auth = newAuth(movetypes.ExecuteAuthorizationItem{ArgConstraints: []movetypes.ArgumentConstraint{{Index: 0, AllowedAddresses: []string{addr2bech}}}})
+	_, err = auth.Accept(ctx, newMsg(nil, append(make([]byte, 12), addr2...)))
+	require.NoError(t, err)
+	_, err = auth.Accept(ctx, newMsg(nil, make([]byte, 32)))
+	require.Error(t, err)
+
+	// invalid constraints
+	auth, err = movetypes.NewExecuteAuthorization(ac, []movetypes.ExecuteAuthorizationItem{
+		{ModuleAddress: addr1bech, ModuleName: "foo", FunctionNames: []string{"bar"}, ArgConstraints: []movetypes.ArgumentConstraint{{Index: 0}}},
+	})
+	require.NoError(t, err)
+	require.Error(t, auth.ValidateBasic())
+
+	_, err = movetypes.NewExecuteAuthorization(ac, []movetypes.ExecuteAuthorizationItem{
+		{ModuleAddress: addr1bech, ModuleName: "foo", FunctionNames: []string{"bar"}, ArgConstraints: []movetypes.ArgumentConstraint{{Index: 0, AllowedAddresses: []string{"invalid"}}}},
+	})
+	require.Error(t, err)
+
+	// remaining uses
+	auth = newAuth(movetypes.ExecuteAuthorizationItem{})
+	auth.RemainingUses = 2
+	resp, err := auth.Accept(ctx, newMsg(nil))
+	require.NoError(t, err)
+	require.False(t, resp.Delete)
+	require.Equal(t, uint64(1), resp.Updated.(*movetypes.ExecuteAuthorization).RemainingUses)
+
+	resp, err = resp.Updated.Accept(ctx, newMsg(nil))
+	require.NoError(t, err)
+	require.True(t, resp.Delete)
+
+	// spend limit requires the spend tracker
+	auth = newAuth(movetypes.ExecuteAuthorizationItem{})
+	auth.SpendLimit = sdk.NewCoins(sdk.NewInt64Coin("uinit", 100))
+	_, err = auth.Accept(ctx, newMsg(nil))
+	require.Error(t, err)
+
+	trackerCtx := movetypes.WithExecuteSpendTracker(ctx)
+	resp, err = auth.Accept(trackerCtx, newMsg(nil))
+	require.NoError(t, err)
+	require.True(t, resp.Accept)
+
+	senderAddr, err := movetypes.AccAddressFromString(ac, sender)
+	require.NoError(t, err)
+
+	tracker := movetypes.GetExecuteSpendTracker(trackerCtx)
+	_, found := tracker.Pop(vmtypes.StdAddress)
+	require.False(t, found)
+
+	spend, found := tracker.Pop(senderAddr)
+	require.True(t, found)
+	require.False(t, spend.Deleted)
+	require.Equal(t, grantee, spend.Grantee)
+	require.Equal(t, auth.SpendLimit, spend.Authorization.SpendLimit)
+}
@@ -25,6 +25,15 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgWhitelist{}, "move/MsgWhitelist")
 	legacy.RegisterAminoMsg(cdc, &MsgDelist{}, "move/MsgDelist")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "move/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgSetRevenueRecipient{}, "move/MsgSetRevenueRecipient")
+	legacy.RegisterAminoMsg(cdc, &MsgClaimRevenue{}, "move/MsgClaimRevenue")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateRevenueRatio{}, "move/MsgUpdateRevenueRatio")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterCronJob{}, "move/MsgRegisterCronJob")
+	legacy.RegisterAminoMsg(cdc, &MsgDepositCronJob{}, "move/MsgDepositCronJob")
+	legacy.RegisterAminoMsg(cdc, &MsgDeregisterCronJob{}, "move/MsgDeregisterCronJob")
+	legacy.RegisterAminoMsg(cdc, &MsgSetPublishNamespace{}, "move/MsgSetPublishNamespace")
+	legacy.RegisterAminoMsg(cdc, &MsgSetAuthenticator{}, "move/MsgSetAuthenticator")
+	legacy.RegisterAminoMsg(cdc, &MsgSetSponsor{}, "move/MsgSetSponsor")
 
 	cdc.RegisterConcrete(&ObjectAccount{}, "move/ObjectAccount", nil)
 	cdc.RegisterConcrete(&TableAccount{}, "move/TableAccount", nil)
@@ -49,6 +58,15 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgWhitelist{},
 		&MsgDelist{},
 		&MsgUpdateParams{},
+		&MsgSetRevenueRecipient{},
+		&MsgClaimRevenue{},
+		&MsgUpdateRevenueRatio{},
+		&MsgRegisterCronJob{},
+		&MsgDepositCronJob{},
+		&MsgDeregisterCronJob{},
+		&MsgSetPublishNamespace{},
+		&MsgSetAuthenticator{},
+		&MsgSetSponsor{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
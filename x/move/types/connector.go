@@ -58,6 +58,8 @@ const (
 	FunctionNameDexRegister          = "register"
 	FunctionNameDexSwap              = "swap_script"
 
+	FunctionNameDexSingleAssetProvideLiquidity = "single_asset_provide_liquidity_script"
+
 	// function names for object
 	FunctionNameObjectTransfer = "transfer"
 
@@ -80,6 +82,11 @@ const (
 	ResourceNameCollection    = "Collection"
 	ResourceNameSimpleNft     = "InitiaNft"
 	ResourceNameNft           = "Nft"
+	ResourceNameObjectCore    = "ObjectCore"
+
+	// event type tags
+	EventTypeTagFungibleAssetDeposit  = "0x1::fungible_asset::DepositEvent"
+	EventTypeTagFungibleAssetWithdraw = "0x1::fungible_asset::WithdrawEvent"
 )
 
 // TypeTagFromStructTag return type tag with struct tag
@@ -295,6 +302,18 @@ func ReadBalanceFromFungibleStore(bz []byte) (vmtypes.AccountAddress, math.Int,
 	return metadata, math.NewIntFromUint64(amount), nil
 }
 
+// ReadOwnerFromObjectCore util function to read owner from ObjectCore
+func ReadOwnerFromObjectCore(bz []byte) (vmtypes.AccountAddress, error) {
+	if len(bz) < AddressBytesLength {
+		return vmtypes.AccountAddress{}, errors.Wrapf(ErrInvalidRequest, "invalid object core length: %d", len(bz))
+	}
+
+	cursor := int(0)
+
+	// read owner
+	return vmtypes.NewAccountAddressFromBytes(bz[cursor : cursor+AddressBytesLength])
+}
+
 // ReadIssuersTableHandleFromModuleStore util function to read issuers table handle from primary_fungible_store::ModuleStore
 func ReadIssuersTableHandleFromModuleStore(bz []byte) (vmtypes.AccountAddress, error) {
 	cursor := int(0)
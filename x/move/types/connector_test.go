@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
 )
 
 func Test_GetDexWeight(t *testing.T) {
@@ -42,3 +43,16 @@ func Test_DeserializeUint128(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, strings.TrimLeft("00010203040506070809101112131415", "0"), num.BigInt().Text(16))
 }
+
+func Test_ReadOwnerFromObjectCore(t *testing.T) {
+	owner, err := types.ReadOwnerFromObjectCore(append(vmtypes.TestAddress.Bytes(), 0, 0, 0, 0, 0, 0, 0, 0, 1))
+	require.NoError(t, err)
+	require.Equal(t, vmtypes.TestAddress, owner)
+
+	// short or corrupt data
+	_, err = types.ReadOwnerFromObjectCore(vmtypes.TestAddress.Bytes()[:types.AddressBytesLength-1])
+	require.Error(t, err)
+
+	_, err = types.ReadOwnerFromObjectCore(nil)
+	require.Error(t, err)
+}
@@ -0,0 +1,304 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// move binary format constants
+var moduleMagic = []byte{0xA1, 0x1C, 0xEB, 0x0B}
+
+const (
+	tableTypeModuleHandles      = byte(0x1)
+	tableTypeStructHandles      = byte(0x2)
+	tableTypeFunctionHandles    = byte(0x3)
+	tableTypeIdentifiers        = byte(0x7)
+	tableTypeAddressIdentifiers = byte(0x8)
+)
+
+type moduleTable struct {
+	offset uint64
+	length uint64
+}
+
+// compiledModule is the module handles and the tables of the compiled
+// module bytes, which are read without the module verification.
+type compiledModule struct {
+	tableContent func(kind byte) ([]byte, error)
+	identifiers  []string
+	moduleIds    []vmtypes.ModuleId
+}
+
+func readCompiledModule(code []byte) (*compiledModule, error) {
+	if !bytes.HasPrefix(code, moduleMagic) || len(code) < len(moduleMagic)+4 {
+		return nil, errors.New("invalid module bytes; bad magic")
+	}
+
+	// skip magic and version
+	r := bytes.NewReader(code[len(moduleMagic)+4:])
+	tableCount, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+
+	tables := make(map[byte]moduleTable)
+	for i := uint64(0); i < tableCount; i++ {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+
+		offset, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return nil, err
+		}
+
+		tables[kind] = moduleTable{offset, length}
+	}
+
+	// the table offsets are relative to the end of the table headers
+	content := code[len(code)-r.Len():]
+	tableContent := func(kind byte) ([]byte, error) {
+		table, ok := tables[kind]
+		if !ok {
+			return nil, nil
+		}
+
+		if table.offset+table.length > uint64(len(content)) {
+			return nil, errors.New("invalid module bytes; table out of range")
+		}
+
+		return content[table.offset : table.offset+table.length], nil
+	}
+
+	identifiers, err := readModuleTable(tableContent, tableTypeIdentifiers, func(r *bytes.Reader) (string, error) {
+		length, err := binary.ReadUvarint(r)
+		if err != nil {
+			return "", err
+		}
+
+		bz := make([]byte, length)
+		if _, err := io.ReadFull(r, bz); err != nil {
+			return "", err
+		}
+
+		return string(bz), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	addresses, err := readModuleTable(tableContent, tableTypeAddressIdentifiers, func(r *bytes.Reader) (vmtypes.AccountAddress, error) {
+		var addr vmtypes.AccountAddress
+		if _, err := io.ReadFull(r, addr[:]); err != nil {
+			return addr, err
+		}
+
+		return addr, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	moduleIds, err := readModuleTable(tableContent, tableTypeModuleHandles, func(r *bytes.Reader) (vmtypes.ModuleId, error) {
+		addrIdx, err := binary.ReadUvarint(r)
+		if err != nil {
+			return vmtypes.ModuleId{}, err
+		}
+
+		nameIdx, err := binary.ReadUvarint(r)
+		if err != nil {
+			return vmtypes.ModuleId{}, err
+		}
+
+		if addrIdx >= uint64(len(addresses)) || nameIdx >= uint64(len(identifiers)) {
+			return vmtypes.ModuleId{}, errors.New("invalid module bytes; module handle out of range")
+		}
+
+		return vmtypes.NewModuleId(addresses[addrIdx], identifiers[nameIdx]), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &compiledModule{
+		tableContent: tableContent,
+		identifiers:  identifiers,
+		moduleIds:    moduleIds,
+	}, nil
+}
+
+// handleName reads the module handle index and the name index of a struct or
+// function handle, and returns the name if the handle belongs to the target.
+func (m *compiledModule) handleName(r *bytes.Reader, target vmtypes.ModuleId) (string, bool, error) {
+	moduleIdx, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", false, err
+	}
+
+	nameIdx, err := binary.ReadUvarint(r)
+	if err != nil {
+		return "", false, err
+	}
+
+	if moduleIdx >= uint64(len(m.moduleIds)) || nameIdx >= uint64(len(m.identifiers)) {
+		return "", false, errors.New("invalid module bytes; handle out of range")
+	}
+
+	moduleId := m.moduleIds[moduleIdx]
+	return m.identifiers[nameIdx], moduleId.Address.Equals(target.Address) && moduleId.Name == target.Name, nil
+}
+
+// ReadModuleDependencies reads the module handles from the compiled module
+// bytes, and returns the modules imported by the module except itself.
+func ReadModuleDependencies(code []byte, self vmtypes.ModuleId) ([]vmtypes.ModuleId, error) {
+	module, err := readCompiledModule(code)
+	if err != nil {
+		return nil, err
+	}
+
+	dependencies := make([]vmtypes.ModuleId, 0, len(module.moduleIds))
+	for _, moduleId := range module.moduleIds {
+		if moduleId.Address.Equals(self.Address) && moduleId.Name == self.Name {
+			continue
+		}
+
+		dependencies = append(dependencies, moduleId)
+	}
+
+	return dependencies, nil
+}
+
+// ReadModuleReferences reads the struct and function handles from the
+// compiled module bytes, and returns the names of the structs and the
+// functions of the target module which are used by the module.
+func ReadModuleReferences(code []byte, target vmtypes.ModuleId) (structs []string, functions []string, err error) {
+	module, err := readCompiledModule(code)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	structHandles, err := readModuleTable(module.tableContent, tableTypeStructHandles, func(r *bytes.Reader) (string, error) {
+		name, found, err := module.handleName(r, target)
+		if err != nil {
+			return "", err
+		}
+
+		// abilities
+		if _, err := r.ReadByte(); err != nil {
+			return "", err
+		}
+
+		// type parameters; the constraints and the phantom flag
+		typeParamCount, err := binary.ReadUvarint(r)
+		if err != nil {
+			return "", err
+		}
+		if _, err := r.Seek(int64(typeParamCount*2), io.SeekCurrent); err != nil {
+			return "", err
+		}
+
+		if !found {
+			return "", nil
+		}
+
+		return name, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	functionHandles, err := readModuleTable(module.tableContent, tableTypeFunctionHandles, func(r *bytes.Reader) (string, error) {
+		name, found, err := module.handleName(r, target)
+		if err != nil {
+			return "", err
+		}
+
+		// parameters and return signature indexes
+		for i := 0; i < 2; i++ {
+			if _, err := binary.ReadUvarint(r); err != nil {
+				return "", err
+			}
+		}
+
+		// type parameters; the constraints
+		typeParamCount, err := binary.ReadUvarint(r)
+		if err != nil {
+			return "", err
+		}
+		if _, err := r.Seek(int64(typeParamCount), io.SeekCurrent); err != nil {
+			return "", err
+		}
+
+		if !found {
+			return "", nil
+		}
+
+		return name, nil
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	for _, name := range structHandles {
+		if name != "" {
+			structs = append(structs, name)
+		}
+	}
+
+	for _, name := range functionHandles {
+		if name != "" {
+			functions = append(functions, name)
+		}
+	}
+
+	return structs, functions, nil
+}
+
+func readModuleTable[T any](
+	tableContent func(kind byte) ([]byte, error),
+	kind byte,
+	readEntry func(r *bytes.Reader) (T, error),
+) ([]T, error) {
+	bz, err := tableContent(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []T
+	r := bytes.NewReader(bz)
+	for r.Len() > 0 {
+		entry, err := readEntry(r)
+		if err != nil {
+			return nil, err
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// ParseModuleIdentifier parses the module id string, `<address>::<module name>`.
+func ParseModuleIdentifier(moduleId string) (ModuleIdentifier, error) {
+	idx := strings.LastIndex(moduleId, "::")
+	if idx == -1 {
+		return ModuleIdentifier{}, fmt.Errorf("invalid module id: %s", moduleId)
+	}
+
+	return ModuleIdentifier{
+		Address:    moduleId[:idx],
+		ModuleName: moduleId[idx+2:],
+	}, nil
+}
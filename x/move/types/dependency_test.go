@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_ReadModuleDependencies(t *testing.T) {
+	code, err := os.ReadFile("../keeper/binaries/BasicCoin.mv")
+	require.NoError(t, err)
+
+	moduleAddr, moduleName, err := vmapi.ReadModuleInfo(code)
+	require.NoError(t, err)
+
+	dependencies, err := types.ReadModuleDependencies(code, vmtypes.NewModuleId(moduleAddr, moduleName))
+	require.NoError(t, err)
+
+	depStrs := make([]string, len(dependencies))
+	for i, dependency := range dependencies {
+		depStrs[i] = dependency.String()
+	}
+	require.Equal(t, []string{"0x1::event", "0x1::signer", "0x1::string", "0x1::type_info"}, depStrs)
+
+	_, err = types.ReadModuleDependencies([]byte{0x1, 0x2, 0x3}, vmtypes.ModuleId{})
+	require.Error(t, err)
+}
+
+func Test_ReadModuleReferences(t *testing.T) {
+	code, err := os.ReadFile("../keeper/binaries/BasicCoin.mv")
+	require.NoError(t, err)
+
+	structs, functions, err := types.ReadModuleReferences(code, vmtypes.NewModuleId(vmtypes.StdAddress, "string"))
+	require.NoError(t, err)
+	require.Equal(t, []string{"String"}, structs)
+	require.Equal(t, []string{"utf8"}, functions)
+
+	structs, functions, err = types.ReadModuleReferences(code, vmtypes.NewModuleId(vmtypes.StdAddress, "event"))
+	require.NoError(t, err)
+	require.Empty(t, structs)
+	require.Equal(t, []string{"emit"}, functions)
+
+	_, _, err = types.ReadModuleReferences([]byte{0x1, 0x2, 0x3}, vmtypes.ModuleId{})
+	require.Error(t, err)
+}
@@ -44,4 +44,28 @@ var (
 
 	// ErrNotSupportedStargateQuery error raised when the stargate request is not supported or accepted
 	ErrNotSupportedStargateQuery = errorsmod.Register(ModuleName, 14, "not supported stargate query")
+
+	// ErrProfileDisabled error raised when the gas profile query is disabled by the node config
+	ErrProfileDisabled = errorsmod.Register(ModuleName, 15, "gas profile is disabled")
+
+	// ErrModuleNotFound error raised when the target module is not published
+	ErrModuleNotFound = errorsmod.Register(ModuleName, 16, "module not found")
+
+	// ErrCronJobNotFound error raised when the cron job is not registered
+	ErrCronJobNotFound = errorsmod.Register(ModuleName, 17, "cron job not found")
+
+	// ErrResourceIndexDisabled error raised when the resource index is disabled by the node config
+	ErrResourceIndexDisabled = errorsmod.Register(ModuleName, 18, "resource index is disabled")
+
+	// ErrInvalidAuthenticator error for the invalid account authenticator
+	ErrInvalidAuthenticator = errorsmod.Register(ModuleName, 19, "invalid authenticator")
+
+	// ErrInvalidSponsor error for the invalid fee sponsor policy
+	ErrInvalidSponsor = errorsmod.Register(ModuleName, 20, "invalid sponsor")
+
+	// ErrEventStreamDisabled error raised when the event stream is disabled by the node config
+	ErrEventStreamDisabled = errorsmod.Register(ModuleName, 21, "event stream is disabled")
+
+	// ErrInvalidConversion error for the coin conversion which is not supported by the dex
+	ErrInvalidConversion = errorsmod.Register(ModuleName, 22, "invalid conversion")
 )
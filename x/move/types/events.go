@@ -9,6 +9,14 @@ const (
 	EventTypeMove                  = "move"
 	EventTypeUpgradePolicy         = "upgrade_policy"
 	EventTypeContractSharedRevenue = "contract_shared_revenue"
+	EventTypeClaimRevenue          = "claim_revenue"
+	EventTypeSetRevenueRecipient   = "set_revenue_recipient"
+	EventTypeUpdateRevenueRatio    = "update_revenue_ratio"
+	EventTypeRegisterCronJob       = "register_cron_job"
+	EventTypeDeregisterCronJob     = "deregister_cron_job"
+	EventTypeCronJobRun            = "cron_job_run"
+	EventTypePublishDeposit        = "publish_deposit"
+	EventTypeRefundPublishDeposit  = "refund_publish_deposit"
 
 	AttributeKeySender       = "sender"
 	AttributeKeyModuleAddr   = "module_addr"
@@ -16,11 +24,29 @@ const (
 	AttributeKeyFunctionName = "function_name"
 	AttributeKeyCreator      = "creator"
 	AttributeKeyRevenue      = "revenue"
+	AttributeKeyRecipient    = "recipient"
+	AttributeKeyRatio        = "ratio"
+	AttributeKeyAmount       = "amount"
 
 	// move type event attributes
 	AttributeKeyTypeTag = "type_tag"
 	AttributeKeyData    = "data"
 
+	// cron job attributes
+	AttributeKeyCronJobId = "cron_job_id"
+	AttributeKeySuccess   = "success"
+	AttributeKeyGasUsed   = "gas_used"
+	AttributeKeyFee       = "fee"
+	AttributeKeyError     = "error"
+	AttributeKeyReason    = "reason"
+	AttributeKeyRefund    = "refund"
+
+	// cron job deregistration reasons
+	AttributeValueReasonDeregistered        = "deregistered"
+	AttributeValueReasonInsufficientDeposit = "insufficient_deposit"
+	AttributeValueReasonMaxFailures         = "max_failures"
+	AttributeValueReasonGasLimitExceeded    = "gas_limit_exceeded"
+
 	// upgrade policy attributes
 	AttributeKeyOriginPolicy = "origin_policy"
 	AttributeKeyNewPolicy    = "new_policy"
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
 
 	distrtypes "github.com/initia-labs/initia/x/distribution/types"
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
@@ -29,6 +30,14 @@ type AccountKeeper interface {
 	NextAccountNumber(ctx context.Context) uint64
 }
 
+// AuthzKeeper is expected keeper for authz module, which is used to
+// update the spend limit of the execute authorization
+type AuthzKeeper interface {
+	GetAuthorization(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) (authz.Authorization, *time.Time)
+	SaveGrant(ctx context.Context, grantee, granter sdk.AccAddress, authorization authz.Authorization, expiration *time.Time) error
+	DeleteGrant(ctx context.Context, grantee, granter sdk.AccAddress, msgType string) error
+}
+
 // BankViewKeeper defines a subset of methods implemented by the cosmos-sdk bank keeper
 type BankViewKeeper interface {
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
@@ -4,6 +4,10 @@ import (
 	context "context"
 
 	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vmtypes "github.com/initia-labs/movevm/types"
 )
 
 type AnteKeeper interface {
@@ -12,3 +16,16 @@ type AnteKeeper interface {
 	BaseDenom(ctx context.Context) (string, error)
 	BaseMinGasPrice(ctx context.Context) (math.LegacyDec, error)
 }
+
+// AuthenticatorKeeper is the keeper of the account authenticators used by the ante handler.
+type AuthenticatorKeeper interface {
+	GetAuthenticator(ctx context.Context, addr vmtypes.AccountAddress) (Authenticator, bool, error)
+	Authenticate(ctx context.Context, authenticator Authenticator, signBytes, signature []byte) error
+	AuthenticatorGasLimit(ctx context.Context) (uint64, error)
+}
+
+// SponsorKeeper is the keeper of the fee sponsor policies used by the ante handler.
+type SponsorKeeper interface {
+	GetSponsor(ctx context.Context, addr vmtypes.AccountAddress) (Sponsor, bool, error)
+	CheckSponsorPolicy(ctx context.Context, sponsor Sponsor, payer vmtypes.AccountAddress, fee sdk.Coins, msgs []sdk.Msg) error
+}
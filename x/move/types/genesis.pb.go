@@ -5,6 +5,8 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -26,14 +28,28 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // GenesisState - genesis state of x/move
 type GenesisState struct {
-	Params           Params       `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
-	ExecutionCounter uint64       `protobuf:"varint,2,opt,name=execution_counter,json=executionCounter,proto3" json:"execution_counter,omitempty" yaml:"execution_counter"`
-	Stdlibs          [][]byte     `protobuf:"bytes,3,rep,name=stdlibs,proto3" json:"stdlibs,omitempty"`
-	Modules          []Module     `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
-	Resources        []Resource   `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
-	TableInfos       []TableInfo  `protobuf:"bytes,6,rep,name=table_infos,json=tableInfos,proto3" json:"table_infos,omitempty"`
-	TableEntries     []TableEntry `protobuf:"bytes,7,rep,name=table_entries,json=tableEntries,proto3" json:"table_entries,omitempty"`
-	DexPairs         []DexPair    `protobuf:"bytes,8,rep,name=dex_pairs,json=dexPairs,proto3" json:"dex_pairs,omitempty"`
+	Params                        Params                                      `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	ExecutionCounter              uint64                                      `protobuf:"varint,2,opt,name=execution_counter,json=executionCounter,proto3" json:"execution_counter,omitempty" yaml:"execution_counter"`
+	Stdlibs                       [][]byte                                    `protobuf:"bytes,3,rep,name=stdlibs,proto3" json:"stdlibs,omitempty"`
+	Modules                       []Module                                    `protobuf:"bytes,4,rep,name=modules,proto3" json:"modules,omitempty"`
+	Resources                     []Resource                                  `protobuf:"bytes,5,rep,name=resources,proto3" json:"resources,omitempty"`
+	TableInfos                    []TableInfo                                 `protobuf:"bytes,6,rep,name=table_infos,json=tableInfos,proto3" json:"table_infos,omitempty"`
+	TableEntries                  []TableEntry                                `protobuf:"bytes,7,rep,name=table_entries,json=tableEntries,proto3" json:"table_entries,omitempty"`
+	DexPairs                      []DexPair                                   `protobuf:"bytes,8,rep,name=dex_pairs,json=dexPairs,proto3" json:"dex_pairs,omitempty"`
+	RevenueRecipients             []ModuleRevenueRecipient                    `protobuf:"bytes,9,rep,name=revenue_recipients,json=revenueRecipients,proto3" json:"revenue_recipients,omitempty"`
+	RevenueRatios                 []ModuleRevenueRatio                        `protobuf:"bytes,10,rep,name=revenue_ratios,json=revenueRatios,proto3" json:"revenue_ratios,omitempty"`
+	ModuleRevenues                []ModuleRevenue                             `protobuf:"bytes,11,rep,name=module_revenues,json=moduleRevenues,proto3" json:"module_revenues,omitempty"`
+	ClaimableRevenues             []ClaimableRevenue                          `protobuf:"bytes,12,rep,name=claimable_revenues,json=claimableRevenues,proto3" json:"claimable_revenues,omitempty"`
+	CronJobs                      []CronJob                                   `protobuf:"bytes,13,rep,name=cron_jobs,json=cronJobs,proto3" json:"cron_jobs,omitempty"`
+	CronRuns                      []CronRun                                   `protobuf:"bytes,14,rep,name=cron_runs,json=cronRuns,proto3" json:"cron_runs,omitempty"`
+	CronJobSequence               uint64                                      `protobuf:"varint,15,opt,name=cron_job_sequence,json=cronJobSequence,proto3" json:"cron_job_sequence,omitempty" yaml:"cron_job_sequence"`
+	PublishNamespaces             []PublishNamespace                          `protobuf:"bytes,16,rep,name=publish_namespaces,json=publishNamespaces,proto3" json:"publish_namespaces,omitempty"`
+	PublishUsages                 []PublishUsage                              `protobuf:"bytes,17,rep,name=publish_usages,json=publishUsages,proto3" json:"publish_usages,omitempty"`
+	PublishDeposits               []PublishDeposit                            `protobuf:"bytes,18,rep,name=publish_deposits,json=publishDeposits,proto3" json:"publish_deposits,omitempty"`
+	Authenticators                []Authenticator                             `protobuf:"bytes,19,rep,name=authenticators,proto3" json:"authenticators,omitempty"`
+	Sponsors                      []Sponsor                                   `protobuf:"bytes,20,rep,name=sponsors,proto3" json:"sponsors,omitempty"`
+	VotingPowerWeightObservations []VotingPowerWeightObservation              `protobuf:"bytes,21,rep,name=voting_power_weight_observations,json=votingPowerWeightObservations,proto3" json:"voting_power_weight_observations,omitempty"`
+	VotingPowerWeights            github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,22,rep,name=voting_power_weights,json=votingPowerWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"voting_power_weights,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -125,6 +141,104 @@ func (m *GenesisState) GetDexPairs() []DexPair {
 	return nil
 }
 
+func (m *GenesisState) GetRevenueRecipients() []ModuleRevenueRecipient {
+	if m != nil {
+		return m.RevenueRecipients
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRevenueRatios() []ModuleRevenueRatio {
+	if m != nil {
+		return m.RevenueRatios
+	}
+	return nil
+}
+
+func (m *GenesisState) GetModuleRevenues() []ModuleRevenue {
+	if m != nil {
+		return m.ModuleRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetClaimableRevenues() []ClaimableRevenue {
+	if m != nil {
+		return m.ClaimableRevenues
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronJobs() []CronJob {
+	if m != nil {
+		return m.CronJobs
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronRuns() []CronRun {
+	if m != nil {
+		return m.CronRuns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCronJobSequence() uint64 {
+	if m != nil {
+		return m.CronJobSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetPublishNamespaces() []PublishNamespace {
+	if m != nil {
+		return m.PublishNamespaces
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPublishUsages() []PublishUsage {
+	if m != nil {
+		return m.PublishUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPublishDeposits() []PublishDeposit {
+	if m != nil {
+		return m.PublishDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAuthenticators() []Authenticator {
+	if m != nil {
+		return m.Authenticators
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSponsors() []Sponsor {
+	if m != nil {
+		return m.Sponsors
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingPowerWeightObservations() []VotingPowerWeightObservation {
+	if m != nil {
+		return m.VotingPowerWeightObservations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetVotingPowerWeights() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.VotingPowerWeights
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "initia.move.v1.GenesisState")
 }
@@ -132,37 +246,68 @@ func init() {
 func init() { proto.RegisterFile("initia/move/v1/genesis.proto", fileDescriptor_40442fa21a3f8cef) }
 
 var fileDescriptor_40442fa21a3f8cef = []byte{
-	// 474 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xc1, 0x6e, 0xd3, 0x40,
-	0x10, 0x86, 0x63, 0xd2, 0x26, 0xcd, 0x36, 0x20, 0x62, 0x28, 0x5d, 0xa2, 0xca, 0xb1, 0x22, 0x0e,
-	0x51, 0x05, 0xb6, 0x0a, 0x27, 0x38, 0x9a, 0x22, 0xd4, 0x03, 0x52, 0x14, 0x10, 0x42, 0x1c, 0x88,
-	0xd6, 0xc9, 0x34, 0xac, 0x64, 0xef, 0x5a, 0xbb, 0xeb, 0x28, 0x79, 0x8b, 0x3e, 0x06, 0x47, 0x1e,
-	0xa3, 0xc7, 0x1e, 0x39, 0x45, 0x28, 0x39, 0x20, 0x71, 0xe4, 0x09, 0x90, 0x77, 0x37, 0x09, 0x35,
-	0xb9, 0x58, 0xa3, 0x99, 0xff, 0xff, 0xfe, 0xf1, 0x6a, 0xd0, 0x09, 0x65, 0x54, 0x51, 0x12, 0xa6,
-	0x7c, 0x0a, 0xe1, 0xf4, 0x2c, 0x9c, 0x00, 0x03, 0x49, 0x65, 0x90, 0x09, 0xae, 0xb8, 0x7b, 0xcf,
-	0x4c, 0x83, 0x62, 0x1a, 0x4c, 0xcf, 0xda, 0x2d, 0x92, 0x52, 0xc6, 0x43, 0xfd, 0x35, 0x92, 0xf6,
-	0xc3, 0x09, 0x9f, 0x70, 0x5d, 0x86, 0x45, 0x65, 0xbb, 0xed, 0x12, 0x56, 0xcd, 0x33, 0xb0, 0xd0,
-	0xee, 0xd5, 0x3e, 0x6a, 0xbe, 0x35, 0x31, 0xef, 0x15, 0x51, 0xe0, 0xbe, 0x44, 0xb5, 0x8c, 0x08,
-	0x92, 0x4a, 0xec, 0xf8, 0x4e, 0xef, 0xf0, 0xf9, 0xa3, 0xe0, 0x76, 0x6c, 0xd0, 0xd7, 0xd3, 0xa8,
-	0x71, 0xbd, 0xe8, 0x54, 0xbe, 0xfd, 0xfa, 0x7e, 0xea, 0x0c, 0xac, 0xc1, 0xbd, 0x40, 0x2d, 0x98,
-	0xc1, 0x28, 0x57, 0x94, 0xb3, 0xe1, 0x88, 0xe7, 0x4c, 0x81, 0xc0, 0x77, 0x7c, 0xa7, 0xb7, 0x17,
-	0x9d, 0xfc, 0x59, 0x74, 0xf0, 0x9c, 0xa4, 0xc9, 0xab, 0xee, 0x7f, 0x92, 0xee, 0xe0, 0xfe, 0xa6,
-	0xf7, 0xda, 0xb4, 0xdc, 0x10, 0xd5, 0xa5, 0x1a, 0x27, 0x34, 0x96, 0xb8, 0xea, 0x57, 0x7b, 0xcd,
-	0xe8, 0xe8, 0xf7, 0xa2, 0xd3, 0xb2, 0xad, 0xa7, 0x3c, 0xa5, 0x0a, 0xd2, 0x4c, 0xcd, 0x07, 0x6b,
-	0x95, 0xdb, 0x47, 0xf5, 0x94, 0x8f, 0xf3, 0x04, 0x24, 0xde, 0xf3, 0xab, 0xbb, 0xf6, 0x7e, 0xa7,
-	0xc7, 0x91, 0x57, 0xec, 0x5d, 0xc0, 0xac, 0x7c, 0x0b, 0x33, 0x3f, 0xb3, 0xc6, 0xb8, 0x9f, 0x50,
-	0x43, 0x80, 0xe4, 0xb9, 0x18, 0x81, 0xc4, 0xfb, 0x9a, 0x89, 0xcb, 0xcc, 0x81, 0x15, 0x44, 0xbe,
-	0xa5, 0x3e, 0xd8, 0x58, 0xca, 0xdc, 0x2d, 0xcc, 0xfd, 0x82, 0x0e, 0x15, 0x89, 0x13, 0x18, 0x52,
-	0x76, 0xc9, 0x25, 0xae, 0x69, 0xf6, 0xe3, 0x32, 0xfb, 0x43, 0x21, 0xb9, 0x60, 0x97, 0x3c, 0xea,
-	0x5a, 0xf8, 0xd1, 0x3f, 0xae, 0x32, 0x1e, 0xa9, 0xb5, 0x5c, 0xba, 0x80, 0xee, 0x1a, 0x25, 0x30,
-	0x25, 0x28, 0x48, 0x5c, 0xd7, 0x09, 0xed, 0x9d, 0x09, 0x6f, 0x98, 0x12, 0xf3, 0xe8, 0x89, 0x8d,
-	0x38, 0xbe, 0x65, 0x2c, 0x87, 0x34, 0xd5, 0xda, 0x41, 0x41, 0xba, 0x1f, 0x51, 0x63, 0x0c, 0xb3,
-	0x61, 0x46, 0xa8, 0x90, 0xf8, 0x40, 0x47, 0x1c, 0x97, 0x23, 0xce, 0x61, 0xd6, 0x27, 0x54, 0x6c,
-	0xdf, 0x67, 0xe3, 0x28, 0xb3, 0x0f, 0xc6, 0x46, 0x2a, 0xa3, 0xf3, 0xeb, 0xa5, 0xe7, 0xdc, 0x2c,
-	0x3d, 0xe7, 0xe7, 0xd2, 0x73, 0xae, 0x56, 0x5e, 0xe5, 0x66, 0xe5, 0x55, 0x7e, 0xac, 0xbc, 0xca,
-	0xe7, 0xd3, 0x09, 0x55, 0x5f, 0xf3, 0x38, 0x18, 0xf1, 0x34, 0x34, 0x41, 0xcf, 0x12, 0x12, 0x4b,
-	0x5b, 0x87, 0x33, 0x73, 0xe1, 0xfa, 0xbc, 0xe3, 0x9a, 0xbe, 0xef, 0x17, 0x7f, 0x03, 0x00, 0x00,
-	0xff, 0xff, 0xc9, 0xe3, 0x77, 0xbd, 0x54, 0x03, 0x00, 0x00,
+	// 964 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x96, 0x4f, 0x6f, 0x1b, 0x45,
+	0x18, 0xc6, 0x63, 0x52, 0xf2, 0x67, 0x92, 0x38, 0xf1, 0x34, 0x69, 0xa7, 0x51, 0xea, 0x58, 0x16,
+	0x94, 0x10, 0x5a, 0x5b, 0x81, 0x03, 0x82, 0x1b, 0x9b, 0x20, 0x28, 0x12, 0x60, 0x39, 0xa5, 0xa0,
+	0x1e, 0x58, 0xcd, 0xae, 0xa7, 0xce, 0x14, 0xef, 0xcc, 0x32, 0xef, 0xac, 0x9b, 0x70, 0xe2, 0x23,
+	0xf0, 0x31, 0x50, 0x0f, 0x88, 0x8f, 0xd1, 0x63, 0x8f, 0x9c, 0x02, 0x4a, 0x0e, 0x08, 0x8e, 0x7c,
+	0x02, 0xb4, 0xb3, 0xb3, 0x5e, 0x7b, 0x76, 0x93, 0x5e, 0x92, 0xd5, 0xbc, 0xcf, 0xf3, 0xfc, 0x5e,
+	0xbf, 0x7e, 0xbd, 0x1a, 0xb4, 0xc3, 0x05, 0xd7, 0x9c, 0x76, 0x23, 0x39, 0x66, 0xdd, 0xf1, 0x41,
+	0x77, 0xc8, 0x04, 0x03, 0x0e, 0x9d, 0x58, 0x49, 0x2d, 0x71, 0x3d, 0xab, 0x76, 0xd2, 0x6a, 0x67,
+	0x7c, 0xb0, 0xdd, 0xa0, 0x11, 0x17, 0xb2, 0x6b, 0xfe, 0x66, 0x92, 0xed, 0x66, 0x28, 0x21, 0x92,
+	0xd0, 0x0d, 0x28, 0xa4, 0x01, 0x01, 0xd3, 0xf4, 0xa0, 0x1b, 0x4a, 0x2e, 0x6c, 0x7d, 0x73, 0x28,
+	0x87, 0xd2, 0x3c, 0x76, 0xd3, 0x27, 0x7b, 0xba, 0xed, 0x60, 0xf5, 0x59, 0xcc, 0x2c, 0xb4, 0xfd,
+	0x4f, 0x03, 0xad, 0x7e, 0x96, 0xb5, 0x71, 0xac, 0xa9, 0x66, 0xf8, 0x23, 0xb4, 0x10, 0x53, 0x45,
+	0x23, 0x20, 0xb5, 0x56, 0x6d, 0x6f, 0xe5, 0xfd, 0x5b, 0x9d, 0xd9, 0xb6, 0x3a, 0x3d, 0x53, 0xf5,
+	0x96, 0x5f, 0x9e, 0xef, 0xce, 0xfd, 0xfa, 0xf7, 0xef, 0xfb, 0xb5, 0xbe, 0x35, 0xe0, 0x87, 0xa8,
+	0xc1, 0x4e, 0x59, 0x98, 0x68, 0x2e, 0x85, 0x1f, 0xca, 0x44, 0x68, 0xa6, 0xc8, 0x1b, 0xad, 0xda,
+	0xde, 0x0d, 0x6f, 0xe7, 0xbf, 0xf3, 0x5d, 0x72, 0x46, 0xa3, 0xd1, 0xc7, 0xed, 0x92, 0xa4, 0xdd,
+	0xdf, 0x98, 0x9c, 0x1d, 0x66, 0x47, 0xb8, 0x8b, 0x16, 0x41, 0x0f, 0x46, 0x3c, 0x00, 0x32, 0xdf,
+	0x9a, 0xdf, 0x5b, 0xf5, 0xb6, 0xfe, 0x3d, 0xdf, 0x6d, 0xd8, 0xa3, 0xfb, 0x32, 0xe2, 0x9a, 0x45,
+	0xb1, 0x3e, 0xeb, 0xe7, 0x2a, 0xdc, 0x43, 0x8b, 0x91, 0x1c, 0x24, 0x23, 0x06, 0xe4, 0x46, 0x6b,
+	0xbe, 0xaa, 0xef, 0x2f, 0x4d, 0xd9, 0x6b, 0xa6, 0x7d, 0xa7, 0x61, 0x56, 0x5e, 0x84, 0x65, 0x1f,
+	0x26, 0x8f, 0xc1, 0xdf, 0xa1, 0x65, 0xc5, 0x40, 0x26, 0x2a, 0x64, 0x40, 0xde, 0x34, 0x99, 0xc4,
+	0xcd, 0xec, 0x5b, 0x81, 0xd7, 0xb2, 0xa9, 0x37, 0x27, 0x16, 0x37, 0xb7, 0x08, 0xc3, 0xdf, 0xa3,
+	0x15, 0x4d, 0x83, 0x11, 0xf3, 0xb9, 0x78, 0x2a, 0x81, 0x2c, 0x98, 0xec, 0x3b, 0x6e, 0xf6, 0xa3,
+	0x54, 0xf2, 0x50, 0x3c, 0x95, 0x5e, 0xdb, 0x86, 0x6f, 0x4d, 0xb9, 0xdc, 0x78, 0xa4, 0x73, 0x39,
+	0x60, 0x86, 0xd6, 0x32, 0x25, 0x13, 0x5a, 0x71, 0x06, 0x64, 0xd1, 0x10, 0xb6, 0x2b, 0x09, 0x9f,
+	0x0a, 0xad, 0xce, 0xbc, 0xb7, 0x2c, 0xe2, 0xf6, 0x8c, 0xd1, 0x85, 0xac, 0xea, 0xdc, 0xc1, 0x19,
+	0xe0, 0xc7, 0x68, 0x79, 0xc0, 0x4e, 0xfd, 0x98, 0x72, 0x05, 0x64, 0xc9, 0x20, 0x6e, 0xbb, 0x88,
+	0x23, 0x76, 0xda, 0xa3, 0x5c, 0x15, 0xf3, 0x99, 0x38, 0xdc, 0xec, 0xa5, 0x41, 0x26, 0x05, 0xfc,
+	0x73, 0x0d, 0x61, 0xc5, 0xc6, 0x4c, 0x24, 0xcc, 0x57, 0x2c, 0xe4, 0x31, 0x67, 0x42, 0x03, 0x59,
+	0x36, 0x84, 0x7b, 0xd5, 0x5f, 0x6b, 0x3f, 0xd3, 0xf7, 0x73, 0xb9, 0xf7, 0xae, 0x05, 0xee, 0x94,
+	0x93, 0x5c, 0x72, 0x43, 0x39, 0x66, 0xc0, 0x31, 0xaa, 0x4f, 0x7c, 0x54, 0x73, 0x09, 0x04, 0x19,
+	0x7a, 0xfb, 0x7a, 0x7a, 0x2a, 0xf5, 0xde, 0xb6, 0x64, 0x32, 0x9b, 0xe0, 0x52, 0xd7, 0xd4, 0x94,
+	0x09, 0xb0, 0x40, 0xeb, 0xd9, 0xe2, 0xf9, 0xf6, 0x1c, 0xc8, 0x8a, 0x41, 0xde, 0xbd, 0x16, 0xe9,
+	0xdd, 0xb3, 0xb4, 0x3b, 0x8e, 0xdb, 0xc5, 0xd5, 0xa3, 0x69, 0x1b, 0xe0, 0x9f, 0x10, 0x0e, 0x47,
+	0x94, 0x47, 0x34, 0x98, 0x32, 0x91, 0x55, 0x83, 0x6c, 0xb9, 0xc8, 0xc3, 0x5c, 0x99, 0x53, 0x27,
+	0xd3, 0x2d, 0x67, 0x94, 0xa6, 0x1b, 0x3a, 0x66, 0xb3, 0x38, 0xa1, 0x92, 0xc2, 0x7f, 0x26, 0x03,
+	0x20, 0x6b, 0xd5, 0x8b, 0x73, 0xa8, 0xa4, 0xf8, 0x42, 0x06, 0xc5, 0xe2, 0x4c, 0x1c, 0xa5, 0xc5,
+	0x09, 0x33, 0x69, 0x91, 0xab, 0x12, 0x01, 0xa4, 0x7e, 0x75, 0x6e, 0x3f, 0x11, 0x4e, 0x6e, 0xea,
+	0xa8, 0xcc, 0xed, 0x27, 0x02, 0xf0, 0xe7, 0xa8, 0x91, 0xd3, 0x7d, 0x60, 0x3f, 0x26, 0x4c, 0x84,
+	0x8c, 0xac, 0xbb, 0xef, 0xb5, 0x92, 0xa4, 0xdd, 0x5f, 0xb7, 0xad, 0x1d, 0xdb, 0x93, 0x74, 0xea,
+	0x71, 0x12, 0x8c, 0x38, 0x9c, 0xf8, 0x82, 0x46, 0x0c, 0x62, 0x9a, 0xbe, 0x5c, 0x36, 0xaa, 0xa7,
+	0xde, 0xcb, 0x94, 0x5f, 0xe5, 0xc2, 0x62, 0xea, 0xe5, 0x8c, 0xd2, 0xd4, 0x63, 0xc7, 0x0c, 0xf8,
+	0x19, 0xaa, 0xe7, 0xbe, 0x04, 0xe8, 0x90, 0x01, 0x69, 0x18, 0xee, 0xce, 0x15, 0xdc, 0x6f, 0x52,
+	0x51, 0xb1, 0xcd, 0xb3, 0xde, 0xd2, 0x36, 0xc7, 0x53, 0x26, 0xc0, 0x0a, 0x6d, 0xe4, 0xfa, 0x01,
+	0x8b, 0x25, 0x70, 0x0d, 0x04, 0x1b, 0x5a, 0xf3, 0x0a, 0xda, 0x51, 0x26, 0xf3, 0xde, 0xb1, 0xbc,
+	0x6d, 0xd7, 0xef, 0x12, 0xd7, 0xe3, 0x19, 0x23, 0xe0, 0x11, 0xaa, 0xd3, 0x44, 0x9f, 0x30, 0xa1,
+	0x79, 0x48, 0xb5, 0x54, 0x40, 0x6e, 0x56, 0xff, 0x80, 0x3e, 0x99, 0x56, 0x15, 0x1f, 0x70, 0xd6,
+	0x5c, 0xfa, 0xfd, 0xcc, 0x96, 0xf1, 0x23, 0xb4, 0x04, 0xb1, 0x14, 0x90, 0x72, 0x36, 0xab, 0x57,
+	0xed, 0x38, 0xab, 0x7b, 0xbb, 0x96, 0x80, 0x73, 0x43, 0x69, 0xd3, 0xf2, 0x02, 0xfe, 0xad, 0x86,
+	0x5a, 0x63, 0xa9, 0xb9, 0x18, 0xfa, 0xb1, 0x7c, 0xce, 0x94, 0xff, 0x9c, 0xf1, 0xe1, 0x89, 0xf6,
+	0x65, 0x00, 0x4c, 0x8d, 0xd3, 0x37, 0x85, 0x00, 0xb2, 0x65, 0x70, 0xf7, 0x5d, 0xdc, 0x63, 0xe3,
+	0xeb, 0xa5, 0xb6, 0x6f, 0x8d, 0xeb, 0xeb, 0xc2, 0xe4, 0x7d, 0x68, 0x7b, 0xd8, 0x7f, 0x5d, 0xba,
+	0xdb, 0xdb, 0xdd, 0xf1, 0x35, 0xb1, 0x80, 0x5f, 0xd4, 0xd0, 0x66, 0x45, 0x24, 0x90, 0x5b, 0x76,
+	0xb7, 0xb2, 0x0b, 0x4b, 0x27, 0xbd, 0xb0, 0x74, 0xec, 0x85, 0xa5, 0x73, 0xc4, 0xc2, 0x43, 0xc9,
+	0x85, 0xf7, 0xc4, 0x36, 0xd5, 0xac, 0x4a, 0x28, 0x1a, 0x79, 0xf1, 0xe7, 0xee, 0x7b, 0x43, 0xae,
+	0x4f, 0x92, 0xa0, 0x13, 0xca, 0xa8, 0x6b, 0xaf, 0x40, 0xd9, 0xbf, 0x07, 0x30, 0xf8, 0xc1, 0xde,
+	0x67, 0x6c, 0x2a, 0x64, 0x7d, 0xe3, 0x52, 0xdf, 0xe0, 0x1d, 0xbd, 0xbc, 0x68, 0xd6, 0x5e, 0x5d,
+	0x34, 0x6b, 0x7f, 0x5d, 0x34, 0x6b, 0xbf, 0x5c, 0x36, 0xe7, 0x5e, 0x5d, 0x36, 0xe7, 0xfe, 0xb8,
+	0x6c, 0xce, 0x3d, 0xd9, 0x9f, 0xca, 0xcf, 0xc6, 0xfa, 0x60, 0x44, 0x03, 0xb0, 0xcf, 0xdd, 0xd3,
+	0xec, 0xea, 0x64, 0x38, 0xc1, 0x82, 0xb9, 0x38, 0x7d, 0xf0, 0xff, 0x00, 0x47, 0x55, 0x99, 0x15,
+	0xcd, 0x09, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -185,6 +330,207 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.VotingPowerWeights) > 0 {
+		for iNdEx := len(m.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
+	if len(m.VotingPowerWeightObservations) > 0 {
+		for iNdEx := len(m.VotingPowerWeightObservations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VotingPowerWeightObservations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Sponsors) > 0 {
+		for iNdEx := len(m.Sponsors) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Sponsors[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for iNdEx := len(m.Authenticators) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Authenticators[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.PublishDeposits) > 0 {
+		for iNdEx := len(m.PublishDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublishDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.PublishUsages) > 0 {
+		for iNdEx := len(m.PublishUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublishUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
+	if len(m.PublishNamespaces) > 0 {
+		for iNdEx := len(m.PublishNamespaces) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PublishNamespaces[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x82
+		}
+	}
+	if m.CronJobSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.CronJobSequence))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.CronRuns) > 0 {
+		for iNdEx := len(m.CronRuns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronRuns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	if len(m.CronJobs) > 0 {
+		for iNdEx := len(m.CronJobs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CronJobs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x6a
+		}
+	}
+	if len(m.ClaimableRevenues) > 0 {
+		for iNdEx := len(m.ClaimableRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ClaimableRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if len(m.ModuleRevenues) > 0 {
+		for iNdEx := len(m.ModuleRevenues) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ModuleRevenues[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.RevenueRatios) > 0 {
+		for iNdEx := len(m.RevenueRatios) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevenueRatios[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RevenueRecipients) > 0 {
+		for iNdEx := len(m.RevenueRecipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RevenueRecipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.DexPairs) > 0 {
 		for iNdEx := len(m.DexPairs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -340,6 +686,87 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RevenueRecipients) > 0 {
+		for _, e := range m.RevenueRecipients {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RevenueRatios) > 0 {
+		for _, e := range m.RevenueRatios {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ModuleRevenues) > 0 {
+		for _, e := range m.ModuleRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ClaimableRevenues) > 0 {
+		for _, e := range m.ClaimableRevenues {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CronJobs) > 0 {
+		for _, e := range m.CronJobs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CronRuns) > 0 {
+		for _, e := range m.CronRuns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.CronJobSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.CronJobSequence))
+	}
+	if len(m.PublishNamespaces) > 0 {
+		for _, e := range m.PublishNamespaces {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PublishUsages) > 0 {
+		for _, e := range m.PublishUsages {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PublishDeposits) > 0 {
+		for _, e := range m.PublishDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Authenticators) > 0 {
+		for _, e := range m.Authenticators {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Sponsors) > 0 {
+		for _, e := range m.Sponsors {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingPowerWeightObservations) > 0 {
+		for _, e := range m.VotingPowerWeightObservations {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.VotingPowerWeights) > 0 {
+		for _, e := range m.VotingPowerWeights {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -632,6 +1059,467 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueRecipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueRecipients = append(m.RevenueRecipients, ModuleRevenueRecipient{})
+			if err := m.RevenueRecipients[len(m.RevenueRecipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevenueRatios", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevenueRatios = append(m.RevenueRatios, ModuleRevenueRatio{})
+			if err := m.RevenueRatios[len(m.RevenueRatios)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleRevenues = append(m.ModuleRevenues, ModuleRevenue{})
+			if err := m.ModuleRevenues[len(m.ModuleRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClaimableRevenues", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClaimableRevenues = append(m.ClaimableRevenues, ClaimableRevenue{})
+			if err := m.ClaimableRevenues[len(m.ClaimableRevenues)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronJobs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronJobs = append(m.CronJobs, CronJob{})
+			if err := m.CronJobs[len(m.CronJobs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronRuns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronRuns = append(m.CronRuns, CronRun{})
+			if err := m.CronRuns[len(m.CronRuns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronJobSequence", wireType)
+			}
+			m.CronJobSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronJobSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishNamespaces", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishNamespaces = append(m.PublishNamespaces, PublishNamespace{})
+			if err := m.PublishNamespaces[len(m.PublishNamespaces)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishUsages = append(m.PublishUsages, PublishUsage{})
+			if err := m.PublishUsages[len(m.PublishUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PublishDeposits = append(m.PublishDeposits, PublishDeposit{})
+			if err := m.PublishDeposits[len(m.PublishDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authenticators", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authenticators = append(m.Authenticators, Authenticator{})
+			if err := m.Authenticators[len(m.Authenticators)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsors", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sponsors = append(m.Sponsors, Sponsor{})
+			if err := m.Sponsors[len(m.Sponsors)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeightObservations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerWeightObservations = append(m.VotingPowerWeightObservations, VotingPowerWeightObservation{})
+			if err := m.VotingPowerWeightObservations[len(m.VotingPowerWeightObservations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 22:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerWeights = append(m.VotingPowerWeights, types.DecCoin{})
+			if err := m.VotingPowerWeights[len(m.VotingPowerWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -0,0 +1,179 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// SerializeJSONValue serializes the json value of the type into bcs bytes.
+// The json format follows the output of the move value decoder, so that
+//   - integers are json numbers or strings,
+//   - addresses and vector<u8> are hex strings,
+//   - 0x1::string::String is a json string,
+//   - 0x1::option::Option<T> is null or the value,
+//   - 0x1::object::Object<T> is {"inner": address} or an address.
+//
+// Other struct types are not supported.
+func SerializeJSONValue(typeTag vmtypes.TypeTag, value []byte) ([]byte, error) {
+	var buf bytes.Buffer
+	if err := serializeJSONValue(&buf, typeTag, json.RawMessage(value)); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+func serializeJSONValue(buf *bytes.Buffer, typeTag vmtypes.TypeTag, value json.RawMessage) error {
+	switch tt := typeTag.(type) {
+	case *vmtypes.TypeTag__Bool:
+		var b bool
+		if err := json.Unmarshal(value, &b); err != nil {
+			return err
+		}
+		if b {
+			buf.WriteByte(1)
+		} else {
+			buf.WriteByte(0)
+		}
+	case *vmtypes.TypeTag__U8:
+		return serializeJSONUint(buf, value, 1)
+	case *vmtypes.TypeTag__U16:
+		return serializeJSONUint(buf, value, 2)
+	case *vmtypes.TypeTag__U32:
+		return serializeJSONUint(buf, value, 4)
+	case *vmtypes.TypeTag__U64:
+		return serializeJSONUint(buf, value, 8)
+	case *vmtypes.TypeTag__U128:
+		return serializeJSONUint(buf, value, 16)
+	case *vmtypes.TypeTag__U256:
+		return serializeJSONUint(buf, value, 32)
+	case *vmtypes.TypeTag__Address:
+		return serializeJSONAddress(buf, value)
+	case *vmtypes.TypeTag__Vector:
+		// vector<u8> is a hex string
+		if _, ok := tt.Value.(*vmtypes.TypeTag__U8); ok {
+			var str string
+			if err := json.Unmarshal(value, &str); err != nil {
+				return err
+			}
+
+			bz, err := hex.DecodeString(strings.TrimPrefix(str, "0x"))
+			if err != nil {
+				return err
+			}
+
+			writeBcsBytes(buf, bz)
+			return nil
+		}
+
+		var items []json.RawMessage
+		if err := json.Unmarshal(value, &items); err != nil {
+			return err
+		}
+
+		writeBcsLen(buf, len(items))
+		for _, item := range items {
+			if err := serializeJSONValue(buf, tt.Value, item); err != nil {
+				return err
+			}
+		}
+	case *vmtypes.TypeTag__Struct:
+		return serializeJSONStruct(buf, tt.Value, value)
+	default:
+		return fmt.Errorf("unsupported json value type: %T", typeTag)
+	}
+
+	return nil
+}
+
+func serializeJSONStruct(buf *bytes.Buffer, structTag vmtypes.StructTag, value json.RawMessage) error {
+	if structTag.Address != vmtypes.StdAddress {
+		return fmt.Errorf("unsupported json value type: %s::%s", structTag.Module, structTag.Name)
+	}
+
+	switch {
+	case structTag.Module == "string" && structTag.Name == "String":
+		var str string
+		if err := json.Unmarshal(value, &str); err != nil {
+			return err
+		}
+
+		writeBcsBytes(buf, []byte(str))
+	case structTag.Module == "option" && structTag.Name == "Option" && len(structTag.TypeArgs) == 1:
+		// option is a vector of zero or one element
+		if bytes.Equal(bytes.TrimSpace(value), []byte("null")) {
+			writeBcsLen(buf, 0)
+			return nil
+		}
+
+		writeBcsLen(buf, 1)
+		return serializeJSONValue(buf, structTag.TypeArgs[0], value)
+	case structTag.Module == MoveModuleNameObject && structTag.Name == "Object":
+		var object struct {
+			Inner json.RawMessage `json:"inner"`
+		}
+		if err := json.Unmarshal(value, &object); err == nil && object.Inner != nil {
+			value = object.Inner
+		}
+
+		return serializeJSONAddress(buf, value)
+	default:
+		return fmt.Errorf("unsupported json value type: 0x1::%s::%s", structTag.Module, structTag.Name)
+	}
+
+	return nil
+}
+
+func serializeJSONAddress(buf *bytes.Buffer, value json.RawMessage) error {
+	var str string
+	if err := json.Unmarshal(value, &str); err != nil {
+		return err
+	}
+
+	addr, err := vmtypes.NewAccountAddress(str)
+	if err != nil {
+		return err
+	}
+
+	buf.Write(addr.Bytes())
+	return nil
+}
+
+// serializeJSONUint writes the little endian bytes of the json number or string.
+func serializeJSONUint(buf *bytes.Buffer, value json.RawMessage, size int) error {
+	str := string(bytes.TrimSpace(value))
+	if strings.HasPrefix(str, `"`) {
+		if err := json.Unmarshal(value, &str); err != nil {
+			return err
+		}
+	}
+
+	num, ok := new(big.Int).SetString(str, 10)
+	if !ok || num.Sign() < 0 || num.BitLen() > size*8 {
+		return fmt.Errorf("invalid u%d value: %s", size*8, str)
+	}
+
+	bz := num.FillBytes(make([]byte, size))
+	for i, j := 0, len(bz)-1; i < j; i, j = i+1, j-1 {
+		bz[i], bz[j] = bz[j], bz[i]
+	}
+
+	buf.Write(bz)
+	return nil
+}
+
+func writeBcsLen(buf *bytes.Buffer, length int) {
+	buf.Write(binary.AppendUvarint(nil, uint64(length)))
+}
+
+func writeBcsBytes(buf *bytes.Buffer, bz []byte) {
+	writeBcsLen(buf, len(bz))
+	buf.Write(bz)
+}
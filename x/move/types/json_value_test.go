@@ -0,0 +1,54 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmapi "github.com/initia-labs/movevm/api"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_SerializeJSONValue(t *testing.T) {
+	u64Bz, err := vmtypes.SerializeUint64(100)
+	require.NoError(t, err)
+
+	testCases := []struct {
+		typeTag  string
+		value    string
+		expected []byte
+	}{
+		{"bool", `true`, []byte{1}},
+		{"u8", `7`, []byte{7}},
+		{"u64", `"100"`, u64Bz},
+		{"u64", `100`, u64Bz},
+		{"u128", `"1"`, append([]byte{1}, make([]byte, 15)...)},
+		{"address", `"0x1"`, vmtypes.StdAddress.Bytes()},
+		{"vector<u8>", `"0x0102"`, []byte{2, 1, 2}},
+		{"vector<u64>", `["100"]`, append([]byte{1}, u64Bz...)},
+		{"0x1::string::String", `"hi"`, []byte{2, 'h', 'i'}},
+		{"0x1::option::Option<u64>", `null`, []byte{0}},
+		{"0x1::option::Option<u64>", `"100"`, append([]byte{1}, u64Bz...)},
+		{"0x1::object::Object<0x1::object::ObjectCore>", `{"inner":"0x1"}`, vmtypes.StdAddress.Bytes()},
+	}
+
+	for _, tc := range testCases {
+		typeTag, err := vmapi.TypeTagFromString(tc.typeTag)
+		require.NoError(t, err)
+
+		bz, err := types.SerializeJSONValue(typeTag, []byte(tc.value))
+		require.NoError(t, err, tc.typeTag)
+		require.Equal(t, tc.expected, bz, tc.typeTag)
+	}
+
+	typeTag, err := vmapi.TypeTagFromString("u8")
+	require.NoError(t, err)
+	_, err = types.SerializeJSONValue(typeTag, []byte(`256`))
+	require.Error(t, err)
+
+	typeTag, err = vmapi.TypeTagFromString("0x1::fungible_asset::Metadata")
+	require.NoError(t, err)
+	_, err = types.SerializeJSONValue(typeTag, []byte(`{}`))
+	require.Error(t, err)
+}
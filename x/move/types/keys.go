@@ -39,6 +39,25 @@ const MoveStakingModuleName = "move_staking"
 // staking address: initia185vd23fjl3pw2ecfppfdkm4jr7jj372jryhptt
 var MoveStakingModuleAddress = authtypes.NewModuleAddress(MoveStakingModuleName)
 
+// MoveRevenueModuleName is special purpose module name to escrow the contract shared revenue
+// until the recipients claim it
+const MoveRevenueModuleName = "move_revenue"
+
+// MoveRevenueModuleAddress is the escrow address of the contract shared revenue
+var MoveRevenueModuleAddress = authtypes.NewModuleAddress(MoveRevenueModuleName)
+
+// MoveCronModuleName is special purpose module name to escrow the prepaid fee of the cron jobs
+const MoveCronModuleName = "move_cron"
+
+// MoveCronModuleAddress is the escrow address of the cron job deposits
+var MoveCronModuleAddress = authtypes.NewModuleAddress(MoveCronModuleName)
+
+// MovePublishModuleName is special purpose module name to escrow the publish deposits
+const MovePublishModuleName = "move_publish"
+
+// MovePublishModuleAddress is the escrow address of the publish deposits
+var MovePublishModuleAddress = authtypes.NewModuleAddress(MovePublishModuleName)
+
 // GetDelegatorModuleName return a unique delegator module name for a validator
 func GetDelegatorModuleName(val sdk.ValAddress) string {
 	return fmt.Sprintf("%s/%s", ModuleName, val.String())
@@ -64,6 +83,31 @@ var (
 
 	ParamsKey = []byte{0x31} // prefix for parameters for module x/move
 
+	RevenueRecipientPrefix = []byte{0x41} // prefix for contract shared revenue recipients
+	RevenueRatioPrefix     = []byte{0x42} // prefix for contract shared revenue ratio overrides
+	ModuleRevenuePrefix    = []byte{0x43} // prefix for accumulated contract shared revenues
+	ClaimableRevenuePrefix = []byte{0x44} // prefix for claimable contract shared revenues
+
+	CronJobSequenceKey = []byte{0x51} // key for the next cron job id
+	CronJobPrefix      = []byte{0x52} // prefix for cron jobs
+	CronQueuePrefix    = []byte{0x53} // prefix for cron job queue by the next run height
+	CronRunPrefix      = []byte{0x54} // prefix for cron job run history
+
+	PublishNamespacePrefix = []byte{0x61} // prefix for publish namespaces
+	PublishUsagePrefix     = []byte{0x62} // prefix for publish quota usages
+	PublishDepositPrefix   = []byte{0x63} // prefix for publish deposits
+
+	ModuleDependencyPrefix = []byte{0x71} // prefix for module dependencies
+	ModuleDependentPrefix  = []byte{0x72} // prefix for reverse module dependencies
+
+	DenomOwnerPrefix = []byte{0x81} // prefix for primary fungible store owners by metadata
+
+	AuthenticatorPrefix = []byte{0x91} // prefix for account authenticators
+	SponsorPrefix       = []byte{0x92} // prefix for fee sponsor policies
+
+	VotingPowerWeightObservationPrefix = []byte{0xa1} // prefix for LP voting power weight observations
+	VotingPowerWeightPrefix            = []byte{0xa2} // prefix for smoothed LP voting power weights
+
 	ModuleSeparator     = byte(0)
 	ChecksumSeparator   = byte(1)
 	ResourceSeparator   = byte(2)
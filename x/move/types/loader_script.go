@@ -0,0 +1,246 @@
+package types
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// Move binary format constants used to build the module loader script.
+const (
+	moveBinaryMagic   = uint32(0xA11CEB0B)
+	moveBinaryVersion = uint32(6)
+
+	tableKindModuleHandles      = byte(0x1)
+	tableKindSignatures         = byte(0x5)
+	tableKindIdentifiers        = byte(0x7)
+	tableKindAddressIdentifiers = byte(0x8)
+
+	opcodeRet = byte(0x02)
+)
+
+// BuildModuleLoaderScript builds the compiled script which depends on the
+// given modules and does nothing. The vm loads and verifies all dependencies
+// of a script before the execution, so executing the script puts the modules
+// and their dependencies in the module cache of the vm.
+func BuildModuleLoaderScript(moduleIds []vmtypes.ModuleId) ([]byte, error) {
+	if len(moduleIds) == 0 {
+		return nil, errors.New("empty module ids")
+	}
+
+	var moduleHandles, identifiers, addresses bytes.Buffer
+
+	identifierIdx := make(map[vmtypes.Identifier]int)
+	addressIdx := make(map[vmtypes.AccountAddress]int)
+	for _, moduleId := range moduleIds {
+		aIdx, found := addressIdx[moduleId.Address]
+		if !found {
+			aIdx = len(addressIdx)
+			addressIdx[moduleId.Address] = aIdx
+			addresses.Write(moduleId.Address[:])
+		}
+
+		nIdx, found := identifierIdx[moduleId.Name]
+		if !found {
+			nIdx = len(identifierIdx)
+			identifierIdx[moduleId.Name] = nIdx
+			writeULEB128(&identifiers, uint64(len(moduleId.Name)))
+			identifiers.WriteString(string(moduleId.Name))
+		}
+
+		writeULEB128(&moduleHandles, uint64(aIdx))
+		writeULEB128(&moduleHandles, uint64(nIdx))
+	}
+
+	// the empty signature for the parameters and the locals
+	signatures := []byte{0x0}
+
+	tables := []struct {
+		kind byte
+		data []byte
+	}{
+		{tableKindModuleHandles, moduleHandles.Bytes()},
+		{tableKindSignatures, signatures},
+		{tableKindIdentifiers, identifiers.Bytes()},
+		{tableKindAddressIdentifiers, addresses.Bytes()},
+	}
+
+	var script bytes.Buffer
+	_ = binary.Write(&script, binary.BigEndian, moveBinaryMagic)
+	_ = binary.Write(&script, binary.LittleEndian, moveBinaryVersion)
+
+	// table headers
+	writeULEB128(&script, uint64(len(tables)))
+	offset := 0
+	for _, table := range tables {
+		script.WriteByte(table.kind)
+		writeULEB128(&script, uint64(offset))
+		writeULEB128(&script, uint64(len(table.data)))
+		offset += len(table.data)
+	}
+
+	// table contents
+	for _, table := range tables {
+		script.Write(table.data)
+	}
+
+	// no type parameters, no parameters, no locals and a single return
+	writeULEB128(&script, 0)
+	writeULEB128(&script, 0)
+	writeULEB128(&script, 0)
+	writeULEB128(&script, 1)
+	script.WriteByte(opcodeRet)
+
+	return script.Bytes(), nil
+}
+
+// ReadScriptDependencies reads the modules the compiled script depends on
+// from the module handles of the script.
+func ReadScriptDependencies(script []byte) ([]vmtypes.ModuleId, error) {
+	r := bytes.NewReader(script)
+
+	var magic, version uint32
+	if err := binary.Read(r, binary.BigEndian, &magic); err != nil {
+		return nil, err
+	} else if magic != moveBinaryMagic {
+		return nil, errors.New("invalid magic")
+	}
+	if err := binary.Read(r, binary.LittleEndian, &version); err != nil {
+		return nil, err
+	}
+
+	numTables, err := decodeULEB128(r)
+	if err != nil {
+		return nil, err
+	}
+
+	type tableHeader struct {
+		offset uint64
+		length uint64
+	}
+
+	headers := make(map[byte]tableHeader)
+	for i := uint64(0); i < numTables; i++ {
+		kind, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		offset, err := decodeULEB128(r)
+		if err != nil {
+			return nil, err
+		}
+		length, err := decodeULEB128(r)
+		if err != nil {
+			return nil, err
+		}
+
+		headers[kind] = tableHeader{offset, length}
+	}
+
+	contents := script[len(script)-r.Len():]
+	table := func(kind byte) (*bytes.Reader, error) {
+		header := headers[kind]
+		if header.offset+header.length > uint64(len(contents)) {
+			return nil, errors.New("table out of bounds")
+		}
+
+		return bytes.NewReader(contents[header.offset : header.offset+header.length]), nil
+	}
+
+	identifiersReader, err := table(tableKindIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	var identifiers []vmtypes.Identifier
+	for identifiersReader.Len() > 0 {
+		length, err := decodeULEB128(identifiersReader)
+		if err != nil {
+			return nil, err
+		} else if length > uint64(identifiersReader.Len()) {
+			return nil, errors.New("identifier out of bounds")
+		}
+
+		name := make([]byte, length)
+		_, _ = identifiersReader.Read(name)
+		identifiers = append(identifiers, vmtypes.Identifier(name))
+	}
+
+	addressesReader, err := table(tableKindAddressIdentifiers)
+	if err != nil {
+		return nil, err
+	}
+
+	var addresses []vmtypes.AccountAddress
+	for addressesReader.Len() > 0 {
+		var addr vmtypes.AccountAddress
+		if _, err := io.ReadFull(addressesReader, addr[:]); err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, addr)
+	}
+
+	moduleHandlesReader, err := table(tableKindModuleHandles)
+	if err != nil {
+		return nil, err
+	}
+
+	var moduleIds []vmtypes.ModuleId
+	for moduleHandlesReader.Len() > 0 {
+		aIdx, err := decodeULEB128(moduleHandlesReader)
+		if err != nil {
+			return nil, err
+		}
+		nIdx, err := decodeULEB128(moduleHandlesReader)
+		if err != nil {
+			return nil, err
+		}
+
+		if aIdx >= uint64(len(addresses)) || nIdx >= uint64(len(identifiers)) {
+			return nil, errors.New("module handle out of bounds")
+		}
+
+		moduleIds = append(moduleIds, vmtypes.ModuleId{
+			Address: addresses[aIdx],
+			Name:    identifiers[nIdx],
+		})
+	}
+
+	return moduleIds, nil
+}
+
+func decodeULEB128(r io.ByteReader) (uint64, error) {
+	var value uint64
+	for shift := uint(0); shift < 64; shift += 7 {
+		b, err := r.ReadByte()
+		if err != nil {
+			return 0, err
+		}
+
+		value |= uint64(b&0x7F) << shift
+		if b&0x80 == 0 {
+			return value, nil
+		}
+	}
+
+	return 0, errors.New("uleb128 overflow")
+}
+
+func writeULEB128(buf *bytes.Buffer, value uint64) {
+	for {
+		b := byte(value & 0x7F)
+		value >>= 7
+		if value != 0 {
+			b |= 0x80
+		}
+
+		buf.WriteByte(b)
+		if value == 0 {
+			return
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/initia-labs/initia/x/move/types"
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+func Test_ReadScriptDependencies(t *testing.T) {
+	moduleIds := []vmtypes.ModuleId{
+		{Address: vmtypes.StdAddress, Name: "coin"},
+		{Address: vmtypes.TestAddress, Name: "coin"},
+		{Address: vmtypes.TestAddress, Name: "TestAddress"},
+	}
+
+	script, err := types.BuildModuleLoaderScript(moduleIds)
+	require.NoError(t, err)
+
+	deps, err := types.ReadScriptDependencies(script)
+	require.NoError(t, err)
+	require.Equal(t, moduleIds, deps)
+
+	// truncated script
+	_, err = types.ReadScriptDependencies(script[:10])
+	require.Error(t, err)
+
+	_, err = types.ReadScriptDependencies([]byte{0x1, 0x2})
+	require.Error(t, err)
+}
@@ -14,12 +14,21 @@ import (
 
 // Default parameter values
 const (
-	DefaultBaseDenom = "uinit"
+	DefaultBaseDenom          = "uinit"
+	DefaultCronMaxGasPerBlock = uint64(10_000_000)
+	DefaultCronMaxFailures    = uint64(3)
+
+	DefaultAuthenticatorGasLimit = uint64(200_000)
+	DefaultSponsorGasLimit       = uint64(200_000)
+
+	DefaultVotingPowerTwapWindow = uint64(100)
 )
 
 var (
 	DefaultBaseMinGasPrice            = math.LegacyNewDecWithPrec(15, 2) // 0.15
 	DefaultContractSharedRevenueRatio = math.LegacyNewDecWithPrec(50, 2) // 0.5
+
+	DefaultMaxVotingPowerWeightChangeRate = math.LegacyNewDecWithPrec(5, 2) // 0.05
 )
 
 const (
@@ -27,6 +36,9 @@ const (
 	ModuleNameLengthHardLimit   = int(128)
 	FunctionNameLengthHardLimit = int(128)
 	NumArgumentsHardLimit       = int(16)
+	CronRunHistoryLimit         = int(20)
+	CronMaxJobsPerBlock         = int(100)
+	CronMinGasPerRun            = uint64(10_000)
 )
 
 // DefaultParams returns default move parameters
@@ -36,6 +48,17 @@ func DefaultParams() Params {
 		BaseMinGasPrice:            DefaultBaseMinGasPrice,
 		ContractSharedRevenueRatio: DefaultContractSharedRevenueRatio,
 		AllowedPublishers:          nil,
+		CronMaxGasPerBlock:         DefaultCronMaxGasPerBlock,
+		CronMaxFailures:            DefaultCronMaxFailures,
+		PublishMode:                PublishModeUnspecified,
+		PublishDeposit:             math.ZeroInt(),
+		PublishQuota:               0,
+		PublishQuotaWindow:         0,
+		AuthenticatorGasLimit:      DefaultAuthenticatorGasLimit,
+		SponsorGasLimit:            DefaultSponsorGasLimit,
+
+		VotingPowerTwapWindow:          DefaultVotingPowerTwapWindow,
+		MaxVotingPowerWeightChangeRate: DefaultMaxVotingPowerWeightChangeRate,
 	}
 }
 
@@ -65,6 +88,26 @@ func (p Params) Validate(ac address.Codec) error {
 		return errors.Wrap(err, "invalid allowed_publishers")
 	}
 
+	if err := validateCronMaxFailures(p.CronMaxFailures); err != nil {
+		return errors.Wrap(err, "invalid cron_max_failures")
+	}
+
+	if err := validatePublishMode(p.PublishMode, p.AllowedPublishers); err != nil {
+		return errors.Wrap(err, "invalid publish_mode")
+	}
+
+	if err := validatePublishDeposit(p.PublishDeposit); err != nil {
+		return errors.Wrap(err, "invalid publish_deposit")
+	}
+
+	if p.PublishQuota > 0 && p.PublishQuotaWindow == 0 {
+		return errors.New("publish_quota_window must be positive when publish_quota is set")
+	}
+
+	if err := validateMaxVotingPowerWeightChangeRate(p.MaxVotingPowerWeightChangeRate); err != nil {
+		return errors.Wrap(err, "invalid max_voting_power_weight_change_rate")
+	}
+
 	return nil
 }
 
@@ -74,6 +117,17 @@ func (p Params) ToRaw() RawParams {
 		BaseDenom:                  p.BaseDenom,
 		BaseMinGasPrice:            p.BaseMinGasPrice,
 		ContractSharedRevenueRatio: p.ContractSharedRevenueRatio,
+		CronMaxGasPerBlock:         p.CronMaxGasPerBlock,
+		CronMaxFailures:            p.CronMaxFailures,
+		PublishMode:                p.PublishMode,
+		PublishDeposit:             p.PublishDeposit,
+		PublishQuota:               p.PublishQuota,
+		PublishQuotaWindow:         p.PublishQuotaWindow,
+		AuthenticatorGasLimit:      p.AuthenticatorGasLimit,
+		SponsorGasLimit:            p.SponsorGasLimit,
+
+		VotingPowerTwapWindow:          p.VotingPowerTwapWindow,
+		MaxVotingPowerWeightChangeRate: p.MaxVotingPowerWeightChangeRate,
 	}
 }
 
@@ -84,6 +138,17 @@ func (p RawParams) ToParams(allowedPublishers []string) Params {
 		BaseMinGasPrice:            p.BaseMinGasPrice,
 		ContractSharedRevenueRatio: p.ContractSharedRevenueRatio,
 		AllowedPublishers:          allowedPublishers,
+		CronMaxGasPerBlock:         p.CronMaxGasPerBlock,
+		CronMaxFailures:            p.CronMaxFailures,
+		PublishMode:                p.PublishMode,
+		PublishDeposit:             p.PublishDeposit,
+		PublishQuota:               p.PublishQuota,
+		PublishQuotaWindow:         p.PublishQuotaWindow,
+		AuthenticatorGasLimit:      p.AuthenticatorGasLimit,
+		SponsorGasLimit:            p.SponsorGasLimit,
+
+		VotingPowerTwapWindow:          p.VotingPowerTwapWindow,
+		MaxVotingPowerWeightChangeRate: p.MaxVotingPowerWeightChangeRate,
 	}
 }
 
@@ -144,3 +209,75 @@ func validateAllowedPublishers(ac address.Codec, i interface{}) error {
 
 	return nil
 }
+
+func validateCronMaxFailures(i interface{}) error {
+	v, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v == 0 {
+		return fmt.Errorf("cron_max_failures must be positive: %d", v)
+	}
+
+	return nil
+}
+
+func validatePublishMode(mode PublishMode, allowedPublishers []string) error {
+	switch mode {
+	case PublishModeUnspecified:
+		return nil
+	case PublishModeOpen, PublishModeGovernance:
+		if len(allowedPublishers) != 0 {
+			return fmt.Errorf("allowed_publishers must be empty in %s", mode)
+		}
+	case PublishModeAllowlist:
+		if len(allowedPublishers) == 0 {
+			return fmt.Errorf("allowed_publishers must not be empty in %s", mode)
+		}
+	default:
+		return fmt.Errorf("unknown publish mode: %d", mode)
+	}
+
+	return nil
+}
+
+func validatePublishDeposit(i interface{}) error {
+	v, ok := i.(math.Int)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("publish_deposit must be non-negative value: %v", v)
+	}
+
+	return nil
+}
+
+func validateMaxVotingPowerWeightChangeRate(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() || v.IsNegative() {
+		return fmt.Errorf("max_voting_power_weight_change_rate must be non-negative value: %v", v)
+	}
+
+	return nil
+}
+
+// EffectivePublishMode returns the publish mode applied to the publishers,
+// which resolves the unspecified mode by `AllowedPublishers`.
+func (p Params) EffectivePublishMode() PublishMode {
+	if p.PublishMode != PublishModeUnspecified {
+		return p.PublishMode
+	}
+
+	if len(p.AllowedPublishers) == 0 {
+		return PublishModeOpen
+	}
+
+	return PublishModeAllowlist
+}
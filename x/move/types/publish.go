@@ -0,0 +1,72 @@
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	vmtypes "github.com/initia-labs/movevm/types"
+)
+
+// EventTypeTagModulePublished is the type tag of the event emitted by
+// `0x1::code::publish` for each published module.
+const EventTypeTagModulePublished = "0x1::code::ModulePublishedEvent"
+
+// HasPrefix returns true if the module name is in the namespace. An empty
+// namespace allows every module name.
+func (n PublishNamespace) HasPrefix(moduleName string) bool {
+	if len(n.Prefixes) == 0 {
+		return true
+	}
+
+	for _, prefix := range n.Prefixes {
+		if strings.HasPrefix(moduleName, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// ModulePublishedEvent is the event data of `0x1::code::ModulePublishedEvent`.
+type ModulePublishedEvent struct {
+	ModuleId      string `json:"module_id"`
+	UpgradePolicy uint8  `json:"upgrade_policy"`
+}
+
+// ParseModulePublishedEvent parses the event data of the module published event
+// and returns the published module id and its upgrade policy.
+func ParseModulePublishedEvent(eventData string) (vmtypes.ModuleId, UpgradePolicy, error) {
+	var event ModulePublishedEvent
+	if err := json.Unmarshal([]byte(eventData), &event); err != nil {
+		return vmtypes.ModuleId{}, UpgradePolicy_UNSPECIFIED, err
+	}
+
+	moduleId, err := ParseModuleIdentifier(event.ModuleId)
+	if err != nil {
+		return vmtypes.ModuleId{}, UpgradePolicy_UNSPECIFIED, err
+	}
+
+	moduleAddr, err := vmtypes.NewAccountAddress(moduleId.Address)
+	if err != nil {
+		return vmtypes.ModuleId{}, UpgradePolicy_UNSPECIFIED, err
+	}
+
+	return vmtypes.NewModuleId(moduleAddr, moduleId.ModuleName), UpgradePolicy(event.UpgradePolicy), nil
+}
+
+type govPublishKey struct{}
+
+// WithGovPublish returns the context which publishes modules on behalf of
+// the governance, so the publish policy is not enforced.
+func WithGovPublish(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(govPublishKey{}, true)
+}
+
+// IsGovPublish returns true if the context publishes modules on behalf of the governance.
+func IsGovPublish(ctx context.Context) bool {
+	govPublish, _ := ctx.Value(govPublishKey{}).(bool)
+	return govPublish
+}
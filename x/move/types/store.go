@@ -94,3 +94,55 @@ func (iter VMIterator) Value() []byte {
 
 	return value
 }
+
+// TraceVMStore wraps a VMStore and records every state access made by the vm.
+// The accesses are observed at the store boundary, so the trace shows the
+// storage reads and writes of the execution, not the executed instructions.
+type TraceVMStore struct {
+	VMStore
+	entries *[]StateAccess
+}
+
+func NewTraceVMStore(store VMStore) TraceVMStore {
+	return TraceVMStore{VMStore: store, entries: &[]StateAccess{}}
+}
+
+// Entries returns the recorded state accesses in the order they were made
+func (s TraceVMStore) Entries() []StateAccess {
+	return *s.entries
+}
+
+func (s TraceVMStore) record(op string, key []byte, valueSize int) {
+	*s.entries = append(*s.entries, StateAccess{
+		Op:        op,
+		Key:       append([]byte(nil), key...),
+		ValueSize: uint64(valueSize),
+	})
+}
+
+func (s TraceVMStore) Get(key []byte) []byte {
+	bz := s.VMStore.Get(key)
+	s.record("get", key, len(bz))
+
+	return bz
+}
+
+func (s TraceVMStore) Set(key, value []byte) {
+	s.VMStore.Set(key, value)
+	s.record("set", key, len(value))
+}
+
+func (s TraceVMStore) Delete(key []byte) {
+	s.VMStore.Delete(key)
+	s.record("delete", key, 0)
+}
+
+func (s TraceVMStore) Iterator(start, end []byte) dbm.Iterator {
+	s.record("iterate", start, 0)
+	return s.VMStore.Iterator(start, end)
+}
+
+func (s TraceVMStore) ReverseIterator(start, end []byte) dbm.Iterator {
+	s.record("reverse_iterate", start, 0)
+	return s.VMStore.ReverseIterator(start, end)
+}
@@ -23,6 +23,15 @@ var (
 	_ sdk.Msg = &MsgWhitelist{}
 	_ sdk.Msg = &MsgDelist{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgSetRevenueRecipient{}
+	_ sdk.Msg = &MsgClaimRevenue{}
+	_ sdk.Msg = &MsgUpdateRevenueRatio{}
+	_ sdk.Msg = &MsgRegisterCronJob{}
+	_ sdk.Msg = &MsgDepositCronJob{}
+	_ sdk.Msg = &MsgDeregisterCronJob{}
+	_ sdk.Msg = &MsgSetPublishNamespace{}
+	_ sdk.Msg = &MsgSetAuthenticator{}
+	_ sdk.Msg = &MsgSetSponsor{}
 )
 
 /* MsgPublish */
@@ -596,3 +605,262 @@ func (msg MsgUpdateParams) Validate(ac address.Codec) error {
 
 	return msg.Params.Validate(ac)
 }
+
+/* MsgSetRevenueRecipient */
+
+// Validate performs basic MsgSetRevenueRecipient message validation.
+func (msg MsgSetRevenueRecipient) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if err := validateModuleName(msg.ModuleName); err != nil {
+		return err
+	}
+
+	if _, err := AccAddressFromString(ac, msg.Recipient); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/* MsgClaimRevenue */
+
+// Validate performs basic MsgClaimRevenue message validation.
+func (msg MsgClaimRevenue) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Recipient != "" {
+		if _, err := AccAddressFromString(ac, msg.Recipient); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* MsgUpdateRevenueRatio */
+
+// Validate performs basic MsgUpdateRevenueRatio message validation.
+func (msg MsgUpdateRevenueRatio) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := AccAddressFromString(ac, msg.ModuleAddress); err != nil {
+		return err
+	}
+
+	if err := validateModuleName(msg.ModuleName); err != nil {
+		return err
+	}
+
+	if msg.Ratio != nil {
+		if err := validateContractSharedRatio(*msg.Ratio); err != nil {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	return nil
+}
+
+/* MsgRegisterCronJob */
+
+// Validate performs basic MsgRegisterCronJob message validation.
+func (msg MsgRegisterCronJob) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := AccAddressFromString(ac, msg.ModuleAddress); err != nil {
+		return err
+	}
+
+	if err := validateModuleName(msg.ModuleName); err != nil {
+		return err
+	}
+
+	if len(msg.FunctionName) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "empty function name")
+	}
+
+	if len(msg.FunctionName) > FunctionNameLengthHardLimit {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"function name length over hard limit %d",
+			FunctionNameLengthHardLimit,
+		)
+	}
+
+	if len(msg.Args) > NumArgumentsHardLimit {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"number of argument over hard limit %d",
+			NumArgumentsHardLimit,
+		)
+	}
+
+	if msg.Interval == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "interval must be positive")
+	}
+
+	if msg.GasLimit == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "gas limit must be positive")
+	}
+
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Deposit.String())
+	}
+
+	return nil
+}
+
+/* MsgDepositCronJob */
+
+// Validate performs basic MsgDepositCronJob message validation.
+func (msg MsgDepositCronJob) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Amount.String())
+	}
+
+	return nil
+}
+
+/* MsgDeregisterCronJob */
+
+// Validate performs basic MsgDeregisterCronJob message validation.
+func (msg MsgDeregisterCronJob) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+/* MsgSetPublishNamespace */
+
+// Validate performs basic MsgSetPublishNamespace message validation.
+func (msg MsgSetPublishNamespace) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	if _, err := AccAddressFromString(ac, msg.Address); err != nil {
+		return err
+	}
+
+	for _, prefix := range msg.Prefixes {
+		if err := validateModuleName(prefix); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+/* MsgSetAuthenticator */
+
+// Validate performs basic MsgSetAuthenticator message validation.
+func (msg MsgSetAuthenticator) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	// remove the authenticator
+	if len(msg.ModuleAddress) == 0 {
+		if len(msg.ModuleName) != 0 || len(msg.FunctionName) != 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "module name and function name must be empty to remove the authenticator")
+		}
+
+		return nil
+	}
+
+	if _, err := AccAddressFromString(ac, msg.ModuleAddress); err != nil {
+		return err
+	}
+
+	if err := validateModuleName(msg.ModuleName); err != nil {
+		return err
+	}
+
+	if len(msg.FunctionName) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "empty function name")
+	}
+
+	if len(msg.FunctionName) > FunctionNameLengthHardLimit {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"function name length over hard limit %d",
+			FunctionNameLengthHardLimit,
+		)
+	}
+
+	return nil
+}
+
+/* MsgSetSponsor */
+
+// Validate performs basic MsgSetSponsor message validation.
+func (msg MsgSetSponsor) Validate(ac address.Codec) error {
+	if _, err := ac.StringToBytes(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := AccAddressFromString(ac, msg.Sponsor); err != nil {
+		return err
+	}
+
+	// remove the sponsor policy
+	if len(msg.ModuleAddress) == 0 {
+		if len(msg.ModuleName) != 0 || len(msg.FunctionName) != 0 {
+			return errors.Wrap(sdkerrors.ErrInvalidRequest, "module name and function name must be empty to remove the sponsor")
+		}
+
+		return nil
+	}
+
+	if _, err := AccAddressFromString(ac, msg.ModuleAddress); err != nil {
+		return err
+	}
+
+	if err := validateModuleName(msg.ModuleName); err != nil {
+		return err
+	}
+
+	if len(msg.FunctionName) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "empty function name")
+	}
+
+	if len(msg.FunctionName) > FunctionNameLengthHardLimit {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"function name length over hard limit %d",
+			FunctionNameLengthHardLimit,
+		)
+	}
+
+	return nil
+}
+
+func validateModuleName(moduleName string) error {
+	if len(moduleName) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "empty module name")
+	}
+
+	if len(moduleName) > ModuleNameLengthHardLimit {
+		return errors.Wrapf(
+			sdkerrors.ErrInvalidRequest,
+			"module name length over hard limit %d",
+			ModuleNameLengthHardLimit,
+		)
+	}
+
+	return nil
+}
@@ -8,6 +8,8 @@ import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -1156,1625 +1158,1878 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgPublish)(nil), "initia.move.v1.MsgPublish")
-	proto.RegisterType((*MsgPublishResponse)(nil), "initia.move.v1.MsgPublishResponse")
-	proto.RegisterType((*MsgExecute)(nil), "initia.move.v1.MsgExecute")
-	proto.RegisterType((*MsgExecuteResponse)(nil), "initia.move.v1.MsgExecuteResponse")
-	proto.RegisterType((*MsgExecuteJSON)(nil), "initia.move.v1.MsgExecuteJSON")
-	proto.RegisterType((*MsgExecuteJSONResponse)(nil), "initia.move.v1.MsgExecuteJSONResponse")
-	proto.RegisterType((*MsgScript)(nil), "initia.move.v1.MsgScript")
-	proto.RegisterType((*MsgScriptResponse)(nil), "initia.move.v1.MsgScriptResponse")
-	proto.RegisterType((*MsgScriptJSON)(nil), "initia.move.v1.MsgScriptJSON")
-	proto.RegisterType((*MsgScriptJSONResponse)(nil), "initia.move.v1.MsgScriptJSONResponse")
-	proto.RegisterType((*MsgGovPublish)(nil), "initia.move.v1.MsgGovPublish")
-	proto.RegisterType((*MsgGovPublishResponse)(nil), "initia.move.v1.MsgGovPublishResponse")
-	proto.RegisterType((*MsgGovExecute)(nil), "initia.move.v1.MsgGovExecute")
-	proto.RegisterType((*MsgGovExecuteResponse)(nil), "initia.move.v1.MsgGovExecuteResponse")
-	proto.RegisterType((*MsgGovExecuteJSON)(nil), "initia.move.v1.MsgGovExecuteJSON")
-	proto.RegisterType((*MsgGovExecuteJSONResponse)(nil), "initia.move.v1.MsgGovExecuteJSONResponse")
-	proto.RegisterType((*MsgGovScript)(nil), "initia.move.v1.MsgGovScript")
-	proto.RegisterType((*MsgGovScriptResponse)(nil), "initia.move.v1.MsgGovScriptResponse")
-	proto.RegisterType((*MsgGovScriptJSON)(nil), "initia.move.v1.MsgGovScriptJSON")
-	proto.RegisterType((*MsgGovScriptJSONResponse)(nil), "initia.move.v1.MsgGovScriptJSONResponse")
-	proto.RegisterType((*MsgWhitelist)(nil), "initia.move.v1.MsgWhitelist")
-	proto.RegisterType((*MsgWhitelistResponse)(nil), "initia.move.v1.MsgWhitelistResponse")
-	proto.RegisterType((*MsgDelist)(nil), "initia.move.v1.MsgDelist")
-	proto.RegisterType((*MsgDelistResponse)(nil), "initia.move.v1.MsgDelistResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "initia.move.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.move.v1.MsgUpdateParamsResponse")
+// MsgSetRevenueRecipient is the message to register the recipient
+// of the contract shared revenue of a module
+type MsgSetRevenueRecipient struct {
+	// Sender is the module publisher, which is the module address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ModuleName is the name of the module
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// Recipient is the account or the object address to receive the revenue
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func init() { proto.RegisterFile("initia/move/v1/tx.proto", fileDescriptor_0fc2f2cef57f41a3) }
-
-var fileDescriptor_0fc2f2cef57f41a3 = []byte{
-	// 1153 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x58, 0xcf, 0x6f, 0x1b, 0x45,
-	0x14, 0xce, 0xda, 0x8e, 0x5b, 0xbf, 0xc4, 0x2e, 0xd9, 0xa6, 0x89, 0xb3, 0x21, 0x76, 0xea, 0x34,
-	0xd4, 0x8d, 0x54, 0x2f, 0x0d, 0x12, 0x12, 0xbd, 0xd5, 0x0a, 0x50, 0xaa, 0xa4, 0x8d, 0x36, 0x8a,
-	0x8a, 0x40, 0xc2, 0xda, 0x78, 0x87, 0xf5, 0x0a, 0xaf, 0x77, 0xb5, 0xb3, 0x4e, 0x93, 0x1b, 0x42,
-	0xe2, 0x02, 0x17, 0xce, 0xfc, 0x05, 0x5c, 0x2a, 0xe5, 0x00, 0xd7, 0x1e, 0x51, 0xc4, 0xa9, 0xe2,
-	0x84, 0x38, 0x44, 0x90, 0x1c, 0x72, 0xe3, 0xc8, 0x0d, 0x09, 0xed, 0xcc, 0xec, 0xec, 0x4f, 0xdb,
-	0x95, 0x15, 0x05, 0x89, 0x5e, 0x22, 0xcf, 0xfb, 0xe5, 0xf7, 0x7d, 0xf3, 0xe6, 0x9b, 0x89, 0x61,
-	0xde, 0xe8, 0x19, 0xae, 0xa1, 0xca, 0xa6, 0xb5, 0x8f, 0xe4, 0xfd, 0x7b, 0xb2, 0x7b, 0xd0, 0xb0,
-	0x1d, 0xcb, 0xb5, 0xc4, 0x12, 0x75, 0x34, 0x3c, 0x47, 0x63, 0xff, 0x9e, 0x34, 0xa3, 0x9a, 0x46,
-	0xcf, 0x92, 0xc9, 0x5f, 0x1a, 0x22, 0xcd, 0xb7, 0x2d, 0x6c, 0x5a, 0x58, 0x36, 0xb1, 0xee, 0xa5,
-	0x9a, 0x58, 0x67, 0x8e, 0x05, 0xea, 0x68, 0x91, 0x95, 0x4c, 0x17, 0xcc, 0x35, 0xab, 0x5b, 0xba,
-	0x45, 0xed, 0xde, 0x27, 0x66, 0x95, 0xe2, 0x5d, 0x1c, 0xda, 0x88, 0x65, 0xd4, 0x7e, 0x11, 0x00,
-	0xb6, 0xb0, 0xbe, 0xdd, 0xdf, 0xeb, 0x1a, 0xb8, 0x23, 0xbe, 0x0d, 0x79, 0x8c, 0x7a, 0x1a, 0x72,
-	0xca, 0xc2, 0xb2, 0x50, 0x2f, 0x34, 0xcb, 0xbf, 0xfe, 0x78, 0x77, 0x96, 0x7d, 0xc5, 0x03, 0x4d,
-	0x73, 0x10, 0xc6, 0x3b, 0xae, 0x63, 0xf4, 0x74, 0x85, 0xc5, 0x89, 0x4b, 0x00, 0x6d, 0x4b, 0x43,
-	0xad, 0xbd, 0x43, 0x17, 0xe1, 0x72, 0x66, 0x39, 0x5b, 0x9f, 0x56, 0x0a, 0x9e, 0xa5, 0xe9, 0x19,
-	0xc4, 0x47, 0x50, 0xea, 0xdb, 0xba, 0xa3, 0x6a, 0xa8, 0x65, 0x5b, 0x5d, 0xa3, 0x7d, 0x58, 0xce,
-	0x2e, 0x0b, 0xf5, 0xd2, 0xfa, 0x52, 0x23, 0xca, 0x40, 0x63, 0x97, 0x46, 0x6d, 0x93, 0xa0, 0x66,
-	0xee, 0xf8, 0xa4, 0x2a, 0x28, 0xc5, 0x7e, 0xd8, 0x78, 0xbf, 0xfa, 0xd5, 0xf9, 0xd1, 0x1a, 0xfb,
-	0xde, 0x6f, 0xce, 0x8f, 0xd6, 0xae, 0x11, 0x40, 0x41, 0xf7, 0xb5, 0x59, 0x10, 0x83, 0x95, 0x82,
-	0xb0, 0x6d, 0xf5, 0x30, 0xaa, 0xfd, 0x43, 0x21, 0xbe, 0x7f, 0x80, 0xda, 0x7d, 0x17, 0x8d, 0x01,
-	0x71, 0x15, 0x4a, 0xa6, 0xa5, 0xf5, 0xbb, 0xa8, 0xa5, 0x52, 0x7f, 0x39, 0xe3, 0x65, 0x2a, 0x45,
-	0x6a, 0x65, 0x49, 0x62, 0x15, 0xa6, 0x58, 0x58, 0x4f, 0x35, 0x11, 0xc1, 0x59, 0x50, 0x80, 0x9a,
-	0x1e, 0xab, 0x26, 0x12, 0x57, 0xa0, 0xf8, 0x79, 0xbf, 0xd7, 0x76, 0x0d, 0xab, 0x47, 0x43, 0x72,
-	0x24, 0x64, 0xda, 0x37, 0x92, 0xa0, 0x45, 0x28, 0x78, 0xfb, 0xd3, 0x52, 0x1d, 0x1d, 0x97, 0x27,
-	0x97, 0xb3, 0xf5, 0x82, 0x72, 0xd5, 0x33, 0x3c, 0x70, 0x74, 0x2c, 0x8a, 0x90, 0x23, 0xf6, 0x3c,
-	0xa1, 0x99, 0x7c, 0x1e, 0xcc, 0x0a, 0x03, 0xcc, 0x58, 0x61, 0x2b, 0xce, 0xca, 0xd7, 0x19, 0x28,
-	0x05, 0xe6, 0x47, 0x3b, 0x4f, 0x1e, 0xff, 0x6f, 0x98, 0x29, 0x30, 0x66, 0x56, 0x62, 0xcc, 0x5c,
-	0x8f, 0x31, 0xe3, 0x81, 0xae, 0x95, 0x61, 0x2e, 0x6a, 0xe1, 0x0c, 0x3d, 0x17, 0xa0, 0xb0, 0x85,
-	0xf5, 0x9d, 0xb6, 0x63, 0xd8, 0xee, 0x05, 0x9c, 0x0c, 0x21, 0x7a, 0x32, 0x22, 0x70, 0xb2, 0x03,
-	0xe0, 0xe4, 0x42, 0x1b, 0x5d, 0x89, 0xc1, 0x29, 0xf9, 0x70, 0x68, 0x87, 0xb5, 0xeb, 0x30, 0xc3,
-	0x17, 0x1c, 0xc4, 0x4f, 0x02, 0x14, 0xb9, 0x75, 0xcc, 0x5d, 0xbe, 0x28, 0x20, 0xfe, 0xbe, 0xd4,
-	0x62, 0x40, 0xc4, 0x28, 0x10, 0xb2, 0x2d, 0xf3, 0x70, 0x23, 0x62, 0xe0, 0x80, 0xbe, 0xcd, 0x10,
-	0x40, 0x1f, 0x5a, 0xfb, 0xbe, 0x66, 0xbd, 0x0b, 0x05, 0xb5, 0xef, 0x76, 0x2c, 0xc7, 0x70, 0x0f,
-	0x47, 0x62, 0x0a, 0x42, 0x43, 0x44, 0x64, 0xc6, 0x22, 0x22, 0x3b, 0x5a, 0xeb, 0x72, 0x63, 0x6b,
-	0xdd, 0xaa, 0xc7, 0x51, 0xd0, 0x6c, 0x84, 0xa6, 0x00, 0x3b, 0xa3, 0x29, 0x30, 0x70, 0x9a, 0x5e,
-	0x70, 0x9a, 0x7c, 0xdd, 0xbb, 0x3c, 0x9a, 0x92, 0xaa, 0x90, 0x7d, 0x05, 0x55, 0xc8, 0x8d, 0x56,
-	0x85, 0xc9, 0x51, 0xaa, 0x90, 0x1f, 0x30, 0x7d, 0x57, 0x42, 0xc7, 0x68, 0x04, 0xb3, 0xbe, 0x6a,
-	0x72, 0x66, 0xe3, 0xc2, 0xf9, 0x73, 0x86, 0x9c, 0xb3, 0xc0, 0x43, 0x4e, 0xd5, 0xeb, 0xce, 0xae,
-	0x7f, 0xb6, 0xef, 0x24, 0xd9, 0x9d, 0x4b, 0xb2, 0x4b, 0x8e, 0xf8, 0x22, 0x2c, 0x24, 0x8c, 0x9c,
-	0xe5, 0xbf, 0x04, 0x98, 0xa6, 0x5e, 0xa6, 0xbf, 0xff, 0xdd, 0x29, 0x1f, 0x26, 0x77, 0xb9, 0x01,
-	0x94, 0x4c, 0x86, 0x06, 0xee, 0x56, 0x92, 0x92, 0x99, 0x10, 0x25, 0x4c, 0xbd, 0xe7, 0x60, 0x36,
-	0xbc, 0xe6, 0x44, 0xfc, 0x2d, 0xc0, 0x1b, 0x61, 0xc7, 0x25, 0x4f, 0xdb, 0x45, 0x91, 0xe1, 0xcf,
-	0x47, 0x3d, 0x49, 0xc6, 0x8d, 0x04, 0x19, 0x64, 0x3c, 0x24, 0x28, 0xc7, 0x6d, 0x9c, 0x94, 0x73,
-	0x3a, 0x1d, 0x4f, 0x3b, 0x86, 0x8b, 0xba, 0x06, 0x1e, 0x7f, 0x3a, 0x64, 0x98, 0x32, 0x91, 0xab,
-	0x6a, 0xaa, 0xab, 0xb6, 0xba, 0x36, 0x63, 0xa5, 0x74, 0x7a, 0x52, 0x85, 0x2d, 0x66, 0xde, 0xdc,
-	0x56, 0xc0, 0x0f, 0xd9, 0xb4, 0xc5, 0x87, 0x50, 0x74, 0xd0, 0x33, 0xd5, 0xd1, 0x5a, 0xcf, 0x90,
-	0xa1, 0x77, 0x5c, 0x7a, 0xf8, 0x9a, 0x2b, 0xc7, 0x27, 0xd5, 0x89, 0xdf, 0x4f, 0xaa, 0x8b, 0xf4,
-	0x0b, 0xb1, 0xf6, 0x45, 0xc3, 0xb0, 0x64, 0x53, 0x75, 0x3b, 0x8d, 0x4d, 0xa4, 0xab, 0xed, 0xc3,
-	0x0d, 0xd4, 0x56, 0xa6, 0x69, 0xe6, 0x53, 0x92, 0x38, 0x74, 0x2c, 0x38, 0x30, 0x36, 0x16, 0x7c,
-	0xcd, 0x19, 0xf8, 0x9e, 0x3e, 0x4e, 0x36, 0x2e, 0x17, 0xfe, 0x2b, 0x36, 0x4d, 0x5f, 0x22, 0x1b,
-	0xd1, 0x8e, 0x9f, 0x0b, 0x70, 0x6d, 0x0b, 0xeb, 0xbb, 0xb6, 0xa6, 0xba, 0x68, 0x5b, 0x75, 0x54,
-	0x13, 0x8f, 0xdd, 0xf7, 0x7b, 0x90, 0xb7, 0x49, 0x05, 0xd2, 0xf2, 0xd4, 0xfa, 0x5c, 0xfc, 0x86,
-	0xa5, 0xf5, 0x9b, 0x05, 0x6f, 0x5b, 0x7e, 0x38, 0x3f, 0x5a, 0x13, 0x14, 0x96, 0x70, 0xff, 0x76,
-	0x12, 0xc1, 0xac, 0x8f, 0x20, 0xdc, 0x5b, 0x6d, 0x01, 0xe6, 0x63, 0x26, 0x1f, 0xca, 0xfa, 0x8b,
-	0xab, 0x90, 0xdd, 0xc2, 0xba, 0xf8, 0x11, 0x5c, 0xf1, 0x1f, 0x21, 0x52, 0xbc, 0x83, 0xe0, 0x1f,
-	0x11, 0xa9, 0x36, 0xd8, 0xe7, 0x97, 0xf4, 0x4a, 0xf9, 0x17, 0x75, 0x5a, 0x29, 0xe6, 0x4b, 0x2d,
-	0x15, 0xbb, 0xa0, 0xc4, 0x5d, 0x98, 0x0a, 0xdf, 0x4c, 0x95, 0xc1, 0x29, 0x9e, 0x5f, 0x7a, 0x6b,
-	0xb8, 0x9f, 0x97, 0xfd, 0x00, 0xf2, 0x4c, 0x8a, 0x17, 0x52, 0x32, 0xa8, 0x4b, 0xba, 0x39, 0xd0,
-	0xc5, 0xeb, 0x28, 0x00, 0x21, 0x25, 0x5b, 0x1a, 0x98, 0x40, 0x9a, 0x5b, 0x1d, 0xea, 0x0e, 0xd7,
-	0x0c, 0x3d, 0x08, 0xd3, 0x6a, 0x06, 0xee, 0xd4, 0x9a, 0xc9, 0x17, 0x14, 0xab, 0xe9, 0x6f, 0xca,
-	0x80, 0x9a, 0xfe, 0xbe, 0xac, 0x0e, 0x75, 0xf3, 0x9a, 0x9f, 0x41, 0x29, 0xf6, 0x6e, 0xb8, 0x39,
-	0x34, 0x91, 0x70, 0x70, 0x67, 0x64, 0x08, 0xaf, 0xff, 0x04, 0x0a, 0xc1, 0x8d, 0xf9, 0x66, 0x7a,
-	0x1e, 0xdb, 0xa9, 0x5b, 0xc3, 0xbc, 0xbc, 0xe0, 0xa7, 0x50, 0x8c, 0xde, 0x3c, 0xcb, 0xc3, 0xd2,
-	0x48, 0xbb, 0xf5, 0x51, 0x11, 0xe1, 0x6e, 0x03, 0x05, 0x4f, 0xeb, 0x96, 0x7b, 0x53, 0xbb, 0x4d,
-	0x88, 0xa2, 0x37, 0xa2, 0x4c, 0x10, 0xd3, 0x46, 0x94, 0xba, 0x52, 0x47, 0x34, 0x2a, 0x55, 0xe2,
-	0xc7, 0x30, 0x1d, 0x91, 0xa9, 0x6a, 0x4a, 0x4a, 0x38, 0x40, 0xba, 0x3d, 0x22, 0xc0, 0xaf, 0x2c,
-	0x4d, 0x7e, 0xe9, 0x89, 0x51, 0xf3, 0xe1, 0xf1, 0x9f, 0x95, 0x89, 0xe3, 0xd3, 0x8a, 0xf0, 0xf2,
-	0xb4, 0x22, 0xfc, 0x71, 0x5a, 0x11, 0xbe, 0x3b, 0xab, 0x4c, 0xbc, 0x3c, 0xab, 0x4c, 0xfc, 0x76,
-	0x56, 0x99, 0xf8, 0x64, 0x4d, 0x37, 0xdc, 0x4e, 0x7f, 0xaf, 0xd1, 0xb6, 0x4c, 0x99, 0xd6, 0xbd,
-	0xdb, 0x55, 0xf7, 0x30, 0xfb, 0x2c, 0x1f, 0xd0, 0x1f, 0x72, 0xc8, 0xaf, 0x38, 0x7b, 0x79, 0xf2,
-	0x33, 0xce, 0x3b, 0xff, 0x06, 0x00, 0x00, 0xff, 0xff, 0x99, 0x66, 0xd8, 0x27, 0x6a, 0x12, 0x00,
-	0x00,
+func (m *MsgSetRevenueRecipient) Reset()         { *m = MsgSetRevenueRecipient{} }
+func (m *MsgSetRevenueRecipient) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRevenueRecipient) ProtoMessage()    {}
+func (*MsgSetRevenueRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{26}
+}
+func (m *MsgSetRevenueRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetRevenueRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRevenueRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetRevenueRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRevenueRecipient.Merge(m, src)
+}
+func (m *MsgSetRevenueRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetRevenueRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRevenueRecipient.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgSetRevenueRecipient proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Publish stores compiled Move module
-	Publish(ctx context.Context, in *MsgPublish, opts ...grpc.CallOption) (*MsgPublishResponse, error)
-	// Deprecated: Use ExecuteJSON instead
-	// Execute runs a entry function with the given message
-	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
-	// ExecuteJSON runs a entry function with the given message
-	ExecuteJSON(ctx context.Context, in *MsgExecuteJSON, opts ...grpc.CallOption) (*MsgExecuteJSONResponse, error)
-	// Deprecated: Use ScriptJSON instead
-	// Script runs a scripts with the given message
-	Script(ctx context.Context, in *MsgScript, opts ...grpc.CallOption) (*MsgScriptResponse, error)
-	// ScriptJSON runs a scripts with the given message
-	ScriptJSON(ctx context.Context, in *MsgScriptJSON, opts ...grpc.CallOption) (*MsgScriptJSONResponse, error)
-	// GovPublish stores compiled Move module via gov proposal
-	GovPublish(ctx context.Context, in *MsgGovPublish, opts ...grpc.CallOption) (*MsgGovPublishResponse, error)
-	// Deprecated: Use GovExecuteJSON instead
-	// GovExecute runs a entry function with the given message via gov proposal
-	GovExecute(ctx context.Context, in *MsgGovExecute, opts ...grpc.CallOption) (*MsgGovExecuteResponse, error)
-	// GovExecuteJSON runs a entry function with the given message via gov proposal
-	GovExecuteJSON(ctx context.Context, in *MsgGovExecuteJSON, opts ...grpc.CallOption) (*MsgGovExecuteJSONResponse, error)
-	// Deprecated: Use GovScriptJSON instead
-	// GovScript runs a scripts with the given message via gov proposal
-	GovScript(ctx context.Context, in *MsgGovScript, opts ...grpc.CallOption) (*MsgGovScriptResponse, error)
-	// GovScriptJSON runs a scripts with the given message via gov proposal
-	GovScriptJSON(ctx context.Context, in *MsgGovScriptJSON, opts ...grpc.CallOption) (*MsgGovScriptJSONResponse, error)
-	// Whitelist registers a dex pair to whitelist of various features.
-	// - whitelist from coin register operation
-	// - allow counter party denom can be used as gas fee
-	// - register lp denom as staking denom
-	Whitelist(ctx context.Context, in *MsgWhitelist, opts ...grpc.CallOption) (*MsgWhitelistResponse, error)
-	// Delist unregisters a dex pair from the whitelist.
-	Delist(ctx context.Context, in *MsgDelist, opts ...grpc.CallOption) (*MsgDelistResponse, error)
-	// UpdateParams defines an operation for updating the x/move module
-	// parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+// MsgSetRevenueRecipientResponse returns result data.
+type MsgSetRevenueRecipientResponse struct {
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgSetRevenueRecipientResponse) Reset()         { *m = MsgSetRevenueRecipientResponse{} }
+func (m *MsgSetRevenueRecipientResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetRevenueRecipientResponse) ProtoMessage()    {}
+func (*MsgSetRevenueRecipientResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{27}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgSetRevenueRecipientResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) Publish(ctx context.Context, in *MsgPublish, opts ...grpc.CallOption) (*MsgPublishResponse, error) {
-	out := new(MsgPublishResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Publish", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgSetRevenueRecipientResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetRevenueRecipientResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error) {
-	out := new(MsgExecuteResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Execute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetRevenueRecipientResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetRevenueRecipientResponse.Merge(m, src)
 }
-
-func (c *msgClient) ExecuteJSON(ctx context.Context, in *MsgExecuteJSON, opts ...grpc.CallOption) (*MsgExecuteJSONResponse, error) {
-	out := new(MsgExecuteJSONResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/ExecuteJSON", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetRevenueRecipientResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) Script(ctx context.Context, in *MsgScript, opts ...grpc.CallOption) (*MsgScriptResponse, error) {
-	out := new(MsgScriptResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Script", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgSetRevenueRecipientResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetRevenueRecipientResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) ScriptJSON(ctx context.Context, in *MsgScriptJSON, opts ...grpc.CallOption) (*MsgScriptJSONResponse, error) {
-	out := new(MsgScriptJSONResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/ScriptJSON", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgSetRevenueRecipientResponse proto.InternalMessageInfo
 
-func (c *msgClient) GovPublish(ctx context.Context, in *MsgGovPublish, opts ...grpc.CallOption) (*MsgGovPublishResponse, error) {
-	out := new(MsgGovPublishResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovPublish", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+// MsgClaimRevenue is the message to transfer the accrued contract
+// shared revenue from the escrow to the recipient. Anyone can trigger
+// the claim, but the revenue is always sent to the recipient.
+type MsgClaimRevenue struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Recipient is the account or the object address to claim the revenue for,
+	// and the sender is used when it is empty.
+	Recipient string `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
-func (c *msgClient) GovExecute(ctx context.Context, in *MsgGovExecute, opts ...grpc.CallOption) (*MsgGovExecuteResponse, error) {
-	out := new(MsgGovExecuteResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovExecute", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgClaimRevenue) Reset()         { *m = MsgClaimRevenue{} }
+func (m *MsgClaimRevenue) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRevenue) ProtoMessage()    {}
+func (*MsgClaimRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{28}
 }
-
-func (c *msgClient) GovExecuteJSON(ctx context.Context, in *MsgGovExecuteJSON, opts ...grpc.CallOption) (*MsgGovExecuteJSONResponse, error) {
-	out := new(MsgGovExecuteJSONResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovExecuteJSON", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgClaimRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRevenue.Merge(m, src)
+}
+func (m *MsgClaimRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRevenue.DiscardUnknown(m)
 }
 
-func (c *msgClient) GovScript(ctx context.Context, in *MsgGovScript, opts ...grpc.CallOption) (*MsgGovScriptResponse, error) {
-	out := new(MsgGovScriptResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovScript", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgClaimRevenue proto.InternalMessageInfo
+
+// MsgClaimRevenueResponse returns result data.
+type MsgClaimRevenueResponse struct {
+	Amount github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
 }
 
-func (c *msgClient) GovScriptJSON(ctx context.Context, in *MsgGovScriptJSON, opts ...grpc.CallOption) (*MsgGovScriptJSONResponse, error) {
-	out := new(MsgGovScriptJSONResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovScriptJSON", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimRevenueResponse) Reset()         { *m = MsgClaimRevenueResponse{} }
+func (m *MsgClaimRevenueResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimRevenueResponse) ProtoMessage()    {}
+func (*MsgClaimRevenueResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{29}
+}
+func (m *MsgClaimRevenueResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimRevenueResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimRevenueResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgClaimRevenueResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimRevenueResponse.Merge(m, src)
+}
+func (m *MsgClaimRevenueResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimRevenueResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimRevenueResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) Whitelist(ctx context.Context, in *MsgWhitelist, opts ...grpc.CallOption) (*MsgWhitelistResponse, error) {
-	out := new(MsgWhitelistResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Whitelist", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgClaimRevenueResponse proto.InternalMessageInfo
+
+// MsgUpdateRevenueRatio is the message to set or remove the contract
+// shared revenue ratio override of a module
+type MsgUpdateRevenueRatio struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ModuleAddr is the address of the module deployer
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	// ModuleName is the name of the module
+	ModuleName string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// Ratio is the revenue ratio override, and the override is
+	// removed when it is empty.
+	Ratio *cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio,omitempty"`
 }
 
-func (c *msgClient) Delist(ctx context.Context, in *MsgDelist, opts ...grpc.CallOption) (*MsgDelistResponse, error) {
-	out := new(MsgDelistResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Delist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRevenueRatio) Reset()         { *m = MsgUpdateRevenueRatio{} }
+func (m *MsgUpdateRevenueRatio) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRevenueRatio) ProtoMessage()    {}
+func (*MsgUpdateRevenueRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{30}
+}
+func (m *MsgUpdateRevenueRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRevenueRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRevenueRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateRevenueRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRevenueRatio.Merge(m, src)
+}
+func (m *MsgUpdateRevenueRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRevenueRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRevenueRatio.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateRevenueRatio proto.InternalMessageInfo
+
+// MsgUpdateRevenueRatioResponse returns result data.
+type MsgUpdateRevenueRatioResponse struct {
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	// Publish stores compiled Move module
-	Publish(context.Context, *MsgPublish) (*MsgPublishResponse, error)
-	// Deprecated: Use ExecuteJSON instead
-	// Execute runs a entry function with the given message
-	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
-	// ExecuteJSON runs a entry function with the given message
-	ExecuteJSON(context.Context, *MsgExecuteJSON) (*MsgExecuteJSONResponse, error)
-	// Deprecated: Use ScriptJSON instead
-	// Script runs a scripts with the given message
-	Script(context.Context, *MsgScript) (*MsgScriptResponse, error)
-	// ScriptJSON runs a scripts with the given message
-	ScriptJSON(context.Context, *MsgScriptJSON) (*MsgScriptJSONResponse, error)
-	// GovPublish stores compiled Move module via gov proposal
-	GovPublish(context.Context, *MsgGovPublish) (*MsgGovPublishResponse, error)
-	// Deprecated: Use GovExecuteJSON instead
-	// GovExecute runs a entry function with the given message via gov proposal
-	GovExecute(context.Context, *MsgGovExecute) (*MsgGovExecuteResponse, error)
-	// GovExecuteJSON runs a entry function with the given message via gov proposal
-	GovExecuteJSON(context.Context, *MsgGovExecuteJSON) (*MsgGovExecuteJSONResponse, error)
-	// Deprecated: Use GovScriptJSON instead
-	// GovScript runs a scripts with the given message via gov proposal
-	GovScript(context.Context, *MsgGovScript) (*MsgGovScriptResponse, error)
-	// GovScriptJSON runs a scripts with the given message via gov proposal
-	GovScriptJSON(context.Context, *MsgGovScriptJSON) (*MsgGovScriptJSONResponse, error)
-	// Whitelist registers a dex pair to whitelist of various features.
-	// - whitelist from coin register operation
-	// - allow counter party denom can be used as gas fee
-	// - register lp denom as staking denom
-	Whitelist(context.Context, *MsgWhitelist) (*MsgWhitelistResponse, error)
-	// Delist unregisters a dex pair from the whitelist.
-	Delist(context.Context, *MsgDelist) (*MsgDelistResponse, error)
-	// UpdateParams defines an operation for updating the x/move module
-	// parameters.
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+func (m *MsgUpdateRevenueRatioResponse) Reset()         { *m = MsgUpdateRevenueRatioResponse{} }
+func (m *MsgUpdateRevenueRatioResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRevenueRatioResponse) ProtoMessage()    {}
+func (*MsgUpdateRevenueRatioResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{31}
+}
+func (m *MsgUpdateRevenueRatioResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRevenueRatioResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRevenueRatioResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRevenueRatioResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRevenueRatioResponse.Merge(m, src)
+}
+func (m *MsgUpdateRevenueRatioResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRevenueRatioResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRevenueRatioResponse.DiscardUnknown(m)
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+var xxx_messageInfo_MsgUpdateRevenueRatioResponse proto.InternalMessageInfo
+
+// MsgRegisterCronJob is the message to register a scheduled entry function call
+type MsgRegisterCronJob struct {
+	// Sender is the that actor that signed the messages, and
+	// the entry function is executed with the sender as a signer.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ModuleAddr is the address of the module deployer
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	// ModuleName is the name of module to execute
+	ModuleName string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// FunctionName is the name of a function to execute
+	FunctionName string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// TypeArgs is the type arguments of a function to execute
+	// ex) "0x1::BasicCoin::Initia", "bool", "u8", "u64"
+	TypeArgs []string `protobuf:"bytes,5,rep,name=type_args,json=typeArgs,proto3" json:"type_args,omitempty"`
+	// Args is the arguments of a function to execute
+	// - number: little endian
+	// - string: base64 bytes
+	Args [][]byte `protobuf:"bytes,6,rep,name=args,proto3" json:"args,omitempty"`
+	// Interval is the number of blocks between the runs
+	Interval uint64 `protobuf:"varint,7,opt,name=interval,proto3" json:"interval,omitempty"`
+	// GasLimit is the gas budget of each run
+	GasLimit uint64 `protobuf:"varint,8,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Deposit is the prepaid fee in the base denom
+	Deposit types.Coin `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit"`
 }
 
-func (*UnimplementedMsgServer) Publish(ctx context.Context, req *MsgPublish) (*MsgPublishResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+func (m *MsgRegisterCronJob) Reset()         { *m = MsgRegisterCronJob{} }
+func (m *MsgRegisterCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCronJob) ProtoMessage()    {}
+func (*MsgRegisterCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{32}
 }
-func (*UnimplementedMsgServer) Execute(ctx context.Context, req *MsgExecute) (*MsgExecuteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
+func (m *MsgRegisterCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) ExecuteJSON(ctx context.Context, req *MsgExecuteJSON) (*MsgExecuteJSONResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ExecuteJSON not implemented")
+func (m *MsgRegisterCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Script(ctx context.Context, req *MsgScript) (*MsgScriptResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Script not implemented")
+func (m *MsgRegisterCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCronJob.Merge(m, src)
 }
-func (*UnimplementedMsgServer) ScriptJSON(ctx context.Context, req *MsgScriptJSON) (*MsgScriptJSONResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ScriptJSON not implemented")
+func (m *MsgRegisterCronJob) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) GovPublish(ctx context.Context, req *MsgGovPublish) (*MsgGovPublishResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovPublish not implemented")
+func (m *MsgRegisterCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCronJob.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) GovExecute(ctx context.Context, req *MsgGovExecute) (*MsgGovExecuteResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovExecute not implemented")
+
+var xxx_messageInfo_MsgRegisterCronJob proto.InternalMessageInfo
+
+// MsgRegisterCronJobResponse returns result data.
+type MsgRegisterCronJobResponse struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
-func (*UnimplementedMsgServer) GovExecuteJSON(ctx context.Context, req *MsgGovExecuteJSON) (*MsgGovExecuteJSONResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovExecuteJSON not implemented")
+
+func (m *MsgRegisterCronJobResponse) Reset()         { *m = MsgRegisterCronJobResponse{} }
+func (m *MsgRegisterCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterCronJobResponse) ProtoMessage()    {}
+func (*MsgRegisterCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{33}
 }
-func (*UnimplementedMsgServer) GovScript(ctx context.Context, req *MsgGovScript) (*MsgGovScriptResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovScript not implemented")
+func (m *MsgRegisterCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) GovScriptJSON(ctx context.Context, req *MsgGovScriptJSON) (*MsgGovScriptJSONResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GovScriptJSON not implemented")
+func (m *MsgRegisterCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) Whitelist(ctx context.Context, req *MsgWhitelist) (*MsgWhitelistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Whitelist not implemented")
+func (m *MsgRegisterCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterCronJobResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) Delist(ctx context.Context, req *MsgDelist) (*MsgDelistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Delist not implemented")
+func (m *MsgRegisterCronJobResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRegisterCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterCronJobResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgRegisterCronJobResponse proto.InternalMessageInfo
+
+// MsgDepositCronJob is the message to add the prepaid fee to a cron job
+type MsgDepositCronJob struct {
+	// Sender is the that actor that signed the messages
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id is the cron job id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// Amount is the prepaid fee in the base denom
+	Amount types.Coin `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount"`
 }
 
-func _Msg_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgPublish)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Publish(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/Publish",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Publish(ctx, req.(*MsgPublish))
+func (m *MsgDepositCronJob) Reset()         { *m = MsgDepositCronJob{} }
+func (m *MsgDepositCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositCronJob) ProtoMessage()    {}
+func (*MsgDepositCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{34}
+}
+func (m *MsgDepositCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositCronJob.Merge(m, src)
+}
+func (m *MsgDepositCronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositCronJob.DiscardUnknown(m)
 }
 
-func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Execute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/Execute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Execute(ctx, req.(*MsgExecute))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDepositCronJob proto.InternalMessageInfo
+
+// MsgDepositCronJobResponse returns result data.
+type MsgDepositCronJobResponse struct {
 }
 
-func _Msg_ExecuteJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgExecuteJSON)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ExecuteJSON(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/ExecuteJSON",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ExecuteJSON(ctx, req.(*MsgExecuteJSON))
+func (m *MsgDepositCronJobResponse) Reset()         { *m = MsgDepositCronJobResponse{} }
+func (m *MsgDepositCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDepositCronJobResponse) ProtoMessage()    {}
+func (*MsgDepositCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{35}
+}
+func (m *MsgDepositCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDepositCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDepositCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDepositCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDepositCronJobResponse.Merge(m, src)
+}
+func (m *MsgDepositCronJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDepositCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDepositCronJobResponse.DiscardUnknown(m)
 }
 
-func _Msg_Script_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgScript)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Script(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/Script",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Script(ctx, req.(*MsgScript))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDepositCronJobResponse proto.InternalMessageInfo
+
+// MsgDeregisterCronJob is the message to remove a cron job
+type MsgDeregisterCronJob struct {
+	// Sender is the creator of the cron job
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Id is the cron job id
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Msg_ScriptJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgScriptJSON)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ScriptJSON(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/ScriptJSON",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ScriptJSON(ctx, req.(*MsgScriptJSON))
+func (m *MsgDeregisterCronJob) Reset()         { *m = MsgDeregisterCronJob{} }
+func (m *MsgDeregisterCronJob) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterCronJob) ProtoMessage()    {}
+func (*MsgDeregisterCronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{36}
+}
+func (m *MsgDeregisterCronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterCronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterCronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterCronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterCronJob.Merge(m, src)
+}
+func (m *MsgDeregisterCronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterCronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterCronJob.DiscardUnknown(m)
 }
 
-func _Msg_GovPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovPublish)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovPublish(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/GovPublish",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovPublish(ctx, req.(*MsgGovPublish))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDeregisterCronJob proto.InternalMessageInfo
+
+// MsgDeregisterCronJobResponse returns result data.
+type MsgDeregisterCronJobResponse struct {
 }
 
-func _Msg_GovExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovExecute)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovExecute(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/GovExecute",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovExecute(ctx, req.(*MsgGovExecute))
+func (m *MsgDeregisterCronJobResponse) Reset()         { *m = MsgDeregisterCronJobResponse{} }
+func (m *MsgDeregisterCronJobResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgDeregisterCronJobResponse) ProtoMessage()    {}
+func (*MsgDeregisterCronJobResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{37}
+}
+func (m *MsgDeregisterCronJobResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgDeregisterCronJobResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgDeregisterCronJobResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgDeregisterCronJobResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgDeregisterCronJobResponse.Merge(m, src)
+}
+func (m *MsgDeregisterCronJobResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgDeregisterCronJobResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgDeregisterCronJobResponse.DiscardUnknown(m)
 }
 
-func _Msg_GovExecuteJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovExecuteJSON)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovExecuteJSON(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/GovExecuteJSON",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovExecuteJSON(ctx, req.(*MsgGovExecuteJSON))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgDeregisterCronJobResponse proto.InternalMessageInfo
+
+// MsgSetPublishNamespace is the message to set or remove
+// the module name prefixes which an address can publish
+type MsgSetPublishNamespace struct {
+	// authority is the address that controls the module
+	// (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Address is the publisher address
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Prefixes is the allowed module name prefixes of the publisher,
+	// and the namespace is removed when it is empty.
+	Prefixes []string `protobuf:"bytes,3,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
 }
 
-func _Msg_GovScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovScript)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovScript(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/GovScript",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovScript(ctx, req.(*MsgGovScript))
+func (m *MsgSetPublishNamespace) Reset()         { *m = MsgSetPublishNamespace{} }
+func (m *MsgSetPublishNamespace) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPublishNamespace) ProtoMessage()    {}
+func (*MsgSetPublishNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{38}
+}
+func (m *MsgSetPublishNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPublishNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPublishNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetPublishNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPublishNamespace.Merge(m, src)
+}
+func (m *MsgSetPublishNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPublishNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPublishNamespace.DiscardUnknown(m)
 }
 
-func _Msg_GovScriptJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgGovScriptJSON)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).GovScriptJSON(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/GovScriptJSON",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).GovScriptJSON(ctx, req.(*MsgGovScriptJSON))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetPublishNamespace proto.InternalMessageInfo
+
+// MsgSetPublishNamespaceResponse returns result data.
+type MsgSetPublishNamespaceResponse struct {
 }
 
-func _Msg_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWhitelist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Whitelist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/Whitelist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Whitelist(ctx, req.(*MsgWhitelist))
+func (m *MsgSetPublishNamespaceResponse) Reset()         { *m = MsgSetPublishNamespaceResponse{} }
+func (m *MsgSetPublishNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetPublishNamespaceResponse) ProtoMessage()    {}
+func (*MsgSetPublishNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{39}
+}
+func (m *MsgSetPublishNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetPublishNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetPublishNamespaceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgSetPublishNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetPublishNamespaceResponse.Merge(m, src)
+}
+func (m *MsgSetPublishNamespaceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetPublishNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetPublishNamespaceResponse.DiscardUnknown(m)
 }
 
-func _Msg_Delist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDelist)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).Delist(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/Delist",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).Delist(ctx, req.(*MsgDelist))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_MsgSetPublishNamespaceResponse proto.InternalMessageInfo
+
+// MsgSetAuthenticator is the message to set or remove the move view
+// function which authenticates the signatures of the sender
+type MsgSetAuthenticator struct {
+	// Sender is the account to authenticate
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// ModuleAddr is the address of the module deployer, and
+	// the authenticator is removed when it is empty.
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	// ModuleName is the name of the authenticator module
+	ModuleName string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// FunctionName is the name of the authenticator view function
+	FunctionName string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/initia.move.v1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgSetAuthenticator) Reset()         { *m = MsgSetAuthenticator{} }
+func (m *MsgSetAuthenticator) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAuthenticator) ProtoMessage()    {}
+func (*MsgSetAuthenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{40}
+}
+func (m *MsgSetAuthenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAuthenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAuthenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
 }
-
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "initia.move.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Publish",
-			Handler:    _Msg_Publish_Handler,
-		},
-		{
-			MethodName: "Execute",
-			Handler:    _Msg_Execute_Handler,
-		},
-		{
-			MethodName: "ExecuteJSON",
-			Handler:    _Msg_ExecuteJSON_Handler,
-		},
-		{
-			MethodName: "Script",
-			Handler:    _Msg_Script_Handler,
-		},
-		{
-			MethodName: "ScriptJSON",
-			Handler:    _Msg_ScriptJSON_Handler,
-		},
-		{
-			MethodName: "GovPublish",
-			Handler:    _Msg_GovPublish_Handler,
-		},
-		{
-			MethodName: "GovExecute",
-			Handler:    _Msg_GovExecute_Handler,
-		},
-		{
-			MethodName: "GovExecuteJSON",
-			Handler:    _Msg_GovExecuteJSON_Handler,
-		},
-		{
-			MethodName: "GovScript",
-			Handler:    _Msg_GovScript_Handler,
-		},
-		{
-			MethodName: "GovScriptJSON",
-			Handler:    _Msg_GovScriptJSON_Handler,
-		},
-		{
-			MethodName: "Whitelist",
-			Handler:    _Msg_Whitelist_Handler,
-		},
-		{
-			MethodName: "Delist",
-			Handler:    _Msg_Delist_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "initia/move/v1/tx.proto",
+func (m *MsgSetAuthenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAuthenticator.Merge(m, src)
+}
+func (m *MsgSetAuthenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetAuthenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAuthenticator.DiscardUnknown(m)
 }
 
-func (m *MsgPublish) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_MsgSetAuthenticator proto.InternalMessageInfo
+
+// MsgSetAuthenticatorResponse returns result data.
+type MsgSetAuthenticatorResponse struct {
 }
 
-func (m *MsgPublish) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAuthenticatorResponse) Reset()         { *m = MsgSetAuthenticatorResponse{} }
+func (m *MsgSetAuthenticatorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetAuthenticatorResponse) ProtoMessage()    {}
+func (*MsgSetAuthenticatorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{41}
 }
-
-func (m *MsgPublish) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpgradePolicy != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpgradePolicy))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.CodeBytes) > 0 {
-		for iNdEx := len(m.CodeBytes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CodeBytes[iNdEx])
-			copy(dAtA[i:], m.CodeBytes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes[iNdEx])))
-			i--
-			dAtA[i] = 0x12
+func (m *MsgSetAuthenticatorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetAuthenticatorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetAuthenticatorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgPublishResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetAuthenticatorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetAuthenticatorResponse.Merge(m, src)
 }
-
-func (m *MsgPublishResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetAuthenticatorResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgPublishResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetAuthenticatorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetAuthenticatorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgExecute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetAuthenticatorResponse proto.InternalMessageInfo
 
-func (m *MsgExecute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetSponsor is the message to set or remove the move view function
+// which decides whether the sponsor pays the fee of a tx
+type MsgSetSponsor struct {
+	// Sender is the sponsor account or the owner of the sponsor object
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// Sponsor is the account or object address which pays the fees
+	Sponsor string `protobuf:"bytes,2,opt,name=sponsor,proto3" json:"sponsor,omitempty"`
+	// ModuleAddr is the address of the module deployer, and
+	// the sponsor policy is removed when it is empty.
+	ModuleAddress string `protobuf:"bytes,3,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	// ModuleName is the name of the sponsor policy module
+	ModuleName string `protobuf:"bytes,4,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// FunctionName is the name of the sponsor policy view function
+	FunctionName string `protobuf:"bytes,5,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
 }
 
-func (m *MsgExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSetSponsor) Reset()         { *m = MsgSetSponsor{} }
+func (m *MsgSetSponsor) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsor) ProtoMessage()    {}
+func (*MsgSetSponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{42}
+}
+func (m *MsgSetSponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FunctionName) > 0 {
-		i -= len(m.FunctionName)
-		copy(dAtA[i:], m.FunctionName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgExecuteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetSponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsor.Merge(m, src)
 }
-
-func (m *MsgExecuteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSponsor) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetSponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsor.DiscardUnknown(m)
 }
 
-func (m *MsgExecuteJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetSponsor proto.InternalMessageInfo
 
-func (m *MsgExecuteJSON) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// MsgSetSponsorResponse returns result data.
+type MsgSetSponsorResponse struct {
 }
 
-func (m *MsgExecuteJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
+func (m *MsgSetSponsorResponse) Reset()         { *m = MsgSetSponsorResponse{} }
+func (m *MsgSetSponsorResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetSponsorResponse) ProtoMessage()    {}
+func (*MsgSetSponsorResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0fc2f2cef57f41a3, []int{43}
+}
+func (m *MsgSetSponsorResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetSponsorResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetSponsorResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if len(m.FunctionName) > 0 {
-		i -= len(m.FunctionName)
-		copy(dAtA[i:], m.FunctionName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
 }
-
-func (m *MsgExecuteJSONResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetSponsorResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetSponsorResponse.Merge(m, src)
 }
-
-func (m *MsgExecuteJSONResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetSponsorResponse) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *MsgExecuteJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (m *MsgSetSponsorResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetSponsorResponse.DiscardUnknown(m)
 }
 
-func (m *MsgScript) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
+var xxx_messageInfo_MsgSetSponsorResponse proto.InternalMessageInfo
 
-func (m *MsgScript) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() {
+	proto.RegisterType((*MsgPublish)(nil), "initia.move.v1.MsgPublish")
+	proto.RegisterType((*MsgPublishResponse)(nil), "initia.move.v1.MsgPublishResponse")
+	proto.RegisterType((*MsgExecute)(nil), "initia.move.v1.MsgExecute")
+	proto.RegisterType((*MsgExecuteResponse)(nil), "initia.move.v1.MsgExecuteResponse")
+	proto.RegisterType((*MsgExecuteJSON)(nil), "initia.move.v1.MsgExecuteJSON")
+	proto.RegisterType((*MsgExecuteJSONResponse)(nil), "initia.move.v1.MsgExecuteJSONResponse")
+	proto.RegisterType((*MsgScript)(nil), "initia.move.v1.MsgScript")
+	proto.RegisterType((*MsgScriptResponse)(nil), "initia.move.v1.MsgScriptResponse")
+	proto.RegisterType((*MsgScriptJSON)(nil), "initia.move.v1.MsgScriptJSON")
+	proto.RegisterType((*MsgScriptJSONResponse)(nil), "initia.move.v1.MsgScriptJSONResponse")
+	proto.RegisterType((*MsgGovPublish)(nil), "initia.move.v1.MsgGovPublish")
+	proto.RegisterType((*MsgGovPublishResponse)(nil), "initia.move.v1.MsgGovPublishResponse")
+	proto.RegisterType((*MsgGovExecute)(nil), "initia.move.v1.MsgGovExecute")
+	proto.RegisterType((*MsgGovExecuteResponse)(nil), "initia.move.v1.MsgGovExecuteResponse")
+	proto.RegisterType((*MsgGovExecuteJSON)(nil), "initia.move.v1.MsgGovExecuteJSON")
+	proto.RegisterType((*MsgGovExecuteJSONResponse)(nil), "initia.move.v1.MsgGovExecuteJSONResponse")
+	proto.RegisterType((*MsgGovScript)(nil), "initia.move.v1.MsgGovScript")
+	proto.RegisterType((*MsgGovScriptResponse)(nil), "initia.move.v1.MsgGovScriptResponse")
+	proto.RegisterType((*MsgGovScriptJSON)(nil), "initia.move.v1.MsgGovScriptJSON")
+	proto.RegisterType((*MsgGovScriptJSONResponse)(nil), "initia.move.v1.MsgGovScriptJSONResponse")
+	proto.RegisterType((*MsgWhitelist)(nil), "initia.move.v1.MsgWhitelist")
+	proto.RegisterType((*MsgWhitelistResponse)(nil), "initia.move.v1.MsgWhitelistResponse")
+	proto.RegisterType((*MsgDelist)(nil), "initia.move.v1.MsgDelist")
+	proto.RegisterType((*MsgDelistResponse)(nil), "initia.move.v1.MsgDelistResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "initia.move.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "initia.move.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetRevenueRecipient)(nil), "initia.move.v1.MsgSetRevenueRecipient")
+	proto.RegisterType((*MsgSetRevenueRecipientResponse)(nil), "initia.move.v1.MsgSetRevenueRecipientResponse")
+	proto.RegisterType((*MsgClaimRevenue)(nil), "initia.move.v1.MsgClaimRevenue")
+	proto.RegisterType((*MsgClaimRevenueResponse)(nil), "initia.move.v1.MsgClaimRevenueResponse")
+	proto.RegisterType((*MsgUpdateRevenueRatio)(nil), "initia.move.v1.MsgUpdateRevenueRatio")
+	proto.RegisterType((*MsgUpdateRevenueRatioResponse)(nil), "initia.move.v1.MsgUpdateRevenueRatioResponse")
+	proto.RegisterType((*MsgRegisterCronJob)(nil), "initia.move.v1.MsgRegisterCronJob")
+	proto.RegisterType((*MsgRegisterCronJobResponse)(nil), "initia.move.v1.MsgRegisterCronJobResponse")
+	proto.RegisterType((*MsgDepositCronJob)(nil), "initia.move.v1.MsgDepositCronJob")
+	proto.RegisterType((*MsgDepositCronJobResponse)(nil), "initia.move.v1.MsgDepositCronJobResponse")
+	proto.RegisterType((*MsgDeregisterCronJob)(nil), "initia.move.v1.MsgDeregisterCronJob")
+	proto.RegisterType((*MsgDeregisterCronJobResponse)(nil), "initia.move.v1.MsgDeregisterCronJobResponse")
+	proto.RegisterType((*MsgSetPublishNamespace)(nil), "initia.move.v1.MsgSetPublishNamespace")
+	proto.RegisterType((*MsgSetPublishNamespaceResponse)(nil), "initia.move.v1.MsgSetPublishNamespaceResponse")
+	proto.RegisterType((*MsgSetAuthenticator)(nil), "initia.move.v1.MsgSetAuthenticator")
+	proto.RegisterType((*MsgSetAuthenticatorResponse)(nil), "initia.move.v1.MsgSetAuthenticatorResponse")
+	proto.RegisterType((*MsgSetSponsor)(nil), "initia.move.v1.MsgSetSponsor")
+	proto.RegisterType((*MsgSetSponsorResponse)(nil), "initia.move.v1.MsgSetSponsorResponse")
 }
 
-func (m *MsgScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.CodeBytes) > 0 {
-		i -= len(m.CodeBytes)
-		copy(dAtA[i:], m.CodeBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func init() { proto.RegisterFile("initia/move/v1/tx.proto", fileDescriptor_0fc2f2cef57f41a3) }
+
+var fileDescriptor_0fc2f2cef57f41a3 = []byte{
+	// 1786 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe4, 0x59, 0xcf, 0x6f, 0xdb, 0xe4,
+	0x1b, 0xaf, 0x93, 0xb4, 0x5d, 0x9e, 0xb6, 0xe9, 0xea, 0x76, 0xad, 0xeb, 0xae, 0x49, 0xe6, 0xb6,
+	0x5b, 0xd6, 0xad, 0xc9, 0x77, 0xfd, 0x0a, 0xa4, 0x55, 0x08, 0x69, 0x5d, 0x81, 0x6d, 0x6a, 0xb7,
+	0xca, 0xd5, 0x34, 0x04, 0x12, 0x91, 0x93, 0xbc, 0x73, 0xcc, 0x62, 0x3b, 0xb2, 0x9d, 0xae, 0xbd,
+	0x01, 0x13, 0x20, 0xc1, 0x85, 0x33, 0x7f, 0x01, 0x42, 0x9a, 0xb4, 0x03, 0x70, 0x41, 0xe2, 0x88,
+	0x2a, 0x4e, 0x13, 0x27, 0xc4, 0xa1, 0x40, 0x77, 0xe8, 0x05, 0x71, 0xe4, 0x86, 0x84, 0xec, 0xf7,
+	0xf5, 0xeb, 0x9f, 0x49, 0x4a, 0xa8, 0x0a, 0x1a, 0x97, 0x2d, 0xef, 0xf3, 0xcb, 0xcf, 0xe7, 0xf3,
+	0x3e, 0xef, 0xe3, 0xf7, 0x71, 0x61, 0x4a, 0xd1, 0x14, 0x4b, 0x91, 0x4a, 0xaa, 0xbe, 0x8d, 0x4a,
+	0xdb, 0x57, 0x4a, 0xd6, 0x4e, 0xb1, 0x69, 0xe8, 0x96, 0xce, 0x66, 0xb0, 0xa2, 0x68, 0x2b, 0x8a,
+	0xdb, 0x57, 0xf8, 0x31, 0x49, 0x55, 0x34, 0xbd, 0xe4, 0xfc, 0x8b, 0x4d, 0xf8, 0x6c, 0x55, 0x37,
+	0x55, 0xdd, 0x2c, 0x55, 0x24, 0xd3, 0xf6, 0xad, 0x20, 0x4b, 0xba, 0x52, 0xaa, 0xea, 0x8a, 0x46,
+	0xf4, 0x53, 0x44, 0xaf, 0x9a, 0xb2, 0x1d, 0x5a, 0x35, 0x65, 0xa2, 0x98, 0xc6, 0x8a, 0xb2, 0xb3,
+	0x2a, 0xe1, 0x05, 0x51, 0x4d, 0xc8, 0xba, 0xac, 0x63, 0xb9, 0xfd, 0x8b, 0x48, 0xf9, 0x70, 0x96,
+	0xbb, 0x4d, 0x44, 0x3c, 0x84, 0xef, 0x18, 0x80, 0x0d, 0x53, 0xde, 0x6c, 0x55, 0x1a, 0x8a, 0x59,
+	0x67, 0xff, 0x07, 0x03, 0x26, 0xd2, 0x6a, 0xc8, 0xe0, 0x98, 0x3c, 0x53, 0x48, 0xaf, 0x72, 0xdf,
+	0x7f, 0xb1, 0x34, 0x41, 0x1e, 0x71, 0xad, 0x56, 0x33, 0x90, 0x69, 0x6e, 0x59, 0x86, 0xa2, 0xc9,
+	0x22, 0xb1, 0x63, 0x67, 0x01, 0xaa, 0x7a, 0x0d, 0x95, 0x2b, 0xbb, 0x16, 0x32, 0xb9, 0x44, 0x3e,
+	0x59, 0x18, 0x16, 0xd3, 0xb6, 0x64, 0xd5, 0x16, 0xb0, 0xb7, 0x20, 0xd3, 0x6a, 0xca, 0x86, 0x54,
+	0x43, 0xe5, 0xa6, 0xde, 0x50, 0xaa, 0xbb, 0x5c, 0x32, 0xcf, 0x14, 0x32, 0xcb, 0xb3, 0xc5, 0x20,
+	0x43, 0xc5, 0xbb, 0xd8, 0x6a, 0xd3, 0x31, 0x5a, 0x4d, 0xed, 0xed, 0xe7, 0x18, 0x71, 0xa4, 0xe5,
+	0x17, 0xae, 0xe4, 0xde, 0x3b, 0x7c, 0xb2, 0x48, 0x9e, 0xfb, 0xd1, 0xe1, 0x93, 0xc5, 0x51, 0x07,
+	0x90, 0x97, 0xbd, 0x30, 0x01, 0xac, 0xb7, 0x12, 0x91, 0xd9, 0xd4, 0x35, 0x13, 0x09, 0x7f, 0x60,
+	0x88, 0xaf, 0xec, 0xa0, 0x6a, 0xcb, 0x42, 0x3d, 0x40, 0x5c, 0x80, 0x8c, 0xaa, 0xd7, 0x5a, 0x0d,
+	0x54, 0x96, 0xb0, 0x9e, 0x4b, 0xd8, 0x9e, 0xe2, 0x08, 0x96, 0x12, 0x27, 0x36, 0x07, 0x43, 0xc4,
+	0x4c, 0x93, 0x54, 0xe4, 0xe0, 0x4c, 0x8b, 0x80, 0x45, 0xb7, 0x25, 0x15, 0xb1, 0x73, 0x30, 0x72,
+	0xbf, 0xa5, 0x55, 0x2d, 0x45, 0xd7, 0xb0, 0x49, 0xca, 0x31, 0x19, 0x76, 0x85, 0x8e, 0xd1, 0x0c,
+	0xa4, 0xed, 0xfd, 0x29, 0x4b, 0x86, 0x6c, 0x72, 0xfd, 0xf9, 0x64, 0x21, 0x2d, 0x9e, 0xb2, 0x05,
+	0xd7, 0x0c, 0xd9, 0x64, 0x59, 0x48, 0x39, 0xf2, 0x01, 0x87, 0x66, 0xe7, 0x77, 0x7b, 0x56, 0x08,
+	0x60, 0xc2, 0x0a, 0x59, 0x51, 0x56, 0xde, 0x4f, 0x40, 0xc6, 0x13, 0xdf, 0xda, 0xba, 0x73, 0xfb,
+	0xb9, 0x61, 0x26, 0x4d, 0x98, 0x99, 0x0b, 0x31, 0x33, 0x1e, 0x62, 0xc6, 0x06, 0x2d, 0x70, 0x30,
+	0x19, 0x94, 0x50, 0x86, 0x1e, 0x33, 0x90, 0xde, 0x30, 0xe5, 0xad, 0xaa, 0xa1, 0x34, 0xad, 0x63,
+	0x38, 0x19, 0x4c, 0xf0, 0x64, 0x04, 0xe0, 0x24, 0xdb, 0xc0, 0x49, 0xf9, 0x36, 0x3a, 0x1b, 0x82,
+	0x93, 0x71, 0xe1, 0xe0, 0x0c, 0x85, 0x71, 0x18, 0xa3, 0x0b, 0x0a, 0xe2, 0x4b, 0x06, 0x46, 0xa8,
+	0xb4, 0xc7, 0x5d, 0x3e, 0x2e, 0x20, 0xee, 0xbe, 0x08, 0x21, 0x20, 0x6c, 0x10, 0x88, 0xb3, 0x2d,
+	0x53, 0x70, 0x26, 0x20, 0xa0, 0x80, 0x3e, 0x4e, 0x38, 0x80, 0x5e, 0xd3, 0xb7, 0xdd, 0x9e, 0xf5,
+	0x22, 0xa4, 0xa5, 0x96, 0x55, 0xd7, 0x0d, 0xc5, 0xda, 0xed, 0x8a, 0xc9, 0x33, 0xf5, 0x11, 0x91,
+	0xe8, 0x89, 0x88, 0x64, 0xf7, 0x5e, 0x97, 0xea, 0xb9, 0xd7, 0x2d, 0xd8, 0x1c, 0x79, 0xc9, 0x06,
+	0x68, 0xf2, 0xb0, 0x13, 0x9a, 0x3c, 0x01, 0xa5, 0xe9, 0x1b, 0x4a, 0x93, 0xdb, 0xf7, 0x4e, 0x8e,
+	0xa6, 0x68, 0x57, 0x48, 0x1e, 0xa1, 0x2b, 0xa4, 0xba, 0x77, 0x85, 0xfe, 0x6e, 0x5d, 0x61, 0xa0,
+	0x4d, 0xf5, 0x0d, 0xfa, 0x8e, 0x51, 0x17, 0x66, 0xdd, 0xae, 0x49, 0x99, 0x0d, 0x37, 0xce, 0x6f,
+	0x13, 0x30, 0x16, 0xd0, 0x38, 0xa7, 0xea, 0xbf, 0xce, 0xae, 0x7b, 0xb6, 0x2f, 0x46, 0xd9, 0x9d,
+	0x8c, 0xb2, 0xeb, 0x1c, 0xf1, 0x19, 0x98, 0x8e, 0x08, 0x29, 0xcb, 0xbf, 0x31, 0x30, 0x8c, 0xb5,
+	0xa4, 0xff, 0xfe, 0x73, 0xa7, 0xbc, 0x53, 0xbb, 0x4b, 0xb5, 0xa1, 0xa4, 0xdf, 0x57, 0x70, 0xf3,
+	0x51, 0x4a, 0xc6, 0x7c, 0x94, 0x90, 0xee, 0x3d, 0x09, 0x13, 0xfe, 0x35, 0x25, 0xe2, 0x77, 0x06,
+	0x4e, 0xfb, 0x15, 0x27, 0x5c, 0x6d, 0xc7, 0x45, 0x86, 0x5b, 0x1f, 0x85, 0x28, 0x19, 0x67, 0x22,
+	0x64, 0x38, 0xe5, 0xc1, 0x03, 0x17, 0x96, 0x51, 0x52, 0x0e, 0x71, 0x75, 0xdc, 0xab, 0x2b, 0x16,
+	0x6a, 0x28, 0x66, 0xef, 0xd5, 0x51, 0x82, 0x21, 0x15, 0x59, 0x52, 0x4d, 0xb2, 0xa4, 0x72, 0xa3,
+	0x49, 0x58, 0xc9, 0x1c, 0xec, 0xe7, 0x60, 0x83, 0x88, 0xd7, 0x37, 0x45, 0x70, 0x4d, 0xd6, 0x9b,
+	0xec, 0x0d, 0x18, 0x31, 0xd0, 0x43, 0xc9, 0xa8, 0x95, 0x1f, 0x22, 0x45, 0xae, 0x5b, 0xf8, 0xf0,
+	0xad, 0xce, 0xed, 0xed, 0xe7, 0xfa, 0x7e, 0xdc, 0xcf, 0xcd, 0xe0, 0x07, 0x9a, 0xb5, 0x07, 0x45,
+	0x45, 0x2f, 0xa9, 0x92, 0x55, 0x2f, 0xae, 0x23, 0x59, 0xaa, 0xee, 0xae, 0xa1, 0xaa, 0x38, 0x8c,
+	0x3d, 0xef, 0x39, 0x8e, 0x1d, 0xcb, 0x82, 0x02, 0x23, 0x65, 0x41, 0xd7, 0x94, 0x81, 0x4f, 0xf1,
+	0xe5, 0x64, 0xed, 0x64, 0xe1, 0x1f, 0x31, 0x69, 0x7c, 0x13, 0x59, 0x0b, 0x66, 0xfc, 0x98, 0x81,
+	0xd1, 0x0d, 0x53, 0xbe, 0xdb, 0xac, 0x49, 0x16, 0xda, 0x94, 0x0c, 0x49, 0x35, 0x7b, 0xce, 0xfb,
+	0x2a, 0x0c, 0x34, 0x9d, 0x08, 0x4e, 0xca, 0x43, 0xcb, 0x93, 0xe1, 0x37, 0x2c, 0x8e, 0xbf, 0x9a,
+	0xb6, 0xb7, 0xe5, 0xb3, 0xc3, 0x27, 0x8b, 0x8c, 0x48, 0x1c, 0x56, 0x2e, 0x44, 0x11, 0x4c, 0xb8,
+	0x08, 0xfc, 0xb9, 0x09, 0xd3, 0x30, 0x15, 0x12, 0x51, 0x28, 0x5f, 0x31, 0xce, 0xa5, 0x71, 0x0b,
+	0x59, 0x22, 0xda, 0x46, 0x5a, 0x0b, 0x89, 0xa8, 0xaa, 0x34, 0x15, 0xa4, 0xf5, 0x72, 0x4d, 0x0c,
+	0x35, 0xea, 0x44, 0xa4, 0x51, 0x9f, 0x85, 0xb4, 0xe1, 0xc6, 0x27, 0xbd, 0xde, 0x13, 0xac, 0x5c,
+	0x0a, 0x5d, 0xa6, 0x66, 0xe8, 0x65, 0x2a, 0x9a, 0x9d, 0x90, 0x87, 0x6c, 0xbc, 0x86, 0x42, 0xfb,
+	0x10, 0xef, 0xd2, 0xf5, 0x86, 0xa4, 0xa8, 0xc4, 0xa8, 0x07, 0x4c, 0x81, 0x94, 0x13, 0xe1, 0x94,
+	0xe7, 0x43, 0x29, 0x53, 0xfe, 0xfd, 0x4f, 0x15, 0x1e, 0x31, 0x30, 0x15, 0x92, 0xb9, 0x59, 0xb2,
+	0x75, 0x18, 0x90, 0x54, 0xbd, 0xa5, 0x59, 0x1c, 0x93, 0x4f, 0x16, 0x86, 0x96, 0xa7, 0x8b, 0x24,
+	0x1d, 0x7b, 0x98, 0x2e, 0x92, 0x61, 0xba, 0x78, 0x5d, 0x57, 0xb4, 0xd5, 0x17, 0xec, 0x12, 0xf8,
+	0xfc, 0xa7, 0x5c, 0x41, 0x56, 0xac, 0x7a, 0xab, 0x52, 0xac, 0xea, 0x2a, 0x99, 0x99, 0xc9, 0x7f,
+	0x4b, 0x66, 0xed, 0x01, 0x19, 0x89, 0x6d, 0x07, 0x93, 0x94, 0x0b, 0x8e, 0x2f, 0xbc, 0x9b, 0x80,
+	0x33, 0xb4, 0x0c, 0xdc, 0x34, 0x24, 0x4b, 0xd1, 0x7b, 0xae, 0xdd, 0xe3, 0x9a, 0x99, 0xae, 0x42,
+	0xbf, 0x61, 0x27, 0xc2, 0xa5, 0x68, 0x07, 0x62, 0xba, 0x75, 0x20, 0xec, 0xb1, 0xb2, 0x14, 0x3d,
+	0x03, 0x7c, 0xf0, 0x0c, 0xf8, 0x91, 0x0a, 0x39, 0x98, 0x8d, 0x55, 0xd0, 0xa2, 0xf9, 0x20, 0xe9,
+	0x8c, 0x98, 0x22, 0x92, 0x15, 0xd3, 0x42, 0xc6, 0x75, 0x43, 0xd7, 0x6e, 0xe9, 0x95, 0xe7, 0x6d,
+	0xd2, 0x66, 0x79, 0x38, 0xa5, 0x68, 0x16, 0x32, 0xb6, 0xa5, 0x06, 0x37, 0x98, 0x67, 0x0a, 0x29,
+	0x91, 0xae, 0xed, 0x60, 0xb2, 0x64, 0x96, 0x1b, 0x8a, 0xaa, 0x58, 0xdc, 0x29, 0xac, 0x94, 0x25,
+	0x73, 0xdd, 0x5e, 0xb3, 0x2f, 0xc3, 0x60, 0x0d, 0x35, 0x75, 0x53, 0xb1, 0xb8, 0x74, 0x9e, 0xe9,
+	0x5c, 0xaf, 0xbe, 0x96, 0xe5, 0x3a, 0xe1, 0x9e, 0xe5, 0x3b, 0x30, 0x53, 0xee, 0x66, 0x85, 0x18,
+	0x17, 0x2e, 0x03, 0x1f, 0x95, 0xd2, 0x53, 0x93, 0x81, 0x84, 0x52, 0x73, 0xf6, 0x22, 0x25, 0x26,
+	0x94, 0x9a, 0xf0, 0x35, 0x43, 0xfa, 0xb4, 0xf3, 0x94, 0xde, 0x77, 0x0d, 0xc7, 0x4d, 0xb8, 0x71,
+	0xd9, 0x97, 0xe8, 0xe9, 0x4c, 0xfe, 0x05, 0xb4, 0xc4, 0x67, 0xe5, 0x7c, 0x08, 0x2c, 0xbd, 0x3e,
+	0x06, 0xf3, 0x24, 0xd7, 0xc7, 0xa0, 0x90, 0x56, 0xe4, 0x23, 0xc6, 0x79, 0x6f, 0xae, 0x21, 0xe3,
+	0x6f, 0xd7, 0x64, 0x08, 0x1d, 0xbe, 0xe1, 0xfa, 0xf2, 0x9b, 0xf6, 0xf2, 0x0b, 0x3d, 0x4c, 0xc8,
+	0xc2, 0xd9, 0x38, 0x79, 0xcc, 0x7b, 0x84, 0x8c, 0x6f, 0x76, 0x5d, 0x9a, 0x4d, 0xa9, 0xda, 0xfb,
+	0xb4, 0xc6, 0xc1, 0x60, 0xf0, 0xe8, 0xb8, 0x4b, 0xbb, 0x7a, 0x9b, 0x06, 0xba, 0xaf, 0xec, 0x20,
+	0x3a, 0xa5, 0xbb, 0xeb, 0x95, 0x62, 0xb4, 0x21, 0xf8, 0xdf, 0x23, 0xe1, 0xec, 0xbc, 0xf7, 0x48,
+	0x58, 0x43, 0xa1, 0xed, 0x33, 0x30, 0x8e, 0x4d, 0xae, 0xb5, 0xac, 0x3a, 0xd2, 0x2c, 0xa5, 0x2a,
+	0x59, 0xba, 0xf1, 0x2f, 0xef, 0x09, 0xf8, 0x7a, 0xea, 0xdb, 0x5c, 0xce, 0xc7, 0x42, 0x00, 0x88,
+	0x30, 0x0b, 0x33, 0x31, 0x62, 0x8a, 0xff, 0x57, 0xf2, 0xdd, 0x05, 0x59, 0x5b, 0xb6, 0xa0, 0x27,
+	0xe4, 0x1c, 0x0c, 0x9a, 0xd8, 0xd9, 0xdd, 0x4b, 0xb2, 0x3c, 0xd1, 0x19, 0xb0, 0xc3, 0xe7, 0x1a,
+	0x0a, 0xce, 0xfd, 0x5c, 0x43, 0x05, 0x2e, 0x0f, 0xcb, 0x7b, 0xa3, 0x90, 0xdc, 0x30, 0x65, 0xf6,
+	0x26, 0x0c, 0xba, 0xdf, 0x6b, 0xf8, 0xf0, 0x65, 0xcd, 0xfb, 0x66, 0xcb, 0x0b, 0xed, 0x75, 0xb4,
+	0x8d, 0xdd, 0x84, 0x41, 0xf7, 0x9b, 0x46, 0x5c, 0x28, 0xa2, 0xe3, 0x85, 0xf6, 0x3a, 0x1a, 0xea,
+	0x2e, 0x0c, 0xf9, 0x87, 0xf8, 0x6c, 0x7b, 0x17, 0x5b, 0xcf, 0x9f, 0xef, 0xac, 0xa7, 0x61, 0x5f,
+	0x85, 0x01, 0x32, 0xb5, 0x4e, 0xc7, 0x78, 0x60, 0x15, 0x7f, 0xae, 0xad, 0x8a, 0xc6, 0x11, 0x01,
+	0x7c, 0x43, 0xdf, 0x6c, 0x5b, 0x07, 0x27, 0xb9, 0x85, 0x8e, 0x6a, 0x7f, 0x4c, 0xdf, 0xb7, 0xb3,
+	0xb8, 0x98, 0x9e, 0x9a, 0x5f, 0xe8, 0xa8, 0x0e, 0xc5, 0x74, 0x37, 0xa5, 0x4d, 0x4c, 0x77, 0x5f,
+	0x16, 0x3a, 0xaa, 0x69, 0xcc, 0xb7, 0x20, 0x13, 0xfa, 0xc4, 0x72, 0xae, 0xa3, 0xa3, 0xc3, 0xc1,
+	0xc5, 0xae, 0x26, 0x34, 0xfe, 0x1d, 0x48, 0x7b, 0x1f, 0x17, 0xce, 0xc6, 0xfb, 0x91, 0x9d, 0x9a,
+	0xef, 0xa4, 0xa5, 0x01, 0xdf, 0x84, 0x91, 0xe0, 0x90, 0x9e, 0xef, 0xe4, 0xe6, 0xa4, 0x5b, 0xe8,
+	0x66, 0xe1, 0xcf, 0xd6, 0x1b, 0x76, 0xe3, 0xb2, 0xa5, 0x5a, 0x7e, 0xbe, 0x93, 0xd6, 0x5f, 0xa2,
+	0x64, 0x76, 0x8c, 0x2b, 0x51, 0xac, 0xe2, 0xcf, 0xb5, 0x55, 0xd1, 0x38, 0xaf, 0xc3, 0x70, 0x60,
+	0xa2, 0xcb, 0xc5, 0xb8, 0xf8, 0x0d, 0xf8, 0x0b, 0x5d, 0x0c, 0x68, 0x64, 0x15, 0xc6, 0xe3, 0x06,
+	0xac, 0xb8, 0x33, 0x18, 0x63, 0xc7, 0x17, 0x8f, 0x66, 0xe7, 0x07, 0x12, 0x18, 0x7a, 0xe2, 0x80,
+	0xf8, 0x0d, 0xf8, 0x0b, 0x5d, 0x0c, 0x68, 0xe4, 0xb7, 0x81, 0x8d, 0x19, 0x1f, 0x16, 0xda, 0xf2,
+	0xe0, 0x37, 0xe3, 0x97, 0x8e, 0x64, 0x46, 0x9f, 0x25, 0xc1, 0x68, 0xf8, 0x16, 0x1e, 0xd7, 0x07,
+	0x43, 0x36, 0xfc, 0x62, 0x77, 0x1b, 0xff, 0xc1, 0x0c, 0xdd, 0x18, 0xe3, 0xcb, 0xc4, 0x6f, 0xc2,
+	0x5f, 0xec, 0x6a, 0x42, 0xe3, 0xcb, 0x30, 0x16, 0xbd, 0xb6, 0xcd, 0xc7, 0xfa, 0x87, 0xac, 0xf8,
+	0xcb, 0x47, 0xb1, 0x0a, 0x15, 0x58, 0xe4, 0xe6, 0xd5, 0xa6, 0xc0, 0xc2, 0x76, 0x7c, 0xf1, 0x68,
+	0x76, 0xf4, 0x71, 0x35, 0x38, 0x1d, 0xb9, 0x0d, 0xcd, 0xc5, 0xc7, 0x08, 0x18, 0xf1, 0x97, 0x8e,
+	0x60, 0x14, 0x78, 0x65, 0x78, 0x77, 0x8e, 0xd9, 0x78, 0x57, 0xa2, 0xe6, 0x17, 0x3a, 0xaa, 0xdd,
+	0x98, 0x7c, 0xff, 0x3b, 0xf6, 0x05, 0x7d, 0xf5, 0xc6, 0xde, 0x2f, 0xd9, 0xbe, 0xbd, 0x83, 0x2c,
+	0xf3, 0xf4, 0x20, 0xcb, 0xfc, 0x7c, 0x90, 0x65, 0x3e, 0x79, 0x96, 0xed, 0x7b, 0xfa, 0x2c, 0xdb,
+	0xf7, 0xc3, 0xb3, 0x6c, 0xdf, 0x1b, 0x8b, 0xbe, 0xf9, 0x1a, 0x47, 0x5d, 0x6a, 0x48, 0x15, 0x93,
+	0xfc, 0x2e, 0xed, 0xe0, 0xbf, 0x3e, 0x3b, 0x73, 0x76, 0x65, 0xc0, 0xf9, 0xdb, 0xf3, 0xff, 0xff,
+	0x1c, 0x00, 0x0d, 0xd4, 0xf4, 0x00, 0x3f, 0x1f, 0x00, 0x00,
 }
 
-func (m *MsgScriptResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Publish stores compiled Move module
+	Publish(ctx context.Context, in *MsgPublish, opts ...grpc.CallOption) (*MsgPublishResponse, error)
+	// Deprecated: Use ExecuteJSON instead
+	// Execute runs a entry function with the given message
+	Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error)
+	// ExecuteJSON runs a entry function with the given message
+	ExecuteJSON(ctx context.Context, in *MsgExecuteJSON, opts ...grpc.CallOption) (*MsgExecuteJSONResponse, error)
+	// Deprecated: Use ScriptJSON instead
+	// Script runs a scripts with the given message
+	Script(ctx context.Context, in *MsgScript, opts ...grpc.CallOption) (*MsgScriptResponse, error)
+	// ScriptJSON runs a scripts with the given message
+	ScriptJSON(ctx context.Context, in *MsgScriptJSON, opts ...grpc.CallOption) (*MsgScriptJSONResponse, error)
+	// GovPublish stores compiled Move module via gov proposal
+	GovPublish(ctx context.Context, in *MsgGovPublish, opts ...grpc.CallOption) (*MsgGovPublishResponse, error)
+	// Deprecated: Use GovExecuteJSON instead
+	// GovExecute runs a entry function with the given message via gov proposal
+	GovExecute(ctx context.Context, in *MsgGovExecute, opts ...grpc.CallOption) (*MsgGovExecuteResponse, error)
+	// GovExecuteJSON runs a entry function with the given message via gov proposal
+	GovExecuteJSON(ctx context.Context, in *MsgGovExecuteJSON, opts ...grpc.CallOption) (*MsgGovExecuteJSONResponse, error)
+	// Deprecated: Use GovScriptJSON instead
+	// GovScript runs a scripts with the given message via gov proposal
+	GovScript(ctx context.Context, in *MsgGovScript, opts ...grpc.CallOption) (*MsgGovScriptResponse, error)
+	// GovScriptJSON runs a scripts with the given message via gov proposal
+	GovScriptJSON(ctx context.Context, in *MsgGovScriptJSON, opts ...grpc.CallOption) (*MsgGovScriptJSONResponse, error)
+	// Whitelist registers a dex pair to whitelist of various features.
+	// - whitelist from coin register operation
+	// - allow counter party denom can be used as gas fee
+	// - register lp denom as staking denom
+	Whitelist(ctx context.Context, in *MsgWhitelist, opts ...grpc.CallOption) (*MsgWhitelistResponse, error)
+	// Delist unregisters a dex pair from the whitelist.
+	Delist(ctx context.Context, in *MsgDelist, opts ...grpc.CallOption) (*MsgDelistResponse, error)
+	// UpdateParams defines an operation for updating the x/move module
+	// parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetRevenueRecipient registers the recipient of the contract shared revenue
+	// of a module. Only the module publisher can register the recipient.
+	SetRevenueRecipient(ctx context.Context, in *MsgSetRevenueRecipient, opts ...grpc.CallOption) (*MsgSetRevenueRecipientResponse, error)
+	// ClaimRevenue transfers the accrued contract shared revenue to the recipient
+	ClaimRevenue(ctx context.Context, in *MsgClaimRevenue, opts ...grpc.CallOption) (*MsgClaimRevenueResponse, error)
+	// UpdateRevenueRatio sets or removes the contract shared revenue ratio
+	// override of a module via gov proposal
+	UpdateRevenueRatio(ctx context.Context, in *MsgUpdateRevenueRatio, opts ...grpc.CallOption) (*MsgUpdateRevenueRatioResponse, error)
+	// RegisterCronJob registers a scheduled entry function call with the prepaid fee
+	RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error)
+	// DepositCronJob adds the prepaid fee to a cron job
+	DepositCronJob(ctx context.Context, in *MsgDepositCronJob, opts ...grpc.CallOption) (*MsgDepositCronJobResponse, error)
+	// DeregisterCronJob removes a cron job and refunds the remaining deposit
+	DeregisterCronJob(ctx context.Context, in *MsgDeregisterCronJob, opts ...grpc.CallOption) (*MsgDeregisterCronJobResponse, error)
+	// SetPublishNamespace sets or removes the module name prefixes
+	// which an address can publish via gov proposal
+	SetPublishNamespace(ctx context.Context, in *MsgSetPublishNamespace, opts ...grpc.CallOption) (*MsgSetPublishNamespaceResponse, error)
+	// SetAuthenticator sets or removes the move view function which
+	// authenticates the signatures of the sender
+	SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error)
+	// SetSponsor sets or removes the move view function which decides
+	// whether the sponsor pays the fee of a tx
+	SetSponsor(ctx context.Context, in *MsgSetSponsor, opts ...grpc.CallOption) (*MsgSetSponsorResponse, error)
 }
 
-func (m *MsgScriptResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type msgClient struct {
+	cc grpc1.ClientConn
 }
 
-func (m *MsgScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
 }
 
-func (m *MsgScriptJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Publish(ctx context.Context, in *MsgPublish, opts ...grpc.CallOption) (*MsgPublishResponse, error) {
+	out := new(MsgPublishResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Publish", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgScriptJSON) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Execute(ctx context.Context, in *MsgExecute, opts ...grpc.CallOption) (*MsgExecuteResponse, error) {
+	out := new(MsgExecuteResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Execute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgScriptJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.CodeBytes) > 0 {
-		i -= len(m.CodeBytes)
-		copy(dAtA[i:], m.CodeBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ExecuteJSON(ctx context.Context, in *MsgExecuteJSON, opts ...grpc.CallOption) (*MsgExecuteJSONResponse, error) {
+	out := new(MsgExecuteJSONResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/ExecuteJSON", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgScriptJSONResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Script(ctx context.Context, in *MsgScript, opts ...grpc.CallOption) (*MsgScriptResponse, error) {
+	out := new(MsgScriptResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Script", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgScriptJSONResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ScriptJSON(ctx context.Context, in *MsgScriptJSON, opts ...grpc.CallOption) (*MsgScriptJSONResponse, error) {
+	out := new(MsgScriptJSONResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/ScriptJSON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgScriptJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) GovPublish(ctx context.Context, in *MsgGovPublish, opts ...grpc.CallOption) (*MsgGovPublishResponse, error) {
+	out := new(MsgGovPublishResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovPublish", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovPublish) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GovExecute(ctx context.Context, in *MsgGovExecute, opts ...grpc.CallOption) (*MsgGovExecuteResponse, error) {
+	out := new(MsgGovExecuteResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovExecute", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgGovPublish) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GovExecuteJSON(ctx context.Context, in *MsgGovExecuteJSON, opts ...grpc.CallOption) (*MsgGovExecuteJSONResponse, error) {
+	out := new(MsgGovExecuteJSONResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovExecuteJSON", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovPublish) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpgradePolicy != 0 {
-		i = encodeVarintTx(dAtA, i, uint64(m.UpgradePolicy))
-		i--
-		dAtA[i] = 0x20
-	}
-	if len(m.CodeBytes) > 0 {
-		for iNdEx := len(m.CodeBytes) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.CodeBytes[iNdEx])
-			copy(dAtA[i:], m.CodeBytes[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) GovScript(ctx context.Context, in *MsgGovScript, opts ...grpc.CallOption) (*MsgGovScriptResponse, error) {
+	out := new(MsgGovScriptResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovScript", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgGovPublishResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) GovScriptJSON(ctx context.Context, in *MsgGovScriptJSON, opts ...grpc.CallOption) (*MsgGovScriptJSONResponse, error) {
+	out := new(MsgGovScriptJSONResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/GovScriptJSON", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgGovPublishResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	return out, nil
 }
 
-func (m *MsgGovPublishResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) Whitelist(ctx context.Context, in *MsgWhitelist, opts ...grpc.CallOption) (*MsgWhitelistResponse, error) {
+	out := new(MsgWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Whitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovExecute) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) Delist(ctx context.Context, in *MsgDelist, opts ...grpc.CallOption) (*MsgDelistResponse, error) {
+	out := new(MsgDelistResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/Delist", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgGovExecute) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.FunctionName) > 0 {
-		i -= len(m.FunctionName)
-		copy(dAtA[i:], m.FunctionName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetRevenueRecipient(ctx context.Context, in *MsgSetRevenueRecipient, opts ...grpc.CallOption) (*MsgSetRevenueRecipientResponse, error) {
+	out := new(MsgSetRevenueRecipientResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/SetRevenueRecipient", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgGovExecuteResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimRevenue(ctx context.Context, in *MsgClaimRevenue, opts ...grpc.CallOption) (*MsgClaimRevenueResponse, error) {
+	out := new(MsgClaimRevenueResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/ClaimRevenue", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgGovExecuteResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRevenueRatio(ctx context.Context, in *MsgUpdateRevenueRatio, opts ...grpc.CallOption) (*MsgUpdateRevenueRatioResponse, error) {
+	out := new(MsgUpdateRevenueRatioResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/UpdateRevenueRatio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) RegisterCronJob(ctx context.Context, in *MsgRegisterCronJob, opts ...grpc.CallOption) (*MsgRegisterCronJobResponse, error) {
+	out := new(MsgRegisterCronJobResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/RegisterCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovExecuteJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DepositCronJob(ctx context.Context, in *MsgDepositCronJob, opts ...grpc.CallOption) (*MsgDepositCronJobResponse, error) {
+	out := new(MsgDepositCronJobResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/DepositCronJob", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgGovExecuteJSON) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) DeregisterCronJob(ctx context.Context, in *MsgDeregisterCronJob, opts ...grpc.CallOption) (*MsgDeregisterCronJobResponse, error) {
+	out := new(MsgDeregisterCronJobResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/DeregisterCronJob", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovExecuteJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.FunctionName) > 0 {
-		i -= len(m.FunctionName)
-		copy(dAtA[i:], m.FunctionName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) SetPublishNamespace(ctx context.Context, in *MsgSetPublishNamespace, opts ...grpc.CallOption) (*MsgSetPublishNamespaceResponse, error) {
+	out := new(MsgSetPublishNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/SetPublishNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgGovExecuteJSONResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetAuthenticator(ctx context.Context, in *MsgSetAuthenticator, opts ...grpc.CallOption) (*MsgSetAuthenticatorResponse, error) {
+	out := new(MsgSetAuthenticatorResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/SetAuthenticator", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgGovExecuteJSONResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetSponsor(ctx context.Context, in *MsgSetSponsor, opts ...grpc.CallOption) (*MsgSetSponsorResponse, error) {
+	out := new(MsgSetSponsorResponse)
+	err := c.cc.Invoke(ctx, "/initia.move.v1.Msg/SetSponsor", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgGovExecuteJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	// Publish stores compiled Move module
+	Publish(context.Context, *MsgPublish) (*MsgPublishResponse, error)
+	// Deprecated: Use ExecuteJSON instead
+	// Execute runs a entry function with the given message
+	Execute(context.Context, *MsgExecute) (*MsgExecuteResponse, error)
+	// ExecuteJSON runs a entry function with the given message
+	ExecuteJSON(context.Context, *MsgExecuteJSON) (*MsgExecuteJSONResponse, error)
+	// Deprecated: Use ScriptJSON instead
+	// Script runs a scripts with the given message
+	Script(context.Context, *MsgScript) (*MsgScriptResponse, error)
+	// ScriptJSON runs a scripts with the given message
+	ScriptJSON(context.Context, *MsgScriptJSON) (*MsgScriptJSONResponse, error)
+	// GovPublish stores compiled Move module via gov proposal
+	GovPublish(context.Context, *MsgGovPublish) (*MsgGovPublishResponse, error)
+	// Deprecated: Use GovExecuteJSON instead
+	// GovExecute runs a entry function with the given message via gov proposal
+	GovExecute(context.Context, *MsgGovExecute) (*MsgGovExecuteResponse, error)
+	// GovExecuteJSON runs a entry function with the given message via gov proposal
+	GovExecuteJSON(context.Context, *MsgGovExecuteJSON) (*MsgGovExecuteJSONResponse, error)
+	// Deprecated: Use GovScriptJSON instead
+	// GovScript runs a scripts with the given message via gov proposal
+	GovScript(context.Context, *MsgGovScript) (*MsgGovScriptResponse, error)
+	// GovScriptJSON runs a scripts with the given message via gov proposal
+	GovScriptJSON(context.Context, *MsgGovScriptJSON) (*MsgGovScriptJSONResponse, error)
+	// Whitelist registers a dex pair to whitelist of various features.
+	// - whitelist from coin register operation
+	// - allow counter party denom can be used as gas fee
+	// - register lp denom as staking denom
+	Whitelist(context.Context, *MsgWhitelist) (*MsgWhitelistResponse, error)
+	// Delist unregisters a dex pair from the whitelist.
+	Delist(context.Context, *MsgDelist) (*MsgDelistResponse, error)
+	// UpdateParams defines an operation for updating the x/move module
+	// parameters.
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetRevenueRecipient registers the recipient of the contract shared revenue
+	// of a module. Only the module publisher can register the recipient.
+	SetRevenueRecipient(context.Context, *MsgSetRevenueRecipient) (*MsgSetRevenueRecipientResponse, error)
+	// ClaimRevenue transfers the accrued contract shared revenue to the recipient
+	ClaimRevenue(context.Context, *MsgClaimRevenue) (*MsgClaimRevenueResponse, error)
+	// UpdateRevenueRatio sets or removes the contract shared revenue ratio
+	// override of a module via gov proposal
+	UpdateRevenueRatio(context.Context, *MsgUpdateRevenueRatio) (*MsgUpdateRevenueRatioResponse, error)
+	// RegisterCronJob registers a scheduled entry function call with the prepaid fee
+	RegisterCronJob(context.Context, *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error)
+	// DepositCronJob adds the prepaid fee to a cron job
+	DepositCronJob(context.Context, *MsgDepositCronJob) (*MsgDepositCronJobResponse, error)
+	// DeregisterCronJob removes a cron job and refunds the remaining deposit
+	DeregisterCronJob(context.Context, *MsgDeregisterCronJob) (*MsgDeregisterCronJobResponse, error)
+	// SetPublishNamespace sets or removes the module name prefixes
+	// which an address can publish via gov proposal
+	SetPublishNamespace(context.Context, *MsgSetPublishNamespace) (*MsgSetPublishNamespaceResponse, error)
+	// SetAuthenticator sets or removes the move view function which
+	// authenticates the signatures of the sender
+	SetAuthenticator(context.Context, *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error)
+	// SetSponsor sets or removes the move view function which decides
+	// whether the sponsor pays the fee of a tx
+	SetSponsor(context.Context, *MsgSetSponsor) (*MsgSetSponsorResponse, error)
 }
 
-func (m *MsgGovScript) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgGovScript) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) Publish(ctx context.Context, req *MsgPublish) (*MsgPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Publish not implemented")
+}
+func (*UnimplementedMsgServer) Execute(ctx context.Context, req *MsgExecute) (*MsgExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Execute not implemented")
+}
+func (*UnimplementedMsgServer) ExecuteJSON(ctx context.Context, req *MsgExecuteJSON) (*MsgExecuteJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExecuteJSON not implemented")
+}
+func (*UnimplementedMsgServer) Script(ctx context.Context, req *MsgScript) (*MsgScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Script not implemented")
+}
+func (*UnimplementedMsgServer) ScriptJSON(ctx context.Context, req *MsgScriptJSON) (*MsgScriptJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScriptJSON not implemented")
+}
+func (*UnimplementedMsgServer) GovPublish(ctx context.Context, req *MsgGovPublish) (*MsgGovPublishResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovPublish not implemented")
+}
+func (*UnimplementedMsgServer) GovExecute(ctx context.Context, req *MsgGovExecute) (*MsgGovExecuteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovExecute not implemented")
+}
+func (*UnimplementedMsgServer) GovExecuteJSON(ctx context.Context, req *MsgGovExecuteJSON) (*MsgGovExecuteJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovExecuteJSON not implemented")
+}
+func (*UnimplementedMsgServer) GovScript(ctx context.Context, req *MsgGovScript) (*MsgGovScriptResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovScript not implemented")
+}
+func (*UnimplementedMsgServer) GovScriptJSON(ctx context.Context, req *MsgGovScriptJSON) (*MsgGovScriptJSONResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GovScriptJSON not implemented")
+}
+func (*UnimplementedMsgServer) Whitelist(ctx context.Context, req *MsgWhitelist) (*MsgWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Whitelist not implemented")
+}
+func (*UnimplementedMsgServer) Delist(ctx context.Context, req *MsgDelist) (*MsgDelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delist not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) SetRevenueRecipient(ctx context.Context, req *MsgSetRevenueRecipient) (*MsgSetRevenueRecipientResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetRevenueRecipient not implemented")
+}
+func (*UnimplementedMsgServer) ClaimRevenue(ctx context.Context, req *MsgClaimRevenue) (*MsgClaimRevenueResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimRevenue not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRevenueRatio(ctx context.Context, req *MsgUpdateRevenueRatio) (*MsgUpdateRevenueRatioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRevenueRatio not implemented")
+}
+func (*UnimplementedMsgServer) RegisterCronJob(ctx context.Context, req *MsgRegisterCronJob) (*MsgRegisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterCronJob not implemented")
+}
+func (*UnimplementedMsgServer) DepositCronJob(ctx context.Context, req *MsgDepositCronJob) (*MsgDepositCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositCronJob not implemented")
+}
+func (*UnimplementedMsgServer) DeregisterCronJob(ctx context.Context, req *MsgDeregisterCronJob) (*MsgDeregisterCronJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeregisterCronJob not implemented")
+}
+func (*UnimplementedMsgServer) SetPublishNamespace(ctx context.Context, req *MsgSetPublishNamespace) (*MsgSetPublishNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPublishNamespace not implemented")
+}
+func (*UnimplementedMsgServer) SetAuthenticator(ctx context.Context, req *MsgSetAuthenticator) (*MsgSetAuthenticatorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAuthenticator not implemented")
+}
+func (*UnimplementedMsgServer) SetSponsor(ctx context.Context, req *MsgSetSponsor) (*MsgSetSponsorResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetSponsor not implemented")
 }
 
-func (m *MsgGovScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_Publish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPublish)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CodeBytes) > 0 {
-		i -= len(m.CodeBytes)
-		copy(dAtA[i:], m.CodeBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).Publish(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/Publish",
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Publish(ctx, req.(*MsgPublish))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Execute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecute)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Execute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/Execute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Execute(ctx, req.(*MsgExecute))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ExecuteJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgExecuteJSON)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ExecuteJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/ExecuteJSON",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ExecuteJSON(ctx, req.(*MsgExecuteJSON))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_Script_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScript)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Script(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/Script",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Script(ctx, req.(*MsgScript))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptJSON) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ScriptJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgScriptJSON)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).ScriptJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/ScriptJSON",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ScriptJSON(ctx, req.(*MsgScriptJSON))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptJSON) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GovPublish_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovPublish)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovPublish(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/GovPublish",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovPublish(ctx, req.(*MsgGovPublish))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Args) > 0 {
-		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Args[iNdEx])
-			copy(dAtA[i:], m.Args[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.TypeArgs) > 0 {
-		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.TypeArgs[iNdEx])
-			copy(dAtA[i:], m.TypeArgs[iNdEx])
-			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+func _Msg_GovExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovExecute)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.CodeBytes) > 0 {
-		i -= len(m.CodeBytes)
-		copy(dAtA[i:], m.CodeBytes)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).GovExecute(ctx, in)
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/GovExecute",
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovExecute(ctx, req.(*MsgGovExecute))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptJSONResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_GovExecuteJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovExecuteJSON)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).GovExecuteJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/GovExecuteJSON",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovExecuteJSON(ctx, req.(*MsgGovExecuteJSON))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptJSONResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_GovScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovScript)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovScript(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/GovScript",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovScript(ctx, req.(*MsgGovScript))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgGovScriptJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_GovScriptJSON_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgGovScriptJSON)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).GovScriptJSON(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/GovScriptJSON",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).GovScriptJSON(ctx, req.(*MsgGovScriptJSON))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_Whitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWhitelist)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).Whitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/Whitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Whitelist(ctx, req.(*MsgWhitelist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_Delist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDelist)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Delist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/Delist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Delist(ctx, req.(*MsgDelist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.RewardWeight.Size()
-		i -= size
-		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTx(dAtA, i, uint64(size))
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.MetadataLP) > 0 {
-		i -= len(m.MetadataLP)
-		copy(dAtA[i:], m.MetadataLP)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataLP)))
-		i--
-		dAtA[i] = 0x12
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/UpdateParams",
 	}
-	return len(dAtA) - i, nil
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelistResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SetRevenueRecipient_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetRevenueRecipient)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).SetRevenueRecipient(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/SetRevenueRecipient",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetRevenueRecipient(ctx, req.(*MsgSetRevenueRecipient))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ClaimRevenue_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimRevenue)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimRevenue(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/ClaimRevenue",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimRevenue(ctx, req.(*MsgClaimRevenue))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateRevenueRatio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRevenueRatio)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRevenueRatio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/UpdateRevenueRatio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRevenueRatio(ctx, req.(*MsgUpdateRevenueRatio))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelist) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RegisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterCronJob)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RegisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/RegisterCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RegisterCronJob(ctx, req.(*MsgRegisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgDelist) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func _Msg_DepositCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DepositCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/DepositCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DepositCronJob(ctx, req.(*MsgDepositCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_DeregisterCronJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDeregisterCronJob)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).DeregisterCronJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/DeregisterCronJob",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).DeregisterCronJob(ctx, req.(*MsgDeregisterCronJob))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetPublishNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetPublishNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetPublishNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/SetPublishNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetPublishNamespace(ctx, req.(*MsgSetPublishNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetAuthenticator_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetAuthenticator)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetAuthenticator(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/SetAuthenticator",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetAuthenticator(ctx, req.(*MsgSetAuthenticator))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetSponsor_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetSponsor)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetSponsor(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.move.v1.Msg/SetSponsor",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetSponsor(ctx, req.(*MsgSetSponsor))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.move.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Publish",
+			Handler:    _Msg_Publish_Handler,
+		},
+		{
+			MethodName: "Execute",
+			Handler:    _Msg_Execute_Handler,
+		},
+		{
+			MethodName: "ExecuteJSON",
+			Handler:    _Msg_ExecuteJSON_Handler,
+		},
+		{
+			MethodName: "Script",
+			Handler:    _Msg_Script_Handler,
+		},
+		{
+			MethodName: "ScriptJSON",
+			Handler:    _Msg_ScriptJSON_Handler,
+		},
+		{
+			MethodName: "GovPublish",
+			Handler:    _Msg_GovPublish_Handler,
+		},
+		{
+			MethodName: "GovExecute",
+			Handler:    _Msg_GovExecute_Handler,
+		},
+		{
+			MethodName: "GovExecuteJSON",
+			Handler:    _Msg_GovExecuteJSON_Handler,
+		},
+		{
+			MethodName: "GovScript",
+			Handler:    _Msg_GovScript_Handler,
+		},
+		{
+			MethodName: "GovScriptJSON",
+			Handler:    _Msg_GovScriptJSON_Handler,
+		},
+		{
+			MethodName: "Whitelist",
+			Handler:    _Msg_Whitelist_Handler,
+		},
+		{
+			MethodName: "Delist",
+			Handler:    _Msg_Delist_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "SetRevenueRecipient",
+			Handler:    _Msg_SetRevenueRecipient_Handler,
+		},
+		{
+			MethodName: "ClaimRevenue",
+			Handler:    _Msg_ClaimRevenue_Handler,
+		},
+		{
+			MethodName: "UpdateRevenueRatio",
+			Handler:    _Msg_UpdateRevenueRatio_Handler,
+		},
+		{
+			MethodName: "RegisterCronJob",
+			Handler:    _Msg_RegisterCronJob_Handler,
+		},
+		{
+			MethodName: "DepositCronJob",
+			Handler:    _Msg_DepositCronJob_Handler,
+		},
+		{
+			MethodName: "DeregisterCronJob",
+			Handler:    _Msg_DeregisterCronJob_Handler,
+		},
+		{
+			MethodName: "SetPublishNamespace",
+			Handler:    _Msg_SetPublishNamespace_Handler,
+		},
+		{
+			MethodName: "SetAuthenticator",
+			Handler:    _Msg_SetAuthenticator_Handler,
+		},
+		{
+			MethodName: "SetSponsor",
+			Handler:    _Msg_SetSponsor_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/move/v1/tx.proto",
+}
+
+func (m *MsgPublish) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPublish) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPublish) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.MetadataLP) > 0 {
-		i -= len(m.MetadataLP)
-		copy(dAtA[i:], m.MetadataLP)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataLP)))
+	if m.UpgradePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradePolicy))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x18
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.CodeBytes) > 0 {
+		for iNdEx := len(m.CodeBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeBytes[iNdEx])
+			copy(dAtA[i:], m.CodeBytes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDelistResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgPublishResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2784,12 +3039,12 @@ func (m *MsgDelistResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDelistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgPublishResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgPublishResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2797,7 +3052,7 @@ func (m *MsgDelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgExecute) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2807,37 +3062,66 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExecute) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgExecuteResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -2847,12 +3131,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgExecuteResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -2860,520 +3144,3841 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgPublish) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.CodeBytes) > 0 {
-		for _, b := range m.CodeBytes {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if m.UpgradePolicy != 0 {
-		n += 1 + sovTx(uint64(m.UpgradePolicy))
+func (m *MsgExecuteJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgPublishResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgExecuteJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgExecute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgExecuteJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
 	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.FunctionName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Args) > 0 {
-		for _, b := range m.Args {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgExecuteJSONResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgExecuteJSONResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgExecuteJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteJSON) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScript) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScript) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FunctionName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.CodeBytes) > 0 {
+		i -= len(m.CodeBytes)
+		copy(dAtA[i:], m.CodeBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgExecuteJSONResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScriptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScriptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgScript) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScriptJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgScriptJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgScriptJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CodeBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Args) > 0 {
-		for _, b := range m.Args {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.CodeBytes) > 0 {
+		i -= len(m.CodeBytes)
+		copy(dAtA[i:], m.CodeBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgScriptJSONResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgScriptJSON) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CodeBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgScriptJSONResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgScriptJSONResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgScriptJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovPublish) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovPublish) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovPublish) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovPublish) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.UpgradePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.UpgradePolicy))
+		i--
+		dAtA[i] = 0x20
 	}
 	if len(m.CodeBytes) > 0 {
-		for _, b := range m.CodeBytes {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.CodeBytes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.CodeBytes[iNdEx])
+			copy(dAtA[i:], m.CodeBytes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if m.UpgradePolicy != 0 {
-		n += 1 + sovTx(uint64(m.UpgradePolicy))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovPublishResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovPublishResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgGovExecute) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.FunctionName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Args) > 0 {
-		for _, b := range m.Args {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgGovPublishResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovExecuteResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGovPublishResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovExecuteJSON) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovExecute) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovExecute) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovExecute) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
 	}
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.FunctionName)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovExecuteJSONResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovExecuteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgGovScript) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CodeBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	if len(m.Args) > 0 {
-		for _, b := range m.Args {
-			l = len(b)
-			n += 1 + l + sovTx(uint64(l))
-		}
-	}
-	return n
+func (m *MsgGovExecuteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgGovScriptResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGovExecuteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgGovScriptJSON) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovExecuteJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovExecuteJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovExecuteJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.CodeBytes)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
 	}
 	if len(m.TypeArgs) > 0 {
-		for _, s := range m.TypeArgs {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
 		}
 	}
-	if len(m.Args) > 0 {
-		for _, s := range m.Args {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
-		}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	return n
-}
-
-func (m *MsgGovScriptJSONResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x22
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgWhitelist) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	l = len(m.MetadataLP)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.RewardWeight.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgWhitelistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgGovExecuteJSONResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgDelist) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgGovExecuteJSONResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovExecuteJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovScript) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.MetadataLP)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovScript) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovScript) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CodeBytes) > 0 {
+		i -= len(m.CodeBytes)
+		copy(dAtA[i:], m.CodeBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovScriptResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovScriptResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovScriptResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovScriptJSON) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovScriptJSON) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovScriptJSON) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.CodeBytes) > 0 {
+		i -= len(m.CodeBytes)
+		copy(dAtA[i:], m.CodeBytes)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CodeBytes)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgGovScriptJSONResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgGovScriptJSONResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgGovScriptJSONResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.RewardWeight.Size()
+		i -= size
+		if _, err := m.RewardWeight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.MetadataLP) > 0 {
+		i -= len(m.MetadataLP)
+		copy(dAtA[i:], m.MetadataLP)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataLP)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataLP) > 0 {
+		i -= len(m.MetadataLP)
+		copy(dAtA[i:], m.MetadataLP)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MetadataLP)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRevenueRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRevenueRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRevenueRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetRevenueRecipientResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetRevenueRecipientResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetRevenueRecipientResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimRevenueResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimRevenueResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimRevenueResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRevenueRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRevenueRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRevenueRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Ratio != nil {
+		{
+			size := m.Ratio.Size()
+			i -= size
+			if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRevenueRatioResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRevenueRatioResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRevenueRatioResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.GasLimit != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRegisterCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRegisterCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRegisterCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDepositCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDepositCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDepositCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterCronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterCronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterCronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgDeregisterCronJobResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgDeregisterCronJobResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgDeregisterCronJobResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPublishNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPublishNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPublishNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetPublishNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetPublishNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetPublishNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetAuthenticatorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetAuthenticatorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetAuthenticatorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Sponsor) > 0 {
+		i -= len(m.Sponsor)
+		copy(dAtA[i:], m.Sponsor)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sponsor)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetSponsorResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetSponsorResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetSponsorResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgPublish) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CodeBytes) > 0 {
+		for _, b := range m.CodeBytes {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UpgradePolicy != 0 {
+		n += 1 + sovTx(uint64(m.UpgradePolicy))
+	}
+	return n
+}
+
+func (m *MsgPublishResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgExecuteJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgExecuteJSONResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgScriptJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgScriptJSONResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovPublish) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.CodeBytes) > 0 {
+		for _, b := range m.CodeBytes {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.UpgradePolicy != 0 {
+		n += 1 + sovTx(uint64(m.UpgradePolicy))
+	}
+	return n
+}
+
+func (m *MsgGovPublishResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovExecute) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovExecuteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovExecuteJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovExecuteJSONResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovScript) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovScriptResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgGovScriptJSON) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CodeBytes)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, s := range m.Args {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgGovScriptJSONResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWhitelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetadataLP)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.RewardWeight.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDelist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MetadataLP)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetRevenueRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetRevenueRecipientResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimRevenueResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateRevenueRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Ratio != nil {
+		l = m.Ratio.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRevenueRatioResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRegisterCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTx(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTx(uint64(m.GasLimit))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgRegisterCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDepositCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgDepositCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDeregisterCronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovTx(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *MsgDeregisterCronJobResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetPublishNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Prefixes) > 0 {
+		for _, s := range m.Prefixes {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgSetPublishNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetAuthenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetAuthenticatorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetSponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Sponsor)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetSponsorResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgPublish) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPublish: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPublish: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeBytes = append(m.CodeBytes, make([]byte, postIndex-iNdEx))
+			copy(m.CodeBytes[len(m.CodeBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicy", wireType)
+			}
+			m.UpgradePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecute) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteJSON: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteJSONResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteJSONResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScript) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScript: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScript: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeBytes == nil {
+				m.CodeBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScriptResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScriptResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScriptJSON: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScriptJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeBytes == nil {
+				m.CodeBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgScriptJSONResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgScriptJSONResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgScriptJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgGovPublish) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovPublish: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovPublish: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CodeBytes = append(m.CodeBytes, make([]byte, postIndex-iNdEx))
+			copy(m.CodeBytes[len(m.CodeBytes)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicy", wireType)
+			}
+			m.UpgradePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgDelistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+func (m *MsgGovPublishResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovPublishResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovPublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgPublish) Unmarshal(dAtA []byte) error {
+func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3392,19 +6997,147 @@ func (m *MsgPublish) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPublish: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPublish: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovExecute: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3432,13 +7165,13 @@ func (m *MsgPublish) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3448,29 +7181,29 @@ func (m *MsgPublish) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeBytes = append(m.CodeBytes, make([]byte, postIndex-iNdEx))
-			copy(m.CodeBytes[len(m.CodeBytes)-1], dAtA[iNdEx:postIndex])
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicy", wireType)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			m.UpgradePolicy = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3480,11 +7213,24 @@ func (m *MsgPublish) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3506,7 +7252,7 @@ func (m *MsgPublish) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgPublishResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovExecuteResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3529,10 +7275,10 @@ func (m *MsgPublishResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgPublishResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovExecuteResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgPublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3556,7 +7302,7 @@ func (m *MsgPublishResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecute) Unmarshal(dAtA []byte) error {
+func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3579,13 +7325,45 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovExecuteJSON: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovExecuteJSON: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -3617,7 +7395,7 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
@@ -3649,7 +7427,7 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 			}
 			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
@@ -3681,7 +7459,7 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 			}
 			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
 			}
@@ -3713,7 +7491,7 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 			}
 			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
 			}
@@ -3745,11 +7523,11 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 			}
 			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3759,23 +7537,23 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
-			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3798,7 +7576,7 @@ func (m *MsgExecute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovExecuteJSONResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3821,10 +7599,10 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovExecuteJSONResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovExecuteJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -3848,7 +7626,7 @@ func (m *MsgExecuteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
+func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3871,15 +7649,15 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteJSON: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovScript: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovScript: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3907,11 +7685,11 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3939,13 +7717,13 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -3955,57 +7733,27 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.CodeBytes == nil {
+				m.CodeBytes = []byte{}
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
 			}
@@ -4037,11 +7785,11 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 			}
 			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4051,23 +7799,23 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4090,7 +7838,7 @@ func (m *MsgExecuteJSON) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgExecuteJSONResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovScriptResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4113,10 +7861,10 @@ func (m *MsgExecuteJSONResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteJSONResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovScriptResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4140,7 +7888,7 @@ func (m *MsgExecuteJSONResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScript) Unmarshal(dAtA []byte) error {
+func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4150,26 +7898,58 @@ func (m *MsgScript) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowTx
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgGovScriptJSON: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgGovScriptJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScript: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScript: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
@@ -4201,7 +7981,7 @@ func (m *MsgScript) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
 			}
@@ -4235,7 +8015,7 @@ func (m *MsgScript) Unmarshal(dAtA []byte) error {
 				m.CodeBytes = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
 			}
@@ -4267,11 +8047,11 @@ func (m *MsgScript) Unmarshal(dAtA []byte) error {
 			}
 			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4281,23 +8061,23 @@ func (m *MsgScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
-			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4320,7 +8100,7 @@ func (m *MsgScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgGovScriptJSONResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4343,10 +8123,10 @@ func (m *MsgScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgGovScriptJSONResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgGovScriptJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4370,7 +8150,7 @@ func (m *MsgScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
+func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4393,15 +8173,15 @@ func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScriptJSON: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWhitelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScriptJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4429,45 +8209,11 @@ func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeBytes == nil {
-				m.CodeBytes = []byte{}
-			}
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4495,11 +8241,11 @@ func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			m.MetadataLP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4527,7 +8273,9 @@ func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4550,7 +8298,7 @@ func (m *MsgScriptJSON) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgScriptJSONResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWhitelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4573,10 +8321,10 @@ func (m *MsgScriptJSONResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgScriptJSONResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWhitelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgScriptJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4600,7 +8348,7 @@ func (m *MsgScriptJSONResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovPublish) Unmarshal(dAtA []byte) error {
+func (m *MsgDelist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4623,10 +8371,10 @@ func (m *MsgGovPublish) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovPublish: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovPublish: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4663,7 +8411,7 @@ func (m *MsgGovPublish) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLP", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4691,59 +8439,8 @@ func (m *MsgGovPublish) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CodeBytes = append(m.CodeBytes, make([]byte, postIndex-iNdEx))
-			copy(m.CodeBytes[len(m.CodeBytes)-1], dAtA[iNdEx:postIndex])
+			m.MetadataLP = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicy", wireType)
-			}
-			m.UpgradePolicy = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.UpgradePolicy |= UpgradePolicy(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -4765,7 +8462,7 @@ func (m *MsgGovPublish) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovPublishResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDelistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4788,10 +8485,10 @@ func (m *MsgGovPublishResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovPublishResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDelistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovPublishResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4815,7 +8512,7 @@ func (m *MsgGovPublishResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4838,10 +8535,10 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovExecute: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovExecute: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4878,9 +8575,9 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -4890,59 +8587,128 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetRevenueRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetRevenueRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetRevenueRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4970,11 +8736,11 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5002,11 +8768,11 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5034,39 +8800,7 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
-			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5089,7 +8823,7 @@ func (m *MsgGovExecute) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovExecuteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetRevenueRecipientResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5112,10 +8846,10 @@ func (m *MsgGovExecuteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovExecuteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetRevenueRecipientResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovExecuteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetRevenueRecipientResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5139,7 +8873,7 @@ func (m *MsgGovExecuteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimRevenue) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5162,15 +8896,15 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovExecuteJSON: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimRevenue: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovExecuteJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5198,11 +8932,11 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5230,13 +8964,63 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimRevenueResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimRevenueResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimRevenueResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5246,27 +9030,79 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRevenueRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRevenueRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRevenueRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5294,11 +9130,11 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5326,11 +9162,11 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5358,11 +9194,11 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5390,7 +9226,11 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
+			var v cosmossdk_io_math.LegacyDec
+			m.Ratio = &v
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5413,7 +9253,7 @@ func (m *MsgGovExecuteJSON) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovExecuteJSONResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRevenueRatioResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5436,10 +9276,10 @@ func (m *MsgGovExecuteJSONResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovExecuteJSONResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRevenueRatioResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovExecuteJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRevenueRatioResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5463,7 +9303,7 @@ func (m *MsgGovExecuteJSONResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5486,15 +9326,15 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovScript: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovScript: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5522,11 +9362,11 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5554,13 +9394,13 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5570,27 +9410,57 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeBytes == nil {
-				m.CodeBytes = []byte{}
-			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
 			}
@@ -5622,7 +9492,7 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 			}
 			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
 			}
@@ -5654,6 +9524,77 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
 			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5675,7 +9616,7 @@ func (m *MsgGovScript) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovScriptResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRegisterCronJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5698,12 +9639,31 @@ func (m *MsgGovScriptResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovScriptResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRegisterCronJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovScriptResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRegisterCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5725,7 +9685,7 @@ func (m *MsgGovScriptResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositCronJob) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5748,15 +9708,15 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovScriptJSON: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositCronJob: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovScriptJSON: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5784,13 +9744,13 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5800,29 +9760,16 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CodeBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5832,29 +9779,128 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDepositCronJobResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDepositCronJobResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDepositCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgDeregisterCronJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CodeBytes = append(m.CodeBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.CodeBytes == nil {
-				m.CodeBytes = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgDeregisterCronJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgDeregisterCronJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5882,13 +9928,13 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			var stringLen uint64
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -5898,24 +9944,11 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Args = append(m.Args, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -5937,7 +9970,7 @@ func (m *MsgGovScriptJSON) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgGovScriptJSONResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDeregisterCronJobResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5960,10 +9993,10 @@ func (m *MsgGovScriptJSONResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgGovScriptJSONResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDeregisterCronJobResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgGovScriptJSONResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDeregisterCronJobResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5987,7 +10020,7 @@ func (m *MsgGovScriptJSONResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPublishNamespace) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6010,10 +10043,10 @@ func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPublishNamespace: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPublishNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6050,7 +10083,7 @@ func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6078,11 +10111,11 @@ func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataLP = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardWeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6110,9 +10143,7 @@ func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardWeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Prefixes = append(m.Prefixes, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6135,7 +10166,7 @@ func (m *MsgWhitelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWhitelistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetPublishNamespaceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6158,10 +10189,10 @@ func (m *MsgWhitelistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWhitelistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetPublishNamespaceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetPublishNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6185,7 +10216,7 @@ func (m *MsgWhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelist) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAuthenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6208,15 +10239,15 @@ func (m *MsgDelist) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelist: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAuthenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelist: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAuthenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6244,11 +10275,11 @@ func (m *MsgDelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6276,7 +10307,71 @@ func (m *MsgDelist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataLP = string(dAtA[iNdEx:postIndex])
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6299,7 +10394,7 @@ func (m *MsgDelist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDelistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetAuthenticatorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6322,10 +10417,10 @@ func (m *MsgDelistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDelistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetAuthenticatorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetAuthenticatorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6349,7 +10444,7 @@ func (m *MsgDelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSponsor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6372,15 +10467,15 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSponsor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSponsor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6408,13 +10503,13 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sponsor", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -6424,24 +10519,119 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Sponsor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6464,7 +10654,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetSponsorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6487,10 +10677,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetSponsorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetSponsorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
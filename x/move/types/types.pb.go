@@ -7,6 +7,8 @@ import (
 	bytes "bytes"
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -60,6 +62,43 @@ func (UpgradePolicy) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_5ab4b0783858a3a5, []int{0}
 }
 
+// PublishMode defines who can publish the modules
+type PublishMode int32
+
+const (
+	// PUBLISH_MODE_UNSPECIFIED follows `allowed_publishers`; allowlist when
+	// it is not empty, otherwise open.
+	PublishModeUnspecified PublishMode = 0
+	// PUBLISH_MODE_OPEN allows anyone to publish.
+	PublishModeOpen PublishMode = 1
+	// PUBLISH_MODE_ALLOWLIST allows only `allowed_publishers` to publish.
+	PublishModeAllowlist PublishMode = 2
+	// PUBLISH_MODE_GOVERNANCE allows to publish only via governance proposal.
+	PublishModeGovernance PublishMode = 3
+)
+
+var PublishMode_name = map[int32]string{
+	0: "PUBLISH_MODE_UNSPECIFIED",
+	1: "PUBLISH_MODE_OPEN",
+	2: "PUBLISH_MODE_ALLOWLIST",
+	3: "PUBLISH_MODE_GOVERNANCE",
+}
+
+var PublishMode_value = map[string]int32{
+	"PUBLISH_MODE_UNSPECIFIED": 0,
+	"PUBLISH_MODE_OPEN":        1,
+	"PUBLISH_MODE_ALLOWLIST":   2,
+	"PUBLISH_MODE_GOVERNANCE":  3,
+}
+
+func (x PublishMode) String() string {
+	return proto.EnumName(PublishMode_name, int32(x))
+}
+
+func (PublishMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{1}
+}
+
 // Params defines the set of move parameters.
 type Params struct {
 	BaseDenom       string                      `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
@@ -69,6 +108,33 @@ type Params struct {
 	// It is a list of addresses with permission to distribute contracts,
 	// and an empty list is interpreted as allowing anyone to distribute.
 	AllowedPublishers []string `protobuf:"bytes,4,rep,name=allowed_publishers,json=allowedPublishers,proto3" json:"allowed_publishers,omitempty" yaml:"allowed_publishers"`
+	// The maximum total gas which can be used by the cron jobs in a block,
+	// and zero disables the cron job execution.
+	CronMaxGasPerBlock uint64 `protobuf:"varint,5,opt,name=cron_max_gas_per_block,json=cronMaxGasPerBlock,proto3" json:"cron_max_gas_per_block,omitempty" yaml:"cron_max_gas_per_block"`
+	// The number of consecutive failures to deregister a cron job.
+	CronMaxFailures uint64 `protobuf:"varint,6,opt,name=cron_max_failures,json=cronMaxFailures,proto3" json:"cron_max_failures,omitempty" yaml:"cron_max_failures"`
+	// The publish mode which decides who can publish the modules.
+	PublishMode PublishMode `protobuf:"varint,7,opt,name=publish_mode,json=publishMode,proto3,enum=initia.move.v1.PublishMode" json:"publish_mode,omitempty" yaml:"publish_mode"`
+	// The deposit in the base denom which is charged on the new module
+	// publish and refunded when the module is frozen, and zero disables it.
+	PublishDeposit cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=publish_deposit,json=publishDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"publish_deposit" yaml:"publish_deposit"`
+	// The maximum number of modules which can be published by an address
+	// in a quota window, and zero disables the quota.
+	PublishQuota uint64 `protobuf:"varint,9,opt,name=publish_quota,json=publishQuota,proto3" json:"publish_quota,omitempty" yaml:"publish_quota"`
+	// The number of blocks of a quota window.
+	PublishQuotaWindow uint64 `protobuf:"varint,10,opt,name=publish_quota_window,json=publishQuotaWindow,proto3" json:"publish_quota_window,omitempty" yaml:"publish_quota_window"`
+	// The maximum gas which can be used by an account authenticator to
+	// authenticate a signature, and zero disables the authenticators.
+	AuthenticatorGasLimit uint64 `protobuf:"varint,11,opt,name=authenticator_gas_limit,json=authenticatorGasLimit,proto3" json:"authenticator_gas_limit,omitempty" yaml:"authenticator_gas_limit"`
+	// The maximum gas which can be used by a sponsor policy to decide
+	// whether to pay the fee of a tx, and zero disables the sponsors.
+	SponsorGasLimit uint64 `protobuf:"varint,12,opt,name=sponsor_gas_limit,json=sponsorGasLimit,proto3" json:"sponsor_gas_limit,omitempty" yaml:"sponsor_gas_limit"`
+	// The number of blocks over which the LP voting power weights are
+	// averaged, and zero disables the averaging.
+	VotingPowerTwapWindow uint64 `protobuf:"varint,13,opt,name=voting_power_twap_window,json=votingPowerTwapWindow,proto3" json:"voting_power_twap_window,omitempty" yaml:"voting_power_twap_window"`
+	// The maximum ratio by which an LP voting power weight can change
+	// in a block, and zero disables the cap.
+	MaxVotingPowerWeightChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=max_voting_power_weight_change_rate,json=maxVotingPowerWeightChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_voting_power_weight_change_rate" yaml:"max_voting_power_weight_change_rate"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -109,6 +175,33 @@ type RawParams struct {
 	BaseMinGasPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_min_gas_price,json=baseMinGasPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_min_gas_price" yaml:"base_min_gas_price"`
 	// CSR: Percentage of fees distributed to developers
 	ContractSharedRevenueRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=contract_shared_revenue_ratio,json=contractSharedRevenueRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"contract_shared_revenue_ratio" yaml:"contract_shared_revenue_ratio"`
+	// The maximum total gas which can be used by the cron jobs in a block,
+	// and zero disables the cron job execution.
+	CronMaxGasPerBlock uint64 `protobuf:"varint,4,opt,name=cron_max_gas_per_block,json=cronMaxGasPerBlock,proto3" json:"cron_max_gas_per_block,omitempty" yaml:"cron_max_gas_per_block"`
+	// The number of consecutive failures to deregister a cron job.
+	CronMaxFailures uint64 `protobuf:"varint,5,opt,name=cron_max_failures,json=cronMaxFailures,proto3" json:"cron_max_failures,omitempty" yaml:"cron_max_failures"`
+	// The publish mode which decides who can publish the modules.
+	PublishMode PublishMode `protobuf:"varint,6,opt,name=publish_mode,json=publishMode,proto3,enum=initia.move.v1.PublishMode" json:"publish_mode,omitempty" yaml:"publish_mode"`
+	// The deposit in the base denom which is charged on the new module
+	// publish and refunded when the module is frozen, and zero disables it.
+	PublishDeposit cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=publish_deposit,json=publishDeposit,proto3,customtype=cosmossdk.io/math.Int" json:"publish_deposit" yaml:"publish_deposit"`
+	// The maximum number of modules which can be published by an address
+	// in a quota window, and zero disables the quota.
+	PublishQuota uint64 `protobuf:"varint,8,opt,name=publish_quota,json=publishQuota,proto3" json:"publish_quota,omitempty" yaml:"publish_quota"`
+	// The number of blocks of a quota window.
+	PublishQuotaWindow uint64 `protobuf:"varint,9,opt,name=publish_quota_window,json=publishQuotaWindow,proto3" json:"publish_quota_window,omitempty" yaml:"publish_quota_window"`
+	// The maximum gas which can be used by an account authenticator to
+	// authenticate a signature, and zero disables the authenticators.
+	AuthenticatorGasLimit uint64 `protobuf:"varint,10,opt,name=authenticator_gas_limit,json=authenticatorGasLimit,proto3" json:"authenticator_gas_limit,omitempty" yaml:"authenticator_gas_limit"`
+	// The maximum gas which can be used by a sponsor policy to decide
+	// whether to pay the fee of a tx, and zero disables the sponsors.
+	SponsorGasLimit uint64 `protobuf:"varint,11,opt,name=sponsor_gas_limit,json=sponsorGasLimit,proto3" json:"sponsor_gas_limit,omitempty" yaml:"sponsor_gas_limit"`
+	// The number of blocks over which the LP voting power weights are
+	// averaged, and zero disables the averaging.
+	VotingPowerTwapWindow uint64 `protobuf:"varint,12,opt,name=voting_power_twap_window,json=votingPowerTwapWindow,proto3" json:"voting_power_twap_window,omitempty" yaml:"voting_power_twap_window"`
+	// The maximum ratio by which an LP voting power weight can change
+	// in a block, and zero disables the cap.
+	MaxVotingPowerWeightChangeRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=max_voting_power_weight_change_rate,json=maxVotingPowerWeightChangeRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_voting_power_weight_change_rate" yaml:"max_voting_power_weight_change_rate"`
 }
 
 func (m *RawParams) Reset()         { *m = RawParams{} }
@@ -397,6 +490,11 @@ type ExecuteAuthorizationItem struct {
 	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 	// FunctionName is the name of function to execute with wildcard '*' support
 	FunctionNames []string `protobuf:"bytes,3,rep,name=function_names,json=functionNames,proto3" json:"function_names,omitempty"`
+	// TypeArgs is the type arguments the execution must use, and
+	// empty means any type arguments are allowed
+	TypeArgs []string `protobuf:"bytes,4,rep,name=type_args,json=typeArgs,proto3" json:"type_args,omitempty"`
+	// ArgConstraints is the constraints on the bcs encoded arguments
+	ArgConstraints []ArgumentConstraint `protobuf:"bytes,5,rep,name=arg_constraints,json=argConstraints,proto3" json:"arg_constraints"`
 }
 
 func (m *ExecuteAuthorizationItem) Reset()         { *m = ExecuteAuthorizationItem{} }
@@ -432,1010 +530,7706 @@ func (m *ExecuteAuthorizationItem) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ExecuteAuthorizationItem proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterEnum("initia.move.v1.UpgradePolicy", UpgradePolicy_name, UpgradePolicy_value)
-	proto.RegisterType((*Params)(nil), "initia.move.v1.Params")
-	proto.RegisterType((*RawParams)(nil), "initia.move.v1.RawParams")
-	proto.RegisterType((*Module)(nil), "initia.move.v1.Module")
-	proto.RegisterType((*Resource)(nil), "initia.move.v1.Resource")
-	proto.RegisterType((*TableInfo)(nil), "initia.move.v1.TableInfo")
-	proto.RegisterType((*TableEntry)(nil), "initia.move.v1.TableEntry")
-	proto.RegisterType((*UpgradePolicyProto)(nil), "initia.move.v1.UpgradePolicyProto")
-	proto.RegisterType((*DexPair)(nil), "initia.move.v1.DexPair")
-	proto.RegisterType((*ExecuteAuthorizationItem)(nil), "initia.move.v1.ExecuteAuthorizationItem")
+// ArgumentConstraint is the constraint on an argument of the authorized
+// execution. Exactly one of equals, max_amount and allowed_addresses
+// must be set.
+type ArgumentConstraint struct {
+	// Index is the position of the argument
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// Equals is the bcs bytes the argument must be equal to
+	Equals []byte `protobuf:"bytes,2,opt,name=equals,proto3" json:"equals,omitempty"`
+	// MaxAmount is the maximum value of the unsigned integer argument
+	MaxAmount *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_amount,json=maxAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_amount,omitempty"`
+	// AllowedAddresses is the addresses which the address argument must be one of
+	AllowedAddresses []string `protobuf:"bytes,4,rep,name=allowed_addresses,json=allowedAddresses,proto3" json:"allowed_addresses,omitempty"`
 }
 
-func init() { proto.RegisterFile("initia/move/v1/types.proto", fileDescriptor_5ab4b0783858a3a5) }
-
-var fileDescriptor_5ab4b0783858a3a5 = []byte{
-	// 851 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x55, 0x3f, 0x8f, 0x1b, 0xc5,
-	0x1b, 0xf6, 0x9c, 0x73, 0x7f, 0xfc, 0x3a, 0x76, 0x2e, 0xa3, 0x14, 0xce, 0x45, 0x67, 0x9f, 0xf6,
-	0xf7, 0x43, 0x3a, 0x1d, 0xc2, 0xab, 0x80, 0x68, 0xd2, 0xa0, 0x73, 0x6c, 0x90, 0x85, 0x1d, 0x36,
-	0x1b, 0x5f, 0x43, 0xb3, 0x1a, 0xef, 0x4e, 0xec, 0x95, 0x77, 0x77, 0x96, 0x99, 0x59, 0xdf, 0x2d,
-	0x3d, 0x0d, 0x42, 0x02, 0x89, 0x86, 0x0a, 0xa5, 0x4c, 0x99, 0x82, 0x82, 0x8f, 0x70, 0x65, 0x4a,
-	0x44, 0x61, 0x81, 0xaf, 0x08, 0x35, 0x9f, 0x00, 0xcd, 0xcc, 0xfa, 0xc0, 0x8a, 0x64, 0x1d, 0x3d,
-	0xcd, 0x6a, 0xde, 0xe7, 0x79, 0xf4, 0xfe, 0x99, 0x67, 0x67, 0x06, 0x0e, 0xc2, 0x24, 0x94, 0x21,
-	0xb1, 0x63, 0x36, 0xa7, 0xf6, 0xfc, 0xa1, 0x2d, 0xf3, 0x94, 0x8a, 0x76, 0xca, 0x99, 0x64, 0xb8,
-	0x6e, 0xb8, 0xb6, 0xe2, 0xda, 0xf3, 0x87, 0x07, 0x77, 0x49, 0x1c, 0x26, 0xcc, 0xd6, 0x5f, 0x23,
-	0x39, 0xb8, 0x37, 0x61, 0x13, 0xa6, 0x97, 0xb6, 0x5a, 0x19, 0xd4, 0xfa, 0xb1, 0x0c, 0x3b, 0x0e,
-	0xe1, 0x24, 0x16, 0xf8, 0x10, 0x60, 0x4c, 0x04, 0xf5, 0x02, 0x9a, 0xb0, 0xb8, 0x81, 0x8e, 0xd0,
-	0x71, 0xc5, 0xad, 0x28, 0xa4, 0xab, 0x00, 0xcc, 0x01, 0x6b, 0x3a, 0x0e, 0x13, 0x6f, 0x42, 0x84,
-	0x97, 0xf2, 0xd0, 0xa7, 0x8d, 0x2d, 0x25, 0xeb, 0xf4, 0x2e, 0x17, 0xad, 0xd2, 0xaf, 0x8b, 0xd6,
-	0x03, 0x9f, 0x89, 0x98, 0x09, 0x11, 0xcc, 0xda, 0x21, 0xb3, 0x63, 0x22, 0xa7, 0xed, 0x01, 0x9d,
-	0x10, 0x3f, 0xef, 0x52, 0xff, 0xcf, 0x45, 0xeb, 0x7e, 0x4e, 0xe2, 0xe8, 0x91, 0xf5, 0x76, 0x1a,
-	0xeb, 0xe5, 0x9b, 0x57, 0x27, 0xc8, 0xbd, 0xa3, 0x98, 0x61, 0x98, 0x7c, 0x42, 0x84, 0xa3, 0x60,
-	0xfc, 0x3d, 0x82, 0x43, 0x9f, 0x25, 0x92, 0x13, 0x5f, 0x7a, 0x62, 0x4a, 0x38, 0x0d, 0x3c, 0x4e,
-	0xe7, 0x34, 0xc9, 0xa8, 0xc7, 0x89, 0x0c, 0x59, 0xa3, 0xac, 0xeb, 0x3b, 0x37, 0xab, 0xff, 0x7f,
-	0x53, 0x7f, 0x63, 0xc6, 0xa2, 0x95, 0x83, 0x95, 0xe8, 0x99, 0xd6, 0xb8, 0x46, 0xe2, 0x2a, 0x05,
-	0x7e, 0x0a, 0x98, 0x44, 0x11, 0x3b, 0xa7, 0x81, 0x97, 0x66, 0xe3, 0x28, 0x14, 0x53, 0xca, 0x45,
-	0xe3, 0xd6, 0x51, 0xf9, 0xb8, 0xd2, 0xb1, 0xfe, 0x1e, 0xf3, 0x6d, 0x4d, 0x91, 0xfb, 0x6e, 0xc1,
-	0x38, 0xd7, 0xc4, 0xa3, 0xc6, 0x0f, 0x2f, 0x5a, 0xa5, 0x3f, 0x5e, 0xb4, 0xd0, 0xd7, 0x6f, 0x5e,
-	0x9d, 0x54, 0xb5, 0xc3, 0xc6, 0x15, 0xeb, 0xa7, 0x2d, 0xa8, 0xb8, 0xe4, 0xfc, 0x3f, 0x8f, 0xfe,
-	0x95, 0x47, 0xd6, 0xcf, 0x08, 0x76, 0x86, 0x2c, 0xc8, 0x22, 0x8a, 0x1b, 0xb0, 0x4b, 0x82, 0x80,
-	0x53, 0x21, 0x8a, 0x0d, 0x5b, 0x85, 0xb8, 0x05, 0xd5, 0x58, 0x6b, 0xbc, 0x84, 0xc4, 0xc5, 0x3e,
-	0xb9, 0x60, 0xa0, 0x27, 0x24, 0xa6, 0x78, 0x1f, 0xca, 0x64, 0x1c, 0x9a, 0x01, 0x5c, 0xb5, 0xc4,
-	0x0f, 0xa0, 0xc2, 0xc9, 0xb9, 0x37, 0xce, 0x25, 0x55, 0x96, 0xa3, 0xe3, 0xdb, 0xee, 0x1e, 0x27,
-	0xe7, 0x1d, 0x15, 0xe3, 0x2e, 0xd4, 0xb3, 0x74, 0xc2, 0x49, 0x40, 0xbd, 0x94, 0x45, 0xa1, 0x9f,
-	0x37, 0xb6, 0x8f, 0xd0, 0x71, 0xfd, 0xfd, 0xc3, 0xf6, 0xfa, 0xf1, 0x6c, 0x9f, 0x19, 0x95, 0xa3,
-	0x45, 0x6e, 0x2d, 0xfb, 0x67, 0x68, 0x7d, 0x85, 0x60, 0xcf, 0xa5, 0x82, 0x65, 0xdc, 0xdf, 0xd4,
-	0xfc, 0x21, 0x80, 0x90, 0x3c, 0xf3, 0xa5, 0x27, 0xc9, 0xa4, 0xe8, 0xbd, 0x62, 0x90, 0x11, 0x99,
-	0xe0, 0xff, 0x41, 0x4d, 0x55, 0xf3, 0x78, 0x91, 0xa9, 0x18, 0xe2, 0xb6, 0x02, 0xaf, 0xb3, 0x6f,
-	0x9a, 0xc6, 0xf2, 0xa0, 0x32, 0x22, 0xe3, 0x88, 0xf6, 0x93, 0xe7, 0x6c, 0x43, 0x1f, 0xf7, 0x61,
-	0x6f, 0x46, 0x73, 0x4f, 0xdd, 0x46, 0x45, 0x17, 0xbb, 0x33, 0x9a, 0x8f, 0xf2, 0x94, 0xaa, 0x16,
-	0xe7, 0x24, 0xca, 0xa8, 0x21, 0x4d, 0x03, 0x15, 0x8d, 0x28, 0xda, 0xfa, 0x06, 0x01, 0xe8, 0x0a,
-	0xbd, 0x44, 0xf2, 0x7c, 0x43, 0x89, 0x7d, 0x28, 0xcf, 0x68, 0x5e, 0x64, 0x57, 0x4b, 0x7c, 0x0f,
-	0xb6, 0x75, 0x9e, 0x22, 0xa9, 0x09, 0xd4, 0x38, 0xaa, 0x95, 0xb5, 0x71, 0x66, 0x34, 0x37, 0xe6,
-	0xb4, 0xa0, 0x6a, 0x9a, 0x31, 0xf4, 0xb6, 0xa6, 0x4d, 0x7f, 0x66, 0xde, 0x4f, 0x01, 0xaf, 0xf9,
-	0xe2, 0xe8, 0x9b, 0xf5, 0x43, 0xd8, 0x29, 0xbc, 0x44, 0x37, 0xf1, 0xb2, 0x10, 0x5b, 0x04, 0x76,
-	0xbb, 0xf4, 0xc2, 0x21, 0x21, 0xc7, 0xef, 0x40, 0x3d, 0xa6, 0x92, 0x04, 0x44, 0x12, 0xef, 0x8b,
-	0x8c, 0x49, 0x5a, 0x8c, 0x57, 0x5b, 0xa1, 0x4f, 0x15, 0x88, 0x6d, 0xa8, 0x5e, 0xcb, 0xa2, 0xb4,
-	0x38, 0xb4, 0xf5, 0xe5, 0xa2, 0x05, 0xc3, 0x02, 0x1e, 0x38, 0x2e, 0xac, 0x24, 0x83, 0xd4, 0xfa,
-	0x16, 0x41, 0xa3, 0x77, 0x41, 0xfd, 0x4c, 0xd2, 0xd3, 0x4c, 0x4e, 0x19, 0x0f, 0xbf, 0x54, 0xbf,
-	0x7e, 0xd2, 0x97, 0x34, 0xd6, 0x45, 0xcd, 0xaf, 0xbd, 0xbe, 0xa7, 0x35, 0x83, 0x9e, 0xde, 0xf4,
-	0x04, 0xbc, 0x0b, 0xf5, 0xe7, 0x59, 0xe2, 0xab, 0xbc, 0x5a, 0x22, 0x1a, 0x65, 0x7d, 0xcf, 0xdd,
-	0xba, 0x5c, 0xb4, 0x90, 0x5b, 0x5b, 0x71, 0x4a, 0x2b, 0x4e, 0x3e, 0x82, 0xda, 0xda, 0x6e, 0xe0,
-	0x3b, 0x50, 0x3d, 0x7b, 0xf2, 0xcc, 0xe9, 0x3d, 0xee, 0x7f, 0xdc, 0xef, 0x75, 0xf7, 0x4b, 0xb8,
-	0x0e, 0xf0, 0xf8, 0xb3, 0xa1, 0x73, 0x3a, 0xea, 0x77, 0x06, 0xbd, 0x7d, 0x84, 0x6b, 0x50, 0xe9,
-	0x0f, 0x87, 0x67, 0xa3, 0x53, 0x15, 0x6e, 0x75, 0x06, 0x97, 0xbf, 0x37, 0x4b, 0x2f, 0x97, 0x4d,
-	0x74, 0xb9, 0x6c, 0xa2, 0xd7, 0xcb, 0x26, 0xfa, 0x6d, 0xd9, 0x44, 0xdf, 0x5d, 0x35, 0x4b, 0xaf,
-	0xaf, 0x9a, 0xa5, 0x5f, 0xae, 0x9a, 0xa5, 0xcf, 0x4f, 0x26, 0xa1, 0x9c, 0x66, 0xe3, 0xb6, 0xcf,
-	0x62, 0xdb, 0x18, 0xf1, 0x5e, 0x44, 0xc6, 0xa2, 0x58, 0xdb, 0x17, 0xe6, 0x75, 0xd4, 0x4f, 0xe3,
-	0x78, 0x47, 0x3f, 0x71, 0x1f, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff, 0x78, 0x6e, 0x6c, 0xb1, 0x39,
-	0x07, 0x00, 0x00,
+func (m *ArgumentConstraint) Reset()         { *m = ArgumentConstraint{} }
+func (m *ArgumentConstraint) String() string { return proto.CompactTextString(m) }
+func (*ArgumentConstraint) ProtoMessage()    {}
+func (*ArgumentConstraint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{9}
 }
-
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *ArgumentConstraint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ArgumentConstraint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ArgumentConstraint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BaseDenom != that1.BaseDenom {
-		return false
-	}
-	if !this.BaseMinGasPrice.Equal(that1.BaseMinGasPrice) {
-		return false
-	}
-	if !this.ContractSharedRevenueRatio.Equal(that1.ContractSharedRevenueRatio) {
-		return false
-	}
-	if len(this.AllowedPublishers) != len(that1.AllowedPublishers) {
-		return false
-	}
-	for i := range this.AllowedPublishers {
-		if this.AllowedPublishers[i] != that1.AllowedPublishers[i] {
-			return false
+}
+func (m *ArgumentConstraint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArgumentConstraint.Merge(m, src)
+}
+func (m *ArgumentConstraint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ArgumentConstraint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArgumentConstraint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArgumentConstraint proto.InternalMessageInfo
+
+// ModuleRevenueRecipient is the registered recipient of the contract
+// shared revenue of a module. The recipient can be an account or
+// a move object address.
+type ModuleRevenueRecipient struct {
+	ModuleAddress string `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Recipient     string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *ModuleRevenueRecipient) Reset()         { *m = ModuleRevenueRecipient{} }
+func (m *ModuleRevenueRecipient) String() string { return proto.CompactTextString(m) }
+func (*ModuleRevenueRecipient) ProtoMessage()    {}
+func (*ModuleRevenueRecipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{10}
+}
+func (m *ModuleRevenueRecipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleRevenueRecipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleRevenueRecipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (this *RawParams) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ModuleRevenueRecipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleRevenueRecipient.Merge(m, src)
+}
+func (m *ModuleRevenueRecipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleRevenueRecipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleRevenueRecipient.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*RawParams)
-	if !ok {
-		that2, ok := that.(RawParams)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ModuleRevenueRecipient proto.InternalMessageInfo
+
+// ModuleRevenueRatio is the governance set contract shared revenue
+// ratio of a module, which overrides `Params.contract_shared_revenue_ratio`.
+type ModuleRevenueRatio struct {
+	ModuleAddress string                      `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string                      `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Ratio         cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=ratio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"ratio"`
+}
+
+func (m *ModuleRevenueRatio) Reset()         { *m = ModuleRevenueRatio{} }
+func (m *ModuleRevenueRatio) String() string { return proto.CompactTextString(m) }
+func (*ModuleRevenueRatio) ProtoMessage()    {}
+func (*ModuleRevenueRatio) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{11}
+}
+func (m *ModuleRevenueRatio) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleRevenueRatio) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleRevenueRatio.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.BaseDenom != that1.BaseDenom {
-		return false
-	}
-	if !this.BaseMinGasPrice.Equal(that1.BaseMinGasPrice) {
-		return false
-	}
-	if !this.ContractSharedRevenueRatio.Equal(that1.ContractSharedRevenueRatio) {
-		return false
-	}
-	return true
 }
-func (this *Module) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ModuleRevenueRatio) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleRevenueRatio.Merge(m, src)
+}
+func (m *ModuleRevenueRatio) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleRevenueRatio) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleRevenueRatio.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Module)
-	if !ok {
-		that2, ok := that.(Module)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ModuleRevenueRatio proto.InternalMessageInfo
+
+// ModuleRevenue is the accumulated contract shared revenue of a module.
+type ModuleRevenue struct {
+	ModuleAddress string                                   `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string                                   `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	TotalRevenue  github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=total_revenue,json=totalRevenue,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"total_revenue"`
+}
+
+func (m *ModuleRevenue) Reset()         { *m = ModuleRevenue{} }
+func (m *ModuleRevenue) String() string { return proto.CompactTextString(m) }
+func (*ModuleRevenue) ProtoMessage()    {}
+func (*ModuleRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{12}
+}
+func (m *ModuleRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.ModuleName != that1.ModuleName {
-		return false
-	}
-	if this.Abi != that1.Abi {
-		return false
-	}
-	if !bytes.Equal(this.RawBytes, that1.RawBytes) {
-		return false
-	}
-	if this.UpgradePolicy != that1.UpgradePolicy {
-		return false
-	}
-	return true
 }
-func (this *Resource) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ModuleRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleRevenue.Merge(m, src)
+}
+func (m *ModuleRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleRevenue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*Resource)
-	if !ok {
-		that2, ok := that.(Resource)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ModuleRevenue proto.InternalMessageInfo
+
+// ClaimableRevenue is the contract shared revenue accrued in the escrow,
+// which is not claimed by the recipient yet.
+type ClaimableRevenue struct {
+	Recipient string                                   `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Amount    github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=amount,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"amount"`
+}
+
+func (m *ClaimableRevenue) Reset()         { *m = ClaimableRevenue{} }
+func (m *ClaimableRevenue) String() string { return proto.CompactTextString(m) }
+func (*ClaimableRevenue) ProtoMessage()    {}
+func (*ClaimableRevenue) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{13}
+}
+func (m *ClaimableRevenue) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ClaimableRevenue) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ClaimableRevenue.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.StructTag != that1.StructTag {
-		return false
-	}
-	if this.MoveResource != that1.MoveResource {
-		return false
-	}
-	if !bytes.Equal(this.RawBytes, that1.RawBytes) {
-		return false
-	}
-	return true
 }
-func (this *TableInfo) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *ClaimableRevenue) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClaimableRevenue.Merge(m, src)
+}
+func (m *ClaimableRevenue) XXX_Size() int {
+	return m.Size()
+}
+func (m *ClaimableRevenue) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClaimableRevenue.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*TableInfo)
-	if !ok {
-		that2, ok := that.(TableInfo)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_ClaimableRevenue proto.InternalMessageInfo
+
+// CronJob is the scheduled entry function call, which is executed
+// at the end of the block in every `interval` blocks with the prepaid fee.
+type CronJob struct {
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// creator is the sender of the scheduled call, who prepaid the fee
+	Creator       string `protobuf:"bytes,2,opt,name=creator,proto3" json:"creator,omitempty"`
+	ModuleAddress string `protobuf:"bytes,3,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,4,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	FunctionName  string `protobuf:"bytes,5,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+	// type_args is the type arguments of the function
+	TypeArgs []string `protobuf:"bytes,6,rep,name=type_args,json=typeArgs,proto3" json:"type_args,omitempty"`
+	// args is the bcs encoded arguments of the function
+	Args [][]byte `protobuf:"bytes,7,rep,name=args,proto3" json:"args,omitempty"`
+	// interval is the number of blocks between the runs
+	Interval uint64 `protobuf:"varint,8,opt,name=interval,proto3" json:"interval,omitempty"`
+	// gas_limit is the gas budget of each run
+	GasLimit uint64 `protobuf:"varint,9,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// deposit is the remaining prepaid fee in the escrow
+	Deposit types.Coin `protobuf:"bytes,10,opt,name=deposit,proto3" json:"deposit"`
+	// next_run_height is the height of the next run
+	NextRunHeight int64 `protobuf:"varint,11,opt,name=next_run_height,json=nextRunHeight,proto3" json:"next_run_height,omitempty"`
+	// failure_count is the number of consecutive failed runs
+	FailureCount uint64 `protobuf:"varint,12,opt,name=failure_count,json=failureCount,proto3" json:"failure_count,omitempty"`
+}
+
+func (m *CronJob) Reset()         { *m = CronJob{} }
+func (m *CronJob) String() string { return proto.CompactTextString(m) }
+func (*CronJob) ProtoMessage()    {}
+func (*CronJob) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{14}
+}
+func (m *CronJob) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronJob) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronJob.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.KeyType != that1.KeyType {
-		return false
-	}
-	if this.ValueType != that1.ValueType {
-		return false
-	}
-	return true
 }
-func (this *TableEntry) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CronJob) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronJob.Merge(m, src)
+}
+func (m *CronJob) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronJob) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronJob.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*TableEntry)
-	if !ok {
-		that2, ok := that.(TableEntry)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Address != that1.Address {
-		return false
-	}
-	if this.Key != that1.Key {
-		return false
-	}
-	if this.Value != that1.Value {
-		return false
-	}
-	if !bytes.Equal(this.KeyBytes, that1.KeyBytes) {
-		return false
-	}
-	if !bytes.Equal(this.ValueBytes, that1.ValueBytes) {
-		return false
-	}
-	return true
+var xxx_messageInfo_CronJob proto.InternalMessageInfo
+
+// CronRun is the execution record of a cron job.
+type CronRun struct {
+	JobId   uint64 `protobuf:"varint,1,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	Height  int64  `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Success bool   `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// fee is the fee charged from the deposit
+	Fee types.Coin `protobuf:"bytes,5,opt,name=fee,proto3" json:"fee"`
+	// error is the error message of the failed run
+	Error string `protobuf:"bytes,6,opt,name=error,proto3" json:"error,omitempty"`
 }
-func (this *UpgradePolicyProto) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*UpgradePolicyProto)
-	if !ok {
-		that2, ok := that.(UpgradePolicyProto)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *CronRun) Reset()         { *m = CronRun{} }
+func (m *CronRun) String() string { return proto.CompactTextString(m) }
+func (*CronRun) ProtoMessage()    {}
+func (*CronRun) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{15}
+}
+func (m *CronRun) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *CronRun) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_CronRun.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.Policy != that1.Policy {
-		return false
-	}
-	return true
 }
-func (this *DexPair) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *CronRun) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CronRun.Merge(m, src)
+}
+func (m *CronRun) XXX_Size() int {
+	return m.Size()
+}
+func (m *CronRun) XXX_DiscardUnknown() {
+	xxx_messageInfo_CronRun.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*DexPair)
-	if !ok {
-		that2, ok := that.(DexPair)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.MetadataQuote != that1.MetadataQuote {
-		return false
-	}
-	if this.MetadataLP != that1.MetadataLP {
-		return false
-	}
-	return true
+var xxx_messageInfo_CronRun proto.InternalMessageInfo
+
+// PublishNamespace is the module name prefixes which an address can publish.
+type PublishNamespace struct {
+	Address  string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	Prefixes []string `protobuf:"bytes,2,rep,name=prefixes,proto3" json:"prefixes,omitempty"`
 }
-func (this *ExecuteAuthorizationItem) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
 
-	that1, ok := that.(*ExecuteAuthorizationItem)
-	if !ok {
-		that2, ok := that.(ExecuteAuthorizationItem)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
-		}
-	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.ModuleAddress != that1.ModuleAddress {
-		return false
-	}
-	if this.ModuleName != that1.ModuleName {
-		return false
-	}
-	if len(this.FunctionNames) != len(that1.FunctionNames) {
-		return false
-	}
-	for i := range this.FunctionNames {
-		if this.FunctionNames[i] != that1.FunctionNames[i] {
-			return false
+func (m *PublishNamespace) Reset()         { *m = PublishNamespace{} }
+func (m *PublishNamespace) String() string { return proto.CompactTextString(m) }
+func (*PublishNamespace) ProtoMessage()    {}
+func (*PublishNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{16}
+}
+func (m *PublishNamespace) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishNamespace.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *PublishNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishNamespace.Merge(m, src)
+}
+func (m *PublishNamespace) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishNamespace.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PublishNamespace proto.InternalMessageInfo
+
+// PublishUsage is the number of modules published by an address
+// in the current quota window.
+type PublishUsage struct {
+	Address           string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	WindowStartHeight int64  `protobuf:"varint,2,opt,name=window_start_height,json=windowStartHeight,proto3" json:"window_start_height,omitempty"`
+	Count             uint64 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.AllowedPublishers) > 0 {
-		for iNdEx := len(m.AllowedPublishers) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.AllowedPublishers[iNdEx])
-			copy(dAtA[i:], m.AllowedPublishers[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedPublishers[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	{
-		size := m.ContractSharedRevenueRatio.Size()
-		i -= size
-		if _, err := m.ContractSharedRevenueRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseMinGasPrice.Size()
-		i -= size
-		if _, err := m.BaseMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *PublishUsage) Reset()         { *m = PublishUsage{} }
+func (m *PublishUsage) String() string { return proto.CompactTextString(m) }
+func (*PublishUsage) ProtoMessage()    {}
+func (*PublishUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{17}
+}
+func (m *PublishUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *PublishUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishUsage.Merge(m, src)
+}
+func (m *PublishUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishUsage.DiscardUnknown(m)
 }
 
-func (m *RawParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_PublishUsage proto.InternalMessageInfo
+
+// PublishDeposit is the deposit charged on the module publish, which is
+// refunded to the depositor when the module is frozen.
+type PublishDeposit struct {
+	ModuleAddress string     `protobuf:"bytes,1,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string     `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Depositor     string     `protobuf:"bytes,3,opt,name=depositor,proto3" json:"depositor,omitempty"`
+	Amount        types.Coin `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount"`
+}
+
+func (m *PublishDeposit) Reset()         { *m = PublishDeposit{} }
+func (m *PublishDeposit) String() string { return proto.CompactTextString(m) }
+func (*PublishDeposit) ProtoMessage()    {}
+func (*PublishDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{18}
+}
+func (m *PublishDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PublishDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PublishDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *PublishDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PublishDeposit.Merge(m, src)
+}
+func (m *PublishDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PublishDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PublishDeposit.DiscardUnknown(m)
 }
 
-func (m *RawParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_PublishDeposit proto.InternalMessageInfo
+
+// ModuleIdentifier is the address and the name of a module.
+type ModuleIdentifier struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
 }
 
-func (m *RawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.ContractSharedRevenueRatio.Size()
-		i -= size
-		if _, err := m.ContractSharedRevenueRatio.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.BaseMinGasPrice.Size()
-		i -= size
-		if _, err := m.BaseMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+func (m *ModuleIdentifier) Reset()         { *m = ModuleIdentifier{} }
+func (m *ModuleIdentifier) String() string { return proto.CompactTextString(m) }
+func (*ModuleIdentifier) ProtoMessage()    {}
+func (*ModuleIdentifier) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{19}
+}
+func (m *ModuleIdentifier) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleIdentifier) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleIdentifier.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintTypes(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.BaseDenom) > 0 {
-		i -= len(m.BaseDenom)
-		copy(dAtA[i:], m.BaseDenom)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseDenom)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
 }
-
-func (m *Module) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *ModuleIdentifier) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleIdentifier.Merge(m, src)
 }
-
-func (m *Module) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ModuleIdentifier) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleIdentifier) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleIdentifier.DiscardUnknown(m)
 }
 
-func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.UpgradePolicy != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.UpgradePolicy))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.RawBytes) > 0 {
-		i -= len(m.RawBytes)
-		copy(dAtA[i:], m.RawBytes)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RawBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Abi) > 0 {
-		i -= len(m.Abi)
-		copy(dAtA[i:], m.Abi)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Abi)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ModuleIdentifier proto.InternalMessageInfo
+
+// ModuleFriends is the friend declarations of a module.
+type ModuleFriends struct {
+	Address    string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string   `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Friends    []string `protobuf:"bytes,3,rep,name=friends,proto3" json:"friends,omitempty"`
 }
 
-func (m *Resource) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *ModuleFriends) Reset()         { *m = ModuleFriends{} }
+func (m *ModuleFriends) String() string { return proto.CompactTextString(m) }
+func (*ModuleFriends) ProtoMessage()    {}
+func (*ModuleFriends) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{20}
+}
+func (m *ModuleFriends) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleFriends) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleFriends.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *ModuleFriends) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleFriends.Merge(m, src)
+}
+func (m *ModuleFriends) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleFriends) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleFriends.DiscardUnknown(m)
 }
 
-func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RawBytes) > 0 {
-		i -= len(m.RawBytes)
-		copy(dAtA[i:], m.RawBytes)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.RawBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.MoveResource) > 0 {
-		i -= len(m.MoveResource)
-		copy(dAtA[i:], m.MoveResource)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MoveResource)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.StructTag) > 0 {
-		i -= len(m.StructTag)
-		copy(dAtA[i:], m.StructTag)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.StructTag)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+var xxx_messageInfo_ModuleFriends proto.InternalMessageInfo
+
+// FunctionSignature is the signature of an entry or view function.
+type FunctionSignature struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	Name       string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	IsEntry    bool   `protobuf:"varint,4,opt,name=is_entry,json=isEntry,proto3" json:"is_entry,omitempty"`
+	IsView     bool   `protobuf:"varint,5,opt,name=is_view,json=isView,proto3" json:"is_view,omitempty"`
+	// type_params is the generic type parameters with the ability
+	// constraints, e.g. `T0: copy + drop`
+	TypeParams []string `protobuf:"bytes,6,rep,name=type_params,json=typeParams,proto3" json:"type_params,omitempty"`
+	Params     []string `protobuf:"bytes,7,rep,name=params,proto3" json:"params,omitempty"`
+	Returns    []string `protobuf:"bytes,8,rep,name=returns,proto3" json:"returns,omitempty"`
 }
 
-func (m *TableInfo) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *FunctionSignature) Reset()         { *m = FunctionSignature{} }
+func (m *FunctionSignature) String() string { return proto.CompactTextString(m) }
+func (*FunctionSignature) ProtoMessage()    {}
+func (*FunctionSignature) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{21}
+}
+func (m *FunctionSignature) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FunctionSignature) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FunctionSignature.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *FunctionSignature) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FunctionSignature.Merge(m, src)
+}
+func (m *FunctionSignature) XXX_Size() int {
+	return m.Size()
+}
+func (m *FunctionSignature) XXX_DiscardUnknown() {
+	xxx_messageInfo_FunctionSignature.DiscardUnknown(m)
 }
 
-func (m *TableInfo) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_FunctionSignature proto.InternalMessageInfo
+
+// ModuleUpgradeCompatibility is the pre-flight check result of publishing
+// a module of a module bundle.
+type ModuleUpgradeCompatibility struct {
+	Address    string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleName string `protobuf:"bytes,2,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	// is_new is true if the module is not published yet
+	IsNew bool `protobuf:"varint,3,opt,name=is_new,json=isNew,proto3" json:"is_new,omitempty"`
+	// stored_upgrade_policy is the upgrade policy of the published module
+	StoredUpgradePolicy UpgradePolicy `protobuf:"varint,4,opt,name=stored_upgrade_policy,json=storedUpgradePolicy,proto3,enum=initia.move.v1.UpgradePolicy" json:"stored_upgrade_policy,omitempty"`
+	// allowed is true if the upgrade is allowed under the stored upgrade policy
+	Allowed bool `protobuf:"varint,5,opt,name=allowed,proto3" json:"allowed,omitempty"`
+	// reason describes why the upgrade is not allowed
+	Reason string `protobuf:"bytes,6,opt,name=reason,proto3" json:"reason,omitempty"`
+	// removed_functions and changed_functions are the public functions
+	// which are removed or whose signature is changed
+	RemovedFunctions []string `protobuf:"bytes,7,rep,name=removed_functions,json=removedFunctions,proto3" json:"removed_functions,omitempty"`
+	ChangedFunctions []string `protobuf:"bytes,8,rep,name=changed_functions,json=changedFunctions,proto3" json:"changed_functions,omitempty"`
+	// removed_structs and changed_structs are the structs which are
+	// removed or whose abilities, type parameters or fields are changed
+	RemovedStructs []string `protobuf:"bytes,9,rep,name=removed_structs,json=removedStructs,proto3" json:"removed_structs,omitempty"`
+	ChangedStructs []string `protobuf:"bytes,10,rep,name=changed_structs,json=changedStructs,proto3" json:"changed_structs,omitempty"`
+	// broken_dependents is the published modules which use the removed or
+	// changed functions and structs
+	BrokenDependents []ModuleIdentifier `protobuf:"bytes,11,rep,name=broken_dependents,json=brokenDependents,proto3" json:"broken_dependents"`
 }
 
-func (m *TableInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValueType) > 0 {
-		i -= len(m.ValueType)
-		copy(dAtA[i:], m.ValueType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValueType)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.KeyType) > 0 {
-		i -= len(m.KeyType)
-		copy(dAtA[i:], m.KeyType)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyType)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
+func (m *ModuleUpgradeCompatibility) Reset()         { *m = ModuleUpgradeCompatibility{} }
+func (m *ModuleUpgradeCompatibility) String() string { return proto.CompactTextString(m) }
+func (*ModuleUpgradeCompatibility) ProtoMessage()    {}
+func (*ModuleUpgradeCompatibility) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{22}
+}
+func (m *ModuleUpgradeCompatibility) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ModuleUpgradeCompatibility) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ModuleUpgradeCompatibility.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *ModuleUpgradeCompatibility) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModuleUpgradeCompatibility.Merge(m, src)
+}
+func (m *ModuleUpgradeCompatibility) XXX_Size() int {
+	return m.Size()
+}
+func (m *ModuleUpgradeCompatibility) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModuleUpgradeCompatibility.DiscardUnknown(m)
 }
 
-func (m *TableEntry) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ModuleUpgradeCompatibility proto.InternalMessageInfo
+
+// Authenticator is the move view function which authenticates the signatures
+// of an account instead of the account public key. The function must be
+// `fun(account: address, sign_bytes: vector<u8>, signature: vector<u8>): bool`.
+type Authenticator struct {
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	FunctionName  string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
+}
+
+func (m *Authenticator) Reset()         { *m = Authenticator{} }
+func (m *Authenticator) String() string { return proto.CompactTextString(m) }
+func (*Authenticator) ProtoMessage()    {}
+func (*Authenticator) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{23}
+}
+func (m *Authenticator) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Authenticator) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Authenticator.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *Authenticator) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Authenticator.Merge(m, src)
+}
+func (m *Authenticator) XXX_Size() int {
+	return m.Size()
+}
+func (m *Authenticator) XXX_DiscardUnknown() {
+	xxx_messageInfo_Authenticator.DiscardUnknown(m)
 }
 
-func (m *TableEntry) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Authenticator proto.InternalMessageInfo
+
+// Sponsor is the move view function which decides whether the sponsor pays
+// the fee of a tx naming the sponsor as the fee granter. The function must be
+// `fun(sponsor: address, payer: address, msg_types: vector<String>,
+//
+//	fee_denoms: vector<String>, fee_amounts: vector<u64>, gas_limit: u64): bool`.
+type Sponsor struct {
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	ModuleAddress string `protobuf:"bytes,2,opt,name=module_address,json=moduleAddress,proto3" json:"module_address,omitempty"`
+	ModuleName    string `protobuf:"bytes,3,opt,name=module_name,json=moduleName,proto3" json:"module_name,omitempty"`
+	FunctionName  string `protobuf:"bytes,4,opt,name=function_name,json=functionName,proto3" json:"function_name,omitempty"`
 }
 
-func (m *TableEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.ValueBytes) > 0 {
-		i -= len(m.ValueBytes)
-		copy(dAtA[i:], m.ValueBytes)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValueBytes)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.KeyBytes) > 0 {
-		i -= len(m.KeyBytes)
-		copy(dAtA[i:], m.KeyBytes)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyBytes)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.Value) > 0 {
-		i -= len(m.Value)
-		copy(dAtA[i:], m.Value)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Address) > 0 {
-		i -= len(m.Address)
-		copy(dAtA[i:], m.Address)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+func (m *Sponsor) Reset()         { *m = Sponsor{} }
+func (m *Sponsor) String() string { return proto.CompactTextString(m) }
+func (*Sponsor) ProtoMessage()    {}
+func (*Sponsor) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{24}
 }
-
-func (m *UpgradePolicyProto) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *Sponsor) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Sponsor) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Sponsor.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *UpgradePolicyProto) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *Sponsor) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Sponsor.Merge(m, src)
 }
-
-func (m *UpgradePolicyProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Policy != 0 {
-		i = encodeVarintTypes(dAtA, i, uint64(m.Policy))
-		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
+func (m *Sponsor) XXX_Size() int {
+	return m.Size()
 }
-
-func (m *DexPair) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *Sponsor) XXX_DiscardUnknown() {
+	xxx_messageInfo_Sponsor.DiscardUnknown(m)
 }
 
-func (m *DexPair) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_Sponsor proto.InternalMessageInfo
+
+// VotingPowerWeightObservation is the LP voting power weight observed at
+// the beginning of a block, which is the locked base balance of the dex
+// pool divided by the LP supply.
+type VotingPowerWeightObservation struct {
+	Denom  string                      `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	Height int64                       `protobuf:"varint,2,opt,name=height,proto3" json:"height,omitempty"`
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
 }
 
-func (m *DexPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.MetadataLP) > 0 {
-		i -= len(m.MetadataLP)
-		copy(dAtA[i:], m.MetadataLP)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataLP)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.MetadataQuote) > 0 {
-		i -= len(m.MetadataQuote)
-		copy(dAtA[i:], m.MetadataQuote)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataQuote)))
-		i--
-		dAtA[i] = 0xa
+func (m *VotingPowerWeightObservation) Reset()         { *m = VotingPowerWeightObservation{} }
+func (m *VotingPowerWeightObservation) String() string { return proto.CompactTextString(m) }
+func (*VotingPowerWeightObservation) ProtoMessage()    {}
+func (*VotingPowerWeightObservation) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5ab4b0783858a3a5, []int{25}
+}
+func (m *VotingPowerWeightObservation) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VotingPowerWeightObservation) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VotingPowerWeightObservation.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *VotingPowerWeightObservation) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VotingPowerWeightObservation.Merge(m, src)
+}
+func (m *VotingPowerWeightObservation) XXX_Size() int {
+	return m.Size()
+}
+func (m *VotingPowerWeightObservation) XXX_DiscardUnknown() {
+	xxx_messageInfo_VotingPowerWeightObservation.DiscardUnknown(m)
 }
 
-func (m *ExecuteAuthorizationItem) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+var xxx_messageInfo_VotingPowerWeightObservation proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterEnum("initia.move.v1.UpgradePolicy", UpgradePolicy_name, UpgradePolicy_value)
+	proto.RegisterEnum("initia.move.v1.PublishMode", PublishMode_name, PublishMode_value)
+	proto.RegisterType((*Params)(nil), "initia.move.v1.Params")
+	proto.RegisterType((*RawParams)(nil), "initia.move.v1.RawParams")
+	proto.RegisterType((*Module)(nil), "initia.move.v1.Module")
+	proto.RegisterType((*Resource)(nil), "initia.move.v1.Resource")
+	proto.RegisterType((*TableInfo)(nil), "initia.move.v1.TableInfo")
+	proto.RegisterType((*TableEntry)(nil), "initia.move.v1.TableEntry")
+	proto.RegisterType((*UpgradePolicyProto)(nil), "initia.move.v1.UpgradePolicyProto")
+	proto.RegisterType((*DexPair)(nil), "initia.move.v1.DexPair")
+	proto.RegisterType((*ExecuteAuthorizationItem)(nil), "initia.move.v1.ExecuteAuthorizationItem")
+	proto.RegisterType((*ArgumentConstraint)(nil), "initia.move.v1.ArgumentConstraint")
+	proto.RegisterType((*ModuleRevenueRecipient)(nil), "initia.move.v1.ModuleRevenueRecipient")
+	proto.RegisterType((*ModuleRevenueRatio)(nil), "initia.move.v1.ModuleRevenueRatio")
+	proto.RegisterType((*ModuleRevenue)(nil), "initia.move.v1.ModuleRevenue")
+	proto.RegisterType((*ClaimableRevenue)(nil), "initia.move.v1.ClaimableRevenue")
+	proto.RegisterType((*CronJob)(nil), "initia.move.v1.CronJob")
+	proto.RegisterType((*CronRun)(nil), "initia.move.v1.CronRun")
+	proto.RegisterType((*PublishNamespace)(nil), "initia.move.v1.PublishNamespace")
+	proto.RegisterType((*PublishUsage)(nil), "initia.move.v1.PublishUsage")
+	proto.RegisterType((*PublishDeposit)(nil), "initia.move.v1.PublishDeposit")
+	proto.RegisterType((*ModuleIdentifier)(nil), "initia.move.v1.ModuleIdentifier")
+	proto.RegisterType((*ModuleFriends)(nil), "initia.move.v1.ModuleFriends")
+	proto.RegisterType((*FunctionSignature)(nil), "initia.move.v1.FunctionSignature")
+	proto.RegisterType((*ModuleUpgradeCompatibility)(nil), "initia.move.v1.ModuleUpgradeCompatibility")
+	proto.RegisterType((*Authenticator)(nil), "initia.move.v1.Authenticator")
+	proto.RegisterType((*Sponsor)(nil), "initia.move.v1.Sponsor")
+	proto.RegisterType((*VotingPowerWeightObservation)(nil), "initia.move.v1.VotingPowerWeightObservation")
 }
 
-func (m *ExecuteAuthorizationItem) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func init() { proto.RegisterFile("initia/move/v1/types.proto", fileDescriptor_5ab4b0783858a3a5) }
+
+var fileDescriptor_5ab4b0783858a3a5 = []byte{
+	// 2392 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0xd9, 0xd7, 0x8a, 0x14, 0x3f, 0x1e, 0x8a, 0x14, 0x35, 0xb6, 0xe4, 0x35, 0x1d, 0x93, 0x7a, 0xd7,
+	0x6f, 0x5b, 0x41, 0x41, 0x28, 0xc4, 0x6d, 0x82, 0x22, 0x48, 0xd3, 0x52, 0x1f, 0xb6, 0xd5, 0x4a,
+	0x16, 0xbd, 0x92, 0xec, 0x22, 0x28, 0xb0, 0x18, 0xee, 0x8e, 0xa8, 0x89, 0xc8, 0x5d, 0x66, 0x66,
+	0x57, 0x14, 0x7b, 0xe8, 0xa9, 0x05, 0x0a, 0xa3, 0x28, 0x02, 0x14, 0x68, 0x8b, 0xa2, 0x06, 0x02,
+	0xe4, 0x12, 0x14, 0x28, 0x90, 0x43, 0x0f, 0x05, 0xf2, 0x0f, 0xf8, 0x54, 0xa4, 0xb7, 0xa2, 0x07,
+	0xb6, 0x75, 0x0e, 0x69, 0xaf, 0xba, 0xf5, 0x56, 0xcc, 0xc7, 0x52, 0xa4, 0x64, 0xab, 0x0a, 0x2c,
+	0xa7, 0x3d, 0xf4, 0x22, 0xed, 0xf3, 0x31, 0xcf, 0x3c, 0x1f, 0x33, 0xbf, 0x79, 0x66, 0x08, 0x25,
+	0xea, 0xd3, 0x90, 0xe2, 0xc5, 0x76, 0x70, 0x40, 0x16, 0x0f, 0x5e, 0x5d, 0x0c, 0x7b, 0x1d, 0xc2,
+	0xab, 0x1d, 0x16, 0x84, 0x01, 0x2a, 0x28, 0x59, 0x55, 0xc8, 0xaa, 0x07, 0xaf, 0x96, 0xa6, 0x71,
+	0x9b, 0xfa, 0xc1, 0xa2, 0xfc, 0xab, 0x54, 0x4a, 0x65, 0x37, 0xe0, 0xed, 0x80, 0x2f, 0x36, 0x30,
+	0x17, 0xc3, 0x1b, 0x24, 0xc4, 0xaf, 0x2e, 0xba, 0x01, 0xf5, 0xb5, 0xfc, 0x72, 0x33, 0x68, 0x06,
+	0xf2, 0x73, 0x51, 0x7c, 0x29, 0xae, 0xf5, 0x31, 0x40, 0xaa, 0x8e, 0x19, 0x6e, 0x73, 0x74, 0x1d,
+	0x40, 0x8c, 0x75, 0x3c, 0xe2, 0x07, 0x6d, 0xd3, 0x98, 0x33, 0xe6, 0xb3, 0x76, 0x56, 0x70, 0x56,
+	0x04, 0x03, 0x31, 0x40, 0x52, 0xdc, 0xa6, 0xbe, 0xd3, 0xc4, 0xdc, 0xe9, 0x30, 0xea, 0x12, 0x73,
+	0x5c, 0xa8, 0x2d, 0xad, 0x3e, 0xee, 0x57, 0xc6, 0xfe, 0xdc, 0xaf, 0x5c, 0x53, 0x3e, 0x70, 0x6f,
+	0xbf, 0x4a, 0x83, 0xc5, 0x36, 0x0e, 0xf7, 0xaa, 0xeb, 0xa4, 0x89, 0xdd, 0xde, 0x0a, 0x71, 0x8f,
+	0xfa, 0x95, 0xab, 0x3d, 0xdc, 0x6e, 0xbd, 0x61, 0x9d, 0x36, 0x63, 0x7d, 0xf8, 0xd9, 0x47, 0x0b,
+	0x86, 0x3d, 0x25, 0x24, 0x1b, 0xd4, 0xbf, 0x8d, 0x79, 0x5d, 0xb0, 0xd1, 0xcf, 0x0c, 0xb8, 0xee,
+	0x06, 0x7e, 0xc8, 0xb0, 0x1b, 0x3a, 0x7c, 0x0f, 0x33, 0xe2, 0x39, 0x8c, 0x1c, 0x10, 0x3f, 0x22,
+	0x0e, 0xc3, 0x21, 0x0d, 0xcc, 0x84, 0x9c, 0xbf, 0x7e, 0xbe, 0xf9, 0xff, 0x5f, 0xcd, 0x7f, 0xa6,
+	0x45, 0xed, 0x4a, 0x29, 0x56, 0xda, 0x92, 0x3a, 0xb6, 0x52, 0xb1, 0x85, 0x06, 0xba, 0x07, 0x08,
+	0xb7, 0x5a, 0x41, 0x97, 0x78, 0x4e, 0x27, 0x6a, 0xb4, 0x28, 0xdf, 0x23, 0x8c, 0x9b, 0xc9, 0xb9,
+	0xc4, 0x7c, 0x76, 0xc9, 0x3a, 0x0e, 0xf3, 0xb4, 0x8e, 0xb6, 0x3d, 0xad, 0x25, 0xf5, 0x81, 0x00,
+	0xed, 0xc0, 0xac, 0xcb, 0x02, 0xdf, 0x69, 0xe3, 0x43, 0x95, 0x15, 0xc2, 0x9c, 0x46, 0x2b, 0x70,
+	0xf7, 0xcd, 0x89, 0x39, 0x63, 0x3e, 0xb9, 0xf4, 0x7f, 0x47, 0xfd, 0xca, 0x75, 0xed, 0xfd, 0x53,
+	0xf5, 0x2c, 0x1b, 0x09, 0xc1, 0x06, 0x3e, 0x14, 0xc9, 0x23, 0x6c, 0x49, 0x30, 0xd1, 0x1d, 0x98,
+	0x1e, 0xa8, 0xef, 0x62, 0xda, 0x8a, 0x18, 0xe1, 0x66, 0x4a, 0x5a, 0x7c, 0xe9, 0xa8, 0x5f, 0x31,
+	0x4f, 0x58, 0x8c, 0x55, 0x2c, 0x7b, 0x4a, 0x1b, 0xbb, 0xa5, 0x39, 0xe8, 0x01, 0x4c, 0xea, 0x38,
+	0x9c, 0x76, 0xe0, 0x11, 0x33, 0x3d, 0x67, 0xcc, 0x17, 0x6e, 0x5e, 0xab, 0x8e, 0xae, 0xcb, 0xaa,
+	0x0e, 0x69, 0x23, 0xf0, 0xc8, 0xd2, 0x95, 0xa3, 0x7e, 0xe5, 0x92, 0x9a, 0x61, 0x78, 0xa8, 0x65,
+	0xe7, 0x3a, 0xc7, 0x5a, 0x88, 0xc0, 0x54, 0x2c, 0xf5, 0x48, 0x27, 0xe0, 0x34, 0x34, 0x33, 0xb2,
+	0xa6, 0x6f, 0xea, 0x9a, 0xce, 0x9c, 0xae, 0xe9, 0x9a, 0x1f, 0x1e, 0xf5, 0x2b, 0xb3, 0xa3, 0xb6,
+	0xf5, 0x68, 0x9d, 0xe3, 0x82, 0x66, 0xaf, 0x28, 0x2e, 0xfa, 0x06, 0xe4, 0x63, 0xc5, 0x77, 0xa3,
+	0x20, 0xc4, 0x66, 0x56, 0x66, 0xc1, 0x3c, 0xea, 0x57, 0x2e, 0x8f, 0xda, 0x91, 0x62, 0xcb, 0x8e,
+	0xc3, 0xbd, 0x27, 0x48, 0x74, 0x0f, 0x2e, 0x8f, 0xc8, 0x9d, 0x2e, 0xf5, 0xbd, 0xa0, 0x6b, 0x82,
+	0xb4, 0x52, 0x39, 0xea, 0x57, 0xae, 0x3d, 0xc5, 0x8a, 0xd6, 0xb2, 0x6c, 0x34, 0x6c, 0xec, 0x81,
+	0x64, 0xa2, 0xb7, 0xe1, 0x0a, 0x8e, 0xc2, 0x3d, 0xe2, 0x87, 0xd4, 0xc5, 0x61, 0xc0, 0x64, 0x3d,
+	0x5b, 0xb4, 0x4d, 0x43, 0x33, 0x27, 0xad, 0x8a, 0xa5, 0x54, 0xd6, 0x4b, 0xe9, 0xe9, 0x8a, 0x96,
+	0x3d, 0x33, 0x22, 0xb9, 0x8d, 0xf9, 0xba, 0xe0, 0x8b, 0xba, 0xf3, 0x4e, 0xe0, 0xf3, 0x11, 0xab,
+	0x93, 0x27, 0xeb, 0x7e, 0x4a, 0xc5, 0xb2, 0xa7, 0x34, 0x6f, 0x60, 0xe9, 0x7b, 0x60, 0x1e, 0x04,
+	0x21, 0xf5, 0x9b, 0x4e, 0x27, 0xe8, 0x12, 0xe6, 0x84, 0x5d, 0xdc, 0x89, 0x83, 0xcf, 0x4b, 0x83,
+	0x37, 0x8e, 0xfa, 0x95, 0x8a, 0x32, 0xf8, 0x2c, 0x4d, 0xcb, 0x9e, 0x51, 0xa2, 0xba, 0x90, 0x6c,
+	0x77, 0x71, 0x47, 0xe7, 0xe0, 0x03, 0x03, 0x6e, 0x88, 0x85, 0x37, 0x32, 0xb0, 0x4b, 0x68, 0x73,
+	0x2f, 0x74, 0xdc, 0x3d, 0xec, 0x37, 0xe5, 0xa6, 0x24, 0x66, 0x41, 0xae, 0x88, 0xfb, 0xe7, 0xdb,
+	0xe5, 0x0b, 0xca, 0x99, 0x73, 0xd8, 0xd5, 0x6b, 0xa5, 0xdc, 0xc6, 0x87, 0xf7, 0x8f, 0xfd, 0x7b,
+	0x20, 0xf5, 0x96, 0xa5, 0x9a, 0x8d, 0x43, 0xf2, 0x86, 0xf9, 0xcb, 0xf7, 0x2b, 0x63, 0x7f, 0x7f,
+	0xbf, 0x62, 0x3c, 0xfc, 0xec, 0xa3, 0x85, 0x9c, 0x84, 0x67, 0x05, 0x99, 0xd6, 0x1f, 0xb3, 0x90,
+	0xb5, 0x71, 0xf7, 0x7f, 0x00, 0xfa, 0xf9, 0x00, 0xf4, 0xd9, 0x68, 0x97, 0xbc, 0x70, 0xb4, 0x9b,
+	0xb8, 0x08, 0xb4, 0x4b, 0xbd, 0x40, 0xb4, 0x4b, 0x7f, 0x11, 0x68, 0x97, 0xb9, 0x10, 0xb4, 0xcb,
+	0xbe, 0x10, 0xb4, 0x83, 0x17, 0x82, 0x76, 0xb9, 0x8b, 0x46, 0xbb, 0xc9, 0x2f, 0x0c, 0xed, 0xf2,
+	0xff, 0x49, 0xb4, 0xb3, 0x7e, 0x6f, 0x40, 0x6a, 0x23, 0xf0, 0xa2, 0x16, 0x41, 0x26, 0xa4, 0xb1,
+	0xe7, 0x31, 0xc2, 0xb9, 0x46, 0xb3, 0x98, 0x44, 0x15, 0xc8, 0xb5, 0xa5, 0x8e, 0xe3, 0xe3, 0xb6,
+	0x06, 0x31, 0x1b, 0x14, 0xeb, 0x2e, 0x6e, 0x13, 0x54, 0x84, 0x04, 0x6e, 0x50, 0x85, 0x2e, 0xb6,
+	0xf8, 0x44, 0xd7, 0x20, 0xcb, 0x70, 0xd7, 0x69, 0xf4, 0x42, 0xc2, 0xe5, 0x3e, 0x9f, 0xb4, 0x33,
+	0x0c, 0x77, 0x97, 0x04, 0x8d, 0x56, 0xa0, 0x10, 0x75, 0x9a, 0x0c, 0x7b, 0xc4, 0xe9, 0x04, 0x2d,
+	0xea, 0xf6, 0xe4, 0xbe, 0x2d, 0xdc, 0xbc, 0x7e, 0x72, 0xcb, 0xed, 0x28, 0xad, 0xba, 0x54, 0xb2,
+	0xf3, 0xd1, 0x30, 0x69, 0xfd, 0xc8, 0x80, 0x8c, 0x4d, 0x78, 0x10, 0x31, 0xf7, 0x2c, 0xe7, 0xaf,
+	0x03, 0xf0, 0x90, 0x45, 0x6e, 0xe8, 0x84, 0xb8, 0xa9, 0x7d, 0xcf, 0x2a, 0xce, 0x36, 0x6e, 0xa2,
+	0x1b, 0x90, 0x17, 0xb3, 0x39, 0x4c, 0x5b, 0xd2, 0x41, 0x4c, 0x0a, 0xe6, 0xc0, 0xfa, 0x59, 0xd1,
+	0x58, 0x0e, 0x64, 0xb7, 0x71, 0xa3, 0x45, 0xd6, 0xfc, 0xdd, 0xe0, 0x0c, 0x3f, 0xae, 0x42, 0x66,
+	0x9f, 0xf4, 0x1c, 0xd1, 0xe7, 0x6b, 0x2f, 0xd2, 0xfb, 0xa4, 0xb7, 0xdd, 0xeb, 0x10, 0xe1, 0xe2,
+	0x01, 0x6e, 0x45, 0x44, 0x09, 0x95, 0x03, 0x59, 0xc9, 0x11, 0x62, 0xeb, 0x27, 0x06, 0x80, 0x9c,
+	0x61, 0xd5, 0x0f, 0x59, 0xef, 0x8c, 0x29, 0x8a, 0x90, 0xd8, 0x27, 0x3d, 0x6d, 0x5d, 0x7c, 0xa2,
+	0xcb, 0x30, 0x21, 0xed, 0x68, 0xa3, 0x8a, 0x10, 0xe1, 0x08, 0x57, 0x46, 0xc2, 0xd9, 0x27, 0x3d,
+	0x55, 0x9c, 0x0a, 0xe4, 0x94, 0x33, 0x4a, 0x3c, 0x21, 0xc5, 0xca, 0x3f, 0x15, 0xef, 0x77, 0x00,
+	0x8d, 0xd4, 0xa5, 0x2e, 0xef, 0x2c, 0xaf, 0x41, 0x4a, 0xd7, 0xd2, 0x38, 0x4f, 0x2d, 0xb5, 0xb2,
+	0x85, 0x21, 0xbd, 0x42, 0x0e, 0xeb, 0x98, 0x32, 0xf4, 0x25, 0x28, 0xb4, 0x49, 0x88, 0x3d, 0x1c,
+	0x62, 0x09, 0x31, 0x44, 0x87, 0x97, 0x8f, 0xb9, 0x02, 0x61, 0x08, 0x5a, 0x84, 0xdc, 0x40, 0xad,
+	0xd5, 0xd1, 0x27, 0x6a, 0xe1, 0x49, 0xbf, 0x02, 0x1b, 0x9a, 0xbd, 0x5e, 0xb7, 0x21, 0x56, 0x59,
+	0xef, 0x58, 0x3f, 0x1c, 0x07, 0x73, 0xf5, 0x90, 0xb8, 0x51, 0x48, 0x6a, 0x51, 0xb8, 0x17, 0x30,
+	0xfa, 0x7d, 0x71, 0x2e, 0xf9, 0x6b, 0x21, 0x69, 0xcb, 0x49, 0xd5, 0xd2, 0x1e, 0xcd, 0x69, 0x5e,
+	0x71, 0x6b, 0xe7, 0xdd, 0x01, 0x2f, 0x43, 0x61, 0x37, 0xf2, 0x5d, 0x61, 0x57, 0xaa, 0x70, 0x33,
+	0x21, 0x6f, 0x08, 0xc9, 0xc7, 0xfd, 0x8a, 0x61, 0xe7, 0x63, 0x99, 0xd0, 0xe5, 0x22, 0xff, 0xa2,
+	0xd2, 0x0e, 0x66, 0x4d, 0x7d, 0x93, 0xb0, 0x33, 0x82, 0x51, 0x63, 0x4d, 0x8e, 0xee, 0xc3, 0x14,
+	0x66, 0x4d, 0xc7, 0x0d, 0x7c, 0x1e, 0x32, 0x4c, 0xfd, 0x50, 0xd4, 0x20, 0x31, 0x9f, 0xbb, 0x69,
+	0x9d, 0xcc, 0x68, 0x8d, 0x35, 0xa3, 0x36, 0xf1, 0xc3, 0xe5, 0x81, 0xea, 0x52, 0x56, 0xc0, 0x88,
+	0x3e, 0x25, 0x30, 0x6b, 0x1e, 0x4b, 0xb8, 0xf5, 0x5b, 0x03, 0xd0, 0xe9, 0x11, 0x62, 0x85, 0x50,
+	0xdf, 0x23, 0x87, 0x32, 0xee, 0xbc, 0xad, 0x08, 0x34, 0x0b, 0x29, 0xf2, 0x6e, 0x84, 0x5b, 0x5c,
+	0x86, 0x3a, 0x69, 0x6b, 0x0a, 0xbd, 0x09, 0x20, 0xb0, 0x07, 0xb7, 0x83, 0xc8, 0x0f, 0x75, 0x37,
+	0x71, 0x5d, 0x84, 0xf8, 0xcc, 0xc3, 0xcc, 0xce, 0xb6, 0xf1, 0x61, 0x4d, 0xea, 0xa3, 0x97, 0x21,
+	0xbe, 0x0c, 0xc5, 0xd9, 0x26, 0x71, 0xfc, 0x45, 0x2d, 0xa8, 0xc5, 0x7c, 0xeb, 0x07, 0x30, 0xab,
+	0x80, 0x29, 0x6e, 0x26, 0x88, 0x4b, 0x3b, 0x94, 0xf8, 0xe1, 0x85, 0xd5, 0xec, 0x25, 0xc8, 0xb2,
+	0xd8, 0x68, 0xbc, 0xeb, 0x06, 0x0c, 0xeb, 0xd7, 0x06, 0xa0, 0x51, 0x07, 0x64, 0x37, 0x73, 0x51,
+	0x93, 0xbf, 0x05, 0x13, 0xc3, 0x2d, 0xd9, 0xfc, 0x39, 0xf0, 0x5f, 0xd5, 0x55, 0x0d, 0xb3, 0xfe,
+	0x60, 0x40, 0x7e, 0xc4, 0xbd, 0x0b, 0xf3, 0x2c, 0x82, 0x7c, 0x18, 0x84, 0xb8, 0x15, 0x37, 0x7a,
+	0x72, 0x25, 0xe7, 0x6e, 0x5e, 0xad, 0x2a, 0xd7, 0xaa, 0xa2, 0xeb, 0xac, 0xea, 0x27, 0x87, 0xea,
+	0x72, 0x40, 0xfd, 0xa5, 0xd7, 0x84, 0xf3, 0xbf, 0xf9, 0x4b, 0x65, 0xbe, 0x49, 0xc3, 0xbd, 0xa8,
+	0x51, 0x75, 0x83, 0xf6, 0xa2, 0x7e, 0x9f, 0x50, 0xff, 0x5e, 0xe1, 0xde, 0xbe, 0x7e, 0xe1, 0x10,
+	0x03, 0xb8, 0x8a, 0x64, 0x52, 0x4e, 0xa3, 0xdd, 0xb7, 0x7e, 0x65, 0x40, 0x71, 0xb9, 0x85, 0x69,
+	0x5b, 0x20, 0x5d, 0x1c, 0xd3, 0x48, 0x89, 0x8c, 0x13, 0x25, 0x42, 0x7b, 0x90, 0xd2, 0x2b, 0x71,
+	0xfc, 0x05, 0xb9, 0xa8, 0xed, 0x5b, 0xbf, 0x48, 0x40, 0x7a, 0x99, 0x05, 0xfe, 0xb7, 0x83, 0x06,
+	0x2a, 0xc0, 0x38, 0xf5, 0xa4, 0x33, 0x49, 0x7b, 0x9c, 0x7a, 0x02, 0x8f, 0x5d, 0x46, 0x44, 0x8f,
+	0x12, 0xe3, 0xba, 0x26, 0x9f, 0x52, 0x91, 0xc4, 0x39, 0x2a, 0x92, 0x3c, 0x55, 0x91, 0x1b, 0x90,
+	0x1f, 0x01, 0x17, 0x09, 0xca, 0x59, 0x7b, 0x72, 0x18, 0x55, 0x46, 0x41, 0x25, 0x75, 0x02, 0x54,
+	0x10, 0x24, 0x25, 0x3f, 0x3d, 0x97, 0x98, 0x9f, 0xb4, 0xe5, 0x37, 0x2a, 0x41, 0x86, 0xfa, 0x21,
+	0x61, 0x07, 0xb8, 0xa5, 0x3a, 0x46, 0x7b, 0x40, 0x0b, 0x63, 0xc7, 0xcd, 0x55, 0x56, 0x09, 0x9b,
+	0x71, 0xdf, 0xf4, 0x16, 0xa4, 0xe3, 0x76, 0x56, 0x74, 0x73, 0x67, 0xe6, 0x7d, 0x08, 0x90, 0xe2,
+	0x41, 0xe8, 0xcb, 0x30, 0xe5, 0x93, 0xc3, 0xd0, 0x61, 0x91, 0xef, 0xec, 0xc9, 0x86, 0x44, 0xf6,
+	0x6f, 0x09, 0x3b, 0x2f, 0xd8, 0x76, 0xe4, 0xdf, 0x91, 0x4c, 0x19, 0xb6, 0xea, 0xd1, 0x1d, 0x57,
+	0x56, 0x59, 0x36, 0x65, 0xf6, 0xa4, 0x66, 0x2e, 0xcb, 0xca, 0x7c, 0x6c, 0xa8, 0xca, 0xd8, 0x91,
+	0x8f, 0x66, 0x20, 0xf5, 0x4e, 0xd0, 0x70, 0x06, 0xd5, 0x99, 0x78, 0x27, 0x68, 0xac, 0x79, 0x02,
+	0xcc, 0xf4, 0x34, 0xe3, 0x72, 0x1a, 0x4d, 0x89, 0xc2, 0xf1, 0xc8, 0x75, 0xe3, 0xba, 0x64, 0xec,
+	0x98, 0x14, 0x67, 0xb5, 0x08, 0x3f, 0xe2, 0xc4, 0x53, 0x97, 0x14, 0x3b, 0xdd, 0xc4, 0x7c, 0x87,
+	0x13, 0x0f, 0xbd, 0x0e, 0x89, 0x5d, 0xa2, 0x2a, 0x70, 0xde, 0xc0, 0xc5, 0x00, 0x81, 0xb3, 0x84,
+	0xb1, 0x80, 0xc9, 0xdb, 0x45, 0xd6, 0x56, 0x84, 0x75, 0x07, 0x8a, 0xfa, 0x5a, 0x21, 0x4f, 0x86,
+	0x0e, 0x3e, 0xb3, 0x95, 0x29, 0x41, 0xa6, 0xc3, 0xc8, 0x2e, 0x3d, 0x24, 0x5c, 0xae, 0xf8, 0xac,
+	0x3d, 0xa0, 0x2d, 0x1f, 0x26, 0xb5, 0xa5, 0x1d, 0x8e, 0x9b, 0x67, 0x59, 0xa9, 0xc2, 0x25, 0xd5,
+	0xba, 0x3a, 0x3c, 0xc4, 0x2c, 0x74, 0x46, 0x72, 0x33, 0xad, 0x44, 0x5b, 0x42, 0xa2, 0xcb, 0x70,
+	0x19, 0x26, 0xdc, 0x01, 0xdc, 0x27, 0x6d, 0x45, 0x58, 0xbf, 0x33, 0xa0, 0x50, 0x1f, 0xbd, 0x87,
+	0x5c, 0x20, 0x2e, 0xeb, 0xa5, 0x12, 0xb0, 0x18, 0x97, 0x07, 0x0c, 0xf4, 0xe6, 0x60, 0xd3, 0x27,
+	0x3f, 0x47, 0x0d, 0xe2, 0x8d, 0xbc, 0x01, 0x45, 0x85, 0x9a, 0x6b, 0x9e, 0xb8, 0x5a, 0xec, 0x52,
+	0xc2, 0x9e, 0xa3, 0xf1, 0xb5, 0xbc, 0x18, 0x84, 0x6f, 0x31, 0x4a, 0x7c, 0x8f, 0x3f, 0x4f, 0x13,
+	0x6d, 0x42, 0x7a, 0x57, 0x59, 0x51, 0xbd, 0x83, 0x1d, 0x93, 0xd6, 0x3f, 0x0c, 0x98, 0xbe, 0xa5,
+	0xf7, 0xfa, 0x16, 0x6d, 0xfa, 0x38, 0x8c, 0xd8, 0x73, 0xf5, 0xeb, 0x08, 0x92, 0x52, 0xa2, 0x92,
+	0x2b, 0xbf, 0xc5, 0x9a, 0xa7, 0xdc, 0x21, 0xa2, 0xc5, 0x94, 0x99, 0xcd, 0xd8, 0x69, 0xca, 0x55,
+	0xc7, 0x79, 0x05, 0xd2, 0x94, 0x3b, 0x07, 0x94, 0x74, 0xe5, 0xba, 0xcf, 0xd8, 0x29, 0xca, 0xef,
+	0x53, 0xd2, 0x15, 0x13, 0x49, 0xcc, 0xe9, 0xc8, 0x27, 0x11, 0x8d, 0x3a, 0x20, 0x58, 0xfa, 0x91,
+	0x64, 0x16, 0x52, 0x5a, 0x96, 0x96, 0x32, 0x4d, 0x09, 0xdf, 0x19, 0x09, 0x23, 0xe6, 0x73, 0x33,
+	0xa3, 0x62, 0xd5, 0xa4, 0xf5, 0xcf, 0x04, 0x94, 0x54, 0x4a, 0x75, 0xc3, 0xb8, 0x1c, 0xb4, 0x3b,
+	0x38, 0xa4, 0x0d, 0xda, 0xa2, 0x61, 0xef, 0x79, 0x82, 0x9e, 0x81, 0x14, 0xe5, 0x8e, 0x4f, 0xba,
+	0x7a, 0xb7, 0x4f, 0x50, 0x7e, 0x97, 0x74, 0xd1, 0x3d, 0x98, 0xe1, 0x61, 0x20, 0x1e, 0x36, 0x4e,
+	0xdc, 0x49, 0x92, 0xe7, 0xe9, 0x63, 0x2f, 0xa9, 0xb1, 0x23, 0x4c, 0xe9, 0xa4, 0x6a, 0x67, 0x74,
+	0xbe, 0x62, 0x52, 0xe4, 0x83, 0x11, 0xcc, 0x03, 0x5f, 0xc3, 0x80, 0xa6, 0x44, 0x67, 0xc4, 0x88,
+	0x98, 0xc1, 0x73, 0x62, 0x50, 0x8f, 0x53, 0x56, 0xd4, 0x82, 0x78, 0x01, 0x70, 0xa1, 0xac, 0x2e,
+	0x7a, 0xc3, 0xca, 0x2a, 0x8d, 0x45, 0x2d, 0x38, 0x56, 0xfe, 0x0a, 0x4c, 0xc5, 0x96, 0xd5, 0xa5,
+	0x87, 0x9b, 0x59, 0xa9, 0x5a, 0xd0, 0xec, 0x2d, 0xc5, 0x15, 0x8a, 0xb1, 0xd5, 0x58, 0x11, 0x94,
+	0xa2, 0x66, 0xc7, 0x8a, 0xdf, 0x85, 0xe9, 0x06, 0x0b, 0xf6, 0x89, 0x2f, 0x9e, 0x25, 0x88, 0x2f,
+	0xf6, 0x11, 0x37, 0x73, 0xf2, 0x00, 0x9e, 0x3b, 0x99, 0xac, 0x93, 0x7b, 0x6d, 0x78, 0x4b, 0x16,
+	0x95, 0x95, 0x95, 0x81, 0x11, 0xeb, 0xe7, 0x06, 0xe4, 0x6b, 0xc3, 0x97, 0xfe, 0x33, 0xca, 0x7d,
+	0x1a, 0x6c, 0xc6, 0xcf, 0x01, 0x36, 0x89, 0x7f, 0x7f, 0xb6, 0x26, 0x4f, 0x9f, 0xad, 0xd6, 0x7b,
+	0x06, 0xa4, 0xb7, 0xd4, 0xeb, 0xc1, 0x7f, 0x8b, 0x4b, 0x3f, 0x35, 0xe0, 0xa5, 0x53, 0x17, 0xfb,
+	0xcd, 0x06, 0x17, 0xe7, 0xb7, 0x50, 0x12, 0xb0, 0x3d, 0xfc, 0x34, 0xa9, 0x88, 0x67, 0x9e, 0x85,
+	0xdf, 0x82, 0x94, 0x7a, 0x43, 0xf8, 0xdc, 0xfd, 0xa8, 0x1e, 0xb7, 0xf0, 0x4d, 0xc8, 0x8f, 0xee,
+	0x82, 0x29, 0xc8, 0xed, 0xdc, 0xdd, 0xaa, 0xaf, 0x2e, 0xaf, 0xdd, 0x5a, 0x5b, 0x5d, 0x29, 0x8e,
+	0xa1, 0x02, 0xc0, 0xf2, 0xe6, 0x46, 0xbd, 0xb6, 0xbd, 0xb6, 0xb4, 0xbe, 0x5a, 0x34, 0x50, 0x1e,
+	0xb2, 0x6b, 0x1b, 0x1b, 0x3b, 0xdb, 0x35, 0x41, 0x8e, 0x2f, 0x7c, 0x66, 0x40, 0x6e, 0xe8, 0x8d,
+	0x0d, 0x7d, 0x1d, 0xcc, 0xfa, 0xce, 0xd2, 0xfa, 0xda, 0xd6, 0x1d, 0x67, 0x63, 0x73, 0x65, 0xd5,
+	0x19, 0x31, 0x56, 0x2a, 0x3d, 0x7c, 0x34, 0x37, 0x3b, 0xa4, 0xbe, 0xe3, 0xf3, 0x0e, 0x71, 0xc5,
+	0x22, 0xf3, 0xd0, 0x02, 0x4c, 0x8f, 0x8c, 0xdc, 0xac, 0xaf, 0xde, 0x2d, 0x1a, 0xa5, 0x4b, 0x0f,
+	0x1f, 0xcd, 0x4d, 0x0d, 0x0d, 0xd9, 0xec, 0x10, 0x1f, 0x7d, 0x0d, 0x66, 0x47, 0x74, 0x6b, 0xeb,
+	0xeb, 0x9b, 0x0f, 0xd6, 0xd7, 0xb6, 0xb6, 0x8b, 0xe3, 0x25, 0xf3, 0xe1, 0xa3, 0xb9, 0xcb, 0x43,
+	0x03, 0x6a, 0x62, 0x17, 0xb7, 0x28, 0x0f, 0xd1, 0xeb, 0x70, 0x65, 0x64, 0xd4, 0xed, 0xcd, 0xfb,
+	0xab, 0xf6, 0xdd, 0xda, 0xdd, 0xe5, 0xd5, 0x62, 0xa2, 0x74, 0xf5, 0xe1, 0xa3, 0xb9, 0x99, 0xa1,
+	0x61, 0xb7, 0x83, 0x03, 0xc2, 0x7c, 0xec, 0xbb, 0xa4, 0x94, 0xfc, 0xf1, 0x07, 0xe5, 0xb1, 0xa5,
+	0xf5, 0xc7, 0x7f, 0x2b, 0x8f, 0x7d, 0xf8, 0xa4, 0x6c, 0x3c, 0x7e, 0x52, 0x36, 0x3e, 0x79, 0x52,
+	0x36, 0xfe, 0xfa, 0xa4, 0x6c, 0xbc, 0xf7, 0x69, 0x79, 0xec, 0x93, 0x4f, 0xcb, 0x63, 0x7f, 0xfa,
+	0xb4, 0x3c, 0xf6, 0xf6, 0xc2, 0x50, 0x9b, 0xaa, 0xb6, 0xd4, 0x2b, 0x2d, 0xdc, 0xe0, 0xfa, 0x7b,
+	0xf1, 0x50, 0xfd, 0x6c, 0x28, 0xdb, 0xd5, 0x46, 0x4a, 0xfe, 0xb6, 0xf7, 0xd5, 0x7f, 0x0d, 0x00,
+	0x95, 0x89, 0x8a, 0x50, 0x52, 0x1c, 0x00, 0x00,
 }
 
-func (m *ExecuteAuthorizationItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.FunctionNames) > 0 {
-		for iNdEx := len(m.FunctionNames) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.FunctionNames[iNdEx])
-			copy(dAtA[i:], m.FunctionNames[iNdEx])
-			i = encodeVarintTypes(dAtA, i, uint64(len(m.FunctionNames[iNdEx])))
-			i--
-			dAtA[i] = 0x1a
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
 		}
 	}
-	if len(m.ModuleName) > 0 {
-		i -= len(m.ModuleName)
-		copy(dAtA[i:], m.ModuleName)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
-		i--
-		dAtA[i] = 0x12
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	if len(m.ModuleAddress) > 0 {
-		i -= len(m.ModuleAddress)
-		copy(dAtA[i:], m.ModuleAddress)
-		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
-		i--
-		dAtA[i] = 0xa
+	if this.BaseDenom != that1.BaseDenom {
+		return false
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTypes(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if !this.BaseMinGasPrice.Equal(that1.BaseMinGasPrice) {
+		return false
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
+	if !this.ContractSharedRevenueRatio.Equal(that1.ContractSharedRevenueRatio) {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if len(this.AllowedPublishers) != len(that1.AllowedPublishers) {
+		return false
 	}
-	l = m.BaseMinGasPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ContractSharedRevenueRatio.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	if len(m.AllowedPublishers) > 0 {
-		for _, s := range m.AllowedPublishers {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+	for i := range this.AllowedPublishers {
+		if this.AllowedPublishers[i] != that1.AllowedPublishers[i] {
+			return false
 		}
 	}
-	return n
-}
-
-func (m *RawParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.CronMaxGasPerBlock != that1.CronMaxGasPerBlock {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.BaseDenom)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.CronMaxFailures != that1.CronMaxFailures {
+		return false
 	}
-	l = m.BaseMinGasPrice.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	l = m.ContractSharedRevenueRatio.Size()
-	n += 1 + l + sovTypes(uint64(l))
-	return n
-}
-
-func (m *Module) Size() (n int) {
-	if m == nil {
-		return 0
+	if this.PublishMode != that1.PublishMode {
+		return false
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.PublishDeposit.Equal(that1.PublishDeposit) {
+		return false
 	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.PublishQuota != that1.PublishQuota {
+		return false
 	}
-	l = len(m.Abi)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.PublishQuotaWindow != that1.PublishQuotaWindow {
+		return false
 	}
-	l = len(m.RawBytes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.AuthenticatorGasLimit != that1.AuthenticatorGasLimit {
+		return false
 	}
-	if m.UpgradePolicy != 0 {
-		n += 1 + sovTypes(uint64(m.UpgradePolicy))
+	if this.SponsorGasLimit != that1.SponsorGasLimit {
+		return false
 	}
-	return n
+	if this.VotingPowerTwapWindow != that1.VotingPowerTwapWindow {
+		return false
+	}
+	if !this.MaxVotingPowerWeightChangeRate.Equal(that1.MaxVotingPowerWeightChangeRate) {
+		return false
+	}
+	return true
 }
+func (this *RawParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *Resource) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*RawParams)
+	if !ok {
+		that2, ok := that.(RawParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.StructTag)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.BaseDenom != that1.BaseDenom {
+		return false
 	}
-	l = len(m.MoveResource)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.BaseMinGasPrice.Equal(that1.BaseMinGasPrice) {
+		return false
 	}
-	l = len(m.RawBytes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !this.ContractSharedRevenueRatio.Equal(that1.ContractSharedRevenueRatio) {
+		return false
 	}
-	return n
+	if this.CronMaxGasPerBlock != that1.CronMaxGasPerBlock {
+		return false
+	}
+	if this.CronMaxFailures != that1.CronMaxFailures {
+		return false
+	}
+	if this.PublishMode != that1.PublishMode {
+		return false
+	}
+	if !this.PublishDeposit.Equal(that1.PublishDeposit) {
+		return false
+	}
+	if this.PublishQuota != that1.PublishQuota {
+		return false
+	}
+	if this.PublishQuotaWindow != that1.PublishQuotaWindow {
+		return false
+	}
+	if this.AuthenticatorGasLimit != that1.AuthenticatorGasLimit {
+		return false
+	}
+	if this.SponsorGasLimit != that1.SponsorGasLimit {
+		return false
+	}
+	if this.VotingPowerTwapWindow != that1.VotingPowerTwapWindow {
+		return false
+	}
+	if !this.MaxVotingPowerWeightChangeRate.Equal(that1.MaxVotingPowerWeightChangeRate) {
+		return false
+	}
+	return true
 }
+func (this *Module) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TableInfo) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Module)
+	if !ok {
+		that2, ok := that.(Module)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.KeyType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Address != that1.Address {
+		return false
 	}
-	l = len(m.ValueType)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.ModuleName != that1.ModuleName {
+		return false
 	}
-	return n
+	if this.Abi != that1.Abi {
+		return false
+	}
+	if !bytes.Equal(this.RawBytes, that1.RawBytes) {
+		return false
+	}
+	if this.UpgradePolicy != that1.UpgradePolicy {
+		return false
+	}
+	return true
 }
+func (this *Resource) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *TableEntry) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*Resource)
+	if !ok {
+		that2, ok := that.(Resource)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	l = len(m.Address)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
 	}
-	l = len(m.Key)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.Address != that1.Address {
+		return false
 	}
-	l = len(m.Value)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.StructTag != that1.StructTag {
+		return false
 	}
-	l = len(m.KeyBytes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if this.MoveResource != that1.MoveResource {
+		return false
 	}
-	l = len(m.ValueBytes)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if !bytes.Equal(this.RawBytes, that1.RawBytes) {
+		return false
 	}
-	return n
+	return true
 }
+func (this *TableInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
 
-func (m *UpgradePolicyProto) Size() (n int) {
-	if m == nil {
-		return 0
+	that1, ok := that.(*TableInfo)
+	if !ok {
+		that2, ok := that.(TableInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
 	}
-	var l int
-	_ = l
-	if m.Policy != 0 {
-		n += 1 + sovTypes(uint64(m.Policy))
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.KeyType != that1.KeyType {
+		return false
+	}
+	if this.ValueType != that1.ValueType {
+		return false
+	}
+	return true
+}
+func (this *TableEntry) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*TableEntry)
+	if !ok {
+		that2, ok := that.(TableEntry)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Key != that1.Key {
+		return false
+	}
+	if this.Value != that1.Value {
+		return false
+	}
+	if !bytes.Equal(this.KeyBytes, that1.KeyBytes) {
+		return false
+	}
+	if !bytes.Equal(this.ValueBytes, that1.ValueBytes) {
+		return false
+	}
+	return true
+}
+func (this *UpgradePolicyProto) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UpgradePolicyProto)
+	if !ok {
+		that2, ok := that.(UpgradePolicyProto)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Policy != that1.Policy {
+		return false
+	}
+	return true
+}
+func (this *DexPair) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*DexPair)
+	if !ok {
+		that2, ok := that.(DexPair)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MetadataQuote != that1.MetadataQuote {
+		return false
+	}
+	if this.MetadataLP != that1.MetadataLP {
+		return false
+	}
+	return true
+}
+func (this *ExecuteAuthorizationItem) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ExecuteAuthorizationItem)
+	if !ok {
+		that2, ok := that.(ExecuteAuthorizationItem)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if len(this.FunctionNames) != len(that1.FunctionNames) {
+		return false
+	}
+	for i := range this.FunctionNames {
+		if this.FunctionNames[i] != that1.FunctionNames[i] {
+			return false
+		}
+	}
+	if len(this.TypeArgs) != len(that1.TypeArgs) {
+		return false
+	}
+	for i := range this.TypeArgs {
+		if this.TypeArgs[i] != that1.TypeArgs[i] {
+			return false
+		}
+	}
+	if len(this.ArgConstraints) != len(that1.ArgConstraints) {
+		return false
+	}
+	for i := range this.ArgConstraints {
+		if !this.ArgConstraints[i].Equal(&that1.ArgConstraints[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ArgumentConstraint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ArgumentConstraint)
+	if !ok {
+		that2, ok := that.(ArgumentConstraint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if !bytes.Equal(this.Equals, that1.Equals) {
+		return false
+	}
+	if that1.MaxAmount == nil {
+		if this.MaxAmount != nil {
+			return false
+		}
+	} else if !this.MaxAmount.Equal(*that1.MaxAmount) {
+		return false
+	}
+	if len(this.AllowedAddresses) != len(that1.AllowedAddresses) {
+		return false
+	}
+	for i := range this.AllowedAddresses {
+		if this.AllowedAddresses[i] != that1.AllowedAddresses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ModuleRevenueRecipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleRevenueRecipient)
+	if !ok {
+		that2, ok := that.(ModuleRevenueRecipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	return true
+}
+func (this *ModuleRevenueRatio) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleRevenueRatio)
+	if !ok {
+		that2, ok := that.(ModuleRevenueRatio)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if !this.Ratio.Equal(that1.Ratio) {
+		return false
+	}
+	return true
+}
+func (this *ModuleRevenue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleRevenue)
+	if !ok {
+		that2, ok := that.(ModuleRevenue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if len(this.TotalRevenue) != len(that1.TotalRevenue) {
+		return false
+	}
+	for i := range this.TotalRevenue {
+		if !this.TotalRevenue[i].Equal(&that1.TotalRevenue[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ClaimableRevenue) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ClaimableRevenue)
+	if !ok {
+		that2, ok := that.(ClaimableRevenue)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if len(this.Amount) != len(that1.Amount) {
+		return false
+	}
+	for i := range this.Amount {
+		if !this.Amount[i].Equal(&that1.Amount[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *CronJob) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CronJob)
+	if !ok {
+		that2, ok := that.(CronJob)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Id != that1.Id {
+		return false
+	}
+	if this.Creator != that1.Creator {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	if len(this.TypeArgs) != len(that1.TypeArgs) {
+		return false
+	}
+	for i := range this.TypeArgs {
+		if this.TypeArgs[i] != that1.TypeArgs[i] {
+			return false
+		}
+	}
+	if len(this.Args) != len(that1.Args) {
+		return false
+	}
+	for i := range this.Args {
+		if !bytes.Equal(this.Args[i], that1.Args[i]) {
+			return false
+		}
+	}
+	if this.Interval != that1.Interval {
+		return false
+	}
+	if this.GasLimit != that1.GasLimit {
+		return false
+	}
+	if !this.Deposit.Equal(&that1.Deposit) {
+		return false
+	}
+	if this.NextRunHeight != that1.NextRunHeight {
+		return false
+	}
+	if this.FailureCount != that1.FailureCount {
+		return false
+	}
+	return true
+}
+func (this *CronRun) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*CronRun)
+	if !ok {
+		that2, ok := that.(CronRun)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.JobId != that1.JobId {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if this.Success != that1.Success {
+		return false
+	}
+	if this.GasUsed != that1.GasUsed {
+		return false
+	}
+	if !this.Fee.Equal(&that1.Fee) {
+		return false
+	}
+	if this.Error != that1.Error {
+		return false
+	}
+	return true
+}
+func (this *PublishNamespace) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PublishNamespace)
+	if !ok {
+		that2, ok := that.(PublishNamespace)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if len(this.Prefixes) != len(that1.Prefixes) {
+		return false
+	}
+	for i := range this.Prefixes {
+		if this.Prefixes[i] != that1.Prefixes[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *PublishUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PublishUsage)
+	if !ok {
+		that2, ok := that.(PublishUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.WindowStartHeight != that1.WindowStartHeight {
+		return false
+	}
+	if this.Count != that1.Count {
+		return false
+	}
+	return true
+}
+func (this *PublishDeposit) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PublishDeposit)
+	if !ok {
+		that2, ok := that.(PublishDeposit)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.Depositor != that1.Depositor {
+		return false
+	}
+	if !this.Amount.Equal(&that1.Amount) {
+		return false
+	}
+	return true
+}
+func (this *ModuleIdentifier) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleIdentifier)
+	if !ok {
+		that2, ok := that.(ModuleIdentifier)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	return true
+}
+func (this *ModuleFriends) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleFriends)
+	if !ok {
+		that2, ok := that.(ModuleFriends)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if len(this.Friends) != len(that1.Friends) {
+		return false
+	}
+	for i := range this.Friends {
+		if this.Friends[i] != that1.Friends[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *FunctionSignature) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FunctionSignature)
+	if !ok {
+		that2, ok := that.(FunctionSignature)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if this.IsEntry != that1.IsEntry {
+		return false
+	}
+	if this.IsView != that1.IsView {
+		return false
+	}
+	if len(this.TypeParams) != len(that1.TypeParams) {
+		return false
+	}
+	for i := range this.TypeParams {
+		if this.TypeParams[i] != that1.TypeParams[i] {
+			return false
+		}
+	}
+	if len(this.Params) != len(that1.Params) {
+		return false
+	}
+	for i := range this.Params {
+		if this.Params[i] != that1.Params[i] {
+			return false
+		}
+	}
+	if len(this.Returns) != len(that1.Returns) {
+		return false
+	}
+	for i := range this.Returns {
+		if this.Returns[i] != that1.Returns[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *ModuleUpgradeCompatibility) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ModuleUpgradeCompatibility)
+	if !ok {
+		that2, ok := that.(ModuleUpgradeCompatibility)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.IsNew != that1.IsNew {
+		return false
+	}
+	if this.StoredUpgradePolicy != that1.StoredUpgradePolicy {
+		return false
+	}
+	if this.Allowed != that1.Allowed {
+		return false
+	}
+	if this.Reason != that1.Reason {
+		return false
+	}
+	if len(this.RemovedFunctions) != len(that1.RemovedFunctions) {
+		return false
+	}
+	for i := range this.RemovedFunctions {
+		if this.RemovedFunctions[i] != that1.RemovedFunctions[i] {
+			return false
+		}
+	}
+	if len(this.ChangedFunctions) != len(that1.ChangedFunctions) {
+		return false
+	}
+	for i := range this.ChangedFunctions {
+		if this.ChangedFunctions[i] != that1.ChangedFunctions[i] {
+			return false
+		}
+	}
+	if len(this.RemovedStructs) != len(that1.RemovedStructs) {
+		return false
+	}
+	for i := range this.RemovedStructs {
+		if this.RemovedStructs[i] != that1.RemovedStructs[i] {
+			return false
+		}
+	}
+	if len(this.ChangedStructs) != len(that1.ChangedStructs) {
+		return false
+	}
+	for i := range this.ChangedStructs {
+		if this.ChangedStructs[i] != that1.ChangedStructs[i] {
+			return false
+		}
+	}
+	if len(this.BrokenDependents) != len(that1.BrokenDependents) {
+		return false
+	}
+	for i := range this.BrokenDependents {
+		if !this.BrokenDependents[i].Equal(&that1.BrokenDependents[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *Authenticator) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Authenticator)
+	if !ok {
+		that2, ok := that.(Authenticator)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	return true
+}
+func (this *Sponsor) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Sponsor)
+	if !ok {
+		that2, ok := that.(Sponsor)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.ModuleAddress != that1.ModuleAddress {
+		return false
+	}
+	if this.ModuleName != that1.ModuleName {
+		return false
+	}
+	if this.FunctionName != that1.FunctionName {
+		return false
+	}
+	return true
+}
+func (this *VotingPowerWeightObservation) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*VotingPowerWeightObservation)
+	if !ok {
+		that2, ok := that.(VotingPowerWeightObservation)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.Height != that1.Height {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxVotingPowerWeightChangeRate.Size()
+		i -= size
+		if _, err := m.MaxVotingPowerWeightChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.VotingPowerTwapWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VotingPowerTwapWindow))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.SponsorGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SponsorGasLimit))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.AuthenticatorGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AuthenticatorGasLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.PublishQuotaWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublishQuotaWindow))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PublishQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublishQuota))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.PublishDeposit.Size()
+		i -= size
+		if _, err := m.PublishDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if m.PublishMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublishMode))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.CronMaxFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CronMaxFailures))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CronMaxGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CronMaxGasPerBlock))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.AllowedPublishers) > 0 {
+		for iNdEx := len(m.AllowedPublishers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedPublishers[iNdEx])
+			copy(dAtA[i:], m.AllowedPublishers[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedPublishers[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ContractSharedRevenueRatio.Size()
+		i -= size
+		if _, err := m.ContractSharedRevenueRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMinGasPrice.Size()
+		i -= size
+		if _, err := m.BaseMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RawParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RawParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RawParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxVotingPowerWeightChangeRate.Size()
+		i -= size
+		if _, err := m.MaxVotingPowerWeightChangeRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.VotingPowerTwapWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.VotingPowerTwapWindow))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.SponsorGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SponsorGasLimit))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.AuthenticatorGasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AuthenticatorGasLimit))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.PublishQuotaWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublishQuotaWindow))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.PublishQuota != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublishQuota))
+		i--
+		dAtA[i] = 0x40
+	}
+	{
+		size := m.PublishDeposit.Size()
+		i -= size
+		if _, err := m.PublishDeposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.PublishMode != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.PublishMode))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.CronMaxFailures != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CronMaxFailures))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.CronMaxGasPerBlock != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.CronMaxGasPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ContractSharedRevenueRatio.Size()
+		i -= size
+		if _, err := m.ContractSharedRevenueRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.BaseMinGasPrice.Size()
+		i -= size
+		if _, err := m.BaseMinGasPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Module) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Module) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Module) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UpgradePolicy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.UpgradePolicy))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.RawBytes) > 0 {
+		i -= len(m.RawBytes)
+		copy(dAtA[i:], m.RawBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RawBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Abi) > 0 {
+		i -= len(m.Abi)
+		copy(dAtA[i:], m.Abi)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Abi)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Resource) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Resource) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Resource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RawBytes) > 0 {
+		i -= len(m.RawBytes)
+		copy(dAtA[i:], m.RawBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.RawBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MoveResource) > 0 {
+		i -= len(m.MoveResource)
+		copy(dAtA[i:], m.MoveResource)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MoveResource)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.StructTag) > 0 {
+		i -= len(m.StructTag)
+		copy(dAtA[i:], m.StructTag)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.StructTag)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValueType) > 0 {
+		i -= len(m.ValueType)
+		copy(dAtA[i:], m.ValueType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValueType)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.KeyType) > 0 {
+		i -= len(m.KeyType)
+		copy(dAtA[i:], m.KeyType)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TableEntry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TableEntry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TableEntry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ValueBytes) > 0 {
+		i -= len(m.ValueBytes)
+		copy(dAtA[i:], m.ValueBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ValueBytes)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.KeyBytes) > 0 {
+		i -= len(m.KeyBytes)
+		copy(dAtA[i:], m.KeyBytes)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.KeyBytes)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Value) > 0 {
+		i -= len(m.Value)
+		copy(dAtA[i:], m.Value)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Value)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UpgradePolicyProto) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UpgradePolicyProto) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UpgradePolicyProto) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Policy))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DexPair) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DexPair) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DexPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MetadataLP) > 0 {
+		i -= len(m.MetadataLP)
+		copy(dAtA[i:], m.MetadataLP)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataLP)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MetadataQuote) > 0 {
+		i -= len(m.MetadataQuote)
+		copy(dAtA[i:], m.MetadataQuote)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.MetadataQuote)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ExecuteAuthorizationItem) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ExecuteAuthorizationItem) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ExecuteAuthorizationItem) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ArgConstraints) > 0 {
+		for iNdEx := len(m.ArgConstraints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ArgConstraints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.FunctionNames) > 0 {
+		for iNdEx := len(m.FunctionNames) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FunctionNames[iNdEx])
+			copy(dAtA[i:], m.FunctionNames[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.FunctionNames[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ArgumentConstraint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ArgumentConstraint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ArgumentConstraint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedAddresses) > 0 {
+		for iNdEx := len(m.AllowedAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedAddresses[iNdEx])
+			copy(dAtA[i:], m.AllowedAddresses[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.AllowedAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MaxAmount != nil {
+		{
+			size := m.MaxAmount.Size()
+			i -= size
+			if _, err := m.MaxAmount.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTypes(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Equals) > 0 {
+		i -= len(m.Equals)
+		copy(dAtA[i:], m.Equals)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Equals)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleRevenueRecipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleRevenueRecipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleRevenueRecipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleRevenueRatio) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleRevenueRatio) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleRevenueRatio) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Ratio.Size()
+		i -= size
+		if _, err := m.Ratio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TotalRevenue) > 0 {
+		for iNdEx := len(m.TotalRevenue) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TotalRevenue[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ClaimableRevenue) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ClaimableRevenue) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ClaimableRevenue) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Amount) > 0 {
+		for iNdEx := len(m.Amount) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Amount[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronJob) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronJob) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronJob) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FailureCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.FailureCount))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.NextRunHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.NextRunHeight))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.GasLimit != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.Interval != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Interval))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Args) > 0 {
+		for iNdEx := len(m.Args) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Args[iNdEx])
+			copy(dAtA[i:], m.Args[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Args[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TypeArgs) > 0 {
+		for iNdEx := len(m.TypeArgs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeArgs[iNdEx])
+			copy(dAtA[i:], m.TypeArgs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TypeArgs[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *CronRun) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *CronRun) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *CronRun) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x32
+	}
+	{
+		size, err := m.Fee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.GasUsed != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.JobId != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.JobId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Prefixes) > 0 {
+		for iNdEx := len(m.Prefixes) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Prefixes[iNdEx])
+			copy(dAtA[i:], m.Prefixes[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Prefixes[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.WindowStartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.WindowStartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PublishDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PublishDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PublishDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Depositor) > 0 {
+		i -= len(m.Depositor)
+		copy(dAtA[i:], m.Depositor)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Depositor)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleIdentifier) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleIdentifier) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleIdentifier) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleFriends) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleFriends) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleFriends) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Friends) > 0 {
+		for iNdEx := len(m.Friends) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Friends[iNdEx])
+			copy(dAtA[i:], m.Friends[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Friends[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FunctionSignature) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FunctionSignature) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FunctionSignature) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Returns) > 0 {
+		for iNdEx := len(m.Returns) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Returns[iNdEx])
+			copy(dAtA[i:], m.Returns[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Returns[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.Params) > 0 {
+		for iNdEx := len(m.Params) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Params[iNdEx])
+			copy(dAtA[i:], m.Params[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.Params[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.TypeParams) > 0 {
+		for iNdEx := len(m.TypeParams) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeParams[iNdEx])
+			copy(dAtA[i:], m.TypeParams[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.TypeParams[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.IsView {
+		i--
+		if m.IsView {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IsEntry {
+		i--
+		if m.IsEntry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ModuleUpgradeCompatibility) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ModuleUpgradeCompatibility) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ModuleUpgradeCompatibility) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BrokenDependents) > 0 {
+		for iNdEx := len(m.BrokenDependents) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BrokenDependents[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTypes(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.ChangedStructs) > 0 {
+		for iNdEx := len(m.ChangedStructs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedStructs[iNdEx])
+			copy(dAtA[i:], m.ChangedStructs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ChangedStructs[iNdEx])))
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RemovedStructs) > 0 {
+		for iNdEx := len(m.RemovedStructs) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedStructs[iNdEx])
+			copy(dAtA[i:], m.RemovedStructs[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RemovedStructs[iNdEx])))
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.ChangedFunctions) > 0 {
+		for iNdEx := len(m.ChangedFunctions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ChangedFunctions[iNdEx])
+			copy(dAtA[i:], m.ChangedFunctions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.ChangedFunctions[iNdEx])))
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.RemovedFunctions) > 0 {
+		for iNdEx := len(m.RemovedFunctions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RemovedFunctions[iNdEx])
+			copy(dAtA[i:], m.RemovedFunctions[iNdEx])
+			i = encodeVarintTypes(dAtA, i, uint64(len(m.RemovedFunctions[iNdEx])))
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Allowed {
+		i--
+		if m.Allowed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StoredUpgradePolicy != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StoredUpgradePolicy))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IsNew {
+		i--
+		if m.IsNew {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Authenticator) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Authenticator) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Authenticator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Sponsor) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Sponsor) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Sponsor) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FunctionName) > 0 {
+		i -= len(m.FunctionName)
+		copy(dAtA[i:], m.FunctionName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.FunctionName)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ModuleName) > 0 {
+		i -= len(m.ModuleName)
+		copy(dAtA[i:], m.ModuleName)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleName)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ModuleAddress) > 0 {
+		i -= len(m.ModuleAddress)
+		copy(dAtA[i:], m.ModuleAddress)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.ModuleAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *VotingPowerWeightObservation) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VotingPowerWeightObservation) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VotingPowerWeightObservation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Height != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BaseMinGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ContractSharedRevenueRatio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if len(m.AllowedPublishers) > 0 {
+		for _, s := range m.AllowedPublishers {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.CronMaxGasPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.CronMaxGasPerBlock))
+	}
+	if m.CronMaxFailures != 0 {
+		n += 1 + sovTypes(uint64(m.CronMaxFailures))
+	}
+	if m.PublishMode != 0 {
+		n += 1 + sovTypes(uint64(m.PublishMode))
+	}
+	l = m.PublishDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PublishQuota != 0 {
+		n += 1 + sovTypes(uint64(m.PublishQuota))
+	}
+	if m.PublishQuotaWindow != 0 {
+		n += 1 + sovTypes(uint64(m.PublishQuotaWindow))
+	}
+	if m.AuthenticatorGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.AuthenticatorGasLimit))
+	}
+	if m.SponsorGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.SponsorGasLimit))
+	}
+	if m.VotingPowerTwapWindow != 0 {
+		n += 1 + sovTypes(uint64(m.VotingPowerTwapWindow))
+	}
+	l = m.MaxVotingPowerWeightChangeRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *RawParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.BaseMinGasPrice.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.ContractSharedRevenueRatio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.CronMaxGasPerBlock != 0 {
+		n += 1 + sovTypes(uint64(m.CronMaxGasPerBlock))
+	}
+	if m.CronMaxFailures != 0 {
+		n += 1 + sovTypes(uint64(m.CronMaxFailures))
+	}
+	if m.PublishMode != 0 {
+		n += 1 + sovTypes(uint64(m.PublishMode))
+	}
+	l = m.PublishDeposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.PublishQuota != 0 {
+		n += 1 + sovTypes(uint64(m.PublishQuota))
+	}
+	if m.PublishQuotaWindow != 0 {
+		n += 1 + sovTypes(uint64(m.PublishQuotaWindow))
+	}
+	if m.AuthenticatorGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.AuthenticatorGasLimit))
+	}
+	if m.SponsorGasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.SponsorGasLimit))
+	}
+	if m.VotingPowerTwapWindow != 0 {
+		n += 1 + sovTypes(uint64(m.VotingPowerTwapWindow))
+	}
+	l = m.MaxVotingPowerWeightChangeRate.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *Module) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Abi)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RawBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.UpgradePolicy != 0 {
+		n += 1 + sovTypes(uint64(m.UpgradePolicy))
+	}
+	return n
+}
+
+func (m *Resource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.StructTag)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MoveResource)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.RawBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TableInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.KeyType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValueType)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *TableEntry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Key)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Value)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.KeyBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ValueBytes)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *UpgradePolicyProto) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Policy != 0 {
+		n += 1 + sovTypes(uint64(m.Policy))
+	}
+	return n
+}
+
+func (m *DexPair) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MetadataQuote)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.MetadataLP)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ExecuteAuthorizationItem) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.FunctionNames) > 0 {
+		for _, s := range m.FunctionNames {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ArgConstraints) > 0 {
+		for _, e := range m.ArgConstraints {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ArgumentConstraint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovTypes(uint64(m.Index))
+	}
+	l = len(m.Equals)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.MaxAmount != nil {
+		l = m.MaxAmount.Size()
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.AllowedAddresses) > 0 {
+		for _, s := range m.AllowedAddresses {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleRevenueRecipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ModuleRevenueRatio) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Ratio.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ModuleRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TotalRevenue) > 0 {
+		for _, e := range m.TotalRevenue {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ClaimableRevenue) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Amount) > 0 {
+		for _, e := range m.Amount {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *CronJob) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovTypes(uint64(m.Id))
+	}
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.TypeArgs) > 0 {
+		for _, s := range m.TypeArgs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Args) > 0 {
+		for _, b := range m.Args {
+			l = len(b)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if m.Interval != 0 {
+		n += 1 + sovTypes(uint64(m.Interval))
+	}
+	if m.GasLimit != 0 {
+		n += 1 + sovTypes(uint64(m.GasLimit))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	if m.NextRunHeight != 0 {
+		n += 1 + sovTypes(uint64(m.NextRunHeight))
+	}
+	if m.FailureCount != 0 {
+		n += 1 + sovTypes(uint64(m.FailureCount))
+	}
+	return n
+}
+
+func (m *CronRun) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.JobId != 0 {
+		n += 1 + sovTypes(uint64(m.JobId))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovTypes(uint64(m.GasUsed))
+	}
+	l = m.Fee.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *PublishNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Prefixes) > 0 {
+		for _, s := range m.Prefixes {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PublishUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.WindowStartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.WindowStartHeight))
+	}
+	if m.Count != 0 {
+		n += 1 + sovTypes(uint64(m.Count))
+	}
+	return n
+}
+
+func (m *PublishDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Depositor)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *ModuleIdentifier) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *ModuleFriends) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.Friends) > 0 {
+		for _, s := range m.Friends {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *FunctionSignature) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IsEntry {
+		n += 2
+	}
+	if m.IsView {
+		n += 2
+	}
+	if len(m.TypeParams) > 0 {
+		for _, s := range m.TypeParams {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Params) > 0 {
+		for _, s := range m.Params {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.Returns) > 0 {
+		for _, s := range m.Returns {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ModuleUpgradeCompatibility) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.IsNew {
+		n += 2
+	}
+	if m.StoredUpgradePolicy != 0 {
+		n += 1 + sovTypes(uint64(m.StoredUpgradePolicy))
+	}
+	if m.Allowed {
+		n += 2
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if len(m.RemovedFunctions) > 0 {
+		for _, s := range m.RemovedFunctions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ChangedFunctions) > 0 {
+		for _, s := range m.ChangedFunctions {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.RemovedStructs) > 0 {
+		for _, s := range m.RemovedStructs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.ChangedStructs) > 0 {
+		for _, s := range m.ChangedStructs {
+			l = len(s)
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	if len(m.BrokenDependents) > 0 {
+		for _, e := range m.BrokenDependents {
+			l = e.Size()
+			n += 1 + l + sovTypes(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Authenticator) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *Sponsor) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleAddress)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.ModuleName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	l = len(m.FunctionName)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	return n
+}
+
+func (m *VotingPowerWeightObservation) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovTypes(uint64(m.Height))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSharedRevenueRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractSharedRevenueRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPublishers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedPublishers = append(m.AllowedPublishers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronMaxGasPerBlock", wireType)
+			}
+			m.CronMaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronMaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronMaxFailures", wireType)
+			}
+			m.CronMaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronMaxFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishMode", wireType)
+			}
+			m.PublishMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishMode |= PublishMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublishDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishQuota", wireType)
+			}
+			m.PublishQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishQuotaWindow", wireType)
+			}
+			m.PublishQuotaWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishQuotaWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorGasLimit", wireType)
+			}
+			m.AuthenticatorGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorGasLimit", wireType)
+			}
+			m.SponsorGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SponsorGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTwapWindow", wireType)
+			}
+			m.VotingPowerTwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerTwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerWeightChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVotingPowerWeightChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RawParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RawParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseMinGasPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractSharedRevenueRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ContractSharedRevenueRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronMaxGasPerBlock", wireType)
+			}
+			m.CronMaxGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronMaxGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronMaxFailures", wireType)
+			}
+			m.CronMaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CronMaxFailures |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishMode", wireType)
+			}
+			m.PublishMode = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishMode |= PublishMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishDeposit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PublishDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishQuota", wireType)
+			}
+			m.PublishQuota = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishQuota |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PublishQuotaWindow", wireType)
+			}
+			m.PublishQuotaWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PublishQuotaWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuthenticatorGasLimit", wireType)
+			}
+			m.AuthenticatorGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuthenticatorGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SponsorGasLimit", wireType)
+			}
+			m.SponsorGasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SponsorGasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerTwapWindow", wireType)
+			}
+			m.VotingPowerTwapWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VotingPowerTwapWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxVotingPowerWeightChangeRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxVotingPowerWeightChangeRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Module) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Module: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Abi = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawBytes == nil {
+				m.RawBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicy", wireType)
+			}
+			m.UpgradePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Resource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StructTag", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StructTag = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MoveResource", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MoveResource = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.RawBytes == nil {
+				m.RawBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TableEntry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TableEntry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TableEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Key = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Value = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyBytes = append(m.KeyBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyBytes == nil {
+				m.KeyBytes = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValueBytes", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValueBytes = append(m.ValueBytes[:0], dAtA[iNdEx:postIndex]...)
+			if m.ValueBytes == nil {
+				m.ValueBytes = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UpgradePolicyProto) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UpgradePolicyProto: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UpgradePolicyProto: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			}
+			m.Policy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Policy |= UpgradePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DexPair) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DexPair: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DexPair: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLP", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MetadataLP = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ExecuteAuthorizationItem: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ExecuteAuthorizationItem: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionNames", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionNames = append(m.FunctionNames, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ArgConstraints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ArgConstraints = append(m.ArgConstraints, ArgumentConstraint{})
+			if err := m.ArgConstraints[len(m.ArgConstraints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ArgumentConstraint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ArgumentConstraint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ArgumentConstraint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Equals", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Equals = append(m.Equals[:0], dAtA[iNdEx:postIndex]...)
+			if m.Equals == nil {
+				m.Equals = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.MaxAmount = &v
+			if err := m.MaxAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedAddresses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedAddresses = append(m.AllowedAddresses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleRevenueRecipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleRevenueRecipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleRevenueRecipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleRevenueRatio) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleRevenueRatio: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleRevenueRatio: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ratio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Ratio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalRevenue", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TotalRevenue = append(m.TotalRevenue, types.Coin{})
+			if err := m.TotalRevenue[len(m.TotalRevenue)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ClaimableRevenue) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ClaimableRevenue: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ClaimableRevenue: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronJob) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronJob: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronJob: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeArgs", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeArgs = append(m.TypeArgs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Args", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Args = append(m.Args, make([]byte, postIndex-iNdEx))
+			copy(m.Args[len(m.Args)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			m.Interval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Interval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextRunHeight", wireType)
+			}
+			m.NextRunHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextRunHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailureCount", wireType)
+			}
+			m.FailureCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailureCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *CronRun) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: CronRun: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: CronRun: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JobId", wireType)
+			}
+			m.JobId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.JobId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Fee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PublishNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Prefixes", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Prefixes = append(m.Prefixes, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *DexPair) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.MetadataQuote)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.MetadataLP)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
-
-func (m *ExecuteAuthorizationItem) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.ModuleAddress)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	l = len(m.ModuleName)
-	if l > 0 {
-		n += 1 + l + sovTypes(uint64(l))
-	}
-	if len(m.FunctionNames) > 0 {
-		for _, s := range m.FunctionNames {
-			l = len(s)
-			n += 1 + l + sovTypes(uint64(l))
+func (m *PublishUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PublishUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PublishUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowStartHeight", wireType)
+			}
+			m.WindowStartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowStartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
 
-func sovTypes(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTypes(x uint64) (n int) {
-	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *PublishDeposit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1458,15 +8252,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: PublishDeposit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: PublishDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1494,11 +8288,43 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Depositor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1523,16 +8349,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Depositor = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleIdentifier) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 3:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleIdentifier: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleIdentifier: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSharedRevenueRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1560,13 +8467,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractSharedRevenueRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AllowedPublishers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1594,7 +8499,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AllowedPublishers = append(m.AllowedPublishers, string(dAtA[iNdEx:postIndex]))
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1617,7 +8522,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RawParams) Unmarshal(dAtA []byte) error {
+func (m *ModuleFriends) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1640,15 +8545,15 @@ func (m *RawParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RawParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleFriends: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RawParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleFriends: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1676,11 +8581,11 @@ func (m *RawParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseMinGasPrice", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1708,13 +8613,11 @@ func (m *RawParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BaseMinGasPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ContractSharedRevenueRatio", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Friends", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1742,9 +8645,7 @@ func (m *RawParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ContractSharedRevenueRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Friends = append(m.Friends, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1767,7 +8668,7 @@ func (m *RawParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Module) Unmarshal(dAtA []byte) error {
+func (m *FunctionSignature) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1790,10 +8691,10 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Module: wiretype end group for non-group")
+			return fmt.Errorf("proto: FunctionSignature: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Module: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FunctionSignature: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1862,7 +8763,7 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Abi", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1890,13 +8791,53 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Abi = string(dAtA[iNdEx:postIndex])
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsEntry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsEntry = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsView", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsView = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeParams", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1906,31 +8847,61 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawBytes == nil {
-				m.RawBytes = []byte{}
+			m.TypeParams = append(m.TypeParams, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Params = append(m.Params, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpgradePolicy", wireType)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Returns", wireType)
 			}
-			m.UpgradePolicy = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -1940,11 +8911,24 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Returns = append(m.Returns, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
@@ -1966,7 +8950,7 @@ func (m *Module) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Resource) Unmarshal(dAtA []byte) error {
+func (m *ModuleUpgradeCompatibility) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1989,10 +8973,10 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Resource: wiretype end group for non-group")
+			return fmt.Errorf("proto: ModuleUpgradeCompatibility: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Resource: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ModuleUpgradeCompatibility: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2029,7 +9013,7 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StructTag", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2057,13 +9041,13 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.StructTag = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MoveResource", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsNew", wireType)
 			}
-			var stringLen uint64
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2073,29 +9057,56 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
+			m.IsNew = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StoredUpgradePolicy", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
+			m.StoredUpgradePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StoredUpgradePolicy |= UpgradePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allowed", wireType)
 			}
-			m.MoveResource = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Allowed = bool(v != 0)
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RawBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2105,79 +9116,59 @@ func (m *Resource) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RawBytes = append(m.RawBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.RawBytes == nil {
-				m.RawBytes = []byte{}
-			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedFunctions", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *TableInfo) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: TableInfo: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableInfo: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemovedFunctions = append(m.RemovedFunctions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedFunctions", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2205,11 +9196,11 @@ func (m *TableInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Address = string(dAtA[iNdEx:postIndex])
+			m.ChangedFunctions = append(m.ChangedFunctions, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RemovedStructs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2237,11 +9228,11 @@ func (m *TableInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.KeyType = string(dAtA[iNdEx:postIndex])
+			m.RemovedStructs = append(m.RemovedStructs, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChangedStructs", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2269,7 +9260,41 @@ func (m *TableInfo) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueType = string(dAtA[iNdEx:postIndex])
+			m.ChangedStructs = append(m.ChangedStructs, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BrokenDependents", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BrokenDependents = append(m.BrokenDependents, ModuleIdentifier{})
+			if err := m.BrokenDependents[len(m.BrokenDependents)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2292,7 +9317,7 @@ func (m *TableInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TableEntry) Unmarshal(dAtA []byte) error {
+func (m *Authenticator) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2315,10 +9340,10 @@ func (m *TableEntry) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TableEntry: wiretype end group for non-group")
+			return fmt.Errorf("proto: Authenticator: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TableEntry: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Authenticator: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2355,7 +9380,7 @@ func (m *TableEntry) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Key", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2383,11 +9408,11 @@ func (m *TableEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Key = string(dAtA[iNdEx:postIndex])
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Value", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2415,47 +9440,13 @@ func (m *TableEntry) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Value = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field KeyBytes", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTypes
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.KeyBytes = append(m.KeyBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.KeyBytes == nil {
-				m.KeyBytes = []byte{}
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValueBytes", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2465,25 +9456,23 @@ func (m *TableEntry) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTypes
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValueBytes = append(m.ValueBytes[:0], dAtA[iNdEx:postIndex]...)
-			if m.ValueBytes == nil {
-				m.ValueBytes = []byte{}
-			}
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2506,7 +9495,7 @@ func (m *TableEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *UpgradePolicyProto) Unmarshal(dAtA []byte) error {
+func (m *Sponsor) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2529,17 +9518,17 @@ func (m *UpgradePolicyProto) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: UpgradePolicyProto: wiretype end group for non-group")
+			return fmt.Errorf("proto: Sponsor: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: UpgradePolicyProto: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Sponsor: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.Policy = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2549,64 +9538,59 @@ func (m *UpgradePolicyProto) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Policy |= UpgradePolicy(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipTypes(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
 				return ErrInvalidLengthTypes
 			}
-			if (iNdEx + skippy) > l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *DexPair) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowTypes
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: DexPair: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: DexPair: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataQuote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2634,11 +9618,11 @@ func (m *DexPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataQuote = string(dAtA[iNdEx:postIndex])
+			m.ModuleName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MetadataLP", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FunctionName", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2666,7 +9650,7 @@ func (m *DexPair) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MetadataLP = string(dAtA[iNdEx:postIndex])
+			m.FunctionName = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2689,7 +9673,7 @@ func (m *DexPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
+func (m *VotingPowerWeightObservation) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2712,15 +9696,15 @@ func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ExecuteAuthorizationItem: wiretype end group for non-group")
+			return fmt.Errorf("proto: VotingPowerWeightObservation: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ExecuteAuthorizationItem: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VotingPowerWeightObservation: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2748,13 +9732,13 @@ func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAddress = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleName", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
-			var stringLen uint64
+			m.Height = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTypes
@@ -2764,27 +9748,14 @@ func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Height |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthTypes
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTypes
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ModuleName = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FunctionNames", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2812,7 +9783,9 @@ func (m *ExecuteAuthorizationItem) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FunctionNames = append(m.FunctionNames, string(dAtA[iNdEx:postIndex]))
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
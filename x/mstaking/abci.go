@@ -22,5 +22,11 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 // Called every block, update validator set
 func EndBlocker(ctx context.Context, k keeper.Keeper) ([]abci.ValidatorUpdate, error) {
 	defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), telemetry.MetricKeyEndBlocker)
+
+	// apply the scheduled commission changes before the validator set update
+	if err := k.ApplyMatureCommissionChanges(ctx); err != nil {
+		return nil, err
+	}
+
 	return k.BlockValidatorUpdates(ctx)
 }
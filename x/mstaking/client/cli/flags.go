@@ -14,6 +14,10 @@ const (
 	FlagAmount              = "amount"
 	FlagSharesAmount        = "shares-amount"
 	FlagSharesFraction      = "shares-fraction"
+	FlagMinTargetAmount     = "min-target-amount"
+	FlagAddressDelegator    = "delegator"
+	FlagStartTime           = "start-time"
+	FlagEndTime             = "end-time"
 
 	FlagMoniker         = "moniker"
 	FlagIdentity        = "identity"
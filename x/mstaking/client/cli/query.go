@@ -3,6 +3,7 @@ package cli
 import (
 	"fmt"
 	"strings"
+	"time"
 
 	"cosmossdk.io/core/address"
 	"github.com/spf13/cobra"
@@ -39,6 +40,11 @@ func GetQueryCmd(ac, vc address.Codec) *cobra.Command {
 		GetCmdQueryValidatorRedelegations(vc),
 		GetCmdQueryParams(),
 		GetCmdQueryPool(),
+		GetCmdQueryVotingPowerWeights(),
+		GetCmdQueryVotingPowerWeightsHistory(),
+		GetCmdQueryUnbondingCalendar(),
+		GetCmdQueryRedelegationCalendar(),
+		GetCmdQueryPendingCommissionChange(vc),
 	)
 
 	return stakingQueryCmd
@@ -88,6 +94,50 @@ $ %s query staking validator %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
 	return cmd
 }
 
+// GetCmdQueryPendingCommissionChange implements the pending commission change query command.
+func GetCmdQueryPendingCommissionChange(vc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "pending-commission-change [validator-addr]",
+		Short: "Query the scheduled commission change of a validator",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the commission change scheduled by a validator, which is not yet effective.
+
+Example:
+$ %s query mstaking pending-commission-change %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			valAddrStr := args[0]
+			if _, err := vc.StringToBytes(valAddrStr); err != nil {
+				return err
+			}
+
+			params := &types.QueryPendingCommissionChangeRequest{ValidatorAddr: valAddrStr}
+			res, err := queryClient.PendingCommissionChange(cmd.Context(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.PendingCommissionChange)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
 // GetCmdQueryValidators implements the query all validators command.
 func GetCmdQueryValidators() *cobra.Command {
 	cmd := &cobra.Command{
@@ -702,3 +752,224 @@ $ %s query staking params
 
 	return cmd
 }
+
+// GetCmdQueryVotingPowerWeights implements the voting power weights query command.
+func GetCmdQueryVotingPowerWeights() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-weights",
+		Args:  cobra.NoArgs,
+		Short: "Query the current voting power weights of the bond denoms",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the current voting power weights of the bond denoms.
+
+Example:
+$ %s query mstaking voting-power-weights
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.VotingPowerWeights(cmd.Context(), &types.QueryVotingPowerWeightsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryVotingPowerWeightsHistory implements the voting power weights history query command.
+func GetCmdQueryVotingPowerWeightsHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "voting-power-weights-history",
+		Args:  cobra.NoArgs,
+		Short: "Query the voting power weights applied at the recent blocks",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the voting power weights applied at the recent blocks.
+
+Example:
+$ %s query mstaking voting-power-weights-history --reverse --limit 10
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.VotingPowerWeightsHistory(cmd.Context(), &types.QueryVotingPowerWeightsHistoryRequest{
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "voting power weights history")
+
+	return cmd
+}
+
+// GetCmdQueryUnbondingCalendar implements the unbonding calendar query command.
+func GetCmdQueryUnbondingCalendar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "unbonding-calendar",
+		Args:  cobra.NoArgs,
+		Short: "Query the unbonding amounts aggregated per completion time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the unbonding amounts aggregated per completion time, optionally
+filtered by a completion time range, a validator and a delegator.
+
+Example:
+$ %s query mstaking unbonding-calendar --start-time 2024-01-01T00:00:00Z --end-time 2024-01-31T00:00:00Z
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, valAddr, delAddr, err := readCalendarFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.UnbondingCalendar(cmd.Context(), &types.QueryUnbondingCalendarRequest{
+				StartTime:     startTime,
+				EndTime:       endTime,
+				ValidatorAddr: valAddr,
+				DelegatorAddr: delAddr,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addCalendarFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "unbonding calendar")
+
+	return cmd
+}
+
+// GetCmdQueryRedelegationCalendar implements the redelegation calendar query command.
+func GetCmdQueryRedelegationCalendar() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "redelegation-calendar",
+		Args:  cobra.NoArgs,
+		Short: "Query the redelegation amounts aggregated per completion time",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Query the redelegation amounts aggregated per completion time, optionally
+filtered by a completion time range, a source or destination validator and a delegator.
+
+Example:
+$ %s query mstaking redelegation-calendar --delegator init1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			startTime, endTime, valAddr, delAddr, err := readCalendarFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			res, err := queryClient.RedelegationCalendar(cmd.Context(), &types.QueryRedelegationCalendarRequest{
+				StartTime:     startTime,
+				EndTime:       endTime,
+				ValidatorAddr: valAddr,
+				DelegatorAddr: delAddr,
+				Pagination:    pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	addCalendarFlags(cmd)
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "redelegation calendar")
+
+	return cmd
+}
+
+func addCalendarFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagStartTime, "", "The inclusive start of the completion time in RFC3339")
+	cmd.Flags().String(FlagEndTime, "", "The inclusive end of the completion time in RFC3339")
+	cmd.Flags().String(FlagAddressValidator, "", "The Bech32 address of the validator")
+	cmd.Flags().String(FlagAddressDelegator, "", "The Bech32 address of the delegator")
+}
+
+func readCalendarFlags(cmd *cobra.Command) (startTime, endTime time.Time, valAddr, delAddr string, err error) {
+	for flagName, t := range map[string]*time.Time{FlagStartTime: &startTime, FlagEndTime: &endTime} {
+		value, err := cmd.Flags().GetString(flagName)
+		if err != nil {
+			return time.Time{}, time.Time{}, "", "", err
+		}
+		if value == "" {
+			continue
+		}
+
+		if *t, err = time.Parse(time.RFC3339, value); err != nil {
+			return time.Time{}, time.Time{}, "", "", fmt.Errorf("invalid %s: %w", flagName, err)
+		}
+	}
+
+	if valAddr, err = cmd.Flags().GetString(FlagAddressValidator); err != nil {
+		return time.Time{}, time.Time{}, "", "", err
+	}
+	if delAddr, err = cmd.Flags().GetString(FlagAddressDelegator); err != nil {
+		return time.Time{}, time.Time{}, "", "", err
+	}
+
+	return startTime, endTime, valAddr, delAddr, nil
+}
@@ -46,6 +46,10 @@ func NewTxCmd(ac, vc address.Codec) *cobra.Command {
 		NewDelegateCmd(ac, vc),
 		NewRedelegateCmd(ac, vc),
 		NewUnbondCmd(ac, vc),
+		NewRotateConsPubKeyCmd(vc),
+		NewConvertDelegationCmd(ac, vc),
+		NewScheduleCommissionChangeCmd(vc),
+		NewRetireValidatorCmd(vc),
 	)
 
 	return stakingTxCmd
@@ -300,6 +304,202 @@ $ %s tx staking unbond %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100stake --from
 	return cmd
 }
 
+func NewConvertDelegationCmd(ac, vc address.Codec) *cobra.Command {
+	bech32PrefixValAddr := sdk.GetConfig().GetBech32ValidatorAddrPrefix()
+
+	cmd := &cobra.Command{
+		Use:   "convert-delegation [validator-addr] [amount] [target-denom]",
+		Short: "Convert a delegation to another bond denom at the same validator",
+		Args:  cobra.ExactArgs(3),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Convert an amount of delegation to another bond denom at the same validator
+through the dex, between the base denom and the LP denom of a whitelisted dex pair.
+
+Example:
+$ %s tx mstaking convert-delegation %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj 100ulp uinit --min-target-amount 90 --from mykey
+`,
+				version.AppName, bech32PrefixValAddr,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			delAddr := clientCtx.GetFromAddress()
+			delAddrStr, err := ac.BytesToString(delAddr)
+			if err != nil {
+				return err
+			}
+
+			valAddrStr := args[0]
+			if _, err := vc.StringToBytes(valAddrStr); err != nil {
+				return err
+			}
+
+			amount, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return err
+			}
+
+			minTargetAmountStr, err := cmd.Flags().GetString(FlagMinTargetAmount)
+			if err != nil {
+				return err
+			}
+
+			minTargetAmount, ok := math.NewIntFromString(minTargetAmountStr)
+			if !ok {
+				return fmt.Errorf("invalid min target amount: %s", minTargetAmountStr)
+			}
+
+			msg := types.NewMsgConvertDelegation(delAddrStr, valAddrStr, amount, args[2], minTargetAmount)
+			if err := msg.Validate(ac, vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMinTargetAmount, "0", "The minimum amount of the converted coin")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewScheduleCommissionChangeCmd(vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "schedule-commission-change [commission-rate]",
+		Short: "Schedule a commission rate change of an existing validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Schedule a commission rate change of an existing validator. The new rate takes
+effect after the commission change notice period, and replaces any change scheduled before.
+
+Example:
+$ %s tx mstaking schedule-commission-change 0.10 --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			valAddrStr, err := vc.BytesToString(valAddr)
+			if err != nil {
+				return err
+			}
+
+			rate, err := math.LegacyNewDecFromStr(args[0])
+			if err != nil {
+				return fmt.Errorf("invalid new commission rate: %v", err)
+			}
+
+			msg := types.NewMsgScheduleCommissionChange(valAddrStr, rate)
+			if err := msg.Validate(vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRetireValidatorCmd(vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "retire-validator",
+		Short: "Retire an existing validator",
+		Args:  cobra.NoArgs,
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Retire an existing validator without slashing. The validator leaves the active set
+at the next end block and cannot receive new delegations anymore. The retirement cannot be reverted.
+
+Example:
+$ %s tx mstaking retire-validator --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			valAddrStr, err := vc.BytesToString(valAddr)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgRetireValidator(valAddrStr)
+			if err := msg.Validate(vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func NewRotateConsPubKeyCmd(vc address.Codec) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rotate-cons-pubkey [pubkey]",
+		Short: "Rotate the consensus public key of an existing validator",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Rotate the consensus public key of an existing validator. The key rotation fee
+is charged to the validator operator.
+
+Example:
+$ %s tx mstaking rotate-cons-pubkey '{"@type":"/cosmos.crypto.ed25519.PubKey","key":"..."}' --from mykey
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			valAddr := clientCtx.GetFromAddress()
+			valAddrStr, err := vc.BytesToString(valAddr)
+			if err != nil {
+				return err
+			}
+
+			var pk cryptotypes.PubKey
+			if err := clientCtx.Codec.UnmarshalInterfaceJSON([]byte(args[0]), &pk); err != nil {
+				return err
+			}
+
+			msg, err := types.NewMsgRotateConsPubKey(valAddrStr, pk)
+			if err != nil {
+				return err
+			}
+			if err := msg.Validate(vc); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
 func newBuildCreateValidatorMsg(clientCtx client.Context, txf tx.Factory, fs *flag.FlagSet, vc address.Codec) (tx.Factory, *types.MsgCreateValidator, error) {
 	fAmount, _ := fs.GetString(FlagAmount)
 	amount, err := sdk.ParseCoinsNormalized(fAmount)
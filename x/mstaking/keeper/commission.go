@@ -0,0 +1,173 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// ScheduleCommissionChange schedules a commission rate change of the validator,
+// which takes effect after the commission change notice period. A previously
+// scheduled change of the validator is replaced by the new one.
+func (k Keeper) ScheduleCommissionChange(
+	ctx context.Context,
+	validator types.Validator,
+	newRate math.LegacyDec,
+) (types.PendingCommissionChange, error) {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	if validator.IsRetired() {
+		return types.PendingCommissionChange{}, types.ErrValidatorRetired
+	}
+
+	// validate the new rate against the current commission
+	if _, err := k.UpdateValidatorCommission(ctx, validator, newRate); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	noticePeriod, err := k.CommissionChangeNoticePeriod(ctx)
+	if err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	if err := k.RemovePendingCommissionChange(ctx, valAddr); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	change := types.PendingCommissionChange{
+		ValidatorAddress: validator.GetOperator(),
+		Rate:             newRate,
+		ScheduleTime:     blockTime,
+		EffectiveTime:    blockTime.Add(noticePeriod),
+	}
+
+	if err := k.SetPendingCommissionChange(ctx, valAddr, change); err != nil {
+		return types.PendingCommissionChange{}, err
+	}
+
+	return change, nil
+}
+
+// SetPendingCommissionChange stores the pending commission change and its time index.
+func (k Keeper) SetPendingCommissionChange(ctx context.Context, valAddr sdk.ValAddress, change types.PendingCommissionChange) error {
+	if err := k.PendingCommissionChanges.Set(ctx, valAddr, change); err != nil {
+		return err
+	}
+
+	return k.PendingCommissionChangesByTime.Set(ctx, collections.Join(change.EffectiveTime, []byte(valAddr)), true)
+}
+
+// RemovePendingCommissionChange removes the pending commission change of the validator, if any.
+func (k Keeper) RemovePendingCommissionChange(ctx context.Context, valAddr sdk.ValAddress) error {
+	change, err := k.PendingCommissionChanges.Get(ctx, valAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	if err := k.PendingCommissionChanges.Remove(ctx, valAddr); err != nil {
+		return err
+	}
+
+	return k.PendingCommissionChangesByTime.Remove(ctx, collections.Join(change.EffectiveTime, []byte(valAddr)))
+}
+
+// HasPendingCommissionChange returns true if the validator has a scheduled commission change.
+func (k Keeper) HasPendingCommissionChange(ctx context.Context, valAddr sdk.ValAddress) (bool, error) {
+	return k.PendingCommissionChanges.Has(ctx, valAddr)
+}
+
+// GetAllPendingCommissionChanges returns all pending commission changes, used during genesis dump.
+func (k Keeper) GetAllPendingCommissionChanges(ctx context.Context) (changes []types.PendingCommissionChange, err error) {
+	err = k.PendingCommissionChanges.Walk(ctx, nil, func(_ []byte, change types.PendingCommissionChange) (stop bool, err error) {
+		changes = append(changes, change)
+		return false, nil
+	})
+
+	return
+}
+
+// ApplyMatureCommissionChanges applies all the scheduled commission changes whose
+// effective time has passed. A change which no longer satisfies the minimum
+// commission rate is cancelled instead.
+func (k Keeper) ApplyMatureCommissionChanges(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	blockTime := sdkCtx.BlockTime()
+
+	var keys []collections.Pair[time.Time, []byte]
+	err := k.PendingCommissionChangesByTime.Walk(ctx, collections.NewPrefixUntilPairRange[time.Time, []byte](blockTime), func(key collections.Pair[time.Time, []byte], _ bool) (stop bool, err error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	minCommission, err := k.MinCommissionRate(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		valAddr := sdk.ValAddress(key.K2())
+		change, err := k.PendingCommissionChanges.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		if err := k.RemovePendingCommissionChange(ctx, valAddr); err != nil {
+			return err
+		}
+
+		if change.Rate.LT(minCommission) {
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeCancelCommissionChange,
+					sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+					sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+					sdk.NewAttribute(types.AttributeKeyReason, "below minimum commission rate "+minCommission.String()),
+				),
+			)
+
+			continue
+		}
+
+		validator, err := k.Validators.Get(ctx, valAddr)
+		if err != nil {
+			return err
+		}
+
+		// call the before-modification hook since we're about to update the commission
+		if err := k.Hooks().BeforeValidatorModified(ctx, valAddr); err != nil {
+			return err
+		}
+
+		validator.Commission.Rate = change.Rate
+		validator.Commission.UpdateTime = blockTime
+		if err := k.SetValidator(ctx, validator); err != nil {
+			return err
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeApplyCommissionChange,
+				sdk.NewAttribute(types.AttributeKeyValidator, change.ValidatorAddress),
+				sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -90,12 +90,29 @@ func (k CompatibilityKeeper) TotalBondedTokens(ctx context.Context) (math.Int, e
 	return total, err
 }
 
+// GetPubKeyByConsAddr returns the consensus pubkey of the consensus address. The
+// rotated out address returns the old pubkey until the validator set update of
+// the rotation is applied by CometBFT, because the vote extensions are signed
+// with the old pubkey until then.
 func (k CompatibilityKeeper) GetPubKeyByConsAddr(ctx context.Context, addr sdk.ConsAddress) (cmtprotocrypto.PublicKey, error) {
+	if pubkey, found, err := k.getSigningRotatedConsPubKey(ctx, addr); err != nil {
+		return cmtprotocrypto.PublicKey{}, err
+	} else if found {
+		return pubkey, nil
+	}
+
 	v, err := k.GetValidatorByConsAddr(ctx, addr)
 	if err != nil {
 		return cmtprotocrypto.PublicKey{}, err
 	}
 
+	// the address is rotated out and no longer signs
+	if consAddr, err := v.GetConsAddr(); err != nil {
+		return cmtprotocrypto.PublicKey{}, err
+	} else if !consAddr.Equals(addr) {
+		return cmtprotocrypto.PublicKey{}, types.ErrNoValidatorFound.Wrapf("consensus address %s is rotated out", addr)
+	}
+
 	pubkey, err := v.CmtConsPublicKey()
 	if err != nil {
 		return cmtprotocrypto.PublicKey{}, err
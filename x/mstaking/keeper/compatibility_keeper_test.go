@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/header"
+	"cosmossdk.io/math"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	protoio "github.com/cosmos/gogoproto/io"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/skip-mev/slinky/abci/ve"
+
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// validateVoteExtension validates the vote extension of the previous height
+// signed by the private key with the validator store of the staking keeper.
+func validateVoteExtension(t *testing.T, ctx sdk.Context, input TestKeepers, privKey cryptotypes.PrivKey) error {
+	chainID := "test-chain"
+	ctx = ctx.
+		WithHeaderInfo(header.Info{Height: ctx.BlockHeight(), ChainID: chainID}).
+		WithConsensusParams(cmtproto.ConsensusParams{Abci: &cmtproto.ABCIParams{VoteExtensionsEnableHeight: 1}})
+
+	extension := []byte("extension")
+	var buf bytes.Buffer
+	err := protoio.NewDelimitedWriter(&buf).WriteMsg(&cmtproto.CanonicalVoteExtension{
+		Extension: extension,
+		Height:    ctx.BlockHeight() - 1,
+		ChainId:   chainID,
+	})
+	require.NoError(t, err)
+
+	signature, err := privKey.Sign(buf.Bytes())
+	require.NoError(t, err)
+
+	validator := abci.Validator{Address: privKey.PubKey().Address(), Power: 1}
+	ctx = ctx.WithCometInfo(baseapp.NewBlockInfo(nil, nil, nil, abci.CommitInfo{
+		Votes: []abci.VoteInfo{{Validator: validator, BlockIdFlag: cmtproto.BlockIDFlagCommit}},
+	}))
+
+	validateFn := ve.NewDefaultValidateVoteExtensionsFn(keeper.NewCompatibilityKeeper(&input.StakingKeeper))
+	return validateFn(ctx, abci.ExtendedCommitInfo{
+		Votes: []abci.ExtendedVoteInfo{{
+			Validator:          validator,
+			VoteExtension:      extension,
+			ExtensionSignature: signature,
+			BlockIdFlag:        cmtproto.BlockIDFlagCommit,
+		}},
+	})
+}
+
+func Test_GetPubKeyByConsAddr_Rotation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ctx = ctx.WithBlockHeight(10)
+
+	oldPrivKey := ed25519.GenPrivKey()
+	valAddr := sdk.ValAddress(addrs[0])
+	input.Faucet.Fund(ctx, addrs[0], sdk.NewCoin(bondDenom, math.NewInt(100_000_000)))
+
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	_, err := ms.CreateValidator(ctx, newTestMsgCreateValidator(valAddr, oldPrivKey.PubKey(), math.NewInt(1_000_000)))
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	require.NoError(t, validateVoteExtension(t, ctx, input, oldPrivKey))
+
+	// rotate at the height 10
+	newPrivKey := ed25519.GenPrivKey()
+	msg, err := types.NewMsgRotateConsPubKey(valAddr.String(), newPrivKey.PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	// CometBFT applies the update from the height 12, so the vote extensions
+	// of the heights 10 and 11 are signed with the old key
+	for _, height := range []int64{11, 12} {
+		require.NoError(t, validateVoteExtension(t, ctx.WithBlockHeight(height), input, oldPrivKey))
+	}
+
+	// the old key no longer signs after the update is applied
+	ctx = ctx.WithBlockHeight(13)
+	require.Error(t, validateVoteExtension(t, ctx, input, oldPrivKey))
+	require.NoError(t, validateVoteExtension(t, ctx, input, newPrivKey))
+
+	_, err = keeper.NewCompatibilityKeeper(&input.StakingKeeper).GetPubKeyByConsAddr(ctx, sdk.GetConsAddress(oldPrivKey.PubKey()))
+	require.ErrorIs(t, err, types.ErrNoValidatorFound)
+}
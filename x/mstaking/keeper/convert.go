@@ -0,0 +1,81 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingexported "github.com/cosmos/cosmos-sdk/x/auth/vesting/exported"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// ConvertDelegation converts the delegated coin of a bond denom to another bond denom
+// through the dex, and delegates the converted coin back to the same validator.
+//
+// The converted stake never leaves the validator, so no unbonding or redelegation
+// entry is needed; a slash for the infractions committed before the conversion
+// burns the fraction of the validator tokens, which include the converted stake.
+// The delegation received by a redelegation cannot be converted until the
+// redelegation completes, because the redelegation entry slashes the destination
+// shares of the original denom.
+//
+// The delegation of a vesting account cannot be converted, because the vesting
+// account tracks the delegated vesting coins by denom, and the conversion would
+// swap the locked coins into the other denom. The app does not register the
+// vesting accounts, so the check only guards the chains which do.
+func (k Keeper) ConvertDelegation(
+	ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress,
+	amount sdk.Coin, targetDenom string, minTargetAmount math.Int,
+) (sdk.Coin, error) {
+	if _, ok := k.authKeeper.GetAccount(ctx, delAddr).(vestingexported.VestingAccount); ok {
+		return sdk.Coin{}, types.ErrVestingAccountConversion
+	}
+
+	if found, err := k.HasReceivingRedelegation(ctx, delAddr, valAddr); err != nil {
+		return sdk.Coin{}, err
+	} else if found {
+		return sdk.Coin{}, types.ErrTransitiveConversion
+	}
+
+	shares, err := k.ValidateUnbondAmount(ctx, delAddr, valAddr, sdk.NewCoins(amount))
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	returnAmount, err := k.Unbond(ctx, delAddr, valAddr, shares)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	// the validator removed by the unbond cannot receive the converted coin
+	validator, err := k.GetValidator(ctx, valAddr)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	poolName := types.NotBondedPoolName
+	if validator.IsBonded() {
+		poolName = types.BondedPoolName
+	}
+
+	if err := k.bankKeeper.UndelegateCoinsFromModuleToAccount(ctx, poolName, delAddr, returnAmount); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	converted, err := k.DexKeeper.ConvertCoin(ctx, delAddr, sdk.NewCoin(amount.Denom, returnAmount.AmountOf(amount.Denom)), targetDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	if !converted.IsPositive() || converted.Amount.LT(minTargetAmount) {
+		return sdk.Coin{}, types.ErrConversionSlippage.Wrapf("got %s, expected at least %s", converted, minTargetAmount)
+	}
+
+	if _, err := k.Delegate(ctx, delAddr, sdk.NewCoins(converted), types.Unbonded, validator, true); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	return converted, nil
+}
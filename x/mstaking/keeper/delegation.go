@@ -626,6 +626,11 @@ func (k Keeper) Delegate(
 		return nil, types.ErrDelegatorShareExRateInvalid
 	}
 
+	// retired validators do not accept new delegations
+	if validator.IsRetired() {
+		return nil, types.ErrValidatorRetired
+	}
+
 	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
 	if err != nil {
 		return nil, err
@@ -977,10 +982,13 @@ func (k Keeper) BeginRedelegation(
 		return time.Time{}, types.ErrTransitiveRedelegation
 	}
 
-	if has, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr); err != nil {
-		return time.Time{}, err
-	} else if has {
-		return time.Time{}, types.ErrMaxRedelegationEntries
+	// the delegators of a retired validator can move out without the entry limit
+	if !srcValidator.IsRetired() {
+		if has, err := k.HasMaxRedelegationEntries(ctx, delAddr, valSrcAddr, valDstAddr); err != nil {
+			return time.Time{}, err
+		} else if has {
+			return time.Time{}, types.ErrMaxRedelegationEntries
+		}
 	}
 
 	returnAmount, err := k.Unbond(ctx, delAddr, valSrcAddr, sharesAmount)
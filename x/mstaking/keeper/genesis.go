@@ -187,6 +187,23 @@ func (k Keeper) InitGenesis(
 		panic(err)
 	}
 
+	for _, history := range data.RotationHistories {
+		if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, change := range data.PendingCommissionChanges {
+		valAddr, err := k.validatorAddressCodec.StringToBytes(change.ValidatorAddress)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.SetPendingCommissionChange(ctx, valAddr, change); err != nil {
+			panic(err)
+		}
+	}
+
 	// don't need to run Tendermint updates if we exported
 	if data.Exported {
 		for _, lv := range data.LastValidatorPowers {
@@ -280,14 +297,26 @@ func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
 		panic(err)
 	}
 
+	rotationHistories, err := k.GetAllConsPubKeyRotationHistories(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	pendingCommissionChanges, err := k.GetAllPendingCommissionChanges(ctx)
+	if err != nil {
+		panic(err)
+	}
+
 	return &types.GenesisState{
-		Params:               params,
-		LastValidatorPowers:  lastValidatorPowers,
-		Validators:           allValidators,
-		Delegations:          allDelegations,
-		UnbondingDelegations: unbondingDelegations,
-		Redelegations:        redelegations,
-		Exported:             true,
-		NextUnbondingId:      nextUnbondingId,
+		Params:                   params,
+		LastValidatorPowers:      lastValidatorPowers,
+		Validators:               allValidators,
+		Delegations:              allDelegations,
+		UnbondingDelegations:     unbondingDelegations,
+		Redelegations:            redelegations,
+		Exported:                 true,
+		NextUnbondingId:          nextUnbondingId,
+		RotationHistories:        rotationHistories,
+		PendingCommissionChanges: pendingCommissionChanges,
 	}
 }
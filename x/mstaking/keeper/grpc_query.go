@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"bytes"
 	"context"
 	"errors"
 	"strings"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -400,6 +402,276 @@ func (q Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*type
 	return &types.QueryParamsResponse{Params: params}, nil
 }
 
+// VotingPowerWeights queries the current voting power weights of the bond denoms
+func (q Querier) VotingPowerWeights(ctx context.Context, _ *types.QueryVotingPowerWeightsRequest) (*types.QueryVotingPowerWeightsResponse, error) {
+	weights, err := q.GetVotingPowerWeights(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingPowerWeightsResponse{VotingPowerWeights: weights}, nil
+}
+
+// VotingPowerWeightsHistory queries the voting power weights applied at the recent blocks
+func (q Querier) VotingPowerWeightsHistory(ctx context.Context, req *types.QueryVotingPowerWeightsHistoryRequest) (*types.QueryVotingPowerWeightsHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	histories, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.HistoricalVotingPowerWeights, req.Pagination, func(_ int64, history types.HistoricalVotingPowerWeights) (types.HistoricalVotingPowerWeights, error) {
+		return history, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryVotingPowerWeightsHistoryResponse{Histories: histories, Pagination: pageRes}, nil
+}
+
+// UnbondingCalendar queries the unbonding amounts aggregated per completion time of the unbonding queue
+func (q Querier) UnbondingCalendar(ctx context.Context, req *types.QueryUnbondingCalendarRequest) (*types.QueryUnbondingCalendarResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, delAddr, err := q.calendarFilters(req.ValidatorAddr, req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the same pair can be queued multiple times at the same completion time
+	filterPairs := func(pairs types.DVPairs) []types.DVPair {
+		seen := make(map[types.DVPair]bool)
+		filtered := []types.DVPair{}
+		for _, pair := range pairs.Pairs {
+			if seen[pair] || (valAddr != "" && pair.ValidatorAddress != valAddr) || (delAddr != "" && pair.DelegatorAddress != delAddr) {
+				continue
+			}
+
+			seen[pair] = true
+			filtered = append(filtered, pair)
+		}
+
+		return filtered
+	}
+
+	queue, err := newCalendarQueue(q.Keeper.UnbondingQueue, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, queue, req.Pagination, func(_ time.Time, pairs types.DVPairs) (bool, error) {
+		return len(filterPairs(pairs)) > 0, nil
+	}, func(completionTime time.Time, pairs types.DVPairs) (types.MaturityCalendarEntry, error) {
+		amount := sdk.NewCoins()
+		for _, pair := range filterPairs(pairs) {
+			delegatorAddr, err := q.authKeeper.AddressCodec().StringToBytes(pair.DelegatorAddress)
+			if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+			validatorAddr, err := q.validatorAddressCodec.StringToBytes(pair.ValidatorAddress)
+			if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+
+			ubd, err := q.GetUnbondingDelegation(ctx, delegatorAddr, validatorAddr)
+			if err != nil && errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+
+			for _, entry := range ubd.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					amount = amount.Add(entry.Balance...)
+				}
+			}
+		}
+
+		return types.MaturityCalendarEntry{CompletionTime: completionTime, Amount: amount}, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryUnbondingCalendarResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// RedelegationCalendar queries the redelegation amounts aggregated per completion time of the redelegation queue.
+// The amount of a redelegation is its initial balance, because the slashes after the redelegation
+// unbond the destination shares without updating the entry, so the amount can overstate the
+// remaining stake of a slashed redelegation.
+func (q Querier) RedelegationCalendar(ctx context.Context, req *types.QueryRedelegationCalendarRequest) (*types.QueryRedelegationCalendarResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	valAddr, delAddr, err := q.calendarFilters(req.ValidatorAddr, req.DelegatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	// the same triplet can be queued multiple times at the same completion time
+	filterTriplets := func(triplets types.DVVTriplets) []types.DVVTriplet {
+		seen := make(map[types.DVVTriplet]bool)
+		filtered := []types.DVVTriplet{}
+		for _, triplet := range triplets.Triplets {
+			if seen[triplet] || (valAddr != "" && triplet.ValidatorSrcAddress != valAddr && triplet.ValidatorDstAddress != valAddr) || (delAddr != "" && triplet.DelegatorAddress != delAddr) {
+				continue
+			}
+
+			seen[triplet] = true
+			filtered = append(filtered, triplet)
+		}
+
+		return filtered
+	}
+
+	queue, err := newCalendarQueue(q.Keeper.RedelegationQueue, req.StartTime, req.EndTime)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	entries, pageRes, err := query.CollectionFilteredPaginate(ctx, queue, req.Pagination, func(_ time.Time, triplets types.DVVTriplets) (bool, error) {
+		return len(filterTriplets(triplets)) > 0, nil
+	}, func(completionTime time.Time, triplets types.DVVTriplets) (types.MaturityCalendarEntry, error) {
+		amount := sdk.NewCoins()
+		for _, triplet := range filterTriplets(triplets) {
+			delegatorAddr, err := q.authKeeper.AddressCodec().StringToBytes(triplet.DelegatorAddress)
+			if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+			validatorSrcAddr, err := q.validatorAddressCodec.StringToBytes(triplet.ValidatorSrcAddress)
+			if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+			validatorDstAddr, err := q.validatorAddressCodec.StringToBytes(triplet.ValidatorDstAddress)
+			if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+
+			red, err := q.GetRedelegation(ctx, delegatorAddr, validatorSrcAddr, validatorDstAddr)
+			if err != nil && errors.Is(err, collections.ErrNotFound) {
+				continue
+			} else if err != nil {
+				return types.MaturityCalendarEntry{}, err
+			}
+
+			for _, entry := range red.Entries {
+				if entry.CompletionTime.Equal(completionTime) {
+					amount = amount.Add(entry.InitialBalance...)
+				}
+			}
+		}
+
+		return types.MaturityCalendarEntry{CompletionTime: completionTime, Amount: amount}, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryRedelegationCalendarResponse{Entries: entries, Pagination: pageRes}, nil
+}
+
+// PendingCommissionChange queries the scheduled commission change of the given validator
+func (q Querier) PendingCommissionChange(ctx context.Context, req *types.QueryPendingCommissionChangeRequest) (*types.QueryPendingCommissionChangeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ValidatorAddr == "" {
+		return nil, status.Error(codes.InvalidArgument, "validator address cannot be empty")
+	}
+
+	valAddr, err := q.Keeper.validatorAddressCodec.StringToBytes(req.ValidatorAddr)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	change, err := q.Keeper.PendingCommissionChanges.Get(ctx, valAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "no pending commission change for validator %s", req.ValidatorAddr)
+	}
+
+	return &types.QueryPendingCommissionChangeResponse{PendingCommissionChange: change}, nil
+}
+
+// calendarFilters returns the canonical validator and delegator addresses of the
+// calendar filters, which are compared with the addresses stored in the queues.
+func (q Querier) calendarFilters(validatorAddr, delegatorAddr string) (valAddr, delAddr string, err error) {
+	if validatorAddr != "" {
+		bz, err := q.validatorAddressCodec.StringToBytes(validatorAddr)
+		if err != nil {
+			return "", "", err
+		}
+		if valAddr, err = q.validatorAddressCodec.BytesToString(bz); err != nil {
+			return "", "", err
+		}
+	}
+
+	if delegatorAddr != "" {
+		bz, err := q.authKeeper.AddressCodec().StringToBytes(delegatorAddr)
+		if err != nil {
+			return "", "", err
+		}
+		if delAddr, err = q.authKeeper.AddressCodec().BytesToString(bz); err != nil {
+			return "", "", err
+		}
+	}
+
+	return valAddr, delAddr, nil
+}
+
+// calendarQueue restricts the iterators of the queue to the inclusive range of
+// the completion time, so the pagination only walks the queue in the range.
+type calendarQueue[V any] struct {
+	collections.Map[time.Time, V]
+
+	start []byte
+	end   []byte
+}
+
+// newCalendarQueue returns the queue restricted to the inclusive range of the
+// completion time, where the zero time disables the bound.
+func newCalendarQueue[V any](queue collections.Map[time.Time, V], start, end time.Time) (calendarQueue[V], error) {
+	q := calendarQueue[V]{Map: queue}
+
+	var err error
+	if !start.IsZero() {
+		if q.start, err = collections.EncodeKeyWithPrefix(nil, queue.KeyCodec(), start); err != nil {
+			return q, err
+		}
+	}
+
+	// the time keys have the fixed length, so the end key followed by
+	// a zero byte is the exclusive end right after the end key
+	if !end.IsZero() {
+		if q.end, err = collections.EncodeKeyWithPrefix(nil, queue.KeyCodec(), end); err != nil {
+			return q, err
+		}
+		q.end = append(q.end, 0)
+	}
+
+	return q, nil
+}
+
+func (q calendarQueue[V]) IterateRaw(ctx context.Context, start, end []byte, order collections.Order) (collections.Iterator[time.Time, V], error) {
+	if q.start != nil && bytes.Compare(start, q.start) < 0 {
+		start = q.start
+	}
+
+	if q.end != nil && (end == nil || bytes.Compare(q.end, end) < 0) {
+		end = q.end
+	}
+
+	// an empty range; keep the bounds valid for the iterator
+	if end != nil && bytes.Compare(start, end) > 0 {
+		start = end
+	}
+
+	return q.Map.IterateRaw(ctx, start, end, order)
+}
+
 func queryRedelegation(ctx context.Context, q Querier, req *types.QueryRedelegationsRequest) (redels types.Redelegations, err error) {
 	delAddr, err := q.authKeeper.AddressCodec().StringToBytes(req.DelegatorAddr)
 	if err != nil {
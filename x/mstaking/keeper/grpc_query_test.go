@@ -2,11 +2,13 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/initia-labs/initia/x/mstaking/keeper"
 	"github.com/initia-labs/initia/x/mstaking/types"
@@ -454,3 +456,176 @@ func Test_grpcParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, _params, params.Params)
 }
+
+func Test_grpcVotingPowerWeights(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_ = createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+
+	querier := keeper.Querier{&input.StakingKeeper}
+	res, err := querier.VotingPowerWeights(ctx, &types.QueryVotingPowerWeightsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoin(bondDenom, math.OneInt())), res.VotingPowerWeights)
+
+	// the weights are recorded whenever the voting powers are updated
+	err = input.StakingKeeper.ApplyVotingPowerUpdates(ctx.WithBlockHeight(ctx.BlockHeight() + 1))
+	require.NoError(t, err)
+
+	historyRes, err := querier.VotingPowerWeightsHistory(ctx, &types.QueryVotingPowerWeightsHistoryRequest{})
+	require.NoError(t, err)
+	require.Len(t, historyRes.Histories, 2)
+	require.Equal(t, ctx.BlockHeight(), historyRes.Histories[0].Height)
+	require.Equal(t, ctx.BlockHeight()+1, historyRes.Histories[1].Height)
+	require.Equal(t, res.VotingPowerWeights, historyRes.Histories[1].VotingPowerWeights)
+}
+
+func Test_grpcUnbondingCalendar(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+	valAddrStr2, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr2)
+	require.NoError(t, err)
+
+	bondCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoins.Add(bondCoins...)...)
+	delAddrStr, err := input.AccountKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+
+	for _, valAddr := range []sdk.ValAddress{valAddr1, valAddr2} {
+		validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+		require.NoError(t, err)
+
+		_, err = input.StakingKeeper.Delegate(ctx, delAddr, bondCoins, types.Unbonded, validator, true)
+		require.NoError(t, err)
+	}
+
+	completionTime1, _, err := input.StakingKeeper.Undelegate(ctx, delAddr, valAddr1, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+	_, _, err = input.StakingKeeper.Undelegate(ctx, delAddr, valAddr2, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	completionTime2, _, err := input.StakingKeeper.Undelegate(ctx, delAddr, valAddr1, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 200_000)))
+	require.NoError(t, err)
+
+	querier := keeper.Querier{&input.StakingKeeper}
+
+	// query chain-wide calendar
+	res, err := querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 2)
+	require.True(t, completionTime1.Equal(res.Entries[0].CompletionTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), res.Entries[0].Amount)
+	require.True(t, completionTime2.Equal(res.Entries[1].CompletionTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 200_000)), res.Entries[1].Amount)
+
+	// query with validator
+	res, err = querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{ValidatorAddr: valAddrStr2})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500_000)), res.Entries[0].Amount)
+
+	// query with delegator and time range
+	res, err = querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{
+		StartTime:     completionTime1.Add(time.Second),
+		DelegatorAddr: delAddrStr,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.True(t, completionTime2.Equal(res.Entries[0].CompletionTime))
+
+	// query with inclusive time range of a single completion time
+	res, err = querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{
+		StartTime: completionTime2,
+		EndTime:   completionTime2,
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.True(t, completionTime2.Equal(res.Entries[0].CompletionTime))
+
+	// query with time range and pagination
+	res, err = querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{
+		EndTime:    completionTime1,
+		Pagination: &query.PageRequest{Limit: 1, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.True(t, completionTime1.Equal(res.Entries[0].CompletionTime))
+	require.Equal(t, uint64(1), res.Pagination.Total)
+	require.Nil(t, res.Pagination.NextKey)
+
+	// query with time range excluding all entries
+	res, err = querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{EndTime: completionTime1.Add(-time.Second)})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+
+	// query with invalid validator
+	_, err = querier.UnbondingCalendar(ctx, &types.QueryUnbondingCalendarRequest{ValidatorAddr: "invalid"})
+	require.Error(t, err)
+}
+
+func Test_grpcRedelegationCalendar(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 2_000_000, 2)
+	valAddrStr2, err := input.StakingKeeper.ValidatorAddressCodec().BytesToString(valAddr2)
+	require.NoError(t, err)
+
+	bondCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoins...)
+	delAddrStr, err := input.AccountKeeper.AddressCodec().BytesToString(delAddr)
+	require.NoError(t, err)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+
+	_, err = input.StakingKeeper.Delegate(ctx, delAddr, bondCoins, types.Unbonded, validator, true)
+	require.NoError(t, err)
+
+	completionTime, err := input.StakingKeeper.BeginRedelegation(ctx, delAddr, valAddr1, valAddr2, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000)))
+	require.NoError(t, err)
+
+	querier := keeper.Querier{&input.StakingKeeper}
+
+	// query with destination validator
+	res, err := querier.RedelegationCalendar(ctx, &types.QueryRedelegationCalendarRequest{ValidatorAddr: valAddrStr2})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+	require.True(t, completionTime.Equal(res.Entries[0].CompletionTime))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500_000)), res.Entries[0].Amount)
+
+	// query with delegator
+	res, err = querier.RedelegationCalendar(ctx, &types.QueryRedelegationCalendarRequest{DelegatorAddr: delAddrStr})
+	require.NoError(t, err)
+	require.Len(t, res.Entries, 1)
+
+	// query with other delegator
+	otherAddrStr, err := input.AccountKeeper.AddressCodec().BytesToString(valAddr1)
+	require.NoError(t, err)
+	res, err = querier.RedelegationCalendar(ctx, &types.QueryRedelegationCalendarRequest{DelegatorAddr: otherAddrStr})
+	require.NoError(t, err)
+	require.Empty(t, res.Entries)
+}
+
+func Test_grpcPendingCommissionChange(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	querier := keeper.Querier{Keeper: &input.StakingKeeper}
+
+	_, err := querier.PendingCommissionChange(ctx, &types.QueryPendingCommissionChangeRequest{ValidatorAddr: valAddr.String()})
+	require.Error(t, err)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 25))
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	change, err := input.StakingKeeper.ScheduleCommissionChange(ctx, validator, math.LegacyNewDecWithPrec(4, 1))
+	require.NoError(t, err)
+
+	res, err := querier.PendingCommissionChange(ctx, &types.QueryPendingCommissionChangeRequest{ValidatorAddr: valAddr.String()})
+	require.NoError(t, err)
+	require.Equal(t, change, res.PendingCommissionChange)
+	require.Equal(t, valAddr.String(), res.PendingCommissionChange.ValidatorAddress)
+}
@@ -4,9 +4,13 @@ import (
 	"testing"
 
 	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	cosmostypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
 )
 
 func Test_HistoricalInfo(t *testing.T) {
@@ -39,3 +43,78 @@ func Test_HistoricalInfo(t *testing.T) {
 		Valset: nil,
 	}, historicalInfo)
 }
+
+func Test_TrackVotingPowerWeights(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	params.HistoricalEntries = 2
+	input.StakingKeeper.SetParams(ctx, params)
+
+	weights1 := sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1), sdk.NewInt64DecCoin(testDenoms[0], 1))
+	weights2 := sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1), sdk.NewDecCoinFromDec(testDenoms[0], math.LegacyMustNewDecFromStr("1.05")))
+	weights3 := sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1), sdk.NewInt64DecCoin(testDenoms[0], 2))
+
+	require.NoError(t, input.StakingKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(1), weights1))
+
+	// a change below the threshold does not emit an event
+	ctx2 := ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.StakingKeeper.TrackVotingPowerWeights(ctx2, weights2))
+	require.Empty(t, ctx2.EventManager().Events())
+
+	// a change above the threshold emits an event per changed denom
+	ctx3 := ctx.WithBlockHeight(3).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.StakingKeeper.TrackVotingPowerWeights(ctx3, weights3))
+	require.Equal(t, sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeVotingPowerWeightChange,
+			sdk.NewAttribute(types.AttributeKeyDenom, testDenoms[0]),
+			sdk.NewAttribute(types.AttributeKeyOldWeight, weights2.AmountOf(testDenoms[0]).String()),
+			sdk.NewAttribute(types.AttributeKeyNewWeight, weights3.AmountOf(testDenoms[0]).String()),
+		),
+	}, ctx3.EventManager().Events())
+
+	_, err = input.StakingKeeper.HistoricalVotingPowerWeights.Get(ctx, 1)
+	require.ErrorIs(t, err, collections.ErrNotFound)
+
+	history, err := input.StakingKeeper.HistoricalVotingPowerWeights.Get(ctx, 2)
+	require.NoError(t, err)
+	require.Equal(t, weights2, history.VotingPowerWeights)
+
+	history, found, err := input.StakingKeeper.GetLastHistoricalVotingPowerWeights(ctx)
+	require.NoError(t, err)
+	require.True(t, found)
+	require.Equal(t, int64(3), history.Height)
+	require.Equal(t, weights3, history.VotingPowerWeights)
+}
+
+func Test_TrackVotingPowerWeights_WithoutHistory(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	params.HistoricalEntries = 0
+	input.StakingKeeper.SetParams(ctx, params)
+
+	weights1 := sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1), sdk.NewInt64DecCoin(testDenoms[0], 1))
+	weights2 := sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1), sdk.NewInt64DecCoin(testDenoms[0], 2))
+
+	require.NoError(t, input.StakingKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(1), weights1))
+
+	// the change is compared with the last weights, which are kept without the history
+	ctx2 := ctx.WithBlockHeight(2).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, input.StakingKeeper.TrackVotingPowerWeights(ctx2, weights2))
+	require.Len(t, ctx2.EventManager().Events(), 1)
+
+	_, found, err := input.StakingKeeper.GetLastHistoricalVotingPowerWeights(ctx)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	last, err := input.StakingKeeper.LastVotingPowerWeights.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), last.Height)
+	require.Equal(t, weights2, last.VotingPowerWeights)
+}
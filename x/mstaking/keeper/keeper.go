@@ -32,6 +32,7 @@ type Keeper struct {
 	authKeeper        types.AccountKeeper
 	bankKeeper        types.BankKeeper
 	VotingPowerKeeper types.VotingPowerKeeper
+	DexKeeper         types.DexKeeper
 	hooks             types.StakingHooks
 	slashingHooks     types.SlashingHooks
 
@@ -64,7 +65,16 @@ type Keeper struct {
 	RedelegationQueue collections.Map[time.Time, types.DVVTriplets]
 	ValidatorQueue    collections.Map[time.Time, types.ValAddresses]
 
-	HistoricalInfos collections.Map[int64, cosmostypes.HistoricalInfo]
+	HistoricalInfos              collections.Map[int64, cosmostypes.HistoricalInfo]
+	HistoricalVotingPowerWeights collections.Map[int64, types.HistoricalVotingPowerWeights]
+	LastVotingPowerWeights       collections.Item[types.HistoricalVotingPowerWeights]
+
+	RotatedConsAddrs            collections.Map[[]byte, []byte]                                                   // oldConsAddr => valAddr
+	ConsPubKeyRotationHistories collections.Map[collections.Pair[[]byte, int64], types.ConsPubKeyRotationHistory] // valAddr, height
+	ConsPubKeyRotationsByHeight collections.Map[collections.Pair[int64, []byte], bool]                            // height, valAddr
+
+	PendingCommissionChanges       collections.Map[[]byte, types.PendingCommissionChange]     // valAddr => pending change
+	PendingCommissionChangesByTime collections.Map[collections.Pair[time.Time, []byte], bool] // effectiveTime, valAddr
 
 	Params collections.Item[types.Params]
 }
@@ -76,6 +86,7 @@ func NewKeeper(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
 	vk types.VotingPowerKeeper,
+	dk types.DexKeeper,
 	authority string,
 	validatorAddressCodec addresscodec.Codec,
 	consensusAddressCodec addresscodec.Codec,
@@ -102,6 +113,7 @@ func NewKeeper(
 		authKeeper:        ak,
 		bankKeeper:        bk,
 		VotingPowerKeeper: vk,
+		DexKeeper:         dk,
 		hooks:             nil,
 		slashingHooks:     nil,
 
@@ -132,7 +144,16 @@ func NewKeeper(
 		RedelegationQueue: collections.NewMap(sb, types.RedelegationQueuePrefix, "redelegation_queue", sdk.TimeKey, codec.CollValue[types.DVVTriplets](cdc)),
 		ValidatorQueue:    collections.NewMap(sb, types.ValidatorQueuePrefix, "validator_queue", sdk.TimeKey, codec.CollValue[types.ValAddresses](cdc)),
 
-		HistoricalInfos: collections.NewMap(sb, types.HistoricalInfosPrefix, "historical_infos", collections.Int64Key, codec.CollValue[cosmostypes.HistoricalInfo](cdc)),
+		HistoricalInfos:              collections.NewMap(sb, types.HistoricalInfosPrefix, "historical_infos", collections.Int64Key, codec.CollValue[cosmostypes.HistoricalInfo](cdc)),
+		HistoricalVotingPowerWeights: collections.NewMap(sb, types.HistoricalVotingPowerWeightsPrefix, "historical_voting_power_weights", collections.Int64Key, codec.CollValue[types.HistoricalVotingPowerWeights](cdc)),
+		LastVotingPowerWeights:       collections.NewItem(sb, types.LastVotingPowerWeightsKey, "last_voting_power_weights", codec.CollValue[types.HistoricalVotingPowerWeights](cdc)),
+
+		RotatedConsAddrs:            collections.NewMap(sb, types.RotatedConsAddrsPrefix, "rotated_cons_addrs", collections.BytesKey, collections.BytesValue),
+		ConsPubKeyRotationHistories: collections.NewMap(sb, types.ConsPubKeyRotationHistoryPrefix, "cons_pubkey_rotation_histories", collections.PairKeyCodec(collections.BytesKey, collections.Int64Key), codec.CollValue[types.ConsPubKeyRotationHistory](cdc)),
+		ConsPubKeyRotationsByHeight: collections.NewMap(sb, types.ConsPubKeyRotationsByHeightPrefix, "cons_pubkey_rotations_by_height", collections.PairKeyCodec(collections.Int64Key, collections.BytesKey), collections.BoolValue),
+
+		PendingCommissionChanges:       collections.NewMap(sb, types.PendingCommissionChangesPrefix, "pending_commission_changes", collections.BytesKey, codec.CollValue[types.PendingCommissionChange](cdc)),
+		PendingCommissionChangesByTime: collections.NewMap(sb, types.PendingCommissionChangesByTimePrefix, "pending_commission_changes_by_time", collections.PairKeyCodec(sdk.TimeKey, collections.BytesKey), collections.BoolValue),
 
 		Params: collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
 	}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper *Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper *Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 sets the default voting power weight change threshold and the
+// default key rotation fee to the params stored before they are introduced,
+// and keeps the latest historical voting power weights as the last weights.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	updated := false
+	if params.VotingPowerWeightChangeThreshold.IsNil() {
+		params.VotingPowerWeightChangeThreshold = types.DefaultVotingPowerWeightChangeThreshold
+		updated = true
+	}
+
+	// the default fee is charged in the chain's bond denom, not in the sdk default
+	if params.KeyRotationFee.Empty() && len(params.BondDenoms) > 0 {
+		params.KeyRotationFee = sdk.NewCoins(sdk.NewInt64Coin(params.BondDenoms[0], types.DefaultKeyRotationFeeAmount))
+		updated = true
+	}
+
+	if updated {
+		if err := m.keeper.SetParams(ctx, params); err != nil {
+			return err
+		}
+	}
+
+	last, found, err := m.keeper.GetLastHistoricalVotingPowerWeights(ctx)
+	if err != nil || !found {
+		return err
+	}
+
+	return m.keeper.LastVotingPowerWeights.Set(ctx, last)
+}
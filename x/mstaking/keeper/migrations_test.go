@@ -0,0 +1,68 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	"cosmossdk.io/store/rootmulti"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/keeper"
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+func Test_Migrate1to2(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.HistoricalEntries = 1
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	weights := sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 1))
+	require.NoError(t, input.StakingKeeper.TrackVotingPowerWeights(ctx.WithBlockHeight(1), weights))
+	require.NoError(t, input.StakingKeeper.LastVotingPowerWeights.Remove(ctx))
+
+	// drop the threshold and the key rotation fee from the stored params, as
+	// stored before they are introduced
+	storeKey := input.MultiStore.(*rootmulti.Store).StoreKeysByName()[types.StoreKey]
+	store := ctx.KVStore(storeKey)
+
+	bz := store.Get(types.ParamsKey)
+	var stripped []byte
+	for len(bz) > 0 {
+		num, typ, n := protowire.ConsumeTag(bz)
+		require.GreaterOrEqual(t, n, 0)
+		m := protowire.ConsumeFieldValue(num, typ, bz[n:])
+		require.GreaterOrEqual(t, m, 0)
+
+		if num != 8 && num != 9 {
+			stripped = append(stripped, bz[:n+m]...)
+		}
+		bz = bz[n+m:]
+	}
+	store.Set(types.ParamsKey, stripped)
+
+	params, err = input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.True(t, params.VotingPowerWeightChangeThreshold.IsNil())
+	require.True(t, params.KeyRotationFee.Empty())
+
+	m := keeper.NewMigrator(&input.StakingKeeper)
+	require.NoError(t, m.Migrate1to2(ctx))
+
+	params, err = input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultVotingPowerWeightChangeThreshold, params.VotingPowerWeightChangeThreshold)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, types.DefaultKeyRotationFeeAmount)), params.KeyRotationFee)
+	require.NoError(t, params.Validate())
+
+	// the latest historical weights are kept as the last weights
+	last, err := input.StakingKeeper.LastVotingPowerWeights.Get(ctx)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), last.Height)
+	require.Equal(t, weights, last.VotingPowerWeights)
+}
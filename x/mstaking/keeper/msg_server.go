@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"errors"
+	"slices"
 	"strconv"
 	"time"
 
@@ -172,6 +173,20 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 	validator.Description = description
 
 	if msg.CommissionRate != nil {
+		// a commission rate cannot be edited while a scheduled change is pending
+		if found, err := k.HasPendingCommissionChange(ctx, valAddr); err != nil {
+			return nil, err
+		} else if found {
+			return nil, types.ErrPendingCommissionChange
+		}
+
+		// a commission increase must be announced through a scheduled change
+		if noticePeriod, err := k.CommissionChangeNoticePeriod(ctx); err != nil {
+			return nil, err
+		} else if noticePeriod > 0 && msg.CommissionRate.GT(validator.Commission.Rate) {
+			return nil, types.ErrCommissionIncreaseNotScheduled
+		}
+
 		commission, err := k.UpdateValidatorCommission(ctx, validator, *msg.CommissionRate)
 		if err != nil {
 			return nil, err
@@ -200,6 +215,67 @@ func (k msgServer) EditValidator(ctx context.Context, msg *types.MsgEditValidato
 	return &types.MsgEditValidatorResponse{}, nil
 }
 
+// ScheduleCommissionChange defines a method for scheduling a commission rate change of a validator
+func (k msgServer) ScheduleCommissionChange(ctx context.Context, msg *types.MsgScheduleCommissionChange) (*types.MsgScheduleCommissionChangeResponse, error) {
+	if err := msg.Validate(k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	// validator must already be registered
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	change, err := k.Keeper.ScheduleCommissionChange(ctx, validator, msg.CommissionRate)
+	if err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeScheduleCommissionChange,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyCommissionRate, change.Rate.String()),
+			sdk.NewAttribute(types.AttributeKeyEffectiveTime, change.EffectiveTime.Format(time.RFC3339)),
+		),
+	})
+
+	return &types.MsgScheduleCommissionChangeResponse{EffectiveTime: change.EffectiveTime}, nil
+}
+
+// RetireValidator defines a method for voluntarily retiring a validator
+func (k msgServer) RetireValidator(ctx context.Context, msg *types.MsgRetireValidator) (*types.MsgRetireValidatorResponse, error) {
+	if err := msg.Validate(k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	if err := k.Keeper.RetireValidator(ctx, valAddr); err != nil {
+		return nil, err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRetireValidator,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+		),
+	})
+
+	return &types.MsgRetireValidatorResponse{}, nil
+}
+
 // Delegate defines a method for performing a delegation of coins from a delegator to a validator
 func (k msgServer) Delegate(ctx context.Context, msg *types.MsgDelegate) (*types.MsgDelegateResponse, error) {
 	if err := msg.Validate(k.authKeeper.AddressCodec(), k.validatorAddressCodec); err != nil {
@@ -539,3 +615,107 @@ func (ms msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+// RotateConsPubKey defines a method for rotating the consensus pubkey of a validator
+func (k msgServer) RotateConsPubKey(ctx context.Context, msg *types.MsgRotateConsPubKey) (*types.MsgRotateConsPubKeyResponse, error) {
+	if err := msg.Validate(k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	// validator must already be registered
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	pk, ok := msg.NewPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidType, "Expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cp := sdkCtx.ConsensusParams()
+	if cp.Validator != nil {
+		if !tmstrings.StringInSlice(pk.Type(), cp.Validator.PubKeyTypes) {
+			return nil, errorsmod.Wrapf(
+				types.ErrValidatorPubKeyTypeNotSupported,
+				"got: %s, expected: %s", pk.Type(), cp.Validator.PubKeyTypes,
+			)
+		}
+	}
+
+	oldConsAddr, err := validator.GetConsAddr()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.RotateConsPubKey(ctx, validator, pk); err != nil {
+		return nil, err
+	}
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRotateConsPubKey,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyOldConsAddress, oldConsAddr.String()),
+			sdk.NewAttribute(types.AttributeKeyNewConsAddress, sdk.ConsAddress(pk.Address()).String()),
+		),
+	})
+
+	return &types.MsgRotateConsPubKeyResponse{}, nil
+}
+
+// ConvertDelegation defines a method for converting a delegation of a bond denom
+// to another bond denom at the same validator through the dex.
+func (k msgServer) ConvertDelegation(ctx context.Context, msg *types.MsgConvertDelegation) (*types.MsgConvertDelegationResponse, error) {
+	if err := msg.Validate(k.authKeeper.AddressCodec(), k.validatorAddressCodec); err != nil {
+		return nil, err
+	}
+
+	valAddr, err := k.validatorAddressCodec.StringToBytes(msg.ValidatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid validator address: %s", err)
+	}
+
+	delegatorAddress, err := k.authKeeper.AddressCodec().StringToBytes(msg.DelegatorAddress)
+	if err != nil {
+		return nil, sdkerrors.ErrInvalidAddress.Wrapf("invalid delegator address: %s", err)
+	}
+
+	bondDenoms, err := k.BondDenoms(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !types.IsAllBondDenoms(sdk.NewCoins(msg.Amount), bondDenoms) || !slices.Contains(bondDenoms, msg.TargetDenom) {
+		return nil, errorsmod.Wrapf(
+			sdkerrors.ErrInvalidRequest, "invalid coin denomination: got %s and %s, expected one of %s", msg.Amount.Denom, msg.TargetDenom, bondDenoms,
+		)
+	}
+
+	converted, err := k.Keeper.ConvertDelegation(ctx, delegatorAddress, valAddr, msg.Amount, msg.TargetDenom, msg.MinTargetAmount)
+	if err != nil {
+		return nil, err
+	}
+
+	defer func() {
+		telemetry.IncrCounter(1, types.ModuleName, "convert_delegation")
+	}()
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeConvertDelegation,
+			sdk.NewAttribute(types.AttributeKeyValidator, msg.ValidatorAddress),
+			sdk.NewAttribute(types.AttributeKeyDelegator, msg.DelegatorAddress),
+			sdk.NewAttribute(sdk.AttributeKeyAmount, msg.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyTargetAmount, converted.String()),
+		),
+	})
+
+	return &types.MsgConvertDelegationResponse{Amount: converted}, nil
+}
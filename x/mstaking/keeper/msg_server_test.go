@@ -1,10 +1,18 @@
 package keeper_test
 
 import (
+	"context"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
 	"github.com/initia-labs/initia/x/mstaking/keeper"
 	"github.com/initia-labs/initia/x/mstaking/types"
 )
@@ -27,3 +35,376 @@ func Test_UpdateParams(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, params, paramsAfter)
 }
+
+func Test_RotateConsPubKey(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+
+	oldConsAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	oldCmtPubKey, err := validator.CmtConsPublicKey()
+	require.NoError(t, err)
+
+	fee, err := input.StakingKeeper.KeyRotationFee(ctx)
+	require.NoError(t, err)
+	balanceBefore := input.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddr))
+
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	msg, err := types.NewMsgRotateConsPubKey(valAddr.String(), newPubKey)
+	require.NoError(t, err)
+
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// fee charged to the operator
+	balanceAfter := input.BankKeeper.GetAllBalances(ctx, sdk.AccAddress(valAddr))
+	require.Equal(t, balanceBefore.Sub(fee...), balanceAfter)
+
+	// both of the old and the new consensus address resolve to the validator
+	newConsAddr := sdk.ConsAddress(newPubKey.Address())
+	validator, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, newConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator.GetOperator())
+
+	validator, err = input.StakingKeeper.GetValidatorByConsAddr(ctx, oldConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, valAddr.String(), validator.GetOperator())
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	require.Equal(t, newConsAddr, consAddr)
+
+	// the old pubkey is replaced with the new one
+	updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 2)
+	require.Equal(t, oldCmtPubKey, updates[0].PubKey)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	newCmtPubKey, err := validator.CmtConsPublicKey()
+	require.NoError(t, err)
+	require.Equal(t, newCmtPubKey, updates[1].PubKey)
+	require.Equal(t, int64(1), updates[1].Power)
+
+	histories, err := input.StakingKeeper.GetConsPubKeyRotationHistories(ctx, valAddr)
+	require.NoError(t, err)
+	require.Len(t, histories, 1)
+	require.Equal(t, fee, histories[0].Fee)
+
+	// rate limited until the unbonding period passes
+	msg, err = types.NewMsgRotateConsPubKey(valAddr.String(), ed25519.GenPrivKey().PubKey())
+	require.NoError(t, err)
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrConsPubKeyRotationRateLimited)
+
+	unbondingTime, err := input.StakingKeeper.UnbondingTime(ctx)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.NoError(t, err)
+
+	// the rotated out pubkey cannot be used again
+	msg, err = types.NewMsgRotateConsPubKey(valAddr.String(), newPubKey)
+	require.NoError(t, err)
+	ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(ctx.BlockTime().Add(unbondingTime))
+	_, err = ms.RotateConsPubKey(ctx, msg)
+	require.ErrorIs(t, err, types.ErrConsPubKeyAlreadyUsed)
+}
+
+type dexKeeper struct {
+	input TestKeepers
+	rate  math.LegacyDec
+}
+
+func (k dexKeeper) ConvertCoin(ctx context.Context, addr sdk.AccAddress, offerCoin sdk.Coin, targetDenom string) (sdk.Coin, error) {
+	if err := k.input.BankKeeper.SendCoinsFromAccountToModule(ctx, addr, authtypes.FeeCollectorName, sdk.NewCoins(offerCoin)); err != nil {
+		return sdk.Coin{}, err
+	}
+
+	converted := sdk.NewCoin(targetDenom, k.rate.MulInt(offerCoin.Amount).TruncateInt())
+	k.input.Faucet.Fund(sdk.UnwrapSDKContext(ctx), addr, converted)
+
+	return converted, nil
+}
+
+func Test_ConvertDelegation(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	input.StakingKeeper.DexKeeper = dexKeeper{input: input, rate: math.LegacyNewDecWithPrec(5, 1)}
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	testDenom := testDenoms[0]
+	params.BondDenoms = append(params.BondDenoms, testDenom)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 2)
+
+	delAddr := input.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin(bondDenom, 2_000_000))
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	_, err = ms.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddr1.String(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+	require.NoError(t, err)
+
+	// slippage protection; the failed tx is reverted
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = ms.ConvertDelegation(cacheCtx, types.NewMsgConvertDelegation(
+		delAddr.String(), valAddr1.String(), sdk.NewInt64Coin(bondDenom, 500_000), testDenom, math.NewInt(250_001),
+	))
+	require.ErrorIs(t, err, types.ErrConversionSlippage)
+
+	res, err := ms.ConvertDelegation(ctx, types.NewMsgConvertDelegation(
+		delAddr.String(), valAddr1.String(), sdk.NewInt64Coin(bondDenom, 500_000), testDenom, math.NewInt(250_000),
+	))
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewInt64Coin(testDenom, 250_000), res.Amount)
+
+	// the converted coin is delegated to the same validator
+	delegation, err := input.StakingKeeper.GetDelegation(ctx, delAddr, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewInt64DecCoin(bondDenom, 500_000), sdk.NewInt64DecCoin(testDenom, 250_000)), delegation.Shares)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_500_000), sdk.NewInt64Coin(testDenom, 250_000)), validator.Tokens)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000)), input.BankKeeper.GetAllBalances(ctx, delAddr))
+
+	// the delegation received by a redelegation cannot be converted
+	_, err = ms.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddr2.String(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+	require.NoError(t, err)
+	_, err = ms.BeginRedelegate(ctx, types.NewMsgBeginRedelegate(delAddr.String(), valAddr2.String(), valAddr1.String(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 500_000))))
+	require.NoError(t, err)
+
+	_, err = ms.ConvertDelegation(ctx, types.NewMsgConvertDelegation(
+		delAddr.String(), valAddr1.String(), sdk.NewInt64Coin(bondDenom, 500_000), testDenom, math.ZeroInt(),
+	))
+	require.ErrorIs(t, err, types.ErrTransitiveConversion)
+}
+
+func Test_ConvertDelegation_SlashBeforeConversion(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	input.StakingKeeper.DexKeeper = dexKeeper{input: input, rate: math.LegacyNewDecWithPrec(5, 1)}
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	testDenom := testDenoms[0]
+	params.BondDenoms = append(params.BondDenoms, testDenom)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+
+	delAddr := input.Faucet.NewFundedAccount(ctx, sdk.NewInt64Coin(bondDenom, 1_000_000))
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	_, err = ms.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddr.String(), sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))))
+	require.NoError(t, err)
+
+	// the infraction is committed before the conversion
+	infractionHeight := ctx.BlockHeight()
+	ctx = ctx.WithBlockHeight(infractionHeight + 1)
+
+	_, err = ms.ConvertDelegation(ctx, types.NewMsgConvertDelegation(
+		delAddr.String(), valAddr.String(), sdk.NewInt64Coin(bondDenom, 500_000), testDenom, math.ZeroInt(),
+	))
+	require.NoError(t, err)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_500_000), sdk.NewInt64Coin(testDenom, 250_000)), validator.Tokens)
+
+	consAddr, err := validator.GetConsAddr()
+	require.NoError(t, err)
+	_, err = input.StakingKeeper.Slash(ctx, consAddr, infractionHeight, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, err)
+
+	// the converted stake is slashed as well
+	validator, err = input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_350_000), sdk.NewInt64Coin(testDenom, 225_000)), validator.Tokens)
+
+	delegation, err := input.StakingKeeper.GetDelegation(ctx, delAddr, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.NewInt(225_000), validator.TokensFromShares(delegation.Shares).AmountOf(testDenom).TruncateInt())
+}
+
+func Test_ConvertDelegation_VestingAccount(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	input.StakingKeeper.DexKeeper = dexKeeper{input: input, rate: math.LegacyNewDecWithPrec(5, 1)}
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+
+	testDenom := testDenoms[0]
+	params.BondDenoms = append(params.BondDenoms, testDenom)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+
+	// the app does not register the vesting accounts, but a chain can
+	vestingtypes.RegisterInterfaces(input.EncodingConfig.InterfaceRegistry)
+
+	vestingCoins := sdk.NewCoins(sdk.NewInt64Coin(bondDenom, 1_000_000))
+	delAddr := input.Faucet.NewFundedAccount(ctx, vestingCoins...)
+	baseAccount := input.AccountKeeper.GetAccount(ctx, delAddr).(*authtypes.BaseAccount)
+	vestingAccount, err := vestingtypes.NewDelayedVestingAccount(baseAccount, vestingCoins, ctx.BlockTime().Add(time.Hour).Unix())
+	require.NoError(t, err)
+	input.AccountKeeper.SetAccount(ctx, vestingAccount)
+
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	_, err = ms.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddr.String(), vestingCoins))
+	require.NoError(t, err)
+
+	_, err = ms.ConvertDelegation(ctx, types.NewMsgConvertDelegation(
+		delAddr.String(), valAddr.String(), sdk.NewInt64Coin(bondDenom, 500_000), testDenom, math.ZeroInt(),
+	))
+	require.ErrorIs(t, err, types.ErrVestingAccountConversion)
+}
+
+func Test_ScheduleCommissionChange(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.CommissionChangeNoticePeriod = time.Hour * 24 * 7
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	validator.Commission.Rate = math.LegacyNewDecWithPrec(1, 1)
+	validator.Commission.MaxChangeRate = math.LegacyNewDecWithPrec(5, 1)
+	require.NoError(t, input.StakingKeeper.SetValidator(ctx, validator))
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 25))
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+
+	// commission increase must be scheduled
+	newRate := math.LegacyNewDecWithPrec(2, 1)
+	_, err = ms.EditValidator(ctx, types.NewMsgEditValidator(valAddr.String(), types.Description{Moniker: types.DoNotModifyDesc}, &newRate))
+	require.ErrorIs(t, err, types.ErrCommissionIncreaseNotScheduled)
+
+	res, err := ms.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(valAddr.String(), newRate))
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(params.CommissionChangeNoticePeriod), res.EffectiveTime)
+
+	// commission cannot be edited while the change is pending
+	lowerRate := math.LegacyNewDecWithPrec(5, 2)
+	_, err = ms.EditValidator(ctx, types.NewMsgEditValidator(valAddr.String(), types.Description{Moniker: types.DoNotModifyDesc}, &lowerRate))
+	require.ErrorIs(t, err, types.ErrPendingCommissionChange)
+
+	// not yet effective
+	ctx = ctx.WithBlockTime(res.EffectiveTime.Add(-time.Second))
+	require.NoError(t, input.StakingKeeper.ApplyMatureCommissionChanges(ctx))
+	validator, err = input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(1, 1), validator.Commission.Rate)
+
+	ctx = ctx.WithBlockTime(res.EffectiveTime)
+	require.NoError(t, input.StakingKeeper.ApplyMatureCommissionChanges(ctx))
+	validator, err = input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, newRate, validator.Commission.Rate)
+	require.Equal(t, res.EffectiveTime, validator.Commission.UpdateTime)
+
+	found, err := input.StakingKeeper.HasPendingCommissionChange(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func Test_ScheduleCommissionChange_BelowMinCommission(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour * 25))
+
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	newRate := math.LegacyNewDecWithPrec(4, 1)
+	_, err := ms.ScheduleCommissionChange(ctx, types.NewMsgScheduleCommissionChange(valAddr.String(), newRate))
+	require.NoError(t, err)
+
+	// raise the min commission rate before the change is applied
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MinCommissionRate = math.LegacyNewDecWithPrec(45, 2)
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	require.NoError(t, input.StakingKeeper.ApplyMatureCommissionChanges(ctx))
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, math.LegacyNewDecWithPrec(5, 1), validator.Commission.Rate)
+
+	found, err := input.StakingKeeper.HasPendingCommissionChange(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, found)
+}
+
+func Test_RetireValidator(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	valAddr1 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 1)
+	valAddr2 := createValidatorWithBalance(ctx, input, 100_000_000, 1_000_000, 2)
+
+	bondCoins := sdk.NewCoins(sdk.NewCoin(bondDenom, math.NewInt(1_000_000)))
+	delAddr := input.Faucet.NewFundedAccount(ctx, bondCoins...)
+
+	ms := keeper.NewMsgServerImpl(input.StakingKeeper)
+	_, err := ms.Delegate(ctx, types.NewMsgDelegate(delAddr.String(), valAddr1.String(), bondCoins))
+	require.NoError(t, err)
+
+	_, err = input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+
+	validator, err := input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, validator.IsBonded())
+	tokensBefore := validator.Tokens
+
+	_, err = ms.RetireValidator(ctx, types.NewMsgRetireValidator(valAddr1.String()))
+	require.NoError(t, err)
+
+	// cannot retire twice
+	_, err = ms.RetireValidator(ctx, types.NewMsgRetireValidator(valAddr1.String()))
+	require.ErrorIs(t, err, types.ErrValidatorRetired)
+
+	// leaves the active set at the end block without slashing
+	updates, err := input.StakingKeeper.ApplyAndReturnValidatorSetUpdates(ctx)
+	require.NoError(t, err)
+	require.Len(t, updates, 1)
+	require.Equal(t, int64(0), updates[0].Power)
+
+	validator, err = input.StakingKeeper.Validators.Get(ctx, valAddr1)
+	require.NoError(t, err)
+	require.True(t, validator.IsRetired())
+	require.True(t, validator.IsUnbonding())
+	require.False(t, validator.IsJailed())
+	require.Equal(t, tokensBefore, validator.Tokens)
+
+	// no new delegations
+	newDelAddr := input.Faucet.NewFundedAccount(ctx, bondCoins...)
+	_, err = ms.Delegate(ctx, types.NewMsgDelegate(newDelAddr.String(), valAddr1.String(), bondCoins))
+	require.ErrorIs(t, err, types.ErrValidatorRetired)
+
+	// redelegations from the retired validator are not limited by the max entries
+	params, err := input.StakingKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	params.MaxEntries = 1
+	require.NoError(t, input.StakingKeeper.SetParams(ctx, params))
+
+	redelegateCoin := sdk.NewCoin(bondDenom, math.NewInt(100_000))
+	for i := 0; i < 2; i++ {
+		_, err = ms.BeginRedelegate(ctx, types.NewMsgBeginRedelegate(delAddr.String(), valAddr1.String(), valAddr2.String(), sdk.NewCoins(redelegateCoin)))
+		require.NoError(t, err)
+	}
+
+	redelegation, err := input.StakingKeeper.GetRedelegation(ctx, delAddr, valAddr1, valAddr2)
+	require.NoError(t, err)
+	require.Len(t, redelegation.Entries, 2)
+
+	// the retired validator cannot receive redelegations
+	_, err = ms.BeginRedelegate(ctx, types.NewMsgBeginRedelegate(sdk.AccAddress(valAddr2).String(), valAddr2.String(), valAddr1.String(), sdk.NewCoins(redelegateCoin)))
+	require.ErrorIs(t, err, types.ErrValidatorRetired)
+}
@@ -97,6 +97,36 @@ func (k Keeper) MinCommissionRate(ctx context.Context) (math.LegacyDec, error) {
 	return params.MinCommissionRate, nil
 }
 
+// KeyRotationFee - Fee of a consensus pubkey rotation
+func (k Keeper) KeyRotationFee(ctx context.Context) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return params.KeyRotationFee, nil
+}
+
+// VotingPowerWeightChangeThreshold - Relative weight change to emit a voting power weight change event
+func (k Keeper) VotingPowerWeightChangeThreshold(ctx context.Context) (math.LegacyDec, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return math.LegacyZeroDec(), err
+	}
+
+	return params.VotingPowerWeightChangeThreshold, nil
+}
+
+// CommissionChangeNoticePeriod - Notice period before a scheduled commission change takes effect
+func (k Keeper) CommissionChangeNoticePeriod(ctx context.Context) (time.Duration, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	return params.CommissionChangeNoticePeriod, nil
+}
+
 // SetParams sets the x/staking module parameters.
 func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
 	return k.Params.Set(ctx, params)
@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// RetireValidator voluntarily retires a validator. The validator is removed from
+// the power update whitelist, so it leaves the active set at the next end block
+// and starts unbonding without being slashed or jailed.
+//
+// The retirement cannot be reverted. A retired validator does not receive new
+// delegations, and its delegators can redelegate without the max entries limit.
+func (k Keeper) RetireValidator(ctx context.Context, valAddr sdk.ValAddress) error {
+	validator, err := k.Validators.Get(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+
+	if validator.IsRetired() {
+		return types.ErrValidatorRetired
+	}
+
+	validator.Retired = true
+	if err := k.RemoveWhitelistValidator(ctx, validator); err != nil {
+		return err
+	}
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+
+	// the scheduled commission change has no effect on a retired validator
+	return k.RemovePendingCommissionChange(ctx, valAddr)
+}
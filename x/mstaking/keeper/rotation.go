@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
+)
+
+// RotateConsPubKey replaces the consensus pubkey of the validator with the new one.
+// The rotation fee is transferred from the operator to the distribution module, and
+// the old consensus address keeps resolving to the validator.
+func (k Keeper) RotateConsPubKey(ctx context.Context, validator types.Validator, newPubKey cryptotypes.PubKey) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(validator.GetOperator())
+	if err != nil {
+		return err
+	}
+
+	oldPubKey, err := validator.ConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	// the new consensus address must be neither used nor rotated out by any validator
+	newConsAddr := sdk.GetConsAddress(newPubKey)
+	if _, err := k.GetValidatorByConsAddr(ctx, newConsAddr); err == nil {
+		return types.ErrConsPubKeyAlreadyUsed
+	} else if !errors.Is(err, collections.ErrNotFound) {
+		return err
+	}
+	if found, err := k.RotatedConsAddrs.Has(ctx, newConsAddr); err != nil {
+		return err
+	} else if found {
+		return types.ErrConsPubKeyAlreadyUsed
+	}
+
+	// a validator can rotate its consensus pubkey once per unbonding period
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	lastRotation, found, err := k.GetLastConsPubKeyRotation(ctx, valAddr)
+	if err != nil {
+		return err
+	}
+	if found {
+		unbondingTime, err := k.UnbondingTime(ctx)
+		if err != nil {
+			return err
+		}
+
+		if lastRotation.Height == sdkCtx.BlockHeight() || sdkCtx.BlockTime().Before(lastRotation.Time.Add(unbondingTime)) {
+			return types.ErrConsPubKeyRotationRateLimited.Wrapf("last rotation at %s", lastRotation.Time)
+		}
+	}
+
+	fee, err := k.KeyRotationFee(ctx)
+	if err != nil {
+		return err
+	}
+	if !fee.IsZero() {
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sdk.AccAddress(valAddr), distrtypes.ModuleName, fee); err != nil {
+			return err
+		}
+	}
+
+	history, err := types.NewConsPubKeyRotationHistory(
+		validator.GetOperator(), oldPubKey, newPubKey,
+		sdkCtx.BlockHeight(), sdkCtx.BlockTime(), fee,
+	)
+	if err != nil {
+		return err
+	}
+
+	if err := k.SetConsPubKeyRotationHistory(ctx, history); err != nil {
+		return err
+	}
+
+	// replace the consensus address index of the validator
+	oldConsAddr := sdk.GetConsAddress(oldPubKey)
+	if err := k.ValidatorsByConsAddr.Remove(ctx, oldConsAddr); err != nil {
+		return err
+	}
+
+	validator.ConsensusPubkey = history.NewConsPubkey
+	if err := k.SetValidator(ctx, validator); err != nil {
+		return err
+	}
+	if err := k.SetValidatorByConsAddr(ctx, validator); err != nil {
+		return err
+	}
+
+	// the consensus pubkey is replaced in the validator set updates at the end block
+	if err := k.ConsPubKeyRotationsByHeight.Set(ctx, collections.Join(sdkCtx.BlockHeight(), []byte(valAddr)), true); err != nil {
+		return err
+	}
+
+	return k.Hooks().AfterConsensusPubKeyUpdate(ctx, valAddr, oldPubKey, newPubKey, fee)
+}
+
+// SetConsPubKeyRotationHistory stores the rotation history and maps the rotated out
+// consensus address to the validator operator.
+func (k Keeper) SetConsPubKeyRotationHistory(ctx context.Context, history types.ConsPubKeyRotationHistory) error {
+	valAddr, err := k.validatorAddressCodec.StringToBytes(history.OperatorAddress)
+	if err != nil {
+		return err
+	}
+
+	oldPubKey, err := history.OldConsPubKey()
+	if err != nil {
+		return err
+	}
+
+	if err := k.RotatedConsAddrs.Set(ctx, sdk.GetConsAddress(oldPubKey), valAddr); err != nil {
+		return err
+	}
+
+	return k.ConsPubKeyRotationHistories.Set(ctx, collections.Join(valAddr, history.Height), history)
+}
+
+// GetLastConsPubKeyRotation returns the latest consensus pubkey rotation of the validator.
+func (k Keeper) GetLastConsPubKeyRotation(ctx context.Context, valAddr sdk.ValAddress) (history types.ConsPubKeyRotationHistory, found bool, err error) {
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr).Descending(), func(_ collections.Pair[[]byte, int64], value types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		history, found = value, true
+		return true, nil
+	})
+
+	return
+}
+
+// GetConsPubKeyRotationHistories returns the consensus pubkey rotations of the validator.
+func (k Keeper) GetConsPubKeyRotationHistories(ctx context.Context, valAddr sdk.ValAddress) (histories []types.ConsPubKeyRotationHistory, err error) {
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr), func(_ collections.Pair[[]byte, int64], value types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		histories = append(histories, value)
+		return false, nil
+	})
+
+	return
+}
+
+// GetAllConsPubKeyRotationHistories returns the consensus pubkey rotations of all validators, used during genesis dump.
+func (k Keeper) GetAllConsPubKeyRotationHistories(ctx context.Context) (histories []types.ConsPubKeyRotationHistory, err error) {
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, nil, func(_ collections.Pair[[]byte, int64], value types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		histories = append(histories, value)
+		return false, nil
+	})
+
+	return
+}
+
+// getSigningRotatedConsPubKey returns the rotated out consensus pubkey of the
+// consensus address while CometBFT still signs with it. The validator set
+// update of a rotation at height H is applied from H+1+ValidatorUpdateDelay,
+// so the votes up to that height are signed with the old pubkey, and their
+// vote extensions are verified in the following height.
+func (k Keeper) getSigningRotatedConsPubKey(ctx context.Context, consAddr sdk.ConsAddress) (pubKey cmtprotocrypto.PublicKey, found bool, err error) {
+	valAddr, err := k.RotatedConsAddrs.Get(ctx, consAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return pubKey, false, nil
+	} else if err != nil {
+		return pubKey, false, err
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	err = k.ConsPubKeyRotationHistories.Walk(ctx, collections.NewPrefixedPairRange[[]byte, int64](valAddr).Descending(), func(_ collections.Pair[[]byte, int64], history types.ConsPubKeyRotationHistory) (stop bool, err error) {
+		oldPubKey, err := history.OldConsPubKey()
+		if err != nil {
+			return true, err
+		}
+		if !consAddr.Equals(sdk.GetConsAddress(oldPubKey)) {
+			return false, nil
+		}
+
+		if height <= history.Height+sdk.ValidatorUpdateDelay+1 {
+			pubKey, err = history.OldCmtConsPublicKey()
+			found = err == nil
+		}
+
+		return true, err
+	})
+
+	return pubKey, found, err
+}
+
+// applyConsPubKeyRotations replaces the consensus pubkeys of the validators rotated
+// at the current height in the validator set updates. CometBFT only knows the old
+// pubkey of a validator bonded at the last block, so the old pubkey is removed
+// and the new pubkey is added with the validator's current power.
+func (k Keeper) applyConsPubKeyRotations(ctx context.Context, updates []abci.ValidatorUpdate, lastBonded map[string]bool) ([]abci.ValidatorUpdate, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	height := sdkCtx.BlockHeight()
+
+	var keys []collections.Pair[int64, []byte]
+	err := k.ConsPubKeyRotationsByHeight.Walk(ctx, collections.NewPrefixUntilPairRange[int64, []byte](height), func(key collections.Pair[int64, []byte], _ bool) (stop bool, err error) {
+		keys = append(keys, key)
+		return false, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var rotated [][]byte
+	for _, key := range keys {
+		if key.K1() == height {
+			rotated = append(rotated, key.K2())
+		}
+
+		if err := k.ConsPubKeyRotationsByHeight.Remove(ctx, key); err != nil {
+			return nil, err
+		}
+	}
+
+	powerReduction := k.PowerReduction(ctx)
+	for _, valAddr := range rotated {
+		valAddrStr, err := k.validatorAddressCodec.BytesToString(valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		// the validator was not known to CometBFT, so the updates already have the new pubkey
+		if !lastBonded[valAddrStr] {
+			continue
+		}
+
+		history, err := k.ConsPubKeyRotationHistories.Get(ctx, collections.Join(valAddr, height))
+		if err != nil {
+			return nil, err
+		}
+
+		oldCmtPubKey, err := history.OldCmtConsPublicKey()
+		if err != nil {
+			return nil, err
+		}
+
+		validator, err := k.GetValidator(ctx, valAddr)
+		if err != nil {
+			return nil, err
+		}
+
+		newCmtPubKey, err := validator.CmtConsPublicKey()
+		if err != nil {
+			return nil, err
+		}
+
+		// drop the updates of the new pubkey; it is re-added below with the current power
+		filtered := updates[:0]
+		for _, update := range updates {
+			if !update.PubKey.Equal(newCmtPubKey) {
+				filtered = append(filtered, update)
+			}
+		}
+		updates = append(filtered, abci.ValidatorUpdate{PubKey: oldCmtPubKey, Power: 0})
+
+		if validator.IsBonded() {
+			updates = append(updates, validator.ABCIValidatorUpdate(powerReduction))
+		}
+	}
+
+	return updates, nil
+}
@@ -123,6 +123,10 @@ func (k Keeper) ApplyVotingPowerUpdates(ctx context.Context) error {
 		return err
 	}
 
+	if err := k.TrackVotingPowerWeights(ctx, weights); err != nil {
+		return err
+	}
+
 	minVotingPower, err := k.MinVotingPower(ctx)
 	if err != nil {
 		return err
@@ -194,6 +198,12 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		return nil, err
 	}
 
+	// Keep the last validator set to replace the rotated consensus pubkeys.
+	lastBonded := make(map[string]bool, len(last))
+	for valAddrStr := range last {
+		lastBonded[valAddrStr] = true
+	}
+
 	counter := 0
 
 	// Iterate over validators, highest power to lowest.
@@ -279,6 +289,11 @@ func (k Keeper) ApplyAndReturnValidatorSetUpdates(ctx context.Context) (updates
 		updates = append(updates, validator.ABCIValidatorUpdateZero())
 	}
 
+	updates, err = k.applyConsPubKeyRotations(ctx, updates, lastBonded)
+	if err != nil {
+		return nil, err
+	}
+
 	// Update the pools based on the recent updates in the validator set:
 	// - The tokens from the non-bonded candidates that enter the new validator set need to be transferred
 	// to the Bonded pool.
@@ -31,6 +31,11 @@ func (k Keeper) mustGetValidator(ctx context.Context, addr sdk.ValAddress) types
 // get a single validator by consensus address
 func (k Keeper) GetValidatorByConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (validator types.Validator, err error) {
 	valAddr, err := k.ValidatorsByConsAddr.Get(ctx, consAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		// the consensus address can be rotated out, but it still
+		// resolves to the validator for the old-key infractions
+		valAddr, err = k.RotatedConsAddrs.Get(ctx, consAddr)
+	}
 	if err != nil {
 		return validator, err
 	}
@@ -224,6 +229,10 @@ func (k Keeper) RemoveValidator(ctx context.Context, valAddr sdk.ValAddress) err
 		return err
 	}
 
+	if err := k.RemovePendingCommissionChange(ctx, valAddr); err != nil {
+		return err
+	}
+
 	// call hooks
 	if err := k.Hooks().AfterValidatorRemoved(ctx, valConsAddr, valAddr); err != nil {
 		k.Logger(ctx).Error("error in after validator removed hook", "error", err)
@@ -472,8 +481,8 @@ func (k Keeper) IsWhitelist(ctx context.Context, val types.Validator) (bool, err
 
 // AddWhitelistValidator add validator to power update whitelist
 func (k Keeper) AddWhitelistValidator(ctx context.Context, val types.Validator) error {
-	// jailed validators are not kept in the power update whitelist
-	if val.Jailed {
+	// jailed or retired validators are not kept in the power update whitelist
+	if val.Jailed || val.Retired {
 		return nil
 	}
 
@@ -2,8 +2,14 @@ package keeper
 
 import (
 	"context"
+	"errors"
+	"sort"
+
+	"cosmossdk.io/collections"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/initia-labs/initia/x/mstaking/types"
 )
 
 // GetVotingPowerWeight return voting power weights
@@ -15,3 +21,106 @@ func (k Keeper) GetVotingPowerWeights(ctx context.Context) (sdk.DecCoins, error)
 
 	return k.VotingPowerKeeper.GetVotingPowerWeights(ctx, bondDenoms)
 }
+
+// GetLastHistoricalVotingPowerWeights returns the latest recorded voting power weights
+func (k Keeper) GetLastHistoricalVotingPowerWeights(ctx context.Context) (history types.HistoricalVotingPowerWeights, found bool, err error) {
+	err = k.HistoricalVotingPowerWeights.Walk(ctx, new(collections.Range[int64]).Descending(), func(_ int64, value types.HistoricalVotingPowerWeights) (stop bool, err error) {
+		history, found = value, true
+		return true, nil
+	})
+
+	return
+}
+
+// TrackVotingPowerWeights saves the voting power weights applied at the current height,
+// emits an event for each denom whose weight moved more than the change threshold, and
+// deletes the oldest heights that are below pruning height. The last weights are kept
+// regardless of the historical entries, so the events are emitted without the history.
+func (k Keeper) TrackVotingPowerWeights(ctx context.Context, weights sdk.DecCoins) error {
+	entryNum, err := k.HistoricalEntries(ctx)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	last, err := k.LastVotingPowerWeights.Get(ctx)
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return err
+	} else if err == nil {
+		if err := k.emitVotingPowerWeightChangeEvents(ctx, last.VotingPowerWeights, weights); err != nil {
+			return err
+		}
+	}
+
+	current := types.HistoricalVotingPowerWeights{
+		Height:             sdkCtx.BlockHeight(),
+		Time:               sdkCtx.BlockTime(),
+		VotingPowerWeights: weights,
+	}
+	if err := k.LastVotingPowerWeights.Set(ctx, current); err != nil {
+		return err
+	}
+
+	// prune the entries below the pruning height; same as the historical info
+	for i := sdkCtx.BlockHeight() - int64(entryNum); i >= 0; i-- {
+		if found, err := k.HistoricalVotingPowerWeights.Has(ctx, i); err != nil {
+			return err
+		} else if !found {
+			break
+		}
+
+		if err := k.HistoricalVotingPowerWeights.Remove(ctx, i); err != nil {
+			return err
+		}
+	}
+
+	// if there is no need to persist the weights, return
+	if entryNum == 0 {
+		return nil
+	}
+
+	return k.HistoricalVotingPowerWeights.Set(ctx, sdkCtx.BlockHeight(), current)
+}
+
+func (k Keeper) emitVotingPowerWeightChangeEvents(ctx context.Context, oldWeights, newWeights sdk.DecCoins) error {
+	threshold, err := k.VotingPowerWeightChangeThreshold(ctx)
+	if err != nil {
+		return err
+	}
+
+	denoms := make([]string, 0, len(oldWeights)+len(newWeights))
+	for _, weight := range oldWeights {
+		denoms = append(denoms, weight.Denom)
+	}
+	for _, weight := range newWeights {
+		if !oldWeights.AmountOf(weight.Denom).IsPositive() {
+			denoms = append(denoms, weight.Denom)
+		}
+	}
+	sort.Strings(denoms)
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	for _, denom := range denoms {
+		oldWeight := oldWeights.AmountOf(denom)
+		newWeight := newWeights.AmountOf(denom)
+		if oldWeight.Equal(newWeight) {
+			continue
+		}
+
+		// a weight appeared from or disappeared to zero always exceeds the threshold
+		if !oldWeight.IsZero() && newWeight.Sub(oldWeight).Abs().Quo(oldWeight).LTE(threshold) {
+			continue
+		}
+
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypeVotingPowerWeightChange,
+				sdk.NewAttribute(types.AttributeKeyDenom, denom),
+				sdk.NewAttribute(types.AttributeKeyOldWeight, oldWeight.String()),
+				sdk.NewAttribute(types.AttributeKeyNewWeight, newWeight.String()),
+			),
+		)
+	}
+
+	return nil
+}
@@ -19,6 +19,10 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgBeginRedelegate{}, "mstaking/MsgBeginRedelegate")
 	legacy.RegisterAminoMsg(cdc, &MsgCancelUnbondingDelegation{}, "mstaking/MsgCancelUnbondingDelegation")
 	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "mstaking/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRotateConsPubKey{}, "mstaking/MsgRotateConsPubKey")
+	legacy.RegisterAminoMsg(cdc, &MsgConvertDelegation{}, "mstaking/MsgConvertDelegation")
+	legacy.RegisterAminoMsg(cdc, &MsgScheduleCommissionChange{}, "mstaking/MsgScheduleCommissionChange")
+	legacy.RegisterAminoMsg(cdc, &MsgRetireValidator{}, "mstaking/MsgRetireValidator")
 
 	cdc.RegisterInterface((*isStakeAuthorization_Validators)(nil), nil)
 	cdc.RegisterConcrete(&StakeAuthorization_AllowList{}, "mstaking/StakeAuthorization/AllowList", nil)
@@ -37,6 +41,10 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgBeginRedelegate{},
 		&MsgCancelUnbondingDelegation{},
 		&MsgUpdateParams{},
+		&MsgRotateConsPubKey{},
+		&MsgConvertDelegation{},
+		&MsgScheduleCommissionChange{},
+		&MsgRetireValidator{},
 	)
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
@@ -55,4 +55,12 @@ var (
 	ErrInvalidSigner                   = errorsmod.Register(ModuleName, 43, "expected authority account as only signer for proposal message")
 	ErrBadRedelegationSrc              = errorsmod.Register(ModuleName, 44, "redelegation source validator not found")
 	ErrNoUnbondingType                 = errorsmod.Register(ModuleName, 45, "unbonding type not found")
+	ErrConsPubKeyRotationRateLimited   = errorsmod.Register(ModuleName, 46, "consensus pubkey can be rotated once per unbonding period")
+	ErrConsPubKeyAlreadyUsed           = errorsmod.Register(ModuleName, 47, "consensus pubkey is already used by a validator")
+	ErrTransitiveConversion            = errorsmod.Register(ModuleName, 48, "redelegation to this validator in progress; the redelegation must complete before the delegation is converted")
+	ErrConversionSlippage              = errorsmod.Register(ModuleName, 49, "converted amount is smaller than the minimum amount")
+	ErrCommissionIncreaseNotScheduled  = errorsmod.Register(ModuleName, 50, "commission increase must be scheduled with the notice period")
+	ErrPendingCommissionChange         = errorsmod.Register(ModuleName, 51, "validator has a pending commission change")
+	ErrValidatorRetired                = errorsmod.Register(ModuleName, 52, "validator is retired")
+	ErrVestingAccountConversion        = errorsmod.Register(ModuleName, 53, "delegation of a vesting account cannot be converted")
 )
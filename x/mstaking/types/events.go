@@ -10,6 +10,13 @@ const (
 	EventTypeUnbond                    = "unbond"
 	EventTypeCancelUnbondingDelegation = "cancel_unbonding_delegation"
 	EventTypeRedelegate                = "redelegate"
+	EventTypeRotateConsPubKey          = "rotate_cons_pubkey"
+	EventTypeVotingPowerWeightChange   = "voting_power_weight_change"
+	EventTypeConvertDelegation         = "convert_delegation"
+	EventTypeScheduleCommissionChange  = "schedule_commission_change"
+	EventTypeApplyCommissionChange     = "apply_commission_change"
+	EventTypeCancelCommissionChange    = "cancel_commission_change"
+	EventTypeRetireValidator           = "retire_validator"
 
 	AttributeKeyValidator      = "validator"
 	AttributeKeyCommissionRate = "commission_rate"
@@ -19,5 +26,13 @@ const (
 	AttributeKeyCompletionTime = "completion_time"
 	AttributeKeyCreationHeight = "creation_height"
 	AttributeKeyNewShares      = "new_shares"
+	AttributeKeyOldConsAddress = "old_consensus_address"
+	AttributeKeyNewConsAddress = "new_consensus_address"
+	AttributeKeyDenom          = "denom"
+	AttributeKeyOldWeight      = "old_weight"
+	AttributeKeyNewWeight      = "new_weight"
+	AttributeKeyTargetAmount   = "target_amount"
+	AttributeKeyEffectiveTime  = "effective_time"
+	AttributeKeyReason         = "reason"
 	AttributeValueCategory     = ModuleName
 )
@@ -5,6 +5,7 @@ import (
 
 	"cosmossdk.io/core/address"
 	"cosmossdk.io/math"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -13,6 +14,11 @@ type VotingPowerKeeper interface {
 	GetVotingPowerWeights(ctx context.Context, bondDenoms []string) (sdk.DecCoins, error)
 }
 
+// DexKeeper expected move dex keeper (noalias)
+type DexKeeper interface {
+	ConvertCoin(ctx context.Context, addr sdk.AccAddress, offerCoin sdk.Coin, targetDenom string) (sdk.Coin, error)
+}
+
 // CommunityPoolKeeper expected community pool keeper (noalias)
 type CommunityPoolKeeper interface {
 	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
@@ -22,7 +28,7 @@ type CommunityPoolKeeper interface {
 type AccountKeeper interface {
 	AddressCodec() address.Codec
 	IterateAccounts(ctx context.Context, process func(sdk.AccountI) (stop bool))
-	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI // only used for simulation
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
 
 	GetModuleAddress(name string) sdk.AccAddress
 	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
@@ -41,6 +47,7 @@ type BankKeeper interface {
 	GetSupply(ctx context.Context, denom string) sdk.Coin
 
 	SendCoinsFromModuleToModule(ctx context.Context, senderPool, recipientPool string, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 	UndelegateCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
 	DelegateCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
 
@@ -104,6 +111,7 @@ type StakingHooks interface {
 	AfterDelegationModified(ctx context.Context, delAddr sdk.AccAddress, valAddr sdk.ValAddress) error
 	BeforeValidatorSlashed(ctx context.Context, valAddr sdk.ValAddress, fraction sdk.DecCoins) error
 	AfterUnbondingInitiated(ctx context.Context, id uint64) error
+	AfterConsensusPubKeyUpdate(ctx context.Context, valAddr sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, rotationFee sdk.Coins) error // Must be called when a validator's consensus pubkey is rotated
 }
 
 type SlashingHooks interface {
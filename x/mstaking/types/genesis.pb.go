@@ -43,6 +43,10 @@ type GenesisState struct {
 	Exported      bool           `protobuf:"varint,7,opt,name=exported,proto3" json:"exported,omitempty"`
 	// sdk missed this
 	NextUnbondingId uint64 `protobuf:"varint,8,opt,name=next_unbonding_id,json=nextUnbondingId,proto3" json:"next_unbonding_id,omitempty"`
+	// rotation_histories defines the consensus pubkey rotations of the validators.
+	RotationHistories []ConsPubKeyRotationHistory `protobuf:"bytes,9,rep,name=rotation_histories,json=rotationHistories,proto3" json:"rotation_histories" yaml:"rotation_histories"`
+	// pending_commission_changes defines the scheduled commission changes of the validators.
+	PendingCommissionChanges []PendingCommissionChange `protobuf:"bytes,10,rep,name=pending_commission_changes,json=pendingCommissionChanges,proto3" json:"pending_commission_changes" yaml:"pending_commission_changes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -134,6 +138,20 @@ func (m *GenesisState) GetNextUnbondingId() uint64 {
 	return 0
 }
 
+func (m *GenesisState) GetRotationHistories() []ConsPubKeyRotationHistory {
+	if m != nil {
+		return m.RotationHistories
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingCommissionChanges() []PendingCommissionChange {
+	if m != nil {
+		return m.PendingCommissionChanges
+	}
+	return nil
+}
+
 // LastValidatorPower required for validator set update logic.
 type LastValidatorPower struct {
 	// address is the address of the validator.
@@ -183,41 +201,47 @@ func init() {
 func init() { proto.RegisterFile("initia/mstaking/v1/genesis.proto", fileDescriptor_57b79fb94e52eb30) }
 
 var fileDescriptor_57b79fb94e52eb30 = []byte{
-	// 538 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
-	0x18, 0xc7, 0xe3, 0x75, 0xed, 0x3a, 0x17, 0x84, 0x6a, 0x3a, 0x29, 0x54, 0x90, 0x86, 0x1e, 0xa0,
-	0x4c, 0x5a, 0xa2, 0x95, 0xdb, 0x24, 0x0e, 0x44, 0x48, 0x80, 0xc4, 0x61, 0xca, 0x04, 0x07, 0x24,
-	0x54, 0xb9, 0x8b, 0x95, 0x59, 0x24, 0x76, 0x15, 0xbb, 0xa5, 0x7b, 0x00, 0x04, 0x47, 0x1e, 0x61,
-	0x47, 0x8e, 0x1c, 0x78, 0x88, 0x1d, 0x27, 0xc4, 0x81, 0xd3, 0x84, 0xda, 0x03, 0x9c, 0x79, 0x02,
-	0x54, 0xdb, 0x4d, 0xb3, 0x35, 0x97, 0x28, 0x9f, 0xfd, 0xff, 0xfe, 0xbf, 0xbf, 0x2d, 0x7f, 0xd0,
-	0xa5, 0x8c, 0x4a, 0x8a, 0xfd, 0x54, 0x48, 0xfc, 0x9e, 0xb2, 0xd8, 0x9f, 0xec, 0xfb, 0x31, 0x61,
-	0x44, 0x50, 0xe1, 0x8d, 0x32, 0x2e, 0x39, 0x42, 0x5a, 0xe1, 0x2d, 0x15, 0xde, 0x64, 0xbf, 0xdd,
-	0xc4, 0x29, 0x65, 0xdc, 0x57, 0x5f, 0x2d, 0x6b, 0xdf, 0x39, 0xe6, 0x22, 0xe5, 0x62, 0xa0, 0x2a,
-	0x5f, 0x17, 0x66, 0xab, 0x15, 0xf3, 0x98, 0xeb, 0xf5, 0xc5, 0x9f, 0x59, 0x2d, 0x23, 0x2f, 0x11,
-	0x4a, 0xd1, 0xfd, 0x59, 0x85, 0x37, 0x9e, 0xeb, 0x2c, 0x47, 0x12, 0x4b, 0x82, 0x9e, 0xc0, 0xda,
-	0x08, 0x67, 0x38, 0x15, 0x36, 0x70, 0x41, 0xaf, 0xd1, 0x6f, 0x7b, 0xeb, 0xd9, 0xbc, 0x43, 0xa5,
-	0x08, 0xb6, 0xcf, 0x2f, 0x3b, 0xd6, 0xd7, 0x3f, 0xdf, 0x76, 0x41, 0x68, 0x9a, 0xd0, 0x47, 0x00,
-	0x77, 0x12, 0x2c, 0xe4, 0x60, 0x82, 0x13, 0x1a, 0x61, 0xc9, 0xb3, 0xc1, 0x88, 0x7f, 0x20, 0x99,
-	0xb0, 0x37, 0xdc, 0x4a, 0xaf, 0xd1, 0x7f, 0x50, 0x66, 0xf7, 0x0a, 0x0b, 0xf9, 0x66, 0xa9, 0x3f,
-	0x5c, 0xc8, 0x83, 0x47, 0x0b, 0xeb, 0x7f, 0x97, 0x9d, 0xbb, 0xa7, 0x38, 0x4d, 0x0e, 0xba, 0xa5,
-	0x96, 0x5d, 0x8d, 0xbe, 0x9d, 0xac, 0xb5, 0x0b, 0xf4, 0x0e, 0xc2, 0x5c, 0x2e, 0xec, 0x8a, 0x62,
-	0xdf, 0x2b, 0x63, 0xe7, 0x8d, 0x81, 0x63, 0x90, 0x4d, 0x8d, 0x5c, 0xb5, 0x1b, 0x4e, 0xc1, 0x10,
-	0x61, 0xd8, 0x88, 0x48, 0x42, 0x62, 0x2c, 0x29, 0x67, 0xc2, 0xde, 0x54, 0xfe, 0x4e, 0x99, 0xff,
-	0xb3, 0x5c, 0x16, 0x74, 0x0c, 0x00, 0x69, 0x40, 0xc1, 0xc0, 0x10, 0x8a, 0x9e, 0xe8, 0x13, 0x80,
-	0x3b, 0x63, 0x36, 0xe4, 0x2c, 0xa2, 0x2c, 0x1e, 0x14, 0x69, 0x55, 0x45, 0x7b, 0x58, 0x46, 0x7b,
-	0xbd, 0x6c, 0x28, 0x60, 0xaf, 0x5d, 0x65, 0xa9, 0xa7, 0x09, 0xd0, 0x1a, 0xaf, 0xf7, 0x0b, 0x44,
-	0xe1, 0xcd, 0x8c, 0x14, 0x03, 0xd4, 0x54, 0x00, 0xb7, 0x2c, 0x40, 0x58, 0x10, 0x06, 0xf7, 0x0d,
-	0xb9, 0xa5, 0xc9, 0x57, 0x4c, 0x0c, 0xf1, 0xaa, 0x33, 0x6a, 0xc3, 0x3a, 0x99, 0x8e, 0x78, 0x26,
-	0x49, 0x64, 0x6f, 0xb9, 0xa0, 0x57, 0x0f, 0xf3, 0x1a, 0xed, 0xc2, 0x26, 0x23, 0x53, 0x39, 0x58,
-	0x1d, 0x80, 0x46, 0x76, 0xdd, 0x05, 0xbd, 0xcd, 0xf0, 0xd6, 0x62, 0x23, 0x3f, 0xfb, 0xcb, 0xa8,
-	0x7b, 0x02, 0xd1, 0xfa, 0xa3, 0x42, 0x7d, 0xb8, 0x85, 0xa3, 0x28, 0x23, 0x42, 0x3f, 0xee, 0xed,
-	0xc0, 0xfe, 0xf1, 0x7d, 0xaf, 0x65, 0xe6, 0xe8, 0xa9, 0xde, 0x39, 0x92, 0x19, 0x65, 0x71, 0xb8,
-	0x14, 0xa2, 0x16, 0xac, 0xaa, 0xd7, 0x66, 0x6f, 0xb8, 0xa0, 0x57, 0x09, 0x75, 0x71, 0x50, 0xff,
-	0x7c, 0xd6, 0xb1, 0xfe, 0x9e, 0x75, 0xac, 0xe0, 0xc5, 0xf9, 0xcc, 0x01, 0x17, 0x33, 0x07, 0xfc,
-	0x9e, 0x39, 0xe0, 0xcb, 0xdc, 0xb1, 0x2e, 0xe6, 0x8e, 0xf5, 0x6b, 0xee, 0x58, 0x6f, 0xbd, 0x98,
-	0xca, 0x93, 0xf1, 0xd0, 0x3b, 0xe6, 0xa9, 0xaf, 0x6f, 0x6a, 0x2f, 0xc1, 0x43, 0x61, 0xfe, 0xfd,
-	0xe9, 0x6a, 0x2a, 0xe5, 0xe9, 0x88, 0x88, 0x61, 0x4d, 0x4d, 0xe4, 0xe3, 0xff, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xa2, 0x53, 0x0f, 0xd2, 0x2f, 0x04, 0x00, 0x00,
+	// 633 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xbf, 0x6f, 0xd3, 0x40,
+	0x1c, 0xc5, 0x73, 0xfd, 0x99, 0x5e, 0x41, 0x28, 0x47, 0x2a, 0xb9, 0x11, 0x38, 0x6e, 0x86, 0x12,
+	0x8a, 0x6a, 0xab, 0x65, 0xab, 0xc4, 0x80, 0x8b, 0x44, 0x11, 0x0c, 0x95, 0x2b, 0x18, 0x90, 0x50,
+	0x74, 0x89, 0x4f, 0xce, 0x89, 0xd8, 0x67, 0xf9, 0x7b, 0x29, 0xcd, 0x0e, 0x82, 0x91, 0x95, 0xad,
+	0x23, 0x23, 0x03, 0x7f, 0x44, 0xc7, 0x8a, 0x89, 0xa9, 0x42, 0xed, 0x00, 0x33, 0x0b, 0x2b, 0xca,
+	0xdd, 0x25, 0x75, 0xb1, 0x59, 0x2c, 0x9f, 0xef, 0xbd, 0xf7, 0x79, 0x5f, 0x4b, 0x77, 0xd8, 0xe1,
+	0x09, 0x97, 0x9c, 0x7a, 0x31, 0x48, 0xfa, 0x9a, 0x27, 0x91, 0x77, 0xb8, 0xe5, 0x45, 0x2c, 0x61,
+	0xc0, 0xc1, 0x4d, 0x33, 0x21, 0x05, 0x21, 0x5a, 0xe1, 0x4e, 0x14, 0xee, 0xe1, 0x56, 0xa3, 0x46,
+	0x63, 0x9e, 0x08, 0x4f, 0x3d, 0xb5, 0xac, 0xb1, 0xda, 0x13, 0x10, 0x0b, 0xe8, 0xa8, 0x95, 0xa7,
+	0x17, 0x66, 0xab, 0x1e, 0x89, 0x48, 0xe8, 0xef, 0xe3, 0x37, 0xf3, 0xb5, 0x8c, 0x3c, 0x41, 0x28,
+	0x45, 0xeb, 0xcf, 0x22, 0xbe, 0xf6, 0x58, 0x77, 0x39, 0x90, 0x54, 0x32, 0xf2, 0x00, 0x2f, 0xa4,
+	0x34, 0xa3, 0x31, 0x58, 0xc8, 0x41, 0xed, 0xe5, 0xed, 0x86, 0x5b, 0xec, 0xe6, 0xee, 0x2b, 0x85,
+	0xbf, 0x74, 0x72, 0xd6, 0xac, 0x7c, 0xfe, 0xf9, 0x65, 0x03, 0x05, 0xc6, 0x44, 0xde, 0x21, 0xbc,
+	0x32, 0xa0, 0x20, 0x3b, 0x87, 0x74, 0xc0, 0x43, 0x2a, 0x45, 0xd6, 0x49, 0xc5, 0x1b, 0x96, 0x81,
+	0x35, 0xe3, 0xcc, 0xb6, 0x97, 0xb7, 0xd7, 0xcb, 0xe2, 0x9e, 0x51, 0x90, 0x2f, 0x26, 0xfa, 0xfd,
+	0xb1, 0xdc, 0xbf, 0x3b, 0x8e, 0xfe, 0x7d, 0xd6, 0xbc, 0x35, 0xa2, 0xf1, 0x60, 0xa7, 0x55, 0x1a,
+	0xd9, 0xd2, 0xe8, 0x9b, 0x83, 0x82, 0x1d, 0xc8, 0x2b, 0x8c, 0xa7, 0x72, 0xb0, 0x66, 0x15, 0xfb,
+	0x76, 0x19, 0x7b, 0x6a, 0xf4, 0x6d, 0x83, 0xac, 0x69, 0xe4, 0xa5, 0xdd, 0x70, 0x72, 0x81, 0x84,
+	0xe2, 0xe5, 0x90, 0x0d, 0x58, 0x44, 0x25, 0x17, 0x09, 0x58, 0x73, 0x2a, 0xdf, 0x2e, 0xcb, 0x7f,
+	0x34, 0x95, 0xf9, 0x4d, 0x03, 0x20, 0x1a, 0x90, 0x0b, 0x30, 0x84, 0x7c, 0x26, 0x79, 0x8f, 0xf0,
+	0xca, 0x30, 0xe9, 0x8a, 0x24, 0xe4, 0x49, 0xd4, 0xc9, 0xd3, 0xe6, 0x15, 0xed, 0x4e, 0x19, 0xed,
+	0xf9, 0xc4, 0x90, 0xc3, 0xfe, 0xf3, 0x2b, 0x4b, 0x33, 0x4d, 0x81, 0xfa, 0xb0, 0xe8, 0x07, 0xc2,
+	0xf1, 0xf5, 0x8c, 0xe5, 0x0b, 0x2c, 0xa8, 0x02, 0x4e, 0x59, 0x81, 0x20, 0x27, 0xf4, 0xd7, 0x0c,
+	0xb9, 0xae, 0xc9, 0x19, 0x2b, 0x12, 0xaf, 0x26, 0x93, 0x06, 0xae, 0xb2, 0xa3, 0x54, 0x64, 0x92,
+	0x85, 0xd6, 0xa2, 0x83, 0xda, 0xd5, 0x60, 0xba, 0x26, 0x1b, 0xb8, 0x96, 0xb0, 0x23, 0xd9, 0xb9,
+	0x1c, 0x80, 0x87, 0x56, 0xd5, 0x41, 0xed, 0xb9, 0xe0, 0xc6, 0x78, 0x63, 0x3a, 0xfb, 0x93, 0x90,
+	0xbc, 0x45, 0x98, 0x64, 0x42, 0xaa, 0xd4, 0x4e, 0x9f, 0x83, 0x14, 0x19, 0x67, 0x60, 0x2d, 0xa9,
+	0xe2, 0x9b, 0x65, 0xc5, 0x77, 0x45, 0x02, 0xfb, 0xc3, 0xee, 0x53, 0x36, 0x0a, 0x8c, 0x6f, 0x4f,
+	0xd9, 0x46, 0xfe, 0xba, 0x99, 0x62, 0xd5, 0x4c, 0x51, 0x88, 0x35, 0xa3, 0xd4, 0xb2, 0x2b, 0x46,
+	0xce, 0x80, 0x7c, 0x42, 0xb8, 0x91, 0x32, 0x5d, 0xb6, 0x27, 0xe2, 0x98, 0x03, 0x8c, 0x9d, 0xbd,
+	0x3e, 0x4d, 0x22, 0x06, 0x16, 0x56, 0x75, 0xee, 0x95, 0x9e, 0x30, 0xed, 0xda, 0x9d, 0x9a, 0x76,
+	0x95, 0xc7, 0x77, 0x4d, 0x99, 0x35, 0x5d, 0xe6, 0xff, 0xe1, 0xa6, 0x94, 0x95, 0x96, 0x07, 0x41,
+	0xab, 0x8f, 0x49, 0xf1, 0xdc, 0x91, 0x6d, 0xbc, 0x48, 0xc3, 0x30, 0x63, 0xa0, 0xcf, 0xff, 0x92,
+	0x6f, 0x7d, 0xfb, 0xba, 0x59, 0x37, 0x57, 0xcd, 0x43, 0xbd, 0x73, 0x20, 0x33, 0x9e, 0x44, 0xc1,
+	0x44, 0x48, 0xea, 0x78, 0x5e, 0x1d, 0x48, 0x6b, 0xc6, 0x41, 0xed, 0xd9, 0x40, 0x2f, 0x76, 0xaa,
+	0x1f, 0x8e, 0x9b, 0x95, 0x5f, 0xc7, 0xcd, 0x8a, 0xbf, 0x77, 0x72, 0x6e, 0xa3, 0xd3, 0x73, 0x1b,
+	0xfd, 0x38, 0xb7, 0xd1, 0xc7, 0x0b, 0xbb, 0x72, 0x7a, 0x61, 0x57, 0xbe, 0x5f, 0xd8, 0x95, 0x97,
+	0x6e, 0xc4, 0x65, 0x7f, 0xd8, 0x75, 0x7b, 0x22, 0xf6, 0xf4, 0x4f, 0xd8, 0x1c, 0xd0, 0x2e, 0x98,
+	0x77, 0xef, 0xe8, 0xf2, 0xe2, 0x92, 0xa3, 0x94, 0x41, 0x77, 0x41, 0x5d, 0x5a, 0xf7, 0xff, 0x0e,
+	0x00, 0x96, 0xfa, 0xcc, 0xf3, 0x52, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -240,6 +264,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingCommissionChanges) > 0 {
+		for iNdEx := len(m.PendingCommissionChanges) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingCommissionChanges[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.RotationHistories) > 0 {
+		for iNdEx := len(m.RotationHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RotationHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if m.NextUnbondingId != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.NextUnbondingId))
 		i--
@@ -428,6 +480,18 @@ func (m *GenesisState) Size() (n int) {
 	if m.NextUnbondingId != 0 {
 		n += 1 + sovGenesis(uint64(m.NextUnbondingId))
 	}
+	if len(m.RotationHistories) > 0 {
+		for _, e := range m.RotationHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingCommissionChanges) > 0 {
+		for _, e := range m.PendingCommissionChanges {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -724,6 +788,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RotationHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RotationHistories = append(m.RotationHistories, ConsPubKeyRotationHistory{})
+			if err := m.RotationHistories[len(m.RotationHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingCommissionChanges", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingCommissionChanges = append(m.PendingCommissionChanges, PendingCommissionChange{})
+			if err := m.PendingCommissionChanges[len(m.PendingCommissionChanges)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
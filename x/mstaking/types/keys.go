@@ -40,9 +40,18 @@ var (
 	RedelegationQueuePrefix = []byte{0x52} // prefix for the timestamps in redelegations queue
 	ValidatorQueuePrefix    = []byte{0x53} // prefix for the timestamps in validator queue
 
-	HistoricalInfosPrefix = []byte{0x61} // prefix for the historical info
+	HistoricalInfosPrefix              = []byte{0x61} // prefix for the historical info
+	HistoricalVotingPowerWeightsPrefix = []byte{0x62} // prefix for the historical voting power weights
+	LastVotingPowerWeightsKey          = []byte{0x63} // key for the voting power weights applied at the last block
 
 	ParamsKey = []byte{0x71} // prefix for parameters for module x/staking
+
+	RotatedConsAddrsPrefix            = []byte{0x81} // prefix for each key to a validator index, by rotated out consensus address
+	ConsPubKeyRotationHistoryPrefix   = []byte{0x82} // prefix for the consensus pubkey rotation history
+	ConsPubKeyRotationsByHeightPrefix = []byte{0x83} // prefix for the consensus pubkey rotations, by block height
+
+	PendingCommissionChangesPrefix       = []byte{0x91} // prefix for the scheduled commission changes
+	PendingCommissionChangesByTimePrefix = []byte{0x92} // prefix for the scheduled commission changes, by effective time
 )
 
 // UnbondingType defines the type of unbonding operation
@@ -33,17 +33,31 @@ const (
 
 	// DefaultMinVotingPower minimum voting power to get into power update validator set
 	DefaultMinVotingPower uint64 = 1_000_000
+
+	// DefaultKeyRotationFeeAmount is the default fee amount of a consensus pubkey rotation
+	DefaultKeyRotationFeeAmount int64 = 1_000_000
+
+	// DefaultCommissionChangeNoticePeriod is zero, so the commission can be changed
+	// immediately until the governance sets the notice period.
+	DefaultCommissionChangeNoticePeriod time.Duration = 0
 )
 
+// DefaultVotingPowerWeightChangeThreshold is the default relative change of a voting
+// power weight to emit a voting power weight change event
+var DefaultVotingPowerWeightChangeThreshold = math.LegacyNewDecWithPrec(1, 1)
+
 // NewParams creates a new Params instance
-func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint32, historicalEntries uint32, bondDenoms []string, minVotingPower uint64) Params {
+func NewParams(unbondingTime time.Duration, maxValidators, maxEntries uint32, historicalEntries uint32, bondDenoms []string, minVotingPower uint64, keyRotationFee sdk.Coins, votingPowerWeightChangeThreshold math.LegacyDec, commissionChangeNoticePeriod time.Duration) Params {
 	return Params{
-		UnbondingTime:     unbondingTime,
-		MaxValidators:     maxValidators,
-		MaxEntries:        maxEntries,
-		HistoricalEntries: historicalEntries,
-		BondDenoms:        bondDenoms,
-		MinVotingPower:    minVotingPower,
+		UnbondingTime:                    unbondingTime,
+		MaxValidators:                    maxValidators,
+		MaxEntries:                       maxEntries,
+		HistoricalEntries:                historicalEntries,
+		BondDenoms:                       bondDenoms,
+		MinVotingPower:                   minVotingPower,
+		KeyRotationFee:                   keyRotationFee,
+		VotingPowerWeightChangeThreshold: votingPowerWeightChangeThreshold,
+		CommissionChangeNoticePeriod:     commissionChangeNoticePeriod,
 	}
 }
 
@@ -56,6 +70,9 @@ func DefaultParams() Params {
 		DefaultHistoricalEntries,
 		[]string{sdk.DefaultBondDenom},
 		DefaultMinVotingPower,
+		sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, DefaultKeyRotationFeeAmount)),
+		DefaultVotingPowerWeightChangeThreshold,
+		DefaultCommissionChangeNoticePeriod,
 	)
 }
 
@@ -111,6 +128,18 @@ func (p Params) Validate() error {
 		return err
 	}
 
+	if err := validateKeyRotationFee(p.KeyRotationFee); err != nil {
+		return err
+	}
+
+	if err := validateVotingPowerWeightChangeThreshold(p.VotingPowerWeightChangeThreshold); err != nil {
+		return err
+	}
+
+	if err := validateCommissionChangeNoticePeriod(p.CommissionChangeNoticePeriod); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -206,3 +235,46 @@ func validateMinVotingPower(i interface{}) error {
 
 	return nil
 }
+
+func validateKeyRotationFee(i interface{}) error {
+	v, ok := i.(sdk.Coins)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if err := v.Validate(); err != nil {
+		return fmt.Errorf("invalid key rotation fee: %w", err)
+	}
+
+	return nil
+}
+
+func validateVotingPowerWeightChangeThreshold(i interface{}) error {
+	v, ok := i.(math.LegacyDec)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v.IsNil() {
+		return fmt.Errorf("voting power weight change threshold cannot be nil")
+	}
+
+	if v.IsNegative() {
+		return fmt.Errorf("voting power weight change threshold cannot be negative: %s", v)
+	}
+
+	return nil
+}
+
+func validateCommissionChangeNoticePeriod(i interface{}) error {
+	v, ok := i.(time.Duration)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("commission change notice period cannot be negative: %d", v)
+	}
+
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"time"
+
+	"cosmossdk.io/errors"
+
+	cmtprotocrypto "github.com/cometbft/cometbft/proto/tendermint/crypto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var _ codectypes.UnpackInterfacesMessage = (*ConsPubKeyRotationHistory)(nil)
+
+// NewConsPubKeyRotationHistory creates a new ConsPubKeyRotationHistory instance.
+func NewConsPubKeyRotationHistory(
+	operator string, oldPubKey, newPubKey cryptotypes.PubKey,
+	height int64, time time.Time, fee sdk.Coins,
+) (ConsPubKeyRotationHistory, error) {
+	oldPkAny, err := codectypes.NewAnyWithValue(oldPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	newPkAny, err := codectypes.NewAnyWithValue(newPubKey)
+	if err != nil {
+		return ConsPubKeyRotationHistory{}, err
+	}
+
+	return ConsPubKeyRotationHistory{
+		OperatorAddress: operator,
+		OldConsPubkey:   oldPkAny,
+		NewConsPubkey:   newPkAny,
+		Height:          height,
+		Time:            time,
+		Fee:             fee,
+	}, nil
+}
+
+// OldConsPubKey returns the consensus public key before the rotation.
+func (h ConsPubKeyRotationHistory) OldConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.OldConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// NewConsPubKey returns the consensus public key after the rotation.
+func (h ConsPubKeyRotationHistory) NewConsPubKey() (cryptotypes.PubKey, error) {
+	pk, ok := h.NewConsPubkey.GetCachedValue().(cryptotypes.PubKey)
+	if !ok {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidType, "expecting cryptotypes.PubKey, got %T", pk)
+	}
+
+	return pk, nil
+}
+
+// OldCmtConsPublicKey casts the old consensus public key to cmtprotocrypto.PubKey.
+func (h ConsPubKeyRotationHistory) OldCmtConsPublicKey() (cmtprotocrypto.PublicKey, error) {
+	pk, err := h.OldConsPubKey()
+	if err != nil {
+		return cmtprotocrypto.PublicKey{}, err
+	}
+
+	return cryptocodec.ToCmtProtoPublicKey(pk)
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (h ConsPubKeyRotationHistory) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var oldPk, newPk cryptotypes.PubKey
+	if err := unpacker.UnpackAny(h.OldConsPubkey, &oldPk); err != nil {
+		return err
+	}
+
+	return unpacker.UnpackAny(h.NewConsPubkey, &newPk)
+}
@@ -317,6 +317,9 @@ type Validator struct {
 	UnbondingOnHoldRefCount int64 `protobuf:"varint,13,opt,name=unbonding_on_hold_ref_count,json=unbondingOnHoldRefCount,proto3" json:"unbonding_on_hold_ref_count,omitempty"`
 	// unbonding id, uniquely identifing an unbonding of this validator
 	UnbondingId uint64 `protobuf:"varint,14,opt,name=unbonding_id,json=unbondingId,proto3" json:"unbonding_id,omitempty"`
+	// retired is true if the validator was voluntarily retired by its operator,
+	// which cannot join the active set or receive new delegations anymore.
+	Retired bool `protobuf:"varint,15,opt,name=retired,proto3" json:"retired,omitempty"`
 }
 
 func (m *Validator) Reset()      { *m = Validator{} }
@@ -895,6 +898,14 @@ type Params struct {
 	MinVotingPower uint64 `protobuf:"varint,6,opt,name=min_voting_power,json=minVotingPower,proto3" json:"min_voting_power,omitempty" yaml:"min_voting_power"`
 	// min_commission_rate is the chain-wide minimum commission rate that a validator can charge their delegators
 	MinCommissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=min_commission_rate,json=minCommissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_commission_rate" yaml:"min_commission_rate"`
+	// key_rotation_fee is the fee charged to the validator operator for a consensus pubkey rotation
+	KeyRotationFee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,8,rep,name=key_rotation_fee,json=keyRotationFee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"key_rotation_fee" yaml:"key_rotation_fee"`
+	// voting_power_weight_change_threshold is the relative change of a denom's voting power weight
+	// in a block above which a voting power weight change event is emitted
+	VotingPowerWeightChangeThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=voting_power_weight_change_threshold,json=votingPowerWeightChangeThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"voting_power_weight_change_threshold" yaml:"voting_power_weight_change_threshold"`
+	// commission_change_notice_period is the notice period of a scheduled commission change.
+	// When it is positive, a validator can increase its commission only through the scheduling.
+	CommissionChangeNoticePeriod time.Duration `protobuf:"bytes,10,opt,name=commission_change_notice_period,json=commissionChangeNoticePeriod,proto3,stdduration" json:"commission_change_notice_period" yaml:"commission_change_notice_period"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -971,6 +982,202 @@ func (m *Params) GetMinVotingPower() uint64 {
 	return 0
 }
 
+func (m *Params) GetKeyRotationFee() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.KeyRotationFee
+	}
+	return nil
+}
+
+func (m *Params) GetCommissionChangeNoticePeriod() time.Duration {
+	if m != nil {
+		return m.CommissionChangeNoticePeriod
+	}
+	return 0
+}
+
+// HistoricalVotingPowerWeights contains the voting power weights applied at a block.
+type HistoricalVotingPowerWeights struct {
+	// height is the block height the weights are applied.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time the weights are applied.
+	Time time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time"`
+	// voting_power_weights are the voting power weights of the bond denoms.
+	VotingPowerWeights github_com_cosmos_cosmos_sdk_types.DecCoins `protobuf:"bytes,3,rep,name=voting_power_weights,json=votingPowerWeights,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.DecCoins" json:"voting_power_weights"`
+}
+
+func (m *HistoricalVotingPowerWeights) Reset()         { *m = HistoricalVotingPowerWeights{} }
+func (m *HistoricalVotingPowerWeights) String() string { return proto.CompactTextString(m) }
+func (*HistoricalVotingPowerWeights) ProtoMessage()    {}
+func (*HistoricalVotingPowerWeights) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{15}
+}
+func (m *HistoricalVotingPowerWeights) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HistoricalVotingPowerWeights) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HistoricalVotingPowerWeights.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HistoricalVotingPowerWeights) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HistoricalVotingPowerWeights.Merge(m, src)
+}
+func (m *HistoricalVotingPowerWeights) XXX_Size() int {
+	return m.Size()
+}
+func (m *HistoricalVotingPowerWeights) XXX_DiscardUnknown() {
+	xxx_messageInfo_HistoricalVotingPowerWeights.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HistoricalVotingPowerWeights proto.InternalMessageInfo
+
+func (m *HistoricalVotingPowerWeights) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *HistoricalVotingPowerWeights) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *HistoricalVotingPowerWeights) GetVotingPowerWeights() github_com_cosmos_cosmos_sdk_types.DecCoins {
+	if m != nil {
+		return m.VotingPowerWeights
+	}
+	return nil
+}
+
+// PendingCommissionChange contains a validator's commission change, which is
+// announced to the delegators and applied after the notice period.
+type PendingCommissionChange struct {
+	// validator_address defines the address of the validator's operator; bech encoded in JSON.
+	ValidatorAddress string `protobuf:"bytes,1,opt,name=validator_address,json=validatorAddress,proto3" json:"validator_address,omitempty"`
+	// rate is the commission rate to be applied.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// schedule_time is the block time the change is scheduled.
+	ScheduleTime time.Time `protobuf:"bytes,3,opt,name=schedule_time,json=scheduleTime,proto3,stdtime" json:"schedule_time"`
+	// effective_time is the block time after which the change is applied.
+	EffectiveTime time.Time `protobuf:"bytes,4,opt,name=effective_time,json=effectiveTime,proto3,stdtime" json:"effective_time"`
+}
+
+func (m *PendingCommissionChange) Reset()         { *m = PendingCommissionChange{} }
+func (m *PendingCommissionChange) String() string { return proto.CompactTextString(m) }
+func (*PendingCommissionChange) ProtoMessage()    {}
+func (*PendingCommissionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{16}
+}
+func (m *PendingCommissionChange) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingCommissionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingCommissionChange.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingCommissionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingCommissionChange.Merge(m, src)
+}
+func (m *PendingCommissionChange) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingCommissionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingCommissionChange.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingCommissionChange proto.InternalMessageInfo
+
+func (m *PendingCommissionChange) GetValidatorAddress() string {
+	if m != nil {
+		return m.ValidatorAddress
+	}
+	return ""
+}
+
+func (m *PendingCommissionChange) GetScheduleTime() time.Time {
+	if m != nil {
+		return m.ScheduleTime
+	}
+	return time.Time{}
+}
+
+func (m *PendingCommissionChange) GetEffectiveTime() time.Time {
+	if m != nil {
+		return m.EffectiveTime
+	}
+	return time.Time{}
+}
+
+// ConsPubKeyRotationHistory contains a validator's consensus pubkey rotation.
+// The old consensus address keeps resolving to the operator, so the
+// infractions of the old key can still be handled.
+type ConsPubKeyRotationHistory struct {
+	// operator_address defines the address of the validator's operator; bech encoded in JSON.
+	OperatorAddress string `protobuf:"bytes,1,opt,name=operator_address,json=operatorAddress,proto3" json:"operator_address,omitempty"`
+	// old_cons_pubkey is the consensus public key of the validator before the rotation.
+	OldConsPubkey *types.Any `protobuf:"bytes,2,opt,name=old_cons_pubkey,json=oldConsPubkey,proto3" json:"old_cons_pubkey,omitempty"`
+	// new_cons_pubkey is the consensus public key of the validator after the rotation.
+	NewConsPubkey *types.Any `protobuf:"bytes,3,opt,name=new_cons_pubkey,json=newConsPubkey,proto3" json:"new_cons_pubkey,omitempty"`
+	// height is the block height of the rotation.
+	Height int64 `protobuf:"varint,4,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time of the rotation.
+	Time time.Time `protobuf:"bytes,5,opt,name=time,proto3,stdtime" json:"time"`
+	// fee is the rotation fee paid by the validator operator.
+	Fee github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,6,rep,name=fee,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"fee"`
+}
+
+func (m *ConsPubKeyRotationHistory) Reset()         { *m = ConsPubKeyRotationHistory{} }
+func (m *ConsPubKeyRotationHistory) String() string { return proto.CompactTextString(m) }
+func (*ConsPubKeyRotationHistory) ProtoMessage()    {}
+func (*ConsPubKeyRotationHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_869fea6a46e7b076, []int{17}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ConsPubKeyRotationHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ConsPubKeyRotationHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ConsPubKeyRotationHistory.Merge(m, src)
+}
+func (m *ConsPubKeyRotationHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ConsPubKeyRotationHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ConsPubKeyRotationHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ConsPubKeyRotationHistory proto.InternalMessageInfo
+
 // DelegationResponse is equivalent to Delegation except that it contains a
 // balance in addition to shares which is more suitable for client responses.
 type DelegationResponse struct {
@@ -981,7 +1188,7 @@ type DelegationResponse struct {
 func (m *DelegationResponse) Reset()      { *m = DelegationResponse{} }
 func (*DelegationResponse) ProtoMessage() {}
 func (*DelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{15}
+	return fileDescriptor_869fea6a46e7b076, []int{18}
 }
 func (m *DelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1036,7 +1243,7 @@ func (m *RedelegationEntryResponse) Reset()         { *m = RedelegationEntryResp
 func (m *RedelegationEntryResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationEntryResponse) ProtoMessage()    {}
 func (*RedelegationEntryResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{16}
+	return fileDescriptor_869fea6a46e7b076, []int{19}
 }
 func (m *RedelegationEntryResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1091,7 +1298,7 @@ func (m *RedelegationResponse) Reset()         { *m = RedelegationResponse{} }
 func (m *RedelegationResponse) String() string { return proto.CompactTextString(m) }
 func (*RedelegationResponse) ProtoMessage()    {}
 func (*RedelegationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{17}
+	return fileDescriptor_869fea6a46e7b076, []int{20}
 }
 func (m *RedelegationResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1146,7 +1353,7 @@ func (m *Pool) Reset()         { *m = Pool{} }
 func (m *Pool) String() string { return proto.CompactTextString(m) }
 func (*Pool) ProtoMessage()    {}
 func (*Pool) Descriptor() ([]byte, []int) {
-	return fileDescriptor_869fea6a46e7b076, []int{18}
+	return fileDescriptor_869fea6a46e7b076, []int{21}
 }
 func (m *Pool) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1214,6 +1421,9 @@ func init() {
 	proto.RegisterType((*RedelegationEntry)(nil), "initia.mstaking.v1.RedelegationEntry")
 	proto.RegisterType((*Redelegation)(nil), "initia.mstaking.v1.Redelegation")
 	proto.RegisterType((*Params)(nil), "initia.mstaking.v1.Params")
+	proto.RegisterType((*HistoricalVotingPowerWeights)(nil), "initia.mstaking.v1.HistoricalVotingPowerWeights")
+	proto.RegisterType((*PendingCommissionChange)(nil), "initia.mstaking.v1.PendingCommissionChange")
+	proto.RegisterType((*ConsPubKeyRotationHistory)(nil), "initia.mstaking.v1.ConsPubKeyRotationHistory")
 	proto.RegisterType((*DelegationResponse)(nil), "initia.mstaking.v1.DelegationResponse")
 	proto.RegisterType((*RedelegationEntryResponse)(nil), "initia.mstaking.v1.RedelegationEntryResponse")
 	proto.RegisterType((*RedelegationResponse)(nil), "initia.mstaking.v1.RedelegationResponse")
@@ -1223,138 +1433,160 @@ func init() {
 func init() { proto.RegisterFile("initia/mstaking/v1/staking.proto", fileDescriptor_869fea6a46e7b076) }
 
 var fileDescriptor_869fea6a46e7b076 = []byte{
-	// 2089 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x59, 0x4f, 0x6c, 0x1b, 0x59,
-	0xfd, 0xcf, 0x24, 0xae, 0x13, 0x7f, 0xed, 0xc4, 0xce, 0x6b, 0xda, 0x3a, 0x6e, 0x7f, 0xb6, 0x77,
-	0x56, 0x3f, 0x14, 0x2d, 0x5b, 0x9b, 0x76, 0x51, 0x2b, 0x55, 0x20, 0x6d, 0x1c, 0xa7, 0xad, 0x97,
-	0x6e, 0x1a, 0x26, 0x69, 0x0a, 0x8b, 0xd0, 0x68, 0x3c, 0xf3, 0xea, 0x0c, 0xf1, 0xcc, 0x98, 0x79,
-	0xcf, 0x6d, 0xcc, 0x89, 0x0b, 0xa2, 0xea, 0x61, 0xb5, 0x9c, 0x58, 0x0e, 0x95, 0x2a, 0xb8, 0x20,
-	0xb4, 0x37, 0x10, 0x88, 0x03, 0x47, 0xa4, 0x05, 0x84, 0xd4, 0x23, 0x5a, 0x21, 0x2f, 0x6a, 0x39,
-	0x20, 0x4e, 0x28, 0x67, 0x0e, 0xe8, 0xfd, 0x99, 0x3f, 0x1e, 0x7b, 0xeb, 0xa4, 0x5a, 0xad, 0x56,
-	0x82, 0x4b, 0xeb, 0xf7, 0x7d, 0xdf, 0xcf, 0xf7, 0x7d, 0xff, 0x7f, 0xe7, 0xbd, 0x40, 0xd5, 0x76,
-	0x6d, 0x6a, 0x1b, 0x75, 0x87, 0x50, 0xe3, 0xc0, 0x76, 0x3b, 0xf5, 0xfb, 0x97, 0xea, 0xf2, 0x67,
-	0xad, 0xe7, 0x7b, 0xd4, 0x43, 0x48, 0x70, 0xd4, 0x02, 0x8e, 0xda, 0xfd, 0x4b, 0xa5, 0xb2, 0xe9,
-	0x11, 0xc7, 0x23, 0xf5, 0xb6, 0x41, 0x70, 0xfd, 0xfe, 0xa5, 0x36, 0xa6, 0xc6, 0xa5, 0xba, 0xe9,
-	0xd9, 0xae, 0xc0, 0x94, 0x56, 0xc5, 0xbe, 0xce, 0x57, 0x75, 0xb1, 0x90, 0x5b, 0x2b, 0x1d, 0xaf,
-	0xe3, 0x09, 0x3a, 0xfb, 0x15, 0x00, 0x3a, 0x9e, 0xd7, 0xe9, 0xe2, 0x3a, 0x5f, 0xb5, 0xfb, 0xf7,
-	0xea, 0x86, 0x3b, 0x90, 0x5b, 0xe5, 0xe4, 0x96, 0xd5, 0xf7, 0x0d, 0x6a, 0x7b, 0xc1, 0x59, 0x95,
-	0xe4, 0x3e, 0xb5, 0x1d, 0x4c, 0xa8, 0xe1, 0xf4, 0x24, 0xc3, 0x05, 0x8a, 0x5d, 0x0b, 0xfb, 0x8e,
-	0xed, 0xd2, 0x3a, 0x1d, 0xf4, 0x30, 0x11, 0xff, 0x8a, 0x5d, 0xf5, 0xdd, 0x59, 0xc8, 0x6f, 0x78,
-	0x8e, 0x63, 0x13, 0x62, 0x7b, 0xae, 0x66, 0x50, 0x4c, 0xd0, 0x55, 0x48, 0xf9, 0x06, 0xc5, 0x45,
-	0xa5, 0xaa, 0xac, 0x65, 0x1a, 0xaf, 0x7e, 0x38, 0xac, 0xcc, 0x7c, 0x34, 0xac, 0x9c, 0x17, 0x76,
-	0x10, 0xeb, 0xa0, 0x66, 0x7b, 0x75, 0xc7, 0xa0, 0xfb, 0xb5, 0x5b, 0xb8, 0x63, 0x98, 0x83, 0x26,
-	0x36, 0x35, 0x0e, 0x40, 0x5f, 0x87, 0x05, 0xc7, 0x38, 0xd4, 0x39, 0x78, 0x96, 0x83, 0xaf, 0x1c,
-	0x03, 0x7c, 0x34, 0xac, 0xe4, 0x07, 0x86, 0xd3, 0xbd, 0xa6, 0x06, 0x60, 0x55, 0x9b, 0x77, 0x8c,
-	0x43, 0xa6, 0x0c, 0xc2, 0x90, 0x67, 0x54, 0x73, 0xdf, 0x70, 0x3b, 0x58, 0x48, 0x9e, 0xe3, 0x92,
-	0xbf, 0x7a, 0x3c, 0xc9, 0x67, 0x23, 0xc9, 0x31, 0x19, 0xaa, 0xb6, 0xe8, 0x18, 0x87, 0x1b, 0x9c,
-	0xc0, 0x8e, 0xb9, 0xb6, 0xf0, 0xfe, 0x93, 0xca, 0xcc, 0x3f, 0x9e, 0x54, 0x14, 0xf5, 0xcf, 0x0a,
-	0x40, 0xe4, 0x10, 0xf4, 0x0d, 0x28, 0x98, 0xe1, 0x8a, 0x63, 0x09, 0xf7, 0x4b, 0xf6, 0xf2, 0xab,
-	0xb5, 0xf1, 0xcc, 0xa8, 0x25, 0x5c, 0xd9, 0x58, 0x60, 0x5a, 0x3e, 0x1d, 0x56, 0x14, 0x2d, 0x6f,
-	0x26, 0xbc, 0xfc, 0x2d, 0xc8, 0xf6, 0x7b, 0x96, 0x41, 0xb1, 0xce, 0x22, 0xc6, 0xfd, 0x95, 0xbd,
-	0x5c, 0xaa, 0x89, 0x70, 0xd6, 0x82, 0x70, 0xd6, 0x76, 0x83, 0x70, 0x36, 0xca, 0x4c, 0xd6, 0xd1,
-	0xb0, 0x82, 0x84, 0x49, 0x31, 0xb0, 0xfa, 0xde, 0xc7, 0x15, 0x45, 0x03, 0x41, 0x61, 0x80, 0x98,
-	0x3d, 0x7f, 0x50, 0x20, 0xdb, 0xc4, 0xc4, 0xf4, 0xed, 0x1e, 0xcb, 0x1a, 0x54, 0x84, 0x79, 0xc7,
-	0x73, 0xed, 0x03, 0xec, 0x8b, 0xf8, 0x6a, 0xc1, 0x12, 0x95, 0x60, 0xc1, 0xb6, 0xb0, 0x4b, 0x6d,
-	0x3a, 0x10, 0xd1, 0xd3, 0xc2, 0x35, 0x43, 0x3d, 0xc0, 0x6d, 0x62, 0x07, 0xee, 0xd7, 0x82, 0x25,
-	0xba, 0x0e, 0x05, 0x82, 0xcd, 0xbe, 0x6f, 0xd3, 0x81, 0x6e, 0x7a, 0x2e, 0x35, 0x4c, 0x5a, 0x4c,
-	0xf1, 0x08, 0x9d, 0x3f, 0x1a, 0x56, 0xce, 0x09, 0x5d, 0x93, 0x1c, 0xaa, 0x96, 0x0f, 0x48, 0x1b,
-	0x82, 0xc2, 0x4e, 0xb0, 0x30, 0x35, 0xec, 0x2e, 0x29, 0x9e, 0x12, 0x27, 0xc8, 0x65, 0xcc, 0x96,
-	0xdf, 0x66, 0x20, 0xb3, 0x67, 0x74, 0x6d, 0xcb, 0xa0, 0x9e, 0xcf, 0x4e, 0xf6, 0x7a, 0xd8, 0x67,
-	0xbf, 0x75, 0xc3, 0xb2, 0x7c, 0x4c, 0x88, 0x4c, 0xd9, 0xd8, 0xc9, 0x49, 0x0e, 0x55, 0xcb, 0x07,
-	0xa4, 0x75, 0x41, 0x41, 0x94, 0x85, 0xd8, 0x25, 0xd8, 0x25, 0x7d, 0xa2, 0xf7, 0xfa, 0xed, 0x03,
-	0x3c, 0x90, 0xd1, 0x58, 0x19, 0x8b, 0xc6, 0xba, 0x3b, 0x68, 0xbc, 0x11, 0x49, 0x4f, 0xe2, 0xd4,
-	0x3f, 0xfe, 0xea, 0xe2, 0x8a, 0xac, 0x77, 0xd3, 0x1f, 0xf4, 0xa8, 0x57, 0xdb, 0xee, 0xb7, 0xbf,
-	0x86, 0x07, 0x2c, 0xfc, 0x92, 0x75, 0x9b, 0x73, 0xa2, 0xb3, 0x90, 0xfe, 0x8e, 0x61, 0x77, 0xb1,
-	0xc5, 0x1d, 0xba, 0xa0, 0xc9, 0x15, 0xba, 0x02, 0x69, 0x42, 0x0d, 0xda, 0x27, 0xdc, 0x8b, 0x4b,
-	0x97, 0xcb, 0x93, 0xd2, 0xac, 0xe1, 0xb9, 0xd6, 0x0e, 0xe7, 0xd2, 0x24, 0x37, 0xa2, 0x90, 0xa6,
-	0xde, 0x01, 0x76, 0x99, 0xfb, 0xe6, 0xd6, 0xb2, 0x97, 0x57, 0x6b, 0x52, 0x0f, 0xd6, 0xa4, 0x6a,
-	0xb2, 0x49, 0xd5, 0x36, 0x3c, 0xdb, 0x6d, 0xac, 0xcb, 0x44, 0x5a, 0x14, 0x46, 0x08, 0x98, 0xfa,
-	0x8b, 0x8f, 0x2b, 0x6b, 0x1d, 0x9b, 0xee, 0xf7, 0xdb, 0x35, 0xd3, 0x73, 0x64, 0xd7, 0x92, 0xff,
-	0x5d, 0x24, 0xd6, 0x81, 0x6c, 0x1b, 0x4c, 0x02, 0xd1, 0xe4, 0x59, 0xe8, 0xc7, 0x0a, 0x14, 0x2c,
-	0xdc, 0xc5, 0x1d, 0xee, 0x63, 0xb2, 0x6f, 0xf8, 0x98, 0x14, 0xd3, 0x5c, 0x81, 0x0b, 0x13, 0x15,
-	0x68, 0x62, 0x93, 0xeb, 0xb0, 0x25, 0x75, 0x90, 0x8e, 0x4c, 0xca, 0x60, 0xda, 0x7c, 0xf1, 0x18,
-	0xda, 0x48, 0x71, 0x44, 0xcb, 0x87, 0x12, 0x76, 0xb8, 0x00, 0x74, 0x03, 0xb2, 0x56, 0x94, 0xf6,
-	0xc5, 0x79, 0x1e, 0xd0, 0xca, 0x24, 0x67, 0xc6, 0xaa, 0xa3, 0x91, 0x62, 0x6a, 0x69, 0x71, 0x24,
-	0x4b, 0xb3, 0xbe, 0xdb, 0xf6, 0x5c, 0xcb, 0x76, 0x3b, 0xfa, 0x3e, 0xb6, 0x3b, 0xfb, 0xb4, 0xb8,
-	0x50, 0x55, 0xd6, 0xe6, 0xe2, 0x69, 0x96, 0xe4, 0x50, 0xb5, 0x7c, 0x48, 0xba, 0xc9, 0x29, 0xc8,
-	0x82, 0xa5, 0x88, 0x8b, 0x97, 0x7c, 0x66, 0x6a, 0xc9, 0xbf, 0x22, 0xbd, 0x74, 0x26, 0x79, 0x4a,
-	0x54, 0xf5, 0x8b, 0x21, 0x91, 0xc1, 0x50, 0x13, 0x20, 0x6a, 0x34, 0x45, 0xe0, 0x27, 0x94, 0x5f,
-	0xdc, 0xa9, 0xa4, 0xd1, 0x31, 0x1c, 0x7a, 0xa8, 0xc0, 0xe2, 0x7d, 0x8f, 0xb2, 0x93, 0x7a, 0xde,
-	0x03, 0xec, 0x93, 0x62, 0x76, 0x5a, 0x52, 0xdd, 0x94, 0xaa, 0xae, 0x08, 0x55, 0x47, 0xd0, 0x27,
-	0xcb, 0xad, 0x9c, 0xc0, 0x6e, 0x73, 0x28, 0xba, 0x0b, 0xb9, 0xb8, 0xac, 0x62, 0x8e, 0x57, 0xf8,
-	0x97, 0x65, 0xf7, 0x3f, 0x33, 0xde, 0xfd, 0x5b, 0x2e, 0x3d, 0x1a, 0x56, 0x4e, 0x8f, 0xab, 0xa1,
-	0x6a, 0xd9, 0x98, 0x64, 0xf4, 0x15, 0x38, 0x1f, 0xf9, 0xd3, 0x73, 0xf5, 0x7d, 0xaf, 0x6b, 0xe9,
-	0x3e, 0xbe, 0xa7, 0x9b, 0x5e, 0xdf, 0xa5, 0xc5, 0x45, 0x16, 0x62, 0xed, 0x5c, 0xc8, 0x72, 0xdb,
-	0xbd, 0xe9, 0x75, 0x2d, 0x0d, 0xdf, 0xdb, 0x60, 0xdb, 0xe8, 0x15, 0xc8, 0x45, 0x68, 0xdb, 0x2a,
-	0x2e, 0x55, 0x95, 0xb5, 0x94, 0x96, 0x0d, 0x69, 0x2d, 0xeb, 0x5a, 0xee, 0xe1, 0x93, 0xca, 0x8c,
-	0xec, 0x5d, 0x33, 0xea, 0x15, 0xc8, 0xed, 0x19, 0x5d, 0xd9, 0x73, 0x30, 0x41, 0x17, 0x20, 0x63,
-	0x04, 0x8b, 0xa2, 0x52, 0x9d, 0x5b, 0xcb, 0x68, 0x11, 0x41, 0xf4, 0xbc, 0xef, 0xff, 0xb5, 0xaa,
-	0xa8, 0x1f, 0x28, 0x90, 0x6e, 0xee, 0x6d, 0x1b, 0xb6, 0x8f, 0x5a, 0xb0, 0x1c, 0xd5, 0xc9, 0x68,
-	0xc7, 0xbb, 0x70, 0x34, 0xac, 0x14, 0x93, 0xa5, 0x14, 0xb6, 0xbc, 0xa8, 0x44, 0x83, 0x9e, 0xd7,
-	0x82, 0xe5, 0xfb, 0x41, 0x23, 0x0d, 0x45, 0xcd, 0x26, 0x45, 0x8d, 0xb1, 0xa8, 0x5a, 0x21, 0xa4,
-	0x49, 0x51, 0x09, 0x33, 0xd7, 0x61, 0x5e, 0x68, 0x4b, 0xd0, 0x15, 0x38, 0xd5, 0x63, 0x3f, 0xb8,
-	0x75, 0x2c, 0xcf, 0x27, 0xd5, 0x1e, 0xe7, 0x95, 0x19, 0x28, 0xd8, 0xd5, 0x1f, 0xcd, 0x02, 0x34,
-	0xf7, 0xf6, 0x76, 0x7d, 0xbb, 0xd7, 0xc5, 0xf4, 0xd3, 0xb4, 0x7a, 0x17, 0xce, 0x44, 0x26, 0x11,
-	0xdf, 0x4c, 0x58, 0x5e, 0x3d, 0x1a, 0x56, 0x2e, 0x24, 0x2d, 0x8f, 0xb1, 0xa9, 0xda, 0xe9, 0x90,
-	0xbe, 0xe3, 0x9b, 0x13, 0xa5, 0x5a, 0x84, 0x86, 0x52, 0xe7, 0x3e, 0x59, 0x6a, 0x8c, 0x2d, 0x2e,
-	0xb5, 0x49, 0xe8, 0x64, 0xb7, 0xde, 0x86, 0x6c, 0xe4, 0x12, 0x82, 0xde, 0x84, 0x05, 0x2a, 0x7f,
-	0x4b, 0xef, 0x96, 0x27, 0x7b, 0x37, 0x80, 0x48, 0x0f, 0x87, 0x28, 0xf5, 0x03, 0xe6, 0x64, 0xe1,
-	0x1f, 0x56, 0xf0, 0x9f, 0xcb, 0xd4, 0x42, 0xdf, 0x83, 0xb4, 0x1c, 0x29, 0x73, 0xc7, 0x18, 0x29,
-	0xcd, 0xd1, 0xb1, 0xf6, 0x92, 0x83, 0x44, 0x9e, 0x98, 0xf0, 0xff, 0x0f, 0x67, 0xe1, 0xf4, 0x9d,
-	0xa0, 0xb6, 0x3f, 0xf7, 0x7e, 0xbb, 0x05, 0xf3, 0xd8, 0xa5, 0xbe, 0x1d, 0x3a, 0xee, 0xf5, 0x49,
-	0xd9, 0x31, 0xc1, 0x9e, 0x4d, 0x97, 0xfa, 0x03, 0x99, 0x2b, 0x81, 0x88, 0x84, 0x27, 0x7e, 0x97,
-	0x82, 0xe2, 0x27, 0x21, 0xd1, 0x06, 0xe4, 0x4d, 0x1f, 0x73, 0x42, 0x30, 0x2a, 0x15, 0x3e, 0x2a,
-	0x4b, 0xd1, 0xa7, 0x78, 0x82, 0x41, 0xd5, 0x96, 0x02, 0x8a, 0x1c, 0x94, 0x1d, 0x60, 0xdf, 0xca,
-	0x2c, 0x4d, 0x19, 0xd7, 0x31, 0x3f, 0x8e, 0x55, 0x19, 0xfc, 0xe0, 0x90, 0x51, 0x01, 0x62, 0x54,
-	0x2e, 0x45, 0x54, 0x3e, 0x2b, 0xdf, 0x55, 0x20, 0x2f, 0xfc, 0xd2, 0xd5, 0xdb, 0x46, 0xd7, 0x70,
-	0x4d, 0x2c, 0xfd, 0xf5, 0x82, 0x39, 0xf7, 0xd6, 0xe8, 0x41, 0x09, 0xfc, 0xc9, 0x26, 0xdd, 0x92,
-	0x44, 0x37, 0x04, 0x18, 0x3d, 0x80, 0xf9, 0x40, 0x8f, 0xd4, 0x34, 0x3d, 0x1a, 0x52, 0x8f, 0x25,
-	0xa1, 0xc7, 0x4b, 0x9d, 0x1f, 0x9c, 0x36, 0x36, 0xcd, 0x4e, 0x8d, 0x4d, 0xb3, 0x69, 0xe3, 0x32,
-	0xfd, 0xc2, 0x71, 0x19, 0xfb, 0x86, 0xff, 0x7d, 0x0a, 0x96, 0x35, 0x6c, 0xfd, 0x2f, 0x71, 0x3e,
-	0xcd, 0xc4, 0xf9, 0x81, 0x02, 0x20, 0xfa, 0x16, 0x9b, 0x2c, 0x32, 0x79, 0x5e, 0xdc, 0x2d, 0x83,
-	0xef, 0xb5, 0xe5, 0x78, 0xb7, 0x64, 0xe8, 0x13, 0x77, 0xcc, 0x8c, 0xc0, 0x36, 0x09, 0xfd, 0x2c,
-	0xf3, 0xe8, 0xa3, 0x59, 0xc8, 0xc5, 0xf3, 0xe8, 0xbf, 0xf4, 0x3b, 0x01, 0x6d, 0x46, 0xbd, 0x5e,
-	0x84, 0xfd, 0xff, 0x27, 0xf5, 0xfa, 0xb1, 0x8a, 0x7b, 0x71, 0x93, 0xff, 0x65, 0x0a, 0xd2, 0xdb,
-	0x86, 0x6f, 0x38, 0x04, 0x99, 0x63, 0xd7, 0x16, 0xf1, 0xfc, 0xb1, 0x3a, 0x56, 0x53, 0x4d, 0xf9,
-	0x30, 0x35, 0xe5, 0xd6, 0xf2, 0xfe, 0x84, 0x5b, 0xcb, 0x9b, 0xb0, 0xe4, 0x18, 0x87, 0x7a, 0x68,
-	0x9f, 0xf0, 0xf4, 0x62, 0x63, 0x35, 0x92, 0x32, 0xba, 0x2f, 0x1e, 0x70, 0xc2, 0xb7, 0x00, 0x82,
-	0xae, 0x42, 0x96, 0x71, 0x44, 0x63, 0x8f, 0xc1, 0xcf, 0x46, 0xaf, 0x25, 0xb1, 0x4d, 0x55, 0x03,
-	0xc7, 0x38, 0xdc, 0x14, 0x0b, 0x74, 0x0b, 0xd0, 0xbe, 0x4d, 0xa8, 0xe7, 0xdb, 0xa6, 0xd1, 0xd5,
-	0x23, 0x57, 0x32, 0xfc, 0xff, 0x1d, 0x0d, 0x2b, 0xab, 0x02, 0x3f, 0xce, 0xa3, 0x6a, 0xcb, 0x11,
-	0x31, 0x90, 0x76, 0x15, 0xb2, 0xcc, 0x2e, 0xdd, 0xc2, 0xae, 0xe7, 0x88, 0xab, 0x78, 0x26, 0xae,
-	0x46, 0x6c, 0x53, 0xd5, 0x80, 0xad, 0x9a, 0x7c, 0x81, 0x36, 0xa1, 0xe0, 0xd8, 0xae, 0x3e, 0x72,
-	0xd5, 0x61, 0xb5, 0x90, 0x8a, 0xdf, 0x32, 0x93, 0x1c, 0xaa, 0xb6, 0xe4, 0xd8, 0xee, 0x5e, 0xec,
-	0x52, 0xf3, 0x5d, 0x38, 0xcd, 0x98, 0x12, 0x4f, 0x56, 0xfc, 0xf6, 0x9b, 0x11, 0xf7, 0xfe, 0xe9,
-	0x4f, 0x66, 0xa5, 0xe8, 0xb0, 0x84, 0x1c, 0x55, 0x5b, 0x76, 0x6c, 0x77, 0xf4, 0x8d, 0x2b, 0x56,
-	0x92, 0x7f, 0x52, 0x00, 0x45, 0x5f, 0x04, 0x1a, 0x26, 0x3d, 0xcf, 0x25, 0xfc, 0x4a, 0x1a, 0x25,
-	0x9f, 0xcc, 0x9e, 0xc9, 0x9f, 0xab, 0x21, 0x57, 0x70, 0x25, 0x8d, 0x95, 0x37, 0x8e, 0x66, 0xe3,
-	0xec, 0xb4, 0x56, 0xfb, 0x25, 0x86, 0x7e, 0xa9, 0x49, 0x18, 0x5a, 0x33, 0xa3, 0xfe, 0x5d, 0x81,
-	0xd5, 0xb1, 0xb2, 0x09, 0x8d, 0x7a, 0x07, 0x90, 0x1f, 0xdb, 0xe4, 0x49, 0x31, 0x90, 0xc6, 0x9d,
-	0xa8, 0x02, 0x97, 0xfd, 0xb1, 0x61, 0xf8, 0x19, 0x99, 0x9a, 0xe2, 0x41, 0xfb, 0x8d, 0x02, 0x2b,
-	0x71, 0xdd, 0x42, 0x0b, 0xdf, 0x82, 0x5c, 0x5c, 0x35, 0x69, 0x5b, 0x75, 0x9a, 0x6d, 0xd2, 0xac,
-	0x11, 0x2c, 0x7a, 0x3b, 0x6a, 0x52, 0xc2, 0xa2, 0x8b, 0xc7, 0x72, 0x51, 0xa0, 0x4b, 0xb2, 0x59,
-	0xa5, 0x78, 0x80, 0xfe, 0x3d, 0x07, 0xa9, 0x6d, 0xcf, 0xeb, 0xa2, 0x9f, 0x2b, 0xb0, 0xec, 0x7a,
-	0x54, 0x67, 0xe5, 0x84, 0x2d, 0x5d, 0x3e, 0x83, 0x29, 0xd3, 0x5c, 0x67, 0x30, 0xa1, 0xff, 0x1c,
-	0x56, 0xc6, 0xb1, 0xd1, 0xb8, 0x18, 0xdb, 0x3a, 0xd9, 0x9c, 0xce, 0xbb, 0x1e, 0x6d, 0x70, 0xf8,
-	0xae, 0x78, 0x2f, 0xfb, 0x89, 0x02, 0x8b, 0xa3, 0x6a, 0x4e, 0x8d, 0xf0, 0x37, 0xa5, 0x9a, 0x8b,
-	0x49, 0x15, 0x57, 0xa2, 0x96, 0xf2, 0x92, 0xea, 0xe5, 0xda, 0x71, 0xdd, 0x7e, 0xaa, 0xc0, 0x4a,
-	0xbc, 0xbb, 0xe8, 0x0f, 0xf8, 0x67, 0xd5, 0xf1, 0x2e, 0x5f, 0x9a, 0xec, 0xf9, 0xe7, 0xc7, 0xdf,
-	0x5d, 0x02, 0x39, 0x27, 0xfe, 0xb0, 0x40, 0xb1, 0xe7, 0x9a, 0xbb, 0x42, 0xc6, 0xb5, 0x05, 0x96,
-	0xb4, 0xff, 0x7a, 0x52, 0x51, 0x5e, 0xfb, 0xb5, 0x02, 0x10, 0xbd, 0x83, 0xa2, 0xd7, 0xe1, 0x5c,
-	0xe3, 0xf6, 0x56, 0x53, 0xdf, 0xd9, 0x5d, 0xdf, 0xbd, 0xb3, 0xa3, 0xdf, 0xd9, 0xda, 0xd9, 0xde,
-	0xdc, 0x68, 0x5d, 0x6f, 0x6d, 0x36, 0x0b, 0x33, 0xa5, 0xfc, 0xa3, 0xc7, 0xd5, 0xec, 0x1d, 0x97,
-	0xf4, 0xb0, 0x69, 0xdf, 0xb3, 0xb1, 0x85, 0xbe, 0x00, 0x2b, 0xa3, 0xdc, 0x6c, 0xb5, 0xd9, 0x2c,
-	0x28, 0xa5, 0xdc, 0xa3, 0xc7, 0xd5, 0x05, 0x71, 0xc1, 0xc1, 0x16, 0x5a, 0x83, 0x33, 0xe3, 0x7c,
-	0xad, 0xad, 0x1b, 0x85, 0xd9, 0xd2, 0xe2, 0xa3, 0xc7, 0xd5, 0x4c, 0x78, 0x13, 0x42, 0x2a, 0xa0,
-	0x38, 0xa7, 0x94, 0x37, 0x57, 0x82, 0x47, 0x8f, 0xab, 0x69, 0x91, 0x03, 0xa5, 0xd4, 0xc3, 0x9f,
-	0x95, 0x67, 0x5e, 0xfb, 0x36, 0x40, 0xcb, 0xbd, 0xe7, 0x1b, 0x26, 0x2f, 0x8d, 0x12, 0x9c, 0x6d,
-	0x6d, 0x5d, 0xd7, 0xd6, 0x37, 0x76, 0x5b, 0xb7, 0xb7, 0x46, 0xd5, 0x4e, 0xec, 0x35, 0x6f, 0xdf,
-	0x69, 0xdc, 0xda, 0xd4, 0x77, 0x5a, 0x37, 0xb6, 0x0a, 0x0a, 0x3a, 0x07, 0xa7, 0x47, 0xf6, 0xee,
-	0x6e, 0xed, 0xb6, 0xde, 0xde, 0x2c, 0xcc, 0x36, 0x6e, 0x7e, 0xf8, 0xac, 0xac, 0x3c, 0x7d, 0x56,
-	0x56, 0xfe, 0xf6, 0xac, 0xac, 0xbc, 0xf7, 0xbc, 0x3c, 0xf3, 0xf4, 0x79, 0x79, 0xe6, 0x2f, 0xcf,
-	0xcb, 0x33, 0xef, 0xd4, 0x62, 0xce, 0x17, 0xe5, 0x77, 0xb1, 0x6b, 0xb4, 0x89, 0xfc, 0x5d, 0x3f,
-	0x8c, 0xfe, 0x0a, 0xc6, 0x03, 0xd1, 0x4e, 0xf3, 0xd1, 0xfe, 0xc6, 0x7f, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x78, 0x69, 0xc3, 0x60, 0x25, 0x1b, 0x00, 0x00,
+	// 2443 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x3a, 0x4d, 0x6c, 0x5b, 0x59,
+	0xd5, 0x79, 0xb1, 0x9b, 0x38, 0xc7, 0x71, 0x9c, 0xdc, 0xa6, 0xad, 0xe3, 0x66, 0x6c, 0xcf, 0x9b,
+	0xef, 0x1b, 0x45, 0x33, 0x53, 0x9b, 0x76, 0x50, 0x07, 0x45, 0x20, 0x4d, 0x1c, 0xa7, 0x53, 0x4f,
+	0x3b, 0x69, 0x78, 0x49, 0x53, 0x18, 0x34, 0xb2, 0x9e, 0xdf, 0xbb, 0x71, 0x1e, 0xb1, 0xdf, 0x33,
+	0xef, 0x5e, 0x27, 0x35, 0x2b, 0x04, 0x42, 0x54, 0x5d, 0x8c, 0x8a, 0x84, 0xc4, 0xb0, 0xa8, 0x54,
+	0x0d, 0x1b, 0x84, 0x66, 0x39, 0x82, 0x15, 0x12, 0x1b, 0xa4, 0x01, 0x84, 0x54, 0x01, 0x0b, 0x34,
+	0x42, 0x19, 0xd4, 0xb2, 0x40, 0xac, 0x50, 0x16, 0xac, 0x58, 0xa0, 0xfb, 0xf3, 0x7e, 0xfc, 0xec,
+	0xd4, 0x4e, 0xa9, 0x46, 0x23, 0xc1, 0xa6, 0x7d, 0xf7, 0xdc, 0x73, 0xce, 0x3d, 0xe7, 0xdc, 0xf3,
+	0x77, 0x8f, 0x03, 0x05, 0xcb, 0xb6, 0xa8, 0xa5, 0x97, 0x5a, 0x84, 0xea, 0x7b, 0x96, 0xdd, 0x28,
+	0xed, 0x5f, 0x2c, 0xc9, 0xcf, 0x62, 0xdb, 0x75, 0xa8, 0x83, 0x90, 0xc0, 0x28, 0x7a, 0x18, 0xc5,
+	0xfd, 0x8b, 0xd9, 0x9c, 0xe1, 0x90, 0x96, 0x43, 0x4a, 0x75, 0x9d, 0xe0, 0xd2, 0xfe, 0xc5, 0x3a,
+	0xa6, 0xfa, 0xc5, 0x92, 0xe1, 0x58, 0xb6, 0xa0, 0xc9, 0x2e, 0x88, 0xfd, 0x1a, 0x5f, 0x95, 0xc4,
+	0x42, 0x6e, 0xcd, 0x37, 0x9c, 0x86, 0x23, 0xe0, 0xec, 0xcb, 0x23, 0x68, 0x38, 0x4e, 0xa3, 0x89,
+	0x4b, 0x7c, 0x55, 0xef, 0xec, 0x94, 0x74, 0xbb, 0x2b, 0xb7, 0x72, 0xd1, 0x2d, 0xb3, 0xe3, 0xea,
+	0xd4, 0x72, 0xbc, 0xb3, 0xf2, 0xd1, 0x7d, 0x6a, 0xb5, 0x30, 0xa1, 0x7a, 0xab, 0x2d, 0x11, 0x16,
+	0x29, 0xb6, 0x4d, 0xec, 0xb6, 0x2c, 0x9b, 0x96, 0x68, 0xb7, 0x8d, 0x89, 0xf8, 0x57, 0xec, 0xaa,
+	0xef, 0x8e, 0x43, 0x7a, 0xd5, 0x69, 0xb5, 0x2c, 0x42, 0x2c, 0xc7, 0xd6, 0x74, 0x8a, 0x09, 0x7a,
+	0x0d, 0xe2, 0xae, 0x4e, 0x71, 0x46, 0x29, 0x28, 0x4b, 0x53, 0xe5, 0x17, 0x3e, 0x3a, 0xcc, 0x8f,
+	0x7d, 0x7c, 0x98, 0x3f, 0x2f, 0xf4, 0x20, 0xe6, 0x5e, 0xd1, 0x72, 0x4a, 0x2d, 0x9d, 0xee, 0x16,
+	0xaf, 0xe3, 0x86, 0x6e, 0x74, 0x2b, 0xd8, 0xd0, 0x38, 0x01, 0xfa, 0x32, 0x24, 0x5a, 0xfa, 0xed,
+	0x1a, 0x27, 0x1e, 0xe7, 0xc4, 0x97, 0x47, 0x20, 0x3e, 0x3a, 0xcc, 0xa7, 0xbb, 0x7a, 0xab, 0xb9,
+	0xac, 0x7a, 0xc4, 0xaa, 0x36, 0xd9, 0xd2, 0x6f, 0x33, 0x61, 0x10, 0x86, 0x34, 0x83, 0x1a, 0xbb,
+	0xba, 0xdd, 0xc0, 0x82, 0x73, 0x8c, 0x73, 0xfe, 0xd2, 0x68, 0x9c, 0xcf, 0x06, 0x9c, 0x43, 0x3c,
+	0x54, 0x2d, 0xd5, 0xd2, 0x6f, 0xaf, 0x72, 0x00, 0x3b, 0x66, 0x39, 0xf1, 0xde, 0x83, 0xfc, 0xd8,
+	0xdf, 0x1e, 0xe4, 0x15, 0xf5, 0x77, 0x0a, 0x40, 0x60, 0x10, 0xf4, 0x15, 0x98, 0x35, 0xfc, 0x15,
+	0xa7, 0x25, 0xdc, 0x2e, 0xc9, 0x4b, 0x2f, 0x14, 0xfb, 0x3d, 0xa3, 0x18, 0x31, 0x65, 0x39, 0xc1,
+	0xa4, 0x7c, 0x78, 0x98, 0x57, 0xb4, 0xb4, 0x11, 0xb1, 0xf2, 0xd7, 0x20, 0xd9, 0x69, 0x9b, 0x3a,
+	0xc5, 0x35, 0x76, 0x63, 0xdc, 0x5e, 0xc9, 0x4b, 0xd9, 0xa2, 0xb8, 0xce, 0xa2, 0x77, 0x9d, 0xc5,
+	0x2d, 0xef, 0x3a, 0xcb, 0x39, 0xc6, 0xeb, 0xe8, 0x30, 0x8f, 0x84, 0x4a, 0x21, 0x62, 0xf5, 0xde,
+	0x27, 0x79, 0x45, 0x03, 0x01, 0x61, 0x04, 0x21, 0x7d, 0x7e, 0xad, 0x40, 0xb2, 0x82, 0x89, 0xe1,
+	0x5a, 0x6d, 0xe6, 0x35, 0x28, 0x03, 0x93, 0x2d, 0xc7, 0xb6, 0xf6, 0xb0, 0x2b, 0xee, 0x57, 0xf3,
+	0x96, 0x28, 0x0b, 0x09, 0xcb, 0xc4, 0x36, 0xb5, 0x68, 0x57, 0xdc, 0x9e, 0xe6, 0xaf, 0x19, 0xd5,
+	0x01, 0xae, 0x13, 0xcb, 0x33, 0xbf, 0xe6, 0x2d, 0xd1, 0x15, 0x98, 0x25, 0xd8, 0xe8, 0xb8, 0x16,
+	0xed, 0xd6, 0x0c, 0xc7, 0xa6, 0xba, 0x41, 0x33, 0x71, 0x7e, 0x43, 0xe7, 0x8f, 0x0e, 0xf3, 0xe7,
+	0x84, 0xac, 0x51, 0x0c, 0x55, 0x4b, 0x7b, 0xa0, 0x55, 0x01, 0x61, 0x27, 0x98, 0x98, 0xea, 0x56,
+	0x93, 0x64, 0x4e, 0x89, 0x13, 0xe4, 0x32, 0xa4, 0xcb, 0x1f, 0xa6, 0x60, 0x6a, 0x5b, 0x6f, 0x5a,
+	0xa6, 0x4e, 0x1d, 0x97, 0x9d, 0xec, 0xb4, 0xb1, 0xcb, 0xbe, 0x6b, 0xba, 0x69, 0xba, 0x98, 0x90,
+	0x8c, 0x12, 0x3d, 0x39, 0x8a, 0xa1, 0x6a, 0x69, 0x0f, 0xb4, 0x22, 0x20, 0x88, 0xb2, 0x2b, 0xb6,
+	0x09, 0xb6, 0x49, 0x87, 0xd4, 0xda, 0x9d, 0xfa, 0x1e, 0xee, 0xca, 0xdb, 0x98, 0xef, 0xbb, 0x8d,
+	0x15, 0xbb, 0x5b, 0x7e, 0x35, 0xe0, 0x1e, 0xa5, 0x53, 0x7f, 0xf3, 0xe1, 0x85, 0x79, 0x19, 0xef,
+	0x86, 0xdb, 0x6d, 0x53, 0xa7, 0xb8, 0xd1, 0xa9, 0x5f, 0xc3, 0x5d, 0x2d, 0xed, 0xa3, 0x6e, 0x70,
+	0x4c, 0x74, 0x16, 0x26, 0xbe, 0xae, 0x5b, 0x4d, 0x6c, 0x72, 0x83, 0x26, 0x34, 0xb9, 0x42, 0x97,
+	0x61, 0x82, 0x50, 0x9d, 0x76, 0x08, 0xb7, 0xe2, 0xcc, 0xa5, 0xdc, 0x20, 0x37, 0x2b, 0x3b, 0xb6,
+	0xb9, 0xc9, 0xb1, 0x34, 0x89, 0x8d, 0x28, 0x4c, 0x50, 0x67, 0x0f, 0xdb, 0xcc, 0x7c, 0xb1, 0xa5,
+	0xe4, 0xa5, 0x85, 0xa2, 0x94, 0x83, 0x25, 0xa9, 0xa2, 0x4c, 0x52, 0xc5, 0x55, 0xc7, 0xb2, 0xcb,
+	0x2b, 0xd2, 0x91, 0x52, 0x42, 0x09, 0x41, 0xa6, 0xfe, 0xf4, 0x93, 0xfc, 0x52, 0xc3, 0xa2, 0xbb,
+	0x9d, 0x7a, 0xd1, 0x70, 0x5a, 0x32, 0x6b, 0xc9, 0xff, 0x2e, 0x10, 0x73, 0x4f, 0xa6, 0x0d, 0xc6,
+	0x81, 0x68, 0xf2, 0x2c, 0xf4, 0x43, 0x05, 0x66, 0x4d, 0xdc, 0xc4, 0x0d, 0x6e, 0x63, 0xb2, 0xab,
+	0xbb, 0x98, 0x64, 0x26, 0xb8, 0x00, 0x8b, 0x03, 0x05, 0xa8, 0x60, 0x83, 0xcb, 0xb0, 0x2e, 0x65,
+	0x90, 0x86, 0x8c, 0xf2, 0x60, 0xd2, 0xbc, 0x3c, 0x82, 0x34, 0x92, 0x1d, 0xd1, 0xd2, 0x3e, 0x87,
+	0x4d, 0xce, 0x00, 0xbd, 0x01, 0x49, 0x33, 0x70, 0xfb, 0xcc, 0x24, 0xbf, 0xd0, 0xfc, 0x20, 0x63,
+	0x86, 0xa2, 0xa3, 0x1c, 0x67, 0x62, 0x69, 0x61, 0x4a, 0xe6, 0x66, 0x1d, 0xbb, 0xee, 0xd8, 0xa6,
+	0x65, 0x37, 0x6a, 0xbb, 0xd8, 0x6a, 0xec, 0xd2, 0x4c, 0xa2, 0xa0, 0x2c, 0xc5, 0xc2, 0x6e, 0x16,
+	0xc5, 0x50, 0xb5, 0xb4, 0x0f, 0xba, 0xca, 0x21, 0xc8, 0x84, 0x99, 0x00, 0x8b, 0x87, 0xfc, 0xd4,
+	0xd0, 0x90, 0x7f, 0x5e, 0x5a, 0xe9, 0x4c, 0xf4, 0x94, 0x20, 0xea, 0x53, 0x3e, 0x90, 0x91, 0xa1,
+	0x0a, 0x40, 0x90, 0x68, 0x32, 0xc0, 0x4f, 0xc8, 0x3d, 0x39, 0x53, 0x49, 0xa5, 0x43, 0x74, 0xe8,
+	0x8e, 0x02, 0xa9, 0x7d, 0x87, 0xb2, 0x93, 0xda, 0xce, 0x01, 0x76, 0x49, 0x26, 0x39, 0xcc, 0xa9,
+	0xae, 0x4a, 0x51, 0xe7, 0x85, 0xa8, 0x3d, 0xd4, 0x27, 0xf3, 0xad, 0x69, 0x41, 0xbb, 0xc1, 0x49,
+	0xd1, 0x2d, 0x98, 0x0e, 0xf3, 0xca, 0x4c, 0xf3, 0x08, 0xff, 0xbc, 0xcc, 0xfe, 0x67, 0xfa, 0xb3,
+	0x7f, 0xd5, 0xa6, 0x47, 0x87, 0xf9, 0xd3, 0xfd, 0x62, 0xa8, 0x5a, 0x32, 0xc4, 0x19, 0x7d, 0x11,
+	0xce, 0x07, 0xf6, 0x74, 0xec, 0xda, 0xae, 0xd3, 0x34, 0x6b, 0x2e, 0xde, 0xa9, 0x19, 0x4e, 0xc7,
+	0xa6, 0x99, 0x14, 0xbb, 0x62, 0xed, 0x9c, 0x8f, 0x72, 0xc3, 0xbe, 0xea, 0x34, 0x4d, 0x0d, 0xef,
+	0xac, 0xb2, 0x6d, 0xf4, 0x3c, 0x4c, 0x07, 0xd4, 0x96, 0x99, 0x99, 0x29, 0x28, 0x4b, 0x71, 0x2d,
+	0xe9, 0xc3, 0xaa, 0x26, 0xcb, 0x68, 0x2e, 0xa6, 0x96, 0x8b, 0xcd, 0x4c, 0x9a, 0x87, 0xb8, 0xb7,
+	0x5c, 0x9e, 0xbe, 0xf3, 0x20, 0x3f, 0x26, 0xb3, 0xda, 0x98, 0x7a, 0x19, 0xa6, 0xb7, 0xf5, 0xa6,
+	0xcc, 0x46, 0x98, 0xa0, 0x45, 0x98, 0xd2, 0xbd, 0x45, 0x46, 0x29, 0xc4, 0x96, 0xa6, 0xb4, 0x00,
+	0x20, 0xb2, 0xe1, 0xb7, 0xfe, 0x5c, 0x50, 0xd4, 0x0f, 0x14, 0x98, 0xa8, 0x6c, 0x6f, 0xe8, 0x96,
+	0x8b, 0xaa, 0x30, 0x17, 0x44, 0x50, 0x6f, 0x2e, 0x5c, 0x3c, 0x3a, 0xcc, 0x67, 0xa2, 0x41, 0xe6,
+	0x27, 0xc3, 0x20, 0x78, 0xbd, 0x6c, 0x58, 0x85, 0xb9, 0x7d, 0x2f, 0xc5, 0xfa, 0xac, 0xc6, 0xa3,
+	0xac, 0xfa, 0x50, 0x54, 0x6d, 0xd6, 0x87, 0x49, 0x56, 0x11, 0x35, 0x57, 0x60, 0x52, 0x48, 0x4b,
+	0xd0, 0x65, 0x38, 0xd5, 0x66, 0x1f, 0x5c, 0x3b, 0x16, 0x01, 0x83, 0xa2, 0x92, 0xe3, 0x4a, 0xdf,
+	0x14, 0xe8, 0xea, 0xf7, 0xc7, 0x01, 0x2a, 0xdb, 0xdb, 0x5b, 0xae, 0xd5, 0x6e, 0x62, 0xfa, 0x2c,
+	0xb5, 0xde, 0x82, 0x33, 0x81, 0x4a, 0xc4, 0x35, 0x22, 0x9a, 0x17, 0x8e, 0x0e, 0xf3, 0x8b, 0x51,
+	0xcd, 0x43, 0x68, 0xaa, 0x76, 0xda, 0x87, 0x6f, 0xba, 0xc6, 0x40, 0xae, 0x26, 0xa1, 0x3e, 0xd7,
+	0xd8, 0xf1, 0x5c, 0x43, 0x68, 0x61, 0xae, 0x15, 0x42, 0x07, 0x9b, 0xf5, 0x06, 0x24, 0x03, 0x93,
+	0x10, 0xf4, 0x3a, 0x24, 0xa8, 0xfc, 0x96, 0xd6, 0xcd, 0x0d, 0xb6, 0xae, 0x47, 0x22, 0x2d, 0xec,
+	0x53, 0xa9, 0x1f, 0x30, 0x23, 0x0b, 0xfb, 0xb0, 0x54, 0xf0, 0x99, 0x74, 0x2d, 0xf4, 0x4d, 0x98,
+	0x90, 0xc5, 0x26, 0x36, 0x42, 0xb1, 0xa9, 0xf4, 0x16, 0xbc, 0xa7, 0x2c, 0x31, 0xf2, 0xc4, 0x88,
+	0xfd, 0xbf, 0x37, 0x0e, 0xa7, 0x6f, 0x7a, 0x51, 0xff, 0x99, 0xb7, 0xdb, 0x75, 0x98, 0xc4, 0x36,
+	0x75, 0x2d, 0xdf, 0x70, 0xaf, 0x0c, 0xf2, 0x8e, 0x01, 0xfa, 0xac, 0xd9, 0xd4, 0xed, 0x4a, 0x5f,
+	0xf1, 0x58, 0x44, 0x2c, 0xf1, 0x8b, 0x38, 0x64, 0x8e, 0xa3, 0x44, 0xab, 0x90, 0x36, 0x5c, 0xcc,
+	0x01, 0x5e, 0x11, 0x55, 0x78, 0x11, 0xcd, 0x06, 0x4d, 0x7a, 0x04, 0x41, 0xd5, 0x66, 0x3c, 0x88,
+	0x2c, 0xa1, 0x0d, 0x60, 0x5d, 0x34, 0x73, 0x53, 0x86, 0x35, 0x62, 0xdb, 0xac, 0xca, 0xcb, 0xf7,
+	0x0e, 0xe9, 0x65, 0x20, 0x8a, 0xe8, 0x4c, 0x00, 0xe5, 0x55, 0xf4, 0x5d, 0x05, 0xd2, 0xc2, 0x2e,
+	0xcd, 0x5a, 0x5d, 0x6f, 0xea, 0xb6, 0x81, 0x33, 0xb1, 0x61, 0x15, 0xf0, 0xcd, 0xde, 0x83, 0x22,
+	0xf4, 0x27, 0xab, 0x81, 0x33, 0x92, 0xba, 0x2c, 0x88, 0xd1, 0x01, 0x4c, 0x7a, 0x72, 0xc4, 0x87,
+	0xc9, 0x51, 0x96, 0x72, 0xcc, 0x08, 0x39, 0x9e, 0xea, 0x7c, 0xef, 0xb4, 0xbe, 0x3a, 0x77, 0xaa,
+	0xbf, 0xce, 0x0d, 0x29, 0xa4, 0x13, 0x4f, 0x2c, 0xa4, 0xa1, 0xee, 0xfe, 0x57, 0x71, 0x98, 0xd3,
+	0xb0, 0xf9, 0x3f, 0xc7, 0x79, 0x96, 0x8e, 0xf3, 0x5d, 0x05, 0x40, 0xe4, 0x2d, 0x56, 0x59, 0x32,
+	0xf1, 0x11, 0xb2, 0xa5, 0xd7, 0xc9, 0xcd, 0x85, 0xb3, 0x25, 0xa3, 0x3e, 0x71, 0xc6, 0x9c, 0x12,
+	0xb4, 0x15, 0x42, 0x3f, 0x4d, 0x3f, 0xfa, 0x78, 0x1c, 0xa6, 0xc3, 0x7e, 0xf4, 0x5f, 0xda, 0x27,
+	0xa0, 0xb5, 0x20, 0xd7, 0x8b, 0x6b, 0xff, 0xff, 0x41, 0xb9, 0xbe, 0x2f, 0xe2, 0x9e, 0x9c, 0xe4,
+	0xbf, 0x9d, 0x80, 0x89, 0x0d, 0xdd, 0xd5, 0x5b, 0x04, 0x19, 0x7d, 0x0f, 0x1a, 0x31, 0x18, 0x59,
+	0xe8, 0x8b, 0xa9, 0x8a, 0x1c, 0x59, 0x0d, 0x79, 0xcf, 0xbc, 0x37, 0xe0, 0x3d, 0xf3, 0x3a, 0xcc,
+	0xb0, 0xd9, 0x8d, 0xaf, 0x9f, 0xb0, 0x74, 0xaa, 0xbc, 0x10, 0x70, 0xe9, 0xdd, 0x17, 0xa3, 0x1d,
+	0x7f, 0x4a, 0xc0, 0xa6, 0x59, 0x49, 0x86, 0x11, 0x94, 0x3d, 0x46, 0x7e, 0x36, 0x98, 0xa3, 0x84,
+	0x36, 0x55, 0x0d, 0x5a, 0xfa, 0xed, 0x35, 0xb1, 0x40, 0xd7, 0x01, 0xed, 0x5a, 0x84, 0x3a, 0xae,
+	0x65, 0xe8, 0xcd, 0x5a, 0x60, 0x4a, 0x46, 0xff, 0xdc, 0xd1, 0x61, 0x7e, 0x41, 0xd0, 0xf7, 0xe3,
+	0xa8, 0xda, 0x5c, 0x00, 0xf4, 0xb8, 0xbd, 0x06, 0x49, 0xa6, 0x57, 0xcd, 0xc4, 0xb6, 0xd3, 0x12,
+	0x8f, 0xf4, 0xa9, 0xb0, 0x18, 0xa1, 0x4d, 0x55, 0x03, 0xb6, 0xaa, 0xf0, 0x05, 0x5a, 0x83, 0xd9,
+	0x96, 0x65, 0xd7, 0x7a, 0x1e, 0x41, 0x2c, 0x16, 0xe2, 0xe1, 0xf7, 0x67, 0x14, 0x43, 0xd5, 0x66,
+	0x5a, 0x96, 0xbd, 0x1d, 0x7a, 0xee, 0x7c, 0x03, 0x4e, 0x33, 0xa4, 0xc8, 0x30, 0x8b, 0xbf, 0x8b,
+	0xa7, 0xca, 0x2b, 0xa3, 0x0d, 0xd3, 0xb2, 0xc1, 0x61, 0x11, 0x3e, 0xaa, 0x36, 0xd7, 0xb2, 0xec,
+	0xde, 0xe9, 0x17, 0xba, 0xa7, 0xc0, 0xec, 0x1e, 0xee, 0xd6, 0x5c, 0x87, 0x8a, 0xf4, 0xbc, 0x83,
+	0x71, 0x26, 0x31, 0x2c, 0x1b, 0x5e, 0xeb, 0x9d, 0x0c, 0x44, 0x19, 0x9c, 0x30, 0x1d, 0xee, 0xe1,
+	0xae, 0x26, 0xa9, 0xaf, 0x60, 0x8c, 0xde, 0x57, 0xe0, 0xff, 0xc2, 0x76, 0xaa, 0x1d, 0xf0, 0x02,
+	0xe1, 0xcd, 0x06, 0xe9, 0xae, 0x8b, 0x09, 0xcb, 0x3b, 0xfc, 0x6d, 0x3e, 0x55, 0xd6, 0x46, 0xb3,
+	0xcb, 0xcb, 0xfd, 0x8f, 0xcd, 0xe3, 0x18, 0xab, 0x5a, 0x21, 0xf4, 0x08, 0xbd, 0xc5, 0x91, 0xc4,
+	0x1c, 0x72, 0xcb, 0x43, 0x41, 0x3f, 0x50, 0x20, 0x1f, 0xb2, 0xaf, 0x64, 0x61, 0x3b, 0xd4, 0x32,
+	0x70, 0xad, 0x8d, 0x5d, 0xcb, 0x31, 0x33, 0x30, 0x2c, 0xd4, 0x2e, 0x49, 0x33, 0xbe, 0xe8, 0x57,
+	0xaf, 0x27, 0xf1, 0x13, 0xb1, 0xb7, 0x18, 0x60, 0x09, 0x99, 0xd6, 0x39, 0xce, 0x06, 0x47, 0x09,
+	0x65, 0xd8, 0x7f, 0x2a, 0xb0, 0x78, 0xd5, 0xf7, 0xf0, 0xed, 0xa8, 0x3e, 0x84, 0x0d, 0xb7, 0xc2,
+	0xb5, 0x5a, 0x93, 0x2b, 0xf4, 0x05, 0x88, 0x8f, 0x58, 0x7c, 0xf9, 0xe0, 0x94, 0x97, 0x58, 0x4e,
+	0x81, 0xbe, 0xa3, 0xc0, 0xfc, 0x00, 0xfb, 0x8e, 0xd6, 0xff, 0xbf, 0xca, 0x98, 0x9d, 0xb4, 0x78,
+	0xa1, 0xbe, 0x7b, 0x22, 0xea, 0x2f, 0xc7, 0xe1, 0xdc, 0x06, 0xe6, 0xd9, 0x69, 0x35, 0x62, 0x2a,
+	0xb4, 0x3e, 0xa8, 0x4b, 0x17, 0x55, 0xe6, 0xf9, 0xdf, 0x7f, 0x78, 0xe1, 0x39, 0x29, 0xe0, 0x76,
+	0xa4, 0x25, 0xdf, 0xa4, 0xae, 0x65, 0x37, 0x06, 0xb4, 0xea, 0xde, 0x14, 0x7e, 0xfc, 0xa4, 0x53,
+	0xf8, 0x2a, 0xa4, 0x88, 0xb1, 0x8b, 0xcd, 0x4e, 0x53, 0x8e, 0x96, 0x63, 0x27, 0xb0, 0xf6, 0xb4,
+	0x47, 0xca, 0x36, 0xd1, 0x35, 0x98, 0xc1, 0x3b, 0x3b, 0xd8, 0xa0, 0xd6, 0xbe, 0xe4, 0x15, 0x3f,
+	0x01, 0xaf, 0x94, 0x4f, 0xcb, 0x76, 0x97, 0xe3, 0xdc, 0x77, 0xfe, 0x18, 0x83, 0x85, 0x55, 0xc7,
+	0x26, 0x72, 0x2e, 0x2a, 0x63, 0x53, 0x78, 0x53, 0x17, 0x5d, 0x3f, 0x76, 0xa6, 0x3b, 0x82, 0x0d,
+	0xfb, 0x26, 0xbb, 0xdb, 0x90, 0x66, 0x3d, 0x04, 0x1b, 0xbd, 0x8e, 0x32, 0xd8, 0xcd, 0x1c, 0x3b,
+	0xbd, 0x4d, 0x39, 0x4d, 0x53, 0x0a, 0xcd, 0x66, 0xb7, 0xdb, 0x90, 0xb6, 0xf1, 0x41, 0x0f, 0xdf,
+	0xd8, 0xd3, 0xf1, 0xb5, 0xf1, 0x41, 0x88, 0x6f, 0x10, 0x36, 0xf1, 0x81, 0x61, 0x73, 0xea, 0xc4,
+	0x61, 0xf3, 0x0e, 0xc4, 0x58, 0xd2, 0x9d, 0x18, 0x96, 0x74, 0x3f, 0x27, 0x23, 0x64, 0xf4, 0xcc,
+	0xca, 0xf8, 0x2e, 0x27, 0xee, 0x78, 0x7d, 0xc1, 0x6f, 0x15, 0x40, 0xc1, 0x9b, 0x4f, 0xc3, 0xa4,
+	0xed, 0xd8, 0x84, 0x8f, 0x23, 0x83, 0xf6, 0x42, 0xf6, 0x07, 0x83, 0x07, 0x12, 0x3e, 0x96, 0x37,
+	0x8e, 0x0c, 0xe8, 0x10, 0x0e, 0x5e, 0x3f, 0xe3, 0xcf, 0x5e, 0x13, 0x8f, 0xb7, 0x9f, 0xe0, 0xc6,
+	0xd4, 0xbf, 0x2a, 0xb0, 0xd0, 0xd7, 0x18, 0xf9, 0x4a, 0xbd, 0x0d, 0xc8, 0x0d, 0x6d, 0xf2, 0xb2,
+	0xdf, 0x95, 0xca, 0x9d, 0xa8, 0xc7, 0x9a, 0x73, 0xa3, 0x1b, 0x9f, 0x96, 0xaa, 0x22, 0x16, 0x7f,
+	0xae, 0xc0, 0x7c, 0x58, 0x36, 0x5f, 0xc3, 0x37, 0x61, 0x3a, 0x2c, 0x9a, 0xd4, 0xad, 0x30, 0x4c,
+	0x37, 0xa9, 0x56, 0x0f, 0x2d, 0x7a, 0x2b, 0x68, 0x43, 0x85, 0x46, 0x17, 0x46, 0x32, 0x91, 0x27,
+	0x4b, 0xb4, 0x1d, 0x8d, 0xf3, 0x0b, 0xfa, 0x57, 0x0c, 0xe2, 0x1b, 0x8e, 0xd3, 0x44, 0x3f, 0x51,
+	0x60, 0xce, 0x76, 0x68, 0x8d, 0x35, 0x4c, 0xd8, 0xac, 0xc9, 0x9f, 0x40, 0x94, 0x61, 0xa6, 0xd3,
+	0x19, 0xd3, 0xbf, 0x1f, 0xe6, 0xfb, 0x69, 0x83, 0x07, 0x41, 0xdf, 0xd6, 0xc9, 0x5a, 0x8f, 0xb4,
+	0xed, 0xd0, 0x32, 0x27, 0xdf, 0xe2, 0xd4, 0xe8, 0x47, 0x0a, 0xa4, 0x7a, 0xc5, 0x1c, 0x7a, 0xc3,
+	0x5f, 0x95, 0x62, 0xa6, 0xa2, 0x22, 0xce, 0x07, 0x4d, 0xe3, 0x53, 0x8a, 0x37, 0x5d, 0x0f, 0xcb,
+	0xf6, 0xfe, 0x7f, 0x52, 0x5e, 0x35, 0xd9, 0x6a, 0x9c, 0x3f, 0xb6, 0x0d, 0x22, 0xea, 0x33, 0xa8,
+	0xbe, 0xcb, 0x09, 0xe6, 0xb4, 0xff, 0x78, 0x90, 0x57, 0x5e, 0xfa, 0x99, 0x02, 0x10, 0xfc, 0x06,
+	0x86, 0x5e, 0x81, 0x73, 0xe5, 0x1b, 0xeb, 0x95, 0xda, 0xe6, 0xd6, 0xca, 0xd6, 0xcd, 0xcd, 0xda,
+	0xcd, 0xf5, 0xcd, 0x8d, 0xb5, 0xd5, 0xea, 0x95, 0xea, 0x5a, 0x65, 0x76, 0x2c, 0x9b, 0xbe, 0x7b,
+	0xbf, 0x90, 0xbc, 0x69, 0x93, 0x36, 0x36, 0xac, 0x1d, 0x0b, 0x9b, 0xe8, 0x45, 0x98, 0xef, 0xc5,
+	0x66, 0xab, 0xb5, 0xca, 0xac, 0x92, 0x9d, 0xbe, 0x7b, 0xbf, 0x90, 0x10, 0x23, 0x2c, 0x6c, 0xa2,
+	0x25, 0x38, 0xd3, 0x8f, 0x57, 0x5d, 0x7f, 0x63, 0x76, 0x3c, 0x9b, 0xba, 0x7b, 0xbf, 0x30, 0xe5,
+	0xcf, 0xba, 0x90, 0x0a, 0x28, 0x8c, 0x29, 0xf9, 0xc5, 0xb2, 0x70, 0xf7, 0x7e, 0x61, 0x42, 0xf8,
+	0x40, 0x36, 0x7e, 0xe7, 0xc7, 0xb9, 0xb1, 0x97, 0xde, 0x01, 0xa8, 0xda, 0x3b, 0xae, 0x6e, 0xf0,
+	0xd0, 0xc8, 0xc2, 0xd9, 0xea, 0xfa, 0x15, 0x6d, 0x65, 0x75, 0xab, 0x7a, 0x63, 0xbd, 0x57, 0xec,
+	0xc8, 0x5e, 0xe5, 0xc6, 0xcd, 0xf2, 0xf5, 0xb5, 0xda, 0x66, 0xf5, 0x8d, 0xf5, 0x59, 0x05, 0x9d,
+	0x83, 0xd3, 0x3d, 0x7b, 0xb7, 0xd6, 0xb7, 0xaa, 0x6f, 0xad, 0xcd, 0x8e, 0x97, 0xaf, 0x7e, 0xf4,
+	0x28, 0xa7, 0x3c, 0x7c, 0x94, 0x53, 0xfe, 0xf2, 0x28, 0xa7, 0xdc, 0x7b, 0x9c, 0x1b, 0x7b, 0xf8,
+	0x38, 0x37, 0xf6, 0xa7, 0xc7, 0xb9, 0xb1, 0xb7, 0x8b, 0x21, 0xe3, 0x8b, 0xf0, 0xbb, 0xd0, 0xd4,
+	0xeb, 0x44, 0x7e, 0x97, 0x6e, 0x07, 0x7f, 0x01, 0xc1, 0x2f, 0xa2, 0x3e, 0xc1, 0x8b, 0xcb, 0xab,
+	0xff, 0x1e, 0x00, 0xd1, 0xd0, 0xca, 0x23, 0x21, 0x21, 0x00, 0x00,
 }
 
 func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
@@ -1363,539 +1595,622 @@ func (this *Pool) Description() (desc *github_com_cosmos_gogoproto_protoc_gen_go
 func StakingDescription() (desc *github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet) {
 	d := &github_com_cosmos_gogoproto_protoc_gen_gogo_descriptor.FileDescriptorSet{}
 	var gzipped = []byte{
-		// 8502 bytes of a gzipped FileDescriptorSet
-		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x7d, 0x7b, 0x90, 0x1c, 0xc7,
-		0x79, 0xdf, 0xed, 0xe3, 0xf6, 0x76, 0xbf, 0xdb, 0xdb, 0x9b, 0xeb, 0x3b, 0x00, 0x8b, 0x03, 0x79,
-		0x77, 0x5c, 0x8a, 0x24, 0x08, 0x92, 0x0b, 0x12, 0x24, 0x40, 0x72, 0x21, 0x4a, 0xda, 0x17, 0x0e,
-		0x0b, 0xde, 0x4b, 0xb3, 0x77, 0xe0, 0xc3, 0xe5, 0x9a, 0xcc, 0xcd, 0xf6, 0xed, 0x0d, 0xb1, 0x3b,
-		0x33, 0x9a, 0x99, 0x05, 0x70, 0xac, 0x54, 0x8a, 0x2e, 0xc5, 0xb1, 0xcc, 0x38, 0x8a, 0x14, 0xa7,
-		0xa2, 0x27, 0x14, 0x4a, 0x8a, 0x25, 0x45, 0x51, 0x12, 0xdb, 0x52, 0xa4, 0x28, 0x2e, 0xa7, 0x9c,
-		0xa4, 0x9c, 0xc8, 0x4e, 0x25, 0xa5, 0xe4, 0x8f, 0xc4, 0xe5, 0x4a, 0x60, 0x8b, 0x52, 0xc5, 0x8a,
-		0x2d, 0x27, 0x32, 0x43, 0xa7, 0xe2, 0x62, 0x92, 0x4a, 0xf5, 0x6b, 0x1e, 0xfb, 0xb8, 0xd9, 0x43,
-		0x44, 0x5a, 0x95, 0xf8, 0x1f, 0x60, 0xa7, 0xfb, 0xfb, 0xfd, 0xfa, 0xeb, 0xaf, 0xbf, 0xfe, 0xfa,
-		0xeb, 0x9e, 0xc7, 0xc1, 0x77, 0x2a, 0xb0, 0xd2, 0x36, 0xcd, 0x76, 0x07, 0x9f, 0xb5, 0x6c, 0xd3,
-		0x35, 0x77, 0x7b, 0x7b, 0x67, 0x5b, 0xd8, 0xd1, 0x6c, 0xdd, 0x72, 0x4d, 0xbb, 0x48, 0xcb, 0xd0,
-		0x2c, 0x93, 0x28, 0x0a, 0x89, 0xc2, 0x3a, 0xcc, 0x5d, 0xd2, 0x3b, 0xb8, 0xe6, 0x09, 0x36, 0xb1,
-		0x8b, 0x9e, 0x82, 0xe4, 0x9e, 0xde, 0xc1, 0xf9, 0xd8, 0x4a, 0xe2, 0xf4, 0xf4, 0xb9, 0x77, 0x15,
-		0xfb, 0x40, 0xc5, 0x30, 0x62, 0x8b, 0x14, 0xcb, 0x14, 0x51, 0xf8, 0xdf, 0x49, 0x98, 0x1f, 0x52,
-		0x8b, 0x10, 0x24, 0x0d, 0xb5, 0x4b, 0x18, 0x63, 0xa7, 0x33, 0x32, 0xfd, 0x8d, 0xf2, 0x30, 0x65,
-		0xa9, 0xda, 0x35, 0xb5, 0x8d, 0xf3, 0x71, 0x5a, 0x2c, 0x2e, 0xd1, 0x12, 0x40, 0x0b, 0x5b, 0xd8,
-		0x68, 0x61, 0x43, 0x3b, 0xc8, 0x27, 0x56, 0x12, 0xa7, 0x33, 0x72, 0xa0, 0x04, 0x3d, 0x04, 0x73,
-		0x56, 0x6f, 0xb7, 0xa3, 0x6b, 0x4a, 0x40, 0x0c, 0x56, 0x12, 0xa7, 0x27, 0x65, 0x89, 0x55, 0xd4,
-		0x7c, 0xe1, 0x07, 0x60, 0xf6, 0x06, 0x56, 0xaf, 0x05, 0x45, 0xa7, 0xa9, 0x68, 0x8e, 0x14, 0x07,
-		0x04, 0xab, 0x90, 0xed, 0x62, 0xc7, 0x51, 0xdb, 0x58, 0x71, 0x0f, 0x2c, 0x9c, 0x4f, 0xd2, 0xde,
-		0xaf, 0x0c, 0xf4, 0xbe, 0xbf, 0xe7, 0xd3, 0x1c, 0xb5, 0x7d, 0x60, 0x61, 0x54, 0x86, 0x0c, 0x36,
-		0x7a, 0x5d, 0xc6, 0x30, 0x39, 0xc2, 0x7e, 0x75, 0xa3, 0xd7, 0xed, 0x67, 0x49, 0x13, 0x18, 0xa7,
-		0x98, 0x72, 0xb0, 0x7d, 0x5d, 0xd7, 0x70, 0x3e, 0x45, 0x09, 0x1e, 0x18, 0x20, 0x68, 0xb2, 0xfa,
-		0x7e, 0x0e, 0x81, 0x43, 0x55, 0xc8, 0xe0, 0x9b, 0x2e, 0x36, 0x1c, 0xdd, 0x34, 0xf2, 0x53, 0x94,
-		0xe4, 0xbe, 0x21, 0xa3, 0x88, 0x3b, 0xad, 0x7e, 0x0a, 0x1f, 0x87, 0x2e, 0xc0, 0x94, 0x69, 0xb9,
-		0xba, 0x69, 0x38, 0xf9, 0xf4, 0x4a, 0xec, 0xf4, 0xf4, 0xb9, 0xbb, 0x86, 0x3a, 0xc2, 0x26, 0x93,
-		0x91, 0x85, 0x30, 0x6a, 0x80, 0xe4, 0x98, 0x3d, 0x5b, 0xc3, 0x8a, 0x66, 0xb6, 0xb0, 0xa2, 0x1b,
-		0x7b, 0x66, 0x3e, 0x43, 0x09, 0x96, 0x07, 0x3b, 0x42, 0x05, 0xab, 0x66, 0x0b, 0x37, 0x8c, 0x3d,
-		0x53, 0xce, 0x39, 0xa1, 0x6b, 0x74, 0x1c, 0x52, 0xce, 0x81, 0xe1, 0xaa, 0x37, 0xf3, 0x59, 0xea,
-		0x21, 0xfc, 0x8a, 0xb8, 0x0e, 0x6e, 0xe9, 0xa4, 0xb9, 0xfc, 0x0c, 0x73, 0x1d, 0x7e, 0x59, 0xf8,
-		0x66, 0x0a, 0x66, 0xc7, 0x71, 0xbe, 0x8b, 0x30, 0xb9, 0x47, 0xfa, 0x9f, 0x8f, 0x1f, 0xc5, 0x3a,
-		0x0c, 0x13, 0x36, 0x6f, 0xea, 0x0e, 0xcd, 0x5b, 0x86, 0x69, 0x03, 0x3b, 0x2e, 0x6e, 0x31, 0x5f,
-		0x49, 0x8c, 0xe9, 0x6d, 0xc0, 0x40, 0x83, 0xce, 0x96, 0xbc, 0x23, 0x67, 0x7b, 0x1e, 0x66, 0x3d,
-		0x95, 0x14, 0x5b, 0x35, 0xda, 0xc2, 0x6b, 0xcf, 0x46, 0x69, 0x52, 0xac, 0x0b, 0x9c, 0x4c, 0x60,
-		0x72, 0x0e, 0x87, 0xae, 0x51, 0x0d, 0xc0, 0x34, 0xb0, 0xb9, 0xa7, 0xb4, 0xb0, 0xd6, 0xc9, 0xa7,
-		0x47, 0x58, 0x69, 0x93, 0x88, 0x0c, 0x58, 0xc9, 0x64, 0xa5, 0x5a, 0x07, 0x3d, 0xed, 0x3b, 0xe1,
-		0xd4, 0x08, 0x1f, 0x5a, 0x67, 0xd3, 0x6f, 0xc0, 0x0f, 0x77, 0x20, 0x67, 0x63, 0x32, 0x23, 0x70,
-		0x8b, 0xf7, 0x2c, 0x43, 0x95, 0x28, 0x46, 0xf6, 0x4c, 0xe6, 0x30, 0xd6, 0xb1, 0x19, 0x3b, 0x78,
-		0x89, 0xee, 0x05, 0xaf, 0x40, 0xa1, 0x6e, 0x05, 0x34, 0x3e, 0x65, 0x45, 0xe1, 0x86, 0xda, 0xc5,
-		0x8b, 0x2f, 0x43, 0x2e, 0x6c, 0x1e, 0xb4, 0x00, 0x93, 0x8e, 0xab, 0xda, 0x2e, 0xf5, 0xc2, 0x49,
-		0x99, 0x5d, 0x20, 0x09, 0x12, 0xd8, 0x68, 0xd1, 0xf8, 0x37, 0x29, 0x93, 0x9f, 0xe8, 0x7d, 0x7e,
-		0x87, 0x13, 0xb4, 0xc3, 0xf7, 0x0f, 0x8e, 0x68, 0x88, 0xb9, 0xbf, 0xdf, 0x8b, 0x4f, 0xc2, 0x4c,
-		0xa8, 0x03, 0xe3, 0x36, 0x5d, 0xf8, 0xf3, 0x70, 0x6c, 0x28, 0x35, 0x7a, 0x1e, 0x16, 0x7a, 0x86,
-		0x6e, 0xb8, 0xd8, 0xb6, 0x6c, 0x4c, 0x3c, 0x96, 0x35, 0x95, 0xff, 0xbd, 0xa9, 0x11, 0x3e, 0xb7,
-		0x13, 0x94, 0x66, 0x2c, 0xf2, 0x7c, 0x6f, 0xb0, 0xf0, 0x4c, 0x26, 0xfd, 0xfd, 0x29, 0xe9, 0x95,
-		0x57, 0x5e, 0x79, 0x25, 0x5e, 0xf8, 0x27, 0x29, 0x58, 0x18, 0x36, 0x67, 0x86, 0x4e, 0xdf, 0xe3,
-		0x90, 0x32, 0x7a, 0xdd, 0x5d, 0x6c, 0x53, 0x23, 0x4d, 0xca, 0xfc, 0x0a, 0x95, 0x61, 0xb2, 0xa3,
-		0xee, 0xe2, 0x4e, 0x3e, 0xb9, 0x12, 0x3b, 0x9d, 0x3b, 0xf7, 0xd0, 0x58, 0xb3, 0xb2, 0xb8, 0x46,
-		0x20, 0x32, 0x43, 0xa2, 0xf7, 0x40, 0x92, 0x07, 0x6f, 0xc2, 0x70, 0x66, 0x3c, 0x06, 0x32, 0x97,
-		0x64, 0x8a, 0x43, 0xa7, 0x20, 0x43, 0xfe, 0x67, 0xbe, 0x91, 0xa2, 0x3a, 0xa7, 0x49, 0x01, 0xf1,
-		0x0b, 0xb4, 0x08, 0x69, 0x3a, 0x4d, 0x5a, 0x58, 0x2c, 0x7a, 0xde, 0x35, 0x71, 0xac, 0x16, 0xde,
-		0x53, 0x7b, 0x1d, 0x57, 0xb9, 0xae, 0x76, 0x7a, 0x98, 0x3a, 0x7c, 0x46, 0xce, 0xf2, 0xc2, 0xab,
-		0xa4, 0x0c, 0x2d, 0xc3, 0x34, 0x9b, 0x55, 0xba, 0xd1, 0xc2, 0x37, 0x69, 0x5c, 0x9d, 0x94, 0xd9,
-		0x44, 0x6b, 0x90, 0x12, 0xd2, 0xfc, 0x4b, 0x8e, 0x69, 0x08, 0xd7, 0xa4, 0x4d, 0x90, 0x02, 0xda,
-		0xfc, 0x93, 0xfd, 0x21, 0xfd, 0xee, 0xe1, 0xdd, 0x1b, 0x98, 0x4b, 0x0f, 0xc0, 0x2c, 0x95, 0x78,
-		0x9c, 0x0f, 0xbd, 0xda, 0xc9, 0xcf, 0xad, 0xc4, 0x4e, 0xa7, 0xe5, 0x1c, 0x2b, 0xde, 0xe4, 0xa5,
-		0x85, 0xaf, 0xc7, 0x21, 0x49, 0x03, 0xcb, 0x2c, 0x4c, 0x6f, 0xbf, 0xb0, 0x55, 0x57, 0x6a, 0x9b,
-		0x3b, 0x95, 0xb5, 0xba, 0x14, 0x43, 0x39, 0x00, 0x5a, 0x70, 0x69, 0x6d, 0xb3, 0xbc, 0x2d, 0xc5,
-		0xbd, 0xeb, 0xc6, 0xc6, 0xf6, 0x85, 0x27, 0xa4, 0x84, 0x07, 0xd8, 0x61, 0x05, 0xc9, 0xa0, 0xc0,
-		0xe3, 0xe7, 0xa4, 0x49, 0x24, 0x41, 0x96, 0x11, 0x34, 0x9e, 0xaf, 0xd7, 0x2e, 0x3c, 0x21, 0xa5,
-		0xc2, 0x25, 0x8f, 0x9f, 0x93, 0xa6, 0xd0, 0x0c, 0x64, 0x68, 0x49, 0x65, 0x73, 0x73, 0x4d, 0x4a,
-		0x7b, 0x9c, 0xcd, 0x6d, 0xb9, 0xb1, 0xb1, 0x2a, 0x65, 0x3c, 0xce, 0x55, 0x79, 0x73, 0x67, 0x4b,
-		0x02, 0x8f, 0x61, 0xbd, 0xde, 0x6c, 0x96, 0x57, 0xeb, 0xd2, 0xb4, 0x27, 0x51, 0x79, 0x61, 0xbb,
-		0xde, 0x94, 0xb2, 0x21, 0xb5, 0x1e, 0x3f, 0x27, 0xcd, 0x78, 0x4d, 0xd4, 0x37, 0x76, 0xd6, 0xa5,
-		0x1c, 0x9a, 0x83, 0x19, 0xd6, 0x84, 0x50, 0x62, 0xb6, 0xaf, 0xe8, 0xc2, 0x13, 0x92, 0xe4, 0x2b,
-		0xc2, 0x58, 0xe6, 0x42, 0x05, 0x17, 0x9e, 0x90, 0x50, 0xa1, 0x0a, 0x93, 0xd4, 0x0d, 0x11, 0x82,
-		0xdc, 0x5a, 0xb9, 0x52, 0x5f, 0x53, 0x36, 0xb7, 0xb6, 0x1b, 0x9b, 0x1b, 0xe5, 0x35, 0x29, 0xe6,
-		0x97, 0xc9, 0xf5, 0xf7, 0xef, 0x34, 0xe4, 0x7a, 0x4d, 0x8a, 0x07, 0xcb, 0xb6, 0xea, 0xe5, 0xed,
-		0x7a, 0x4d, 0x4a, 0x14, 0x34, 0x58, 0x18, 0x16, 0x50, 0x87, 0x4e, 0xa1, 0x80, 0x2f, 0xc4, 0x47,
-		0xf8, 0x02, 0xe5, 0xea, 0xf7, 0x85, 0xc2, 0x77, 0xe3, 0x30, 0x3f, 0x64, 0x51, 0x19, 0xda, 0xc8,
-		0x7b, 0x61, 0x92, 0xf9, 0x32, 0x5b, 0x66, 0x1f, 0x1c, 0xba, 0x3a, 0x51, 0xcf, 0x1e, 0x58, 0x6a,
-		0x29, 0x2e, 0x98, 0x84, 0x24, 0x46, 0x24, 0x21, 0x84, 0x62, 0xc0, 0x61, 0x7f, 0x72, 0x20, 0xf8,
-		0xb3, 0xf5, 0xf1, 0xc2, 0x38, 0xeb, 0x23, 0x2d, 0x3b, 0xda, 0x22, 0x30, 0x39, 0x64, 0x11, 0xb8,
-		0x08, 0x73, 0x03, 0x44, 0x63, 0x07, 0xe3, 0x0f, 0xc6, 0x20, 0x3f, 0xca, 0x38, 0x11, 0x21, 0x31,
-		0x1e, 0x0a, 0x89, 0x17, 0xfb, 0x2d, 0x78, 0xcf, 0xe8, 0x41, 0x18, 0x18, 0xeb, 0x2f, 0xc6, 0xe0,
-		0xf8, 0xf0, 0x64, 0x73, 0xa8, 0x0e, 0xef, 0x81, 0x54, 0x17, 0xbb, 0xfb, 0xa6, 0x48, 0xab, 0xee,
-		0x1f, 0xb2, 0x58, 0x93, 0xea, 0xfe, 0xc1, 0xe6, 0xa8, 0xe0, 0x6a, 0x9f, 0x18, 0x95, 0x31, 0x32,
-		0x6d, 0x06, 0x34, 0xfd, 0xd9, 0x38, 0x1c, 0x1b, 0x4a, 0x3e, 0x54, 0xd1, 0xbb, 0x01, 0x74, 0xc3,
-		0xea, 0xb9, 0x2c, 0x75, 0x62, 0x91, 0x38, 0x43, 0x4b, 0x68, 0xf0, 0x22, 0x51, 0xb6, 0xe7, 0x7a,
-		0xf5, 0x09, 0x5a, 0x0f, 0xac, 0x88, 0x0a, 0x3c, 0xe5, 0x2b, 0x9a, 0xa4, 0x8a, 0x2e, 0x8d, 0xe8,
-		0xe9, 0x80, 0x63, 0x3e, 0x0a, 0x92, 0xd6, 0xd1, 0xb1, 0xe1, 0x2a, 0x8e, 0x6b, 0x63, 0xb5, 0xab,
-		0x1b, 0x6d, 0xba, 0xd4, 0xa4, 0x4b, 0x93, 0x7b, 0x6a, 0xc7, 0xc1, 0xf2, 0x2c, 0xab, 0x6e, 0x8a,
-		0x5a, 0x82, 0xa0, 0x0e, 0x64, 0x07, 0x10, 0xa9, 0x10, 0x82, 0x55, 0x7b, 0x88, 0xc2, 0x47, 0x33,
-		0x30, 0x1d, 0x48, 0xcd, 0xd1, 0x3d, 0x90, 0x7d, 0x49, 0xbd, 0xae, 0x2a, 0x62, 0xbb, 0xc5, 0x2c,
-		0x31, 0x4d, 0xca, 0xb6, 0xf8, 0x96, 0xeb, 0x51, 0x58, 0xa0, 0x22, 0x66, 0xcf, 0xc5, 0xb6, 0xa2,
-		0x75, 0x54, 0xc7, 0xa1, 0x46, 0x4b, 0x53, 0x51, 0x44, 0xea, 0x36, 0x49, 0x55, 0x55, 0xd4, 0xa0,
-		0xf3, 0x30, 0x4f, 0x11, 0xdd, 0x5e, 0xc7, 0xd5, 0xad, 0x0e, 0x56, 0xc8, 0x06, 0xd0, 0xa1, 0x4b,
-		0x8e, 0xa7, 0xd9, 0x1c, 0x91, 0x58, 0xe7, 0x02, 0x44, 0x23, 0x07, 0xd5, 0xe0, 0x6e, 0x0a, 0x6b,
-		0x63, 0x03, 0xdb, 0xaa, 0x8b, 0x15, 0xfc, 0x81, 0x9e, 0xda, 0x71, 0x14, 0xd5, 0x68, 0x29, 0xfb,
-		0xaa, 0xb3, 0x9f, 0x5f, 0x20, 0x04, 0x95, 0x78, 0x3e, 0x26, 0x9f, 0x24, 0x82, 0xab, 0x5c, 0xae,
-		0x4e, 0xc5, 0xca, 0x46, 0xeb, 0xb2, 0xea, 0xec, 0xa3, 0x12, 0x1c, 0xa7, 0x2c, 0x8e, 0x6b, 0xeb,
-		0x46, 0x5b, 0xd1, 0xf6, 0xb1, 0x76, 0x4d, 0xe9, 0xb9, 0x7b, 0x4f, 0xe5, 0x4f, 0x05, 0xdb, 0xa7,
-		0x1a, 0x36, 0xa9, 0x4c, 0x95, 0x88, 0xec, 0xb8, 0x7b, 0x4f, 0xa1, 0x26, 0x64, 0xc9, 0x60, 0x74,
-		0xf5, 0x97, 0xb1, 0xb2, 0x67, 0xda, 0x74, 0x0d, 0xcd, 0x0d, 0x09, 0x4d, 0x01, 0x0b, 0x16, 0x37,
-		0x39, 0x60, 0xdd, 0x6c, 0xe1, 0xd2, 0x64, 0x73, 0xab, 0x5e, 0xaf, 0xc9, 0xd3, 0x82, 0xe5, 0x92,
-		0x69, 0x13, 0x87, 0x6a, 0x9b, 0x9e, 0x81, 0xa7, 0x99, 0x43, 0xb5, 0x4d, 0x61, 0xde, 0xf3, 0x30,
-		0xaf, 0x69, 0xac, 0xcf, 0xba, 0xa6, 0xf0, 0x6d, 0x9a, 0x93, 0x97, 0x42, 0xc6, 0xd2, 0xb4, 0x55,
-		0x26, 0xc0, 0x7d, 0xdc, 0x41, 0x4f, 0xc3, 0x31, 0xdf, 0x58, 0x41, 0xe0, 0xdc, 0x40, 0x2f, 0xfb,
-		0xa1, 0xe7, 0x61, 0xde, 0x3a, 0x18, 0x04, 0xa2, 0x50, 0x8b, 0xd6, 0x41, 0x3f, 0xec, 0x49, 0x58,
-		0xb0, 0xf6, 0xad, 0x41, 0xdc, 0x99, 0x20, 0x0e, 0x59, 0xfb, 0x56, 0x3f, 0xf0, 0x3e, 0xba, 0x67,
-		0xb7, 0xb1, 0xa6, 0xba, 0xb8, 0x95, 0x3f, 0x11, 0x14, 0x0f, 0x54, 0xa0, 0x22, 0x48, 0x9a, 0xa6,
-		0x60, 0x43, 0xdd, 0xed, 0x60, 0x45, 0xb5, 0xb1, 0xa1, 0x3a, 0xf9, 0x65, 0x2a, 0x9c, 0x74, 0xed,
-		0x1e, 0x96, 0x73, 0x9a, 0x56, 0xa7, 0x95, 0x65, 0x5a, 0x87, 0xce, 0xc0, 0x9c, 0xb9, 0xfb, 0x92,
-		0xc6, 0x3c, 0x52, 0xb1, 0x6c, 0xbc, 0xa7, 0xdf, 0xcc, 0xbf, 0x8b, 0x9a, 0x77, 0x96, 0x54, 0x50,
-		0x7f, 0xdc, 0xa2, 0xc5, 0xe8, 0x41, 0x90, 0x34, 0x67, 0x5f, 0xb5, 0x2d, 0x1a, 0x92, 0x1d, 0x4b,
-		0xd5, 0x70, 0xfe, 0x3e, 0x26, 0xca, 0xca, 0x37, 0x44, 0x31, 0x99, 0x11, 0xce, 0x0d, 0x7d, 0xcf,
-		0x15, 0x8c, 0x0f, 0xb0, 0x19, 0x41, 0xcb, 0x38, 0xdb, 0x69, 0x90, 0x88, 0x25, 0x42, 0x0d, 0x9f,
-		0xa6, 0x62, 0x39, 0x6b, 0xdf, 0x0a, 0xb6, 0x7b, 0x2f, 0xcc, 0x10, 0x49, 0xbf, 0xd1, 0x07, 0x59,
-		0xe2, 0x66, 0xed, 0x07, 0x5a, 0x7c, 0x02, 0x8e, 0x13, 0xa1, 0x2e, 0x76, 0xd5, 0x96, 0xea, 0xaa,
-		0x01, 0xe9, 0x87, 0xa9, 0x34, 0x31, 0xfb, 0x3a, 0xaf, 0x0c, 0xe9, 0x69, 0xf7, 0x76, 0x0f, 0x3c,
-		0xc7, 0x7a, 0x84, 0xe9, 0x49, 0xca, 0x84, 0x6b, 0xbd, 0x6d, 0xc9, 0x79, 0xa1, 0x04, 0xd9, 0xa0,
-		0xdf, 0xa3, 0x0c, 0x30, 0xcf, 0x97, 0x62, 0x24, 0x09, 0xaa, 0x6e, 0xd6, 0x48, 0xfa, 0xf2, 0x62,
-		0x5d, 0x8a, 0x93, 0x34, 0x6a, 0xad, 0xb1, 0x5d, 0x57, 0xe4, 0x9d, 0x8d, 0xed, 0xc6, 0x7a, 0x5d,
-		0x4a, 0x04, 0x12, 0xfb, 0x2b, 0xc9, 0xf4, 0xfd, 0xd2, 0x03, 0x85, 0x5f, 0x49, 0x40, 0x2e, 0xbc,
-		0x53, 0x43, 0xef, 0x86, 0x13, 0xe2, 0xc0, 0xc5, 0xc1, 0xae, 0x72, 0x43, 0xb7, 0xe9, 0x84, 0xec,
-		0xaa, 0x6c, 0x71, 0xf4, 0xfc, 0x67, 0x81, 0x4b, 0x35, 0xb1, 0xfb, 0x9c, 0x6e, 0x93, 0xe9, 0xd6,
-		0x55, 0x5d, 0xb4, 0x06, 0xcb, 0x86, 0xa9, 0x38, 0xae, 0x6a, 0xb4, 0x54, 0xbb, 0xa5, 0xf8, 0x47,
-		0x5d, 0x8a, 0xaa, 0x69, 0xd8, 0x71, 0x4c, 0xb6, 0x10, 0x7a, 0x2c, 0x77, 0x19, 0x66, 0x93, 0x0b,
-		0xfb, 0x2b, 0x44, 0x99, 0x8b, 0xf6, 0xb9, 0x6f, 0x62, 0x94, 0xfb, 0x9e, 0x82, 0x4c, 0x57, 0xb5,
-		0x14, 0x6c, 0xb8, 0xf6, 0x01, 0xcd, 0xcf, 0xd3, 0x72, 0xba, 0xab, 0x5a, 0x75, 0x72, 0x8d, 0xae,
-		0xc2, 0xfd, 0xbe, 0xa8, 0xd2, 0xc1, 0x6d, 0x55, 0x3b, 0x50, 0x68, 0x32, 0x4e, 0x8f, 0x0d, 0x14,
-		0xcd, 0x34, 0xf6, 0x3a, 0xba, 0xe6, 0x3a, 0x34, 0x3e, 0xb0, 0x18, 0x57, 0xf0, 0x11, 0x6b, 0x14,
-		0x70, 0xc5, 0x31, 0x0d, 0x9a, 0x83, 0x57, 0x85, 0xf4, 0x3b, 0xb2, 0xfd, 0xba, 0x92, 0x4c, 0x27,
-		0xa5, 0xc9, 0x2b, 0xc9, 0xf4, 0xa4, 0x94, 0xba, 0x92, 0x4c, 0xa7, 0xa4, 0xa9, 0x2b, 0xc9, 0x74,
-		0x5a, 0xca, 0x5c, 0x49, 0xa6, 0x33, 0x12, 0x14, 0xbe, 0x91, 0x86, 0x6c, 0x70, 0x67, 0x40, 0x36,
-		0x5a, 0x1a, 0x5d, 0x1b, 0x63, 0x34, 0x7a, 0xde, 0x7b, 0xe8, 0x3e, 0xa2, 0x58, 0x25, 0x8b, 0x66,
-		0x29, 0xc5, 0xd2, 0x70, 0x99, 0x21, 0x49, 0xc2, 0x42, 0xdc, 0x1a, 0xb3, 0xb4, 0x27, 0x2d, 0xf3,
-		0x2b, 0xb4, 0x0a, 0xa9, 0x97, 0x1c, 0xca, 0x9d, 0xa2, 0xdc, 0xef, 0x3a, 0x9c, 0xfb, 0x4a, 0x93,
-		0x92, 0x67, 0xae, 0x34, 0x95, 0x8d, 0x4d, 0x79, 0xbd, 0xbc, 0x26, 0x73, 0x38, 0x3a, 0x09, 0xc9,
-		0x8e, 0xfa, 0xf2, 0x41, 0x78, 0x79, 0xa5, 0x45, 0xa8, 0x08, 0xb3, 0x3d, 0xe3, 0x3a, 0xb6, 0xf5,
-		0x3d, 0x9d, 0x0c, 0x15, 0x91, 0x9a, 0x0d, 0x4a, 0xe5, 0xfc, 0xda, 0x35, 0x22, 0x3f, 0xa6, 0x7b,
-		0x9c, 0x84, 0xe4, 0x0d, 0xac, 0x5e, 0x0b, 0x2f, 0x82, 0xb4, 0x08, 0x9d, 0x86, 0x6c, 0x0b, 0xef,
-		0xf6, 0xda, 0x8a, 0x8d, 0x5b, 0xaa, 0xe6, 0x86, 0x43, 0xff, 0x34, 0xad, 0x92, 0x69, 0x0d, 0x7a,
-		0x16, 0x32, 0x64, 0x8c, 0x0c, 0x3a, 0xc6, 0x73, 0xd4, 0x04, 0x8f, 0x1c, 0x6e, 0x02, 0x3e, 0xc4,
-		0x02, 0x24, 0xfb, 0x78, 0x74, 0x09, 0x52, 0xae, 0x6a, 0xb7, 0xb1, 0x4b, 0x23, 0x7f, 0x6e, 0xc8,
-		0xe1, 0xc7, 0x10, 0xa6, 0x6d, 0x8a, 0xa0, 0x7b, 0x5a, 0x8e, 0x7e, 0x1b, 0xa3, 0xcc, 0x59, 0x98,
-		0xa4, 0xee, 0x81, 0x00, 0xb8, 0x83, 0x48, 0x13, 0x28, 0x0d, 0xc9, 0xea, 0xa6, 0x4c, 0x22, 0x8d,
-		0x04, 0x59, 0x56, 0xaa, 0x6c, 0x35, 0xea, 0xd5, 0xba, 0x14, 0x2f, 0x9c, 0x87, 0x14, 0x1b, 0x73,
-		0x12, 0x85, 0xbc, 0x51, 0x97, 0x26, 0xf8, 0x25, 0xe7, 0x88, 0x89, 0xda, 0x9d, 0xf5, 0x4a, 0x5d,
-		0x96, 0xe2, 0x85, 0x1d, 0x98, 0xed, 0xb3, 0x13, 0x3a, 0x06, 0x73, 0x72, 0x7d, 0xbb, 0xbe, 0x41,
-		0xf6, 0x59, 0xca, 0xce, 0xc6, 0xb3, 0x1b, 0x9b, 0xcf, 0x6d, 0x48, 0x13, 0xe1, 0x62, 0x11, 0xd2,
-		0x62, 0x68, 0x01, 0x24, 0xbf, 0xb8, 0xb9, 0xb9, 0x23, 0x53, 0x6d, 0x7e, 0x2e, 0x0e, 0x52, 0xbf,
-		0xd5, 0xd0, 0x09, 0x98, 0xdf, 0x2e, 0xcb, 0xab, 0xf5, 0x6d, 0x85, 0xed, 0x1d, 0x3d, 0xea, 0x05,
-		0x90, 0x82, 0x15, 0x97, 0x1a, 0x74, 0x6b, 0xbc, 0x0c, 0xa7, 0x82, 0xa5, 0xf5, 0xe7, 0xb7, 0xeb,
-		0x1b, 0x4d, 0xda, 0x78, 0x79, 0x63, 0x95, 0xc4, 0xd7, 0x3e, 0x3e, 0xb1, 0x5b, 0x4d, 0x10, 0x55,
-		0xc3, 0x7c, 0xf5, 0xb5, 0x9a, 0x94, 0xec, 0x2f, 0xde, 0xdc, 0xa8, 0x6f, 0x5e, 0x92, 0x26, 0xfb,
-		0x5b, 0xa7, 0x3b, 0xd8, 0x14, 0x5a, 0x84, 0xe3, 0xfd, 0xa5, 0x4a, 0x7d, 0x63, 0x5b, 0x7e, 0x41,
-		0x9a, 0xea, 0x6f, 0xb8, 0x59, 0x97, 0xaf, 0x36, 0xaa, 0x75, 0x29, 0x8d, 0x8e, 0x03, 0x0a, 0x6b,
-		0xb4, 0x7d, 0x79, 0xb3, 0x26, 0x65, 0x06, 0x22, 0x4a, 0xc1, 0x81, 0x6c, 0x70, 0x1b, 0xf9, 0xce,
-		0x9c, 0x25, 0x7d, 0x3c, 0x0e, 0xd3, 0x81, 0x6d, 0x21, 0xc9, 0xe7, 0xd5, 0x4e, 0xc7, 0xbc, 0xa1,
-		0xa8, 0x1d, 0x5d, 0x75, 0x78, 0xbc, 0x01, 0x5a, 0x54, 0x26, 0x25, 0xe3, 0xce, 0xef, 0xf1, 0x23,
-		0x7c, 0xea, 0xc7, 0x31, 0xc2, 0x4f, 0x4a, 0xa9, 0xc2, 0x67, 0x62, 0x20, 0xf5, 0xef, 0xf7, 0xfa,
-		0xba, 0x1f, 0x1b, 0xd5, 0xfd, 0x77, 0x64, 0xec, 0x3e, 0x1d, 0x83, 0x5c, 0x78, 0x93, 0xd7, 0xa7,
-		0xde, 0x3d, 0x7f, 0xaa, 0xea, 0xfd, 0x6e, 0x1c, 0x66, 0x42, 0x5b, 0xbb, 0x71, 0xb5, 0xfb, 0x00,
-		0xcc, 0xe9, 0x2d, 0xdc, 0xb5, 0x4c, 0x17, 0x1b, 0xda, 0x81, 0xd2, 0xc1, 0xd7, 0x71, 0x27, 0x5f,
-		0xa0, 0x41, 0xf9, 0xec, 0xe1, 0x9b, 0xc7, 0x62, 0xc3, 0xc7, 0xad, 0x11, 0x58, 0x69, 0xbe, 0x51,
-		0xab, 0xaf, 0x6f, 0x6d, 0x6e, 0xd7, 0x37, 0xaa, 0x2f, 0x88, 0xe8, 0x22, 0x4b, 0x7a, 0x9f, 0xd8,
-		0xdb, 0x18, 0xb4, 0xb7, 0x40, 0xea, 0x57, 0x8a, 0xc4, 0x8a, 0x21, 0x6a, 0x49, 0x13, 0x68, 0x1e,
-		0x66, 0x37, 0x36, 0x95, 0x66, 0xa3, 0x56, 0x57, 0xea, 0x97, 0x2e, 0xd5, 0xab, 0xdb, 0x4d, 0x76,
-		0x1c, 0xe8, 0x49, 0x6f, 0x4b, 0xf1, 0xa0, 0x89, 0x3f, 0x99, 0x80, 0xf9, 0x21, 0x9a, 0xa0, 0x32,
-		0xdf, 0xc8, 0xb3, 0xb3, 0x85, 0x47, 0xc6, 0xd1, 0xbe, 0x48, 0x52, 0xe9, 0x2d, 0xd5, 0x76, 0xf9,
-		0xbe, 0xff, 0x41, 0x20, 0x56, 0x32, 0x5c, 0xb2, 0xb2, 0xdb, 0xfc, 0x98, 0x95, 0xed, 0xee, 0x67,
-		0xfd, 0x72, 0x76, 0xd2, 0xfa, 0x30, 0x20, 0xcb, 0x74, 0x74, 0x57, 0xbf, 0x8e, 0x15, 0xdd, 0x10,
-		0x67, 0xb2, 0x64, 0xb7, 0x9f, 0x94, 0x25, 0x51, 0xd3, 0x30, 0x5c, 0x4f, 0xda, 0xc0, 0x6d, 0xb5,
-		0x4f, 0x9a, 0x64, 0x1e, 0x09, 0x59, 0x12, 0x35, 0x9e, 0xf4, 0x3d, 0x90, 0x6d, 0x99, 0x3d, 0xb2,
-		0x05, 0x62, 0x72, 0x24, 0x5a, 0xc4, 0xe4, 0x69, 0x56, 0xe6, 0x89, 0xf0, 0xcd, 0xad, 0x7f, 0x18,
-		0x9c, 0x95, 0xa7, 0x59, 0x19, 0x13, 0x79, 0x00, 0x66, 0xd5, 0x76, 0xdb, 0x26, 0xe4, 0x82, 0x88,
-		0x6d, 0xd7, 0x73, 0x5e, 0x31, 0x15, 0x5c, 0xbc, 0x02, 0x69, 0x61, 0x07, 0x92, 0xc1, 0x12, 0x4b,
-		0x28, 0x16, 0x3b, 0x83, 0x8a, 0x9f, 0xce, 0xc8, 0x69, 0x43, 0x54, 0xde, 0x03, 0x59, 0xdd, 0x51,
-		0xfc, 0x7b, 0x5b, 0xf1, 0x95, 0xf8, 0xe9, 0xb4, 0x3c, 0xad, 0x3b, 0xde, 0x7d, 0x81, 0xc2, 0x17,
-		0xe3, 0x90, 0x0b, 0xdf, 0xb5, 0x43, 0x35, 0x48, 0x77, 0x4c, 0x4d, 0xa5, 0xae, 0xc5, 0x6e, 0x19,
-		0x9f, 0x8e, 0xb8, 0xd1, 0x57, 0x5c, 0xe3, 0xf2, 0xb2, 0x87, 0x5c, 0xfc, 0xd7, 0x31, 0x48, 0x8b,
-		0x62, 0x74, 0x1c, 0x92, 0x96, 0xea, 0xee, 0x53, 0xba, 0xc9, 0x4a, 0x5c, 0x8a, 0xc9, 0xf4, 0x9a,
-		0x94, 0x3b, 0x96, 0x6a, 0x50, 0x17, 0xe0, 0xe5, 0xe4, 0x9a, 0x8c, 0x6b, 0x07, 0xab, 0x2d, 0x7a,
-		0x16, 0x60, 0x76, 0xbb, 0xd8, 0x70, 0x1d, 0x31, 0xae, 0xbc, 0xbc, 0xca, 0x8b, 0xd1, 0x43, 0x30,
-		0xe7, 0xda, 0xaa, 0xde, 0x09, 0xc9, 0x26, 0xa9, 0xac, 0x24, 0x2a, 0x3c, 0xe1, 0x12, 0x9c, 0x14,
-		0xbc, 0x2d, 0xec, 0xaa, 0xda, 0x3e, 0x6e, 0xf9, 0xa0, 0x14, 0x3d, 0xf3, 0x3b, 0xc1, 0x05, 0x6a,
-		0xbc, 0x5e, 0x60, 0x0b, 0xdf, 0x8e, 0xc3, 0x9c, 0x38, 0xbd, 0x68, 0x79, 0xc6, 0x5a, 0x07, 0x50,
-		0x0d, 0xc3, 0x74, 0x83, 0xe6, 0x1a, 0x74, 0xe5, 0x01, 0x5c, 0xb1, 0xec, 0x81, 0xe4, 0x00, 0xc1,
-		0xe2, 0x1f, 0xc4, 0x00, 0xfc, 0xaa, 0x91, 0x76, 0x5b, 0x86, 0x69, 0x7e, 0x4f, 0x96, 0xde, 0xd8,
-		0x67, 0x07, 0x5e, 0xc0, 0x8a, 0x2e, 0xe9, 0x1d, 0x7a, 0x2c, 0xb9, 0x8b, 0xdb, 0xba, 0xc1, 0xef,
-		0xa7, 0xb0, 0x0b, 0x71, 0x2c, 0x99, 0xf4, 0x6f, 0x4f, 0xc9, 0x90, 0x76, 0x70, 0x57, 0x35, 0x5c,
-		0x5d, 0xe3, 0x77, 0x48, 0x2e, 0x1c, 0x49, 0xf9, 0x62, 0x93, 0xa3, 0x65, 0x8f, 0xa7, 0x70, 0x1a,
-		0xd2, 0xa2, 0x94, 0x24, 0x7e, 0x1b, 0x9b, 0x1b, 0x75, 0x69, 0x02, 0x4d, 0x41, 0xa2, 0x59, 0xdf,
-		0x96, 0x62, 0x64, 0xdb, 0x59, 0x5e, 0x6b, 0x94, 0x9b, 0x52, 0xbc, 0xf2, 0x17, 0x60, 0x5e, 0x33,
-		0xbb, 0xfd, 0x0d, 0x56, 0xa4, 0xbe, 0x23, 0x3f, 0xe7, 0x72, 0xec, 0xc5, 0x47, 0xb8, 0x50, 0xdb,
-		0xec, 0xa8, 0x46, 0xbb, 0x68, 0xda, 0x6d, 0xff, 0xb1, 0x08, 0xb2, 0x3b, 0x70, 0x02, 0x0f, 0x47,
-		0x58, 0xbb, 0xff, 0x23, 0x16, 0xfb, 0x5c, 0x3c, 0xb1, 0xba, 0x55, 0xf9, 0x72, 0x7c, 0x71, 0x95,
-		0x01, 0xb7, 0x44, 0x77, 0x64, 0xbc, 0xd7, 0xc1, 0x1a, 0x51, 0x1e, 0xfe, 0xf0, 0x21, 0x58, 0x68,
-		0x9b, 0x6d, 0x93, 0x32, 0x9d, 0x25, 0xbf, 0xf8, 0x73, 0x15, 0x19, 0xaf, 0x74, 0x31, 0xf2, 0x21,
-		0x8c, 0xd2, 0x06, 0xcc, 0x73, 0x61, 0x85, 0xde, 0xbe, 0x65, 0x87, 0x0b, 0xe8, 0xd0, 0x93, 0xed,
-		0xfc, 0x2f, 0x7d, 0x8f, 0x66, 0x25, 0xf2, 0x1c, 0x87, 0x92, 0x3a, 0x76, 0xfe, 0x50, 0x92, 0xe1,
-		0x58, 0x88, 0x8f, 0xc5, 0x08, 0x6c, 0x47, 0x30, 0xfe, 0x3a, 0x67, 0x9c, 0x0f, 0x30, 0x36, 0x39,
-		0xb4, 0x54, 0x85, 0x99, 0xa3, 0x70, 0xfd, 0x73, 0xce, 0x95, 0xc5, 0x41, 0x92, 0x55, 0x98, 0xa5,
-		0x24, 0x5a, 0xcf, 0x71, 0xcd, 0x2e, 0x0d, 0xc0, 0x87, 0xd3, 0xfc, 0x8b, 0xef, 0xb1, 0x49, 0x9b,
-		0x23, 0xb0, 0xaa, 0x87, 0x2a, 0x95, 0x80, 0xde, 0xb1, 0x6e, 0x61, 0xad, 0x13, 0xc1, 0xf0, 0x2d,
-		0xae, 0x88, 0x27, 0x5f, 0xba, 0x0a, 0x0b, 0xe4, 0x37, 0x8d, 0x8f, 0x41, 0x4d, 0xa2, 0x8f, 0xc1,
-		0xf3, 0xff, 0xe6, 0x83, 0x2c, 0x2e, 0xcc, 0x7b, 0x04, 0x01, 0x9d, 0x02, 0xa3, 0xd8, 0xc6, 0xae,
-		0x8b, 0x6d, 0x47, 0x51, 0x3b, 0xc3, 0xd4, 0x0b, 0x9c, 0x23, 0xe6, 0x3f, 0xf1, 0x83, 0xf0, 0x28,
-		0xae, 0x32, 0x64, 0xb9, 0xd3, 0x29, 0xed, 0xc0, 0x89, 0x21, 0x5e, 0x31, 0x06, 0xe7, 0x27, 0x39,
-		0xe7, 0xc2, 0x80, 0x67, 0x10, 0xda, 0x2d, 0x10, 0xe5, 0xde, 0x58, 0x8e, 0xc1, 0xf9, 0x29, 0xce,
-		0x89, 0x38, 0x56, 0x0c, 0x29, 0x61, 0xbc, 0x02, 0x73, 0xd7, 0xb1, 0xbd, 0x6b, 0x3a, 0xfc, 0xec,
-		0x76, 0x0c, 0xba, 0x4f, 0x73, 0xba, 0x59, 0x0e, 0xa4, 0x87, 0xb9, 0x84, 0xeb, 0x69, 0x48, 0xef,
-		0xa9, 0x1a, 0x1e, 0x83, 0xe2, 0x16, 0xa7, 0x98, 0x22, 0xf2, 0x04, 0x5a, 0x86, 0x6c, 0xdb, 0xe4,
-		0x4b, 0x64, 0x34, 0xfc, 0x33, 0x1c, 0x3e, 0x2d, 0x30, 0x9c, 0xc2, 0x32, 0xad, 0x5e, 0x87, 0xac,
-		0x9f, 0xd1, 0x14, 0x7f, 0x53, 0x50, 0x08, 0x0c, 0xa7, 0x38, 0x82, 0x59, 0x5f, 0x13, 0x14, 0x4e,
-		0xc0, 0x9e, 0xef, 0x85, 0x69, 0xd3, 0xe8, 0x1c, 0x98, 0xc6, 0x38, 0x4a, 0x7c, 0x96, 0x33, 0x00,
-		0x87, 0x10, 0x82, 0x8b, 0x90, 0x19, 0x77, 0x20, 0x7e, 0xe1, 0x07, 0x62, 0x7a, 0x88, 0x11, 0x58,
-		0x85, 0x59, 0x11, 0xa0, 0x74, 0xd3, 0x18, 0x83, 0xe2, 0x0b, 0x9c, 0x22, 0x17, 0x80, 0xf1, 0x6e,
-		0xb8, 0xd8, 0x71, 0xdb, 0x78, 0x1c, 0x92, 0x2f, 0x8a, 0x6e, 0x70, 0x08, 0x37, 0xe5, 0x2e, 0x36,
-		0xb4, 0xfd, 0xf1, 0x18, 0xbe, 0x24, 0x4c, 0x29, 0x30, 0x84, 0xa2, 0x0a, 0x33, 0x5d, 0xd5, 0x76,
-		0xf6, 0xd5, 0xce, 0x58, 0xc3, 0xf1, 0xb7, 0x39, 0x47, 0xd6, 0x03, 0x71, 0x8b, 0xf4, 0x8c, 0xa3,
-		0xd0, 0x7c, 0x59, 0x58, 0x24, 0x00, 0xe3, 0x53, 0xcf, 0x71, 0xe9, 0x41, 0xf7, 0x51, 0xd8, 0xfe,
-		0x8e, 0x98, 0x7a, 0x0c, 0xbb, 0x1e, 0x64, 0xbc, 0x08, 0x19, 0x47, 0x7f, 0x79, 0x2c, 0x9a, 0xaf,
-		0x88, 0x91, 0xa6, 0x00, 0x02, 0x7e, 0x01, 0x4e, 0x0e, 0x5d, 0x26, 0xc6, 0x20, 0xfb, 0xbb, 0x9c,
-		0xec, 0xf8, 0x90, 0xa5, 0x82, 0x87, 0x84, 0xa3, 0x52, 0xfe, 0x3d, 0x11, 0x12, 0x70, 0x1f, 0xd7,
-		0x16, 0xd9, 0xb4, 0x38, 0xea, 0xde, 0xd1, 0xac, 0xf6, 0xf7, 0x85, 0xd5, 0x18, 0x36, 0x64, 0xb5,
-		0x6d, 0x38, 0xce, 0x19, 0x8f, 0x36, 0xae, 0xbf, 0x28, 0x02, 0x2b, 0x43, 0xef, 0x84, 0x47, 0xf7,
-		0x27, 0x60, 0xd1, 0x33, 0xa7, 0xc8, 0x8e, 0x1d, 0xa5, 0xab, 0x5a, 0x63, 0x30, 0xff, 0x12, 0x67,
-		0x16, 0x11, 0xdf, 0x4b, 0xaf, 0x9d, 0x75, 0xd5, 0x22, 0xe4, 0xcf, 0x43, 0x5e, 0x90, 0xf7, 0x0c,
-		0x1b, 0x6b, 0x66, 0xdb, 0xd0, 0x5f, 0xc6, 0xad, 0x31, 0xa8, 0x7f, 0xb9, 0x6f, 0xa8, 0x76, 0x02,
-		0x70, 0xc2, 0xdc, 0x00, 0xc9, 0xcb, 0x55, 0x14, 0xbd, 0x6b, 0x99, 0xb6, 0x1b, 0xc1, 0xf8, 0x55,
-		0x31, 0x52, 0x1e, 0xae, 0x41, 0x61, 0xa5, 0x3a, 0xb0, 0xa7, 0x3f, 0xc6, 0x75, 0xc9, 0xaf, 0x71,
-		0xa2, 0x19, 0x1f, 0xc5, 0x03, 0x87, 0x66, 0x76, 0x2d, 0xd5, 0x1e, 0x27, 0xfe, 0xfd, 0x03, 0x11,
-		0x38, 0x38, 0x84, 0x07, 0x0e, 0x92, 0xd1, 0x91, 0xd5, 0x7e, 0x0c, 0x86, 0xaf, 0x8b, 0xc0, 0x21,
-		0x30, 0x9c, 0x42, 0x24, 0x0c, 0x63, 0x50, 0x7c, 0x43, 0x50, 0x08, 0x0c, 0xa1, 0x78, 0xbf, 0xbf,
-		0xd0, 0xda, 0xb8, 0xad, 0x3b, 0xae, 0xcd, 0x52, 0xf2, 0xc3, 0xa9, 0xfe, 0xe1, 0x0f, 0xc2, 0x49,
-		0x98, 0x1c, 0x80, 0x92, 0x48, 0xc4, 0x6f, 0x7d, 0xd0, 0x2d, 0x5b, 0xb4, 0x62, 0xdf, 0x14, 0x91,
-		0x28, 0x00, 0x23, 0xba, 0x05, 0x32, 0x44, 0x62, 0x76, 0x8d, 0x6c, 0x54, 0xc6, 0xa0, 0xfb, 0x47,
-		0x7d, 0xca, 0x35, 0x05, 0x96, 0x70, 0x06, 0xf2, 0x9f, 0x9e, 0x71, 0x0d, 0x1f, 0x8c, 0xe5, 0x9d,
-		0xbf, 0xd2, 0x97, 0xff, 0xec, 0x30, 0x24, 0x8b, 0x21, 0xb3, 0x7d, 0xf9, 0x14, 0x8a, 0x7a, 0xd6,
-		0x2f, 0xff, 0x53, 0x6f, 0xf2, 0xfe, 0x86, 0xd3, 0xa9, 0xd2, 0x1a, 0x71, 0xf2, 0x70, 0xd2, 0x13,
-		0x4d, 0xf6, 0xc1, 0x37, 0x3d, 0x3f, 0x0f, 0xe5, 0x3c, 0xa5, 0x4b, 0x30, 0x13, 0x4a, 0x78, 0xa2,
-		0xa9, 0xfe, 0x22, 0xa7, 0xca, 0x06, 0xf3, 0x9d, 0xd2, 0x79, 0x48, 0x92, 0xe4, 0x25, 0x1a, 0xfe,
-		0xd3, 0x1c, 0x4e, 0xc5, 0x4b, 0xcf, 0x40, 0x5a, 0x24, 0x2d, 0xd1, 0xd0, 0xbf, 0xc4, 0xa1, 0x1e,
-		0x84, 0xc0, 0x45, 0xc2, 0x12, 0x0d, 0xff, 0x19, 0x01, 0x17, 0x10, 0x02, 0x1f, 0xdf, 0x84, 0xbf,
-		0xf6, 0x97, 0x93, 0x7c, 0xd1, 0x11, 0xb6, 0xbb, 0x08, 0x53, 0x3c, 0x53, 0x89, 0x46, 0xff, 0x2c,
-		0x6f, 0x5c, 0x20, 0x4a, 0x4f, 0xc2, 0xe4, 0x98, 0x06, 0xff, 0x2b, 0x1c, 0xca, 0xe4, 0x4b, 0x55,
-		0x98, 0x0e, 0x64, 0x27, 0xd1, 0xf0, 0x0f, 0x73, 0x78, 0x10, 0x45, 0x54, 0xe7, 0xd9, 0x49, 0x34,
-		0xc1, 0x5f, 0x15, 0xaa, 0x73, 0x04, 0x31, 0x9b, 0x48, 0x4c, 0xa2, 0xd1, 0x1f, 0x11, 0x56, 0x17,
-		0x90, 0xd2, 0x7b, 0x21, 0xe3, 0x2d, 0x36, 0xd1, 0xf8, 0x8f, 0x72, 0xbc, 0x8f, 0x21, 0x16, 0x08,
-		0x2c, 0x76, 0xd1, 0x14, 0x7f, 0x4d, 0x58, 0x20, 0x80, 0x22, 0xd3, 0xa8, 0x3f, 0x81, 0x89, 0x66,
-		0xfa, 0x79, 0x31, 0x8d, 0xfa, 0xf2, 0x17, 0x32, 0x9a, 0x34, 0xe6, 0x47, 0x53, 0xfc, 0x75, 0x31,
-		0x9a, 0x54, 0x9e, 0xa8, 0xd1, 0x9f, 0x11, 0x44, 0x73, 0x7c, 0x4c, 0xa8, 0xd1, 0x97, 0x10, 0x94,
-		0xb6, 0x00, 0x0d, 0x66, 0x03, 0xd1, 0x7c, 0x1f, 0xe7, 0x7c, 0x73, 0x03, 0xc9, 0x40, 0xe9, 0x39,
-		0x38, 0x3e, 0x3c, 0x13, 0x88, 0x66, 0xfd, 0xc4, 0x9b, 0x7d, 0x7b, 0xb7, 0x60, 0x22, 0x50, 0xda,
-		0xf6, 0x97, 0x94, 0x60, 0x16, 0x10, 0x4d, 0xfb, 0xc9, 0x37, 0xc3, 0x81, 0x3b, 0x98, 0x04, 0x94,
-		0xca, 0x00, 0xfe, 0x02, 0x1c, 0xcd, 0xf5, 0x69, 0xce, 0x15, 0x00, 0x91, 0xa9, 0xc1, 0xd7, 0xdf,
-		0x68, 0xfc, 0x2d, 0x31, 0x35, 0x38, 0x82, 0x4c, 0x0d, 0xb1, 0xf4, 0x46, 0xa3, 0x3f, 0x23, 0xa6,
-		0x86, 0x80, 0x10, 0xcf, 0x0e, 0xac, 0x6e, 0xd1, 0x0c, 0x9f, 0x15, 0x9e, 0x1d, 0x40, 0x95, 0x36,
-		0x60, 0x6e, 0x60, 0x41, 0x8c, 0xa6, 0xfa, 0x1c, 0xa7, 0x92, 0xfa, 0xd7, 0xc3, 0xe0, 0xe2, 0xc5,
-		0x17, 0xc3, 0x68, 0xb6, 0xcf, 0xf7, 0x2d, 0x5e, 0x7c, 0x2d, 0x2c, 0x5d, 0x84, 0xb4, 0xd1, 0xeb,
-		0x74, 0xc8, 0xe4, 0x41, 0x87, 0x3f, 0x9f, 0x9b, 0xff, 0xcf, 0x6f, 0x71, 0xeb, 0x08, 0x40, 0xe9,
-		0x3c, 0x4c, 0xe2, 0xee, 0x2e, 0x6e, 0x45, 0x21, 0x7f, 0xff, 0x2d, 0x11, 0x30, 0x89, 0x74, 0xe9,
-		0xbd, 0x00, 0xec, 0x68, 0x84, 0xde, 0x38, 0x8f, 0xc0, 0xfe, 0xc1, 0x5b, 0xfc, 0x81, 0x38, 0x1f,
-		0xe2, 0x13, 0xb0, 0xc7, 0xeb, 0x0e, 0x27, 0xf8, 0x41, 0x98, 0x80, 0x8e, 0xc8, 0xd3, 0x30, 0xf5,
-		0x92, 0x63, 0x1a, 0xae, 0xda, 0x8e, 0x42, 0xff, 0x21, 0x47, 0x0b, 0x79, 0x62, 0xb0, 0xae, 0x69,
-		0x63, 0x57, 0x6d, 0x3b, 0x51, 0xd8, 0xff, 0xc2, 0xb1, 0x1e, 0x80, 0x80, 0x35, 0xd5, 0x71, 0xc7,
-		0xe9, 0xf7, 0x7f, 0x15, 0x60, 0x01, 0x20, 0x4a, 0x93, 0xdf, 0xd7, 0xf0, 0x41, 0x14, 0xf6, 0x87,
-		0x42, 0x69, 0x2e, 0x5f, 0x7a, 0x06, 0x32, 0xe4, 0x27, 0x7b, 0xca, 0x35, 0x02, 0xfc, 0x47, 0x1c,
-		0xec, 0x23, 0x48, 0xcb, 0x8e, 0xdb, 0x72, 0xf5, 0x68, 0x63, 0xbf, 0xc1, 0x47, 0x5a, 0xc8, 0x97,
-		0xca, 0x30, 0xed, 0xb8, 0xad, 0x56, 0x8f, 0xe7, 0xa7, 0x11, 0xf0, 0xff, 0xf6, 0x96, 0x77, 0x64,
-		0xe1, 0x61, 0xc8, 0x68, 0xdf, 0xb8, 0xe6, 0x5a, 0x26, 0xbd, 0xdf, 0x12, 0xc5, 0xf0, 0x26, 0x67,
-		0x08, 0x40, 0x4a, 0x55, 0xc8, 0x92, 0xbe, 0xd8, 0xd8, 0xc2, 0xf4, 0xe6, 0x58, 0x04, 0xc5, 0x1f,
-		0x73, 0x03, 0x84, 0x40, 0x95, 0x3f, 0xf7, 0xad, 0xd7, 0x97, 0x62, 0xdf, 0x7e, 0x7d, 0x29, 0xf6,
-		0xbb, 0xaf, 0x2f, 0xc5, 0x3e, 0xf2, 0xdd, 0xa5, 0x89, 0x6f, 0x7f, 0x77, 0x69, 0xe2, 0xb7, 0xbe,
-		0xbb, 0x34, 0x31, 0xfc, 0x94, 0x18, 0x56, 0xcd, 0x55, 0x93, 0x9d, 0x0f, 0xbf, 0x78, 0x5f, 0x5b,
-		0x77, 0xf7, 0x7b, 0xbb, 0x45, 0xcd, 0xec, 0x9e, 0xd5, 0x4c, 0xa7, 0x6b, 0x3a, 0x67, 0xc3, 0xe7,
-		0xba, 0xf4, 0x17, 0xfc, 0x49, 0x0a, 0x4e, 0xb2, 0x6a, 0x85, 0x55, 0xb1, 0x0b, 0x7e, 0xe8, 0x9b,
-		0x0d, 0x56, 0x45, 0x9f, 0xfb, 0x16, 0x9e, 0x85, 0xf9, 0x06, 0xe9, 0x3d, 0xc9, 0xea, 0xfc, 0x13,
-		0xeb, 0xa1, 0xcf, 0xa7, 0xae, 0x84, 0x12, 0x18, 0x7e, 0x5e, 0x1f, 0x2c, 0x2a, 0xfc, 0x54, 0x0c,
-		0xa4, 0xa6, 0xa6, 0x76, 0x54, 0xfb, 0xff, 0x96, 0x0a, 0x3d, 0x09, 0xc0, 0x6e, 0x5f, 0x7b, 0x2f,
-		0x22, 0xe5, 0xce, 0xe5, 0x8b, 0xc1, 0xce, 0x15, 0x59, 0x4b, 0xf4, 0x89, 0x90, 0x0c, 0x95, 0x25,
-		0x3f, 0xcf, 0x3c, 0x0f, 0xe0, 0x57, 0xa0, 0x53, 0x70, 0xa2, 0x59, 0x2d, 0xaf, 0x95, 0x65, 0xf1,
-		0xd0, 0x43, 0x73, 0xab, 0x5e, 0x6d, 0x5c, 0x6a, 0xd4, 0x6b, 0xd2, 0x04, 0x3a, 0x0e, 0x28, 0x58,
-		0xe9, 0x3d, 0xa4, 0x71, 0x0c, 0xe6, 0x82, 0xe5, 0xec, 0xa9, 0xfb, 0x38, 0x59, 0xf9, 0xf4, 0xae,
-		0xd5, 0xc1, 0xf4, 0x4e, 0x8a, 0xa2, 0x0b, 0xab, 0x45, 0x07, 0xd5, 0xdf, 0xf8, 0x77, 0xec, 0x49,
-		0xec, 0x79, 0x1f, 0xee, 0xd9, 0xbc, 0xb4, 0x06, 0x73, 0xaa, 0xa6, 0x61, 0x2b, 0x44, 0x19, 0xe1,
-		0x7a, 0x84, 0x90, 0xde, 0x1b, 0xe2, 0x48, 0x9f, 0xed, 0x49, 0x48, 0x39, 0xb4, 0xf7, 0x51, 0x14,
-		0xbf, 0xc9, 0x29, 0xb8, 0x78, 0xc9, 0x80, 0x39, 0xb2, 0x92, 0x91, 0x0d, 0xaf, 0xaf, 0xc6, 0xe1,
-		0xfb, 0xa6, 0x5f, 0xfd, 0xea, 0xa3, 0xf4, 0x4e, 0xd1, 0x3d, 0xe1, 0x61, 0x19, 0xe2, 0x4e, 0xb2,
-		0xc4, 0xb9, 0x7d, 0x45, 0x31, 0xe4, 0x44, 0x7b, 0x5c, 0xe1, 0xc3, 0x1b, 0xfb, 0xc7, 0xbc, 0xb1,
-		0xa5, 0x61, 0x3e, 0x10, 0x68, 0x69, 0x86, 0xb3, 0xb2, 0x8a, 0x4a, 0x7d, 0xd4, 0x74, 0x7c, 0xf1,
-		0xa1, 0xc1, 0xd9, 0xc6, 0xfe, 0x7b, 0x84, 0x32, 0x5f, 0x0c, 0x36, 0xb3, 0x9b, 0x62, 0x6f, 0x94,
-		0xc0, 0xa7, 0x92, 0x30, 0xa7, 0x76, 0x75, 0xc3, 0x3c, 0x4b, 0xff, 0xe5, 0x73, 0x6e, 0x92, 0x5e,
-		0x8c, 0x71, 0x93, 0xe5, 0x02, 0x9b, 0x0a, 0xd1, 0x1e, 0xf3, 0x47, 0x3f, 0xf7, 0x85, 0x49, 0x7f,
-		0xba, 0x94, 0xd6, 0x41, 0x12, 0x0f, 0x25, 0x62, 0x43, 0x33, 0x5b, 0x63, 0xed, 0xba, 0xde, 0x10,
-		0x1c, 0x62, 0xbf, 0x5e, 0xe7, 0xd0, 0xd2, 0xbb, 0x21, 0xed, 0xd1, 0x44, 0x45, 0x5a, 0x41, 0xe2,
-		0x21, 0x48, 0x9c, 0x65, 0x33, 0x73, 0x9c, 0x55, 0xf5, 0x4d, 0x81, 0x67, 0x33, 0x74, 0x83, 0xf4,
-		0x66, 0x15, 0x72, 0x2d, 0xd3, 0x70, 0x15, 0xb3, 0xab, 0xbb, 0xb8, 0x6b, 0xb9, 0x91, 0xeb, 0xd4,
-		0x1f, 0x33, 0x92, 0xb4, 0x3c, 0x43, 0x70, 0x9b, 0x02, 0x46, 0x34, 0x61, 0xef, 0x1d, 0x8d, 0xa3,
-		0xc9, 0x7f, 0xf7, 0x34, 0xa1, 0x18, 0xa2, 0xc9, 0x1d, 0x79, 0x87, 0xd3, 0xba, 0xc6, 0xef, 0xd5,
-		0xb9, 0x37, 0x99, 0x17, 0x78, 0xde, 0xf1, 0xcf, 0x92, 0xb0, 0xc4, 0x85, 0x77, 0x55, 0x07, 0x9f,
-		0xbd, 0xfe, 0xd8, 0x2e, 0x76, 0xd5, 0xc7, 0xce, 0x6a, 0xa6, 0x6e, 0x70, 0x57, 0x99, 0xe7, 0xc1,
-		0x9a, 0xd4, 0x17, 0x79, 0xfd, 0xe2, 0xd0, 0xdb, 0x77, 0x8b, 0xa3, 0x83, 0xfc, 0xe2, 0xa0, 0x0f,
-		0x16, 0x3a, 0x90, 0xac, 0x9a, 0xba, 0x81, 0x16, 0x60, 0xb2, 0x85, 0x0d, 0xb3, 0xcb, 0xc3, 0x2d,
-		0xbb, 0x40, 0x97, 0x21, 0xa5, 0x76, 0xcd, 0x9e, 0xe1, 0xb2, 0x50, 0x5b, 0x79, 0xf4, 0x5b, 0xb7,
-		0x97, 0x27, 0x7e, 0xfb, 0xf6, 0xf2, 0x31, 0x46, 0xeb, 0xb4, 0xae, 0x15, 0x75, 0xf3, 0x6c, 0x57,
-		0x75, 0xf7, 0xc9, 0xf4, 0xfd, 0xb7, 0x5f, 0x7b, 0x04, 0x78, 0x7b, 0x0d, 0xc3, 0xfd, 0xd2, 0xef,
-		0xfd, 0xe2, 0x99, 0x98, 0xcc, 0xf1, 0xa5, 0xe4, 0xf7, 0x5f, 0x5b, 0x8e, 0x15, 0x2c, 0x98, 0xaa,
-		0x61, 0xed, 0x90, 0x06, 0x1b, 0x7d, 0x0d, 0x3e, 0xc6, 0x1b, 0x3c, 0x35, 0xd8, 0x20, 0x7b, 0x00,
-		0xa9, 0x86, 0xb5, 0x40, 0xb3, 0x35, 0xac, 0xf5, 0xb5, 0xd8, 0x80, 0x74, 0xc3, 0x70, 0xd9, 0xcb,
-		0x13, 0xcf, 0x40, 0x42, 0x37, 0xd8, 0xf3, 0xb8, 0x99, 0xca, 0x43, 0x47, 0xe8, 0x8a, 0x4c, 0x70,
-		0x85, 0x4d, 0x48, 0xd7, 0xb0, 0xc6, 0xa8, 0xaa, 0x90, 0x68, 0x61, 0x8d, 0x53, 0xdd, 0x81, 0x92,
-		0x04, 0x5d, 0xa9, 0xfd, 0xd6, 0x77, 0x96, 0x26, 0x5e, 0x79, 0x7d, 0x69, 0x62, 0xa4, 0x3b, 0x15,
-		0xa2, 0xdd, 0xc9, 0xf3, 0xa2, 0xff, 0x15, 0x83, 0x93, 0xfd, 0x91, 0x44, 0x35, 0x0e, 0x46, 0xbd,
-		0x2c, 0x7f, 0x01, 0x12, 0x65, 0xe3, 0x00, 0x9d, 0x64, 0x1b, 0x18, 0xa5, 0x67, 0x77, 0xf8, 0x48,
-		0x4c, 0x91, 0xeb, 0x1d, 0xbb, 0x43, 0x46, 0x48, 0xbc, 0xef, 0x14, 0x3b, 0x9d, 0xe5, 0x2f, 0x31,
-		0x55, 0x3e, 0x1c, 0x3b, 0x5a, 0xa6, 0x92, 0x2e, 0x1b, 0x07, 0xd4, 0x66, 0x5b, 0xb1, 0x17, 0x1f,
-		0x8e, 0xbc, 0x8f, 0x7d, 0xcd, 0x30, 0x6f, 0x18, 0x44, 0x6d, 0x6b, 0x57, 0xdc, 0xc3, 0x5e, 0xea,
-		0xbf, 0x87, 0xfd, 0x1c, 0xee, 0x74, 0x9e, 0x25, 0x72, 0xdb, 0xa1, 0xfe, 0xff, 0x7c, 0x1c, 0x96,
-		0x06, 0x22, 0x29, 0x4f, 0xf2, 0x46, 0x19, 0xa1, 0x04, 0xe9, 0x9a, 0xc8, 0x1d, 0xf3, 0x30, 0xe5,
-		0x60, 0xcd, 0x34, 0x5a, 0x0e, 0x35, 0x44, 0x42, 0x16, 0x97, 0xc4, 0x10, 0x86, 0x6a, 0x98, 0x0e,
-		0x7f, 0x19, 0x89, 0x5d, 0x54, 0x3e, 0x75, 0x44, 0x43, 0xcc, 0x88, 0x96, 0x84, 0x35, 0x1e, 0x1b,
-		0xd3, 0x1a, 0xa2, 0x13, 0xa1, 0x3b, 0xfb, 0xe3, 0x5a, 0xe5, 0x63, 0x71, 0x58, 0xee, 0xb7, 0x0a,
-		0xc9, 0x9c, 0x1d, 0x57, 0xed, 0x5a, 0xa3, 0xcc, 0x72, 0x11, 0x32, 0xdb, 0x42, 0xe6, 0xc8, 0x76,
-		0xb9, 0x75, 0x44, 0xbb, 0xe4, 0xbc, 0xa6, 0x84, 0x61, 0xce, 0x8d, 0x69, 0x18, 0xaf, 0x1f, 0x77,
-		0x64, 0x99, 0x2f, 0x24, 0xe1, 0x6e, 0xfa, 0xb6, 0xaa, 0xdd, 0xd5, 0x0d, 0xf7, 0xac, 0x66, 0x1f,
-		0x58, 0xae, 0x49, 0x1a, 0x32, 0xf7, 0xb8, 0x5d, 0xe6, 0xfc, 0xea, 0x22, 0xab, 0x1e, 0x1e, 0x72,
-		0x0b, 0x7b, 0x30, 0xb9, 0x45, 0x70, 0xc4, 0x22, 0xae, 0xe9, 0xaa, 0x1d, 0x6e, 0x29, 0x76, 0x41,
-		0x4a, 0xd9, 0x1b, 0xae, 0x71, 0x56, 0xaa, 0x8b, 0x97, 0x5b, 0x3b, 0x58, 0xdd, 0x63, 0x2f, 0x0a,
-		0x25, 0xe8, 0x14, 0x4b, 0x93, 0x02, 0xfa, 0x4e, 0xd0, 0x02, 0x4c, 0xaa, 0x3d, 0xf6, 0x30, 0x4f,
-		0x82, 0xcc, 0x3d, 0x7a, 0x51, 0x78, 0x16, 0xa6, 0xf8, 0x3d, 0x7d, 0x24, 0x41, 0xe2, 0x1a, 0x3e,
-		0xa0, 0xed, 0x64, 0x65, 0xf2, 0x13, 0x15, 0x61, 0x92, 0x2a, 0xcf, 0xdf, 0x80, 0xcc, 0x17, 0x07,
-		0xb4, 0x2f, 0x52, 0x25, 0x65, 0x26, 0x56, 0xb8, 0x02, 0xe9, 0x9a, 0x49, 0x56, 0x82, 0x30, 0x5b,
-		0x86, 0xb1, 0x51, 0x9d, 0xad, 0x1e, 0x8f, 0xc3, 0x32, 0xbb, 0x40, 0xc7, 0x21, 0xc5, 0x5e, 0x1c,
-		0xe3, 0x0f, 0x24, 0xf1, 0xab, 0x42, 0x15, 0xa6, 0x28, 0xf7, 0xa6, 0x45, 0xd2, 0x76, 0xef, 0x59,
-		0xfa, 0x0c, 0x7f, 0x8d, 0x98, 0xd3, 0xc7, 0x7d, 0x65, 0x11, 0x24, 0x5b, 0xaa, 0xab, 0xf2, 0x7e,
-		0xd3, 0xdf, 0x85, 0xf7, 0x40, 0x9a, 0x93, 0x38, 0xe8, 0x1c, 0x24, 0x4c, 0xcb, 0xe1, 0x8f, 0x14,
-		0x2d, 0x8e, 0xea, 0xca, 0xa6, 0x55, 0x49, 0x92, 0xd8, 0x2b, 0x13, 0xe1, 0xca, 0xc6, 0xc8, 0x30,
-		0xfa, 0x44, 0x28, 0x8c, 0x76, 0xb1, 0xbb, 0xbb, 0xe7, 0xfa, 0x3f, 0xd8, 0x70, 0x0e, 0xb8, 0x82,
-		0xe7, 0x28, 0xb7, 0xe2, 0xb0, 0x14, 0xa8, 0xbd, 0x8e, 0x6d, 0x47, 0x37, 0x0d, 0xe6, 0x89, 0xdc,
-		0x53, 0x50, 0x40, 0x41, 0x5e, 0x3f, 0xc2, 0x55, 0x9e, 0x81, 0x44, 0xd9, 0xb2, 0xd0, 0x22, 0xa4,
-		0xe9, 0xb5, 0x66, 0x32, 0x5f, 0x49, 0xca, 0xde, 0x35, 0xa9, 0x73, 0xcc, 0x3d, 0xf7, 0x86, 0x6a,
-		0x7b, 0xef, 0x55, 0x8b, 0xeb, 0xc2, 0xd3, 0x90, 0xa9, 0x9a, 0x86, 0x83, 0x0d, 0xa7, 0x47, 0xe7,
-		0xdf, 0x6e, 0xc7, 0xd4, 0xae, 0x71, 0x06, 0x76, 0x41, 0x8c, 0xad, 0x5a, 0x16, 0x45, 0x26, 0x65,
-		0xf2, 0x93, 0xad, 0x84, 0x95, 0xcd, 0x91, 0xe6, 0x39, 0x7f, 0x34, 0xf3, 0xf0, 0x0e, 0x7a, 0xf6,
-		0xf9, 0x93, 0x18, 0xdc, 0x35, 0x38, 0x91, 0xae, 0xe1, 0x03, 0xe7, 0xa8, 0xf3, 0xe8, 0x79, 0xc8,
-		0x6c, 0xd1, 0x4f, 0x9e, 0x3c, 0x8b, 0x0f, 0xd0, 0x22, 0x4c, 0xe1, 0xd6, 0xb9, 0xf3, 0xe7, 0x1f,
-		0x7b, 0x9a, 0x79, 0xf9, 0xe5, 0x09, 0x59, 0x14, 0xa0, 0x25, 0xc8, 0x38, 0x58, 0xb3, 0xce, 0x9d,
-		0xbf, 0x70, 0xed, 0x31, 0xe6, 0x56, 0x97, 0x27, 0x64, 0xbf, 0xa8, 0x94, 0x26, 0x3d, 0xfe, 0xfe,
-		0x67, 0x97, 0x63, 0x95, 0x49, 0x48, 0x38, 0xbd, 0xee, 0xdb, 0xe6, 0x1b, 0x3f, 0x4c, 0xc1, 0x4a,
-		0xa0, 0x96, 0x45, 0xa7, 0xeb, 0x6a, 0x47, 0x6f, 0xa9, 0xfe, 0x87, 0x6a, 0xa4, 0x40, 0xff, 0xa9,
-		0xc4, 0x88, 0xcc, 0xed, 0x50, 0x2b, 0x16, 0x7e, 0x39, 0x06, 0xd9, 0xab, 0x82, 0xb9, 0x89, 0x5d,
-		0x74, 0x11, 0xc0, 0x6b, 0x49, 0x4c, 0x95, 0x53, 0xc5, 0xfe, 0xb6, 0x8a, 0x1e, 0x46, 0x0e, 0x88,
-		0xa3, 0x27, 0xa9, 0x03, 0x5a, 0xa6, 0xc3, 0xdf, 0xb1, 0x8d, 0x80, 0x7a, 0xc2, 0xe8, 0x61, 0x40,
-		0x34, 0xaa, 0x29, 0xd7, 0x4d, 0x57, 0x37, 0xda, 0x8a, 0x65, 0xde, 0xe0, 0x5f, 0x2e, 0x48, 0xc8,
-		0x12, 0xad, 0xb9, 0x4a, 0x2b, 0xb6, 0x48, 0x39, 0x51, 0x3a, 0xe3, 0xb1, 0x90, 0xa5, 0x44, 0x6d,
-		0xb5, 0x6c, 0xec, 0x38, 0x3c, 0x70, 0x89, 0x4b, 0x74, 0x11, 0xa6, 0xac, 0xde, 0xae, 0x22, 0xa2,
-		0xc4, 0xf4, 0xb9, 0xbb, 0x86, 0xcd, 0x79, 0xe1, 0x1b, 0x7c, 0xd6, 0xa7, 0xac, 0xde, 0x2e, 0xf1,
-		0x94, 0x7b, 0x20, 0x3b, 0x44, 0x99, 0xe9, 0xeb, 0xbe, 0x1e, 0xf4, 0x2b, 0x3b, 0xbc, 0x07, 0x8a,
-		0x65, 0xeb, 0xa6, 0xad, 0xbb, 0x07, 0xf4, 0x51, 0xc0, 0x84, 0x2c, 0x89, 0x8a, 0x2d, 0x5e, 0x5e,
-		0xb8, 0x06, 0xb3, 0x4d, 0xba, 0xe5, 0xf6, 0x35, 0x3f, 0xef, 0xeb, 0x17, 0x8b, 0xd6, 0x6f, 0xa4,
-		0x66, 0xf1, 0x01, 0xcd, 0xce, 0xfc, 0xfb, 0x18, 0x4c, 0x57, 0xc8, 0xc4, 0x6d, 0xd4, 0x2e, 0x75,
-		0xd4, 0x36, 0x7a, 0x0c, 0x8e, 0x55, 0xd6, 0x36, 0xab, 0xcf, 0x2a, 0x8d, 0x9a, 0x72, 0x69, 0xad,
-		0xbc, 0xea, 0x3f, 0x7b, 0xbc, 0x78, 0xfc, 0xd5, 0x5b, 0x2b, 0x28, 0x20, 0xbb, 0x63, 0xd0, 0x75,
-		0x10, 0x9d, 0x85, 0x85, 0x30, 0xa4, 0x5c, 0x69, 0xd6, 0x37, 0xb6, 0xa5, 0xd8, 0xe2, 0xb1, 0x57,
-		0x6f, 0xad, 0xcc, 0x05, 0x10, 0xe5, 0x5d, 0x07, 0x1b, 0xee, 0x20, 0xa0, 0xba, 0xb9, 0xbe, 0xde,
-		0xd8, 0x96, 0xe2, 0x03, 0x80, 0xaa, 0xd9, 0xed, 0xea, 0x2e, 0x7a, 0x10, 0xe6, 0xc2, 0x80, 0x8d,
-		0xc6, 0x9a, 0x94, 0x58, 0x44, 0xaf, 0xde, 0x5a, 0xc9, 0x05, 0xa4, 0x37, 0xf4, 0xce, 0x62, 0xfa,
-		0x43, 0x9f, 0x5f, 0x9a, 0xf8, 0xd2, 0xdf, 0x5a, 0x8a, 0x55, 0xd6, 0x47, 0xce, 0xb9, 0xc7, 0x8f,
-		0x36, 0xe7, 0xc2, 0x79, 0xee, 0x5b, 0x77, 0x85, 0xc2, 0x0d, 0xdf, 0x53, 0x05, 0x82, 0xf1, 0xb8,
-		0xd3, 0x2d, 0x2a, 0x2f, 0x5a, 0x3c, 0x3c, 0x3d, 0x58, 0x8c, 0x58, 0x14, 0x16, 0x23, 0x03, 0x43,
-		0xe1, 0x69, 0x98, 0xd9, 0x52, 0x6d, 0xb7, 0x89, 0xdd, 0xcb, 0x58, 0x6d, 0x61, 0x3b, 0x9c, 0x3f,
-		0xcc, 0x88, 0xfc, 0x01, 0x41, 0x92, 0x26, 0x09, 0x6c, 0xfd, 0xa4, 0xbf, 0x0b, 0xfb, 0x90, 0xa4,
-		0x4f, 0x39, 0x7b, 0xb9, 0x05, 0x47, 0xb0, 0xdc, 0x82, 0xac, 0x0c, 0x07, 0x2e, 0x76, 0x44, 0xea,
-		0x4e, 0x2f, 0xd0, 0x13, 0x22, 0x43, 0x48, 0x1c, 0x9e, 0x21, 0xf0, 0xe9, 0xc5, 0xf3, 0x84, 0x0e,
-		0x4c, 0xf1, 0x21, 0xf6, 0x14, 0x89, 0xf9, 0x8a, 0xa0, 0x75, 0x98, 0xb5, 0x54, 0xdb, 0xa5, 0x6f,
-		0x3d, 0xee, 0xd3, 0x5e, 0xf0, 0x19, 0xbc, 0x3c, 0x18, 0x4f, 0x42, 0x9d, 0xe5, 0xad, 0xcc, 0x58,
-		0xc1, 0xc2, 0xc2, 0x7f, 0x4a, 0x42, 0x8a, 0x1b, 0xe3, 0x19, 0x98, 0xe2, 0x66, 0xe5, 0x73, 0xee,
-		0xee, 0xe2, 0xe0, 0x32, 0x5b, 0xf4, 0x96, 0x43, 0xce, 0x27, 0x30, 0xe8, 0x7e, 0x48, 0x6b, 0xfb,
-		0xaa, 0x6e, 0x28, 0x7a, 0x8b, 0x6f, 0x26, 0xa7, 0x5f, 0xbf, 0xbd, 0x3c, 0x55, 0x25, 0x65, 0x8d,
-		0x9a, 0x3c, 0x45, 0x2b, 0x1b, 0x2d, 0x92, 0xd3, 0xec, 0x63, 0xbd, 0xbd, 0xef, 0xf2, 0xb8, 0xc1,
-		0xaf, 0xd0, 0x53, 0x90, 0x24, 0x0e, 0xc1, 0xdf, 0x89, 0x5f, 0x1c, 0x38, 0x25, 0xf0, 0xd2, 0xd6,
-		0x4a, 0x9a, 0x34, 0xfc, 0x91, 0xdf, 0x59, 0x8e, 0xc9, 0x14, 0x81, 0xaa, 0x30, 0xd3, 0x51, 0x1d,
-		0x57, 0xa1, 0xeb, 0x31, 0x69, 0x7e, 0x92, 0x52, 0x9c, 0x1c, 0x34, 0x08, 0x37, 0x2c, 0x57, 0x7d,
-		0x9a, 0xa0, 0x58, 0x51, 0x0b, 0x9d, 0x06, 0x89, 0x92, 0x68, 0x74, 0x06, 0xb2, 0x2c, 0x31, 0x45,
-		0xed, 0x9e, 0x23, 0xe5, 0x6c, 0x62, 0xd2, 0x5c, 0xf1, 0x14, 0x64, 0xe8, 0x5b, 0xb8, 0x54, 0x84,
-		0x3d, 0x5a, 0x9f, 0x26, 0x05, 0xb4, 0xf2, 0x01, 0x98, 0xf5, 0xa3, 0x3e, 0x13, 0x49, 0x33, 0x16,
-		0xbf, 0x98, 0x0a, 0x3e, 0x0a, 0x0b, 0x06, 0xbe, 0x49, 0x1f, 0xf6, 0x0f, 0x49, 0x67, 0xa8, 0x34,
-		0x22, 0x75, 0x57, 0xc3, 0x88, 0xfb, 0x20, 0xa7, 0x09, 0xe3, 0x33, 0x59, 0xa0, 0xb2, 0x33, 0x5e,
-		0x29, 0x15, 0x3b, 0x09, 0x69, 0xd5, 0xb2, 0x98, 0xc0, 0x34, 0x8f, 0xfa, 0x96, 0x45, 0xab, 0xce,
-		0xc0, 0x1c, 0xed, 0xa3, 0x8d, 0x9d, 0x5e, 0xc7, 0xe5, 0x24, 0x59, 0x2a, 0x33, 0x4b, 0x2a, 0x64,
-		0x56, 0x4e, 0x65, 0xef, 0x85, 0x19, 0x7c, 0x5d, 0x6f, 0x61, 0x43, 0xc3, 0x4c, 0x6e, 0x86, 0xca,
-		0x65, 0x45, 0x21, 0x15, 0x7a, 0x10, 0xbc, 0x68, 0xae, 0x88, 0x95, 0x26, 0xc7, 0xf8, 0x44, 0x79,
-		0x99, 0x15, 0x17, 0xf2, 0x90, 0xac, 0xa9, 0xae, 0x4a, 0xd2, 0x25, 0xf7, 0x26, 0x5b, 0x3e, 0xb3,
-		0x32, 0xf9, 0x59, 0xf8, 0x46, 0x02, 0x92, 0x57, 0x4d, 0x17, 0xa3, 0xc7, 0x03, 0xa9, 0x6c, 0x6e,
-		0x98, 0x3f, 0x37, 0xf5, 0xb6, 0x81, 0x5b, 0xeb, 0x4e, 0x3b, 0xf0, 0xc9, 0x1c, 0xdf, 0x9d, 0xe2,
-		0x21, 0x77, 0x5a, 0x80, 0x49, 0xdb, 0xec, 0x19, 0x2d, 0xf1, 0x50, 0x3a, 0xbd, 0x40, 0x75, 0x48,
-		0x7b, 0x5e, 0x92, 0x8c, 0xf2, 0x92, 0x59, 0xe2, 0x25, 0xc4, 0x87, 0x79, 0x81, 0x3c, 0xb5, 0xcb,
-		0x9d, 0xa5, 0x02, 0x19, 0x2f, 0x78, 0x71, 0x6f, 0x1b, 0xcf, 0x61, 0x7d, 0x18, 0x59, 0x22, 0xbd,
-		0xb1, 0xf7, 0x8c, 0xc7, 0x3c, 0x4e, 0xf2, 0x2a, 0xb8, 0xf5, 0x42, 0x6e, 0xc5, 0x3f, 0xdf, 0x33,
-		0x45, 0xfb, 0xe5, 0xbb, 0x15, 0xfb, 0x84, 0xcf, 0x5d, 0x90, 0x71, 0xf4, 0xb6, 0xa1, 0xba, 0x3d,
-		0x1b, 0x73, 0xcf, 0xf3, 0x0b, 0x48, 0xad, 0xff, 0x82, 0x06, 0xf3, 0xb4, 0xc0, 0x57, 0xc5, 0xce,
-		0xc2, 0xbc, 0xff, 0x3d, 0x2f, 0x9f, 0x85, 0x79, 0x19, 0xf2, 0xaa, 0x9a, 0xa2, 0xa6, 0xf0, 0x6b,
-		0x31, 0x48, 0xf1, 0x15, 0xcb, 0x1f, 0x86, 0xd8, 0xf0, 0x61, 0x88, 0x8f, 0x1a, 0x86, 0xc4, 0x9d,
-		0x0f, 0x43, 0x19, 0xc0, 0x53, 0xd3, 0xe1, 0x1f, 0x69, 0x19, 0x92, 0x56, 0x31, 0x15, 0x9b, 0x7a,
-		0x9b, 0xcf, 0xfb, 0x00, 0xa8, 0xf0, 0x1f, 0x63, 0x24, 0xc3, 0xe7, 0xf5, 0xa8, 0x0c, 0x33, 0x42,
-		0x2f, 0x65, 0xaf, 0xa3, 0xb6, 0xb9, 0x2b, 0xde, 0x3d, 0x52, 0x39, 0xb2, 0x0a, 0xcb, 0xd3, 0x5c,
-		0x1f, 0x9a, 0x4f, 0x0c, 0x1d, 0xd6, 0xf8, 0x88, 0x61, 0x0d, 0xf9, 0x51, 0xe2, 0xce, 0xfc, 0x28,
-		0x34, 0xe2, 0xc9, 0xbe, 0x11, 0x2f, 0x7c, 0x27, 0xc6, 0xbf, 0x26, 0xd6, 0x62, 0x6f, 0x97, 0xfc,
-		0x69, 0x0d, 0xd5, 0x8b, 0xdc, 0xb7, 0x5a, 0xb8, 0xa5, 0x0c, 0x8c, 0xd9, 0xbd, 0x83, 0x8c, 0x61,
-		0x9d, 0xfd, 0xb1, 0x43, 0x82, 0xa5, 0xe9, 0x8f, 0xe1, 0xd7, 0xe2, 0x30, 0x37, 0x20, 0xff, 0xff,
-		0xde, 0x58, 0x86, 0x67, 0xef, 0xe4, 0x98, 0xb3, 0x37, 0x35, 0x72, 0xf6, 0x7e, 0x35, 0x4e, 0x0f,
-		0x00, 0x2c, 0xd3, 0x51, 0x3b, 0xef, 0x44, 0xec, 0x3d, 0x05, 0x19, 0xcb, 0xec, 0x28, 0xac, 0x86,
-		0xbd, 0x16, 0x94, 0xb6, 0xcc, 0x8e, 0x3c, 0xe0, 0x66, 0x93, 0x3f, 0xa2, 0xc0, 0x9c, 0xfa, 0x11,
-		0x0c, 0xc2, 0x54, 0xff, 0x84, 0xb2, 0x21, 0xcb, 0x4c, 0xc1, 0xb3, 0xa6, 0x47, 0x89, 0x0d, 0x68,
-		0x1a, 0x16, 0x1b, 0xcc, 0xf2, 0x98, 0xda, 0x4c, 0x52, 0xe6, 0x72, 0x04, 0xc1, 0x92, 0x8c, 0x61,
-		0x27, 0x47, 0xc1, 0x88, 0x25, 0x73, 0xb9, 0xc2, 0xdf, 0x88, 0x01, 0xac, 0x11, 0xcb, 0xd2, 0xfe,
-		0x92, 0x7c, 0xc7, 0xa1, 0x2a, 0x28, 0xa1, 0x96, 0x97, 0x46, 0x0d, 0x1a, 0x6f, 0x3f, 0xeb, 0x04,
-		0xf5, 0xae, 0xc2, 0x8c, 0xef, 0xdb, 0x0e, 0x16, 0xca, 0x2c, 0x1d, 0xb2, 0x2b, 0x6d, 0x62, 0x57,
-		0xce, 0x5e, 0x0f, 0x5c, 0x15, 0xfe, 0x69, 0x0c, 0x32, 0x54, 0xa7, 0x75, 0xec, 0xaa, 0xa1, 0x31,
-		0x8c, 0xdd, 0xf9, 0x18, 0xde, 0x0d, 0xc0, 0x68, 0x1c, 0xfd, 0x65, 0xcc, 0x3d, 0x2b, 0x43, 0x4b,
-		0x9a, 0xfa, 0xcb, 0x18, 0x5d, 0xf0, 0x0c, 0x9e, 0x38, 0xdc, 0xe0, 0x62, 0xd7, 0xca, 0xcd, 0x7e,
-		0x02, 0xa6, 0xe8, 0xf7, 0x2e, 0x6f, 0x3a, 0x7c, 0x23, 0x9a, 0x32, 0x7a, 0xdd, 0xed, 0x9b, 0x4e,
-		0xe1, 0x25, 0x98, 0xda, 0xbe, 0xc9, 0xce, 0x13, 0x4f, 0x41, 0xc6, 0x36, 0x4d, 0x9e, 0xfd, 0xb1,
-		0xac, 0x3b, 0x4d, 0x0a, 0x68, 0xb2, 0x23, 0xce, 0xd0, 0xe2, 0xfe, 0x19, 0x9a, 0x7f, 0x08, 0x98,
-		0x18, 0xeb, 0x10, 0x90, 0xec, 0x3e, 0x67, 0x42, 0x33, 0x09, 0x3d, 0x0c, 0x27, 0x9a, 0x8d, 0xd5,
-		0x8d, 0x7a, 0x4d, 0x59, 0x6f, 0xae, 0xf6, 0xbd, 0xf2, 0xbf, 0x38, 0xfb, 0xea, 0xad, 0x95, 0x69,
-		0xbe, 0xed, 0x1c, 0x25, 0xbd, 0x25, 0xd7, 0xaf, 0x6e, 0x6e, 0xd7, 0xa5, 0x18, 0x93, 0xde, 0xb2,
-		0xf1, 0x75, 0xd3, 0x65, 0x1f, 0x94, 0x7d, 0x14, 0x4e, 0x0e, 0x91, 0xf6, 0x36, 0x9f, 0x73, 0xaf,
-		0xde, 0x5a, 0x99, 0xd9, 0xb2, 0x31, 0xf3, 0x32, 0x8a, 0x28, 0x42, 0x7e, 0x10, 0xb1, 0xb9, 0xb5,
-		0xd9, 0x2c, 0xaf, 0x49, 0x2b, 0x8b, 0xd2, 0xab, 0xb7, 0x56, 0xb2, 0x22, 0x64, 0x10, 0xf9, 0xb7,
-		0x7f, 0xf7, 0xf9, 0x0b, 0x17, 0x60, 0x45, 0x37, 0x74, 0x57, 0x57, 0xcf, 0x76, 0x1d, 0x57, 0xbd,
-		0xa6, 0x1b, 0xed, 0xb3, 0xd7, 0x1f, 0x3b, 0xcb, 0x7f, 0x8a, 0xe3, 0x40, 0x26, 0x51, 0x14, 0x12,
-		0xc5, 0xeb, 0x8f, 0x2d, 0x46, 0xdc, 0xe1, 0x3b, 0xec, 0xb6, 0xdd, 0x88, 0xfb, 0x7c, 0x23, 0x6f,
-		0xf6, 0x2c, 0x46, 0xdc, 0x07, 0x89, 0xde, 0xf9, 0x1e, 0xba, 0xc1, 0x2e, 0x7c, 0x38, 0x0e, 0xb3,
-		0x34, 0x22, 0x38, 0xec, 0xeb, 0x9a, 0x2e, 0xfd, 0xd6, 0x52, 0xd2, 0x56, 0x5d, 0x7e, 0xec, 0x5b,
-		0xb9, 0x77, 0x8c, 0x3b, 0x62, 0x32, 0x05, 0xa0, 0xf7, 0x43, 0xba, 0xab, 0xde, 0x54, 0x28, 0x98,
-		0x6d, 0xd3, 0x2e, 0x8c, 0x01, 0x7e, 0xe3, 0xf6, 0xf2, 0xec, 0x81, 0xda, 0xed, 0x94, 0x0a, 0x02,
-		0x5c, 0x90, 0xa7, 0xba, 0xea, 0x4d, 0xa2, 0x0c, 0xc2, 0x30, 0x4b, 0x4a, 0xb5, 0x7d, 0xd5, 0x68,
-		0x63, 0xc6, 0x4c, 0x8f, 0xab, 0x2b, 0xcf, 0x8c, 0xc7, 0x7c, 0xdc, 0x67, 0x0e, 0x70, 0x14, 0xe4,
-		0x99, 0xae, 0x7a, 0xb3, 0x4a, 0x0b, 0x48, 0x33, 0xa5, 0xf4, 0xc7, 0x5f, 0x5b, 0x9e, 0xa0, 0x37,
-		0x19, 0xff, 0x55, 0x0c, 0xc0, 0x37, 0x08, 0x7a, 0x1e, 0x24, 0xcd, 0xbb, 0xa2, 0x58, 0x87, 0xc7,
-		0x9f, 0x7b, 0x8b, 0x83, 0x9e, 0x51, 0xec, 0x33, 0x25, 0x5b, 0x04, 0xbe, 0x7d, 0x7b, 0x39, 0x26,
-		0xcf, 0x6a, 0x7d, 0x56, 0xfe, 0x09, 0x98, 0xee, 0x59, 0x2d, 0xd5, 0xc5, 0x0a, 0xdd, 0x9a, 0xc6,
-		0x23, 0x17, 0x94, 0x25, 0xc2, 0xf5, 0xc6, 0xed, 0x65, 0xc4, 0xba, 0x14, 0x00, 0x17, 0xe8, 0x32,
-		0x03, 0xac, 0x84, 0x00, 0x02, 0xfd, 0xf9, 0x8d, 0x18, 0x4c, 0xd7, 0x02, 0x0f, 0xd5, 0xe4, 0x61,
-		0xaa, 0x6b, 0x1a, 0xfa, 0x35, 0x1e, 0xda, 0x33, 0xb2, 0xb8, 0x44, 0x8b, 0x90, 0x66, 0xef, 0x9a,
-		0xbb, 0x07, 0xe2, 0xac, 0x5a, 0x5c, 0x13, 0xd4, 0x0d, 0xbc, 0xeb, 0xe8, 0xc2, 0xfc, 0xb2, 0xb8,
-		0x44, 0x97, 0x40, 0x72, 0xb0, 0xd6, 0xb3, 0x75, 0xf7, 0x40, 0xd1, 0x4c, 0xc3, 0x55, 0x35, 0x97,
-		0xbd, 0xb5, 0x5c, 0x39, 0xf5, 0xc6, 0xed, 0xe5, 0x13, 0x4c, 0xd7, 0x7e, 0x89, 0x82, 0x3c, 0x2b,
-		0x8a, 0xaa, 0xac, 0x84, 0xb4, 0xd0, 0xc2, 0xae, 0xaa, 0x77, 0x9c, 0x3c, 0xbb, 0x47, 0x2f, 0x2e,
-		0x03, 0x7d, 0xf9, 0x66, 0x26, 0x78, 0x02, 0x79, 0x09, 0x24, 0xd3, 0xc2, 0x76, 0x28, 0x81, 0x8a,
-		0xf5, 0xb7, 0xdc, 0x2f, 0x51, 0x90, 0x67, 0x45, 0x91, 0x48, 0xae, 0x5c, 0x32, 0xc4, 0x62, 0xef,
-		0x6b, 0xf5, 0x76, 0xfd, 0x83, 0xcb, 0x85, 0x81, 0xd1, 0x28, 0x1b, 0x07, 0x95, 0xc7, 0x7d, 0xf6,
-		0x7e, 0x5c, 0xe1, 0x37, 0xbf, 0xf6, 0xc8, 0x02, 0x9f, 0xef, 0xfe, 0x41, 0xe2, 0xb3, 0xf8, 0x80,
-		0x0c, 0x3f, 0x17, 0xdd, 0xa2, 0x92, 0x24, 0xbf, 0x79, 0x49, 0xd5, 0x3b, 0xe2, 0xab, 0x1e, 0x32,
-		0xbf, 0x22, 0x4b, 0x90, 0xe3, 0xaa, 0x6e, 0xcf, 0xe1, 0x9f, 0x8a, 0x5d, 0x1a, 0xe6, 0x66, 0x15,
-		0xd3, 0x68, 0x35, 0xa9, 0x94, 0xcc, 0xa5, 0x91, 0x0b, 0x29, 0xd7, 0xbc, 0x86, 0x0d, 0x87, 0x7f,
-		0x27, 0xf9, 0x64, 0x71, 0xc8, 0x63, 0x06, 0xc5, 0xaa, 0xa9, 0x1b, 0x95, 0x32, 0x77, 0xa4, 0x19,
-		0xd6, 0x09, 0x06, 0x2b, 0x7c, 0xf9, 0x77, 0x96, 0x4f, 0x47, 0xdf, 0xa2, 0xa6, 0x0c, 0x8e, 0xcc,
-		0xdb, 0x42, 0x1f, 0x8b, 0x81, 0xd4, 0xc2, 0x1d, 0xdc, 0x66, 0x4b, 0xfd, 0xbe, 0x4a, 0x12, 0x6f,
-		0xf6, 0xe5, 0xe9, 0xbb, 0x86, 0x2a, 0xc0, 0x1f, 0x19, 0xa8, 0x6c, 0x70, 0x1d, 0xb8, 0x21, 0xfb,
-		0x39, 0x88, 0x36, 0x63, 0x3c, 0x7f, 0x21, 0xe8, 0x1c, 0x79, 0xd6, 0x63, 0x68, 0x52, 0x02, 0xb4,
-		0x1a, 0x7e, 0xbc, 0x4c, 0x7c, 0xa4, 0x79, 0x88, 0x31, 0x03, 0xb3, 0x43, 0x1c, 0xde, 0x04, 0x9f,
-		0x42, 0xbb, 0x04, 0x52, 0xcf, 0xd8, 0x35, 0x0d, 0xfa, 0xb2, 0x3d, 0x4f, 0x49, 0xc9, 0xe6, 0x37,
-		0x11, 0x74, 0xb3, 0x7e, 0x89, 0x82, 0x3c, 0xeb, 0x15, 0x5d, 0x66, 0x89, 0x6b, 0x0b, 0x72, 0xbe,
-		0x14, 0x9d, 0xf2, 0x99, 0xc8, 0x29, 0x7f, 0x0f, 0xb7, 0xd2, 0xb1, 0xfe, 0x56, 0xfc, 0x59, 0x3f,
-		0xe3, 0x15, 0x12, 0x18, 0xaa, 0x01, 0xf8, 0x81, 0x86, 0x6e, 0xaf, 0xa7, 0x87, 0xbb, 0x90, 0x1f,
-		0xa9, 0xc4, 0xce, 0xd5, 0xc7, 0xa1, 0x0f, 0xc5, 0x60, 0x26, 0x78, 0xd8, 0xed, 0xd0, 0x4f, 0xd3,
-		0x1f, 0xea, 0x54, 0x97, 0xb9, 0xaa, 0x0b, 0x4c, 0xd5, 0x10, 0xfa, 0x68, 0xbe, 0x95, 0x0d, 0x1c,
-		0xa9, 0x3b, 0xe8, 0xb9, 0xbe, 0x63, 0x77, 0xfa, 0xc1, 0xf5, 0xca, 0x13, 0x87, 0x3e, 0xf1, 0xf1,
-		0xc6, 0xed, 0xe5, 0xf9, 0x41, 0x35, 0x0a, 0xe1, 0xdb, 0x08, 0xef, 0x86, 0x53, 0xbe, 0x3d, 0x4d,
-		0x43, 0xd9, 0x37, 0x3b, 0x2d, 0xc5, 0xc6, 0x7b, 0x8a, 0x46, 0x9f, 0x59, 0x99, 0xa1, 0x79, 0xdc,
-		0x09, 0x4f, 0x64, 0xd3, 0xb8, 0x6c, 0x76, 0x5a, 0x32, 0xde, 0xab, 0x92, 0x6a, 0x74, 0x0f, 0x64,
-		0x7d, 0xb4, 0xde, 0xa2, 0x27, 0x53, 0x49, 0x79, 0xda, 0x2b, 0x6b, 0xb4, 0x4a, 0xd9, 0x0f, 0xbd,
-		0xb6, 0x3c, 0xc1, 0x63, 0xd7, 0x44, 0xe1, 0x02, 0xbd, 0xe3, 0xc3, 0x63, 0x0e, 0x76, 0xc8, 0x4e,
-		0x40, 0x15, 0x17, 0xf4, 0xc4, 0x2a, 0x23, 0xfb, 0x05, 0x2c, 0xe6, 0xbd, 0xf2, 0x1f, 0x56, 0x62,
-		0x85, 0xaf, 0xc4, 0x20, 0x55, 0xbb, 0xba, 0xa5, 0xea, 0x36, 0x6a, 0xc0, 0x9c, 0x3f, 0x4f, 0xc2,
-		0x11, 0xef, 0xae, 0x37, 0x6e, 0x2f, 0xe7, 0xfb, 0xa7, 0x92, 0x17, 0xf2, 0xfc, 0x29, 0x2a, 0x62,
-		0x5e, 0x63, 0xd4, 0xee, 0x33, 0x44, 0x35, 0x20, 0x52, 0x18, 0xdc, 0x9b, 0xf6, 0x75, 0xb3, 0x0c,
-		0x53, 0x4c, 0x5b, 0x07, 0x5d, 0x80, 0x49, 0x8b, 0xfc, 0xf0, 0xee, 0xfc, 0x0e, 0x9b, 0x7b, 0x54,
-		0xd6, 0x3b, 0xa4, 0x26, 0xe2, 0x85, 0x8f, 0xc6, 0x01, 0x6a, 0x57, 0xaf, 0x6e, 0xdb, 0xba, 0xd5,
-		0xc1, 0xee, 0x8f, 0xb2, 0xd7, 0xdb, 0x70, 0x2c, 0xb0, 0x2f, 0xb1, 0xb5, 0xbe, 0x9e, 0xaf, 0xbc,
-		0x71, 0x7b, 0xf9, 0xae, 0xfe, 0x9e, 0x07, 0xc4, 0x0a, 0xf2, 0xbc, 0xbf, 0x43, 0xb1, 0xb5, 0xa1,
-		0xac, 0x2d, 0xc7, 0xf5, 0x58, 0x13, 0xa3, 0x59, 0x03, 0x62, 0x41, 0xd6, 0x9a, 0xe3, 0x0e, 0x37,
-		0xeb, 0x26, 0x4c, 0xfb, 0x26, 0x71, 0xd0, 0xfb, 0x20, 0xed, 0xf2, 0xdf, 0xdc, 0xba, 0x4b, 0xc3,
-		0xad, 0x2b, 0x20, 0xdc, 0xc2, 0x1e, 0xaa, 0xf0, 0x15, 0x62, 0x64, 0x66, 0x1f, 0x32, 0xe1, 0x7f,
-		0x2c, 0x5d, 0x0b, 0xbd, 0x0c, 0x29, 0xbe, 0xa4, 0x24, 0xc6, 0x58, 0x52, 0x6a, 0xe1, 0x65, 0xed,
-		0x0e, 0x17, 0x12, 0xde, 0x62, 0x9f, 0xfd, 0x7f, 0x26, 0x0e, 0xf3, 0x3b, 0x62, 0x6e, 0xff, 0xd8,
-		0xdb, 0x6d, 0x0d, 0xa6, 0xb0, 0xe1, 0xda, 0xba, 0x67, 0xb8, 0x87, 0x87, 0x79, 0xc7, 0x90, 0xfe,
-		0xd0, 0xef, 0x45, 0x8a, 0x9b, 0x2f, 0x9c, 0xa2, 0xcf, 0x12, 0xbf, 0x9a, 0x84, 0xfc, 0x28, 0x24,
-		0xaa, 0xc2, 0xac, 0x66, 0x63, 0x5a, 0xa0, 0x04, 0xcf, 0x01, 0x2b, 0x8b, 0x7e, 0x2a, 0xde, 0x27,
-		0x50, 0x90, 0x73, 0xa2, 0x84, 0x2f, 0x94, 0x6d, 0x20, 0xb9, 0x32, 0x71, 0x53, 0x22, 0x35, 0x66,
-		0x72, 0x5c, 0xe0, 0x83, 0x2f, 0x1a, 0x09, 0x13, 0xb0, 0xa5, 0x32, 0xe7, 0x97, 0xd2, 0xb5, 0xf2,
-		0xc3, 0x31, 0x98, 0x65, 0x76, 0xe9, 0x28, 0xbb, 0x6a, 0x47, 0x35, 0x34, 0xf1, 0xe7, 0x2e, 0x0e,
-		0x59, 0xe7, 0xae, 0x84, 0x1b, 0xea, 0xc3, 0x1f, 0x6d, 0xa5, 0xcb, 0x71, 0x74, 0x85, 0x81, 0xd1,
-		0x0d, 0x98, 0x12, 0x7a, 0x24, 0xa3, 0xf4, 0xa8, 0x70, 0x3d, 0x72, 0x4c, 0x8f, 0x3b, 0x6a, 0x5f,
-		0xb4, 0x36, 0xb0, 0x9a, 0x4d, 0x0e, 0xac, 0x66, 0x51, 0xcb, 0x65, 0xea, 0xd0, 0xe5, 0x32, 0x90,
-		0xc3, 0xff, 0x7a, 0x12, 0xe6, 0x64, 0xdc, 0xfa, 0x33, 0xc7, 0xf9, 0x51, 0x3a, 0xce, 0x4f, 0xc7,
-		0x00, 0x58, 0xdc, 0x22, 0x2b, 0x0b, 0x77, 0x9e, 0xc3, 0xa3, 0xa5, 0xc8, 0xd7, 0xe6, 0x82, 0xd1,
-		0x92, 0xa0, 0x8f, 0x1c, 0x31, 0x33, 0x0c, 0x5b, 0x73, 0xdc, 0x77, 0xd2, 0x8f, 0x7e, 0x3b, 0x0e,
-		0xd9, 0xa0, 0x1f, 0xfd, 0x7f, 0x9a, 0x27, 0xa0, 0xba, 0x1f, 0xeb, 0x93, 0xfc, 0x6f, 0xd9, 0x0c,
-		0x89, 0xf5, 0x03, 0x33, 0xee, 0xf0, 0x20, 0xff, 0xd5, 0x24, 0xa4, 0xb6, 0x54, 0x5b, 0xed, 0x3a,
-		0x48, 0x1b, 0xd8, 0xb6, 0x88, 0xe3, 0xd7, 0x81, 0xbf, 0x56, 0xc3, 0x0f, 0xa6, 0x22, 0x76, 0x2d,
-		0x1f, 0x1f, 0xb2, 0x6b, 0x79, 0x1f, 0xe4, 0xba, 0xea, 0xcd, 0xc0, 0xfd, 0x6a, 0x6a, 0xe9, 0x99,
-		0xca, 0x49, 0x9f, 0x25, 0x5c, 0xcf, 0x0e, 0x70, 0xae, 0x06, 0x9f, 0x81, 0x9a, 0x26, 0x12, 0xfe,
-		0xb2, 0x47, 0xe0, 0xc7, 0xfd, 0xd3, 0x92, 0x40, 0x65, 0x41, 0x86, 0xae, 0x7a, 0xb3, 0xce, 0x2e,
-		0xd0, 0x1a, 0xa0, 0x7d, 0xdd, 0x71, 0x4d, 0x5b, 0xd7, 0xd4, 0x8e, 0xe2, 0x9b, 0x92, 0xe0, 0xef,
-		0x7e, 0xe3, 0xf6, 0xf2, 0x49, 0x86, 0x1f, 0x94, 0x29, 0xc8, 0x73, 0x7e, 0xa1, 0x60, 0x7b, 0x12,
-		0xa6, 0x49, 0xbf, 0x14, 0xfa, 0x04, 0x3c, 0xdb, 0x8a, 0x67, 0x82, 0x6a, 0x04, 0x2a, 0x0b, 0x32,
-		0x90, 0xab, 0x1a, 0xbd, 0x40, 0x75, 0x90, 0xba, 0xba, 0x11, 0x7e, 0x10, 0x8b, 0xcc, 0x85, 0x64,
-		0x70, 0x97, 0xd9, 0x2f, 0x51, 0x90, 0x73, 0x5d, 0xdd, 0x08, 0x3c, 0xa3, 0x85, 0x3e, 0x00, 0xf3,
-		0x44, 0xa8, 0xef, 0xc8, 0x8a, 0xfd, 0xc5, 0x16, 0xb6, 0xef, 0x8f, 0x3e, 0x32, 0x5b, 0xf4, 0x1b,
-		0xeb, 0xe3, 0x29, 0xc8, 0x73, 0x5d, 0xdd, 0x08, 0x9f, 0x71, 0x05, 0xa6, 0xe4, 0xbf, 0x8c, 0x01,
-		0xf2, 0x33, 0x02, 0x19, 0x3b, 0x96, 0x69, 0x38, 0x74, 0x4b, 0xea, 0x3b, 0x9f, 0x77, 0x3f, 0x61,
-		0xe8, 0x46, 0x5c, 0x48, 0x89, 0x2d, 0x69, 0x60, 0x7a, 0x63, 0x7f, 0x6d, 0x8c, 0x47, 0x85, 0x5a,
-		0xfa, 0x6a, 0xc3, 0x1d, 0xad, 0x84, 0x5e, 0x6f, 0x26, 0x0a, 0xdf, 0x8b, 0xc1, 0xc9, 0x81, 0x69,
-		0xe3, 0x75, 0xea, 0x45, 0x40, 0x76, 0xa0, 0x92, 0x7f, 0x79, 0x9b, 0x75, 0xee, 0x48, 0x33, 0x70,
-		0xce, 0x1e, 0x58, 0x0c, 0xdf, 0xa1, 0xae, 0xb2, 0x97, 0x2a, 0xbe, 0x11, 0x83, 0x85, 0xa0, 0x6e,
-		0x5e, 0x0f, 0xaf, 0x40, 0x36, 0xa8, 0x1a, 0xef, 0xdb, 0x4a, 0x54, 0xdf, 0x78, 0xb7, 0x42, 0x58,
-		0xb4, 0xee, 0x07, 0x29, 0xf1, 0x91, 0xcc, 0x71, 0x4c, 0x24, 0x74, 0xe9, 0x0f, 0x56, 0x49, 0x3a,
-		0x40, 0xff, 0x33, 0x01, 0xc9, 0x2d, 0xd3, 0xec, 0xa0, 0x2f, 0xc5, 0x60, 0xce, 0x30, 0x5d, 0x85,
-		0x4c, 0x27, 0xdc, 0x52, 0xf8, 0x31, 0x58, 0x2c, 0xca, 0x74, 0x2a, 0x21, 0xfd, 0xfd, 0xdb, 0xcb,
-		0x83, 0x58, 0x7f, 0xb9, 0x18, 0xa8, 0x3a, 0xda, 0x3a, 0x3d, 0x6b, 0x98, 0x6e, 0x85, 0xc2, 0xb7,
-		0xd9, 0x79, 0xd9, 0x27, 0x62, 0x30, 0x13, 0x56, 0x33, 0x72, 0x84, 0x5f, 0xe0, 0x6a, 0xce, 0xf4,
-		0xab, 0xb8, 0xe0, 0x87, 0x94, 0x3b, 0x54, 0x2f, 0xbb, 0x1b, 0xd4, 0xed, 0x73, 0x31, 0x58, 0x08,
-		0x46, 0x17, 0xe5, 0x06, 0x4d, 0xab, 0xc6, 0xdb, 0x7c, 0xc9, 0x3c, 0xe6, 0x9f, 0x1a, 0x3c, 0x77,
-		0x11, 0x3c, 0x47, 0x4e, 0x2c, 0x50, 0xe0, 0xb8, 0xe6, 0x39, 0xc6, 0xc1, 0x9e, 0x06, 0xfe, 0xe1,
-		0x6b, 0xcb, 0xb1, 0x33, 0x5f, 0x8f, 0x01, 0xf8, 0xe7, 0xa0, 0xe8, 0x61, 0x38, 0x51, 0xd9, 0xdc,
-		0xa8, 0x29, 0xcd, 0xed, 0xf2, 0xf6, 0x4e, 0x33, 0xfc, 0x96, 0xa7, 0xb8, 0xd7, 0xe5, 0x58, 0x58,
-		0xa3, 0xdf, 0x4e, 0x47, 0xf7, 0xc3, 0x42, 0x58, 0x9a, 0x5c, 0xd5, 0x6b, 0x52, 0x6c, 0x31, 0xfb,
-		0xea, 0xad, 0x95, 0x34, 0xdb, 0xe0, 0xe0, 0x16, 0x3a, 0x0d, 0xc7, 0x06, 0xe5, 0x1a, 0x1b, 0xab,
-		0x52, 0x7c, 0x71, 0xe6, 0xd5, 0x5b, 0x2b, 0x19, 0x6f, 0x27, 0x84, 0x0a, 0x80, 0x82, 0x92, 0x9c,
-		0x2f, 0xb1, 0x08, 0xaf, 0xde, 0x5a, 0x49, 0x31, 0x1f, 0x58, 0x4c, 0x7e, 0xe8, 0xf3, 0x4b, 0x13,
-		0x67, 0x7e, 0x12, 0xa0, 0x61, 0xec, 0xd9, 0x2a, 0xfd, 0x74, 0x23, 0x5a, 0x84, 0xe3, 0x8d, 0x8d,
-		0x4b, 0x72, 0xb9, 0xca, 0x3f, 0xf6, 0x1d, 0x7c, 0x39, 0x35, 0x5c, 0xc7, 0xfe, 0x62, 0x95, 0xd2,
-		0x6c, 0xac, 0x6e, 0x48, 0x31, 0xfa, 0x55, 0xdb, 0x60, 0xdd, 0x73, 0xec, 0x73, 0xe0, 0xf1, 0xca,
-		0xe5, 0x91, 0x37, 0xcb, 0x8a, 0x01, 0xe3, 0xb3, 0xe9, 0xf7, 0x48, 0x47, 0xdd, 0x75, 0xf8, 0xef,
-		0xb3, 0x37, 0xfd, 0xbb, 0x60, 0xa1, 0xfb, 0x64, 0xff, 0x27, 0x00, 0x00, 0xff, 0xff, 0xfe, 0xe6,
-		0xfe, 0x2e, 0xc2, 0x73, 0x00, 0x00,
+		// 9835 bytes of a gzipped FileDescriptorSet
+		0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0xbd, 0x7b, 0x90, 0x5c, 0xc7,
+		0x75, 0x1f, 0x8c, 0x3b, 0xef, 0x39, 0x3b, 0x3b, 0x73, 0xb7, 0x77, 0x01, 0x0c, 0x16, 0xe0, 0xee,
+		0x72, 0x20, 0x91, 0x20, 0x48, 0x2e, 0x08, 0x80, 0x00, 0x89, 0xa1, 0x68, 0x79, 0x5e, 0x58, 0x0c,
+		0xb0, 0xbb, 0xb3, 0xba, 0xb3, 0xbb, 0x7c, 0xf8, 0x53, 0xdd, 0xef, 0xee, 0x4c, 0xef, 0xee, 0x10,
+		0x33, 0xf7, 0x8e, 0xee, 0xbd, 0x03, 0x60, 0x59, 0xa9, 0x14, 0x15, 0xc5, 0x11, 0x4d, 0x59, 0x8a,
+		0x6c, 0xab, 0x22, 0x4a, 0x32, 0x64, 0x49, 0x4e, 0x24, 0x45, 0x91, 0x13, 0xdb, 0x52, 0xa4, 0x38,
+		0x29, 0x27, 0x4a, 0x52, 0x8e, 0x65, 0xb9, 0x92, 0x92, 0x9d, 0x97, 0xcb, 0x95, 0xc0, 0xb1, 0xa4,
+		0x12, 0x29, 0x59, 0x4e, 0x64, 0x86, 0x76, 0x25, 0x25, 0x27, 0x95, 0xea, 0xd7, 0x7d, 0xcd, 0xcc,
+		0xce, 0x2c, 0x4c, 0x29, 0xac, 0x24, 0xff, 0x00, 0xd3, 0xdd, 0xe7, 0x77, 0xfa, 0xf4, 0xe9, 0xd3,
+		0xa7, 0x4f, 0x3f, 0x6e, 0x2f, 0x7c, 0xeb, 0x59, 0x58, 0xd8, 0x31, 0x8c, 0x9d, 0x36, 0x3e, 0xd3,
+		0x35, 0x0d, 0xdb, 0xd8, 0xea, 0x6d, 0x9f, 0x69, 0x62, 0xab, 0x61, 0xb6, 0xba, 0xb6, 0x61, 0x2e,
+		0xd2, 0x3c, 0x94, 0x61, 0x14, 0x8b, 0x82, 0x22, 0xb7, 0x02, 0x53, 0x97, 0x5b, 0x6d, 0x5c, 0x76,
+		0x08, 0xeb, 0xd8, 0x46, 0x8f, 0x43, 0x64, 0xbb, 0xd5, 0xc6, 0x59, 0x69, 0x21, 0x7c, 0x6a, 0xe2,
+		0xdc, 0x5b, 0x16, 0x03, 0xa0, 0x45, 0x3f, 0x62, 0x8d, 0x64, 0x2b, 0x14, 0x91, 0x7b, 0x39, 0x0a,
+		0xd3, 0x03, 0x4a, 0x11, 0x82, 0x88, 0xae, 0x75, 0x08, 0x47, 0xe9, 0x54, 0x52, 0xa1, 0xbf, 0x51,
+		0x16, 0xe2, 0x5d, 0xad, 0x71, 0x5d, 0xdb, 0xc1, 0xd9, 0x10, 0xcd, 0x16, 0x49, 0x34, 0x07, 0xd0,
+		0xc4, 0x5d, 0xac, 0x37, 0xb1, 0xde, 0xd8, 0xcb, 0x86, 0x17, 0xc2, 0xa7, 0x92, 0x8a, 0x27, 0x07,
+		0x3d, 0x08, 0x53, 0xdd, 0xde, 0x56, 0xbb, 0xd5, 0x50, 0x3d, 0x64, 0xb0, 0x10, 0x3e, 0x15, 0x55,
+		0x64, 0x56, 0x50, 0x76, 0x89, 0xef, 0x87, 0xcc, 0x4d, 0xac, 0x5d, 0xf7, 0x92, 0x4e, 0x50, 0xd2,
+		0x34, 0xc9, 0xf6, 0x10, 0x96, 0x20, 0xd5, 0xc1, 0x96, 0xa5, 0xed, 0x60, 0xd5, 0xde, 0xeb, 0xe2,
+		0x6c, 0x84, 0xb6, 0x7e, 0xa1, 0xaf, 0xf5, 0xc1, 0x96, 0x4f, 0x70, 0xd4, 0xfa, 0x5e, 0x17, 0xa3,
+		0x02, 0x24, 0xb1, 0xde, 0xeb, 0x30, 0x0e, 0xd1, 0x21, 0xfa, 0xab, 0xe8, 0xbd, 0x4e, 0x90, 0x4b,
+		0x82, 0xc0, 0x38, 0x8b, 0xb8, 0x85, 0xcd, 0x1b, 0xad, 0x06, 0xce, 0xc6, 0x28, 0x83, 0xfb, 0xfb,
+		0x18, 0xd4, 0x59, 0x79, 0x90, 0x87, 0xc0, 0xa1, 0x12, 0x24, 0xf1, 0x2d, 0x1b, 0xeb, 0x56, 0xcb,
+		0xd0, 0xb3, 0x71, 0xca, 0xe4, 0xad, 0x03, 0x7a, 0x11, 0xb7, 0x9b, 0x41, 0x16, 0x2e, 0x0e, 0x5d,
+		0x84, 0xb8, 0xd1, 0xb5, 0x5b, 0x86, 0x6e, 0x65, 0x13, 0x0b, 0xd2, 0xa9, 0x89, 0x73, 0x27, 0x06,
+		0x1a, 0x42, 0x8d, 0xd1, 0x28, 0x82, 0x18, 0x55, 0x41, 0xb6, 0x8c, 0x9e, 0xd9, 0xc0, 0x6a, 0xc3,
+		0x68, 0x62, 0xb5, 0xa5, 0x6f, 0x1b, 0xd9, 0x24, 0x65, 0x30, 0xdf, 0xdf, 0x10, 0x4a, 0x58, 0x32,
+		0x9a, 0xb8, 0xaa, 0x6f, 0x1b, 0x4a, 0xda, 0xf2, 0xa5, 0xd1, 0x11, 0x88, 0x59, 0x7b, 0xba, 0xad,
+		0xdd, 0xca, 0xa6, 0xa8, 0x85, 0xf0, 0x14, 0x3a, 0x07, 0x71, 0xdc, 0x6c, 0x91, 0xea, 0xb2, 0xe9,
+		0x05, 0xe9, 0x54, 0xfa, 0x5c, 0xb6, 0x5f, 0xc7, 0xac, 0x5c, 0x11, 0x84, 0xb9, 0x5f, 0x8b, 0x41,
+		0x66, 0x1c, 0xb3, 0x7c, 0x02, 0xa2, 0xdb, 0x44, 0x33, 0xd9, 0xd0, 0x41, 0xf4, 0xc6, 0x30, 0x7e,
+		0xc5, 0xc7, 0xee, 0x52, 0xf1, 0x05, 0x98, 0xd0, 0xb1, 0x65, 0xe3, 0x26, 0xb3, 0xa2, 0xf0, 0x98,
+		0x76, 0x08, 0x0c, 0xd4, 0x6f, 0x86, 0x91, 0xbb, 0x32, 0xc3, 0xa7, 0x21, 0xe3, 0x88, 0xa4, 0x9a,
+		0x9a, 0xbe, 0x23, 0xec, 0xf9, 0xcc, 0x28, 0x49, 0x16, 0x2b, 0x02, 0xa7, 0x10, 0x98, 0x92, 0xc6,
+		0xbe, 0x34, 0x2a, 0x03, 0x18, 0x3a, 0x36, 0xb6, 0xd5, 0x26, 0x6e, 0xb4, 0xb3, 0x89, 0x21, 0x5a,
+		0xaa, 0x11, 0x92, 0x3e, 0x2d, 0x19, 0x2c, 0xb7, 0xd1, 0x46, 0x97, 0x5c, 0xf3, 0x8c, 0x0f, 0xb1,
+		0xae, 0x15, 0x36, 0x30, 0xfb, 0x2c, 0x74, 0x03, 0xd2, 0x26, 0x26, 0x63, 0x05, 0x37, 0x79, 0xcb,
+		0x92, 0x54, 0x88, 0xc5, 0x91, 0x2d, 0x53, 0x38, 0x8c, 0x35, 0x6c, 0xd2, 0xf4, 0x26, 0xd1, 0x49,
+		0x70, 0x32, 0x54, 0x6a, 0x56, 0x40, 0x3d, 0x57, 0x4a, 0x64, 0xae, 0x6a, 0x1d, 0x3c, 0xfb, 0x3c,
+		0xa4, 0xfd, 0xea, 0x41, 0x33, 0x10, 0xb5, 0x6c, 0xcd, 0xb4, 0xa9, 0x15, 0x46, 0x15, 0x96, 0x40,
+		0x32, 0x84, 0xb1, 0xde, 0xa4, 0x9e, 0x31, 0xaa, 0x90, 0x9f, 0xe8, 0xc7, 0xdd, 0x06, 0x87, 0x69,
+		0x83, 0xef, 0xeb, 0xef, 0x51, 0x1f, 0xe7, 0x60, 0xbb, 0x67, 0x1f, 0x83, 0x49, 0x5f, 0x03, 0xc6,
+		0xad, 0x3a, 0xf7, 0x9b, 0x11, 0x38, 0x3c, 0x90, 0x37, 0x7a, 0x1a, 0x66, 0x7a, 0x7a, 0x4b, 0xb7,
+		0xb1, 0xd9, 0x35, 0x31, 0x31, 0x59, 0x56, 0x57, 0xf6, 0x95, 0xf8, 0x10, 0xa3, 0xdb, 0xf0, 0x52,
+		0x33, 0x2e, 0xca, 0x74, 0xaf, 0x3f, 0x13, 0x3d, 0x03, 0x13, 0xc4, 0x3e, 0x34, 0x53, 0xa3, 0x0c,
+		0xd9, 0x68, 0x3c, 0x37, 0x5e, 0x93, 0x17, 0xcb, 0x2e, 0xb2, 0x18, 0x7e, 0x51, 0x0a, 0x29, 0x5e,
+		0x5e, 0xe8, 0x31, 0x48, 0x6c, 0x63, 0xcd, 0xee, 0x99, 0xd8, 0xca, 0x9e, 0xa3, 0xaa, 0x3c, 0xde,
+		0x3f, 0x48, 0x19, 0x41, 0x1d, 0xdb, 0x8a, 0x43, 0x8c, 0x76, 0x21, 0x75, 0x03, 0x9b, 0xad, 0xed,
+		0x56, 0x83, 0x09, 0x15, 0xa6, 0xce, 0xe7, 0xf1, 0x31, 0x85, 0xda, 0xf4, 0x40, 0xeb, 0xb6, 0x66,
+		0xe3, 0x3c, 0x6c, 0xac, 0x6e, 0x56, 0x94, 0xea, 0xe5, 0x6a, 0xa5, 0xac, 0xf8, 0x38, 0xcf, 0x7e,
+		0x48, 0x82, 0x09, 0x4f, 0x23, 0x88, 0x27, 0xd4, 0x7b, 0x9d, 0x2d, 0x6c, 0xf2, 0xae, 0xe2, 0x29,
+		0x74, 0x1c, 0x92, 0xdb, 0xbd, 0x76, 0x9b, 0xd9, 0x1b, 0x9b, 0x46, 0x13, 0x24, 0x83, 0xd8, 0x1a,
+		0x71, 0x6f, 0xdc, 0x83, 0x50, 0xf7, 0x46, 0x7e, 0xa3, 0x59, 0x48, 0x08, 0x7b, 0xcc, 0x46, 0x17,
+		0xa4, 0x53, 0x09, 0xc5, 0x49, 0xb3, 0xb2, 0x2e, 0xd6, 0x6c, 0xdc, 0xcc, 0xc6, 0x44, 0x19, 0x4b,
+		0x5f, 0x8d, 0x24, 0x22, 0x72, 0x34, 0xf7, 0x28, 0x4c, 0xf5, 0xb5, 0x02, 0x65, 0x60, 0xa2, 0x5c,
+		0x29, 0x2d, 0x17, 0x94, 0xc2, 0x7a, 0xb5, 0xb6, 0x2a, 0x1f, 0x42, 0x69, 0xf0, 0x34, 0x4c, 0x96,
+		0x4e, 0x27, 0x13, 0xaf, 0xc6, 0xe5, 0x17, 0x5e, 0x78, 0xe1, 0x85, 0x50, 0xee, 0x9f, 0xc6, 0x60,
+		0x66, 0x90, 0xff, 0x1b, 0xe8, 0x8a, 0xdd, 0x46, 0x87, 0x7d, 0x8d, 0x2e, 0x40, 0xb4, 0xad, 0x6d,
+		0xe1, 0x76, 0x36, 0x42, 0xf5, 0xff, 0xe0, 0x58, 0x1e, 0x76, 0x71, 0x99, 0x40, 0x14, 0x86, 0x44,
+		0x3f, 0xc6, 0x55, 0x13, 0xa5, 0x1c, 0x4e, 0x8f, 0xc7, 0x81, 0xf8, 0x45, 0xae, 0xc6, 0xe3, 0x90,
+		0x24, 0xff, 0x33, 0xbd, 0xc7, 0x98, 0xde, 0x49, 0x06, 0xd5, 0xfb, 0x2c, 0x24, 0xa8, 0xcb, 0x6b,
+		0x62, 0xa7, 0x4f, 0x44, 0x9a, 0x38, 0x89, 0x26, 0xde, 0xd6, 0x7a, 0x6d, 0x5b, 0xbd, 0xa1, 0xb5,
+		0x7b, 0x98, 0x3a, 0xaf, 0xa4, 0x92, 0xe2, 0x99, 0x9b, 0x24, 0x0f, 0xcd, 0xc3, 0x04, 0xf3, 0x90,
+		0x2d, 0xbd, 0x89, 0x6f, 0xd1, 0xd9, 0x33, 0xaa, 0x30, 0xa7, 0x59, 0x25, 0x39, 0xa4, 0xfa, 0xe7,
+		0x2c, 0x43, 0x17, 0x6e, 0x86, 0x56, 0x41, 0x32, 0x68, 0xf5, 0x8f, 0x05, 0x27, 0xee, 0x7b, 0x06,
+		0x37, 0xaf, 0xcf, 0x2f, 0xde, 0x0f, 0x19, 0x4a, 0x71, 0x9e, 0x8f, 0x62, 0xad, 0x9d, 0x9d, 0xa2,
+		0x66, 0x90, 0x66, 0xd9, 0x35, 0x9e, 0x9b, 0xfb, 0x52, 0x08, 0x22, 0x74, 0x92, 0xc8, 0xc0, 0xc4,
+		0xfa, 0x33, 0x6b, 0x15, 0xb5, 0x5c, 0xdb, 0x28, 0x2e, 0x57, 0x64, 0x89, 0x74, 0x3d, 0xcd, 0xb8,
+		0xbc, 0x5c, 0x2b, 0xac, 0xcb, 0x21, 0x27, 0x5d, 0x5d, 0x5d, 0xbf, 0xf8, 0xa8, 0x1c, 0x76, 0x00,
+		0x1b, 0x2c, 0x23, 0xe2, 0x25, 0x38, 0x7f, 0x4e, 0x8e, 0x22, 0x19, 0x52, 0x8c, 0x41, 0xf5, 0xe9,
+		0x4a, 0xf9, 0xe2, 0xa3, 0x72, 0xcc, 0x9f, 0x73, 0xfe, 0x9c, 0x1c, 0x47, 0x93, 0x90, 0xa4, 0x39,
+		0xc5, 0x5a, 0x6d, 0x59, 0x4e, 0x38, 0x3c, 0xeb, 0xeb, 0x4a, 0x75, 0x75, 0x49, 0x4e, 0x3a, 0x3c,
+		0x97, 0x94, 0xda, 0xc6, 0x9a, 0x0c, 0x0e, 0x87, 0x95, 0x4a, 0xbd, 0x5e, 0x58, 0xaa, 0xc8, 0x13,
+		0x0e, 0x45, 0xf1, 0x99, 0xf5, 0x4a, 0x5d, 0x4e, 0xf9, 0xc4, 0x3a, 0x7f, 0x4e, 0x9e, 0x74, 0xaa,
+		0xa8, 0xac, 0x6e, 0xac, 0xc8, 0x69, 0x34, 0x05, 0x93, 0xac, 0x0a, 0x21, 0x44, 0x26, 0x90, 0x75,
+		0xf1, 0x51, 0x59, 0x76, 0x05, 0x61, 0x5c, 0xa6, 0x7c, 0x19, 0x17, 0x1f, 0x95, 0x51, 0xae, 0x04,
+		0x51, 0x6a, 0x86, 0x08, 0x41, 0x7a, 0xb9, 0x50, 0xac, 0x2c, 0xab, 0xb5, 0x35, 0x32, 0x68, 0x0a,
+		0xcb, 0xb2, 0xe4, 0xe6, 0x29, 0x95, 0xb5, 0x4a, 0x61, 0xbd, 0x52, 0x96, 0xc3, 0xde, 0xbc, 0x77,
+		0x6c, 0x54, 0x95, 0x4a, 0x59, 0x0e, 0xe5, 0x1a, 0x30, 0x33, 0x68, 0x72, 0x1c, 0x38, 0x84, 0x3c,
+		0xb6, 0x10, 0x1a, 0x62, 0x0b, 0x94, 0x57, 0xd0, 0x16, 0x72, 0xdf, 0x0c, 0xc1, 0xf4, 0x80, 0x00,
+		0x61, 0x60, 0x25, 0x6f, 0x87, 0x28, 0xb3, 0x65, 0xe6, 0xa4, 0x1f, 0x18, 0x18, 0x69, 0x50, 0xcb,
+		0xee, 0x0b, 0x9b, 0x28, 0xce, 0x1b, 0x6a, 0x86, 0x87, 0x84, 0x9a, 0x84, 0x45, 0x9f, 0xc1, 0xbe,
+		0xb3, 0x6f, 0x22, 0x67, 0xb1, 0xce, 0xc5, 0x71, 0x62, 0x1d, 0x9a, 0x77, 0xb0, 0x09, 0x3d, 0x3a,
+		0x60, 0x42, 0x7f, 0x02, 0xa6, 0xfa, 0x18, 0x8d, 0x3d, 0xb1, 0xbe, 0x47, 0x82, 0xec, 0x30, 0xe5,
+		0x8c, 0x70, 0x89, 0x21, 0x9f, 0x4b, 0x7c, 0x22, 0xa8, 0xc1, 0x7b, 0x87, 0x77, 0x42, 0x5f, 0x5f,
+		0x7f, 0x46, 0x82, 0x23, 0x83, 0x97, 0x14, 0x03, 0x65, 0xf8, 0x31, 0x88, 0x75, 0xb0, 0xbd, 0x6b,
+		0x88, 0x10, 0xf9, 0xbe, 0x01, 0x81, 0x17, 0x29, 0x0e, 0x76, 0x36, 0x47, 0xa1, 0x4b, 0x41, 0x59,
+		0xe7, 0x87, 0x2d, 0x70, 0xfa, 0x24, 0xfd, 0xa9, 0x10, 0x1c, 0x1e, 0xc8, 0x7c, 0xa0, 0xa0, 0xf7,
+		0x00, 0xb4, 0xf4, 0x6e, 0xcf, 0x66, 0x61, 0x30, 0xf3, 0xc4, 0x49, 0x9a, 0x43, 0x9d, 0x17, 0xf1,
+		0xb2, 0x3d, 0xdb, 0x29, 0x67, 0xb3, 0x24, 0xb0, 0x2c, 0x4a, 0xf0, 0xb8, 0x2b, 0x68, 0x84, 0x0a,
+		0x3a, 0x37, 0xa4, 0xa5, 0x7d, 0x86, 0xf9, 0x08, 0xc8, 0x8d, 0x76, 0x0b, 0xeb, 0xb6, 0x6a, 0xd9,
+		0x26, 0xd6, 0x3a, 0x2d, 0x7d, 0x87, 0xcd, 0xb6, 0xf9, 0xe8, 0xb6, 0xd6, 0xb6, 0xb0, 0x92, 0x61,
+		0xc5, 0x75, 0x51, 0x4a, 0x10, 0xd4, 0x80, 0x4c, 0x0f, 0x22, 0xe6, 0x43, 0xb0, 0x62, 0x07, 0x91,
+		0xfb, 0x5a, 0x12, 0x26, 0x3c, 0x0b, 0x30, 0x74, 0x2f, 0xa4, 0x9e, 0xd3, 0x6e, 0x68, 0xaa, 0x58,
+		0x54, 0x33, 0x4d, 0x4c, 0x90, 0xbc, 0x35, 0x96, 0x85, 0x1e, 0x81, 0x19, 0x4a, 0x62, 0xf4, 0x6c,
+		0x6c, 0xaa, 0x8d, 0xb6, 0x66, 0x59, 0x54, 0x69, 0x09, 0x4a, 0x8a, 0x48, 0x59, 0x8d, 0x14, 0x95,
+		0x44, 0x09, 0xba, 0x00, 0xd3, 0x14, 0xd1, 0xe9, 0xb5, 0xed, 0x56, 0xb7, 0x8d, 0x55, 0xb2, 0xcc,
+		0xb7, 0xb2, 0xe0, 0x95, 0x6c, 0x8a, 0x50, 0xac, 0x70, 0x02, 0x22, 0x91, 0x85, 0xca, 0x70, 0x0f,
+		0x85, 0xed, 0x60, 0x1d, 0x9b, 0x9a, 0x8d, 0x55, 0xfc, 0xae, 0x9e, 0xd6, 0xb6, 0x54, 0x4d, 0x6f,
+		0xaa, 0xbb, 0x9a, 0xb5, 0x9b, 0x9d, 0x21, 0x0c, 0x8a, 0xa1, 0xac, 0xa4, 0x1c, 0x23, 0x84, 0x4b,
+		0x9c, 0xae, 0x42, 0xc9, 0x0a, 0x7a, 0xf3, 0x8a, 0x66, 0xed, 0xa2, 0x3c, 0x1c, 0xa1, 0x5c, 0x2c,
+		0xdb, 0x6c, 0xe9, 0x3b, 0x6a, 0x63, 0x17, 0x37, 0xae, 0xab, 0x3d, 0x7b, 0xfb, 0xf1, 0xec, 0x71,
+		0x6f, 0xfd, 0x54, 0xc2, 0x3a, 0xa5, 0x29, 0x11, 0x92, 0x0d, 0x7b, 0xfb, 0x71, 0x54, 0x87, 0x14,
+		0xe9, 0x8c, 0x4e, 0xeb, 0x79, 0xac, 0x6e, 0x1b, 0x26, 0x9d, 0x43, 0xd3, 0x03, 0x5c, 0x93, 0x47,
+		0x83, 0x8b, 0x35, 0x0e, 0x58, 0x31, 0x9a, 0x38, 0x1f, 0xad, 0xaf, 0x55, 0x2a, 0x65, 0x65, 0x42,
+		0x70, 0xb9, 0x6c, 0x98, 0xc4, 0xa0, 0x76, 0x0c, 0x47, 0xc1, 0x13, 0xcc, 0xa0, 0x76, 0x0c, 0xa1,
+		0xde, 0x0b, 0x30, 0xdd, 0x68, 0xb0, 0x36, 0xb7, 0x1a, 0x2a, 0x5f, 0x8c, 0x5b, 0x59, 0xd9, 0xa7,
+		0xac, 0x46, 0x63, 0x89, 0x11, 0x70, 0x1b, 0xb7, 0xd0, 0x25, 0x38, 0xec, 0x2a, 0xcb, 0x0b, 0x9c,
+		0xea, 0x6b, 0x65, 0x10, 0x7a, 0x01, 0xa6, 0xbb, 0x7b, 0xfd, 0x40, 0xe4, 0xab, 0xb1, 0xbb, 0x17,
+		0x84, 0x3d, 0x06, 0x33, 0xdd, 0xdd, 0x6e, 0x3f, 0xee, 0xb4, 0x17, 0x87, 0xba, 0xbb, 0xdd, 0x20,
+		0xf0, 0xad, 0x74, 0x67, 0xc6, 0xc4, 0x0d, 0x1a, 0x23, 0x1e, 0xf5, 0x92, 0x7b, 0x0a, 0xd0, 0x22,
+		0xc8, 0x8d, 0x86, 0x8a, 0x75, 0x6d, 0xab, 0x8d, 0x55, 0xcd, 0xc4, 0xba, 0x66, 0x65, 0xe7, 0x29,
+		0x71, 0xc4, 0x36, 0x7b, 0x58, 0x49, 0x37, 0x1a, 0x15, 0x5a, 0x58, 0xa0, 0x65, 0xe8, 0x34, 0x4c,
+		0x19, 0x5b, 0xcf, 0x35, 0x98, 0x45, 0xaa, 0x5d, 0x13, 0x6f, 0xb7, 0x6e, 0x65, 0xdf, 0x42, 0xd5,
+		0x9b, 0x21, 0x05, 0xd4, 0x1e, 0xd7, 0x68, 0x36, 0x7a, 0x00, 0xe4, 0x86, 0xb5, 0xab, 0x99, 0x5d,
+		0xea, 0x92, 0xad, 0xae, 0xd6, 0xc0, 0xd9, 0xb7, 0x32, 0x52, 0x96, 0xbf, 0x2a, 0xb2, 0xc9, 0x88,
+		0xb0, 0x6e, 0xb6, 0xb6, 0x6d, 0xc1, 0xf1, 0x7e, 0x36, 0x22, 0x68, 0x1e, 0xe7, 0x76, 0x0a, 0x64,
+		0xa2, 0x09, 0x5f, 0xc5, 0xa7, 0x28, 0x59, 0xba, 0xbb, 0xdb, 0xf5, 0xd6, 0x7b, 0x12, 0x26, 0xbb,
+		0xbb, 0xde, 0x4a, 0x1f, 0x60, 0x81, 0x5b, 0x77, 0xd7, 0x53, 0xe3, 0xa3, 0x70, 0x84, 0x10, 0x75,
+		0xb0, 0xad, 0x35, 0x35, 0x5b, 0xf3, 0x50, 0x3f, 0x44, 0xa9, 0x89, 0xda, 0x57, 0x78, 0xa1, 0x4f,
+		0x4e, 0xb3, 0xb7, 0xb5, 0xe7, 0x18, 0xd6, 0xc3, 0x4c, 0x4e, 0x92, 0x27, 0x4c, 0xeb, 0xae, 0x97,
+		0x2c, 0x3f, 0xb4, 0x05, 0x5a, 0x2e, 0x0f, 0x29, 0xef, 0x80, 0x41, 0x49, 0x60, 0x43, 0x46, 0x96,
+		0x48, 0xf4, 0x54, 0xaa, 0x95, 0x49, 0xdc, 0xf3, 0x6c, 0x45, 0x0e, 0x91, 0xf8, 0x6b, 0xb9, 0xba,
+		0x5e, 0x51, 0x95, 0x8d, 0xd5, 0xf5, 0xea, 0x4a, 0x45, 0x0e, 0x7b, 0x56, 0x04, 0x57, 0x23, 0x89,
+		0xfb, 0xe4, 0xfb, 0x73, 0xaf, 0x87, 0x21, 0xed, 0x5f, 0xae, 0xa3, 0xb7, 0xc1, 0x51, 0xb1, 0x1f,
+		0x67, 0x61, 0x5b, 0xbd, 0xd9, 0x32, 0xe9, 0x48, 0xee, 0x68, 0x6c, 0x56, 0x75, 0x0c, 0x6f, 0x86,
+		0x53, 0xd5, 0xb1, 0xfd, 0x54, 0xcb, 0x24, 0xe3, 0xb4, 0xa3, 0xd9, 0x68, 0x19, 0xe6, 0x75, 0x43,
+		0xb5, 0x6c, 0x4d, 0x6f, 0x6a, 0x66, 0x53, 0x75, 0x77, 0x42, 0x55, 0xad, 0xd1, 0xc0, 0x96, 0x65,
+		0xb0, 0x19, 0xd4, 0xe1, 0x72, 0x42, 0x37, 0xea, 0x9c, 0xd8, 0x9d, 0x5a, 0x0a, 0x9c, 0x34, 0x60,
+		0xf7, 0xe1, 0x61, 0x76, 0x7f, 0x1c, 0x92, 0x1d, 0xad, 0xab, 0x62, 0xdd, 0x36, 0xf7, 0x68, 0x60,
+		0x9f, 0x50, 0x12, 0x1d, 0xad, 0x5b, 0x21, 0x69, 0xb4, 0x09, 0xf7, 0xb9, 0xa4, 0x6a, 0x1b, 0xef,
+		0x68, 0x8d, 0x3d, 0x95, 0x46, 0xf1, 0x74, 0xef, 0x48, 0x6d, 0x18, 0xfa, 0x76, 0xbb, 0xd5, 0xb0,
+		0xad, 0xec, 0x84, 0xe3, 0x1c, 0x73, 0x2e, 0x62, 0x99, 0x02, 0xae, 0x5a, 0x86, 0x4e, 0x83, 0xf7,
+		0x92, 0xa0, 0xf6, 0x99, 0x46, 0xea, 0x4d, 0x61, 0x1a, 0xfe, 0xee, 0x8d, 0xc8, 0xd1, 0xab, 0x91,
+		0x44, 0x54, 0x8e, 0x5d, 0x8d, 0x24, 0x62, 0x72, 0xfc, 0x6a, 0x24, 0x91, 0x90, 0x93, 0x57, 0x23,
+		0x89, 0xa4, 0x0c, 0xb9, 0x5f, 0x02, 0x48, 0x79, 0xd7, 0x22, 0x64, 0x69, 0xd7, 0xa0, 0xb3, 0xb1,
+		0x44, 0xfd, 0xf5, 0xc9, 0x7d, 0x57, 0x2e, 0x8b, 0x25, 0x32, 0x4d, 0xe7, 0x63, 0x2c, 0xf0, 0x57,
+		0x18, 0x92, 0x84, 0x48, 0x64, 0x20, 0x61, 0x16, 0x68, 0x25, 0x14, 0x9e, 0x42, 0x4b, 0x10, 0x7b,
+		0xce, 0xa2, 0xbc, 0x63, 0x94, 0xf7, 0x5b, 0xf6, 0xe7, 0x7d, 0xb5, 0x4e, 0x99, 0x27, 0xaf, 0xd6,
+		0xd5, 0xd5, 0x9a, 0xb2, 0x52, 0x58, 0x56, 0x38, 0x1c, 0x1d, 0x83, 0x48, 0x5b, 0x7b, 0x7e, 0xcf,
+		0x3f, 0xa1, 0xd3, 0x2c, 0xb4, 0x08, 0x99, 0x9e, 0xce, 0x16, 0xf2, 0xa4, 0x8f, 0x09, 0x55, 0xc6,
+		0x4b, 0x95, 0x76, 0x4b, 0x97, 0x09, 0xfd, 0x98, 0x76, 0x75, 0x0c, 0x22, 0x64, 0xb3, 0xda, 0x3f,
+		0xed, 0xd2, 0x2c, 0x74, 0x0a, 0x52, 0x4d, 0xbc, 0xd5, 0xdb, 0x51, 0x4d, 0xdc, 0xd4, 0x1a, 0xb6,
+		0x7f, 0xb2, 0x99, 0xa0, 0x45, 0x0a, 0x2d, 0x41, 0xd7, 0x20, 0x49, 0xfa, 0x48, 0xa7, 0x7d, 0x3c,
+		0x45, 0x55, 0xf0, 0xf0, 0xfe, 0x2a, 0xe0, 0x5d, 0x2c, 0x40, 0x8a, 0x8b, 0x47, 0x57, 0x20, 0x6e,
+		0x6b, 0xe6, 0x0e, 0xb6, 0xad, 0xec, 0xf4, 0x42, 0xf8, 0x54, 0xfa, 0xdc, 0xe2, 0x38, 0xac, 0xd6,
+		0x29, 0x84, 0x2e, 0xa3, 0x05, 0x1c, 0x3d, 0x05, 0x32, 0xdf, 0xa2, 0x55, 0xf9, 0x1a, 0xd8, 0xca,
+		0xce, 0x50, 0x03, 0x7c, 0x68, 0x7f, 0x96, 0x7c, 0x87, 0xb7, 0xcc, 0x40, 0x4a, 0x06, 0xfb, 0xd2,
+		0xfe, 0x71, 0x71, 0xf8, 0x4d, 0x31, 0x2e, 0x66, 0x9f, 0x85, 0xb4, 0x5f, 0x6a, 0xef, 0x4e, 0x76,
+		0x78, 0xcc, 0x9d, 0x6c, 0xb2, 0xb8, 0x10, 0xcb, 0x2d, 0x32, 0x4f, 0xb0, 0x44, 0xee, 0x0c, 0x44,
+		0xe9, 0x70, 0x40, 0x00, 0x7c, 0x40, 0xc8, 0x87, 0x50, 0x02, 0x22, 0xa5, 0x9a, 0x42, 0x5c, 0xb2,
+		0x0c, 0x29, 0x96, 0xab, 0xae, 0x55, 0x2b, 0xa5, 0x8a, 0x1c, 0xca, 0x5d, 0x80, 0x18, 0xb3, 0x71,
+		0xe2, 0xae, 0x1d, 0x2b, 0x97, 0x0f, 0xf1, 0x24, 0xe7, 0x21, 0x89, 0xd2, 0x8d, 0x95, 0x62, 0x45,
+		0x91, 0x43, 0xb9, 0x0d, 0xc8, 0x04, 0xec, 0x02, 0x1d, 0x86, 0x29, 0xa5, 0xb2, 0x5e, 0x59, 0x25,
+		0x2b, 0x59, 0x75, 0x63, 0xf5, 0xda, 0x6a, 0xed, 0x29, 0xb2, 0x0d, 0xe4, 0xcb, 0x16, 0xbe, 0x5f,
+		0x42, 0x33, 0x20, 0xbb, 0xd9, 0xf5, 0xda, 0x86, 0x42, 0xa5, 0xf9, 0xe9, 0x10, 0xc8, 0x41, 0x23,
+		0x41, 0x47, 0x61, 0x7a, 0xbd, 0xa0, 0x2c, 0x55, 0xd6, 0x55, 0xb6, 0x3a, 0x77, 0x58, 0xcf, 0x80,
+		0xec, 0x2d, 0xb8, 0x5c, 0xa5, 0x9b, 0x0f, 0xf3, 0x70, 0xdc, 0x9b, 0x5b, 0x79, 0x7a, 0xbd, 0xb2,
+		0x5a, 0xa7, 0x95, 0x17, 0x56, 0x97, 0xc8, 0x44, 0x14, 0xe0, 0x27, 0xf6, 0x03, 0xc2, 0x44, 0x54,
+		0x3f, 0xbf, 0xca, 0x72, 0x59, 0x8e, 0x04, 0xb3, 0x6b, 0xab, 0x95, 0xda, 0x65, 0x39, 0x1a, 0xac,
+		0x9d, 0xee, 0x11, 0xc4, 0xd0, 0x2c, 0x1c, 0x09, 0xe6, 0xaa, 0x95, 0xd5, 0x75, 0xe5, 0x19, 0x39,
+		0x1e, 0xac, 0xb8, 0x5e, 0x51, 0x36, 0xab, 0xa5, 0x8a, 0x9c, 0x40, 0x47, 0x00, 0xf9, 0x25, 0x5a,
+		0xbf, 0x52, 0x2b, 0xcb, 0xc9, 0x41, 0x1e, 0x14, 0xc9, 0xd3, 0xb9, 0xcf, 0x4b, 0x90, 0xf2, 0xae,
+		0xd7, 0x7d, 0x46, 0x2e, 0xbd, 0xd9, 0x9c, 0x7f, 0xee, 0x77, 0x42, 0x30, 0xe1, 0x59, 0xb8, 0x93,
+		0x15, 0x97, 0xd6, 0x6e, 0x1b, 0x37, 0x55, 0xad, 0xdd, 0xd2, 0x2c, 0xee, 0x9f, 0x81, 0x66, 0x15,
+		0x48, 0xce, 0xb8, 0xfe, 0x70, 0xfc, 0xa9, 0x34, 0x76, 0xd7, 0x53, 0x69, 0xfc, 0x4d, 0x38, 0x95,
+		0x46, 0xe5, 0x58, 0xee, 0xdd, 0x21, 0x90, 0x83, 0x4b, 0xf9, 0x80, 0xde, 0xa4, 0x61, 0x7a, 0xf3,
+		0xb6, 0x2f, 0x74, 0x90, 0xf6, 0x05, 0x67, 0x99, 0xf0, 0xd0, 0x59, 0xe6, 0x47, 0x62, 0x57, 0xff,
+		0x56, 0x82, 0xb4, 0x7f, 0x8b, 0xc0, 0xd7, 0xb4, 0xdc, 0x41, 0x9a, 0xe6, 0x57, 0xdd, 0xbd, 0xc3,
+		0x54, 0xf7, 0x23, 0x69, 0xd7, 0x47, 0xc2, 0x30, 0xe9, 0xdb, 0x51, 0x18, 0x57, 0xba, 0x77, 0xc1,
+		0x54, 0xab, 0x89, 0x3b, 0x5d, 0xc3, 0x26, 0x47, 0xd9, 0x6a, 0x1b, 0xdf, 0xc0, 0x6d, 0xaa, 0x86,
+		0xf4, 0x80, 0xe3, 0x3a, 0x5f, 0x0d, 0x8b, 0x55, 0x17, 0xb7, 0x4c, 0x60, 0xf9, 0xe9, 0x6a, 0xb9,
+		0xb2, 0xb2, 0x56, 0x5b, 0xaf, 0xac, 0x96, 0x9e, 0x11, 0x2e, 0x57, 0x91, 0x5b, 0x01, 0x32, 0x9f,
+		0xc2, 0x4f, 0xbe, 0x39, 0x56, 0x24, 0x6b, 0x20, 0x07, 0x5b, 0x43, 0x3c, 0xef, 0x80, 0xf6, 0xc8,
+		0x87, 0xd0, 0x34, 0x64, 0x56, 0x6b, 0x6a, 0xbd, 0x5a, 0xae, 0xa8, 0x95, 0xcb, 0x97, 0x2b, 0xa5,
+		0xf5, 0x3a, 0xdb, 0xbe, 0x76, 0xa8, 0xd7, 0xe5, 0x90, 0xb7, 0x6f, 0x3e, 0x1a, 0x86, 0xe9, 0x01,
+		0x92, 0xa0, 0x02, 0xdf, 0x78, 0x62, 0x7b, 0x61, 0x0f, 0x8f, 0x23, 0xfd, 0x22, 0x59, 0xfa, 0xad,
+		0x69, 0xa6, 0xcd, 0xf7, 0xa9, 0x1e, 0x00, 0xa2, 0x5e, 0xdd, 0x26, 0x71, 0xa1, 0xc9, 0x8f, 0x05,
+		0xd8, 0x6e, 0x54, 0xc6, 0xcd, 0x67, 0x27, 0x03, 0x0f, 0x01, 0xea, 0x1a, 0x56, 0xcb, 0x6e, 0xdd,
+		0x20, 0x27, 0xeb, 0xe2, 0x0c, 0x81, 0xec, 0x4e, 0x45, 0x14, 0x59, 0x94, 0x54, 0x75, 0xdb, 0xa1,
+		0xd6, 0xf1, 0x8e, 0x16, 0xa0, 0x26, 0x71, 0x6b, 0x58, 0x91, 0x45, 0x89, 0x43, 0x7d, 0x2f, 0xa4,
+		0x9a, 0x46, 0x8f, 0x2c, 0xd9, 0x19, 0x1d, 0xf1, 0x9d, 0x92, 0x32, 0xc1, 0xf2, 0x1c, 0x12, 0xbe,
+		0x19, 0xe3, 0x1e, 0x5e, 0xa4, 0x94, 0x09, 0x96, 0xc7, 0x48, 0xee, 0x87, 0x8c, 0xb6, 0xb3, 0x63,
+		0x12, 0xe6, 0x82, 0x11, 0xdb, 0x5e, 0x4a, 0x3b, 0xd9, 0x94, 0x70, 0xf6, 0x2a, 0x24, 0x84, 0x1e,
+		0xc8, 0xc2, 0x89, 0x68, 0x42, 0xed, 0xb2, 0x3d, 0xd3, 0x10, 0x39, 0xcf, 0xd0, 0x45, 0xe1, 0xbd,
+		0x90, 0x6a, 0x59, 0xaa, 0x7b, 0xae, 0x1e, 0x5a, 0x08, 0x9d, 0x4a, 0x28, 0x13, 0x2d, 0xcb, 0x39,
+		0x66, 0xcb, 0xfd, 0x79, 0x12, 0xc0, 0x35, 0x36, 0xf4, 0x3e, 0x09, 0xd2, 0x6c, 0x26, 0xe8, 0x9a,
+		0xd8, 0xc2, 0x7a, 0x43, 0xac, 0x27, 0x1e, 0xd8, 0xc7, 0x44, 0x59, 0x74, 0xb9, 0xc6, 0x01, 0xc5,
+		0x4b, 0x2f, 0x4a, 0xd2, 0xcb, 0x52, 0xe4, 0x65, 0x49, 0xfa, 0x94, 0x34, 0x89, 0x12, 0x95, 0xa7,
+		0xd7, 0x96, 0xab, 0xa5, 0xea, 0x7a, 0xf6, 0xdb, 0x71, 0x9a, 0xae, 0xae, 0xf0, 0xf4, 0x2b, 0x71,
+		0x7f, 0xf9, 0xab, 0x71, 0x65, 0x72, 0xdb, 0xcb, 0x09, 0x6d, 0x7b, 0x0f, 0xe3, 0x43, 0xc3, 0xd6,
+		0x1e, 0xae, 0x1c, 0x15, 0x7e, 0x04, 0x5f, 0x3c, 0x49, 0x45, 0x88, 0x51, 0x11, 0x26, 0x50, 0xac,
+		0xb4, 0x5c, 0xab, 0x57, 0xca, 0x54, 0x80, 0x24, 0x8a, 0xd4, 0xd6, 0x2a, 0xab, 0xd9, 0x57, 0xe2,
+		0x9e, 0x13, 0xfb, 0x9f, 0x95, 0xe0, 0xa8, 0x38, 0xaf, 0xe3, 0x13, 0x21, 0xd6, 0x1b, 0x46, 0x93,
+		0x6c, 0x25, 0xb2, 0xe0, 0xf2, 0xec, 0x7e, 0xd5, 0x2a, 0x1c, 0x4a, 0xd5, 0x50, 0xe1, 0xc0, 0xe2,
+		0xfd, 0x7d, 0x6a, 0x28, 0xac, 0x96, 0xb9, 0x14, 0x13, 0x28, 0xb6, 0x56, 0x28, 0x5d, 0xab, 0x94,
+		0x89, 0x1c, 0x87, 0xcd, 0x41, 0x78, 0x74, 0x0b, 0x32, 0x64, 0xc7, 0x8e, 0x58, 0x42, 0xab, 0xc9,
+		0x4e, 0x4d, 0x23, 0xc3, 0xce, 0xdc, 0x5c, 0x59, 0xc8, 0x16, 0xde, 0xa6, 0x83, 0x28, 0x9e, 0xf4,
+		0x08, 0x91, 0x44, 0x91, 0xd5, 0xda, 0x6a, 0x45, 0x08, 0x40, 0x8f, 0x19, 0x9f, 0x21, 0x02, 0xa4,
+		0x7b, 0x3e, 0x10, 0xba, 0x05, 0xb2, 0xd8, 0x3f, 0x70, 0xd4, 0x10, 0x1d, 0x76, 0x60, 0xe8, 0x56,
+		0xcd, 0x77, 0x21, 0x1c, 0x05, 0x2c, 0x78, 0xea, 0x9e, 0x41, 0x99, 0xe5, 0xca, 0xea, 0xd2, 0xfa,
+		0x15, 0x75, 0x4d, 0xa9, 0xd0, 0x73, 0x9f, 0xec, 0xb7, 0xe3, 0x4a, 0xa6, 0xe3, 0x87, 0xa0, 0xbf,
+		0x04, 0x13, 0x2c, 0x18, 0x61, 0xbb, 0x15, 0x6c, 0xb9, 0x79, 0xdf, 0x7e, 0x95, 0xd2, 0x58, 0x84,
+		0x52, 0x17, 0xcf, 0xd3, 0xfa, 0xc2, 0xa2, 0xdf, 0x8f, 0x22, 0xb4, 0x5c, 0x59, 0x2a, 0x94, 0x9e,
+		0x51, 0x8b, 0x95, 0xfa, 0x3a, 0x71, 0x55, 0x35, 0x85, 0x19, 0x21, 0xa0, 0x68, 0x61, 0x79, 0xb9,
+		0xf6, 0x14, 0x69, 0x3b, 0x3c, 0xe7, 0x30, 0xc8, 0xfd, 0x7f, 0x30, 0xe9, 0xb3, 0x64, 0x12, 0x98,
+		0xd2, 0x80, 0x96, 0x08, 0x5d, 0xaf, 0xac, 0x96, 0xbc, 0x81, 0x74, 0x0a, 0x1c, 0xcb, 0x95, 0x25,
+		0x92, 0x12, 0x76, 0x2d, 0x87, 0x88, 0x87, 0xe4, 0x55, 0x3b, 0x87, 0x4f, 0xe1, 0xdc, 0x63, 0x90,
+		0x10, 0xf6, 0x49, 0xc2, 0x63, 0x1a, 0xe5, 0x06, 0x82, 0xf3, 0x04, 0x50, 0xe3, 0x94, 0x25, 0xb2,
+		0x14, 0x61, 0x46, 0x2b, 0x87, 0x72, 0x9b, 0x70, 0x78, 0xa0, 0x85, 0xa1, 0x93, 0x30, 0x2f, 0x0e,
+		0xbc, 0x58, 0xe0, 0xad, 0x56, 0x56, 0x4b, 0xb5, 0x32, 0x59, 0xaa, 0xb8, 0x3c, 0x01, 0xb8, 0xa9,
+		0x31, 0x29, 0x85, 0x19, 0xca, 0xa1, 0x5c, 0x09, 0xd2, 0x7e, 0x6b, 0x41, 0xc7, 0xe1, 0xe8, 0xc6,
+		0xfa, 0xe5, 0xc7, 0xd5, 0xcd, 0xc2, 0x72, 0xb5, 0x5c, 0x08, 0x2c, 0x4a, 0x12, 0x40, 0xcd, 0x87,
+		0x09, 0xc7, 0x8c, 0x47, 0x0e, 0xe5, 0xea, 0x90, 0x09, 0xf4, 0x3b, 0x3a, 0x01, 0x59, 0xbe, 0x3e,
+		0x18, 0x24, 0xcf, 0x34, 0x04, 0x2d, 0x81, 0xad, 0x94, 0xca, 0x95, 0xe5, 0xea, 0x4a, 0x75, 0x9d,
+		0x4a, 0x76, 0x05, 0xc0, 0xed, 0x57, 0x32, 0x11, 0x5d, 0xad, 0xd7, 0x56, 0xd5, 0xcb, 0x64, 0x99,
+		0xb5, 0xee, 0x61, 0x95, 0x04, 0xd6, 0x8f, 0xb2, 0x44, 0x56, 0x03, 0xfd, 0x9d, 0x2d, 0x87, 0x4e,
+		0xc7, 0xc8, 0x34, 0xf4, 0x9d, 0xf8, 0xe9, 0x58, 0xe2, 0x3b, 0x71, 0xf9, 0xbb, 0xe4, 0xff, 0xf7,
+		0xad, 0xca, 0x1f, 0x5c, 0xbd, 0x1a, 0x4b, 0xbc, 0x12, 0x97, 0x5f, 0x8d, 0xe7, 0xfe, 0x67, 0x08,
+		0x90, 0x6b, 0x4d, 0xce, 0x0a, 0xf8, 0x69, 0x48, 0x38, 0x4b, 0x6a, 0x76, 0x97, 0xef, 0x6d, 0xfb,
+		0x18, 0xa1, 0x80, 0x79, 0xb2, 0x02, 0x4b, 0x6c, 0x87, 0x1b, 0x2a, 0x40, 0xa6, 0xd3, 0xd2, 0x5b,
+		0x9d, 0x5e, 0x47, 0x15, 0xcb, 0xd7, 0xc8, 0x88, 0xe5, 0x6b, 0x9a, 0x03, 0x78, 0x9a, 0xb2, 0xd0,
+		0x6e, 0xf9, 0x58, 0x44, 0x47, 0xb2, 0x60, 0x00, 0x9e, 0x9e, 0x7d, 0xaf, 0x04, 0xd9, 0x61, 0xc2,
+		0xde, 0xd5, 0xca, 0xfa, 0x6e, 0xe3, 0xe3, 0xdc, 0x67, 0x42, 0x90, 0xf6, 0xdf, 0x65, 0x43, 0x65,
+		0x48, 0xb4, 0x0d, 0x7e, 0x4f, 0x84, 0x29, 0xff, 0xd4, 0x88, 0xeb, 0x6f, 0x8b, 0xcb, 0x9c, 0x5e,
+		0x71, 0x90, 0xb3, 0xff, 0x4a, 0x82, 0x84, 0xc8, 0x46, 0x47, 0x20, 0xd2, 0xd5, 0xec, 0x5d, 0xca,
+		0x2e, 0x5a, 0x0c, 0xc9, 0x92, 0x42, 0xd3, 0x24, 0xdf, 0xea, 0x6a, 0xec, 0x8e, 0x0c, 0xcf, 0x27,
+		0x69, 0x12, 0x57, 0xb4, 0xb1, 0xd6, 0xa4, 0x67, 0x27, 0x46, 0xa7, 0x83, 0x75, 0xdb, 0x12, 0x71,
+		0x05, 0xcf, 0x2f, 0xf1, 0x6c, 0x72, 0xa5, 0xd2, 0x36, 0xb5, 0x56, 0xdb, 0x47, 0x1b, 0xa1, 0xb4,
+		0xb2, 0x28, 0x70, 0x88, 0xf3, 0x70, 0x4c, 0xf0, 0x6d, 0x62, 0x5b, 0x6b, 0xec, 0xe2, 0xa6, 0x0b,
+		0x8a, 0xd1, 0x33, 0xd2, 0xa3, 0x9c, 0xa0, 0xcc, 0xcb, 0x05, 0x36, 0xf7, 0xf5, 0x10, 0x4c, 0x89,
+		0xd3, 0x9e, 0xa6, 0xa3, 0xac, 0x15, 0x00, 0x4d, 0xd7, 0x0d, 0xdb, 0xab, 0xae, 0xfe, 0x50, 0xaa,
+		0x0f, 0xb7, 0x58, 0x70, 0x40, 0x8a, 0x87, 0xc1, 0xec, 0x1f, 0x49, 0x00, 0x6e, 0xd1, 0x50, 0xbd,
+		0xcd, 0xc3, 0x04, 0xbf, 0xa9, 0x48, 0xaf, 0xbb, 0xb2, 0xed, 0x14, 0x60, 0x59, 0xe4, 0x5c, 0x88,
+		0xec, 0xb4, 0x6c, 0xe1, 0x9d, 0x96, 0xce, 0xef, 0x9f, 0xb0, 0x84, 0x38, 0xc6, 0x8d, 0xb8, 0x57,
+		0xb3, 0x14, 0x48, 0x58, 0xb8, 0xa3, 0xe9, 0x76, 0xab, 0xc1, 0x8d, 0xf8, 0xe2, 0x81, 0x84, 0x5f,
+		0xac, 0x73, 0xb4, 0xe2, 0xf0, 0xc9, 0x9d, 0x82, 0x84, 0xc8, 0x75, 0x9c, 0xd6, 0x21, 0x14, 0x87,
+		0x70, 0xbd, 0x42, 0x5c, 0x35, 0xf5, 0x1a, 0xd5, 0x42, 0x5d, 0x0e, 0x9d, 0xfe, 0xae, 0x04, 0x71,
+		0x31, 0xaa, 0xa6, 0x21, 0x53, 0x29, 0x57, 0x03, 0x3e, 0x6f, 0x1a, 0xd2, 0x22, 0x73, 0x4d, 0xa9,
+		0xad, 0xd7, 0xce, 0xc9, 0xdf, 0x8e, 0xf7, 0x65, 0x9e, 0x97, 0x5f, 0x89, 0xa3, 0x29, 0x48, 0x89,
+		0xcc, 0x73, 0x8f, 0x9c, 0x3b, 0x2f, 0xbf, 0x4a, 0xb7, 0x2e, 0x44, 0xd6, 0x59, 0x75, 0x9d, 0xb8,
+		0xa5, 0xda, 0xea, 0xf2, 0x33, 0xb2, 0xe4, 0x2d, 0x38, 0xe7, 0x29, 0x08, 0xa1, 0x7b, 0xe0, 0xa8,
+		0x28, 0xb8, 0x74, 0xe9, 0xd2, 0xa5, 0xc7, 0x3c, 0x85, 0xb7, 0xdf, 0x1f, 0x0b, 0x16, 0x3f, 0xee,
+		0x29, 0xfe, 0x78, 0x7f, 0xf1, 0x25, 0x4f, 0xf1, 0x2f, 0xbc, 0x3f, 0x56, 0xfc, 0xcb, 0x30, 0xdd,
+		0x30, 0x3a, 0x41, 0xed, 0x16, 0xe5, 0xc0, 0x79, 0xb0, 0x75, 0x45, 0x7a, 0xf6, 0x61, 0x4e, 0xb4,
+		0x63, 0xb4, 0x35, 0x7d, 0x67, 0xd1, 0x30, 0x77, 0xdc, 0x9b, 0xd1, 0x24, 0x16, 0xb3, 0x3c, 0xf7,
+		0xa3, 0xbb, 0x5b, 0xff, 0x4d, 0x92, 0x3e, 0x15, 0x0a, 0x2f, 0xad, 0x15, 0x3f, 0x17, 0x9a, 0x5d,
+		0x62, 0xc0, 0x35, 0xd1, 0x77, 0x0a, 0xde, 0x6e, 0xe3, 0x06, 0x51, 0x30, 0xfc, 0xf1, 0x83, 0x30,
+		0xb3, 0x63, 0xec, 0x18, 0x94, 0xd3, 0x19, 0xf2, 0x8b, 0x09, 0x81, 0x92, 0x4e, 0xee, 0xec, 0xc8,
+		0x7b, 0xd8, 0xf9, 0x55, 0x98, 0xe6, 0xc4, 0x2a, 0x0d, 0x0d, 0xd9, 0xc9, 0x13, 0xda, 0xf7, 0xda,
+		0x43, 0xf6, 0x57, 0xbe, 0x45, 0x97, 0xdd, 0xca, 0x14, 0x87, 0x92, 0x32, 0x76, 0x38, 0x95, 0x57,
+		0xe0, 0xb0, 0x8f, 0x1f, 0x0b, 0xc8, 0xb1, 0x39, 0x82, 0xe3, 0x6f, 0x70, 0x8e, 0xd3, 0x1e, 0x8e,
+		0x75, 0x0e, 0xcd, 0x97, 0x60, 0xf2, 0x20, 0xbc, 0xfe, 0x05, 0xe7, 0x95, 0xc2, 0x5e, 0x26, 0x4b,
+		0x90, 0xa1, 0x4c, 0x1a, 0x3d, 0xcb, 0x36, 0x3a, 0x74, 0xb5, 0xb3, 0x3f, 0x9b, 0xdf, 0xfc, 0x16,
+		0xf3, 0x50, 0x69, 0x02, 0x2b, 0x39, 0xa8, 0x7c, 0x1e, 0x68, 0xa0, 0x4b, 0xae, 0xf1, 0x8d, 0xe0,
+		0xf0, 0x55, 0x2e, 0x88, 0x43, 0x9f, 0xdf, 0x84, 0x19, 0xf2, 0x9b, 0x2e, 0x46, 0xbc, 0x92, 0x8c,
+		0xbe, 0x23, 0x91, 0xfd, 0x9d, 0xf7, 0x30, 0x27, 0x38, 0xed, 0x30, 0xf0, 0xc8, 0xe4, 0xe9, 0xc5,
+		0x1d, 0x6c, 0xdb, 0xd8, 0xb4, 0x54, 0xad, 0x3d, 0x48, 0x3c, 0xcf, 0x21, 0x73, 0xf6, 0x23, 0xdf,
+		0xf3, 0xf7, 0xe2, 0x12, 0x43, 0x16, 0xda, 0xed, 0xfc, 0x06, 0x1c, 0x1d, 0x60, 0x15, 0x63, 0xf0,
+		0xfc, 0x28, 0xe7, 0x39, 0xd3, 0x67, 0x19, 0x84, 0xed, 0x1a, 0x88, 0x7c, 0xa7, 0x2f, 0xc7, 0xe0,
+		0xf9, 0x31, 0xce, 0x13, 0x71, 0xac, 0xe8, 0x52, 0xc2, 0xf1, 0x2a, 0x4c, 0xdd, 0xc0, 0xe6, 0x96,
+		0x61, 0xf1, 0x83, 0xfd, 0x31, 0xd8, 0xfd, 0x3c, 0x67, 0x97, 0xe1, 0x40, 0x7a, 0xd2, 0x4f, 0x78,
+		0x5d, 0x82, 0xc4, 0xb6, 0xd6, 0xc0, 0x63, 0xb0, 0xb8, 0xcd, 0x59, 0xc4, 0x09, 0x3d, 0x81, 0x16,
+		0x20, 0xb5, 0x63, 0xf0, 0xf5, 0xe8, 0x68, 0xf8, 0xc7, 0x39, 0x7c, 0x42, 0x60, 0x38, 0x8b, 0xae,
+		0xd1, 0xed, 0xb5, 0xc9, 0x62, 0x75, 0x34, 0x8b, 0x5f, 0x10, 0x2c, 0x04, 0x86, 0xb3, 0x38, 0x80,
+		0x5a, 0x3f, 0x21, 0x58, 0x58, 0x1e, 0x7d, 0xbe, 0x9d, 0xdc, 0xf7, 0x6b, 0xef, 0x19, 0xfa, 0x38,
+		0x42, 0x7c, 0x92, 0x73, 0x00, 0x0e, 0x21, 0x0c, 0x9e, 0x80, 0xe4, 0xb8, 0x1d, 0xf1, 0xb7, 0xbe,
+		0x27, 0x86, 0x87, 0xe8, 0x81, 0x25, 0xc8, 0x08, 0x07, 0x45, 0x4e, 0x61, 0x46, 0xb3, 0xf8, 0x34,
+		0x67, 0x91, 0xf6, 0xc0, 0x78, 0x33, 0x6c, 0x6c, 0xd9, 0x3b, 0x78, 0x1c, 0x26, 0x9f, 0x11, 0xcd,
+		0xe0, 0x10, 0xae, 0xca, 0x2d, 0xac, 0x37, 0x76, 0xc7, 0xe3, 0xf0, 0x59, 0xa1, 0x4a, 0x81, 0x21,
+		0x2c, 0x4a, 0x30, 0xd9, 0xd1, 0x4c, 0x6b, 0x57, 0x6b, 0x8f, 0xd5, 0x1d, 0x7f, 0x9b, 0xf3, 0x48,
+		0x39, 0x20, 0xae, 0x91, 0x9e, 0x7e, 0x10, 0x36, 0x9f, 0x13, 0x1a, 0xe9, 0xe9, 0x3e, 0x46, 0x6b,
+		0x30, 0x63, 0xd9, 0xf4, 0x16, 0xc4, 0x41, 0xb8, 0xfd, 0x1d, 0x31, 0xf4, 0x18, 0x76, 0xc5, 0xcb,
+		0xf1, 0x09, 0x48, 0x5a, 0xad, 0xe7, 0xc7, 0x62, 0xf3, 0x79, 0xd1, 0xd3, 0x14, 0x40, 0xc0, 0xcf,
+		0xc0, 0xb1, 0x81, 0xd3, 0xc4, 0x18, 0xcc, 0x7e, 0x89, 0x33, 0x3b, 0x32, 0x60, 0xaa, 0xe0, 0x2e,
+		0xe1, 0xa0, 0x2c, 0xff, 0xae, 0x70, 0x09, 0x38, 0xc0, 0x6b, 0x8d, 0xec, 0x10, 0x5a, 0xda, 0xf6,
+		0xc1, 0xb4, 0xf6, 0xf7, 0x84, 0xd6, 0x18, 0xd6, 0xa7, 0xb5, 0x75, 0x38, 0xc2, 0x39, 0x1e, 0xac,
+		0x5f, 0x7f, 0x59, 0x38, 0x56, 0x86, 0xde, 0xf0, 0xf7, 0xee, 0x4f, 0xc0, 0xac, 0xa3, 0x4e, 0xb1,
+		0x15, 0x65, 0xa9, 0xe4, 0x06, 0xc0, 0x68, 0xce, 0xbf, 0xc2, 0x39, 0x0b, 0x8f, 0xef, 0xec, 0x65,
+		0x59, 0x2b, 0x5a, 0x97, 0x30, 0x7f, 0x1a, 0xb2, 0x82, 0x79, 0x4f, 0x37, 0x71, 0xc3, 0xd8, 0xd1,
+		0x5b, 0xcf, 0xe3, 0xe6, 0x18, 0xac, 0x7f, 0x35, 0xd0, 0x55, 0x1b, 0x1e, 0x38, 0xe1, 0x5c, 0x05,
+		0xd9, 0x89, 0x55, 0xd4, 0x56, 0xa7, 0x6b, 0x98, 0xf6, 0x08, 0x8e, 0x5f, 0x10, 0x3d, 0xe5, 0xe0,
+		0xaa, 0x14, 0x96, 0xaf, 0x00, 0xbb, 0x1a, 0x3c, 0xae, 0x49, 0x7e, 0x91, 0x33, 0x9a, 0x74, 0x51,
+		0xdc, 0x71, 0x34, 0x8c, 0x4e, 0x57, 0x33, 0xc7, 0xf1, 0x7f, 0x7f, 0x5f, 0x38, 0x0e, 0x0e, 0xe1,
+		0x8e, 0x83, 0x44, 0x74, 0x64, 0xb6, 0x1f, 0x83, 0xc3, 0x97, 0x84, 0xe3, 0x10, 0x18, 0xce, 0x42,
+		0x04, 0x0c, 0x63, 0xb0, 0xf8, 0xb2, 0x60, 0x21, 0x30, 0x84, 0xc5, 0x3b, 0xdc, 0x89, 0xd6, 0xc4,
+		0x3b, 0x2d, 0xcb, 0xe6, 0x97, 0xf7, 0xf7, 0x67, 0xf5, 0x0f, 0xbe, 0xe7, 0x0f, 0xc2, 0x14, 0x0f,
+		0x94, 0x78, 0x22, 0xbe, 0xbb, 0x44, 0xf7, 0x47, 0x47, 0x0b, 0xf6, 0x6b, 0xc2, 0x13, 0x79, 0x60,
+		0x44, 0x36, 0x4f, 0x84, 0x48, 0xd4, 0xde, 0x20, 0xab, 0xb2, 0x31, 0xd8, 0xfd, 0xc3, 0x80, 0x70,
+		0x75, 0x81, 0x25, 0x3c, 0x3d, 0xf1, 0x4f, 0x4f, 0xbf, 0x8e, 0xf7, 0xc6, 0xb2, 0xce, 0x7f, 0x14,
+		0x88, 0x7f, 0x36, 0x18, 0x92, 0xf9, 0x90, 0x4c, 0x20, 0x9e, 0x42, 0xa3, 0x3e, 0xea, 0xc9, 0xbe,
+		0xfb, 0x75, 0xde, 0x5e, 0x7f, 0x38, 0x95, 0x5f, 0x06, 0x99, 0xe7, 0xb8, 0x01, 0xec, 0x48, 0x66,
+		0xef, 0x79, 0xdd, 0xb1, 0x73, 0x5f, 0xcc, 0x93, 0xbf, 0x0c, 0x93, 0xbe, 0x80, 0x67, 0x34, 0xab,
+		0xbf, 0xca, 0x59, 0xa5, 0xbc, 0xf1, 0x4e, 0xfe, 0x02, 0x44, 0x48, 0xf0, 0x32, 0x1a, 0xfe, 0x93,
+		0x1c, 0x4e, 0xc9, 0xf3, 0x4f, 0x42, 0x42, 0x04, 0x2d, 0xa3, 0xa1, 0x7f, 0x8d, 0x43, 0x1d, 0x08,
+		0x81, 0x8b, 0x80, 0x65, 0x34, 0xfc, 0xbd, 0x02, 0x2e, 0x20, 0x04, 0x3e, 0xbe, 0x0a, 0xbf, 0xf2,
+		0xbe, 0x08, 0x83, 0x0b, 0x48, 0x9e, 0x5c, 0x4d, 0x66, 0x91, 0xca, 0x68, 0xf4, 0x4f, 0xf1, 0xca,
+		0x05, 0x22, 0xff, 0x18, 0x44, 0xc7, 0x54, 0xf8, 0xfb, 0x39, 0x94, 0xd1, 0xe7, 0x4b, 0x30, 0xe1,
+		0x89, 0x4e, 0x46, 0xc3, 0x3f, 0xc0, 0xe1, 0x5e, 0x14, 0x11, 0x9d, 0x47, 0x27, 0xa3, 0x19, 0xfc,
+		0x75, 0x21, 0x3a, 0x47, 0x10, 0xb5, 0x89, 0xc0, 0x64, 0x34, 0xfa, 0x83, 0x42, 0xeb, 0x02, 0x92,
+		0x7f, 0x3b, 0x24, 0x9d, 0xc9, 0x66, 0x34, 0xfe, 0x67, 0x38, 0xde, 0xc5, 0x10, 0x0d, 0xf4, 0xf4,
+		0x03, 0xb0, 0xf8, 0x59, 0xa1, 0x01, 0x0f, 0x8a, 0x0c, 0xa3, 0x60, 0x00, 0x33, 0x9a, 0xd3, 0xcf,
+		0x89, 0x61, 0x14, 0x88, 0x5f, 0x48, 0x6f, 0x52, 0x9f, 0x3f, 0x9a, 0xc5, 0x87, 0x44, 0x6f, 0x52,
+		0x7a, 0x22, 0x46, 0x30, 0x22, 0x18, 0xcd, 0xe3, 0xc3, 0x42, 0x8c, 0x40, 0x40, 0x90, 0x5f, 0x03,
+		0xd4, 0x1f, 0x0d, 0x8c, 0xe6, 0xf7, 0x32, 0xe7, 0x37, 0xd5, 0x17, 0x0c, 0xe4, 0x9f, 0x82, 0x23,
+		0x83, 0x23, 0x81, 0xd1, 0x5c, 0x3f, 0xf2, 0x7a, 0x60, 0xed, 0xe6, 0x0d, 0x04, 0xf2, 0xeb, 0x30,
+		0x33, 0x28, 0x0a, 0x18, 0xcd, 0xf6, 0xa3, 0xaf, 0xfb, 0x1d, 0xb7, 0x37, 0x08, 0xc8, 0x17, 0x00,
+		0xdc, 0x09, 0x78, 0x34, 0xaf, 0x9f, 0xe7, 0xbc, 0x3c, 0x20, 0x32, 0x34, 0xf8, 0xfc, 0x3b, 0x1a,
+		0x7f, 0x5b, 0x0c, 0x0d, 0x8e, 0x20, 0x43, 0x43, 0x4c, 0xbd, 0xa3, 0xd1, 0x1f, 0x17, 0x43, 0x43,
+		0x40, 0x88, 0x65, 0x7b, 0x66, 0xb7, 0xd1, 0x1c, 0x3e, 0x29, 0x2c, 0xdb, 0x83, 0xca, 0xaf, 0xc2,
+		0x54, 0xdf, 0x84, 0x38, 0x9a, 0xd5, 0xa7, 0x38, 0x2b, 0x39, 0x38, 0x1f, 0x7a, 0x27, 0x2f, 0x3e,
+		0x19, 0x8e, 0xe6, 0xf6, 0x8b, 0x81, 0xc9, 0x8b, 0xcf, 0x85, 0xf9, 0x27, 0x20, 0xa1, 0xf7, 0xda,
+		0x6d, 0x32, 0x78, 0xd0, 0xfe, 0x1f, 0x6f, 0x65, 0xbf, 0xf3, 0x03, 0xae, 0x1d, 0x01, 0xc8, 0x5f,
+		0x80, 0x28, 0xee, 0x6c, 0xe1, 0xe6, 0x28, 0xe4, 0x77, 0x7f, 0x20, 0x1c, 0x26, 0xa1, 0xce, 0xbf,
+		0x1d, 0x80, 0x6d, 0x8d, 0xd0, 0x3b, 0x8e, 0x23, 0xb0, 0x7f, 0xf4, 0x03, 0xfe, 0xb5, 0x84, 0x0b,
+		0x71, 0x19, 0xb0, 0x6f, 0x2f, 0xf6, 0x67, 0xf0, 0x3d, 0x3f, 0x03, 0xda, 0x23, 0x97, 0x20, 0x4e,
+		0x8e, 0xb1, 0x6c, 0x6d, 0x67, 0x14, 0xfa, 0x8f, 0x39, 0x5a, 0xd0, 0x13, 0x85, 0x75, 0x0c, 0x13,
+		0xdb, 0xda, 0x8e, 0x35, 0x0a, 0xfb, 0x9f, 0x39, 0xd6, 0x01, 0x10, 0x70, 0x43, 0xb3, 0xec, 0x71,
+		0xda, 0xfd, 0x5f, 0x04, 0x58, 0x00, 0x88, 0xd0, 0xe4, 0xf7, 0x75, 0xbc, 0x37, 0x0a, 0xfb, 0x7d,
+		0x21, 0x34, 0xa7, 0xcf, 0x3f, 0x09, 0x49, 0xf2, 0x93, 0x7d, 0x02, 0x35, 0x02, 0xfc, 0x27, 0x1c,
+		0xec, 0x22, 0x48, 0xcd, 0x96, 0xdd, 0xb4, 0x5b, 0xa3, 0x95, 0xfd, 0x1a, 0xef, 0x69, 0x41, 0x9f,
+		0x2f, 0xc0, 0x84, 0x65, 0x37, 0x9b, 0x3d, 0x1e, 0x9f, 0x8e, 0x80, 0xff, 0xd7, 0x1f, 0x38, 0x5b,
+		0x16, 0x0e, 0x86, 0xf4, 0xf6, 0xcd, 0xeb, 0x76, 0xd7, 0xa0, 0x97, 0x1b, 0x46, 0x71, 0x78, 0x9d,
+		0x73, 0xf0, 0x40, 0xf2, 0x25, 0x48, 0x91, 0xb6, 0x88, 0x53, 0xe3, 0x51, 0x2c, 0xfe, 0x94, 0x2b,
+		0xc0, 0x07, 0x2a, 0xfe, 0xff, 0x5f, 0xfd, 0xc6, 0x9c, 0xf4, 0xf5, 0x6f, 0xcc, 0x49, 0xff, 0xe9,
+		0x1b, 0x73, 0xd2, 0x07, 0xbf, 0x39, 0x77, 0xe8, 0xeb, 0xdf, 0x9c, 0x3b, 0xf4, 0x7b, 0xdf, 0x9c,
+		0x3b, 0x34, 0x78, 0x97, 0x18, 0x96, 0x8c, 0x25, 0x83, 0xed, 0x0f, 0x3f, 0xfb, 0xd6, 0x9d, 0x96,
+		0xbd, 0xdb, 0xdb, 0x5a, 0x6c, 0x18, 0x9d, 0x33, 0x0d, 0xc3, 0xea, 0x18, 0xd6, 0x19, 0xff, 0xbe,
+		0x2e, 0xfd, 0x05, 0x1f, 0x4b, 0xc2, 0x31, 0x56, 0xac, 0xb2, 0x22, 0x96, 0x60, 0x3c, 0x51, 0xca,
+		0x5b, 0x34, 0x7a, 0xdf, 0x37, 0x77, 0x0d, 0xa6, 0xab, 0xa4, 0xf5, 0x24, 0xaa, 0x73, 0x77, 0xac,
+		0x07, 0x7e, 0xbc, 0xb4, 0xe0, 0x0b, 0x60, 0xf8, 0xe1, 0x84, 0x37, 0x2b, 0xf7, 0x6e, 0x09, 0xe4,
+		0x7a, 0x43, 0x6b, 0x6b, 0xe6, 0x5f, 0x94, 0x15, 0x7a, 0x0c, 0x80, 0x5d, 0x18, 0x70, 0x5e, 0x1c,
+		0x20, 0xe7, 0x65, 0xde, 0xc6, 0x2d, 0xb2, 0x9a, 0xe8, 0xdd, 0xdd, 0x24, 0xa5, 0x25, 0x3f, 0x4f,
+		0x3f, 0x0d, 0xe0, 0x16, 0x90, 0x93, 0xd7, 0x7a, 0xa9, 0xb0, 0x5c, 0x50, 0xc4, 0x91, 0x70, 0x7d,
+		0xad, 0x52, 0x62, 0x5f, 0x01, 0x1f, 0x22, 0x87, 0x9b, 0xde, 0x42, 0xe7, 0x7e, 0xe9, 0x61, 0x98,
+		0xf2, 0xe6, 0xb3, 0x4f, 0x32, 0x43, 0xf9, 0x2b, 0x64, 0xe5, 0x43, 0x2e, 0x2a, 0xa9, 0x5a, 0xb3,
+		0x89, 0x9b, 0x6a, 0x4b, 0x47, 0x23, 0x3e, 0xbf, 0xca, 0xfe, 0xd6, 0xbf, 0x8b, 0xd2, 0xa6, 0x4d,
+		0x32, 0x60, 0x81, 0xe0, 0xaa, 0x3a, 0x99, 0x43, 0x5b, 0x9d, 0x6e, 0x1b, 0xd3, 0x03, 0x28, 0xb5,
+		0x25, 0xf4, 0x3f, 0xda, 0x3d, 0x13, 0x7e, 0xe4, 0x30, 0x6b, 0xda, 0x85, 0x3b, 0xbd, 0x97, 0xbf,
+		0xe6, 0x5e, 0x2f, 0x70, 0x04, 0x1c, 0xc9, 0xf1, 0x6b, 0x5c, 0x42, 0xb1, 0x3a, 0x13, 0x22, 0x2e,
+		0xc3, 0x14, 0xf9, 0x2c, 0xa1, 0xeb, 0x93, 0x6f, 0xc4, 0x88, 0x10, 0xad, 0x95, 0x39, 0xd2, 0x15,
+		0xed, 0x31, 0x88, 0x59, 0xb4, 0x53, 0x46, 0xb1, 0x10, 0xe2, 0x70, 0x72, 0xb2, 0x9c, 0x67, 0x66,
+		0xe0, 0xb4, 0x68, 0x04, 0x83, 0xdf, 0xe6, 0x0c, 0x52, 0x14, 0x26, 0x5a, 0xa3, 0xc3, 0x14, 0xfb,
+		0xdc, 0x1e, 0x7b, 0x5a, 0xb3, 0xff, 0xaa, 0xf0, 0xd7, 0xbf, 0xf0, 0x08, 0x3d, 0xf4, 0xbb, 0xd7,
+		0x6f, 0x74, 0x03, 0x06, 0x8b, 0x22, 0x73, 0xde, 0x6e, 0x7b, 0x31, 0xa4, 0x45, 0x7d, 0xbc, 0xdd,
+		0xfb, 0x57, 0xf6, 0x8f, 0x79, 0x65, 0x73, 0x83, 0x2c, 0xdc, 0x53, 0xd3, 0x24, 0xe7, 0xca, 0x0a,
+		0xf2, 0x45, 0x98, 0x24, 0xe7, 0x84, 0xae, 0x72, 0xf6, 0xaf, 0xe5, 0x9f, 0x7c, 0xe1, 0x11, 0x36,
+		0xd0, 0x08, 0x88, 0xab, 0xa6, 0x58, 0x19, 0xe6, 0xb0, 0x9e, 0x7d, 0xb0, 0xdf, 0x1f, 0xb1, 0xff,
+		0x1e, 0xa6, 0xdc, 0x9f, 0xf0, 0x8a, 0xba, 0x15, 0xa3, 0xff, 0x9d, 0x87, 0x8f, 0x45, 0x60, 0x8a,
+		0x7c, 0x0d, 0x68, 0x9c, 0xa1, 0xff, 0x72, 0xaf, 0x14, 0xa5, 0x89, 0x31, 0x8e, 0xa1, 0x2e, 0x32,
+		0x67, 0x31, 0xda, 0x6e, 0xff, 0xe4, 0xa7, 0x3f, 0x1d, 0x75, 0x1d, 0x4a, 0x7e, 0xa5, 0xff, 0x6a,
+		0xcd, 0x68, 0x1e, 0xaf, 0x09, 0x1e, 0xc1, 0xfb, 0x32, 0xf9, 0xb7, 0x91, 0xb3, 0x1d, 0xce, 0x66,
+		0xd4, 0x5c, 0x24, 0x98, 0x38, 0x08, 0x32, 0x13, 0x31, 0xa3, 0x1d, 0x27, 0xee, 0x78, 0x5d, 0xe0,
+		0x99, 0x0f, 0x23, 0x17, 0xd0, 0xf2, 0x4b, 0x90, 0x6e, 0x1a, 0xba, 0xad, 0x1a, 0x9d, 0x96, 0x8d,
+		0x3b, 0x5d, 0x7b, 0xe4, 0x4c, 0xfe, 0xa7, 0x8c, 0x49, 0x42, 0x99, 0x24, 0xb8, 0x9a, 0x80, 0x11,
+		0x49, 0xd8, 0x67, 0xfb, 0xe3, 0x48, 0xf2, 0x67, 0x8e, 0x24, 0x14, 0x43, 0x24, 0xb9, 0x2b, 0xeb,
+		0xb0, 0x9a, 0xd7, 0xf9, 0x69, 0xa6, 0x7d, 0x8b, 0x59, 0x81, 0x63, 0x1d, 0xff, 0x3c, 0x02, 0x73,
+		0x9c, 0x78, 0x4b, 0xb3, 0xf0, 0x99, 0x1b, 0x67, 0xb7, 0xb0, 0xad, 0x9d, 0x3d, 0xd3, 0x30, 0x5a,
+		0x3a, 0x37, 0x95, 0x69, 0x56, 0xbe, 0x48, 0xca, 0x17, 0x79, 0xf9, 0xec, 0xc0, 0x03, 0xce, 0xd9,
+		0xe1, 0xd3, 0xe0, 0x6c, 0xbf, 0x0d, 0xe6, 0xda, 0x10, 0x29, 0x19, 0x2d, 0xfa, 0xb1, 0x42, 0x13,
+		0xeb, 0x46, 0x87, 0x4f, 0x48, 0x2c, 0x81, 0xae, 0x40, 0x4c, 0xeb, 0x18, 0x3d, 0xdd, 0x66, 0x93,
+		0x51, 0xf1, 0x91, 0xaf, 0xde, 0x99, 0x3f, 0xf4, 0xfb, 0x77, 0xe6, 0x0f, 0x33, 0xb6, 0x56, 0xf3,
+		0xfa, 0x62, 0xcb, 0x38, 0xd3, 0xd1, 0xec, 0x5d, 0xe2, 0x02, 0x7e, 0xf7, 0x8b, 0x0f, 0x03, 0xaf,
+		0xaf, 0xaa, 0xdb, 0x9f, 0x7d, 0xe5, 0x97, 0x4f, 0x4b, 0x0a, 0xc7, 0xe7, 0x23, 0xaf, 0x7e, 0x62,
+		0x5e, 0xca, 0x75, 0x21, 0x5e, 0xc6, 0x8d, 0x7d, 0x2a, 0xac, 0x06, 0x2a, 0x3c, 0xcb, 0x2b, 0x3c,
+		0xde, 0x5f, 0x21, 0xbb, 0x1d, 0x5e, 0xc6, 0x0d, 0x4f, 0xb5, 0x65, 0xdc, 0x08, 0xd4, 0x58, 0x85,
+		0x44, 0x55, 0xb7, 0xd9, 0xb7, 0xc7, 0x4f, 0x42, 0xb8, 0xa5, 0xb3, 0xaf, 0xd2, 0x92, 0xc5, 0x07,
+		0x0f, 0xd0, 0x14, 0x85, 0xe0, 0x72, 0x35, 0x48, 0x94, 0x71, 0x83, 0xb1, 0x2a, 0x41, 0xb8, 0x89,
+		0x1b, 0x59, 0xe9, 0x6e, 0x85, 0x24, 0xe8, 0x62, 0xf9, 0xf7, 0xfe, 0x70, 0xee, 0xd0, 0x0b, 0xdf,
+		0x98, 0x3b, 0x34, 0xd4, 0x9c, 0x72, 0xa3, 0xcd, 0xc9, 0xb1, 0xa2, 0xff, 0x21, 0xc1, 0x31, 0x66,
+		0xc9, 0xae, 0x27, 0xd1, 0xf4, 0xbd, 0x61, 0x2f, 0x8a, 0x5d, 0x84, 0x70, 0x41, 0xdf, 0x43, 0xc7,
+		0xd8, 0x12, 0x4f, 0xed, 0x99, 0x6d, 0xde, 0x13, 0x71, 0x92, 0xde, 0x30, 0xdb, 0xfe, 0xef, 0x57,
+		0x52, 0xfc, 0xfb, 0x95, 0xe2, 0x07, 0xa4, 0x83, 0xc5, 0x72, 0x89, 0x82, 0xbe, 0x47, 0x75, 0xb6,
+		0x26, 0x3d, 0xfb, 0xd0, 0xc8, 0x93, 0xfe, 0xeb, 0xba, 0x71, 0x53, 0x27, 0x62, 0x77, 0xb7, 0xc4,
+		0x29, 0xff, 0x5c, 0xf0, 0x94, 0xff, 0x29, 0xdc, 0x6e, 0x5f, 0x23, 0x74, 0xeb, 0xbe, 0xf6, 0xff,
+		0x5c, 0x08, 0xe6, 0xfa, 0x3c, 0x29, 0x0f, 0x83, 0x87, 0x29, 0x21, 0x0f, 0x89, 0x32, 0x27, 0x21,
+		0xef, 0x9c, 0x59, 0xb8, 0x61, 0xe8, 0x4d, 0xf6, 0x85, 0x46, 0x58, 0x11, 0x49, 0xa2, 0x08, 0x5d,
+		0xd3, 0x0d, 0x8b, 0x7f, 0xcb, 0xcf, 0x12, 0xc5, 0x8f, 0x1d, 0x50, 0x11, 0x93, 0xa2, 0x26, 0xa1,
+		0x8d, 0xb3, 0x63, 0x6a, 0x43, 0x34, 0xc2, 0x77, 0xf7, 0x61, 0x5c, 0xad, 0x7c, 0x38, 0x04, 0xf3,
+		0x41, 0xad, 0x90, 0xb5, 0x85, 0x65, 0x6b, 0x9d, 0xee, 0x30, 0xb5, 0x3c, 0x01, 0xc9, 0x75, 0x41,
+		0x73, 0x60, 0xbd, 0xdc, 0x3e, 0xa0, 0x5e, 0xd2, 0x4e, 0x55, 0x42, 0x31, 0xe7, 0xc6, 0x54, 0x8c,
+		0xd3, 0x8e, 0xbb, 0xd2, 0xcc, 0xa7, 0x23, 0x70, 0x0f, 0x7d, 0xec, 0xc5, 0xec, 0xb4, 0x74, 0xfb,
+		0x4c, 0xc3, 0xdc, 0xeb, 0xda, 0x06, 0xa9, 0xc8, 0xd8, 0xe6, 0x7a, 0x99, 0x72, 0x8b, 0x17, 0x59,
+		0xf1, 0x60, 0x97, 0x9b, 0xdb, 0x86, 0xe8, 0x1a, 0xc1, 0x11, 0x8d, 0xd8, 0x86, 0xad, 0xb5, 0xb9,
+		0xa6, 0x58, 0x82, 0xe4, 0xb2, 0x07, 0x62, 0x42, 0x2c, 0xb7, 0x25, 0xde, 0x86, 0x69, 0x63, 0x6d,
+		0x9b, 0x7d, 0x67, 0x1f, 0xa6, 0x43, 0x2c, 0x41, 0x32, 0xe8, 0x27, 0xf5, 0x33, 0x10, 0xd5, 0x7a,
+		0xec, 0x6e, 0x57, 0x98, 0x8c, 0x3d, 0x9a, 0xc8, 0x5d, 0x83, 0x38, 0xbf, 0xf5, 0x40, 0x2e, 0x37,
+		0x5d, 0xc7, 0x7b, 0xb4, 0x9e, 0x94, 0x42, 0x7e, 0xa2, 0x45, 0x88, 0x52, 0xe1, 0xf9, 0x8d, 0xb8,
+		0xec, 0x62, 0x9f, 0xf4, 0x8b, 0x54, 0x48, 0x85, 0x91, 0xe5, 0xae, 0x42, 0xa2, 0x6c, 0x90, 0x99,
+		0xc0, 0xcf, 0x2d, 0xc9, 0xb8, 0x51, 0x99, 0xbb, 0x3d, 0x5b, 0x7c, 0xbc, 0x46, 0x13, 0xe4, 0x9b,
+		0x4d, 0xf6, 0xee, 0x02, 0xbf, 0x9f, 0xc6, 0x53, 0xb9, 0x12, 0xc4, 0x29, 0xef, 0x5a, 0xd7, 0x79,
+		0xcc, 0x48, 0xf2, 0x3c, 0x66, 0xc4, 0xd9, 0x87, 0x5c, 0x61, 0x11, 0x44, 0x9a, 0x9a, 0xad, 0xf1,
+		0x76, 0xd3, 0xdf, 0xb9, 0x1f, 0x83, 0x04, 0x67, 0x62, 0xa1, 0x73, 0x10, 0x36, 0xba, 0xe2, 0x36,
+		0xe4, 0xec, 0xb0, 0xa6, 0xd4, 0xba, 0xc5, 0x08, 0xf1, 0xbd, 0x0a, 0x21, 0x2e, 0xae, 0x0e, 0x75,
+		0xa3, 0x8f, 0xfa, 0xdc, 0x68, 0x07, 0xdb, 0x5b, 0xdb, 0xb6, 0xfb, 0x83, 0x75, 0x67, 0x9f, 0x29,
+		0x38, 0x86, 0x72, 0x3b, 0x04, 0x73, 0x9e, 0xd2, 0x1b, 0xd8, 0x24, 0xdb, 0x7e, 0xcc, 0x12, 0xb9,
+		0xa5, 0x20, 0x8f, 0x80, 0xbc, 0x7c, 0x88, 0xa9, 0x3c, 0x09, 0xe1, 0x42, 0xb7, 0x4b, 0x9e, 0x1e,
+		0xa2, 0xe9, 0x86, 0xc1, 0x6c, 0x25, 0xa2, 0x38, 0x69, 0x52, 0x66, 0x19, 0xdb, 0xf6, 0x4d, 0xcd,
+		0x74, 0x9e, 0x25, 0x12, 0xe9, 0xdc, 0x25, 0x48, 0x96, 0x0c, 0xdd, 0xc2, 0xba, 0xd5, 0xa3, 0xe3,
+		0x6f, 0xab, 0x6d, 0x34, 0xae, 0x73, 0x0e, 0x2c, 0x41, 0x94, 0xad, 0x75, 0xbb, 0x14, 0x19, 0x51,
+		0xc8, 0x4f, 0x36, 0x13, 0x16, 0x6b, 0x43, 0xd5, 0x73, 0xe1, 0x60, 0xea, 0xe1, 0x0d, 0x74, 0xf4,
+		0xf3, 0xdf, 0x25, 0x38, 0xd1, 0x3f, 0x90, 0xae, 0xe3, 0x3d, 0xeb, 0xa0, 0xe3, 0xe8, 0x69, 0x48,
+		0xae, 0xd1, 0x77, 0x21, 0xaf, 0xe1, 0x3d, 0x34, 0x4b, 0x2e, 0x86, 0x9e, 0xbb, 0x70, 0xe1, 0xec,
+		0x25, 0x66, 0xe5, 0x57, 0x0e, 0x29, 0x22, 0x03, 0xcd, 0x41, 0xd2, 0xc2, 0x8d, 0xee, 0xb9, 0x0b,
+		0x17, 0xaf, 0x9f, 0x65, 0x66, 0x75, 0xe5, 0x90, 0xe2, 0x66, 0xe5, 0x13, 0xa4, 0xc5, 0xaf, 0x7e,
+		0x72, 0x5e, 0x2a, 0x46, 0x21, 0x6c, 0xf5, 0x3a, 0x3f, 0x34, 0xdb, 0xf8, 0x7e, 0x0c, 0x16, 0x3c,
+		0xa5, 0xcc, 0x3b, 0xf1, 0x3b, 0xf4, 0xce, 0x6b, 0x9e, 0xb2, 0xa7, 0xfd, 0x94, 0x62, 0x48, 0xe4,
+		0xb6, 0xaf, 0x16, 0x73, 0xbf, 0x2a, 0x41, 0x6a, 0x53, 0x70, 0x26, 0x5f, 0x4f, 0x3c, 0x01, 0xe0,
+		0xd4, 0x24, 0x86, 0xca, 0xf1, 0xc5, 0x60, 0x5d, 0x8b, 0x0e, 0x46, 0xf1, 0x90, 0x93, 0x2b, 0xb4,
+		0x5d, 0xd3, 0xe8, 0x1a, 0x16, 0x7f, 0xa2, 0x66, 0x04, 0xd4, 0x21, 0x26, 0xdf, 0xaa, 0x50, 0xaf,
+		0xa6, 0xde, 0x30, 0x6c, 0x72, 0xa1, 0xa7, 0x6b, 0xdc, 0xe4, 0x0f, 0x7f, 0x85, 0x15, 0x99, 0x96,
+		0x6c, 0xd2, 0x82, 0x35, 0x92, 0x4f, 0x84, 0x4e, 0x3a, 0x5c, 0xc8, 0x54, 0xa2, 0x35, 0x9b, 0x26,
+		0xb6, 0x2c, 0xee, 0xb8, 0x44, 0x92, 0xbc, 0x8b, 0xd3, 0xed, 0x6d, 0xa9, 0xc2, 0x4b, 0x90, 0x97,
+		0x85, 0x06, 0x8c, 0x79, 0x61, 0x1b, 0x7c, 0xd4, 0xc7, 0xba, 0xbd, 0x2d, 0x62, 0x29, 0xf7, 0x42,
+		0x6a, 0x80, 0x30, 0x13, 0x37, 0x5c, 0x39, 0xe8, 0x53, 0xa4, 0xbc, 0x05, 0x6a, 0xd7, 0x6c, 0x19,
+		0x66, 0xcb, 0xde, 0xa3, 0x37, 0x43, 0xc3, 0x8a, 0x2c, 0x0a, 0xd6, 0x78, 0x7e, 0xee, 0x3a, 0x64,
+		0xea, 0x74, 0x2b, 0xc1, 0x95, 0xfc, 0x82, 0x2b, 0x9f, 0x34, 0x5a, 0xbe, 0xa1, 0x92, 0x85, 0xfa,
+		0x24, 0x3b, 0xfd, 0xef, 0x25, 0x98, 0x28, 0x92, 0x81, 0x5b, 0x2d, 0x5f, 0x6e, 0x6b, 0x3b, 0xe8,
+		0x2c, 0x1c, 0x2e, 0x2e, 0xd7, 0x4a, 0xd7, 0xd4, 0x6a, 0x59, 0xbd, 0xbc, 0x5c, 0xf0, 0x5c, 0x66,
+		0x9f, 0x3d, 0xf2, 0xd2, 0xed, 0x05, 0xe4, 0xa1, 0xdd, 0xd0, 0xe9, 0x3c, 0x88, 0xce, 0xc0, 0x8c,
+		0x1f, 0x52, 0x28, 0xd6, 0xc9, 0x77, 0x51, 0xd2, 0xec, 0xe1, 0x97, 0x6e, 0x2f, 0x4c, 0x79, 0x10,
+		0x85, 0x2d, 0x0b, 0xeb, 0x76, 0x3f, 0xa0, 0x54, 0x5b, 0x59, 0x21, 0xdf, 0x12, 0xf4, 0x01, 0xc8,
+		0x7d, 0xe0, 0x96, 0x8d, 0x1e, 0x80, 0x29, 0x3f, 0x60, 0xb5, 0xba, 0x2c, 0x87, 0x67, 0xd1, 0x4b,
+		0xb7, 0x17, 0xd2, 0x1e, 0xea, 0xd5, 0x56, 0x7b, 0x36, 0xf1, 0xe2, 0x2f, 0xce, 0x1d, 0xfa, 0xec,
+		0xdf, 0x9c, 0x93, 0x8a, 0x2b, 0x43, 0xc7, 0xdc, 0xf9, 0x83, 0x8d, 0x39, 0x7f, 0x9c, 0xfb, 0x83,
+		0x13, 0x70, 0x22, 0x58, 0xe8, 0x73, 0xc6, 0xe3, 0x0e, 0xb7, 0x51, 0x71, 0xd1, 0xec, 0xfe, 0xe1,
+		0xc1, 0xec, 0x88, 0x49, 0x61, 0x76, 0xa4, 0x63, 0xc8, 0x5d, 0x82, 0x49, 0xf2, 0xe5, 0x54, 0x1d,
+		0xdb, 0x57, 0xb0, 0xd6, 0xc4, 0xa6, 0x3f, 0x7e, 0x98, 0x14, 0xf1, 0x03, 0x82, 0x08, 0x0d, 0x12,
+		0xd8, 0xfc, 0x49, 0x7f, 0xe7, 0x76, 0x21, 0x42, 0xa0, 0x6e, 0x6c, 0xc1, 0x11, 0x34, 0x41, 0x72,
+		0xb7, 0xf6, 0x6c, 0x7e, 0x3b, 0x3e, 0xa5, 0xb0, 0x04, 0x7a, 0x54, 0x44, 0x08, 0xe1, 0xfd, 0x23,
+		0x04, 0x3e, 0xbc, 0x78, 0x9c, 0xd0, 0x86, 0x38, 0xef, 0x62, 0x47, 0x10, 0xc9, 0x15, 0x04, 0xad,
+		0x40, 0xa6, 0xab, 0x99, 0x36, 0x7d, 0xfb, 0x63, 0x97, 0xb6, 0x82, 0x8f, 0xe0, 0xf9, 0x7e, 0x7f,
+		0xe2, 0x6b, 0x2c, 0xaf, 0x65, 0xb2, 0xeb, 0xcd, 0xcc, 0x7d, 0x3b, 0x02, 0x31, 0xae, 0x8c, 0x27,
+		0x21, 0xce, 0xd5, 0xca, 0xc7, 0xdc, 0x3d, 0x8b, 0xfd, 0xd3, 0xec, 0xa2, 0x33, 0x1d, 0x72, 0x7e,
+		0x02, 0x83, 0xee, 0x83, 0x44, 0x63, 0x57, 0x6b, 0xe9, 0x6a, 0xab, 0xc9, 0x17, 0x93, 0x13, 0xdf,
+		0xb8, 0x33, 0x1f, 0x2f, 0x91, 0xbc, 0x6a, 0x59, 0x89, 0xd3, 0xc2, 0x6a, 0x93, 0xc4, 0x34, 0xbb,
+		0xb8, 0xb5, 0xb3, 0x6b, 0x73, 0xbf, 0xc1, 0x53, 0xe4, 0x75, 0x65, 0x62, 0x10, 0xfc, 0x49, 0xa9,
+		0xd9, 0xbe, 0x5d, 0x02, 0x27, 0x6c, 0x2d, 0x26, 0x48, 0xc5, 0x1f, 0xfc, 0x83, 0x79, 0x49, 0xa1,
+		0x08, 0x54, 0x82, 0xc9, 0xb6, 0x66, 0xd9, 0x2a, 0x9d, 0x8f, 0x49, 0xf5, 0x51, 0xca, 0xe2, 0x58,
+		0xbf, 0x42, 0xb8, 0x62, 0xb9, 0xe8, 0x13, 0x04, 0xc5, 0xb2, 0x9a, 0xe4, 0xc5, 0x1b, 0xca, 0xa4,
+		0x41, 0x47, 0x20, 0x8b, 0x12, 0x63, 0x54, 0xef, 0x69, 0x92, 0xcf, 0x06, 0x26, 0x8d, 0x15, 0x8f,
+		0x43, 0x92, 0x3e, 0x62, 0x43, 0x49, 0xd8, 0x97, 0x7e, 0x09, 0x92, 0x41, 0x0b, 0xef, 0x87, 0x8c,
+		0xeb, 0xf5, 0x19, 0x49, 0x82, 0x71, 0x71, 0xb3, 0x29, 0xe1, 0x23, 0x30, 0xa3, 0xe3, 0x5b, 0xb6,
+		0x1a, 0xa4, 0x4e, 0x52, 0x6a, 0x44, 0xca, 0x36, 0xfd, 0x88, 0xb7, 0x42, 0xba, 0x21, 0x94, 0xcf,
+		0x68, 0x81, 0xd2, 0x4e, 0x3a, 0xb9, 0x94, 0xec, 0x18, 0x24, 0xb4, 0x6e, 0x97, 0x11, 0x4c, 0x70,
+		0xaf, 0xdf, 0xed, 0xd2, 0xa2, 0xd3, 0x30, 0x45, 0xdb, 0x68, 0x62, 0x8b, 0x7c, 0xae, 0xc2, 0x68,
+		0x52, 0x94, 0x26, 0x43, 0x0a, 0x14, 0x96, 0x4f, 0x69, 0x4f, 0xc2, 0x24, 0xbe, 0xd1, 0x6a, 0x62,
+		0xbd, 0x81, 0x19, 0xdd, 0x24, 0xa5, 0x4b, 0x89, 0x4c, 0x4a, 0xf4, 0x00, 0x38, 0xde, 0x5c, 0x15,
+		0x33, 0x4d, 0x9a, 0xf1, 0x13, 0xf9, 0x05, 0x96, 0x9d, 0xcb, 0x42, 0xa4, 0xac, 0xd9, 0x1a, 0x09,
+		0x97, 0xec, 0x5b, 0x6c, 0xfa, 0x4c, 0x29, 0xe4, 0x67, 0xee, 0xcb, 0x61, 0x88, 0x6c, 0x1a, 0x36,
+		0x46, 0xe7, 0x3d, 0xa1, 0x6c, 0x7a, 0x90, 0x3d, 0xd7, 0x5b, 0x3b, 0x3a, 0x6e, 0xae, 0x58, 0x3b,
+		0x9e, 0x17, 0x27, 0x5d, 0x73, 0x0a, 0xf9, 0xcc, 0x69, 0x06, 0xa2, 0xa6, 0xd1, 0xd3, 0x9b, 0xe2,
+		0x1b, 0x05, 0x9a, 0x40, 0x15, 0x48, 0x38, 0x56, 0x12, 0x19, 0x65, 0x25, 0x19, 0x62, 0x25, 0xc4,
+		0x86, 0x79, 0x86, 0x12, 0xdf, 0xe2, 0xc6, 0x52, 0x84, 0xa4, 0xe3, 0xbc, 0xb2, 0xd1, 0x03, 0x18,
+		0xac, 0x0b, 0x23, 0x53, 0xa4, 0xd3, 0xf7, 0x8e, 0xf2, 0x98, 0xc5, 0xc9, 0x4e, 0x01, 0xd7, 0x9e,
+		0xcf, 0xac, 0xf8, 0xeb, 0x97, 0x71, 0xda, 0x2e, 0xd7, 0xac, 0xd8, 0x0b, 0x98, 0x27, 0xc8, 0x3d,
+		0xc8, 0x1d, 0x9d, 0x7e, 0x7f, 0xc3, 0x2d, 0xcf, 0xcd, 0x20, 0xa5, 0xee, 0xf7, 0xa2, 0xcc, 0xd2,
+		0xdc, 0x0c, 0x74, 0x06, 0xa6, 0x9d, 0x84, 0xea, 0x72, 0x61, 0x56, 0x86, 0x9c, 0xa2, 0xba, 0x28,
+		0xc9, 0x7d, 0x45, 0x82, 0x18, 0x9f, 0xb1, 0xdc, 0x6e, 0x90, 0x06, 0x77, 0x43, 0x68, 0x58, 0x37,
+		0x84, 0xef, 0xbe, 0x1b, 0x0a, 0x00, 0x8e, 0x98, 0x16, 0x7f, 0xe3, 0x70, 0x40, 0x58, 0xc5, 0x44,
+		0xac, 0xb7, 0x76, 0xf8, 0xb8, 0xf7, 0x80, 0x72, 0xff, 0x51, 0x82, 0xa4, 0x53, 0x8e, 0x0a, 0x30,
+		0x29, 0xe4, 0x52, 0xb7, 0xdb, 0xda, 0x0e, 0x37, 0xc5, 0x7b, 0x86, 0x0a, 0x47, 0x66, 0x61, 0x65,
+		0x82, 0xcb, 0x43, 0x12, 0x83, 0xbb, 0x35, 0x34, 0xa4, 0x5b, 0x7d, 0x76, 0x14, 0xbe, 0x3b, 0x3b,
+		0xf2, 0xf5, 0x78, 0x24, 0xd0, 0xe3, 0xb9, 0x3f, 0x94, 0xf8, 0xc3, 0xca, 0x4d, 0xdc, 0xfc, 0xdf,
+		0xd9, 0x55, 0xcf, 0x72, 0xdb, 0x22, 0x47, 0x04, 0x7d, 0x7d, 0x76, 0xb2, 0x9f, 0xa3, 0x5f, 0x66,
+		0xb7, 0xef, 0x90, 0xe0, 0x52, 0x77, 0xfb, 0xf0, 0x8b, 0x21, 0x98, 0xea, 0xa3, 0xff, 0x3f, 0xaf,
+		0x2f, 0xfd, 0xa3, 0x37, 0x3a, 0xe6, 0xe8, 0x8d, 0x0d, 0x1d, 0xbd, 0x5f, 0x08, 0xd1, 0x0d, 0x80,
+		0xae, 0x61, 0x69, 0xed, 0x1f, 0x85, 0xef, 0x3d, 0x0e, 0xc9, 0xae, 0xd1, 0x56, 0x59, 0x09, 0xfb,
+		0x4a, 0x2c, 0xd1, 0x35, 0xda, 0x4a, 0x9f, 0x99, 0x45, 0xdf, 0x20, 0xc7, 0x1c, 0x7b, 0x03, 0x3a,
+		0x21, 0x1e, 0x1c, 0x50, 0x26, 0xa4, 0x98, 0x2a, 0x78, 0xd4, 0xf4, 0x08, 0xd1, 0x01, 0xf9, 0x95,
+		0x95, 0xfa, 0xa3, 0x3c, 0x26, 0x36, 0xa3, 0x54, 0x62, 0xbb, 0x0e, 0x82, 0x05, 0x19, 0xd9, 0xd0,
+		0x30, 0x04, 0xb3, 0x62, 0x85, 0xd3, 0xe5, 0xfe, 0x86, 0x04, 0xb0, 0x4c, 0x34, 0x4b, 0xdb, 0x4b,
+		0xe2, 0x1d, 0x8b, 0x8a, 0xa0, 0xfa, 0x6a, 0x9e, 0x1b, 0xd6, 0x69, 0xbc, 0xfe, 0x94, 0xe5, 0x95,
+		0xbb, 0x04, 0x93, 0xae, 0x6d, 0x5b, 0x58, 0x08, 0x33, 0xb7, 0xcf, 0xaa, 0x94, 0x7c, 0xdb, 0x99,
+		0xba, 0xe1, 0x49, 0xe5, 0xfe, 0x99, 0x04, 0x49, 0x2a, 0x13, 0x79, 0xbc, 0xcf, 0xd7, 0x87, 0xd2,
+		0xdd, 0xf7, 0xe1, 0x3d, 0x00, 0x8c, 0x0d, 0xb9, 0x7f, 0xc4, 0x2d, 0x2b, 0x49, 0x73, 0xc8, 0xad,
+		0x22, 0x74, 0xd1, 0x51, 0x78, 0x78, 0x7f, 0x85, 0x8b, 0x55, 0x2b, 0x57, 0xfb, 0x51, 0x88, 0xd3,
+		0xd7, 0x06, 0x6e, 0x59, 0x7c, 0x21, 0x4a, 0xde, 0x88, 0x5d, 0xbf, 0x65, 0xe5, 0x9e, 0x83, 0xf8,
+		0xfa, 0x2d, 0xb6, 0x9f, 0x78, 0x1c, 0x92, 0xa6, 0x61, 0xf0, 0xe8, 0x8f, 0x45, 0xdd, 0x09, 0x92,
+		0x41, 0x83, 0x1d, 0xb1, 0x87, 0x16, 0x72, 0xf7, 0xd0, 0xdc, 0x4d, 0xc0, 0xf0, 0x58, 0x9b, 0x80,
+		0x64, 0xf5, 0x39, 0xe9, 0x1b, 0x49, 0xe8, 0x21, 0x38, 0x5a, 0xaf, 0x2e, 0xad, 0x56, 0xca, 0xea,
+		0x4a, 0x7d, 0x29, 0xf0, 0xc9, 0xf8, 0x6c, 0xe6, 0xa5, 0xdb, 0x0b, 0x13, 0x7c, 0xd9, 0x39, 0x8c,
+		0x7a, 0x4d, 0xa9, 0x6c, 0xd6, 0xd6, 0x2b, 0xb2, 0xc4, 0xa8, 0xd7, 0x4c, 0x7c, 0xc3, 0xb0, 0xd9,
+		0x5f, 0xdd, 0x78, 0x04, 0x8e, 0x0d, 0xa0, 0x76, 0x16, 0x9f, 0x53, 0x2f, 0xdd, 0x5e, 0x98, 0x5c,
+		0x33, 0x31, 0xb3, 0x32, 0x8a, 0x58, 0x84, 0x6c, 0x3f, 0xa2, 0xb6, 0x56, 0xab, 0x17, 0x96, 0xe5,
+		0x85, 0x59, 0xf9, 0xa5, 0xdb, 0x0b, 0x29, 0xe1, 0x32, 0x08, 0xfd, 0x0f, 0x7f, 0xf5, 0xf9, 0xc9,
+		0x22, 0x2c, 0xb4, 0xf4, 0x96, 0xdd, 0xd2, 0xce, 0x74, 0x2c, 0x5b, 0xbb, 0xde, 0xd2, 0x77, 0xce,
+		0xdc, 0x38, 0x7b, 0x86, 0xff, 0x14, 0xdb, 0x81, 0x8c, 0x62, 0x51, 0x50, 0x2c, 0xde, 0x38, 0x3b,
+		0x3b, 0xe2, 0x84, 0x6f, 0xbf, 0x63, 0xbb, 0x21, 0xe7, 0x7c, 0x43, 0x0f, 0x7b, 0x66, 0x47, 0x9c,
+		0x83, 0x8c, 0x5e, 0xf9, 0xee, 0xbb, 0xc0, 0xce, 0x7d, 0x20, 0x04, 0x19, 0xea, 0x11, 0x2c, 0xf6,
+		0x76, 0xbe, 0x4d, 0x9f, 0x2a, 0x8d, 0x98, 0x9a, 0xcd, 0xb7, 0x7d, 0x8b, 0x27, 0xc7, 0x38, 0x11,
+		0x53, 0x28, 0x00, 0xbd, 0x03, 0x12, 0x1d, 0xed, 0x96, 0x4a, 0xc1, 0x6c, 0x99, 0x76, 0x71, 0x0c,
+		0xf0, 0x6b, 0x77, 0xe6, 0x33, 0x7b, 0x5a, 0xa7, 0x9d, 0xcf, 0x09, 0x70, 0x4e, 0x89, 0x77, 0xb4,
+		0x5b, 0x44, 0x18, 0x84, 0xe9, 0x27, 0xeb, 0x6a, 0x63, 0x57, 0xd3, 0x77, 0x30, 0xe3, 0x4c, 0xb7,
+		0xab, 0x8b, 0x4f, 0x8e, 0xc7, 0xf9, 0x88, 0xcb, 0xd9, 0xc3, 0x23, 0xa7, 0x4c, 0x76, 0xb4, 0x5b,
+		0x25, 0x9a, 0x41, 0xaa, 0xc9, 0x27, 0x5e, 0xfe, 0xc4, 0xfc, 0x21, 0x7a, 0xc8, 0xf8, 0x2f, 0x25,
+		0x00, 0x57, 0x21, 0xe8, 0x69, 0x90, 0x1b, 0x4e, 0x8a, 0x62, 0xc5, 0xa3, 0x5f, 0x27, 0x17, 0xfb,
+		0x2d, 0x63, 0x31, 0xa0, 0x4a, 0x36, 0x09, 0x7c, 0xfd, 0xce, 0xbc, 0xa4, 0x64, 0x1a, 0x01, 0x2d,
+		0xff, 0x04, 0x4c, 0xf4, 0xba, 0x4d, 0xcd, 0xc6, 0x2a, 0x5d, 0x9a, 0x86, 0x46, 0x4e, 0x28, 0x73,
+		0x84, 0xd7, 0x6b, 0x77, 0xe6, 0x11, 0x6b, 0x92, 0x07, 0x9c, 0xa3, 0xd3, 0x0c, 0xb0, 0x1c, 0x02,
+		0xf0, 0xb4, 0xe7, 0xb7, 0xe8, 0x5f, 0x35, 0x70, 0xaf, 0x1d, 0x65, 0x21, 0xde, 0x31, 0xf4, 0xd6,
+		0x75, 0xee, 0xda, 0x93, 0x8a, 0x48, 0x92, 0xbd, 0x6a, 0xf6, 0xf4, 0x8d, 0xbd, 0x27, 0xf6, 0xaa,
+		0x45, 0x9a, 0xa0, 0x6e, 0xe2, 0x2d, 0xab, 0x25, 0xd4, 0xaf, 0x88, 0x24, 0xba, 0x4c, 0x1e, 0x51,
+		0x6e, 0xf4, 0xc8, 0x66, 0x1b, 0x79, 0x03, 0xcc, 0x26, 0x4f, 0x55, 0xd1, 0xef, 0x37, 0x8b, 0xc7,
+		0x5f, 0xbb, 0x33, 0x7f, 0x94, 0xc9, 0x1a, 0xa4, 0xc8, 0x29, 0x19, 0x91, 0x55, 0x62, 0x39, 0xa4,
+		0x86, 0x26, 0xb6, 0xb5, 0x56, 0xdb, 0xca, 0xb2, 0x33, 0x7a, 0x91, 0xf4, 0xb4, 0xe5, 0x5f, 0x27,
+		0xbd, 0x3b, 0x90, 0x97, 0x41, 0x36, 0xba, 0xd8, 0xf4, 0x05, 0x50, 0x52, 0xb0, 0xe6, 0x20, 0x45,
+		0x4e, 0xc9, 0x88, 0x2c, 0x11, 0x5c, 0xd9, 0x20, 0x3b, 0xab, 0x5c, 0xb5, 0xdb, 0xdb, 0x72, 0x37,
+		0x2e, 0x67, 0xfa, 0x7a, 0xa3, 0xa0, 0xef, 0x15, 0xcf, 0xbb, 0xdc, 0x83, 0xb8, 0xdc, 0xd7, 0xbe,
+		0xf8, 0xf0, 0x0c, 0x1f, 0xef, 0xee, 0x46, 0x22, 0xd9, 0x45, 0xcc, 0x38, 0xa4, 0x6b, 0x94, 0x92,
+		0xc4, 0x37, 0xcf, 0x69, 0xad, 0xb6, 0x78, 0x72, 0x4d, 0xe1, 0x29, 0x32, 0x05, 0x59, 0xb6, 0x66,
+		0xf7, 0x2c, 0xfe, 0xba, 0xc3, 0xdc, 0x20, 0x33, 0x2b, 0x1a, 0x7a, 0xb3, 0x4e, 0xa9, 0x14, 0x4e,
+		0x8d, 0x6c, 0x88, 0xd9, 0xc6, 0x75, 0xac, 0x5b, 0xfc, 0x4f, 0xc6, 0x1c, 0x5b, 0x1c, 0x70, 0xcd,
+		0x60, 0x91, 0x1c, 0xd7, 0x17, 0x0b, 0xdc, 0x90, 0x26, 0x59, 0x23, 0x18, 0x2c, 0xf7, 0xb9, 0x3f,
+		0x98, 0x3f, 0x35, 0xfa, 0x88, 0x9a, 0x72, 0xb0, 0x14, 0x5e, 0x17, 0xfa, 0xb0, 0x04, 0x72, 0x13,
+		0xb7, 0xf1, 0x0e, 0x9b, 0xea, 0x77, 0x35, 0x12, 0x78, 0xb3, 0x3f, 0xc2, 0x73, 0x62, 0xa0, 0x00,
+		0xfc, 0xca, 0x40, 0x71, 0x95, 0xcb, 0xc0, 0x15, 0x19, 0xe4, 0x41, 0xa4, 0x19, 0xe3, 0xfe, 0x85,
+		0x60, 0x67, 0x29, 0x19, 0x87, 0x43, 0x9d, 0x32, 0x40, 0x4b, 0xfe, 0x0b, 0x78, 0xe2, 0xef, 0xd5,
+		0x0c, 0x50, 0xa6, 0x67, 0x74, 0x88, 0xcd, 0x1b, 0x0f, 0x92, 0x98, 0x59, 0x4f, 0xdf, 0x32, 0x74,
+		0xfa, 0xf6, 0x02, 0x0f, 0x49, 0xc9, 0xe2, 0x37, 0xec, 0x35, 0xb3, 0x20, 0x45, 0x4e, 0xc9, 0x38,
+		0x59, 0x57, 0x68, 0x0e, 0x6a, 0x42, 0xda, 0xa5, 0xa2, 0x43, 0x3e, 0x39, 0x72, 0xc8, 0xdf, 0xcb,
+		0xb5, 0x74, 0x38, 0x58, 0x8b, 0x3b, 0xea, 0x27, 0x9d, 0x4c, 0x02, 0x23, 0x7f, 0xe8, 0xc7, 0x75,
+		0x34, 0x59, 0xe0, 0x71, 0xd7, 0xbe, 0x9e, 0x4a, 0xac, 0x5c, 0x5d, 0x1c, 0x7a, 0x51, 0x82, 0x49,
+		0xef, 0x66, 0xb7, 0x95, 0x9d, 0x18, 0x65, 0x54, 0x57, 0xb8, 0xa8, 0x33, 0x4c, 0x54, 0x1f, 0xfa,
+		0x60, 0xb6, 0x95, 0xf2, 0x6c, 0xa9, 0x93, 0xb7, 0x4a, 0xfd, 0xdb, 0xee, 0xf4, 0xaf, 0x52, 0x15,
+		0x1f, 0xdd, 0xf7, 0xc6, 0xc7, 0x6b, 0x77, 0xe6, 0xa7, 0xfb, 0xc5, 0xc8, 0xf9, 0x8f, 0x11, 0xde,
+		0x06, 0xc7, 0x5d, 0x7d, 0x1a, 0xba, 0xba, 0x6b, 0xb4, 0x9b, 0xaa, 0x89, 0xb7, 0xd5, 0x06, 0xbd,
+		0xb3, 0x32, 0x49, 0xe3, 0xb8, 0xa3, 0x0e, 0x49, 0x4d, 0xbf, 0x62, 0xb4, 0x9b, 0x0a, 0xde, 0x2e,
+		0x91, 0x62, 0x72, 0x1a, 0xe0, 0xa2, 0x5b, 0x4d, 0xba, 0x33, 0x15, 0x51, 0x26, 0x9c, 0xbc, 0x6a,
+		0x93, 0x78, 0x34, 0x13, 0xdb, 0x2d, 0x13, 0x37, 0xd9, 0x83, 0xb4, 0x8a, 0x48, 0xe6, 0x53, 0x2f,
+		0x7e, 0x62, 0xfe, 0x10, 0xf7, 0x6a, 0x87, 0x72, 0x17, 0xe9, 0x59, 0x10, 0xf7, 0x46, 0xd8, 0x22,
+		0x6b, 0x04, 0x4d, 0x24, 0xe8, 0x5e, 0x56, 0x52, 0x71, 0x33, 0x98, 0x37, 0x7c, 0xe1, 0x3f, 0x2c,
+		0x48, 0xe4, 0x61, 0xca, 0x58, 0x79, 0x73, 0x4d, 0x6b, 0x99, 0xa8, 0x0a, 0x53, 0x8e, 0xfd, 0x07,
+		0x7c, 0xe1, 0x89, 0xd7, 0xee, 0xcc, 0x67, 0x83, 0x83, 0xcc, 0x71, 0x86, 0xee, 0xe0, 0x15, 0xde,
+		0xb0, 0x3a, 0x6c, 0x5d, 0xea, 0x63, 0xd5, 0x47, 0x92, 0xeb, 0x5f, 0xb5, 0x06, 0x9a, 0x59, 0x80,
+		0x38, 0x93, 0xd6, 0x42, 0x17, 0x21, 0xda, 0x25, 0x3f, 0x9c, 0x33, 0xe1, 0x41, 0xa3, 0x92, 0xd2,
+		0x3a, 0xdb, 0xd7, 0x84, 0x3c, 0xf7, 0x33, 0x21, 0x80, 0xf2, 0xe6, 0xe6, 0xba, 0xd9, 0xea, 0xb6,
+		0xb1, 0xfd, 0x46, 0xb6, 0x7a, 0x1d, 0x0e, 0xbb, 0x4d, 0xb2, 0xcc, 0x46, 0xa0, 0xe5, 0x0b, 0xaf,
+		0xdd, 0x99, 0x3f, 0x11, 0x6c, 0xb9, 0x87, 0x2c, 0xa7, 0x4c, 0xbb, 0x6b, 0x17, 0xb3, 0x31, 0x90,
+		0x6b, 0xd3, 0xb2, 0x1d, 0xae, 0xe1, 0xe1, 0x5c, 0x3d, 0x64, 0x5e, 0xae, 0x65, 0xcb, 0x1e, 0xac,
+		0xd6, 0x1a, 0x4c, 0xb8, 0x2a, 0xb1, 0xd0, 0x8f, 0x43, 0xc2, 0xe6, 0xbf, 0xb9, 0x76, 0xe7, 0x06,
+		0x6b, 0x57, 0x40, 0xb8, 0x86, 0x1d, 0x54, 0xee, 0xf3, 0x44, 0xc9, 0x4c, 0x3f, 0xc4, 0x15, 0xbc,
+		0x29, 0x4d, 0x0b, 0x3d, 0x0f, 0x31, 0x3e, 0xd9, 0x84, 0xc7, 0x98, 0x6c, 0xca, 0xfe, 0x09, 0xef,
+		0x2e, 0xa7, 0x18, 0x5e, 0x63, 0x40, 0xff, 0xef, 0x0d, 0x91, 0x37, 0x0a, 0xf9, 0xa8, 0x7f, 0xd3,
+		0xeb, 0x6d, 0x19, 0xe2, 0x58, 0xb7, 0xcd, 0x96, 0xa3, 0xb8, 0x87, 0x06, 0x59, 0xc7, 0x80, 0xf6,
+		0xd0, 0xf7, 0xd4, 0xc5, 0xb1, 0x0c, 0x67, 0x11, 0xd0, 0xc4, 0xaf, 0x47, 0x20, 0x3b, 0x0c, 0x89,
+		0x4a, 0x90, 0x69, 0x98, 0x98, 0x66, 0xa8, 0xde, 0x1d, 0xc2, 0xe2, 0xac, 0x1b, 0xa4, 0x07, 0x08,
+		0x72, 0x4a, 0x5a, 0xe4, 0xf0, 0x29, 0x74, 0x07, 0x48, 0x14, 0x4d, 0xcc, 0x94, 0x50, 0x8d, 0x19,
+		0x36, 0xe7, 0x78, 0xe7, 0x8b, 0x4a, 0xfc, 0x0c, 0xd8, 0x24, 0x9a, 0x76, 0x73, 0xe9, 0x2c, 0xfa,
+		0x01, 0x09, 0x32, 0x4c, 0x2f, 0x6d, 0x75, 0x4b, 0x6b, 0x6b, 0xe4, 0x35, 0xc3, 0xf0, 0xa8, 0x19,
+		0xf0, 0xaa, 0xbf, 0xa2, 0x00, 0xfe, 0x60, 0x73, 0x60, 0x9a, 0xa3, 0x8b, 0x0c, 0x8c, 0x6e, 0x42,
+		0x5c, 0xc8, 0x11, 0x19, 0x25, 0x47, 0x91, 0xcb, 0x91, 0x66, 0x72, 0xdc, 0x55, 0xfd, 0xa2, 0xb6,
+		0xbe, 0x79, 0x2e, 0xda, 0x3f, 0xcf, 0x8d, 0x98, 0x48, 0x63, 0xfb, 0x4e, 0xa4, 0x9e, 0xe8, 0xfe,
+		0x37, 0x22, 0x30, 0xa5, 0xe0, 0xe6, 0xff, 0x33, 0x9c, 0x37, 0xd2, 0x70, 0x7e, 0x52, 0x02, 0x60,
+		0x7e, 0x8b, 0xcc, 0x2c, 0xd9, 0xc8, 0x18, 0xde, 0x52, 0x44, 0x72, 0x53, 0x5e, 0x6f, 0x49, 0xd0,
+		0x07, 0xf6, 0x98, 0x49, 0x86, 0x2d, 0x5b, 0xf6, 0x8f, 0xd2, 0x8e, 0x7e, 0x3f, 0x04, 0x29, 0xaf,
+		0x1d, 0xfd, 0x5f, 0x1a, 0x27, 0xa0, 0x8a, 0xeb, 0xeb, 0x23, 0xfc, 0x0f, 0x7e, 0x0e, 0xf0, 0xf5,
+		0x7d, 0x23, 0x6e, 0x7f, 0x27, 0xff, 0x57, 0x12, 0x10, 0x5b, 0xd3, 0x4c, 0xad, 0x63, 0xa1, 0x46,
+		0xdf, 0x82, 0x46, 0x6c, 0xcc, 0xf6, 0xfd, 0x49, 0x4f, 0xbe, 0x65, 0x35, 0x62, 0x3d, 0xf3, 0xf2,
+		0x80, 0xf5, 0xcc, 0x8f, 0x03, 0x79, 0x81, 0xd0, 0x73, 0x92, 0x4d, 0x35, 0x3d, 0x59, 0x3c, 0xe6,
+		0x72, 0xf1, 0x97, 0xb3, 0xad, 0x9d, 0x4d, 0xef, 0xed, 0xa8, 0x09, 0x42, 0xe1, 0x4e, 0x7b, 0x04,
+		0x7e, 0xc4, 0xdd, 0x47, 0xf1, 0x14, 0xe6, 0x14, 0xe8, 0x68, 0xb7, 0x2a, 0x2c, 0x81, 0x96, 0x01,
+		0xed, 0xb6, 0x2c, 0xdb, 0x30, 0x5b, 0x0d, 0xad, 0xad, 0xba, 0xaa, 0x24, 0xf8, 0x7b, 0x5e, 0xbb,
+		0x33, 0x7f, 0x8c, 0xe1, 0xfb, 0x69, 0x72, 0xca, 0x94, 0x9b, 0x29, 0xb8, 0x3d, 0x06, 0x13, 0xa4,
+		0x5d, 0x2a, 0xbd, 0x1b, 0xcf, 0x16, 0xe9, 0x49, 0xaf, 0x18, 0x9e, 0xc2, 0x9c, 0x02, 0x24, 0x55,
+		0xa6, 0x09, 0x54, 0x01, 0xb9, 0xd3, 0xd2, 0xfd, 0x57, 0xb4, 0xc8, 0x58, 0x88, 0x78, 0xd7, 0x9f,
+		0x41, 0x8a, 0x1c, 0x7d, 0xfb, 0xd1, 0x73, 0x7b, 0x0b, 0xbd, 0x0b, 0xa6, 0x09, 0x51, 0x60, 0x33,
+		0x8b, 0xfd, 0x29, 0xc4, 0x62, 0x61, 0xbc, 0xcd, 0xb4, 0x59, 0xb7, 0xb2, 0x00, 0x9f, 0x9c, 0x32,
+		0xd5, 0x69, 0xe9, 0xfe, 0xdd, 0x2f, 0xf4, 0x41, 0x09, 0xe4, 0xeb, 0x78, 0x4f, 0x35, 0xf9, 0x93,
+		0x7b, 0xea, 0x36, 0xc6, 0xd9, 0xc4, 0x28, 0x6f, 0x78, 0xcd, 0xbf, 0x33, 0x10, 0x64, 0x70, 0x40,
+		0x77, 0x78, 0x1d, 0xef, 0x29, 0x1c, 0x7d, 0x19, 0x63, 0xf4, 0x29, 0x09, 0xde, 0xe2, 0xd5, 0x93,
+		0x7a, 0x93, 0x4e, 0x10, 0x62, 0x6f, 0xd0, 0xde, 0x35, 0xb1, 0x45, 0xfc, 0x0e, 0x5d, 0x9b, 0x27,
+		0x8b, 0xca, 0x78, 0x7a, 0x79, 0xb0, 0x7f, 0xb1, 0x39, 0x8c, 0x71, 0x4e, 0x59, 0xf0, 0x2c, 0x42,
+		0x9f, 0xa2, 0x44, 0x6c, 0x1f, 0x72, 0x5d, 0x90, 0xa0, 0x0f, 0x49, 0x30, 0xef, 0xd1, 0x2f, 0x67,
+		0xa1, 0x1b, 0x76, 0xab, 0x81, 0xd5, 0x2e, 0x36, 0x5b, 0x46, 0x33, 0x0b, 0xa3, 0x86, 0xda, 0x39,
+		0xae, 0xc6, 0xfb, 0x9c, 0xd9, 0x6b, 0x3f, 0x7e, 0x6c, 0xec, 0x9d, 0x70, 0xa9, 0x98, 0x4c, 0xab,
+		0x94, 0x66, 0x8d, 0x92, 0x78, 0x3c, 0xec, 0x9f, 0x49, 0x70, 0xe2, 0x8a, 0x63, 0xe1, 0x9b, 0xc1,
+		0xf6, 0x58, 0x43, 0x8f, 0x81, 0xc5, 0x3d, 0x9c, 0xd0, 0x81, 0xef, 0xe1, 0xbc, 0x47, 0x82, 0x99,
+		0x01, 0xfa, 0x1d, 0x2f, 0xfe, 0x3f, 0x4f, 0x98, 0x1d, 0x74, 0xf2, 0x42, 0x7d, 0xfd, 0x64, 0xe5,
+		0xbe, 0x12, 0x82, 0xa3, 0x6b, 0x58, 0x17, 0xcf, 0x78, 0x7a, 0x55, 0x85, 0x56, 0x07, 0x45, 0xe9,
+		0x6c, 0x96, 0xb9, 0xf7, 0x77, 0xbf, 0xf8, 0xf0, 0x3d, 0x5c, 0xc0, 0xcd, 0x40, 0x48, 0xce, 0xde,
+		0x3b, 0x19, 0x10, 0xaa, 0x8b, 0x5d, 0xf8, 0xd0, 0x41, 0x77, 0xe1, 0xab, 0x30, 0x69, 0x91, 0xf7,
+		0x3f, 0x7b, 0x6d, 0xbe, 0xb5, 0x7c, 0x90, 0x03, 0xe3, 0x94, 0x80, 0x92, 0x42, 0x74, 0x0d, 0xd2,
+		0x78, 0x7b, 0x1b, 0x37, 0xe8, 0x6b, 0xe6, 0x07, 0xbe, 0x41, 0x35, 0xe9, 0x60, 0x49, 0x29, 0xff,
+		0x88, 0xe7, 0xdf, 0x84, 0xe1, 0x18, 0xb9, 0xe7, 0xc5, 0xf7, 0x45, 0xf9, 0xd8, 0x64, 0xd6, 0xb4,
+		0x87, 0x96, 0x87, 0xee, 0xe9, 0x8e, 0xa1, 0xc3, 0xbe, 0x9d, 0xdd, 0x4d, 0xc8, 0x18, 0xec, 0x4f,
+		0x53, 0x8c, 0xb5, 0xb1, 0x9b, 0x1d, 0xba, 0x7b, 0x3b, 0x69, 0xb4, 0x9b, 0x5c, 0x68, 0xb2, 0x77,
+		0xbb, 0x09, 0x19, 0x1d, 0xdf, 0xf4, 0xf1, 0x0d, 0xdf, 0x1d, 0x5f, 0x1d, 0xdf, 0xf4, 0xf0, 0x75,
+		0x87, 0x4d, 0x64, 0xe0, 0xb0, 0x89, 0x1e, 0x78, 0xd8, 0xbc, 0x13, 0xc2, 0xc4, 0xe9, 0xc6, 0x46,
+		0x39, 0xdd, 0x47, 0xf8, 0x08, 0x19, 0xdf, 0xb3, 0x12, 0xbe, 0xf9, 0xc4, 0x8b, 0x22, 0x2e, 0xf8,
+		0x6d, 0x09, 0x90, 0xbb, 0xe6, 0x53, 0xb0, 0xd5, 0x35, 0x74, 0x8b, 0x6e, 0x47, 0xba, 0xe1, 0x85,
+		0x73, 0x96, 0x3c, 0x70, 0x13, 0x56, 0x50, 0x89, 0xed, 0x48, 0x17, 0x87, 0xb0, 0xbb, 0xfa, 0x09,
+		0xbd, 0xf1, 0x2d, 0x11, 0xbc, 0x1d, 0x07, 0x77, 0x28, 0xf7, 0x2d, 0x09, 0x8e, 0xf5, 0x05, 0x46,
+		0x4e, 0xa3, 0x9e, 0x05, 0x64, 0x7a, 0x0a, 0xf9, 0xdf, 0x1e, 0x63, 0x8d, 0x3b, 0x50, 0x8c, 0x35,
+		0x65, 0x06, 0x0b, 0x7e, 0x54, 0x4d, 0x65, 0x63, 0xf1, 0xcb, 0x12, 0xcc, 0x78, 0x65, 0x73, 0x5a,
+		0x78, 0x15, 0x52, 0x5e, 0xd1, 0x78, 0xdb, 0x16, 0x46, 0xb5, 0x8d, 0x37, 0xcb, 0x87, 0x45, 0x2b,
+		0x6e, 0x18, 0x2a, 0xfe, 0x5e, 0xc3, 0x38, 0x2a, 0x12, 0xb2, 0x04, 0xc3, 0xd1, 0x08, 0xed, 0xa0,
+		0x3f, 0x0f, 0x43, 0x64, 0xcd, 0x30, 0xda, 0xe8, 0xb3, 0x12, 0x4c, 0xe9, 0x86, 0xad, 0x92, 0x80,
+		0x09, 0x37, 0x55, 0x7e, 0x04, 0x22, 0x8d, 0x52, 0x9d, 0x46, 0x98, 0x7e, 0xf7, 0xce, 0x7c, 0x3f,
+		0xd6, 0x5d, 0x10, 0xf4, 0x15, 0x1d, 0x2c, 0xf4, 0xc8, 0xe8, 0x86, 0x5d, 0xa4, 0xf0, 0x75, 0x8a,
+		0x46, 0x1f, 0x91, 0x60, 0xd2, 0x2f, 0xe6, 0xc8, 0x1e, 0x7e, 0x86, 0x8b, 0x39, 0x19, 0x14, 0x71,
+		0xc6, 0x0d, 0x1a, 0xef, 0x52, 0xbc, 0xd4, 0x96, 0x57, 0xb6, 0x4f, 0xfd, 0x45, 0xa6, 0x57, 0x85,
+		0x87, 0x1a, 0xc7, 0x87, 0x86, 0x41, 0x56, 0xee, 0x0d, 0x98, 0x7d, 0xd9, 0x97, 0x20, 0xdf, 0xff,
+		0xc4, 0xbc, 0x74, 0xfa, 0x4b, 0x12, 0x80, 0x7b, 0x06, 0x46, 0x6e, 0x2e, 0x14, 0x6b, 0xab, 0x65,
+		0xb5, 0xbe, 0x5e, 0x58, 0xdf, 0xa8, 0xfb, 0xdf, 0x40, 0x10, 0xf7, 0x1c, 0xac, 0x2e, 0x6e, 0xd0,
+		0x3f, 0x02, 0x87, 0xee, 0x83, 0x19, 0x3f, 0x35, 0x49, 0x91, 0xc7, 0xe4, 0x67, 0x53, 0x2f, 0xdd,
+		0x5e, 0x48, 0xb0, 0x2d, 0x2c, 0x4c, 0x6e, 0xed, 0x1e, 0xee, 0xa7, 0x23, 0xef, 0x27, 0x84, 0x66,
+		0x27, 0x5f, 0xba, 0xbd, 0x90, 0x74, 0xf6, 0xba, 0x50, 0x0e, 0x90, 0x97, 0x92, 0xf3, 0x0b, 0xcf,
+		0xc2, 0x4b, 0xb7, 0x17, 0x62, 0xcc, 0x06, 0x66, 0x23, 0xe4, 0x36, 0xc3, 0xe9, 0x77, 0x02, 0x54,
+		0xf5, 0x6d, 0x53, 0xa3, 0x0f, 0x1b, 0x93, 0xbf, 0x12, 0x50, 0x5d, 0xbd, 0xac, 0x14, 0x4a, 0xfc,
+		0xf1, 0x68, 0x8f, 0xd8, 0x81, 0x32, 0xf6, 0xc7, 0xbe, 0x55, 0x72, 0x7f, 0x82, 0x3d, 0x03, 0xed,
+		0x2b, 0x7b, 0x8a, 0xfd, 0x9d, 0xaf, 0x50, 0xf1, 0xca, 0xd0, 0x8b, 0x12, 0x8b, 0x1e, 0xe5, 0xb3,
+		0xe1, 0xf7, 0x70, 0x5b, 0xdb, 0xb2, 0xf8, 0xef, 0x33, 0xb7, 0xdc, 0x1b, 0x10, 0xbe, 0x3b, 0x12,
+		0xff, 0x6b, 0x00, 0xa4, 0x0c, 0xb5, 0x99, 0xe3, 0x86, 0x00, 0x00,
 	}
 	r := bytes.NewReader(gzipped)
 	gzipr, err := compress_gzip.NewReader(r)
@@ -2147,6 +2462,53 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.MinCommissionRate.Equal(that1.MinCommissionRate) {
 		return false
 	}
+	if len(this.KeyRotationFee) != len(that1.KeyRotationFee) {
+		return false
+	}
+	for i := range this.KeyRotationFee {
+		if !this.KeyRotationFee[i].Equal(&that1.KeyRotationFee[i]) {
+			return false
+		}
+	}
+	if !this.VotingPowerWeightChangeThreshold.Equal(that1.VotingPowerWeightChangeThreshold) {
+		return false
+	}
+	if this.CommissionChangeNoticePeriod != that1.CommissionChangeNoticePeriod {
+		return false
+	}
+	return true
+}
+func (this *PendingCommissionChange) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*PendingCommissionChange)
+	if !ok {
+		that2, ok := that.(PendingCommissionChange)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ValidatorAddress != that1.ValidatorAddress {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	if !this.ScheduleTime.Equal(that1.ScheduleTime) {
+		return false
+	}
+	if !this.EffectiveTime.Equal(that1.EffectiveTime) {
+		return false
+	}
 	return true
 }
 func (this *RedelegationEntryResponse) Equal(that interface{}) bool {
@@ -2398,6 +2760,16 @@ func (m *Validator) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Retired {
+		i--
+		if m.Retired {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x78
+	}
 	if m.UnbondingId != 0 {
 		i = encodeVarintStaking(dAtA, i, uint64(m.UnbondingId))
 		i--
@@ -3045,6 +3417,38 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.CommissionChangeNoticePeriod, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod):])
+	if err9 != nil {
+		return 0, err9
+	}
+	i -= n9
+	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.VotingPowerWeightChangeThreshold.Size()
+		i -= size
+		if _, err := m.VotingPowerWeightChangeThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if len(m.KeyRotationFee) > 0 {
+		for iNdEx := len(m.KeyRotationFee) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.KeyRotationFee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
 	{
 		size := m.MinCommissionRate.Size()
 		i -= size
@@ -3084,18 +3488,18 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x10
 	}
-	n9, err9 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
-	if err9 != nil {
-		return 0, err9
+	n10, err10 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.UnbondingTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.UnbondingTime):])
+	if err10 != nil {
+		return 0, err10
 	}
-	i -= n9
-	i = encodeVarintStaking(dAtA, i, uint64(n9))
+	i -= n10
+	i = encodeVarintStaking(dAtA, i, uint64(n10))
 	i--
 	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *HistoricalVotingPowerWeights) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3105,20 +3509,20 @@ func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *HistoricalVotingPowerWeights) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *HistoricalVotingPowerWeights) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.VotingPowerWeights) > 0 {
+		for iNdEx := len(m.VotingPowerWeights) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.VotingPowerWeights[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3126,23 +3530,26 @@ func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x1a
 		}
 	}
-	{
-		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	n11, err11 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err11 != nil {
+		return 0, err11
 	}
+	i -= n11
+	i = encodeVarintStaking(dAtA, i, uint64(n11))
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+func (m *PendingCommissionChange) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3152,44 +3559,53 @@ func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *PendingCommissionChange) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PendingCommissionChange) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Balance) > 0 {
-		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintStaking(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x12
-		}
+	n12, err12 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.EffectiveTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime):])
+	if err12 != nil {
+		return 0, err12
+	}
+	i -= n12
+	i = encodeVarintStaking(dAtA, i, uint64(n12))
+	i--
+	dAtA[i] = 0x22
+	n13, err13 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ScheduleTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduleTime):])
+	if err13 != nil {
+		return 0, err13
 	}
+	i -= n13
+	i = encodeVarintStaking(dAtA, i, uint64(n13))
+	i--
+	dAtA[i] = 0x1a
 	{
-		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
-		i -= size
 		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0xa
+	dAtA[i] = 0x12
+	if len(m.ValidatorAddress) > 0 {
+		i -= len(m.ValidatorAddress)
+		copy(dAtA[i:], m.ValidatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.ValidatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+func (m *ConsPubKeyRotationHistory) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3199,20 +3615,20 @@ func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *ConsPubKeyRotationHistory) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ConsPubKeyRotationHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Entries) > 0 {
-		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+	if len(m.Fee) > 0 {
+		for iNdEx := len(m.Fee) - 1; iNdEx >= 0; iNdEx-- {
 			{
-				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				size, err := m.Fee[iNdEx].MarshalToSizedBuffer(dAtA[:i])
 				if err != nil {
 					return 0, err
 				}
@@ -3220,16 +3636,191 @@ func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 				i = encodeVarintStaking(dAtA, i, uint64(size))
 			}
 			i--
-			dAtA[i] = 0x12
+			dAtA[i] = 0x32
 		}
 	}
-	{
-		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintStaking(dAtA, i, uint64(size))
+	n14, err14 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err14 != nil {
+		return 0, err14
+	}
+	i -= n14
+	i = encodeVarintStaking(dAtA, i, uint64(n14))
+	i--
+	dAtA[i] = 0x2a
+	if m.Height != 0 {
+		i = encodeVarintStaking(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.NewConsPubkey != nil {
+		{
+			size, err := m.NewConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OldConsPubkey != nil {
+		{
+			size, err := m.OldConsPubkey.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintStaking(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.OperatorAddress) > 0 {
+		i -= len(m.OperatorAddress)
+		copy(dAtA[i:], m.OperatorAddress)
+		i = encodeVarintStaking(dAtA, i, uint64(len(m.OperatorAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Delegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationEntryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationEntryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationEntryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Balance) > 0 {
+		for iNdEx := len(m.Balance) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Balance[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.RedelegationEntry.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *RedelegationResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RedelegationResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RedelegationResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Entries) > 0 {
+		for iNdEx := len(m.Entries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Entries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintStaking(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Redelegation.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintStaking(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0xa
@@ -3424,6 +4015,9 @@ func (m *Validator) Size() (n int) {
 	if m.UnbondingId != 0 {
 		n += 1 + sovStaking(uint64(m.UnbondingId))
 	}
+	if m.Retired {
+		n += 2
+	}
 	return n
 }
 
@@ -3675,6 +4269,87 @@ func (m *Params) Size() (n int) {
 	}
 	l = m.MinCommissionRate.Size()
 	n += 1 + l + sovStaking(uint64(l))
+	if len(m.KeyRotationFee) > 0 {
+		for _, e := range m.KeyRotationFee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	l = m.VotingPowerWeightChangeThreshold.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.CommissionChangeNoticePeriod)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *HistoricalVotingPowerWeights) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.VotingPowerWeights) > 0 {
+		for _, e := range m.VotingPowerWeights {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PendingCommissionChange) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ValidatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ScheduleTime)
+	n += 1 + l + sovStaking(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.EffectiveTime)
+	n += 1 + l + sovStaking(uint64(l))
+	return n
+}
+
+func (m *ConsPubKeyRotationHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.OldConsPubkey != nil {
+		l = m.OldConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.NewConsPubkey != nil {
+		l = m.NewConsPubkey.Size()
+		n += 1 + l + sovStaking(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovStaking(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovStaking(uint64(l))
+	if len(m.Fee) > 0 {
+		for _, e := range m.Fee {
+			l = e.Size()
+			n += 1 + l + sovStaking(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4686,6 +5361,26 @@ func (m *Validator) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retired", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Retired = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
@@ -6313,6 +7008,665 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyRotationFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyRotationFee = append(m.KeyRotationFee, types1.Coin{})
+			if err := m.KeyRotationFee[len(m.KeyRotationFee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeightChangeThreshold", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.VotingPowerWeightChangeThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CommissionChangeNoticePeriod", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.CommissionChangeNoticePeriod, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HistoricalVotingPowerWeights) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HistoricalVotingPowerWeights: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HistoricalVotingPowerWeights: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VotingPowerWeights", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VotingPowerWeights = append(m.VotingPowerWeights, types1.DecCoin{})
+			if err := m.VotingPowerWeights[len(m.VotingPowerWeights)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingCommissionChange) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingCommissionChange: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingCommissionChange: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduleTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ScheduleTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EffectiveTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.EffectiveTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipStaking(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ConsPubKeyRotationHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowStaking
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ConsPubKeyRotationHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.OldConsPubkey == nil {
+				m.OldConsPubkey = &types.Any{}
+			}
+			if err := m.OldConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewConsPubkey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.NewConsPubkey == nil {
+				m.NewConsPubkey = &types.Any{}
+			}
+			if err := m.NewConsPubkey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Fee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowStaking
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthStaking
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthStaking
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Fee = append(m.Fee, types1.Coin{})
+			if err := m.Fee[len(m.Fee)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipStaking(dAtA[iNdEx:])
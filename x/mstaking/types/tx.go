@@ -20,6 +20,11 @@ var (
 	_ sdk.Msg                            = &MsgBeginRedelegate{}
 	_ sdk.Msg                            = &MsgCancelUnbondingDelegation{}
 	_ sdk.Msg                            = &MsgUpdateParams{}
+	_ sdk.Msg                            = &MsgRotateConsPubKey{}
+	_ codectypes.UnpackInterfacesMessage = (*MsgRotateConsPubKey)(nil)
+	_ sdk.Msg                            = &MsgConvertDelegation{}
+	_ sdk.Msg                            = &MsgScheduleCommissionChange{}
+	_ sdk.Msg                            = &MsgRetireValidator{}
 )
 
 // NewMsgCreateValidator creates a new MsgCreateValidator instance.
@@ -288,3 +293,137 @@ func (msg MsgUpdateParams) Validate(accAddrCodec address.Codec) error {
 
 	return msg.Params.Validate()
 }
+
+/* MsgRotateConsPubKey */
+
+// NewMsgRotateConsPubKey creates a new MsgRotateConsPubKey instance.
+func NewMsgRotateConsPubKey(valAddr string, pubKey cryptotypes.PubKey) (*MsgRotateConsPubKey, error) {
+	var pkAny *codectypes.Any
+	if pubKey != nil {
+		var err error
+		if pkAny, err = codectypes.NewAnyWithValue(pubKey); err != nil {
+			return nil, err
+		}
+	}
+	return &MsgRotateConsPubKey{
+		ValidatorAddress: valAddr,
+		NewPubkey:        pkAny,
+	}, nil
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgRotateConsPubKey) Validate(valAddrCodec address.Codec) error {
+	if valAddr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	} else if len(valAddr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if msg.NewPubkey == nil {
+		return ErrEmptyValidatorPubKey
+	}
+
+	return nil
+}
+
+// UnpackInterfaces implements UnpackInterfacesMessage.UnpackInterfaces
+func (msg MsgRotateConsPubKey) UnpackInterfaces(unpacker codectypes.AnyUnpacker) error {
+	var pubKey cryptotypes.PubKey
+	return unpacker.UnpackAny(msg.NewPubkey, &pubKey)
+}
+
+// NewMsgConvertDelegation creates a new MsgConvertDelegation instance.
+//
+//nolint:interfacer
+func NewMsgConvertDelegation(delAddr, valAddr string, amount sdk.Coin, targetDenom string, minTargetAmount math.Int) *MsgConvertDelegation {
+	return &MsgConvertDelegation{
+		DelegatorAddress: delAddr,
+		ValidatorAddress: valAddr,
+		Amount:           amount,
+		TargetDenom:      targetDenom,
+		MinTargetAmount:  minTargetAmount,
+	}
+}
+
+// Validate implements the sdk.Msg interface.
+func (msg MsgConvertDelegation) Validate(accAddrCodec address.Codec, valAddrCodec address.Codec) error {
+	if addr, err := accAddrCodec.StringToBytes(msg.DelegatorAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyDelegatorAddr
+	}
+
+	if addr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	} else if len(addr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if !msg.Amount.IsValid() || !msg.Amount.IsPositive() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid convert amount",
+		)
+	}
+
+	if err := sdk.ValidateDenom(msg.TargetDenom); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if msg.TargetDenom == msg.Amount.Denom {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"target denom must be different from the convert amount denom",
+		)
+	}
+
+	if msg.MinTargetAmount.IsNil() || msg.MinTargetAmount.IsNegative() {
+		return errors.Wrap(
+			sdkerrors.ErrInvalidRequest,
+			"invalid min target amount",
+		)
+	}
+
+	return nil
+}
+
+// NewMsgScheduleCommissionChange creates a new MsgScheduleCommissionChange instance.
+func NewMsgScheduleCommissionChange(valAddr string, commissionRate math.LegacyDec) *MsgScheduleCommissionChange {
+	return &MsgScheduleCommissionChange{
+		ValidatorAddress: valAddr,
+		CommissionRate:   commissionRate,
+	}
+}
+
+// Validate validates the MsgScheduleCommissionChange sdk msg.
+func (msg MsgScheduleCommissionChange) Validate(valAddrCodec address.Codec) error {
+	if valAddr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	} else if len(valAddr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	if msg.CommissionRate.IsNil() || msg.CommissionRate.GT(math.LegacyOneDec()) || msg.CommissionRate.IsNegative() {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "commission rate must be between 0 and 1 (inclusive)")
+	}
+
+	return nil
+}
+
+// NewMsgRetireValidator creates a new MsgRetireValidator instance.
+func NewMsgRetireValidator(valAddr string) *MsgRetireValidator {
+	return &MsgRetireValidator{
+		ValidatorAddress: valAddr,
+	}
+}
+
+// Validate validates the MsgRetireValidator sdk msg.
+func (msg MsgRetireValidator) Validate(valAddrCodec address.Codec) error {
+	if valAddr, err := valAddrCodec.StringToBytes(msg.ValidatorAddress); err != nil {
+		return err
+	} else if len(valAddr) == 0 {
+		return ErrEmptyValidatorAddr
+	}
+
+	return nil
+}
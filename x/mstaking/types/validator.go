@@ -528,6 +528,7 @@ func (v *Validator) MinEqual(other *Validator) bool {
 		v.Description.Equal(other.Description) &&
 		v.Commission.Equal(other.Commission) &&
 		v.Jailed == other.Jailed &&
+		v.Retired == other.Retired &&
 		v.ConsensusPubkey.Equal(other.ConsensusPubkey)
 
 }
@@ -540,6 +541,7 @@ func (v *Validator) Equal(v2 *Validator) bool {
 }
 
 func (v Validator) IsJailed() bool        { return v.Jailed }
+func (v Validator) IsRetired() bool       { return v.Retired }
 func (v Validator) GetMoniker() string    { return v.Description.Moniker }
 func (v Validator) GetStatus() BondStatus { return v.Status }
 func (v Validator) GetOperator() string {
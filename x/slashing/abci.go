@@ -4,6 +4,9 @@ import (
 	"context"
 	"time"
 
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
 	"cosmossdk.io/core/comet"
 
 	"github.com/cosmos/cosmos-sdk/telemetry"
@@ -11,6 +14,11 @@ import (
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	"github.com/initia-labs/initia/x/slashing/keeper"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+
+	"github.com/skip-mev/slinky/abci/strategies/codec"
+	slinkyabci "github.com/skip-mev/slinky/abci/types"
+	"github.com/skip-mev/slinky/abci/ve"
 )
 
 // BeginBlocker check for infraction evidence or downtime of validators
@@ -30,3 +38,67 @@ func BeginBlocker(ctx context.Context, k keeper.Keeper) error {
 	}
 	return nil
 }
+
+// OraclePreBlocker returns the pre-blocker which tracks the oracle vote liveness of
+// validators from the extended commit info, which is injected into the proposal by
+// the oracle proposal handler. The votes are not tracked while the market map has no
+// enabled market, because the validators have no price to submit.
+func OraclePreBlocker(k keeper.Keeper, mmk customtypes.MarketMapKeeper, veCodec codec.VoteExtensionCodec, ecCodec codec.ExtendedCommitCodec) sdk.PreBlocker {
+	return func(ctx sdk.Context, req *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
+		defer telemetry.ModuleMeasureSince(types.ModuleName, time.Now(), "oracle_pre_blocker")
+
+		// vote extensions are only included in the proposal after they are enabled
+		if !ve.VoteExtensionsEnabled(ctx) {
+			return &sdk.ResponsePreBlock{}, nil
+		}
+
+		if len(req.Txs) < slinkyabci.NumInjectedTxs {
+			return nil, slinkyabci.MissingCommitInfoError{}
+		}
+
+		if enabled, err := hasEnabledMarket(ctx, mmk); err != nil {
+			return nil, err
+		} else if !enabled {
+			return &sdk.ResponsePreBlock{}, nil
+		}
+
+		extendedCommitInfo, err := ecCodec.Decode(req.Txs[slinkyabci.OracleInfoIndex])
+		if err != nil {
+			return nil, err
+		}
+
+		for _, vote := range extendedCommitInfo.Votes {
+			voted := false
+			if vote.BlockIdFlag == cmtproto.BlockIDFlagCommit && len(vote.VoteExtension) > 0 {
+				voteExtension, err := veCodec.Decode(vote.VoteExtension)
+				if err != nil {
+					return nil, err
+				}
+
+				voted = len(voteExtension.Prices) > 0
+			}
+
+			if err := k.HandleValidatorOracleVote(ctx, vote.Validator.Address, vote.Validator.Power, voted); err != nil {
+				return nil, err
+			}
+		}
+
+		return &sdk.ResponsePreBlock{}, nil
+	}
+}
+
+// hasEnabledMarket returns true if the market map has any enabled market.
+func hasEnabledMarket(ctx sdk.Context, mmk customtypes.MarketMapKeeper) (bool, error) {
+	markets, err := mmk.GetAllMarkets(ctx)
+	if err != nil {
+		return false, err
+	}
+
+	for _, market := range markets {
+		if market.Ticker.Enabled {
+			return true, nil
+		}
+	}
+
+	return false, nil
+}
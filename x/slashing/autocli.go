@@ -7,6 +7,8 @@ import (
 	slashingv1beta "cosmossdk.io/api/cosmos/slashing/v1beta1"
 
 	"github.com/cosmos/cosmos-sdk/version"
+
+	slashingv1 "github.com/initia-labs/initia/api/initia/slashing/v1"
 )
 
 func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
@@ -35,6 +37,66 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Short:     "Query signing information of all validators",
 				},
 			},
+			SubCommands: map[string]*autocliv1.ServiceCommandDescriptor{
+				"oracle": {
+					Service: slashingv1.Query_ServiceDesc.ServiceName,
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod: "OracleParams",
+							Use:       "params",
+							Short:     "Query the current oracle vote liveness parameters",
+						},
+						{
+							RpcMethod: "OracleSigningInfo",
+							Use:       "signing-info [validator-cons-address]",
+							Short:     "Query a validator's oracle vote liveness information",
+							Example:   fmt.Sprintf(`%s query slashing oracle signing-info initvalcons1...`, version.AppName),
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "cons_address"},
+							},
+						},
+						{
+							RpcMethod: "OracleSigningInfos",
+							Use:       "signing-infos",
+							Short:     "Query oracle vote liveness information of all validators",
+						},
+						{RpcMethod: "UptimeParams", Skip: true},
+						{RpcMethod: "MissedBlockBitmap", Skip: true},
+						{RpcMethod: "UptimeHistory", Skip: true},
+					},
+				},
+				"uptime": {
+					Service: slashingv1.Query_ServiceDesc.ServiceName,
+					RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+						{
+							RpcMethod: "UptimeParams",
+							Use:       "params",
+							Short:     "Query the current uptime history parameters",
+						},
+						{
+							RpcMethod: "MissedBlockBitmap",
+							Use:       "missed-block-bitmap [validator-cons-address]",
+							Short:     "Query a validator's missed block bitmap of the current signed blocks window",
+							Example:   fmt.Sprintf(`%s query slashing uptime missed-block-bitmap initvalcons1...`, version.AppName),
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "cons_address"},
+							},
+						},
+						{
+							RpcMethod: "UptimeHistory",
+							Use:       "history [validator-cons-address]",
+							Short:     "Query a validator's per-epoch uptime history",
+							Example:   fmt.Sprintf(`%s query slashing uptime history initvalcons1...`, version.AppName),
+							PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+								{ProtoField: "cons_address"},
+							},
+						},
+						{RpcMethod: "OracleParams", Skip: true},
+						{RpcMethod: "OracleSigningInfo", Skip: true},
+						{RpcMethod: "OracleSigningInfos", Skip: true},
+					},
+				},
+			},
 		},
 		Tx: &autocliv1.ServiceCommandDescriptor{
 			Service: slashingv1beta.Msg_ServiceDesc.ServiceName,
@@ -1,15 +1,18 @@
 package keeper
 
 import (
+	"cosmossdk.io/collections"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
 )
 
 // InitGenesis initialize default parameters
 // and the keeper's address to pubkey map
-func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
+func (k Keeper) InitGenesis(ctx sdk.Context, data *customtypes.GenesisState) {
 	err := k.sk.IterateValidators(ctx,
 		func(validator stakingtypes.ValidatorI) (bool, error) {
 			consPk, err := validator.ConsPubKey()
@@ -51,15 +54,60 @@ func (k Keeper) InitGenesis(ctx sdk.Context, data *types.GenesisState) {
 		}
 	}
 
+	for _, info := range data.OracleSigningInfos {
+		address, err := k.sk.ConsensusAddressCodec().StringToBytes(info.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		if err := k.OracleSigningInfos.Set(ctx, address, info); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, array := range data.OracleMissedVotes {
+		address, err := k.sk.ConsensusAddressCodec().StringToBytes(array.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, missed := range array.MissedVotes {
+			if err := k.SetMissedOracleVoteBitmapValue(ctx, address, missed.Index, missed.Missed); err != nil {
+				panic(err)
+			}
+		}
+	}
+
 	if err := k.SetParams(ctx, data.Params); err != nil {
 		panic(err)
 	}
+
+	if err := k.OracleParams.Set(ctx, data.OracleParams); err != nil {
+		panic(err)
+	}
+
+	for _, history := range data.UptimeHistories {
+		address, err := k.sk.ConsensusAddressCodec().StringToBytes(history.Address)
+		if err != nil {
+			panic(err)
+		}
+
+		for _, uptime := range history.Epochs {
+			if err := k.EpochUptimes.Set(ctx, collections.Join(sdk.ConsAddress(address), uptime.Epoch), uptime); err != nil {
+				panic(err)
+			}
+		}
+	}
+
+	if err := k.UptimeParams.Set(ctx, data.UptimeParams); err != nil {
+		panic(err)
+	}
 }
 
 // ExportGenesis writes the current store values
 // to a genesis file, which can be imported again
 // with InitGenesis
-func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
+func (k Keeper) ExportGenesis(ctx sdk.Context) (data *customtypes.GenesisState) {
 	params, err := k.GetParams(ctx)
 	if err != nil {
 		panic(err)
@@ -89,5 +137,53 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) (data *types.GenesisState) {
 		panic(err)
 	}
 
-	return types.NewGenesisState(params, signingInfos, missedBlocks)
+	oracleParams, err := k.GetOracleParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	oracleSigningInfos := make([]customtypes.OracleSigningInfo, 0)
+	oracleMissedVotes := make([]customtypes.ValidatorMissedOracleVotes, 0)
+	err = k.OracleSigningInfos.Walk(ctx, nil, func(address sdk.ConsAddress, info customtypes.OracleSigningInfo) (stop bool, err error) {
+		oracleSigningInfos = append(oracleSigningInfos, info)
+
+		localMissedVotes, err := k.GetValidatorMissedOracleVotes(ctx, address)
+		if err != nil {
+			return true, err
+		}
+
+		oracleMissedVotes = append(oracleMissedVotes, customtypes.ValidatorMissedOracleVotes{
+			Address:     address.String(),
+			MissedVotes: localMissedVotes,
+		})
+
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	uptimeParams, err := k.GetUptimeParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	uptimeHistories := make([]customtypes.ValidatorUptimeHistory, 0)
+	err = k.EpochUptimes.Walk(ctx, nil, func(key collections.Pair[sdk.ConsAddress, uint64], uptime customtypes.EpochUptime) (stop bool, err error) {
+		address := key.K1().String()
+		if n := len(uptimeHistories); n == 0 || uptimeHistories[n-1].Address != address {
+			uptimeHistories = append(uptimeHistories, customtypes.ValidatorUptimeHistory{Address: address})
+		}
+
+		last := &uptimeHistories[len(uptimeHistories)-1]
+		last.Epochs = append(last.Epochs, uptime)
+		return false, nil
+	})
+	if err != nil {
+		panic(err)
+	}
+
+	return customtypes.NewGenesisState(
+		params, signingInfos, missedBlocks,
+		oracleParams, oracleSigningInfos, oracleMissedVotes,
+		uptimeParams, uptimeHistories,
+	)
 }
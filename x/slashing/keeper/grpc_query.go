@@ -6,11 +6,15 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 
+	"cosmossdk.io/collections"
 	"cosmossdk.io/store/prefix"
 
 	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
 )
 
 var _ types.QueryServer = Keeper{}
@@ -72,3 +76,138 @@ func (k Keeper) SigningInfos(ctx context.Context, req *types.QuerySigningInfosRe
 	}
 	return &types.QuerySigningInfosResponse{Info: signInfos, Pagination: pageRes}, nil
 }
+
+type customQueryServer struct {
+	Keeper
+}
+
+// NewCustomQueryServerImpl returns an implementation of the initia specific slashing
+// QueryServer interface, which serves the oracle vote liveness and the uptime history,
+// for the provided Keeper.
+func NewCustomQueryServerImpl(k Keeper) customtypes.QueryServer {
+	return &customQueryServer{k}
+}
+
+var _ customtypes.QueryServer = customQueryServer{}
+
+// OracleParams returns the oracle vote liveness parameters.
+func (q customQueryServer) OracleParams(ctx context.Context, req *customtypes.QueryOracleParamsRequest) (*customtypes.QueryOracleParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.GetOracleParams(ctx)
+	return &customtypes.QueryOracleParamsResponse{Params: params}, err
+}
+
+// OracleSigningInfo returns the oracle signing info of a specific validator.
+func (q customQueryServer) OracleSigningInfo(ctx context.Context, req *customtypes.QueryOracleSigningInfoRequest) (*customtypes.QueryOracleSigningInfoResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := q.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	info, err := q.Keeper.OracleSigningInfos.Get(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "OracleSigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	return &customtypes.QueryOracleSigningInfoResponse{Info: info}, nil
+}
+
+// OracleSigningInfos returns the oracle signing infos of all validators.
+func (q customQueryServer) OracleSigningInfos(ctx context.Context, req *customtypes.QueryOracleSigningInfosRequest) (*customtypes.QueryOracleSigningInfosResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	infos, pageRes, err := query.CollectionPaginate(ctx, q.Keeper.OracleSigningInfos, req.Pagination, func(_ sdk.ConsAddress, info customtypes.OracleSigningInfo) (customtypes.OracleSigningInfo, error) {
+		return info, nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryOracleSigningInfosResponse{Infos: infos, Pagination: pageRes}, nil
+}
+
+// UptimeParams returns the uptime history parameters.
+func (q customQueryServer) UptimeParams(ctx context.Context, req *customtypes.QueryUptimeParamsRequest) (*customtypes.QueryUptimeParamsResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := q.GetUptimeParams(ctx)
+	return &customtypes.QueryUptimeParamsResponse{Params: params}, err
+}
+
+// MissedBlockBitmap returns the missed block bitmap of the current signed blocks window of a specific validator.
+func (q customQueryServer) MissedBlockBitmap(ctx context.Context, req *customtypes.QueryMissedBlockBitmapRequest) (*customtypes.QueryMissedBlockBitmapResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := q.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	signInfo, err := q.GetValidatorSigningInfo(ctx, consAddr)
+	if err != nil {
+		return nil, status.Errorf(codes.NotFound, "SigningInfo not found for validator %s", req.ConsAddress)
+	}
+
+	signedBlocksWindow, err := q.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	bitmap, err := q.GetMissedBlockBitmap(ctx, consAddr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryMissedBlockBitmapResponse{
+		SignedBlocksWindow:  signedBlocksWindow,
+		IndexOffset:         signInfo.IndexOffset,
+		MissedBlocksCounter: signInfo.MissedBlocksCounter,
+		Bitmap:              bitmap,
+	}, nil
+}
+
+// UptimeHistory returns the retained per-epoch uptime history of a specific validator.
+func (q customQueryServer) UptimeHistory(ctx context.Context, req *customtypes.QueryUptimeHistoryRequest) (*customtypes.QueryUptimeHistoryResponse, error) {
+	if req == nil {
+		return nil, status.Errorf(codes.InvalidArgument, "empty request")
+	}
+
+	if req.ConsAddress == "" {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid request")
+	}
+
+	consAddr, err := q.sk.ConsensusAddressCodec().StringToBytes(req.ConsAddress)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	epochs, pageRes, err := query.CollectionPaginate(ctx, q.EpochUptimes, req.Pagination, func(_ collections.Pair[sdk.ConsAddress, uint64], uptime customtypes.EpochUptime) (customtypes.EpochUptime, error) {
+		return uptime, nil
+	}, query.WithCollectionPaginationPairPrefix[sdk.ConsAddress, uint64](consAddr))
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &customtypes.QueryUptimeHistoryResponse{Epochs: epochs, Pagination: pageRes}, nil
+}
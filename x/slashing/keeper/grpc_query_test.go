@@ -4,11 +4,17 @@ import (
 	"testing"
 	"time"
 
+	"cosmossdk.io/core/comet"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
 	"github.com/stretchr/testify/require"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	"github.com/initia-labs/initia/x/slashing/keeper"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
 )
 
 func TestGRPCQueryParams(t *testing.T) {
@@ -73,3 +79,71 @@ func TestGRPCSigningInfos(t *testing.T) {
 	require.NotNil(t, infoResp.Pagination.NextKey)
 	require.Equal(t, uint64(2), infoResp.Pagination.Total)
 }
+
+func TestGRPCOracleSigningInfos(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	params := customtypes.DefaultOracleParams()
+	params.SignedVotesWindow = 100
+	require.NoError(t, input.SlashingKeeper.OracleParams.Set(ctx, params))
+
+	querier := keeper.NewCustomQueryServerImpl(input.SlashingKeeper)
+
+	_, valPubKey1 := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 2_000_000, 1)
+	_, valPubKey2 := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 2_000_000, 2)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	_, err := querier.OracleSigningInfo(ctx, &customtypes.QueryOracleSigningInfoRequest{ConsAddress: sdk.ConsAddress(valPubKey1.Address()).String()})
+	require.Error(t, err)
+
+	require.NoError(t, input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey1.Address(), 2, true))
+	require.NoError(t, input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey2.Address(), 2, false))
+
+	infoResp, err := querier.OracleSigningInfo(ctx, &customtypes.QueryOracleSigningInfoRequest{ConsAddress: sdk.ConsAddress(valPubKey2.Address()).String()})
+	require.NoError(t, err)
+	require.Equal(t, customtypes.NewOracleSigningInfo(sdk.ConsAddress(valPubKey2.Address()), ctx.BlockHeight(), 1, 1), infoResp.Info)
+
+	infosResp, err := querier.OracleSigningInfos(ctx, &customtypes.QueryOracleSigningInfosRequest{})
+	require.NoError(t, err)
+	require.Len(t, infosResp.Infos, 2)
+}
+
+func TestGRPCMissedBlockBitmapAndUptimeHistory(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	require.NoError(t, input.SlashingKeeper.UptimeParams.Set(ctx, customtypes.NewUptimeParams(10, 5)))
+
+	querier := keeper.NewCustomQueryServerImpl(input.SlashingKeeper)
+
+	ctx = ctx.WithBlockHeight(1)
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 2_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	consAddrStr := sdk.ConsAddress(valPubKey.Address()).String()
+	for height := int64(1); height <= 12; height++ {
+		ctx = ctx.WithBlockHeight(height)
+
+		signed := comet.BlockIDFlagCommit
+		if height == 2 {
+			signed = comet.BlockIDFlagAbsent
+		}
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), 2, signed))
+	}
+
+	bitmapResp, err := querier.MissedBlockBitmap(ctx, &customtypes.QueryMissedBlockBitmapRequest{ConsAddress: consAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, int64(100), bitmapResp.SignedBlocksWindow)
+	require.Equal(t, int64(12), bitmapResp.IndexOffset)
+	require.Equal(t, int64(1), bitmapResp.MissedBlocksCounter)
+	require.Len(t, bitmapResp.Bitmap, 13)
+	require.Equal(t, byte(0b00000010), bitmapResp.Bitmap[0])
+
+	historyResp, err := querier.UptimeHistory(ctx, &customtypes.QueryUptimeHistoryRequest{ConsAddress: consAddrStr})
+	require.NoError(t, err)
+	require.Equal(t, []customtypes.EpochUptime{
+		{Epoch: 0, StartHeight: 1, SignedBlocks: 8, MissedBlocks: 1},
+		{Epoch: 1, StartHeight: 10, SignedBlocks: 3, MissedBlocks: 0},
+	}, historyResp.Epochs)
+
+	paramsResp, err := querier.UptimeParams(ctx, &customtypes.QueryUptimeParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, customtypes.NewUptimeParams(10, 5), paramsResp.Params)
+}
@@ -6,6 +6,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto"
 
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
 
@@ -96,3 +97,23 @@ func (h Hooks) BeforeValidatorSlashed(_ context.Context, _ sdk.ValAddress, _ sdk
 func (h Hooks) AfterUnbondingInitiated(_ context.Context, _ uint64) error {
 	return nil
 }
+
+// AfterConsensusPubKeyUpdate adds the address-pubkey relation of the new pubkey and moves
+// the signing info, the oracle signing info and the uptime history to the new consensus address. The relation of the old pubkey is kept,
+// so the evidences of the old key can still be handled.
+func (h Hooks) AfterConsensusPubKeyUpdate(ctx context.Context, _ sdk.ValAddress, oldPubKey, newPubKey cryptotypes.PubKey, _ sdk.Coins) error {
+	if err := h.k.AddPubkey(ctx, newPubKey); err != nil {
+		return err
+	}
+
+	oldAddr, newAddr := sdk.ConsAddress(oldPubKey.Address()), sdk.ConsAddress(newPubKey.Address())
+	if err := h.k.RotateValidatorSigningInfo(ctx, oldAddr, newAddr); err != nil {
+		return err
+	}
+
+	if err := h.k.RotateOracleSigningInfo(ctx, oldAddr, newAddr); err != nil {
+		return err
+	}
+
+	return h.k.RotateUptimeHistory(ctx, oldAddr, newAddr)
+}
@@ -29,6 +29,13 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 		return err
 	}
 
+	// the votes can be signed with a rotated out consensus key until the rotation
+	// takes effect, so use the validator's current consensus address
+	consAddr, err = k.currentConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
 	if isJailed {
 		return nil
 	}
@@ -80,6 +87,10 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 		// bitmap value at this index has not changed, no need to update counter
 	}
 
+	if err := k.RecordEpochUptime(ctx, consAddr, missed); err != nil {
+		return err
+	}
+
 	minSignedPerWindow, err := k.MinSignedPerWindow(ctx)
 	if err != nil {
 		return err
@@ -186,3 +197,14 @@ func (k Keeper) HandleValidatorSignature(ctx context.Context, addr cryptotypes.A
 	// Set the updated signing info
 	return k.SetValidatorSigningInfo(ctx, consAddr, signInfo)
 }
+
+// currentConsAddr returns the current consensus address of the validator,
+// which differs from the given one when its consensus pubkey was rotated.
+func (k Keeper) currentConsAddr(ctx context.Context, consAddr sdk.ConsAddress) (sdk.ConsAddress, error) {
+	validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return validator.GetConsAddr()
+}
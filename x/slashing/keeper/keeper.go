@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 
+	"cosmossdk.io/collections"
 	storetypes "cosmossdk.io/core/store"
 	"cosmossdk.io/log"
 	sdkmath "cosmossdk.io/math"
@@ -27,16 +28,38 @@ type Keeper struct {
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
+
+	Schema                 collections.Schema
+	OracleParams           collections.Item[customtypes.OracleParams]
+	OracleSigningInfos     collections.Map[sdk.ConsAddress, customtypes.OracleSigningInfo]
+	OracleMissedVoteBitmap collections.Map[collections.Pair[sdk.ConsAddress, int64], []byte]
+	UptimeParams           collections.Item[customtypes.UptimeParams]
+	EpochUptimes           collections.Map[collections.Pair[sdk.ConsAddress, uint64], customtypes.EpochUptime]
 }
 
 // NewKeeper creates a slashing keeper
 func NewKeeper(cdc codec.BinaryCodec, storeService storetypes.KVStoreService, sk customtypes.StakingKeeper, authority string) Keeper {
-	return Keeper{
+	sb := collections.NewSchemaBuilder(storeService)
+	k := Keeper{
 		storeService: storeService,
 		cdc:          cdc,
 		sk:           sk,
 		authority:    authority,
+
+		OracleParams:           collections.NewItem(sb, customtypes.OracleParamsKey, "oracle_params", codec.CollValue[customtypes.OracleParams](cdc)),
+		OracleSigningInfos:     collections.NewMap(sb, customtypes.OracleSigningInfoKeyPrefix, "oracle_signing_infos", sdk.ConsAddressKey, codec.CollValue[customtypes.OracleSigningInfo](cdc)),
+		OracleMissedVoteBitmap: collections.NewMap(sb, customtypes.OracleMissedVoteBitmapKeyPrefix, "oracle_missed_vote_bitmap", collections.PairKeyCodec(sdk.ConsAddressKey, collections.Int64Key), collections.BytesValue),
+		UptimeParams:           collections.NewItem(sb, customtypes.UptimeParamsKey, "uptime_params", codec.CollValue[customtypes.UptimeParams](cdc)),
+		EpochUptimes:           collections.NewMap(sb, customtypes.EpochUptimeKeyPrefix, "epoch_uptimes", collections.PairKeyCodec(sdk.ConsAddressKey, collections.Uint64Key), codec.CollValue[customtypes.EpochUptime](cdc)),
+	}
+
+	schema, err := sb.Build()
+	if err != nil {
+		panic(err)
 	}
+	k.Schema = schema
+
+	return k
 }
 
 // GetAuthority returns the x/slashing module's authority.
@@ -8,6 +8,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
 )
 
 type msgServer struct {
@@ -55,3 +57,51 @@ func (k msgServer) Unjail(ctx context.Context, msg *types.MsgUnjail) (*types.Msg
 
 	return &types.MsgUnjailResponse{}, nil
 }
+
+type customMsgServer struct {
+	Keeper
+}
+
+// NewCustomMsgServerImpl returns an implementation of the initia specific slashing
+// MsgServer interface for the provided Keeper.
+func NewCustomMsgServerImpl(keeper Keeper) customtypes.MsgServer {
+	return &customMsgServer{Keeper: keeper}
+}
+
+var _ customtypes.MsgServer = customMsgServer{}
+
+// UpdateOracleParams implements MsgServer.UpdateOracleParams method.
+// It defines a method to update the oracle vote liveness parameters.
+func (k customMsgServer) UpdateOracleParams(ctx context.Context, req *customtypes.MsgUpdateOracleParams) (*customtypes.MsgUpdateOracleParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.OracleParams.Set(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &customtypes.MsgUpdateOracleParamsResponse{}, nil
+}
+
+// UpdateUptimeParams implements MsgServer.UpdateUptimeParams method.
+// It defines a method to update the uptime history parameters.
+func (k customMsgServer) UpdateUptimeParams(ctx context.Context, req *customtypes.MsgUpdateUptimeParams) (*customtypes.MsgUpdateUptimeParamsResponse, error) {
+	if k.authority != req.Authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, req.Authority)
+	}
+
+	if err := req.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.UptimeParams.Set(ctx, req.Params); err != nil {
+		return nil, err
+	}
+
+	return &customtypes.MsgUpdateUptimeParamsResponse{}, nil
+}
@@ -0,0 +1,305 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+// GetOracleParams returns the oracle vote liveness parameters. The tracking is
+// disabled until the parameters are set.
+func (k Keeper) GetOracleParams(ctx context.Context) (customtypes.OracleParams, error) {
+	params, err := k.OracleParams.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return customtypes.OracleParams{}, nil
+	}
+
+	return params, err
+}
+
+// HandleValidatorOracleVote handles the oracle vote extension of a validator, must be
+// called once per validator per block with vote extensions enabled. The vote is
+// missed when the validator does not submit any price in its vote extension.
+func (k Keeper) HandleValidatorOracleVote(ctx context.Context, addr cryptotypes.Address, power int64, voted bool) error {
+	params, err := k.GetOracleParams(ctx)
+	if err != nil {
+		return err
+	} else if !params.Enabled() {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	logger := k.Logger(ctx)
+	height := sdkCtx.BlockHeight()
+	consAddr := sdk.ConsAddress(addr)
+
+	// don't update missed votes when validator's jailed
+	isJailed, err := k.sk.IsValidatorJailed(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	consAddr, err = k.currentConsAddr(ctx, consAddr)
+	if err != nil {
+		return err
+	}
+
+	if isJailed {
+		return nil
+	}
+
+	// the validators bonded before the tracking is enabled start from their first vote
+	signInfo, err := k.OracleSigningInfos.Get(ctx, consAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		signInfo = customtypes.NewOracleSigningInfo(consAddr, height, 0, 0)
+	} else if err != nil {
+		return err
+	}
+
+	index := signInfo.IndexOffset % params.SignedVotesWindow
+	signInfo.IndexOffset++
+
+	previous, err := k.GetMissedOracleVoteBitmapValue(ctx, consAddr, index)
+	if err != nil {
+		return errorsmod.Wrap(err, "failed to get the validator's oracle vote bitmap value")
+	}
+
+	missed := !voted
+	switch {
+	case !previous && missed:
+		if err := k.SetMissedOracleVoteBitmapValue(ctx, consAddr, index, true); err != nil {
+			return err
+		}
+
+		signInfo.MissedVotesCounter++
+	case previous && !missed:
+		if err := k.SetMissedOracleVoteBitmapValue(ctx, consAddr, index, false); err != nil {
+			return err
+		}
+
+		signInfo.MissedVotesCounter--
+	default:
+		// bitmap value at this index has not changed, no need to update counter
+	}
+
+	minVotedPerWindow := params.MinVotedPerWindowInt()
+	if missed {
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				customtypes.EventTypeOracleLiveness,
+				sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+				sdk.NewAttribute(customtypes.AttributeKeyMissedOracleVotes, fmt.Sprintf("%d", signInfo.MissedVotesCounter)),
+				sdk.NewAttribute(types.AttributeKeyHeight, fmt.Sprintf("%d", height)),
+			),
+		)
+
+		logger.Debug(
+			"absent oracle vote",
+			"height", height,
+			"validator", consAddr.String(),
+			"missed", signInfo.MissedVotesCounter,
+			"threshold", minVotedPerWindow,
+		)
+	}
+
+	minHeight := signInfo.StartHeight + params.SignedVotesWindow
+	maxMissed := params.SignedVotesWindow - minVotedPerWindow
+
+	// if we are past the minimum height and the validator has missed too many votes, punish them
+	if height > minHeight && signInfo.MissedVotesCounter > maxMissed {
+		validator, err := k.sk.ValidatorByConsAddr(ctx, consAddr)
+		if err != nil {
+			return err
+		}
+
+		if validator != nil && !validator.IsJailed() {
+			// the vote extensions are included in the last commit, so the stake
+			// distribution is retrieved same as the block downtime.
+			distributionHeight := height - sdk.ValidatorUpdateDelay - 1
+
+			coinsBurned := sdk.NewCoins()
+			if params.SlashFractionDowntime.IsPositive() {
+				coinsBurned, err = k.sk.SlashWithInfractionReason(ctx, consAddr, distributionHeight, params.SlashFractionDowntime, stakingtypes.Infraction_INFRACTION_DOWNTIME)
+				if err != nil {
+					return err
+				}
+			}
+
+			sdkCtx.EventManager().EmitEvent(
+				sdk.NewEvent(
+					types.EventTypeSlash,
+					sdk.NewAttribute(types.AttributeKeyAddress, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyPower, fmt.Sprintf("%d", power)),
+					sdk.NewAttribute(types.AttributeKeyReason, customtypes.AttributeValueMissingOracleVote),
+					sdk.NewAttribute(types.AttributeKeyJailed, consAddr.String()),
+					sdk.NewAttribute(types.AttributeKeyBurnedCoins, coinsBurned.String()),
+				),
+			)
+
+			err = k.sk.Jail(ctx, consAddr)
+			if err != nil {
+				return err
+			}
+
+			// the jail period is recorded in the validator signing info, which
+			// is checked on unjail.
+			jailedUntil := sdkCtx.BlockHeader().Time.Add(params.DowntimeJailDuration)
+			if err := k.JailUntil(ctx, consAddr, jailedUntil); err != nil {
+				return err
+			}
+
+			// We need to reset the counter & bitmap so that the validator won't be
+			// immediately slashed for oracle downtime upon re-bonding.
+			signInfo.MissedVotesCounter = 0
+			signInfo.IndexOffset = 0
+			err = k.DeleteMissedOracleVoteBitmap(ctx, consAddr)
+			if err != nil {
+				return err
+			}
+
+			logger.Info(
+				"slashing and jailing validator due to oracle liveness fault",
+				"height", height,
+				"validator", consAddr.String(),
+				"min_height", minHeight,
+				"threshold", minVotedPerWindow,
+				"slashed", params.SlashFractionDowntime.String(),
+				"jailed_until", jailedUntil,
+			)
+		} else {
+			// validator was (a) not found or (b) already jailed so we do not slash
+			logger.Info(
+				"validator would have been slashed for oracle downtime, but was either not found in store or already jailed",
+				"validator", consAddr.String(),
+			)
+		}
+	}
+
+	return k.OracleSigningInfos.Set(ctx, consAddr, signInfo)
+}
+
+// GetMissedOracleVoteBitmapValue returns true if a validator missed the oracle vote
+// at the given index of the signed votes window.
+func (k Keeper) GetMissedOracleVoteBitmapValue(ctx context.Context, addr sdk.ConsAddress, index int64) (bool, error) {
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+
+	bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+	chunk, err := k.OracleMissedVoteBitmap.Get(ctx, collections.Join(addr, chunkIndex))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return false, errorsmod.Wrapf(err, "failed to get bitmap chunk; index: %d", index)
+	} else if err == nil {
+		if err := bs.UnmarshalBinary(chunk); err != nil {
+			return false, errorsmod.Wrapf(err, "failed to decode bitmap chunk; index: %d", index)
+		}
+	}
+
+	bitIndex := index % types.MissedBlockBitmapChunkSize
+	return bs.Test(uint(bitIndex)), nil
+}
+
+// SetMissedOracleVoteBitmapValue sets, i.e. flips, a bit in the validator's missed
+// oracle vote bitmap at the given index of the signed votes window.
+func (k Keeper) SetMissedOracleVoteBitmapValue(ctx context.Context, addr sdk.ConsAddress, index int64, missed bool) error {
+	chunkIndex := index / types.MissedBlockBitmapChunkSize
+
+	bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+	chunk, err := k.OracleMissedVoteBitmap.Get(ctx, collections.Join(addr, chunkIndex))
+	if err != nil && !errors.Is(err, collections.ErrNotFound) {
+		return errorsmod.Wrapf(err, "failed to get bitmap chunk; index: %d", index)
+	} else if err == nil {
+		if err := bs.UnmarshalBinary(chunk); err != nil {
+			return errorsmod.Wrapf(err, "failed to decode bitmap chunk; index: %d", index)
+		}
+	}
+
+	bitIndex := uint(index % types.MissedBlockBitmapChunkSize)
+	if missed {
+		bs.Set(bitIndex)
+	} else {
+		bs.Clear(bitIndex)
+	}
+
+	updatedChunk, err := bs.MarshalBinary()
+	if err != nil {
+		return errorsmod.Wrapf(err, "failed to encode bitmap chunk; index: %d", index)
+	}
+
+	return k.OracleMissedVoteBitmap.Set(ctx, collections.Join(addr, chunkIndex), updatedChunk)
+}
+
+// DeleteMissedOracleVoteBitmap removes a validator's missed oracle vote bitmap from state.
+func (k Keeper) DeleteMissedOracleVoteBitmap(ctx context.Context, addr sdk.ConsAddress) error {
+	return k.OracleMissedVoteBitmap.Clear(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, int64](addr))
+}
+
+// GetValidatorMissedOracleVotes returns array of missed oracle votes for given validator.
+func (k Keeper) GetValidatorMissedOracleVotes(ctx context.Context, addr sdk.ConsAddress) ([]types.MissedBlock, error) {
+	missedVotes := []types.MissedBlock{}
+	err := k.OracleMissedVoteBitmap.Walk(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, int64](addr), func(key collections.Pair[sdk.ConsAddress, int64], chunk []byte) (stop bool, err error) {
+		bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+		if err := bs.UnmarshalBinary(chunk); err != nil {
+			return true, errorsmod.Wrapf(err, "failed to decode bitmap chunk; chunk index: %d", key.K2())
+		}
+
+		for i := uint(0); i < types.MissedBlockBitmapChunkSize; i++ {
+			if bs.Test(i) {
+				missedVotes = append(missedVotes, types.NewMissedBlock(key.K2()*types.MissedBlockBitmapChunkSize+int64(i), true))
+			}
+		}
+
+		return false, nil
+	})
+
+	return missedVotes, err
+}
+
+// RotateOracleSigningInfo moves the oracle signing info and the missed oracle vote
+// bitmap of a validator from the rotated out consensus address to the new one.
+func (k Keeper) RotateOracleSigningInfo(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	signInfo, err := k.OracleSigningInfos.Get(ctx, oldAddr)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		// the validator has never voted with the tracking enabled
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	signInfo.Address = newAddr.String()
+	if err := k.OracleSigningInfos.Set(ctx, newAddr, signInfo); err != nil {
+		return err
+	}
+	if err := k.OracleSigningInfos.Remove(ctx, oldAddr); err != nil {
+		return err
+	}
+
+	var chunkIndexes []int64
+	var chunks [][]byte
+	err = k.OracleMissedVoteBitmap.Walk(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, int64](oldAddr), func(key collections.Pair[sdk.ConsAddress, int64], chunk []byte) (stop bool, err error) {
+		chunkIndexes = append(chunkIndexes, key.K2())
+		chunks = append(chunks, chunk)
+		return false, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	for i, chunkIndex := range chunkIndexes {
+		if err := k.OracleMissedVoteBitmap.Set(ctx, collections.Join(newAddr, chunkIndex), chunks[i]); err != nil {
+			return err
+		}
+	}
+
+	return k.DeleteMissedOracleVoteBitmap(ctx, oldAddr)
+}
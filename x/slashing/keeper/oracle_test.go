@@ -0,0 +1,165 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
+	"github.com/initia-labs/initia/x/slashing/keeper"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+func TestHandleValidatorOracleVote_Disabled(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 10_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	err := input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey.Address(), 10, false)
+	require.NoError(t, err)
+
+	_, err = input.SlashingKeeper.OracleSigningInfos.Get(ctx, sdk.ConsAddress(valPubKey.Address()))
+	require.Error(t, err)
+}
+
+func TestHandleValidatorOracleVote(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	params := customtypes.NewOracleParams(10, math.LegacyNewDecWithPrec(5, 1), time.Hour, math.LegacyNewDecWithPrec(1, 1))
+	require.NoError(t, input.SlashingKeeper.OracleParams.Set(ctx, params))
+
+	ctx = ctx.WithBlockHeight(1)
+	valAddr, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 10_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	consAddr := sdk.ConsAddress(valPubKey.Address())
+
+	// 10 first votes OK
+	height := int64(1)
+	for ; height <= 10; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey.Address(), 10, true))
+	}
+
+	// block signatures do not matter for the oracle vote liveness
+	info, err := input.SlashingKeeper.OracleSigningInfos.Get(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, customtypes.NewOracleSigningInfo(consAddr, 1, 10, 0), info)
+
+	// 5 votes missed, which is allowed
+	for ; height <= 15; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		require.NoError(t, input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey.Address(), 10, false))
+	}
+
+	info, err = input.SlashingKeeper.OracleSigningInfos.Get(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(5), info.MissedVotesCounter)
+
+	missedVotes, err := input.SlashingKeeper.GetValidatorMissedOracleVotes(ctx, consAddr)
+	require.NoError(t, err)
+	require.Len(t, missedVotes, 5)
+
+	validator, err := input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.False(t, validator.IsJailed())
+
+	// one more vote missed
+	ctx = ctx.WithBlockHeight(height)
+	require.NoError(t, input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey.Address(), 10, false))
+
+	// validator should have been jailed and slashed
+	validator, err = input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.True(t, validator.IsJailed())
+	require.Equal(t, math.NewInt(9_000_000), validator.GetTokens().AmountOf(bondDenom))
+
+	signInfo, err := input.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), signInfo.JailedUntil)
+
+	// counter and bitmap should be reset
+	info, err = input.SlashingKeeper.OracleSigningInfos.Get(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.MissedVotesCounter)
+	require.Equal(t, int64(0), info.IndexOffset)
+
+	missedVotes, err = input.SlashingKeeper.GetValidatorMissedOracleVotes(ctx, consAddr)
+	require.NoError(t, err)
+	require.Empty(t, missedVotes)
+
+	// jailed validator is not tracked
+	ctx = ctx.WithBlockHeight(height + 1)
+	require.NoError(t, input.SlashingKeeper.HandleValidatorOracleVote(ctx, valPubKey.Address(), 10, false))
+
+	info, err = input.SlashingKeeper.OracleSigningInfos.Get(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, int64(0), info.IndexOffset)
+
+	staking.EndBlocker(ctx, input.StakingKeeper)
+	validator, err = input.StakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	require.Equal(t, stakingtypes.Unbonding, validator.GetStatus())
+}
+
+func TestUpdateOracleParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewCustomMsgServerImpl(input.SlashingKeeper)
+	params := customtypes.DefaultOracleParams()
+	params.SignedVotesWindow = 100
+
+	_, err := ms.UpdateOracleParams(ctx, customtypes.NewMsgUpdateOracleParams(sdk.AccAddress([]byte("invalid")).String(), params))
+	require.Error(t, err)
+
+	params.MinVotedPerWindow = math.LegacyNewDec(2)
+	_, err = ms.UpdateOracleParams(ctx, customtypes.NewMsgUpdateOracleParams(input.SlashingKeeper.GetAuthority(), params))
+	require.Error(t, err)
+
+	params.MinVotedPerWindow = math.LegacyNewDecWithPrec(9, 1)
+	_, err = ms.UpdateOracleParams(ctx, customtypes.NewMsgUpdateOracleParams(input.SlashingKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+
+	res, err := keeper.NewCustomQueryServerImpl(input.SlashingKeeper).OracleParams(ctx, &customtypes.QueryOracleParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, res.Params)
+}
+
+func TestRotateOracleSigningInfo(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	oldConsAddr := sdk.ConsAddress([]byte("old_cons_addr_______"))
+	newConsAddr := sdk.ConsAddress([]byte("new_cons_addr_______"))
+
+	// nothing to move
+	require.NoError(t, input.SlashingKeeper.RotateOracleSigningInfo(ctx, oldConsAddr, newConsAddr))
+
+	info := customtypes.NewOracleSigningInfo(oldConsAddr, 4, 3, 1)
+	require.NoError(t, input.SlashingKeeper.OracleSigningInfos.Set(ctx, oldConsAddr, info))
+	require.NoError(t, input.SlashingKeeper.SetMissedOracleVoteBitmapValue(ctx, oldConsAddr, 2, true))
+
+	require.NoError(t, input.SlashingKeeper.RotateOracleSigningInfo(ctx, oldConsAddr, newConsAddr))
+
+	found, err := input.SlashingKeeper.OracleSigningInfos.Has(ctx, oldConsAddr)
+	require.NoError(t, err)
+	require.False(t, found)
+
+	info, err = input.SlashingKeeper.OracleSigningInfos.Get(ctx, newConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, customtypes.NewOracleSigningInfo(newConsAddr, 4, 3, 1), info)
+
+	missed, err := input.SlashingKeeper.GetMissedOracleVoteBitmapValue(ctx, newConsAddr, 2)
+	require.NoError(t, err)
+	require.True(t, missed)
+
+	missed, err = input.SlashingKeeper.GetMissedOracleVoteBitmapValue(ctx, oldConsAddr, 2)
+	require.NoError(t, err)
+	require.False(t, missed)
+}
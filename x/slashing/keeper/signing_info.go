@@ -268,3 +268,54 @@ func (k Keeper) GetValidatorMissedBlocks(ctx context.Context, addr sdk.ConsAddre
 
 	return missedBlocks, err
 }
+
+// RotateValidatorSigningInfo moves the signing info and the missed block bitmap
+// of a validator from the rotated out consensus address to the new one.
+func (k Keeper) RotateValidatorSigningInfo(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	signInfo, err := k.GetValidatorSigningInfo(ctx, oldAddr)
+	if errors.IsOf(err, types.ErrNoSigningInfoFound) {
+		// the validator has never been bonded
+		return nil
+	} else if err != nil {
+		return err
+	}
+
+	signInfo.Address = newAddr.String()
+	if err := k.SetValidatorSigningInfo(ctx, newAddr, signInfo); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.ValidatorSigningInfoKey(oldAddr)); err != nil {
+		return err
+	}
+
+	oldPrefix := types.ValidatorMissedBlockBitmapPrefixKey(oldAddr)
+	newPrefix := types.ValidatorMissedBlockBitmapPrefixKey(newAddr)
+	iter, err := store.Iterator(oldPrefix, storetypes.PrefixEndBytes(oldPrefix))
+	if err != nil {
+		return err
+	}
+
+	var keys, chunks [][]byte
+	for ; iter.Valid(); iter.Next() {
+		keys = append(keys, iter.Key())
+		chunks = append(chunks, iter.Value())
+	}
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	for i, key := range keys {
+		newKey := append(append([]byte{}, newPrefix...), key[len(oldPrefix):]...)
+		if err := store.Set(newKey, chunks[i]); err != nil {
+			return err
+		}
+
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -160,3 +160,36 @@ func TestJailUntil(t *testing.T) {
 	require.NoError(t, err)
 	require.Equal(t, time.Unix(253402300799, 0).UTC(), info.JailedUntil)
 }
+
+func TestRotateValidatorSigningInfo(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	ctx = ctx.WithBlockHeight(1)
+
+	oldConsAddr := sdk.ConsAddress([]byte("old_cons_addr_______"))
+	newConsAddr := sdk.ConsAddress([]byte("new_cons_addr_______"))
+
+	// nothing to move
+	require.NoError(t, input.SlashingKeeper.RotateValidatorSigningInfo(ctx, oldConsAddr, newConsAddr))
+	require.False(t, input.SlashingKeeper.HasValidatorSigningInfo(ctx, newConsAddr))
+
+	info := types.NewValidatorSigningInfo(oldConsAddr, int64(4), int64(3), time.Unix(2, 0), false, int64(1))
+	require.NoError(t, input.SlashingKeeper.SetValidatorSigningInfo(ctx, oldConsAddr, info))
+	require.NoError(t, input.SlashingKeeper.SetMissedBlockBitmapValue(ctx, oldConsAddr, 2, true))
+
+	require.NoError(t, input.SlashingKeeper.RotateValidatorSigningInfo(ctx, oldConsAddr, newConsAddr))
+	require.False(t, input.SlashingKeeper.HasValidatorSigningInfo(ctx, oldConsAddr))
+
+	info, err := input.SlashingKeeper.GetValidatorSigningInfo(ctx, newConsAddr)
+	require.NoError(t, err)
+	require.Equal(t, newConsAddr.String(), info.Address)
+	require.Equal(t, int64(4), info.StartHeight)
+	require.Equal(t, int64(1), info.MissedBlocksCounter)
+
+	missed, err := input.SlashingKeeper.GetMissedBlockBitmapValue(ctx, newConsAddr, 2)
+	require.NoError(t, err)
+	require.True(t, missed)
+
+	missed, err = input.SlashingKeeper.GetMissedBlockBitmapValue(ctx, oldConsAddr, 2)
+	require.NoError(t, err)
+	require.False(t, missed)
+}
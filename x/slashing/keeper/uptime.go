@@ -0,0 +1,139 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"github.com/bits-and-blooms/bitset"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+// GetUptimeParams returns the uptime history parameters. The history is
+// disabled until the parameters are set.
+func (k Keeper) GetUptimeParams(ctx context.Context) (customtypes.UptimeParams, error) {
+	params, err := k.UptimeParams.Get(ctx)
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		return customtypes.UptimeParams{}, nil
+	}
+
+	return params, err
+}
+
+// RecordEpochUptime counts the block signature of a validator into the uptime
+// of the current epoch. The epochs older than the retained epochs are pruned
+// when the validator enters a new epoch.
+func (k Keeper) RecordEpochUptime(ctx context.Context, addr sdk.ConsAddress, missed bool) error {
+	params, err := k.GetUptimeParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.Enabled() {
+		return nil
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	epoch := params.Epoch(height)
+
+	uptime, err := k.EpochUptimes.Get(ctx, collections.Join(addr, epoch))
+	if err != nil && errors.Is(err, collections.ErrNotFound) {
+		uptime = customtypes.EpochUptime{Epoch: epoch, StartHeight: height}
+		if err := k.pruneEpochUptimes(ctx, addr, epoch, params.RetainedEpochs); err != nil {
+			return err
+		}
+	} else if err != nil {
+		return err
+	}
+
+	if missed {
+		uptime.MissedBlocks++
+	} else {
+		uptime.SignedBlocks++
+	}
+
+	return k.EpochUptimes.Set(ctx, collections.Join(addr, epoch), uptime)
+}
+
+// pruneEpochUptimes removes the epoch uptimes of a validator which fall out of
+// the retained epochs ending at the given epoch.
+func (k Keeper) pruneEpochUptimes(ctx context.Context, addr sdk.ConsAddress, epoch, retainedEpochs uint64) error {
+	if epoch < retainedEpochs {
+		return nil
+	}
+
+	ranger := collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](addr).EndInclusive(epoch - retainedEpochs)
+	return k.EpochUptimes.Clear(ctx, ranger)
+}
+
+// GetValidatorUptimeHistory returns the retained epoch uptimes of a validator in ascending order.
+func (k Keeper) GetValidatorUptimeHistory(ctx context.Context, addr sdk.ConsAddress) ([]customtypes.EpochUptime, error) {
+	epochs := []customtypes.EpochUptime{}
+	err := k.EpochUptimes.Walk(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](addr), func(_ collections.Pair[sdk.ConsAddress, uint64], uptime customtypes.EpochUptime) (stop bool, err error) {
+		epochs = append(epochs, uptime)
+		return false, nil
+	})
+
+	return epochs, err
+}
+
+// GetMissedBlockBitmap returns the missed block bitmap of a validator for the
+// current signed blocks window, where the bit (i % 8) of the byte (i / 8) is set
+// if the validator missed the block at index i.
+func (k Keeper) GetMissedBlockBitmap(ctx context.Context, addr sdk.ConsAddress) ([]byte, error) {
+	signedBlocksWindow, err := k.SignedBlocksWindow(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	bitmap := make([]byte, (signedBlocksWindow+7)/8)
+	for chunkIndex := int64(0); chunkIndex*types.MissedBlockBitmapChunkSize < signedBlocksWindow; chunkIndex++ {
+		chunk, err := k.getMissedBlockBitmapChunk(ctx, addr, chunkIndex)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to get bitmap chunk; chunk index: %d", chunkIndex)
+		}
+		if chunk == nil {
+			continue
+		}
+
+		bs := bitset.New(uint(types.MissedBlockBitmapChunkSize))
+		if err := bs.UnmarshalBinary(chunk); err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to decode bitmap chunk; chunk index: %d", chunkIndex)
+		}
+
+		for i := uint(0); i < types.MissedBlockBitmapChunkSize; i++ {
+			index := chunkIndex*types.MissedBlockBitmapChunkSize + int64(i)
+			if index >= signedBlocksWindow {
+				break
+			}
+
+			if bs.Test(i) {
+				bitmap[index/8] |= 1 << (index % 8)
+			}
+		}
+	}
+
+	return bitmap, nil
+}
+
+// RotateUptimeHistory moves the uptime history of a validator from the rotated
+// out consensus address to the new one.
+func (k Keeper) RotateUptimeHistory(ctx context.Context, oldAddr, newAddr sdk.ConsAddress) error {
+	epochs, err := k.GetValidatorUptimeHistory(ctx, oldAddr)
+	if err != nil {
+		return err
+	}
+
+	for _, uptime := range epochs {
+		if err := k.EpochUptimes.Set(ctx, collections.Join(newAddr, uptime.Epoch), uptime); err != nil {
+			return err
+		}
+	}
+
+	return k.EpochUptimes.Clear(ctx, collections.NewPrefixedPairRange[sdk.ConsAddress, uint64](oldAddr))
+}
@@ -0,0 +1,115 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/core/comet"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	staking "github.com/initia-labs/initia/x/mstaking"
+	"github.com/initia-labs/initia/x/slashing/keeper"
+	customtypes "github.com/initia-labs/initia/x/slashing/types"
+)
+
+func TestRecordEpochUptime_Disabled(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 10_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	err := input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), 10, comet.BlockIDFlagCommit)
+	require.NoError(t, err)
+
+	history, err := input.SlashingKeeper.GetValidatorUptimeHistory(ctx, sdk.ConsAddress(valPubKey.Address()))
+	require.NoError(t, err)
+	require.Empty(t, history)
+}
+
+func TestRecordEpochUptime(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+	require.NoError(t, input.SlashingKeeper.UptimeParams.Set(ctx, customtypes.NewUptimeParams(10, 2)))
+
+	ctx = ctx.WithBlockHeight(1)
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 10_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	consAddr := sdk.ConsAddress(valPubKey.Address())
+
+	// miss every third block from the height 1 to 25
+	for height := int64(1); height <= 25; height++ {
+		ctx = ctx.WithBlockHeight(height)
+
+		signed := comet.BlockIDFlagCommit
+		if height%3 == 0 {
+			signed = comet.BlockIDFlagAbsent
+		}
+		require.NoError(t, input.SlashingKeeper.HandleValidatorSignature(ctx, valPubKey.Address(), 10, signed))
+	}
+
+	// epoch 0 is pruned when entering epoch 2
+	history, err := input.SlashingKeeper.GetValidatorUptimeHistory(ctx, consAddr)
+	require.NoError(t, err)
+	require.Equal(t, []customtypes.EpochUptime{
+		{Epoch: 1, StartHeight: 10, SignedBlocks: 7, MissedBlocks: 3},
+		{Epoch: 2, StartHeight: 20, SignedBlocks: 4, MissedBlocks: 2},
+	}, history)
+
+	// the history follows the consensus key rotation
+	newPubKey := ed25519.GenPrivKey().PubKey()
+	require.NoError(t, input.SlashingKeeper.Hooks().AfterConsensusPubKeyUpdate(ctx, nil, valPubKey, newPubKey, nil))
+
+	history, err = input.SlashingKeeper.GetValidatorUptimeHistory(ctx, consAddr)
+	require.NoError(t, err)
+	require.Empty(t, history)
+
+	history, err = input.SlashingKeeper.GetValidatorUptimeHistory(ctx, sdk.ConsAddress(newPubKey.Address()))
+	require.NoError(t, err)
+	require.Len(t, history, 2)
+}
+
+func TestGetMissedBlockBitmap(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	_, valPubKey := createValidatorWithBalanceAndGetPk(ctx, input, 100_000_000, 10_000_000, 1)
+	staking.EndBlocker(ctx, input.StakingKeeper)
+
+	consAddr := sdk.ConsAddress(valPubKey.Address())
+	for _, index := range []int64{0, 9, 99} {
+		require.NoError(t, input.SlashingKeeper.SetMissedBlockBitmapValue(ctx, consAddr, index, true))
+	}
+
+	bitmap, err := input.SlashingKeeper.GetMissedBlockBitmap(ctx, consAddr)
+	require.NoError(t, err)
+
+	// the signed blocks window is 100 blocks
+	expected := make([]byte, 13)
+	expected[0] = 0b00000001
+	expected[1] = 0b00000010
+	expected[12] = 0b00001000
+	require.Equal(t, expected, bitmap)
+}
+
+func TestUpdateUptimeParams(t *testing.T) {
+	ctx, input := createDefaultTestInput(t)
+
+	ms := keeper.NewCustomMsgServerImpl(input.SlashingKeeper)
+
+	params := customtypes.NewUptimeParams(100, 0)
+	_, err := ms.UpdateUptimeParams(ctx, customtypes.NewMsgUpdateUptimeParams(input.SlashingKeeper.GetAuthority(), params))
+	require.Error(t, err)
+
+	params = customtypes.NewUptimeParams(100, 10)
+	_, err = ms.UpdateUptimeParams(ctx, customtypes.NewMsgUpdateUptimeParams("invalid", params))
+	require.Error(t, err)
+
+	_, err = ms.UpdateUptimeParams(ctx, customtypes.NewMsgUpdateUptimeParams(input.SlashingKeeper.GetAuthority(), params))
+	require.NoError(t, err)
+
+	res, err := input.SlashingKeeper.GetUptimeParams(ctx)
+	require.NoError(t, err)
+	require.Equal(t, params, res)
+}
@@ -0,0 +1,30 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
+	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
+)
+
+// RegisterLegacyAminoCodec registers the initia specific x/slashing types
+// on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateOracleParams{}, "slashing/MsgUpdateOracleParams")
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateUptimeParams{}, "slashing/MsgUpdateUptimeParams")
+
+	cdc.RegisterConcrete(OracleParams{}, "slashing/OracleParams", nil)
+	cdc.RegisterConcrete(UptimeParams{}, "slashing/UptimeParams", nil)
+}
+
+// RegisterInterfaces registers the initia specific x/slashing interfaces types
+// with the interface registry
+func RegisterInterfaces(registry types.InterfaceRegistry) {
+	registry.RegisterImplementations((*sdk.Msg)(nil),
+		&MsgUpdateOracleParams{},
+		&MsgUpdateUptimeParams{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
+}
@@ -0,0 +1,10 @@
+package types
+
+// Slashing module event types
+const (
+	EventTypeOracleLiveness = "oracle_liveness"
+
+	AttributeKeyMissedOracleVotes = "missed_oracle_votes"
+
+	AttributeValueMissingOracleVote = "missing_oracle_vote"
+)
@@ -10,6 +10,8 @@ import (
 	stakingtypes "github.com/initia-labs/initia/x/mstaking/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	marketmaptypes "github.com/skip-mev/slinky/x/marketmap/types"
 )
 
 // StakingKeeper expected staking keeper
@@ -44,3 +46,8 @@ type StakingKeeper interface {
 	// VotingPowerToConsensusPower converts voting power to consensus power
 	VotingPowerToConsensusPower(ctx context.Context, votingPower math.Int) int64
 }
+
+// MarketMapKeeper expected market map keeper
+type MarketMapKeeper interface {
+	GetAllMarkets(ctx sdk.Context) (map[string]marketmaptypes.Market, error)
+}
@@ -0,0 +1,57 @@
+package types
+
+import (
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/x/slashing/types"
+)
+
+// NewGenesisState creates a new GenesisState object
+func NewGenesisState(
+	params types.Params, signingInfos []types.SigningInfo, missedBlocks []types.ValidatorMissedBlocks,
+	oracleParams OracleParams, oracleSigningInfos []OracleSigningInfo, oracleMissedVotes []ValidatorMissedOracleVotes,
+	uptimeParams UptimeParams, uptimeHistories []ValidatorUptimeHistory,
+) *GenesisState {
+	return &GenesisState{
+		Params:             params,
+		SigningInfos:       signingInfos,
+		MissedBlocks:       missedBlocks,
+		OracleParams:       oracleParams,
+		OracleSigningInfos: oracleSigningInfos,
+		OracleMissedVotes:  oracleMissedVotes,
+		UptimeParams:       uptimeParams,
+		UptimeHistories:    uptimeHistories,
+	}
+}
+
+// DefaultGenesisState creates a default GenesisState object
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params:             types.DefaultParams(),
+		SigningInfos:       []types.SigningInfo{},
+		MissedBlocks:       []types.ValidatorMissedBlocks{},
+		OracleParams:       DefaultOracleParams(),
+		OracleSigningInfos: []OracleSigningInfo{},
+		OracleMissedVotes:  []ValidatorMissedOracleVotes{},
+		UptimeParams:       DefaultUptimeParams(),
+		UptimeHistories:    []ValidatorUptimeHistory{},
+	}
+}
+
+// ValidateGenesis validates the provided genesis state to ensure the
+// expected invariants holds.
+func ValidateGenesis(data GenesisState) error {
+	if err := types.ValidateGenesis(*types.NewGenesisState(data.Params, data.SigningInfos, data.MissedBlocks)); err != nil {
+		return err
+	}
+
+	if err := data.OracleParams.Validate(); err != nil {
+		return fmt.Errorf("invalid oracle params: %w", err)
+	}
+
+	if err := data.UptimeParams.Validate(); err != nil {
+		return fmt.Errorf("invalid uptime params: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,1241 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/genesis.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	types "github.com/cosmos/cosmos-sdk/x/slashing/types"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// GenesisState defines the slashing module's genesis state, which extends the
+// cosmos slashing genesis state with the oracle vote liveness states.
+type GenesisState struct {
+	// params defines all the parameters of the module.
+	Params types.Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// signing_infos represents a map between validator addresses and their
+	// signing infos.
+	SigningInfos []types.SigningInfo `protobuf:"bytes,2,rep,name=signing_infos,json=signingInfos,proto3" json:"signing_infos"`
+	// missed_blocks represents a map between validator addresses and their
+	// missed blocks.
+	MissedBlocks []types.ValidatorMissedBlocks `protobuf:"bytes,3,rep,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks"`
+	// oracle_params defines the parameters of the oracle vote liveness tracking.
+	OracleParams OracleParams `protobuf:"bytes,4,opt,name=oracle_params,json=oracleParams,proto3" json:"oracle_params"`
+	// oracle_signing_infos represents the oracle vote liveness infos of validators.
+	OracleSigningInfos []OracleSigningInfo `protobuf:"bytes,5,rep,name=oracle_signing_infos,json=oracleSigningInfos,proto3" json:"oracle_signing_infos"`
+	// oracle_missed_votes represents a map between validator addresses and their
+	// missed oracle votes.
+	OracleMissedVotes []ValidatorMissedOracleVotes `protobuf:"bytes,6,rep,name=oracle_missed_votes,json=oracleMissedVotes,proto3" json:"oracle_missed_votes"`
+	// uptime_params defines the parameters of the per-epoch uptime history.
+	UptimeParams UptimeParams `protobuf:"bytes,7,opt,name=uptime_params,json=uptimeParams,proto3" json:"uptime_params"`
+	// uptime_histories represents the per-epoch uptime histories of validators.
+	UptimeHistories []ValidatorUptimeHistory `protobuf:"bytes,8,rep,name=uptime_histories,json=uptimeHistories,proto3" json:"uptime_histories"`
+}
+
+func (m *GenesisState) Reset()         { *m = GenesisState{} }
+func (m *GenesisState) String() string { return proto.CompactTextString(m) }
+func (*GenesisState) ProtoMessage()    {}
+func (*GenesisState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_441346ed3f1003ad, []int{0}
+}
+func (m *GenesisState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GenesisState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GenesisState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GenesisState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GenesisState.Merge(m, src)
+}
+func (m *GenesisState) XXX_Size() int {
+	return m.Size()
+}
+func (m *GenesisState) XXX_DiscardUnknown() {
+	xxx_messageInfo_GenesisState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GenesisState proto.InternalMessageInfo
+
+func (m *GenesisState) GetParams() types.Params {
+	if m != nil {
+		return m.Params
+	}
+	return types.Params{}
+}
+
+func (m *GenesisState) GetSigningInfos() []types.SigningInfo {
+	if m != nil {
+		return m.SigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMissedBlocks() []types.ValidatorMissedBlocks {
+	if m != nil {
+		return m.MissedBlocks
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleParams() OracleParams {
+	if m != nil {
+		return m.OracleParams
+	}
+	return OracleParams{}
+}
+
+func (m *GenesisState) GetOracleSigningInfos() []OracleSigningInfo {
+	if m != nil {
+		return m.OracleSigningInfos
+	}
+	return nil
+}
+
+func (m *GenesisState) GetOracleMissedVotes() []ValidatorMissedOracleVotes {
+	if m != nil {
+		return m.OracleMissedVotes
+	}
+	return nil
+}
+
+func (m *GenesisState) GetUptimeParams() UptimeParams {
+	if m != nil {
+		return m.UptimeParams
+	}
+	return UptimeParams{}
+}
+
+func (m *GenesisState) GetUptimeHistories() []ValidatorUptimeHistory {
+	if m != nil {
+		return m.UptimeHistories
+	}
+	return nil
+}
+
+// ValidatorMissedOracleVotes contains the missed oracle votes of a validator.
+type ValidatorMissedOracleVotes struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// missed_votes is an array of missed oracle votes by the validator.
+	MissedVotes []types.MissedBlock `protobuf:"bytes,2,rep,name=missed_votes,json=missedVotes,proto3" json:"missed_votes"`
+}
+
+func (m *ValidatorMissedOracleVotes) Reset()         { *m = ValidatorMissedOracleVotes{} }
+func (m *ValidatorMissedOracleVotes) String() string { return proto.CompactTextString(m) }
+func (*ValidatorMissedOracleVotes) ProtoMessage()    {}
+func (*ValidatorMissedOracleVotes) Descriptor() ([]byte, []int) {
+	return fileDescriptor_441346ed3f1003ad, []int{1}
+}
+func (m *ValidatorMissedOracleVotes) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorMissedOracleVotes) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorMissedOracleVotes.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorMissedOracleVotes) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorMissedOracleVotes.Merge(m, src)
+}
+func (m *ValidatorMissedOracleVotes) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorMissedOracleVotes) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorMissedOracleVotes.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorMissedOracleVotes proto.InternalMessageInfo
+
+func (m *ValidatorMissedOracleVotes) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorMissedOracleVotes) GetMissedVotes() []types.MissedBlock {
+	if m != nil {
+		return m.MissedVotes
+	}
+	return nil
+}
+
+// ValidatorUptimeHistory contains the per-epoch uptime history of a validator.
+type ValidatorUptimeHistory struct {
+	// address is the validator consensus address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// epochs is an array of the retained epoch uptimes of the validator.
+	Epochs []EpochUptime `protobuf:"bytes,2,rep,name=epochs,proto3" json:"epochs"`
+}
+
+func (m *ValidatorUptimeHistory) Reset()         { *m = ValidatorUptimeHistory{} }
+func (m *ValidatorUptimeHistory) String() string { return proto.CompactTextString(m) }
+func (*ValidatorUptimeHistory) ProtoMessage()    {}
+func (*ValidatorUptimeHistory) Descriptor() ([]byte, []int) {
+	return fileDescriptor_441346ed3f1003ad, []int{2}
+}
+func (m *ValidatorUptimeHistory) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ValidatorUptimeHistory) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ValidatorUptimeHistory.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ValidatorUptimeHistory) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidatorUptimeHistory.Merge(m, src)
+}
+func (m *ValidatorUptimeHistory) XXX_Size() int {
+	return m.Size()
+}
+func (m *ValidatorUptimeHistory) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidatorUptimeHistory.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ValidatorUptimeHistory proto.InternalMessageInfo
+
+func (m *ValidatorUptimeHistory) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *ValidatorUptimeHistory) GetEpochs() []EpochUptime {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*GenesisState)(nil), "initia.slashing.v1.GenesisState")
+	proto.RegisterType((*ValidatorMissedOracleVotes)(nil), "initia.slashing.v1.ValidatorMissedOracleVotes")
+	proto.RegisterType((*ValidatorUptimeHistory)(nil), "initia.slashing.v1.ValidatorUptimeHistory")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/genesis.proto", fileDescriptor_441346ed3f1003ad) }
+
+var fileDescriptor_441346ed3f1003ad = []byte{
+	// 528 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x94, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x1b, 0xc6, 0x3a, 0xe6, 0xb6, 0x82, 0x99, 0x09, 0x45, 0x3d, 0xa4, 0x55, 0xc5, 0xd0,
+	0x34, 0x09, 0x47, 0x1b, 0x6f, 0x10, 0x09, 0x31, 0x0e, 0x88, 0xa9, 0x83, 0x1d, 0x38, 0x50, 0x9c,
+	0xd4, 0x4b, 0x2d, 0x92, 0x38, 0xca, 0xe7, 0x56, 0xec, 0x15, 0x38, 0xf1, 0x18, 0x1c, 0x79, 0x8c,
+	0x1d, 0x77, 0xe4, 0x02, 0x42, 0xed, 0x81, 0xd7, 0x40, 0xb5, 0xdd, 0xd5, 0xed, 0x92, 0x72, 0xa9,
+	0x5c, 0x7f, 0xff, 0xef, 0xf7, 0xfd, 0xfd, 0xaf, 0x6b, 0xd4, 0xe5, 0x19, 0x97, 0x9c, 0xfa, 0x90,
+	0x50, 0x18, 0xf1, 0x2c, 0xf6, 0x27, 0xc7, 0x7e, 0xcc, 0x32, 0x06, 0x1c, 0x48, 0x5e, 0x08, 0x29,
+	0x30, 0xd6, 0x0a, 0xb2, 0x50, 0x90, 0xc9, 0x71, 0x7b, 0x8f, 0xa6, 0x3c, 0x13, 0xbe, 0xfa, 0xd4,
+	0xb2, 0xf6, 0x41, 0x24, 0x20, 0x15, 0x60, 0x83, 0x42, 0x26, 0xe9, 0x1a, 0xad, 0xfd, 0xac, 0x4a,
+	0x76, 0x8b, 0xd7, 0xba, 0xfd, 0x58, 0xc4, 0x42, 0x2d, 0xfd, 0xf9, 0xca, 0xec, 0x7a, 0x25, 0x6e,
+	0xe5, 0x55, 0xce, 0x0c, 0xbd, 0xf7, 0x6b, 0x1b, 0x35, 0x5f, 0xe9, 0x79, 0xe7, 0x92, 0x4a, 0x86,
+	0x03, 0x54, 0xcf, 0x69, 0x41, 0x53, 0x70, 0x9d, 0xae, 0x73, 0xd8, 0x38, 0xe9, 0x10, 0x3d, 0xdf,
+	0x3e, 0x8d, 0x9a, 0x4f, 0xce, 0x94, 0x2c, 0xd8, 0xbd, 0xfe, 0xdd, 0xa9, 0x7d, 0xff, 0xfb, 0xe3,
+	0xc8, 0xe9, 0x9b, 0x4e, 0xfc, 0x0e, 0xb5, 0x80, 0xc7, 0x19, 0xcf, 0xe2, 0x01, 0xcf, 0x2e, 0x05,
+	0xb8, 0xf7, 0xba, 0x5b, 0x87, 0x8d, 0x93, 0xa7, 0x95, 0xa8, 0x73, 0xad, 0x7e, 0x9d, 0x5d, 0x0a,
+	0x9b, 0xd7, 0x84, 0xe5, 0x3e, 0xe0, 0x8f, 0xa8, 0x95, 0x72, 0x00, 0x36, 0x1c, 0x84, 0x89, 0x88,
+	0x3e, 0x83, 0xbb, 0xa5, 0xa8, 0xa4, 0x92, 0x7a, 0x41, 0x13, 0x3e, 0xa4, 0x52, 0x14, 0x6f, 0x54,
+	0x5b, 0xa0, 0xba, 0x56, 0xf8, 0xa9, 0x55, 0xc0, 0x67, 0xa8, 0x25, 0x0a, 0x1a, 0x25, 0x6c, 0x60,
+	0x02, 0xb8, 0xaf, 0x02, 0xe8, 0x92, 0xbb, 0x3f, 0x27, 0x79, 0xab, 0x84, 0x77, 0x13, 0x68, 0x0a,
+	0xab, 0x80, 0x43, 0xb4, 0x6f, 0x88, 0xab, 0x71, 0x6c, 0x2b, 0xe3, 0x07, 0xd5, 0xe0, 0x8a, 0x3c,
+	0xb0, 0x58, 0xaf, 0x02, 0xe6, 0xe8, 0xb1, 0x99, 0x61, 0xc2, 0x99, 0x08, 0xc9, 0xc0, 0xad, 0x9b,
+	0x6c, 0x4a, 0x46, 0xac, 0xc5, 0xa2, 0x27, 0x5e, 0xcc, 0xbb, 0xec, 0x59, 0x7b, 0x9a, 0xaa, 0x35,
+	0xaa, 0x3a, 0x0f, 0x68, 0x9c, 0x4b, 0x9e, 0xde, 0x06, 0xb4, 0x53, 0x1d, 0xd0, 0x7b, 0x25, 0x2c,
+	0x09, 0x68, 0x6c, 0x15, 0xf0, 0x27, 0xf4, 0xc8, 0x10, 0x47, 0x1c, 0xa4, 0x28, 0x38, 0x03, 0xf7,
+	0x81, 0x72, 0x7e, 0xb4, 0xd1, 0xb9, 0xa6, 0x9f, 0xaa, 0x9e, 0x2b, 0x1b, 0xff, 0x70, 0x6c, 0x55,
+	0x38, 0x83, 0xde, 0x57, 0x07, 0xb5, 0xab, 0x0f, 0x8c, 0x5d, 0xb4, 0x43, 0x87, 0xc3, 0x82, 0x81,
+	0xbe, 0xee, 0xbb, 0xfd, 0xc5, 0x57, 0xdc, 0x47, 0xcd, 0x95, 0x40, 0xff, 0x77, 0x85, 0xad, 0x3b,
+	0x66, 0x1b, 0x6a, 0xa4, 0xcb, 0x00, 0x7b, 0x13, 0xf4, 0xa4, 0xfc, 0x08, 0x1b, 0x7c, 0x04, 0xa8,
+	0xce, 0x72, 0x11, 0x8d, 0x16, 0x0e, 0x3a, 0x65, 0xc1, 0xbc, 0x9c, 0x2b, 0x34, 0x71, 0xe5, 0xff,
+	0xa8, 0x3b, 0x83, 0xd3, 0xeb, 0xa9, 0xe7, 0xdc, 0x4c, 0x3d, 0xe7, 0xcf, 0xd4, 0x73, 0xbe, 0xcd,
+	0xbc, 0xda, 0xcd, 0xcc, 0xab, 0xfd, 0x9c, 0x79, 0xb5, 0x0f, 0x24, 0xe6, 0x72, 0x34, 0x0e, 0x49,
+	0x24, 0x52, 0x5f, 0x73, 0x9f, 0x27, 0x34, 0x04, 0xb3, 0xf6, 0xbf, 0x2c, 0xdf, 0x0d, 0xf5, 0x68,
+	0x84, 0x75, 0xf5, 0x6a, 0xbc, 0xf8, 0x37, 0x00, 0xd7, 0xf4, 0xb8, 0xb0, 0x05, 0x05, 0x00, 0x00,
+}
+
+func (m *GenesisState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GenesisState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.UptimeHistories) > 0 {
+		for iNdEx := len(m.UptimeHistories) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.UptimeHistories[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size, err := m.UptimeParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if len(m.OracleMissedVotes) > 0 {
+		for iNdEx := len(m.OracleMissedVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleMissedVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.OracleSigningInfos) > 0 {
+		for iNdEx := len(m.OracleSigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.OracleSigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	{
+		size, err := m.OracleParams.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.MissedBlocks) > 0 {
+		for iNdEx := len(m.MissedBlocks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedBlocks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.SigningInfos) > 0 {
+		for iNdEx := len(m.SigningInfos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SigningInfos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorMissedOracleVotes) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorMissedOracleVotes) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorMissedOracleVotes) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MissedVotes) > 0 {
+		for iNdEx := len(m.MissedVotes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MissedVotes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ValidatorUptimeHistory) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ValidatorUptimeHistory) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ValidatorUptimeHistory) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
+	offset -= sovGenesis(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *GenesisState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.SigningInfos) > 0 {
+		for _, e := range m.SigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MissedBlocks) > 0 {
+		for _, e := range m.MissedBlocks {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.OracleParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.OracleSigningInfos) > 0 {
+		for _, e := range m.OracleSigningInfos {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.OracleMissedVotes) > 0 {
+		for _, e := range m.OracleMissedVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.UptimeParams.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.UptimeHistories) > 0 {
+		for _, e := range m.UptimeHistories {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorMissedOracleVotes) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.MissedVotes) > 0 {
+		for _, e := range m.MissedVotes {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ValidatorUptimeHistory) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovGenesis(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozGenesis(x uint64) (n int) {
+	return sovGenesis(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *GenesisState) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GenesisState: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GenesisState: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SigningInfos = append(m.SigningInfos, types.SigningInfo{})
+			if err := m.SigningInfos[len(m.SigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedBlocks = append(m.MissedBlocks, types.ValidatorMissedBlocks{})
+			if err := m.MissedBlocks[len(m.MissedBlocks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OracleParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleSigningInfos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleSigningInfos = append(m.OracleSigningInfos, OracleSigningInfo{})
+			if err := m.OracleSigningInfos[len(m.OracleSigningInfos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleMissedVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleMissedVotes = append(m.OracleMissedVotes, ValidatorMissedOracleVotes{})
+			if err := m.OracleMissedVotes[len(m.OracleMissedVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UptimeParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UptimeHistories", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.UptimeHistories = append(m.UptimeHistories, ValidatorUptimeHistory{})
+			if err := m.UptimeHistories[len(m.UptimeHistories)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorMissedOracleVotes) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorMissedOracleVotes: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorMissedOracleVotes: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MissedVotes = append(m.MissedVotes, types.MissedBlock{})
+			if err := m.MissedVotes[len(m.MissedVotes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ValidatorUptimeHistory) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ValidatorUptimeHistory: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ValidatorUptimeHistory: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochUptime{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipGenesis(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthGenesis
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupGenesis
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthGenesis
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthGenesis        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowGenesis          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupGenesis = fmt.Errorf("proto: unexpected end of group")
+)
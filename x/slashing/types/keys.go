@@ -0,0 +1,11 @@
+package types
+
+// The cosmos slashing module uses the 0x00 ~ 0x03 prefixes, so the oracle vote
+// liveness and the uptime history states are stored after them.
+var (
+	OracleParamsKey                 = []byte{0x11} // Key to store the oracle vote liveness params
+	OracleSigningInfoKeyPrefix      = []byte{0x12} // Prefix for the oracle signing info
+	OracleMissedVoteBitmapKeyPrefix = []byte{0x13} // Prefix for the missed oracle vote bitmap
+	UptimeParamsKey                 = []byte{0x14} // Key to store the uptime history params
+	EpochUptimeKeyPrefix            = []byte{0x15} // Prefix for the per-epoch uptime history
+)
@@ -0,0 +1,155 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	"gopkg.in/yaml.v3"
+
+	"github.com/pkg/errors"
+)
+
+// Default oracle parameter values; the tracking is disabled by default
+// until the governance enables it with the window.
+const (
+	DefaultSignedVotesWindow          = int64(0)
+	DefaultOracleDowntimeJailDuration = 60 * 10 * time.Second
+)
+
+// Default uptime parameter values
+const (
+	DefaultUptimeEpochLength    = int64(10000)
+	DefaultUptimeRetainedEpochs = uint64(30)
+)
+
+var (
+	DefaultMinVotedPerWindow           = math.LegacyNewDecWithPrec(5, 1) // 50%
+	DefaultOracleSlashFractionDowntime = math.LegacyZeroDec()
+)
+
+func NewOracleParams(
+	signedVotesWindow int64, minVotedPerWindow math.LegacyDec,
+	downtimeJailDuration time.Duration, slashFractionDowntime math.LegacyDec,
+) OracleParams {
+	return OracleParams{
+		SignedVotesWindow:     signedVotesWindow,
+		MinVotedPerWindow:     minVotedPerWindow,
+		DowntimeJailDuration:  downtimeJailDuration,
+		SlashFractionDowntime: slashFractionDowntime,
+	}
+}
+
+// DefaultOracleParams returns default oracle vote liveness parameters
+func DefaultOracleParams() OracleParams {
+	return NewOracleParams(
+		DefaultSignedVotesWindow,
+		DefaultMinVotedPerWindow,
+		DefaultOracleDowntimeJailDuration,
+		DefaultOracleSlashFractionDowntime,
+	)
+}
+
+// String returns a human readable string representation of the parameters.
+func (p OracleParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Enabled returns true if the oracle vote liveness tracking is enabled.
+func (p OracleParams) Enabled() bool {
+	return p.SignedVotesWindow > 0
+}
+
+// MinVotedPerWindowInt returns the minimum number of oracle votes per window.
+func (p OracleParams) MinVotedPerWindowInt() int64 {
+	// NOTE: RoundInt64 will never panic as MinVotedPerWindow is
+	//       less than 1.
+	return p.MinVotedPerWindow.MulInt64(p.SignedVotesWindow).RoundInt64()
+}
+
+// Validate performs basic validation on oracle vote liveness parameters.
+// The zero window disables the tracking, so the other parameters are not validated.
+func (p OracleParams) Validate() error {
+	if p.SignedVotesWindow < 0 {
+		return fmt.Errorf("signed votes window must not be negative: %d", p.SignedVotesWindow)
+	}
+
+	if !p.Enabled() {
+		return nil
+	}
+
+	if err := validateFraction(p.MinVotedPerWindow); err != nil {
+		return errors.Wrap(err, "invalid min voted per window")
+	}
+
+	if p.DowntimeJailDuration < 0 {
+		return fmt.Errorf("downtime jail duration must not be negative: %s", p.DowntimeJailDuration)
+	}
+
+	if err := validateFraction(p.SlashFractionDowntime); err != nil {
+		return errors.Wrap(err, "invalid slash fraction downtime")
+	}
+
+	return nil
+}
+
+func NewUptimeParams(epochLength int64, retainedEpochs uint64) UptimeParams {
+	return UptimeParams{
+		EpochLength:    epochLength,
+		RetainedEpochs: retainedEpochs,
+	}
+}
+
+// DefaultUptimeParams returns default uptime history parameters
+func DefaultUptimeParams() UptimeParams {
+	return NewUptimeParams(DefaultUptimeEpochLength, DefaultUptimeRetainedEpochs)
+}
+
+// String returns a human readable string representation of the parameters.
+func (p UptimeParams) String() string {
+	out, _ := yaml.Marshal(p)
+	return string(out)
+}
+
+// Enabled returns true if the uptime history is enabled.
+func (p UptimeParams) Enabled() bool {
+	return p.EpochLength > 0
+}
+
+// Epoch returns the uptime epoch of the given height.
+func (p UptimeParams) Epoch(height int64) uint64 {
+	return uint64(height / p.EpochLength)
+}
+
+// Validate performs basic validation on uptime history parameters.
+// The zero epoch length disables the history, so the retention is not validated.
+func (p UptimeParams) Validate() error {
+	if p.EpochLength < 0 {
+		return fmt.Errorf("epoch length must not be negative: %d", p.EpochLength)
+	}
+
+	if !p.Enabled() {
+		return nil
+	}
+
+	if p.RetainedEpochs == 0 {
+		return fmt.Errorf("retained epochs must be positive")
+	}
+
+	return nil
+}
+
+func validateFraction(v math.LegacyDec) error {
+	if v.IsNil() {
+		return fmt.Errorf("fraction must be not nil")
+	}
+	if v.IsNegative() {
+		return fmt.Errorf("fraction cannot be negative: %s", v)
+	}
+	if v.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("fraction too large: %s", v)
+	}
+
+	return nil
+}
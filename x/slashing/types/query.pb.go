@@ -0,0 +1,2754 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryOracleParamsRequest is the request type for the Query/OracleParams RPC method.
+type QueryOracleParamsRequest struct {
+}
+
+func (m *QueryOracleParamsRequest) Reset()         { *m = QueryOracleParamsRequest{} }
+func (m *QueryOracleParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleParamsRequest) ProtoMessage()    {}
+func (*QueryOracleParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{0}
+}
+func (m *QueryOracleParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleParamsRequest.Merge(m, src)
+}
+func (m *QueryOracleParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleParamsRequest proto.InternalMessageInfo
+
+// QueryOracleParamsResponse is the response type for the Query/OracleParams RPC method.
+type QueryOracleParamsResponse struct {
+	Params OracleParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryOracleParamsResponse) Reset()         { *m = QueryOracleParamsResponse{} }
+func (m *QueryOracleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleParamsResponse) ProtoMessage()    {}
+func (*QueryOracleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{1}
+}
+func (m *QueryOracleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleParamsResponse.Merge(m, src)
+}
+func (m *QueryOracleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleParamsResponse proto.InternalMessageInfo
+
+func (m *QueryOracleParamsResponse) GetParams() OracleParams {
+	if m != nil {
+		return m.Params
+	}
+	return OracleParams{}
+}
+
+// QueryOracleSigningInfoRequest is the request type for the Query/OracleSigningInfo RPC method.
+type QueryOracleSigningInfoRequest struct {
+	// cons_address is the address to query the oracle signing info for
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryOracleSigningInfoRequest) Reset()         { *m = QueryOracleSigningInfoRequest{} }
+func (m *QueryOracleSigningInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleSigningInfoRequest) ProtoMessage()    {}
+func (*QueryOracleSigningInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{2}
+}
+func (m *QueryOracleSigningInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleSigningInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleSigningInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleSigningInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleSigningInfoRequest.Merge(m, src)
+}
+func (m *QueryOracleSigningInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleSigningInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleSigningInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleSigningInfoRequest proto.InternalMessageInfo
+
+func (m *QueryOracleSigningInfoRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryOracleSigningInfoResponse is the response type for the Query/OracleSigningInfo RPC method.
+type QueryOracleSigningInfoResponse struct {
+	// info is the oracle signing info of requested cons address
+	Info OracleSigningInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info"`
+}
+
+func (m *QueryOracleSigningInfoResponse) Reset()         { *m = QueryOracleSigningInfoResponse{} }
+func (m *QueryOracleSigningInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleSigningInfoResponse) ProtoMessage()    {}
+func (*QueryOracleSigningInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{3}
+}
+func (m *QueryOracleSigningInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleSigningInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleSigningInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleSigningInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleSigningInfoResponse.Merge(m, src)
+}
+func (m *QueryOracleSigningInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleSigningInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleSigningInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleSigningInfoResponse proto.InternalMessageInfo
+
+func (m *QueryOracleSigningInfoResponse) GetInfo() OracleSigningInfo {
+	if m != nil {
+		return m.Info
+	}
+	return OracleSigningInfo{}
+}
+
+// QueryOracleSigningInfosRequest is the request type for the Query/OracleSigningInfos RPC method.
+type QueryOracleSigningInfosRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOracleSigningInfosRequest) Reset()         { *m = QueryOracleSigningInfosRequest{} }
+func (m *QueryOracleSigningInfosRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleSigningInfosRequest) ProtoMessage()    {}
+func (*QueryOracleSigningInfosRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{4}
+}
+func (m *QueryOracleSigningInfosRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleSigningInfosRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleSigningInfosRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleSigningInfosRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleSigningInfosRequest.Merge(m, src)
+}
+func (m *QueryOracleSigningInfosRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleSigningInfosRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleSigningInfosRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleSigningInfosRequest proto.InternalMessageInfo
+
+func (m *QueryOracleSigningInfosRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryOracleSigningInfosResponse is the response type for the Query/OracleSigningInfos RPC method.
+type QueryOracleSigningInfosResponse struct {
+	// infos is the oracle signing infos of all validators
+	Infos      []OracleSigningInfo `protobuf:"bytes,1,rep,name=infos,proto3" json:"infos"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryOracleSigningInfosResponse) Reset()         { *m = QueryOracleSigningInfosResponse{} }
+func (m *QueryOracleSigningInfosResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryOracleSigningInfosResponse) ProtoMessage()    {}
+func (*QueryOracleSigningInfosResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{5}
+}
+func (m *QueryOracleSigningInfosResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryOracleSigningInfosResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryOracleSigningInfosResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryOracleSigningInfosResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryOracleSigningInfosResponse.Merge(m, src)
+}
+func (m *QueryOracleSigningInfosResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryOracleSigningInfosResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryOracleSigningInfosResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryOracleSigningInfosResponse proto.InternalMessageInfo
+
+func (m *QueryOracleSigningInfosResponse) GetInfos() []OracleSigningInfo {
+	if m != nil {
+		return m.Infos
+	}
+	return nil
+}
+
+func (m *QueryOracleSigningInfosResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUptimeParamsRequest is the request type for the Query/UptimeParams RPC method.
+type QueryUptimeParamsRequest struct {
+}
+
+func (m *QueryUptimeParamsRequest) Reset()         { *m = QueryUptimeParamsRequest{} }
+func (m *QueryUptimeParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUptimeParamsRequest) ProtoMessage()    {}
+func (*QueryUptimeParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{6}
+}
+func (m *QueryUptimeParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUptimeParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUptimeParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUptimeParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUptimeParamsRequest.Merge(m, src)
+}
+func (m *QueryUptimeParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUptimeParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUptimeParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUptimeParamsRequest proto.InternalMessageInfo
+
+// QueryUptimeParamsResponse is the response type for the Query/UptimeParams RPC method.
+type QueryUptimeParamsResponse struct {
+	Params UptimeParams `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+}
+
+func (m *QueryUptimeParamsResponse) Reset()         { *m = QueryUptimeParamsResponse{} }
+func (m *QueryUptimeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUptimeParamsResponse) ProtoMessage()    {}
+func (*QueryUptimeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{7}
+}
+func (m *QueryUptimeParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUptimeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUptimeParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUptimeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUptimeParamsResponse.Merge(m, src)
+}
+func (m *QueryUptimeParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUptimeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUptimeParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUptimeParamsResponse proto.InternalMessageInfo
+
+func (m *QueryUptimeParamsResponse) GetParams() UptimeParams {
+	if m != nil {
+		return m.Params
+	}
+	return UptimeParams{}
+}
+
+// QueryMissedBlockBitmapRequest is the request type for the Query/MissedBlockBitmap RPC method.
+type QueryMissedBlockBitmapRequest struct {
+	// cons_address is the address to query the missed block bitmap for
+	ConsAddress string `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+}
+
+func (m *QueryMissedBlockBitmapRequest) Reset()         { *m = QueryMissedBlockBitmapRequest{} }
+func (m *QueryMissedBlockBitmapRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlockBitmapRequest) ProtoMessage()    {}
+func (*QueryMissedBlockBitmapRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{8}
+}
+func (m *QueryMissedBlockBitmapRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlockBitmapRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlockBitmapRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlockBitmapRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlockBitmapRequest.Merge(m, src)
+}
+func (m *QueryMissedBlockBitmapRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlockBitmapRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlockBitmapRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlockBitmapRequest proto.InternalMessageInfo
+
+func (m *QueryMissedBlockBitmapRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+// QueryMissedBlockBitmapResponse is the response type for the Query/MissedBlockBitmap RPC method.
+type QueryMissedBlockBitmapResponse struct {
+	// signed_blocks_window is the number of the blocks in the bitmap.
+	SignedBlocksWindow int64 `protobuf:"varint,1,opt,name=signed_blocks_window,json=signedBlocksWindow,proto3" json:"signed_blocks_window,omitempty"`
+	// index_offset is the index offset of the validator signing info, where the
+	// block at index (index_offset - 1) % signed_blocks_window is the latest block.
+	IndexOffset int64 `protobuf:"varint,2,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_blocks_counter is the number of the missed blocks in the window.
+	MissedBlocksCounter int64 `protobuf:"varint,3,opt,name=missed_blocks_counter,json=missedBlocksCounter,proto3" json:"missed_blocks_counter,omitempty"`
+	// bitmap is the missed block bitmap of the window, where the bit (i % 8) of
+	// the byte (i / 8) is set if the validator missed the block at index i.
+	Bitmap []byte `protobuf:"bytes,4,opt,name=bitmap,proto3" json:"bitmap,omitempty"`
+}
+
+func (m *QueryMissedBlockBitmapResponse) Reset()         { *m = QueryMissedBlockBitmapResponse{} }
+func (m *QueryMissedBlockBitmapResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMissedBlockBitmapResponse) ProtoMessage()    {}
+func (*QueryMissedBlockBitmapResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{9}
+}
+func (m *QueryMissedBlockBitmapResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMissedBlockBitmapResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMissedBlockBitmapResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMissedBlockBitmapResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMissedBlockBitmapResponse.Merge(m, src)
+}
+func (m *QueryMissedBlockBitmapResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMissedBlockBitmapResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMissedBlockBitmapResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMissedBlockBitmapResponse proto.InternalMessageInfo
+
+func (m *QueryMissedBlockBitmapResponse) GetSignedBlocksWindow() int64 {
+	if m != nil {
+		return m.SignedBlocksWindow
+	}
+	return 0
+}
+
+func (m *QueryMissedBlockBitmapResponse) GetIndexOffset() int64 {
+	if m != nil {
+		return m.IndexOffset
+	}
+	return 0
+}
+
+func (m *QueryMissedBlockBitmapResponse) GetMissedBlocksCounter() int64 {
+	if m != nil {
+		return m.MissedBlocksCounter
+	}
+	return 0
+}
+
+func (m *QueryMissedBlockBitmapResponse) GetBitmap() []byte {
+	if m != nil {
+		return m.Bitmap
+	}
+	return nil
+}
+
+// QueryUptimeHistoryRequest is the request type for the Query/UptimeHistory RPC method.
+type QueryUptimeHistoryRequest struct {
+	// cons_address is the address to query the uptime history for
+	ConsAddress string             `protobuf:"bytes,1,opt,name=cons_address,json=consAddress,proto3" json:"cons_address,omitempty"`
+	Pagination  *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUptimeHistoryRequest) Reset()         { *m = QueryUptimeHistoryRequest{} }
+func (m *QueryUptimeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUptimeHistoryRequest) ProtoMessage()    {}
+func (*QueryUptimeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{10}
+}
+func (m *QueryUptimeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUptimeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUptimeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUptimeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUptimeHistoryRequest.Merge(m, src)
+}
+func (m *QueryUptimeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUptimeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUptimeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUptimeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryUptimeHistoryRequest) GetConsAddress() string {
+	if m != nil {
+		return m.ConsAddress
+	}
+	return ""
+}
+
+func (m *QueryUptimeHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryUptimeHistoryResponse is the response type for the Query/UptimeHistory RPC method.
+type QueryUptimeHistoryResponse struct {
+	// epochs is the retained epoch uptimes of requested cons address in ascending order
+	Epochs     []EpochUptime       `protobuf:"bytes,1,rep,name=epochs,proto3" json:"epochs"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryUptimeHistoryResponse) Reset()         { *m = QueryUptimeHistoryResponse{} }
+func (m *QueryUptimeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUptimeHistoryResponse) ProtoMessage()    {}
+func (*QueryUptimeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1f68280573da87f, []int{11}
+}
+func (m *QueryUptimeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUptimeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUptimeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryUptimeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUptimeHistoryResponse.Merge(m, src)
+}
+func (m *QueryUptimeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUptimeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUptimeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryUptimeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryUptimeHistoryResponse) GetEpochs() []EpochUptime {
+	if m != nil {
+		return m.Epochs
+	}
+	return nil
+}
+
+func (m *QueryUptimeHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryOracleParamsRequest)(nil), "initia.slashing.v1.QueryOracleParamsRequest")
+	proto.RegisterType((*QueryOracleParamsResponse)(nil), "initia.slashing.v1.QueryOracleParamsResponse")
+	proto.RegisterType((*QueryOracleSigningInfoRequest)(nil), "initia.slashing.v1.QueryOracleSigningInfoRequest")
+	proto.RegisterType((*QueryOracleSigningInfoResponse)(nil), "initia.slashing.v1.QueryOracleSigningInfoResponse")
+	proto.RegisterType((*QueryOracleSigningInfosRequest)(nil), "initia.slashing.v1.QueryOracleSigningInfosRequest")
+	proto.RegisterType((*QueryOracleSigningInfosResponse)(nil), "initia.slashing.v1.QueryOracleSigningInfosResponse")
+	proto.RegisterType((*QueryUptimeParamsRequest)(nil), "initia.slashing.v1.QueryUptimeParamsRequest")
+	proto.RegisterType((*QueryUptimeParamsResponse)(nil), "initia.slashing.v1.QueryUptimeParamsResponse")
+	proto.RegisterType((*QueryMissedBlockBitmapRequest)(nil), "initia.slashing.v1.QueryMissedBlockBitmapRequest")
+	proto.RegisterType((*QueryMissedBlockBitmapResponse)(nil), "initia.slashing.v1.QueryMissedBlockBitmapResponse")
+	proto.RegisterType((*QueryUptimeHistoryRequest)(nil), "initia.slashing.v1.QueryUptimeHistoryRequest")
+	proto.RegisterType((*QueryUptimeHistoryResponse)(nil), "initia.slashing.v1.QueryUptimeHistoryResponse")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/query.proto", fileDescriptor_b1f68280573da87f) }
+
+var fileDescriptor_b1f68280573da87f = []byte{
+	// 815 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x96, 0xcf, 0x6f, 0xd3, 0x48,
+	0x14, 0xc7, 0x33, 0x4d, 0x1b, 0x6d, 0xa7, 0xdd, 0x43, 0x67, 0xbb, 0xab, 0xac, 0xb5, 0xeb, 0xb6,
+	0x46, 0x40, 0xa9, 0xa8, 0xdd, 0xa4, 0x12, 0xd0, 0x0b, 0x88, 0x14, 0x4a, 0x39, 0xa0, 0x96, 0x20,
+	0x84, 0xc4, 0x25, 0x4c, 0x92, 0x89, 0x33, 0x22, 0x9e, 0x71, 0x33, 0x4e, 0x7f, 0x08, 0x71, 0xe1,
+	0xc0, 0x19, 0x81, 0xc4, 0xdf, 0x40, 0x4f, 0x20, 0x8e, 0x70, 0xe2, 0xd6, 0x63, 0x25, 0x2e, 0x9c,
+	0x10, 0x6a, 0x91, 0xf8, 0x37, 0x90, 0x67, 0x26, 0xd4, 0x69, 0xec, 0x34, 0xa9, 0xb8, 0x44, 0xce,
+	0xbc, 0xf7, 0x9d, 0xf7, 0x99, 0x37, 0x5f, 0x3f, 0x19, 0x9a, 0x94, 0xd1, 0x80, 0x62, 0x47, 0x34,
+	0xb0, 0xa8, 0x53, 0xe6, 0x3a, 0x9b, 0x39, 0x67, 0xa3, 0x45, 0x9a, 0x3b, 0xb6, 0xdf, 0xe4, 0x01,
+	0x47, 0x48, 0xc5, 0xed, 0x76, 0xdc, 0xde, 0xcc, 0x19, 0x13, 0xd8, 0xa3, 0x8c, 0x3b, 0xf2, 0x57,
+	0xa5, 0x19, 0x73, 0x15, 0x2e, 0x3c, 0x2e, 0x9c, 0x32, 0x16, 0x44, 0xe9, 0x9d, 0xcd, 0x5c, 0x99,
+	0x04, 0x38, 0xe7, 0xf8, 0xd8, 0xa5, 0x0c, 0x07, 0x94, 0x33, 0x9d, 0x3b, 0xe9, 0x72, 0x97, 0xcb,
+	0x47, 0x27, 0x7c, 0xd2, 0xab, 0xff, 0xb9, 0x9c, 0xbb, 0x0d, 0xe2, 0x60, 0x9f, 0x3a, 0x98, 0x31,
+	0x1e, 0x48, 0x89, 0xd0, 0xd1, 0x38, 0xcc, 0x60, 0xc7, 0x27, 0x3a, 0x6e, 0x19, 0x30, 0x7b, 0x37,
+	0xac, 0xba, 0xd6, 0xc4, 0x95, 0x06, 0x59, 0xc7, 0x4d, 0xec, 0x89, 0x22, 0xd9, 0x68, 0x11, 0x11,
+	0x58, 0x8f, 0xe0, 0xbf, 0x31, 0x31, 0xe1, 0x73, 0x26, 0x08, 0x5a, 0x86, 0x19, 0x5f, 0xae, 0x64,
+	0xc1, 0x34, 0x98, 0x1d, 0xcb, 0x4f, 0xdb, 0xdd, 0x07, 0xb6, 0xa3, 0xca, 0xc2, 0xe8, 0xde, 0xd7,
+	0xa9, 0xd4, 0x9b, 0x1f, 0xef, 0xe6, 0x40, 0x51, 0x4b, 0xad, 0x02, 0xfc, 0x3f, 0x52, 0xe1, 0x1e,
+	0x75, 0x19, 0x65, 0xee, 0x6d, 0x56, 0xe3, 0x1a, 0x01, 0xcd, 0xc0, 0xf1, 0x0a, 0x67, 0xa2, 0x84,
+	0xab, 0xd5, 0x26, 0x11, 0xaa, 0xd6, 0x68, 0x71, 0x2c, 0x5c, 0xbb, 0xae, 0x96, 0xac, 0x1a, 0x34,
+	0x93, 0xf6, 0xd0, 0xa8, 0x37, 0xe0, 0x30, 0x65, 0x35, 0xae, 0x41, 0xcf, 0x26, 0x83, 0x46, 0xc4,
+	0x51, 0x5a, 0xa9, 0xb6, 0xea, 0x49, 0x75, 0xda, 0xfd, 0x42, 0x2b, 0x10, 0x1e, 0xdd, 0x99, 0xae,
+	0x76, 0xce, 0x56, 0x17, 0x6c, 0x87, 0x17, 0x6c, 0x2b, 0x83, 0xe8, 0x0b, 0xb6, 0xd7, 0xb1, 0x4b,
+	0xb4, 0xb6, 0x18, 0x51, 0x5a, 0xef, 0x01, 0x9c, 0x4a, 0x2c, 0xa5, 0xcf, 0xb4, 0x02, 0x47, 0x42,
+	0xaa, 0xb0, 0x23, 0xe9, 0x53, 0x1d, 0x4a, 0xc9, 0xd1, 0xad, 0x0e, 0xe6, 0x21, 0xc9, 0x7c, 0xfe,
+	0x44, 0x66, 0x05, 0xd1, 0x01, 0xdd, 0x36, 0xd2, 0x7d, 0x3f, 0xa0, 0x5e, 0x82, 0x91, 0x3a, 0x63,
+	0x83, 0x18, 0x29, 0xaa, 0xec, 0x65, 0xa4, 0x3b, 0x54, 0x08, 0x52, 0x2d, 0x34, 0x78, 0xe5, 0x71,
+	0x81, 0x06, 0x1e, 0xf6, 0x07, 0x30, 0xd2, 0x27, 0x00, 0xcd, 0xa4, 0x4d, 0x34, 0xeb, 0x02, 0x9c,
+	0x14, 0xd4, 0x65, 0xa4, 0x5a, 0x2a, 0x87, 0x51, 0x51, 0xda, 0xa2, 0xac, 0xca, 0xb7, 0xe4, 0x6e,
+	0xe9, 0x22, 0x52, 0x31, 0x29, 0x14, 0x0f, 0x64, 0x24, 0xac, 0x4b, 0x59, 0x95, 0x6c, 0x97, 0x78,
+	0xad, 0x26, 0x48, 0x20, 0x3b, 0x9c, 0x2e, 0x8e, 0xc9, 0xb5, 0x35, 0xb9, 0x84, 0xf2, 0xf0, 0x6f,
+	0x4f, 0x56, 0x6c, 0x6f, 0x5a, 0xe1, 0x2d, 0x16, 0x90, 0x66, 0x36, 0x2d, 0x73, 0xff, 0xf2, 0x8e,
+	0x70, 0xc4, 0xb2, 0x0a, 0xa1, 0x7f, 0x60, 0xa6, 0x2c, 0xd1, 0xb2, 0xc3, 0xd3, 0x60, 0x76, 0xbc,
+	0xa8, 0xff, 0x59, 0xcf, 0x41, 0x47, 0xab, 0x57, 0xa9, 0x08, 0x78, 0x73, 0xa7, 0xff, 0x26, 0x1c,
+	0xf3, 0xf0, 0xd0, 0xa9, 0x3d, 0xbc, 0x0b, 0xa0, 0x11, 0x07, 0xa2, 0x1b, 0x59, 0x80, 0x19, 0xe2,
+	0xf3, 0x4a, 0xbd, 0xed, 0xdf, 0xa9, 0xb8, 0x4b, 0xbf, 0x19, 0x66, 0x28, 0x7d, 0xc7, 0x9d, 0x2b,
+	0xe5, 0x6f, 0xb3, 0x6e, 0xfe, 0xe5, 0x1f, 0x70, 0x44, 0xb2, 0xa2, 0xd7, 0x00, 0x8e, 0x47, 0x67,
+	0x16, 0xba, 0x18, 0xc7, 0x95, 0x34, 0x30, 0x8d, 0xf9, 0x3e, 0xb3, 0x15, 0x83, 0x75, 0xe1, 0xd9,
+	0xe7, 0xef, 0xaf, 0x86, 0xce, 0xa0, 0x19, 0x27, 0x66, 0x48, 0x73, 0xa9, 0x28, 0x29, 0x7f, 0xa3,
+	0x8f, 0x00, 0x4e, 0x74, 0xbd, 0xce, 0x28, 0x77, 0x42, 0xbd, 0xee, 0x81, 0x6a, 0xe4, 0x07, 0x91,
+	0x68, 0xce, 0x6b, 0x92, 0x73, 0x09, 0x5d, 0xee, 0xc1, 0x29, 0x94, 0xae, 0x24, 0xa7, 0x8a, 0xf3,
+	0x24, 0x6a, 0xb3, 0xa7, 0xe8, 0x2d, 0x80, 0xa8, 0x6b, 0x7b, 0x81, 0x06, 0x60, 0xf9, 0xd5, 0xe2,
+	0xc5, 0x81, 0x34, 0xfa, 0x00, 0x0b, 0xf2, 0x00, 0x73, 0x68, 0xb6, 0xdf, 0x03, 0x48, 0x23, 0x44,
+	0x67, 0x4e, 0x0f, 0x23, 0xc4, 0x0c, 0x3c, 0x63, 0xbe, 0xcf, 0xec, 0x7e, 0x8c, 0xd0, 0x92, 0x8a,
+	0xb6, 0x11, 0x3e, 0x00, 0x38, 0xd1, 0x35, 0x9f, 0x7a, 0x18, 0x21, 0x69, 0x20, 0x1a, 0xf9, 0x41,
+	0x24, 0x9a, 0xf3, 0xaa, 0xe4, 0xbc, 0x82, 0x2e, 0xc5, 0x71, 0x46, 0x67, 0x58, 0x49, 0x8d, 0xa3,
+	0xe3, 0x3e, 0xd8, 0x05, 0xf0, 0xcf, 0x8e, 0x79, 0x80, 0x4e, 0x6a, 0x54, 0xe7, 0x00, 0x33, 0xec,
+	0x7e, 0xd3, 0x35, 0xf0, 0x92, 0x04, 0x5e, 0x44, 0xb9, 0x1e, 0x8d, 0xad, 0x2b, 0xcd, 0x31, 0xd6,
+	0xc2, 0xea, 0xde, 0x81, 0x09, 0xf6, 0x0f, 0x4c, 0xf0, 0xed, 0xc0, 0x04, 0x2f, 0x0e, 0xcd, 0xd4,
+	0xfe, 0xa1, 0x99, 0xfa, 0x72, 0x68, 0xa6, 0x1e, 0xda, 0x2e, 0x0d, 0xea, 0xad, 0xb2, 0x5d, 0xe1,
+	0x9e, 0xde, 0x76, 0xbe, 0x81, 0xcb, 0xa2, 0x5d, 0x62, 0xfb, 0xa8, 0x88, 0xfc, 0xd0, 0x2a, 0x67,
+	0xe4, 0x97, 0xd6, 0xe2, 0xcf, 0x01, 0x00, 0x8c, 0xdb, 0xa5, 0x8f, 0x32, 0x0a, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// OracleParams queries the parameters of the oracle vote liveness tracking.
+	OracleParams(ctx context.Context, in *QueryOracleParamsRequest, opts ...grpc.CallOption) (*QueryOracleParamsResponse, error)
+	// OracleSigningInfo queries the oracle vote liveness info of given cons address.
+	OracleSigningInfo(ctx context.Context, in *QueryOracleSigningInfoRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfoResponse, error)
+	// OracleSigningInfos queries the oracle vote liveness infos of all validators.
+	OracleSigningInfos(ctx context.Context, in *QueryOracleSigningInfosRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfosResponse, error)
+	// UptimeParams queries the parameters of the per-epoch uptime history.
+	UptimeParams(ctx context.Context, in *QueryUptimeParamsRequest, opts ...grpc.CallOption) (*QueryUptimeParamsResponse, error)
+	// MissedBlockBitmap queries the missed block bitmap of the current signed
+	// blocks window of given cons address.
+	MissedBlockBitmap(ctx context.Context, in *QueryMissedBlockBitmapRequest, opts ...grpc.CallOption) (*QueryMissedBlockBitmapResponse, error)
+	// UptimeHistory queries the retained per-epoch uptime history of given cons address.
+	UptimeHistory(ctx context.Context, in *QueryUptimeHistoryRequest, opts ...grpc.CallOption) (*QueryUptimeHistoryResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) OracleParams(ctx context.Context, in *QueryOracleParamsRequest, opts ...grpc.CallOption) (*QueryOracleParamsResponse, error) {
+	out := new(QueryOracleParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/OracleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleSigningInfo(ctx context.Context, in *QueryOracleSigningInfoRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfoResponse, error) {
+	out := new(QueryOracleSigningInfoResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/OracleSigningInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) OracleSigningInfos(ctx context.Context, in *QueryOracleSigningInfosRequest, opts ...grpc.CallOption) (*QueryOracleSigningInfosResponse, error) {
+	out := new(QueryOracleSigningInfosResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/OracleSigningInfos", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UptimeParams(ctx context.Context, in *QueryUptimeParamsRequest, opts ...grpc.CallOption) (*QueryUptimeParamsResponse, error) {
+	out := new(QueryUptimeParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/UptimeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MissedBlockBitmap(ctx context.Context, in *QueryMissedBlockBitmapRequest, opts ...grpc.CallOption) (*QueryMissedBlockBitmapResponse, error) {
+	out := new(QueryMissedBlockBitmapResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/MissedBlockBitmap", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) UptimeHistory(ctx context.Context, in *QueryUptimeHistoryRequest, opts ...grpc.CallOption) (*QueryUptimeHistoryResponse, error) {
+	out := new(QueryUptimeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Query/UptimeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// OracleParams queries the parameters of the oracle vote liveness tracking.
+	OracleParams(context.Context, *QueryOracleParamsRequest) (*QueryOracleParamsResponse, error)
+	// OracleSigningInfo queries the oracle vote liveness info of given cons address.
+	OracleSigningInfo(context.Context, *QueryOracleSigningInfoRequest) (*QueryOracleSigningInfoResponse, error)
+	// OracleSigningInfos queries the oracle vote liveness infos of all validators.
+	OracleSigningInfos(context.Context, *QueryOracleSigningInfosRequest) (*QueryOracleSigningInfosResponse, error)
+	// UptimeParams queries the parameters of the per-epoch uptime history.
+	UptimeParams(context.Context, *QueryUptimeParamsRequest) (*QueryUptimeParamsResponse, error)
+	// MissedBlockBitmap queries the missed block bitmap of the current signed
+	// blocks window of given cons address.
+	MissedBlockBitmap(context.Context, *QueryMissedBlockBitmapRequest) (*QueryMissedBlockBitmapResponse, error)
+	// UptimeHistory queries the retained per-epoch uptime history of given cons address.
+	UptimeHistory(context.Context, *QueryUptimeHistoryRequest) (*QueryUptimeHistoryResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) OracleParams(ctx context.Context, req *QueryOracleParamsRequest) (*QueryOracleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleParams not implemented")
+}
+func (*UnimplementedQueryServer) OracleSigningInfo(ctx context.Context, req *QueryOracleSigningInfoRequest) (*QueryOracleSigningInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleSigningInfo not implemented")
+}
+func (*UnimplementedQueryServer) OracleSigningInfos(ctx context.Context, req *QueryOracleSigningInfosRequest) (*QueryOracleSigningInfosResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method OracleSigningInfos not implemented")
+}
+func (*UnimplementedQueryServer) UptimeParams(ctx context.Context, req *QueryUptimeParamsRequest) (*QueryUptimeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UptimeParams not implemented")
+}
+func (*UnimplementedQueryServer) MissedBlockBitmap(ctx context.Context, req *QueryMissedBlockBitmapRequest) (*QueryMissedBlockBitmapResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MissedBlockBitmap not implemented")
+}
+func (*UnimplementedQueryServer) UptimeHistory(ctx context.Context, req *QueryUptimeHistoryRequest) (*QueryUptimeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UptimeHistory not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_OracleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/OracleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleParams(ctx, req.(*QueryOracleParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleSigningInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleSigningInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleSigningInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/OracleSigningInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleSigningInfo(ctx, req.(*QueryOracleSigningInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_OracleSigningInfos_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryOracleSigningInfosRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).OracleSigningInfos(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/OracleSigningInfos",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).OracleSigningInfos(ctx, req.(*QueryOracleSigningInfosRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UptimeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUptimeParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UptimeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/UptimeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UptimeParams(ctx, req.(*QueryUptimeParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MissedBlockBitmap_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMissedBlockBitmapRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MissedBlockBitmap(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/MissedBlockBitmap",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MissedBlockBitmap(ctx, req.(*QueryMissedBlockBitmapRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_UptimeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUptimeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).UptimeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Query/UptimeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).UptimeHistory(ctx, req.(*QueryUptimeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "OracleParams",
+			Handler:    _Query_OracleParams_Handler,
+		},
+		{
+			MethodName: "OracleSigningInfo",
+			Handler:    _Query_OracleSigningInfo_Handler,
+		},
+		{
+			MethodName: "OracleSigningInfos",
+			Handler:    _Query_OracleSigningInfos_Handler,
+		},
+		{
+			MethodName: "UptimeParams",
+			Handler:    _Query_UptimeParams_Handler,
+		},
+		{
+			MethodName: "MissedBlockBitmap",
+			Handler:    _Query_MissedBlockBitmap_Handler,
+		},
+		{
+			MethodName: "UptimeHistory",
+			Handler:    _Query_UptimeHistory_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/query.proto",
+}
+
+func (m *QueryOracleParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleSigningInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleSigningInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleSigningInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleSigningInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleSigningInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleSigningInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Info.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleSigningInfosRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleSigningInfosRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleSigningInfosRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryOracleSigningInfosResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryOracleSigningInfosResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryOracleSigningInfosResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Infos) > 0 {
+		for iNdEx := len(m.Infos) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Infos[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUptimeParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUptimeParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUptimeParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUptimeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUptimeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUptimeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlockBitmapRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlockBitmapRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlockBitmapRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMissedBlockBitmapResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMissedBlockBitmapResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMissedBlockBitmapResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bitmap) > 0 {
+		i -= len(m.Bitmap)
+		copy(dAtA[i:], m.Bitmap)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Bitmap)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MissedBlocksCounter != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MissedBlocksCounter))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.SignedBlocksWindow != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.SignedBlocksWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUptimeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUptimeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUptimeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ConsAddress) > 0 {
+		i -= len(m.ConsAddress)
+		copy(dAtA[i:], m.ConsAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ConsAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUptimeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUptimeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUptimeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Epochs) > 0 {
+		for iNdEx := len(m.Epochs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Epochs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryOracleParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryOracleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleSigningInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleSigningInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Info.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryOracleSigningInfosRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryOracleSigningInfosResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Infos) > 0 {
+		for _, e := range m.Infos {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUptimeParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryUptimeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMissedBlockBitmapRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMissedBlockBitmapResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedBlocksWindow != 0 {
+		n += 1 + sovQuery(uint64(m.SignedBlocksWindow))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovQuery(uint64(m.IndexOffset))
+	}
+	if m.MissedBlocksCounter != 0 {
+		n += 1 + sovQuery(uint64(m.MissedBlocksCounter))
+	}
+	l = len(m.Bitmap)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUptimeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ConsAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUptimeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Epochs) > 0 {
+		for _, e := range m.Epochs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryOracleParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleSigningInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleSigningInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleSigningInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleSigningInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleSigningInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleSigningInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Info", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Info.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleSigningInfosRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleSigningInfosRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleSigningInfosRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryOracleSigningInfosResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryOracleSigningInfosResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryOracleSigningInfosResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Infos", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Infos = append(m.Infos, OracleSigningInfo{})
+			if err := m.Infos[len(m.Infos)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUptimeParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUptimeParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUptimeParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUptimeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUptimeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUptimeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlockBitmapRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlockBitmapRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlockBitmapRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMissedBlockBitmapResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMissedBlockBitmapResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMissedBlockBitmapResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocksWindow", wireType)
+			}
+			m.SignedBlocksWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocksWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocksCounter", wireType)
+			}
+			m.MissedBlocksCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocksCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bitmap", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bitmap = append(m.Bitmap[:0], dAtA[iNdEx:postIndex]...)
+			if m.Bitmap == nil {
+				m.Bitmap = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUptimeHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUptimeHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUptimeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUptimeHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUptimeHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUptimeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epochs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Epochs = append(m.Epochs, EpochUptime{})
+			if err := m.Epochs[len(m.Epochs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,15 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewOracleSigningInfo creates a new OracleSigningInfo instance
+func NewOracleSigningInfo(consAddr sdk.ConsAddress, startHeight, indexOffset, missedVotesCounter int64) OracleSigningInfo {
+	return OracleSigningInfo{
+		Address:            consAddr.String(),
+		StartHeight:        startHeight,
+		IndexOffset:        indexOffset,
+		MissedVotesCounter: missedVotesCounter,
+	}
+}
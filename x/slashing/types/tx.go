@@ -0,0 +1,50 @@
+package types
+
+import (
+	"cosmossdk.io/core/address"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+var (
+	_ sdk.Msg = &MsgUpdateOracleParams{}
+	_ sdk.Msg = &MsgUpdateUptimeParams{}
+)
+
+/* MsgUpdateOracleParams */
+
+// NewMsgUpdateOracleParams returns a new MsgUpdateOracleParams instance
+func NewMsgUpdateOracleParams(authority string, params OracleParams) *MsgUpdateOracleParams {
+	return &MsgUpdateOracleParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Validate performs basic MsgUpdateOracleParams message validation.
+func (msg MsgUpdateOracleParams) Validate(accCodec address.Codec) error {
+	if _, err := accCodec.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
+
+/* MsgUpdateUptimeParams */
+
+// NewMsgUpdateUptimeParams returns a new MsgUpdateUptimeParams instance
+func NewMsgUpdateUptimeParams(authority string, params UptimeParams) *MsgUpdateUptimeParams {
+	return &MsgUpdateUptimeParams{
+		Authority: authority,
+		Params:    params,
+	}
+}
+
+// Validate performs basic MsgUpdateUptimeParams message validation.
+func (msg MsgUpdateUptimeParams) Validate(accCodec address.Codec) error {
+	if _, err := accCodec.StringToBytes(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority address: %s", err)
+	}
+
+	return msg.Params.Validate()
+}
@@ -0,0 +1,982 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/tx.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MsgUpdateOracleParams is the Msg/UpdateOracleParams request type.
+type MsgUpdateOracleParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the oracle vote liveness parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params OracleParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateOracleParams) Reset()         { *m = MsgUpdateOracleParams{} }
+func (m *MsgUpdateOracleParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleParams) ProtoMessage()    {}
+func (*MsgUpdateOracleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{0}
+}
+func (m *MsgUpdateOracleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleParams.Merge(m, src)
+}
+func (m *MsgUpdateOracleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleParams proto.InternalMessageInfo
+
+func (m *MsgUpdateOracleParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateOracleParams) GetParams() OracleParams {
+	if m != nil {
+		return m.Params
+	}
+	return OracleParams{}
+}
+
+// MsgUpdateOracleParamsResponse defines the response structure for executing a
+// MsgUpdateOracleParams message.
+type MsgUpdateOracleParamsResponse struct {
+}
+
+func (m *MsgUpdateOracleParamsResponse) Reset()         { *m = MsgUpdateOracleParamsResponse{} }
+func (m *MsgUpdateOracleParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateOracleParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateOracleParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{1}
+}
+func (m *MsgUpdateOracleParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateOracleParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateOracleParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateOracleParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateOracleParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateOracleParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateOracleParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateOracleParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateOracleParamsResponse proto.InternalMessageInfo
+
+// MsgUpdateUptimeParams is the Msg/UpdateUptimeParams request type.
+type MsgUpdateUptimeParams struct {
+	// authority is the address that controls the module (defaults to x/gov unless overwritten).
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// params defines the uptime history parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params UptimeParams `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateUptimeParams) Reset()         { *m = MsgUpdateUptimeParams{} }
+func (m *MsgUpdateUptimeParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateUptimeParams) ProtoMessage()    {}
+func (*MsgUpdateUptimeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{2}
+}
+func (m *MsgUpdateUptimeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateUptimeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateUptimeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateUptimeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateUptimeParams.Merge(m, src)
+}
+func (m *MsgUpdateUptimeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateUptimeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateUptimeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateUptimeParams proto.InternalMessageInfo
+
+func (m *MsgUpdateUptimeParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateUptimeParams) GetParams() UptimeParams {
+	if m != nil {
+		return m.Params
+	}
+	return UptimeParams{}
+}
+
+// MsgUpdateUptimeParamsResponse defines the response structure for executing a
+// MsgUpdateUptimeParams message.
+type MsgUpdateUptimeParamsResponse struct {
+}
+
+func (m *MsgUpdateUptimeParamsResponse) Reset()         { *m = MsgUpdateUptimeParamsResponse{} }
+func (m *MsgUpdateUptimeParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateUptimeParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateUptimeParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ea88ec4742b910e1, []int{3}
+}
+func (m *MsgUpdateUptimeParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateUptimeParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateUptimeParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateUptimeParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateUptimeParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateUptimeParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateUptimeParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateUptimeParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateUptimeParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateOracleParams)(nil), "initia.slashing.v1.MsgUpdateOracleParams")
+	proto.RegisterType((*MsgUpdateOracleParamsResponse)(nil), "initia.slashing.v1.MsgUpdateOracleParamsResponse")
+	proto.RegisterType((*MsgUpdateUptimeParams)(nil), "initia.slashing.v1.MsgUpdateUptimeParams")
+	proto.RegisterType((*MsgUpdateUptimeParamsResponse)(nil), "initia.slashing.v1.MsgUpdateUptimeParamsResponse")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/tx.proto", fileDescriptor_ea88ec4742b910e1) }
+
+var fileDescriptor_ea88ec4742b910e1 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x53, 0xbd, 0x4e, 0x32, 0x41,
+	0x14, 0xdd, 0xf9, 0xbe, 0x7c, 0x24, 0xec, 0x57, 0xb9, 0xc1, 0x88, 0x6b, 0x1c, 0x08, 0x15, 0x92,
+	0xb0, 0x93, 0xc5, 0xc4, 0xc2, 0x4e, 0x6c, 0x6c, 0x88, 0x06, 0x43, 0x63, 0x63, 0x06, 0xd8, 0x0c,
+	0x93, 0xb0, 0x3b, 0x9b, 0xbd, 0x03, 0x81, 0xce, 0x58, 0x5a, 0xf9, 0x18, 0x96, 0x14, 0xbe, 0x83,
+	0x94, 0xc4, 0xca, 0xca, 0x18, 0x28, 0x78, 0x01, 0x1f, 0xc0, 0xb0, 0x3f, 0xee, 0x12, 0x37, 0x86,
+	0x58, 0xd8, 0x6c, 0x66, 0xe6, 0x9c, 0x7b, 0xce, 0x3d, 0x77, 0x73, 0xd5, 0x3d, 0xee, 0x70, 0xc9,
+	0x29, 0x81, 0x3e, 0x85, 0x1e, 0x77, 0x18, 0x19, 0x9a, 0x44, 0x8e, 0x0c, 0xd7, 0x13, 0x52, 0x68,
+	0x5a, 0x00, 0x1a, 0x11, 0x68, 0x0c, 0x4d, 0x7d, 0x8b, 0xda, 0xdc, 0x11, 0xc4, 0xff, 0x06, 0x34,
+	0x7d, 0xa7, 0x23, 0xc0, 0x16, 0x40, 0x6c, 0xf0, 0xcb, 0x6d, 0x60, 0x21, 0xb0, 0x1b, 0x00, 0xd7,
+	0xfe, 0x8d, 0x04, 0x97, 0x10, 0xca, 0x31, 0xc1, 0x44, 0xf0, 0xbe, 0x3a, 0x85, 0xaf, 0x38, 0xad,
+	0x9b, 0xb1, 0x6b, 0x85, 0x55, 0xa5, 0x27, 0xa4, 0x6e, 0x37, 0x80, 0xb5, 0xdc, 0x2e, 0x95, 0xd6,
+	0xb9, 0x47, 0x3b, 0x7d, 0xeb, 0x82, 0x7a, 0xd4, 0x06, 0xed, 0x48, 0xcd, 0xd2, 0x81, 0xec, 0x09,
+	0x8f, 0xcb, 0x71, 0x1e, 0x15, 0x51, 0x39, 0x5b, 0xcf, 0x3f, 0x3f, 0x56, 0x73, 0xa1, 0xe9, 0x49,
+	0xb7, 0xeb, 0x59, 0x00, 0x97, 0xd2, 0xe3, 0x0e, 0x6b, 0xc6, 0x54, 0xed, 0x54, 0xcd, 0xb8, 0xbe,
+	0x42, 0xfe, 0x4f, 0x11, 0x95, 0xff, 0xd7, 0x8a, 0xc6, 0xd7, 0xcc, 0x46, 0xd2, 0xa9, 0x9e, 0x9d,
+	0xbe, 0x16, 0x94, 0x87, 0xe5, 0xa4, 0x82, 0x9a, 0x61, 0xe9, 0xb1, 0x79, 0xbb, 0x9c, 0x54, 0x62,
+	0xd1, 0xbb, 0xe5, 0xa4, 0x82, 0x3f, 0x23, 0xa4, 0xf6, 0x5b, 0x2a, 0xa8, 0xfb, 0xa9, 0x40, 0xd3,
+	0x02, 0x57, 0x38, 0x60, 0xad, 0x47, 0x6d, 0xb9, 0x92, 0xdb, 0xbf, 0x12, 0x35, 0xe9, 0xf4, 0xf3,
+	0xa8, 0x49, 0x95, 0xb5, 0xa8, 0x49, 0x20, 0x8a, 0x5a, 0x7b, 0x47, 0xea, 0xdf, 0x06, 0x30, 0xcd,
+	0x53, 0xb5, 0x94, 0x3f, 0x7b, 0x90, 0xd6, 0x66, 0xea, 0xec, 0x74, 0x73, 0x63, 0x6a, 0xe4, 0x1d,
+	0x7b, 0xae, 0x8d, 0xf8, 0x7b, 0xcf, 0x24, 0x55, 0x37, 0x37, 0xa6, 0x46, 0x9e, 0xfa, 0xbf, 0x9b,
+	0xd5, 0x48, 0xeb, 0x67, 0xd3, 0x39, 0x46, 0xb3, 0x39, 0x46, 0x6f, 0x73, 0x8c, 0xee, 0x17, 0x58,
+	0x99, 0x2d, 0xb0, 0xf2, 0xb2, 0xc0, 0xca, 0x95, 0xc1, 0xb8, 0xec, 0x0d, 0xda, 0x46, 0x47, 0xd8,
+	0x24, 0x50, 0xaf, 0xf6, 0x69, 0x1b, 0xc2, 0x33, 0x19, 0xc5, 0xfb, 0xe1, 0x2f, 0x47, 0x3b, 0xe3,
+	0x6f, 0xc7, 0xe1, 0xc7, 0x00, 0xe8, 0x4e, 0xe2, 0xf7, 0xcd, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateOracleParams(ctx context.Context, in *MsgUpdateOracleParams, opts ...grpc.CallOption) (*MsgUpdateOracleParamsResponse, error)
+	UpdateUptimeParams(ctx context.Context, in *MsgUpdateUptimeParams, opts ...grpc.CallOption) (*MsgUpdateUptimeParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateOracleParams(ctx context.Context, in *MsgUpdateOracleParams, opts ...grpc.CallOption) (*MsgUpdateOracleParamsResponse, error) {
+	out := new(MsgUpdateOracleParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Msg/UpdateOracleParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateUptimeParams(ctx context.Context, in *MsgUpdateUptimeParams, opts ...grpc.CallOption) (*MsgUpdateUptimeParamsResponse, error) {
+	out := new(MsgUpdateUptimeParamsResponse)
+	err := c.cc.Invoke(ctx, "/initia.slashing.v1.Msg/UpdateUptimeParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateOracleParams(context.Context, *MsgUpdateOracleParams) (*MsgUpdateOracleParamsResponse, error)
+	UpdateUptimeParams(context.Context, *MsgUpdateUptimeParams) (*MsgUpdateUptimeParamsResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) UpdateOracleParams(ctx context.Context, req *MsgUpdateOracleParams) (*MsgUpdateOracleParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateOracleParams not implemented")
+}
+func (*UnimplementedMsgServer) UpdateUptimeParams(ctx context.Context, req *MsgUpdateUptimeParams) (*MsgUpdateUptimeParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateUptimeParams not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_UpdateOracleParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateOracleParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateOracleParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Msg/UpdateOracleParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateOracleParams(ctx, req.(*MsgUpdateOracleParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateUptimeParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateUptimeParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateUptimeParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/initia.slashing.v1.Msg/UpdateUptimeParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateUptimeParams(ctx, req.(*MsgUpdateUptimeParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "initia.slashing.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateOracleParams",
+			Handler:    _Msg_UpdateOracleParams_Handler,
+		},
+		{
+			MethodName: "UpdateUptimeParams",
+			Handler:    _Msg_UpdateUptimeParams_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "initia/slashing/v1/tx.proto",
+}
+
+func (m *MsgUpdateOracleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateOracleParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateOracleParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateOracleParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateUptimeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUptimeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUptimeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateUptimeParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateUptimeParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateUptimeParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateOracleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateOracleParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateUptimeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateUptimeParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateOracleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateOracleParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateOracleParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateOracleParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateUptimeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateUptimeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateUptimeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateUptimeParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateUptimeParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateUptimeParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTx(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTx
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTx
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTx
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTx        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTx          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTx = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,1269 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: initia/slashing/v1/types.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OracleParams defines the parameters of the oracle vote extension liveness tracking.
+type OracleParams struct {
+	// signed_votes_window is the sliding window of the oracle vote liveness tracking.
+	// Zero disables the oracle vote liveness tracking.
+	SignedVotesWindow int64 `protobuf:"varint,1,opt,name=signed_votes_window,json=signedVotesWindow,proto3" json:"signed_votes_window,omitempty" yaml:"signed_votes_window"`
+	// min_voted_per_window is the minimum ratio of the window a validator must submit
+	// oracle prices in its vote extensions.
+	MinVotedPerWindow cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=min_voted_per_window,json=minVotedPerWindow,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"min_voted_per_window" yaml:"min_voted_per_window"`
+	// downtime_jail_duration is the jail duration of the oracle downtime.
+	DowntimeJailDuration time.Duration `protobuf:"bytes,3,opt,name=downtime_jail_duration,json=downtimeJailDuration,proto3,stdduration" json:"downtime_jail_duration" yaml:"downtime_jail_duration"`
+	// slash_fraction_downtime is the slash fraction of the oracle downtime.
+	SlashFractionDowntime cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=slash_fraction_downtime,json=slashFractionDowntime,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"slash_fraction_downtime" yaml:"slash_fraction_downtime"`
+}
+
+func (m *OracleParams) Reset()      { *m = OracleParams{} }
+func (*OracleParams) ProtoMessage() {}
+func (*OracleParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da596ccfd60d2f0f, []int{0}
+}
+func (m *OracleParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleParams.Merge(m, src)
+}
+func (m *OracleParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleParams proto.InternalMessageInfo
+
+// OracleSigningInfo defines the oracle vote liveness information of a validator.
+type OracleSigningInfo struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// start_height is the height at which the oracle vote liveness tracking started.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// index_offset is the index offset into the missed oracle votes bitmap.
+	IndexOffset int64 `protobuf:"varint,3,opt,name=index_offset,json=indexOffset,proto3" json:"index_offset,omitempty"`
+	// missed_votes_counter is the number of missed oracle votes in the window.
+	MissedVotesCounter int64 `protobuf:"varint,4,opt,name=missed_votes_counter,json=missedVotesCounter,proto3" json:"missed_votes_counter,omitempty"`
+}
+
+func (m *OracleSigningInfo) Reset()         { *m = OracleSigningInfo{} }
+func (m *OracleSigningInfo) String() string { return proto.CompactTextString(m) }
+func (*OracleSigningInfo) ProtoMessage()    {}
+func (*OracleSigningInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da596ccfd60d2f0f, []int{1}
+}
+func (m *OracleSigningInfo) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OracleSigningInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OracleSigningInfo.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OracleSigningInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OracleSigningInfo.Merge(m, src)
+}
+func (m *OracleSigningInfo) XXX_Size() int {
+	return m.Size()
+}
+func (m *OracleSigningInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_OracleSigningInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OracleSigningInfo proto.InternalMessageInfo
+
+// UptimeParams defines the parameters of the per-epoch uptime history.
+type UptimeParams struct {
+	// epoch_length is the number of blocks in an uptime epoch.
+	// Zero disables the uptime history.
+	EpochLength int64 `protobuf:"varint,1,opt,name=epoch_length,json=epochLength,proto3" json:"epoch_length,omitempty" yaml:"epoch_length"`
+	// retained_epochs is the number of the latest epochs kept in the uptime history.
+	RetainedEpochs uint64 `protobuf:"varint,2,opt,name=retained_epochs,json=retainedEpochs,proto3" json:"retained_epochs,omitempty" yaml:"retained_epochs"`
+}
+
+func (m *UptimeParams) Reset()      { *m = UptimeParams{} }
+func (*UptimeParams) ProtoMessage() {}
+func (*UptimeParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da596ccfd60d2f0f, []int{2}
+}
+func (m *UptimeParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *UptimeParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_UptimeParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *UptimeParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UptimeParams.Merge(m, src)
+}
+func (m *UptimeParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *UptimeParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_UptimeParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UptimeParams proto.InternalMessageInfo
+
+// EpochUptime defines the block signing summary of a validator in an epoch.
+type EpochUptime struct {
+	// epoch is the epoch number, which is the block height divided by the epoch length.
+	Epoch uint64 `protobuf:"varint,1,opt,name=epoch,proto3" json:"epoch,omitempty"`
+	// start_height is the first height the validator was tracked in the epoch.
+	StartHeight int64 `protobuf:"varint,2,opt,name=start_height,json=startHeight,proto3" json:"start_height,omitempty"`
+	// signed_blocks is the number of the blocks signed by the validator in the epoch.
+	SignedBlocks int64 `protobuf:"varint,3,opt,name=signed_blocks,json=signedBlocks,proto3" json:"signed_blocks,omitempty"`
+	// missed_blocks is the number of the blocks missed by the validator in the epoch.
+	MissedBlocks int64 `protobuf:"varint,4,opt,name=missed_blocks,json=missedBlocks,proto3" json:"missed_blocks,omitempty"`
+}
+
+func (m *EpochUptime) Reset()         { *m = EpochUptime{} }
+func (m *EpochUptime) String() string { return proto.CompactTextString(m) }
+func (*EpochUptime) ProtoMessage()    {}
+func (*EpochUptime) Descriptor() ([]byte, []int) {
+	return fileDescriptor_da596ccfd60d2f0f, []int{3}
+}
+func (m *EpochUptime) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EpochUptime) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EpochUptime.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EpochUptime) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EpochUptime.Merge(m, src)
+}
+func (m *EpochUptime) XXX_Size() int {
+	return m.Size()
+}
+func (m *EpochUptime) XXX_DiscardUnknown() {
+	xxx_messageInfo_EpochUptime.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EpochUptime proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*OracleParams)(nil), "initia.slashing.v1.OracleParams")
+	proto.RegisterType((*OracleSigningInfo)(nil), "initia.slashing.v1.OracleSigningInfo")
+	proto.RegisterType((*UptimeParams)(nil), "initia.slashing.v1.UptimeParams")
+	proto.RegisterType((*EpochUptime)(nil), "initia.slashing.v1.EpochUptime")
+}
+
+func init() { proto.RegisterFile("initia/slashing/v1/types.proto", fileDescriptor_da596ccfd60d2f0f) }
+
+var fileDescriptor_da596ccfd60d2f0f = []byte{
+	// 712 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xbf, 0x6f, 0xd3, 0x4c,
+	0x18, 0xc7, 0xe3, 0x37, 0x79, 0xdf, 0x57, 0xbd, 0x04, 0x50, 0xdc, 0xf4, 0x57, 0xaa, 0xda, 0xad,
+	0x59, 0xaa, 0x4a, 0xb5, 0x29, 0x6c, 0x61, 0xc2, 0x6d, 0x11, 0xa0, 0x8a, 0x56, 0xae, 0x00, 0x89,
+	0xc5, 0xba, 0xd8, 0x17, 0xe7, 0xa8, 0x7d, 0x17, 0xf9, 0x2e, 0xfd, 0x31, 0x20, 0x76, 0x26, 0x26,
+	0xd4, 0xb1, 0x63, 0x37, 0x3a, 0xf0, 0x47, 0x74, 0x42, 0x15, 0x13, 0x62, 0x30, 0x90, 0x0e, 0x65,
+	0x0e, 0xff, 0x00, 0xf2, 0xdd, 0xb9, 0x54, 0x28, 0x43, 0x97, 0x28, 0xcf, 0xe7, 0xf9, 0xfa, 0x39,
+	0x3f, 0xdf, 0xe7, 0xf1, 0x01, 0x03, 0x13, 0xcc, 0x31, 0x74, 0x58, 0x0c, 0x59, 0x17, 0x93, 0xc8,
+	0xd9, 0x5d, 0x71, 0xf8, 0x41, 0x0f, 0x31, 0xbb, 0x97, 0x52, 0x4e, 0x75, 0x5d, 0xe6, 0xed, 0x22,
+	0x6f, 0xef, 0xae, 0x34, 0xeb, 0x30, 0xc1, 0x84, 0x3a, 0xe2, 0x57, 0xca, 0x9a, 0x33, 0x01, 0x65,
+	0x09, 0x65, 0xbe, 0x88, 0x1c, 0x19, 0xa8, 0x54, 0x23, 0xa2, 0x11, 0x95, 0x3c, 0xff, 0xa7, 0xa8,
+	0x11, 0x51, 0x1a, 0xc5, 0xc8, 0x11, 0x51, 0xbb, 0xdf, 0x71, 0xc2, 0x7e, 0x0a, 0x39, 0xa6, 0x44,
+	0xe6, 0xad, 0x5f, 0x65, 0x50, 0xdb, 0x4c, 0x61, 0x10, 0xa3, 0x2d, 0x98, 0xc2, 0x84, 0xe9, 0x4f,
+	0xc1, 0x38, 0xc3, 0x11, 0x41, 0xa1, 0xbf, 0x4b, 0x39, 0x62, 0xfe, 0x1e, 0x26, 0x21, 0xdd, 0x9b,
+	0xd6, 0xe6, 0xb5, 0xc5, 0xb2, 0x6b, 0x0c, 0x33, 0xb3, 0x79, 0x00, 0x93, 0xb8, 0x65, 0x8d, 0x10,
+	0x59, 0x5e, 0x5d, 0xd2, 0xe7, 0x39, 0x7c, 0x21, 0x98, 0xce, 0x40, 0x23, 0xc1, 0x44, 0xe8, 0x42,
+	0xbf, 0x87, 0xd2, 0xa2, 0xe0, 0x3f, 0xf3, 0xda, 0xe2, 0x98, 0xeb, 0x9e, 0x66, 0x66, 0xe9, 0x6b,
+	0x66, 0xce, 0xca, 0x56, 0x58, 0xb8, 0x63, 0x63, 0xea, 0x24, 0x90, 0x77, 0xed, 0x0d, 0x14, 0xc1,
+	0xe0, 0x60, 0x0d, 0x05, 0xc3, 0xcc, 0x9c, 0x95, 0x67, 0x8e, 0x2a, 0x64, 0x79, 0xf5, 0x04, 0x93,
+	0xfc, 0xc4, 0x70, 0x0b, 0xa5, 0xea, 0xd0, 0x37, 0x60, 0x32, 0xa4, 0x7b, 0x84, 0xe3, 0x04, 0xf9,
+	0xaf, 0x20, 0x8e, 0xfd, 0xa2, 0xeb, 0xe9, 0xf2, 0xbc, 0xb6, 0x58, 0xbd, 0x3b, 0x63, 0x4b, 0x5b,
+	0xec, 0xc2, 0x16, 0x7b, 0x4d, 0x09, 0x5c, 0x3b, 0x7f, 0xa3, 0x61, 0x66, 0xce, 0xc9, 0x23, 0x47,
+	0x97, 0xb1, 0x0e, 0xbf, 0x99, 0xda, 0xf1, 0xc5, 0xc9, 0x92, 0xe6, 0x35, 0x0a, 0xc5, 0x13, 0x88,
+	0xe3, 0xa2, 0x8a, 0xfe, 0x1a, 0x4c, 0x89, 0x49, 0xfa, 0x9d, 0x14, 0x06, 0x39, 0xf1, 0x0b, 0xd9,
+	0x74, 0x45, 0x34, 0xbe, 0x7e, 0xbd, 0xc6, 0x0d, 0x65, 0xf6, 0xe8, 0x5a, 0x96, 0x37, 0x21, 0x32,
+	0x0f, 0x55, 0x62, 0x4d, 0xf1, 0x96, 0x71, 0x78, 0x64, 0x96, 0xde, 0x5e, 0x9c, 0x2c, 0x4d, 0x5c,
+	0xee, 0xdb, 0xd5, 0x21, 0x5b, 0x9f, 0x34, 0x50, 0x97, 0x60, 0x1b, 0x47, 0x04, 0x93, 0xe8, 0x31,
+	0xe9, 0x50, 0xfd, 0x3e, 0xf8, 0x1f, 0x86, 0x61, 0x8a, 0x18, 0x13, 0xe3, 0x1e, 0x73, 0x17, 0x3e,
+	0x7f, 0x5c, 0x9e, 0x53, 0x4b, 0xb6, 0x4a, 0x09, 0x43, 0x84, 0xf5, 0xd9, 0x03, 0x29, 0xd9, 0xe6,
+	0x29, 0x26, 0x91, 0x57, 0x3c, 0xa1, 0x2f, 0x80, 0x1a, 0xe3, 0x30, 0xe5, 0x7e, 0x17, 0xe1, 0xa8,
+	0xcb, 0xc5, 0x7c, 0xcb, 0x5e, 0x55, 0xb0, 0x47, 0x02, 0xe5, 0x12, 0x4c, 0x42, 0xb4, 0xef, 0xd3,
+	0x4e, 0x87, 0x21, 0x2e, 0x66, 0x51, 0xf6, 0xaa, 0x82, 0x6d, 0x0a, 0xa4, 0xdf, 0xc9, 0xb7, 0x85,
+	0xb1, 0xcb, 0xc5, 0x0a, 0x68, 0x9f, 0x70, 0x94, 0x0a, 0xd3, 0xca, 0x9e, 0x2e, 0x73, 0x62, 0xbd,
+	0x56, 0x65, 0xa6, 0x55, 0xf9, 0x79, 0x64, 0x6a, 0xd6, 0x07, 0x0d, 0xd4, 0x9e, 0xf5, 0xf2, 0xde,
+	0xd5, 0x1a, 0xb7, 0x40, 0x0d, 0xf5, 0x68, 0xd0, 0xf5, 0x63, 0x44, 0x22, 0xde, 0x55, 0xfb, 0x3b,
+	0x35, 0xcc, 0xcc, 0x71, 0x69, 0xe9, 0xd5, 0xac, 0xe5, 0x55, 0x45, 0xb8, 0x21, 0x22, 0x7d, 0x15,
+	0xdc, 0x4a, 0x11, 0x87, 0x38, 0xdf, 0x6f, 0xc1, 0x99, 0xe8, 0xa6, 0xe2, 0x36, 0x87, 0x99, 0x39,
+	0x29, 0x1f, 0xff, 0x4b, 0x60, 0x79, 0x37, 0x0b, 0xb2, 0x2e, 0xc0, 0xa8, 0x11, 0x5c, 0x7d, 0x41,
+	0xeb, 0xbd, 0x06, 0xaa, 0x42, 0x2a, 0xa9, 0xde, 0x00, 0xff, 0x8a, 0x52, 0xe2, 0x4d, 0x2b, 0x9e,
+	0x0c, 0xae, 0xe3, 0xea, 0x6d, 0x70, 0x43, 0x7d, 0x8b, 0xed, 0x98, 0x06, 0x3b, 0x4c, 0xd9, 0x5a,
+	0x93, 0xd0, 0x15, 0x2c, 0x17, 0x29, 0x5f, 0x95, 0x48, 0x1a, 0x5a, 0x93, 0x50, 0x8a, 0xa4, 0x95,
+	0xee, 0xd6, 0xe9, 0x0f, 0xa3, 0x74, 0x3c, 0x30, 0xb4, 0xd3, 0x81, 0xa1, 0x9d, 0x0d, 0x0c, 0xed,
+	0xfb, 0xc0, 0xd0, 0xde, 0x9d, 0x1b, 0xa5, 0xb3, 0x73, 0xa3, 0xf4, 0xe5, 0xdc, 0x28, 0xbd, 0xb4,
+	0x23, 0xcc, 0xbb, 0xfd, 0xb6, 0x1d, 0xd0, 0xc4, 0x91, 0xd7, 0xd6, 0x72, 0x0c, 0xdb, 0x4c, 0xfd,
+	0x77, 0xf6, 0xff, 0x5c, 0x72, 0xe2, 0x86, 0x6b, 0xff, 0x27, 0xbe, 0xb2, 0x7b, 0xbf, 0x07, 0x00,
+	0x7b, 0x8f, 0xca, 0x15, 0x04, 0x05, 0x00, 0x00,
+}
+
+func (this *OracleParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleParams)
+	if !ok {
+		that2, ok := that.(OracleParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.SignedVotesWindow != that1.SignedVotesWindow {
+		return false
+	}
+	if !this.MinVotedPerWindow.Equal(that1.MinVotedPerWindow) {
+		return false
+	}
+	if this.DowntimeJailDuration != that1.DowntimeJailDuration {
+		return false
+	}
+	if !this.SlashFractionDowntime.Equal(that1.SlashFractionDowntime) {
+		return false
+	}
+	return true
+}
+func (this *OracleSigningInfo) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*OracleSigningInfo)
+	if !ok {
+		that2, ok := that.(OracleSigningInfo)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.IndexOffset != that1.IndexOffset {
+		return false
+	}
+	if this.MissedVotesCounter != that1.MissedVotesCounter {
+		return false
+	}
+	return true
+}
+func (this *UptimeParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*UptimeParams)
+	if !ok {
+		that2, ok := that.(UptimeParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EpochLength != that1.EpochLength {
+		return false
+	}
+	if this.RetainedEpochs != that1.RetainedEpochs {
+		return false
+	}
+	return true
+}
+func (this *EpochUptime) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EpochUptime)
+	if !ok {
+		that2, ok := that.(EpochUptime)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Epoch != that1.Epoch {
+		return false
+	}
+	if this.StartHeight != that1.StartHeight {
+		return false
+	}
+	if this.SignedBlocks != that1.SignedBlocks {
+		return false
+	}
+	if this.MissedBlocks != that1.MissedBlocks {
+		return false
+	}
+	return true
+}
+func (m *OracleParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.SlashFractionDowntime.Size()
+		i -= size
+		if _, err := m.SlashFractionDowntime.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.DowntimeJailDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintTypes(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinVotedPerWindow.Size()
+		i -= size
+		if _, err := m.MinVotedPerWindow.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTypes(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.SignedVotesWindow != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedVotesWindow))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *OracleSigningInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OracleSigningInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OracleSigningInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedVotesCounter != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedVotesCounter))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.IndexOffset != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.IndexOffset))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTypes(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *UptimeParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *UptimeParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *UptimeParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetainedEpochs != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RetainedEpochs))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EpochLength != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.EpochLength))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EpochUptime) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EpochUptime) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EpochUptime) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MissedBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.MissedBlocks))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.SignedBlocks != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.SignedBlocks))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.StartHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.StartHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Epoch != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.Epoch))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTypes(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OracleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SignedVotesWindow != 0 {
+		n += 1 + sovTypes(uint64(m.SignedVotesWindow))
+	}
+	l = m.MinVotedPerWindow.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.DowntimeJailDuration)
+	n += 1 + l + sovTypes(uint64(l))
+	l = m.SlashFractionDowntime.Size()
+	n += 1 + l + sovTypes(uint64(l))
+	return n
+}
+
+func (m *OracleSigningInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTypes(uint64(l))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.IndexOffset != 0 {
+		n += 1 + sovTypes(uint64(m.IndexOffset))
+	}
+	if m.MissedVotesCounter != 0 {
+		n += 1 + sovTypes(uint64(m.MissedVotesCounter))
+	}
+	return n
+}
+
+func (m *UptimeParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EpochLength != 0 {
+		n += 1 + sovTypes(uint64(m.EpochLength))
+	}
+	if m.RetainedEpochs != 0 {
+		n += 1 + sovTypes(uint64(m.RetainedEpochs))
+	}
+	return n
+}
+
+func (m *EpochUptime) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Epoch != 0 {
+		n += 1 + sovTypes(uint64(m.Epoch))
+	}
+	if m.StartHeight != 0 {
+		n += 1 + sovTypes(uint64(m.StartHeight))
+	}
+	if m.SignedBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.SignedBlocks))
+	}
+	if m.MissedBlocks != 0 {
+		n += 1 + sovTypes(uint64(m.MissedBlocks))
+	}
+	return n
+}
+
+func sovTypes(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTypes(x uint64) (n int) {
+	return sovTypes(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OracleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedVotesWindow", wireType)
+			}
+			m.SignedVotesWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedVotesWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinVotedPerWindow", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinVotedPerWindow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DowntimeJailDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.DowntimeJailDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SlashFractionDowntime", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SlashFractionDowntime.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *OracleSigningInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OracleSigningInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OracleSigningInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTypes
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IndexOffset", wireType)
+			}
+			m.IndexOffset = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.IndexOffset |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedVotesCounter", wireType)
+			}
+			m.MissedVotesCounter = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedVotesCounter |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *UptimeParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: UptimeParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: UptimeParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochLength", wireType)
+			}
+			m.EpochLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EpochLength |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetainedEpochs", wireType)
+			}
+			m.RetainedEpochs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetainedEpochs |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochUptime) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochUptime: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochUptime: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartHeight", wireType)
+			}
+			m.StartHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedBlocks", wireType)
+			}
+			m.SignedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedBlocks", wireType)
+			}
+			m.MissedBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissedBlocks |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTypes(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTypes
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTypes
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTypes
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTypes        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTypes          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTypes = fmt.Errorf("proto: unexpected end of group")
+)